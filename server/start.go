@@ -4,6 +4,7 @@ package server
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"runtime/pprof"
@@ -231,6 +232,8 @@ func startStandAlone(ctx *Context, appCreator types.AppCreator) error {
 		if err = svr.Stop(); err != nil {
 			tmos.Exit(err.Error())
 		}
+
+		closeApp(ctx, app)
 	}()
 
 	// Wait for SIGINT or SIGTERM signal
@@ -462,6 +465,8 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 			}
 		}
 
+		closeApp(ctx, app)
+
 		ctx.Logger.Info("exiting...")
 	}()
 
@@ -469,6 +474,19 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 	return WaitForQuitSignals()
 }
 
+// closeApp closes the resources of the app, if it has any to close, after
+// the node has stopped.
+func closeApp(ctx *Context, app types.Application) {
+	closer, ok := app.(io.Closer)
+	if !ok {
+		return
+	}
+
+	if err := closer.Close(); err != nil {
+		ctx.Logger.Error("failed to close app", "error", err)
+	}
+}
+
 func genPvFileOnlyWhenKmsAddressEmpty(cfg *config.Config) *pvm.FilePV {
 	if len(strings.TrimSpace(cfg.PrivValidatorListenAddr)) == 0 {
 		return pvm.LoadOrGenFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile())
@@ -77,6 +77,9 @@ import (
 	"github.com/Finschia/finschia-sdk/x/gov"
 	govkeeper "github.com/Finschia/finschia-sdk/x/gov/keeper"
 	govtypes "github.com/Finschia/finschia-sdk/x/gov/types"
+	"github.com/Finschia/finschia-sdk/x/group"
+	groupkeeper "github.com/Finschia/finschia-sdk/x/group/keeper"
+	groupmodule "github.com/Finschia/finschia-sdk/x/group/module"
 	"github.com/Finschia/finschia-sdk/x/mint"
 	mintkeeper "github.com/Finschia/finschia-sdk/x/mint/keeper"
 	minttypes "github.com/Finschia/finschia-sdk/x/mint/types"
@@ -121,6 +124,7 @@ var (
 		mint.AppModuleBasic{},
 		distr.AppModuleBasic{},
 		foundationmodule.AppModuleBasic{},
+		groupmodule.AppModuleBasic{},
 		gov.NewAppModuleBasic(
 			paramsclient.ProposalHandler,
 			distrclient.ProposalHandler,
@@ -150,6 +154,7 @@ var (
 		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:            {authtypes.Burner},
+		token.ModuleName:               {authtypes.Minter, authtypes.Burner},
 	}
 
 	// module accounts that are allowed to receive tokens
@@ -176,6 +181,7 @@ type SimApp struct {
 
 	// keys to access the substores
 	keys    map[string]*sdk.KVStoreKey
+	tkeys   map[string]*sdk.TransientStoreKey
 	memKeys map[string]*sdk.MemoryStoreKey
 
 	// keepers
@@ -187,6 +193,7 @@ type SimApp struct {
 	MintKeeper       mintkeeper.Keeper
 	DistrKeeper      distrkeeper.Keeper
 	FoundationKeeper foundationkeeper.Keeper
+	GroupKeeper      groupkeeper.Keeper
 	GovKeeper        govkeeper.Keeper
 	CrisisKeeper     crisiskeeper.Keeper
 	UpgradeKeeper    upgradekeeper.Keeper
@@ -206,6 +213,9 @@ type SimApp struct {
 
 	// the configurator
 	configurator module.Configurator
+
+	// the proposal archive of x/foundation, if enabled
+	foundationArchive dbm.DB
 }
 
 func init() {
@@ -246,12 +256,13 @@ func NewSimApp(
 		capabilitytypes.StoreKey,
 		feegrant.StoreKey,
 		foundation.StoreKey,
+		group.StoreKey,
 		class.StoreKey,
 		token.StoreKey,
 		collection.StoreKey,
 		authzkeeper.StoreKey,
 	)
-	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
+	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, foundation.TStoreKey)
 	// NOTE: The testingkey is just mounted for testing purposes. Actual applications should
 	// not include this key.
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey, "testingkey")
@@ -269,6 +280,7 @@ func NewSimApp(
 		interfaceRegistry: interfaceRegistry,
 		invCheckPeriod:    invCheckPeriod,
 		keys:              keys,
+		tkeys:             tkeys,
 		memKeys:           memKeys,
 	}
 
@@ -311,11 +323,21 @@ func NewSimApp(
 	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, keys[feegrant.StoreKey], app.AccountKeeper)
 	app.UpgradeKeeper = upgradekeeper.NewKeeper(skipUpgradeHeights, keys[upgradetypes.StoreKey], appCodec, homePath, app.BaseApp)
 
+	app.GroupKeeper = groupkeeper.NewKeeper(appCodec, keys[group.StoreKey], app.BaseApp.MsgServiceRouter(), app.AccountKeeper, group.DefaultConfig())
+
 	foundationConfig := foundation.DefaultConfig()
-	app.FoundationKeeper = foundationkeeper.NewKeeper(appCodec, keys[foundation.StoreKey], app.BaseApp.MsgServiceRouter(), app.AccountKeeper, app.BankKeeper, authtypes.FeeCollectorName, foundationConfig, foundation.DefaultAuthority().String(), app.GetSubspace(foundation.ModuleName))
+	if cast.ToBool(appOpts.Get(foundationmodule.FlagProposalArchive)) {
+		archive, err := sdk.NewLevelDB("foundation_archive", filepath.Join(homePath, "data"))
+		if err != nil {
+			panic(err)
+		}
+		foundationConfig.ProposalArchive = archive
+		app.foundationArchive = archive
+	}
+	app.FoundationKeeper = foundationkeeper.NewKeeper(appCodec, keys[foundation.StoreKey], tkeys[foundation.TStoreKey], app.BaseApp.MsgServiceRouter(), app.AccountKeeper, app.BankKeeper, app.GroupKeeper, authtypes.FeeCollectorName, foundationConfig, foundation.DefaultAuthority().String(), app.GetSubspace(foundation.ModuleName))
 
 	app.ClassKeeper = classkeeper.NewKeeper(appCodec, keys[class.StoreKey])
-	app.TokenKeeper = tokenkeeper.NewKeeper(appCodec, keys[token.StoreKey], app.ClassKeeper)
+	app.TokenKeeper = tokenkeeper.NewKeeper(appCodec, keys[token.StoreKey], app.ClassKeeper, app.BankKeeper, app.GetSubspace(token.ModuleName))
 	app.CollectionKeeper = collectionkeeper.NewKeeper(appCodec, keys[collection.StoreKey], app.ClassKeeper)
 
 	// register the staking hooks
@@ -372,6 +394,7 @@ func NewSimApp(
 		crisis.NewAppModule(&app.CrisisKeeper, skipGenesisInvariants),
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
 		foundationmodule.NewAppModule(appCodec, app.FoundationKeeper),
+		groupmodule.NewAppModule(appCodec, app.GroupKeeper),
 		gov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
 		mint.NewAppModule(appCodec, app.MintKeeper, app.AccountKeeper),
 		slashing.NewAppModule(appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
@@ -380,8 +403,8 @@ func NewSimApp(
 		upgrade.NewAppModule(app.UpgradeKeeper),
 		evidence.NewAppModule(app.EvidenceKeeper),
 		params.NewAppModule(app.ParamsKeeper),
-		tokenmodule.NewAppModule(appCodec, app.TokenKeeper),
-		collectionmodule.NewAppModule(appCodec, app.CollectionKeeper),
+		tokenmodule.NewAppModule(appCodec, app.TokenKeeper, app.AccountKeeper, app.BankKeeper),
+		collectionmodule.NewAppModule(appCodec, app.CollectionKeeper, app.AccountKeeper, app.BankKeeper),
 		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 	)
 
@@ -395,6 +418,7 @@ func NewSimApp(
 		capabilitytypes.ModuleName,
 		minttypes.ModuleName,
 		foundation.ModuleName,
+		group.ModuleName,
 		distrtypes.ModuleName,
 		slashingtypes.ModuleName,
 		evidencetypes.ModuleName,
@@ -429,6 +453,7 @@ func NewSimApp(
 		upgradetypes.ModuleName,
 		vestingtypes.ModuleName,
 		foundation.ModuleName,
+		group.ModuleName,
 		token.ModuleName,
 		collection.ModuleName,
 	)
@@ -447,6 +472,8 @@ func NewSimApp(
 		slashingtypes.ModuleName,
 		govtypes.ModuleName,
 		minttypes.ModuleName,
+		// the foundation outsourced to x/group refers to its group policy
+		group.ModuleName,
 		foundation.ModuleName,
 		crisistypes.ModuleName,
 		genutiltypes.ModuleName,
@@ -485,6 +512,7 @@ func NewSimApp(
 
 	// initialize stores
 	app.MountKVStores(keys)
+	app.MountTransientStores(tkeys)
 	app.MountMemoryStores(memKeys)
 
 	// initialize BaseApp
@@ -542,6 +570,15 @@ func (app *SimApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.
 	return app.mm.InitGenesis(ctx, app.appCodec, genesisState)
 }
 
+// Close closes the databases opened by the app, other than the one passed in.
+func (app *SimApp) Close() error {
+	if app.foundationArchive != nil {
+		return app.foundationArchive.Close()
+	}
+
+	return nil
+}
+
 // LoadHeight loads a particular height
 func (app *SimApp) LoadHeight(height int64) error {
 	return app.LoadVersion(height)
@@ -633,6 +670,13 @@ func (app *SimApp) GetKey(storeKey string) *sdk.KVStoreKey {
 	return app.keys[storeKey]
 }
 
+// GetTKey returns the TransientStoreKey for the provided store key.
+//
+// NOTE: This is solely to be used for testing purposes.
+func (app *SimApp) GetTKey(storeKey string) *sdk.TransientStoreKey {
+	return app.tkeys[storeKey]
+}
+
 // GetMemKey returns the MemStoreKey for the provided mem key.
 //
 // NOTE: This is solely used for testing purposes.
@@ -722,6 +766,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(govtypes.ModuleName).WithKeyTable(govtypes.ParamKeyTable())
 	paramsKeeper.Subspace(crisistypes.ModuleName)
 	paramsKeeper.Subspace(foundation.ModuleName)
+	paramsKeeper.Subspace(token.ModuleName)
 
 	return paramsKeeper
 }
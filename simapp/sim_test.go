@@ -23,6 +23,8 @@ import (
 	authzkeeper "github.com/Finschia/finschia-sdk/x/authz/keeper"
 	banktypes "github.com/Finschia/finschia-sdk/x/bank/types"
 	capabilitytypes "github.com/Finschia/finschia-sdk/x/capability/types"
+	"github.com/Finschia/finschia-sdk/x/collection"
+	collectionkeeper "github.com/Finschia/finschia-sdk/x/collection/keeper"
 	distrtypes "github.com/Finschia/finschia-sdk/x/distribution/types"
 	evidencetypes "github.com/Finschia/finschia-sdk/x/evidence/types"
 	govtypes "github.com/Finschia/finschia-sdk/x/gov/types"
@@ -31,6 +33,8 @@ import (
 	"github.com/Finschia/finschia-sdk/x/simulation"
 	slashingtypes "github.com/Finschia/finschia-sdk/x/slashing/types"
 	stakingtypes "github.com/Finschia/finschia-sdk/x/staking/types"
+	"github.com/Finschia/finschia-sdk/x/token"
+	"github.com/Finschia/finschia-sdk/x/token/class"
 )
 
 // Get flags every time the simulator is run
@@ -179,6 +183,11 @@ func TestAppImportExport(t *testing.T) {
 		{app.keys[evidencetypes.StoreKey], newApp.keys[evidencetypes.StoreKey], [][]byte{}},
 		{app.keys[capabilitytypes.StoreKey], newApp.keys[capabilitytypes.StoreKey], [][]byte{}},
 		{app.keys[authzkeeper.StoreKey], newApp.keys[authzkeeper.StoreKey], [][]byte{}},
+		{app.keys[class.StoreKey], newApp.keys[class.StoreKey], [][]byte{}},
+		{app.keys[token.StoreKey], newApp.keys[token.StoreKey], [][]byte{}},
+		{app.keys[collection.StoreKey], newApp.keys[collection.StoreKey], [][]byte{
+			collectionkeeper.LegacyTokenKeyPrefix, // not exported, and left behind on burning
+		}},
 	}
 
 	for _, skp := range storeKeysPrefixes {
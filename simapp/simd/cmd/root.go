@@ -33,6 +33,7 @@ import (
 	"github.com/Finschia/finschia-sdk/x/auth/types"
 	banktypes "github.com/Finschia/finschia-sdk/x/bank/types"
 	"github.com/Finschia/finschia-sdk/x/crisis"
+	foundationmodule "github.com/Finschia/finschia-sdk/x/foundation/module"
 	genutilcli "github.com/Finschia/finschia-sdk/x/genutil/client/cli"
 )
 
@@ -154,6 +155,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 
 func addModuleInitFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
+	foundationmodule.AddModuleInitFlags(startCmd)
 }
 
 func queryCommand() *cobra.Command {
@@ -0,0 +1,53 @@
+package authz
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+)
+
+// NewAllowList returns the allow list of the recipients from the addresses,
+// which is used by the send authorizations.
+func NewAllowList(addrs []sdk.AccAddress) []string {
+	if len(addrs) == 0 {
+		return nil
+	}
+
+	res := make([]string, len(addrs))
+	for i, addr := range addrs {
+		res[i] = addr.String()
+	}
+	return res
+}
+
+// IsAllowed returns whether the recipient is in the allow list.
+// An empty allow list allows any recipient.
+func IsAllowed(allowList []string, to string) bool {
+	if len(allowList) == 0 {
+		return true
+	}
+
+	for _, allowed := range allowList {
+		if allowed == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateAllowList checks that the allow list has valid addresses without
+// any duplicate.
+func ValidateAllowList(allowList []string) error {
+	seenAddrs := map[string]bool{}
+	for _, addr := range allowList {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid address in allow list: %s", addr)
+		}
+		if seenAddrs[addr] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate address in allow list: %s", addr)
+		}
+		seenAddrs[addr] = true
+	}
+
+	return nil
+}
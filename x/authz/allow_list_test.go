@@ -0,0 +1,31 @@
+package authz_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/authz"
+)
+
+func TestAllowList(t *testing.T) {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress("alice"),
+		sdk.AccAddress("bob"),
+	}
+	stranger := sdk.AccAddress("stranger")
+
+	require.Nil(t, authz.NewAllowList(nil))
+	require.True(t, authz.IsAllowed(nil, stranger.String()))
+
+	allowList := authz.NewAllowList(addrs)
+	require.Equal(t, []string{addrs[0].String(), addrs[1].String()}, allowList)
+	require.True(t, authz.IsAllowed(allowList, addrs[1].String()))
+	require.False(t, authz.IsAllowed(allowList, stranger.String()))
+
+	require.NoError(t, authz.ValidateAllowList(allowList))
+	require.ErrorIs(t, authz.ValidateAllowList([]string{"invalid"}), sdkerrors.ErrInvalidAddress)
+	require.ErrorIs(t, authz.ValidateAllowList(append(allowList, addrs[0].String())), sdkerrors.ErrInvalidRequest)
+}
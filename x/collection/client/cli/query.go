@@ -13,7 +13,9 @@ import (
 )
 
 const (
-	FlagTokenID = "token-id"
+	FlagTokenID    = "token-id"
+	FlagContractID = "contract-id"
+	FlagTokenType  = "token-type"
 )
 
 // NewQueryCmd returns the cli query commands for this module
@@ -44,6 +46,9 @@ func NewQueryCmd() *cobra.Command {
 		NewQueryCmdGranteeGrants(),
 		NewQueryCmdIsOperatorFor(),
 		NewQueryCmdHoldersByOperator(),
+		NewQueryCmdNFTsByOwner(),
+		NewQueryCmdRoyaltyInfo(),
+		NewQueryCmdNFTApproved(),
 	)
 
 	return queryCmd
@@ -715,3 +720,153 @@ func NewQueryCmdHoldersByOperator() *cobra.Command {
 	flags.AddPaginationFlagsToCmd(cmd, "approvers")
 	return cmd
 }
+
+func NewQueryCmdNFTsByOwner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "nfts-by-owner [owner]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "query all the non-fungible tokens owned by an address",
+		Example: fmt.Sprintf(`$ %s query %s nfts-by-owner [owner] [--contract-id [contract-id] [--token-type [token-type]]]`, version.AppName, collection.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner := args[0]
+			if _, err := sdk.AccAddressFromBech32(owner); err != nil {
+				return err
+			}
+
+			contractID, err := cmd.Flags().GetString(FlagContractID)
+			if err != nil {
+				return err
+			}
+			if len(contractID) != 0 {
+				if err := collection.ValidateContractID(contractID); err != nil {
+					return err
+				}
+			}
+
+			tokenType, err := cmd.Flags().GetString(FlagTokenType)
+			if err != nil {
+				return err
+			}
+			if len(tokenType) != 0 {
+				if len(contractID) == 0 {
+					return fmt.Errorf("--%s requires --%s", FlagTokenType, FlagContractID)
+				}
+				if err := collection.ValidateLegacyNFTClassID(tokenType); err != nil {
+					return err
+				}
+			}
+
+			queryClient := collection.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &collection.QueryNFTsByOwnerRequest{
+				Owner:      owner,
+				ContractId: contractID,
+				TokenType:  tokenType,
+				Pagination: pageReq,
+			}
+			res, err := queryClient.NFTsByOwner(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagContractID, "", "Contract ID to narrow down the results")
+	cmd.Flags().String(FlagTokenType, "", "Token type to narrow down the results (requires --contract-id)")
+	flags.AddPaginationFlagsToCmd(cmd, "nfts by owner")
+	return cmd
+}
+
+func NewQueryCmdRoyaltyInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "royalty-info [contract-id] [token-id] [sale-price]",
+		Args:    cobra.ExactArgs(3),
+		Short:   "query the royalty of a non-fungible token for a given sale price",
+		Example: fmt.Sprintf(`$ %s query %s royalty-info [contract-id] [token-id] [sale-price]`, version.AppName, collection.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractID := args[0]
+			if err := collection.ValidateContractID(contractID); err != nil {
+				return err
+			}
+
+			tokenID := args[1]
+			if err := collection.ValidateNFTID(tokenID); err != nil {
+				return err
+			}
+
+			salePrice, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("failed to set sale price: %s", args[2])
+			}
+
+			queryClient := collection.NewQueryClient(clientCtx)
+			req := &collection.QueryRoyaltyInfoRequest{
+				ContractId: contractID,
+				TokenId:    tokenID,
+				SalePrice:  salePrice,
+			}
+			res, err := queryClient.RoyaltyInfo(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewQueryCmdNFTApproved() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "nft-approved [contract-id] [token-id]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "query the address approved on a non-fungible token",
+		Example: fmt.Sprintf(`$ %s query %s nft-approved [contract-id] [token-id]`, version.AppName, collection.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractID := args[0]
+			if err := collection.ValidateContractID(contractID); err != nil {
+				return err
+			}
+
+			tokenID := args[1]
+			if err := collection.ValidateNFTID(tokenID); err != nil {
+				return err
+			}
+
+			queryClient := collection.NewQueryClient(clientCtx)
+			req := &collection.QueryApprovedRequest{
+				ContractId: contractID,
+				TokenId:    tokenID,
+			}
+			res, err := queryClient.Approved(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -29,6 +29,9 @@ const (
 	FlagTo       = "to"
 	FlagSupply   = "supply"
 
+	// flag for non-fungible token classes
+	FlagRoyalty = "royalty"
+
 	DefaultDecimals = 8
 	DefaultSupply   = "0"
 )
@@ -61,6 +64,8 @@ func NewTxCmd() *cobra.Command {
 		NewTxCmdRevokePermission(),
 		NewTxCmdAuthorizeOperator(),
 		NewTxCmdRevokeOperator(),
+		NewTxCmdApproveNFT(),
+		NewTxCmdRevokeNFTApproval(),
 		NewTxCmdModify(),
 	)
 
@@ -392,11 +397,21 @@ func NewTxCmdIssueNFT() *cobra.Command {
 				return err
 			}
 
+			royaltyStr, err := cmd.Flags().GetString(FlagRoyalty)
+			if err != nil {
+				return err
+			}
+			royalty, err := collection.RoyaltyFromAttributeValue(royaltyStr)
+			if err != nil {
+				return err
+			}
+
 			msg := collection.MsgIssueNFT{
 				ContractId: args[0],
 				Owner:      operator,
 				Name:       name,
 				Meta:       meta,
+				Royalty:    royalty,
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -408,6 +423,7 @@ func NewTxCmdIssueNFT() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().String(FlagName, "", "set name")
 	cmd.Flags().String(FlagMeta, "", "set meta")
+	cmd.Flags().String(FlagRoyalty, "", "set royalty in the form of <recipient>:<basis_points>")
 
 	return cmd
 }
@@ -956,6 +972,77 @@ func NewTxCmdAuthorizeOperator() *cobra.Command {
 	return cmd
 }
 
+func NewTxCmdApproveNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-nft [contract-id] [owner] [approved] [token-id]",
+		Args:  cobra.ExactArgs(4),
+		Short: "approve an address to send a non-fungible token of owner",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s approve-nft [contract-id] [owner] [approved] [token-id]`, version.AppName, collection.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			owner := args[1]
+			if err := cmd.Flags().Set(flags.FlagFrom, owner); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := collection.MsgApproveNFT{
+				ContractId: args[0],
+				Owner:      owner,
+				Approved:   args[2],
+				TokenId:    args[3],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdRevokeNFTApproval() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-nft-approval [contract-id] [owner] [token-id]",
+		Args:  cobra.ExactArgs(3),
+		Short: "revoke the approval on a non-fungible token of owner",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s revoke-nft-approval [contract-id] [owner] [token-id]`, version.AppName, collection.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			owner := args[1]
+			if err := cmd.Flags().Set(flags.FlagFrom, owner); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := collection.MsgRevokeNFTApproval{
+				ContractId: args[0],
+				Owner:      owner,
+				TokenId:    args[2],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func NewTxCmdRevokeOperator() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "revoke-operator [contract-id] [holder] [operator]",
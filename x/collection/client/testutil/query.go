@@ -1159,3 +1159,238 @@ func (s *IntegrationTestSuite) TestNewQueryCmdHoldersByOperator() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestNewQueryCmdNFTsByOwner() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, s.setupHeight),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	tokens := make([]collection.ContractNFT, 3)
+	for i := range tokens {
+		tokens[i] = collection.ContractNFT{
+			ContractId: s.contractID,
+			Token: collection.NFT{
+				TokenId: collection.NewNFTID(s.nftClassID, i*s.lenChain+1),
+				Name:    "arctic fox",
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		args     []string
+		valid    bool
+		expected proto.Message
+	}{
+		"valid query": {
+			[]string{
+				s.customer.String(),
+			},
+			true,
+			&collection.QueryNFTsByOwnerResponse{
+				Tokens:     tokens,
+				Pagination: &query.PageResponse{},
+			},
+		},
+		"valid query with token type": {
+			[]string{
+				s.customer.String(),
+				fmt.Sprintf("--%s=%s", cli.FlagContractID, s.contractID),
+				fmt.Sprintf("--%s=%s", cli.FlagTokenType, s.nftClassID),
+			},
+			true,
+			&collection.QueryNFTsByOwnerResponse{
+				Tokens:     tokens,
+				Pagination: &query.PageResponse{},
+			},
+		},
+		"extra args": {
+			[]string{
+				s.customer.String(),
+				"extra",
+			},
+			false,
+			nil,
+		},
+		"not enough args": {
+			[]string{},
+			false,
+			nil,
+		},
+		"token type without contract id": {
+			[]string{
+				s.customer.String(),
+				fmt.Sprintf("--%s=%s", cli.FlagTokenType, s.nftClassID),
+			},
+			false,
+			nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewQueryCmdNFTsByOwner()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var actual collection.QueryNFTsByOwnerResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &actual), out.String())
+			s.Require().Equal(tc.expected, &actual)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewQueryCmdRoyaltyInfo() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, s.setupHeight),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	tokenID := collection.NewNFTID(s.nftClassID, 1)
+	testCases := map[string]struct {
+		args     []string
+		valid    bool
+		expected proto.Message
+	}{
+		"valid query": {
+			[]string{
+				s.contractID,
+				tokenID,
+				"10000",
+			},
+			true,
+			&collection.QueryRoyaltyInfoResponse{
+				RoyaltyAmount: sdk.ZeroInt(),
+			},
+		},
+		"extra args": {
+			[]string{
+				s.contractID,
+				tokenID,
+				"10000",
+				"extra",
+			},
+			false,
+			nil,
+		},
+		"not enough args": {
+			[]string{
+				s.contractID,
+				tokenID,
+			},
+			false,
+			nil,
+		},
+		"invalid sale price": {
+			[]string{
+				s.contractID,
+				tokenID,
+				"price",
+			},
+			false,
+			nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewQueryCmdRoyaltyInfo()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var actual collection.QueryRoyaltyInfoResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &actual), out.String())
+			s.Require().Equal(tc.expected, &actual)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewQueryCmdNFTApproved() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, s.setupHeight),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	tokenID := collection.NewNFTID(s.nftClassID, s.lenChain*(3*3+2)+1)
+	testCases := map[string]struct {
+		args     []string
+		valid    bool
+		expected proto.Message
+	}{
+		"valid query": {
+			[]string{
+				s.contractID,
+				tokenID,
+			},
+			true,
+			&collection.QueryApprovedResponse{
+				Approved: s.customer.String(),
+			},
+		},
+		"no approval": {
+			[]string{
+				s.contractID,
+				collection.NewNFTID(s.nftClassID, 1),
+			},
+			true,
+			&collection.QueryApprovedResponse{},
+		},
+		"extra args": {
+			[]string{
+				s.contractID,
+				tokenID,
+				"extra",
+			},
+			false,
+			nil,
+		},
+		"not enough args": {
+			[]string{
+				s.contractID,
+			},
+			false,
+			nil,
+		},
+		"invalid token id": {
+			[]string{
+				s.contractID,
+				collection.NewFTID(s.ftClassID),
+			},
+			false,
+			nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewQueryCmdNFTApproved()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var actual collection.QueryApprovedResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &actual), out.String())
+			s.Require().Equal(tc.expected, &actual)
+		})
+	}
+}
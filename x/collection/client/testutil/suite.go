@@ -129,6 +129,9 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	// for the revocation.
 	s.authorizeOperator(s.contractID, s.operator, s.vendor)
 
+	// stranger approves customer to send one of its nfts, for the revocation.
+	s.approveNFT(s.contractID, s.stranger, s.customer, collection.NewNFTID(s.nftClassID, s.lenChain*(3*3+2)+1))
+
 	s.setupHeight, err = s.network.LatestHeight()
 	s.Require().NoError(err)
 	s.Require().NoError(s.network.WaitForNextBlock())
@@ -347,6 +350,23 @@ func (s *IntegrationTestSuite) authorizeOperator(contractID string, holder, oper
 	s.Require().EqualValues(0, res.Code, out.String())
 }
 
+func (s *IntegrationTestSuite) approveNFT(contractID string, owner, approved sdk.AccAddress, tokenID string) {
+	val := s.network.Validators[0]
+	args := append([]string{
+		contractID,
+		owner.String(),
+		approved.String(),
+		tokenID,
+	}, commonArgs...)
+
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.NewTxCmdApproveNFT(), args)
+	s.Require().NoError(err)
+
+	var res sdk.TxResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+	s.Require().EqualValues(0, res.Code, out.String())
+}
+
 func (s *IntegrationTestSuite) TearDownSuite() {
 	s.T().Log("tearing down integration test suite")
 	s.network.Cleanup()
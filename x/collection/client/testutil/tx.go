@@ -478,6 +478,14 @@ func (s *IntegrationTestSuite) TestNewTxCmdIssueNFT() {
 			},
 			true,
 		},
+		"valid transaction with royalty": {
+			[]string{
+				s.contractID,
+				s.operator.String(),
+				fmt.Sprintf("--%s=%s:250", cli.FlagRoyalty, s.vendor),
+			},
+			true,
+		},
 		"extra args": {
 			[]string{
 				s.contractID,
@@ -499,6 +507,14 @@ func (s *IntegrationTestSuite) TestNewTxCmdIssueNFT() {
 			},
 			false,
 		},
+		"invalid royalty": {
+			[]string{
+				s.contractID,
+				s.operator.String(),
+				fmt.Sprintf("--%s=%s", cli.FlagRoyalty, s.vendor),
+			},
+			false,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -1524,3 +1540,139 @@ func (s *IntegrationTestSuite) TestNewTxCmdRevokeOperator() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestNewTxCmdApproveNFT() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	tokenID := collection.NewNFTID(s.nftClassID, s.lenChain*(3*3+2)+1)
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				s.contractID,
+				s.stranger.String(),
+				s.operator.String(),
+				tokenID,
+			},
+			true,
+		},
+		"extra args": {
+			[]string{
+				s.contractID,
+				s.stranger.String(),
+				s.operator.String(),
+				tokenID,
+				"extra",
+			},
+			false,
+		},
+		"not enough args": {
+			[]string{
+				s.contractID,
+				s.stranger.String(),
+				s.operator.String(),
+			},
+			false,
+		},
+		"invalid token id": {
+			[]string{
+				s.contractID,
+				s.stranger.String(),
+				s.operator.String(),
+				"",
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdApproveNFT()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res sdk.TxResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+			s.Require().EqualValues(0, res.Code, out.String())
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewTxCmdRevokeNFTApproval() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	tokenID := collection.NewNFTID(s.nftClassID, s.lenChain*(3*3+2)+1)
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				s.contractID,
+				s.stranger.String(),
+				tokenID,
+			},
+			true,
+		},
+		"extra args": {
+			[]string{
+				s.contractID,
+				s.stranger.String(),
+				tokenID,
+				"extra",
+			},
+			false,
+		},
+		"not enough args": {
+			[]string{
+				s.contractID,
+				s.stranger.String(),
+			},
+			false,
+		},
+		"invalid token id": {
+			[]string{
+				s.contractID,
+				s.stranger.String(),
+				"",
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdRevokeNFTApproval()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res sdk.TxResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+			s.Require().EqualValues(0, res.Code, out.String())
+		})
+	}
+}
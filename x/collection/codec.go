@@ -7,6 +7,7 @@ import (
 	cryptocodec "github.com/Finschia/finschia-sdk/crypto/codec"
 	sdk "github.com/Finschia/finschia-sdk/types"
 	"github.com/Finschia/finschia-sdk/types/msgservice"
+	"github.com/Finschia/finschia-sdk/x/authz"
 	authzcodec "github.com/Finschia/finschia-sdk/x/authz/codec"
 	fdncodec "github.com/Finschia/finschia-sdk/x/foundation/codec"
 	govcodec "github.com/Finschia/finschia-sdk/x/gov/codec"
@@ -18,6 +19,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgOperatorSendFT{}, "lbm-sdk/MsgOperatorSendFT")
 	legacy.RegisterAminoMsg(cdc, &MsgSendNFT{}, "lbm-sdk/MsgSendNFT")
 	legacy.RegisterAminoMsg(cdc, &MsgOperatorSendNFT{}, "lbm-sdk/MsgOperatorSendNFT")
+	legacy.RegisterAminoMsg(cdc, &MsgApproveNFT{}, "lbm-sdk/MsgApproveNFT")
+	legacy.RegisterAminoMsg(cdc, &MsgRevokeNFTApproval{}, "lbm-sdk/MsgRevokeNFTApproval")
 	legacy.RegisterAminoMsg(cdc, &MsgAuthorizeOperator{}, "lbm-sdk/collection/MsgAuthorizeOperator") // Changed msgName due to conflict with `x/token`
 	legacy.RegisterAminoMsg(cdc, &MsgRevokeOperator{}, "lbm-sdk/collection/MsgRevokeOperator")       // Changed msgName due to conflict with `x/token`
 	legacy.RegisterAminoMsg(cdc, &MsgCreateContract{}, "lbm-sdk/MsgCreateContract")
@@ -36,6 +39,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgDetach{}, "lbm-sdk/MsgDetach")
 	legacy.RegisterAminoMsg(cdc, &MsgOperatorAttach{}, "lbm-sdk/MsgOperatorAttach")
 	legacy.RegisterAminoMsg(cdc, &MsgOperatorDetach{}, "lbm-sdk/MsgOperatorDetach")
+
+	cdc.RegisterConcrete(&FTSendAuthorization{}, "lbm-sdk/collection/FTSendAuthorization", nil)
+	cdc.RegisterConcrete(&NFTSendAuthorization{}, "lbm-sdk/collection/NFTSendAuthorization", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -51,6 +57,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgOperatorSendFT{},
 		&MsgSendNFT{},
 		&MsgOperatorSendNFT{},
+		&MsgApproveNFT{},
+		&MsgRevokeNFTApproval{},
 		&MsgAuthorizeOperator{},
 		&MsgRevokeOperator{},
 		&MsgBurnFT{},
@@ -64,6 +72,12 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgOperatorDetach{},
 	)
 
+	registry.RegisterImplementations(
+		(*authz.Authorization)(nil),
+		&FTSendAuthorization{},
+		&NFTSendAuthorization{},
+	)
+
 	registry.RegisterInterface(
 		"lbm.collection.v1.TokenClass",
 		(*TokenClass)(nil),
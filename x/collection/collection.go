@@ -2,13 +2,16 @@ package collection
 
 import (
 	"fmt"
+	"math/big"
 	"regexp"
+	"strconv"
 	"strings"
 
 	proto "github.com/gogo/protobuf/proto"
 
 	codectypes "github.com/Finschia/finschia-sdk/codec/types"
 	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 )
 
 const (
@@ -140,10 +143,79 @@ func (c NFTClass) ValidateBasic() error {
 	if err := validateMeta(c.Meta); err != nil {
 		return err
 	}
+	if c.Royalty != nil {
+		if err := c.Royalty.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Royalty
+
+// MaxRoyaltyBasisPoints is the basis points of 100%.
+const MaxRoyaltyBasisPoints = 10000
+
+func (r Royalty) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(r.Recipient); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", r.Recipient)
+	}
+
+	if r.BasisPoints == 0 || r.BasisPoints > MaxRoyaltyBasisPoints {
+		return ErrInvalidRoyalty.Wrapf("basis points must be within (0, %d]: %d", MaxRoyaltyBasisPoints, r.BasisPoints)
+	}
 
 	return nil
 }
 
+// Amount returns the royalty of a given sale price, rounded down.
+// The product is computed on big.Int, as it may exceed the range of sdk.Int
+// while the royalty never exceeds the sale price.
+func (r Royalty) Amount(salePrice sdk.Int) sdk.Int {
+	amount := new(big.Int).Mul(salePrice.BigInt(), big.NewInt(int64(r.BasisPoints)))
+	amount.Quo(amount, big.NewInt(MaxRoyaltyBasisPoints))
+	return sdk.NewIntFromBigInt(amount)
+}
+
+// RoyaltyToAttributeValue returns the value of the royalty attribute used in MsgModify.
+// It returns an empty string on nil, which removes the royalty.
+func RoyaltyToAttributeValue(royalty *Royalty) string {
+	if royalty == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s:%d", royalty.Recipient, royalty.BasisPoints)
+}
+
+// RoyaltyFromAttributeValue parses the value of the royalty attribute used in MsgModify.
+// It returns nil on an empty string.
+func RoyaltyFromAttributeValue(value string) (*Royalty, error) {
+	if len(value) == 0 {
+		return nil, nil
+	}
+
+	recipient, bp, ok := strings.Cut(value, ":")
+	if !ok {
+		return nil, ErrInvalidRoyalty.Wrapf("invalid format: %s", value)
+	}
+
+	basisPoints, err := strconv.ParseUint(bp, 10, 32)
+	if err != nil {
+		return nil, ErrInvalidRoyalty.Wrap(err.Error())
+	}
+
+	royalty := &Royalty{
+		Recipient:   recipient,
+		BasisPoints: uint32(basisPoints),
+	}
+	if err := royalty.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return royalty, nil
+}
+
 // ----------------------------------------------------------------------------
 // Coin
 func NewFTCoin(classID string, amount sdk.Int) Coin {
@@ -286,6 +286,10 @@ type NFTClass struct {
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	// meta is a brief description of the token class.
 	Meta string `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`
+	// royalty defines the royalty of the tokens of the class (optional).
+	//
+	// Since: 0.49.0 (finschia)
+	Royalty *Royalty `protobuf:"bytes,4,opt,name=royalty,proto3" json:"royalty,omitempty"`
 }
 
 func (m *NFTClass) Reset()         { *m = NFTClass{} }
@@ -342,6 +346,13 @@ func (m *NFTClass) GetMeta() string {
 	return ""
 }
 
+func (m *NFTClass) GetRoyalty() *Royalty {
+	if m != nil {
+		return m.Royalty
+	}
+	return nil
+}
+
 // NFT defines the information of non-fungible token.
 //
 // Since: 0.46.0 (finschia)
@@ -352,6 +363,10 @@ type NFT struct {
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	// meta is a brief description of the token.
 	Meta string `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`
+	// royalty overrides the royalty of its class (optional).
+	//
+	// Since: 0.49.0 (finschia)
+	Royalty *Royalty `protobuf:"bytes,4,opt,name=royalty,proto3" json:"royalty,omitempty"`
 }
 
 func (m *NFT) Reset()         { *m = NFT{} }
@@ -387,6 +402,92 @@ func (m *NFT) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NFT proto.InternalMessageInfo
 
+// Royalty defines the royalty of non-fungible tokens.
+//
+// Since: 0.49.0 (finschia)
+type Royalty struct {
+	// address which receives the royalty.
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// royalty rate in basis points (1/10000).
+	BasisPoints uint32 `protobuf:"varint,2,opt,name=basis_points,json=basisPoints,proto3" json:"basis_points,omitempty"`
+}
+
+func (m *Royalty) Reset()         { *m = Royalty{} }
+func (m *Royalty) String() string { return proto.CompactTextString(m) }
+func (*Royalty) ProtoMessage()    {}
+func (*Royalty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb15fea9f4c37044, []int{5}
+}
+func (m *Royalty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Royalty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Royalty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Royalty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Royalty.Merge(m, src)
+}
+func (m *Royalty) XXX_Size() int {
+	return m.Size()
+}
+func (m *Royalty) XXX_DiscardUnknown() {
+	xxx_messageInfo_Royalty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Royalty proto.InternalMessageInfo
+
+// ContractNFT defines a non-fungible token with its contract id.
+//
+// Since: 0.49.0 (finschia)
+type ContractNFT struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// token defines the non-fungible token.
+	Token NFT `protobuf:"bytes,2,opt,name=token,proto3" json:"token"`
+}
+
+func (m *ContractNFT) Reset()         { *m = ContractNFT{} }
+func (m *ContractNFT) String() string { return proto.CompactTextString(m) }
+func (*ContractNFT) ProtoMessage()    {}
+func (*ContractNFT) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb15fea9f4c37044, []int{6}
+}
+func (m *ContractNFT) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractNFT.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractNFT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractNFT.Merge(m, src)
+}
+func (m *ContractNFT) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractNFT) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractNFT.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractNFT proto.InternalMessageInfo
+
 // Deprecated: use NFT
 //
 // OwnerNFT defines the information of non-fungible token.
@@ -407,7 +508,7 @@ func (m *OwnerNFT) Reset()         { *m = OwnerNFT{} }
 func (m *OwnerNFT) String() string { return proto.CompactTextString(m) }
 func (*OwnerNFT) ProtoMessage()    {}
 func (*OwnerNFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb15fea9f4c37044, []int{5}
+	return fileDescriptor_bb15fea9f4c37044, []int{7}
 }
 func (m *OwnerNFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -458,7 +559,7 @@ func (m *FT) Reset()         { *m = FT{} }
 func (m *FT) String() string { return proto.CompactTextString(m) }
 func (*FT) ProtoMessage()    {}
 func (*FT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb15fea9f4c37044, []int{6}
+	return fileDescriptor_bb15fea9f4c37044, []int{8}
 }
 func (m *FT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -509,7 +610,7 @@ func (m *TokenType) Reset()         { *m = TokenType{} }
 func (m *TokenType) String() string { return proto.CompactTextString(m) }
 func (*TokenType) ProtoMessage()    {}
 func (*TokenType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb15fea9f4c37044, []int{7}
+	return fileDescriptor_bb15fea9f4c37044, []int{9}
 }
 func (m *TokenType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -549,7 +650,7 @@ type Coin struct {
 func (m *Coin) Reset()      { *m = Coin{} }
 func (*Coin) ProtoMessage() {}
 func (*Coin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb15fea9f4c37044, []int{8}
+	return fileDescriptor_bb15fea9f4c37044, []int{10}
 }
 func (m *Coin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -592,7 +693,7 @@ func (m *Grant) Reset()         { *m = Grant{} }
 func (m *Grant) String() string { return proto.CompactTextString(m) }
 func (*Grant) ProtoMessage()    {}
 func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb15fea9f4c37044, []int{9}
+	return fileDescriptor_bb15fea9f4c37044, []int{11}
 }
 func (m *Grant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -635,7 +736,7 @@ func (m *Authorization) Reset()         { *m = Authorization{} }
 func (m *Authorization) String() string { return proto.CompactTextString(m) }
 func (*Authorization) ProtoMessage()    {}
 func (*Authorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb15fea9f4c37044, []int{10}
+	return fileDescriptor_bb15fea9f4c37044, []int{12}
 }
 func (m *Authorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -676,7 +777,7 @@ func (m *Attribute) Reset()         { *m = Attribute{} }
 func (m *Attribute) String() string { return proto.CompactTextString(m) }
 func (*Attribute) ProtoMessage()    {}
 func (*Attribute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb15fea9f4c37044, []int{11}
+	return fileDescriptor_bb15fea9f4c37044, []int{13}
 }
 func (m *Attribute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -713,6 +814,8 @@ func init() {
 	proto.RegisterType((*FTClass)(nil), "lbm.collection.v1.FTClass")
 	proto.RegisterType((*NFTClass)(nil), "lbm.collection.v1.NFTClass")
 	proto.RegisterType((*NFT)(nil), "lbm.collection.v1.NFT")
+	proto.RegisterType((*Royalty)(nil), "lbm.collection.v1.Royalty")
+	proto.RegisterType((*ContractNFT)(nil), "lbm.collection.v1.ContractNFT")
 	proto.RegisterType((*OwnerNFT)(nil), "lbm.collection.v1.OwnerNFT")
 	proto.RegisterType((*FT)(nil), "lbm.collection.v1.FT")
 	proto.RegisterType((*TokenType)(nil), "lbm.collection.v1.TokenType")
@@ -727,63 +830,68 @@ func init() {
 }
 
 var fileDescriptor_bb15fea9f4c37044 = []byte{
-	// 886 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x4f, 0x6f, 0xe3, 0xd4,
-	0x17, 0xb5, 0xf3, 0xaf, 0xc9, 0xad, 0xa6, 0x93, 0xf1, 0x2f, 0xbf, 0xe2, 0x06, 0xd5, 0x89, 0xcc,
-	0x82, 0xa1, 0xa8, 0x89, 0x66, 0x06, 0x10, 0xaa, 0xc4, 0xa2, 0xc9, 0x24, 0x83, 0x51, 0x9b, 0x56,
-	0x4e, 0x8a, 0x34, 0x6c, 0x82, 0x63, 0xbf, 0x26, 0x4f, 0xb5, 0xfd, 0x22, 0xfb, 0xa5, 0x25, 0x7c,
-	0x82, 0x51, 0x36, 0xb0, 0x64, 0x13, 0xa9, 0x12, 0x2c, 0x66, 0xc3, 0x6e, 0xd6, 0xac, 0xbb, 0x1c,
-	0xcd, 0x0a, 0xb1, 0x18, 0x41, 0xbb, 0x61, 0xcf, 0x17, 0x40, 0xef, 0xd9, 0x71, 0x4c, 0x12, 0x66,
-	0x46, 0x20, 0xb1, 0xbb, 0xf7, 0xbe, 0x73, 0xce, 0xbd, 0x3e, 0xd7, 0xf6, 0x03, 0xd5, 0xee, 0x39,
-	0x55, 0x93, 0xd8, 0x36, 0x32, 0x29, 0x26, 0x6e, 0xf5, 0xfc, 0x5e, 0x2c, 0xab, 0x0c, 0x3d, 0x42,
-	0x89, 0x74, 0xc7, 0xee, 0x39, 0x95, 0x58, 0xf5, 0xfc, 0x5e, 0xb1, 0xd0, 0x27, 0x7d, 0xc2, 0x4f,
-	0xab, 0x2c, 0x0a, 0x80, 0xc5, 0x2d, 0x93, 0xf8, 0x0e, 0xf1, 0xbb, 0xc1, 0x41, 0x90, 0x04, 0x47,
-	0xaa, 0x0e, 0x99, 0x63, 0xc3, 0x33, 0x1c, 0x5f, 0x7a, 0x07, 0xd6, 0x2d, 0x34, 0xa4, 0x83, 0xae,
-	0x8d, 0x1d, 0x4c, 0x65, 0xb1, 0x2c, 0xde, 0xbd, 0x55, 0x4b, 0xc8, 0xa2, 0x0e, 0xbc, 0x7c, 0xc0,
-	0xaa, 0x0c, 0x74, 0x81, 0xad, 0x08, 0x94, 0x98, 0x83, 0x78, 0x99, 0x83, 0xd4, 0x0e, 0x64, 0xeb,
-	0xc4, 0xa5, 0x9e, 0x61, 0x52, 0x69, 0x03, 0x12, 0xd8, 0xe2, 0x62, 0x39, 0x3d, 0x81, 0x2d, 0x49,
-	0x82, 0x94, 0x6b, 0x38, 0x88, 0x33, 0x73, 0x3a, 0x8f, 0x59, 0xcd, 0x41, 0xd4, 0x90, 0x93, 0x41,
-	0x8d, 0xc5, 0x52, 0x1e, 0x92, 0x23, 0x0f, 0xcb, 0x29, 0x5e, 0x62, 0xa1, 0xfa, 0x8d, 0x08, 0x6b,
-	0xcd, 0x4e, 0xdd, 0x36, 0x7c, 0xff, 0x1f, 0xab, 0x16, 0x21, 0x6b, 0x21, 0x13, 0x3b, 0x86, 0xed,
-	0x73, 0xe9, 0xb4, 0x1e, 0xe5, 0xec, 0xcc, 0xc1, 0x2e, 0x35, 0x7a, 0x36, 0x92, 0xd3, 0x65, 0xf1,
-	0x6e, 0x56, 0x8f, 0xf2, 0xbd, 0xc2, 0x93, 0xcb, 0x92, 0xf8, 0xe2, 0xd9, 0x2e, 0x74, 0xc8, 0x19,
-	0x72, 0xf9, 0x0c, 0xb2, 0xa8, 0x7e, 0x0e, 0xd9, 0xd6, 0xbf, 0x9c, 0x68, 0x4f, 0x5a, 0x56, 0x56,
-	0x3f, 0x85, 0x64, 0xab, 0xd9, 0x91, 0xb6, 0x20, 0x4b, 0x59, 0xb1, 0x1b, 0x09, 0xaf, 0xf1, 0x5c,
-	0x7b, 0x63, 0x75, 0xe6, 0x59, 0xf6, 0xe8, 0xc2, 0x45, 0x1e, 0xd3, 0x2b, 0xc1, 0xba, 0x19, 0xae,
-	0x65, 0x2e, 0x09, 0xb3, 0x92, 0x66, 0xfd, 0xa5, 0x61, 0x62, 0x75, 0xc3, 0xe4, 0x8a, 0x86, 0xa9,
-	0x98, 0xc1, 0x05, 0x48, 0x13, 0xd6, 0x8f, 0x3b, 0x98, 0xd3, 0x83, 0x64, 0x2f, 0xf7, 0xe2, 0xd9,
-	0x6e, 0x9a, 0x3f, 0xa0, 0xfa, 0xa3, 0x08, 0x89, 0xff, 0x68, 0x96, 0xf8, 0xb2, 0xd3, 0xaf, 0x58,
-	0x76, 0x66, 0x61, 0xd9, 0xeb, 0xd1, 0xb4, 0xb2, 0xa8, 0xfa, 0x90, 0xe3, 0x61, 0x67, 0x3c, 0x44,
-	0xaf, 0x9f, 0x7a, 0x1b, 0x20, 0x98, 0x9a, 0x8e, 0x87, 0xb3, 0xed, 0xe4, 0x68, 0xc4, 0x7f, 0xc3,
-	0xc9, 0xd5, 0x0b, 0x48, 0xd5, 0x09, 0x76, 0x5f, 0xf5, 0x06, 0x7c, 0x06, 0x19, 0xc3, 0x21, 0x23,
-	0x37, 0xf8, 0x06, 0x73, 0xb5, 0xfb, 0x57, 0x2f, 0x4b, 0xc2, 0x2f, 0x2f, 0x4b, 0x3b, 0x7d, 0x4c,
-	0x07, 0xa3, 0x5e, 0xc5, 0x24, 0x4e, 0xb5, 0x89, 0x5d, 0xdf, 0x1c, 0x60, 0xa3, 0x7a, 0x1a, 0x06,
-	0xbb, 0xbe, 0x75, 0x56, 0x65, 0xa3, 0xf9, 0x15, 0xcd, 0xa5, 0x7a, 0xa8, 0xb0, 0x97, 0xfd, 0xee,
-	0xb2, 0x24, 0xfc, 0x7e, 0x59, 0x12, 0xd5, 0x2f, 0x21, 0xfd, 0xc8, 0x33, 0x5c, 0x2a, 0xc9, 0xb0,
-	0xd6, 0x67, 0x01, 0x42, 0xb3, 0xc6, 0x61, 0x2a, 0x7d, 0x02, 0x30, 0x44, 0x9e, 0x83, 0x7d, 0x1f,
-	0x13, 0x97, 0x37, 0xdf, 0xb8, 0xbf, 0x5d, 0x59, 0xfa, 0x13, 0x55, 0x8e, 0x23, 0x90, 0x1e, 0x23,
-	0xa8, 0x75, 0xb8, 0xb5, 0x3f, 0xa2, 0x03, 0xe2, 0xe1, 0xaf, 0x0d, 0x06, 0x95, 0x36, 0x21, 0x33,
-	0x20, 0xb6, 0x85, 0xbc, 0xb0, 0x51, 0x98, 0xb1, 0x0d, 0x91, 0x21, 0xf2, 0x0c, 0x4a, 0xbc, 0xd0,
-	0xc8, 0x28, 0x57, 0x1f, 0x40, 0x6e, 0x9f, 0x52, 0x0f, 0xf7, 0x46, 0x14, 0xb1, 0x3f, 0xc5, 0x19,
-	0x1a, 0x87, 0x6c, 0x16, 0xb2, 0x97, 0xf0, 0xdc, 0xb0, 0x47, 0xb3, 0x05, 0x04, 0xc9, 0xce, 0x1f,
-	0x22, 0xc0, 0x7c, 0x28, 0xe9, 0x43, 0xd8, 0x3c, 0x6e, 0xe8, 0x87, 0x5a, 0xbb, 0xad, 0x1d, 0xb5,
-	0xba, 0x27, 0xad, 0xf6, 0x71, 0xa3, 0xae, 0x35, 0xb5, 0xc6, 0xc3, 0xbc, 0x50, 0xdc, 0x9a, 0x4c,
-	0xcb, 0xff, 0x9f, 0x63, 0x4f, 0x5c, 0x7f, 0x88, 0x4c, 0x7c, 0x8a, 0x91, 0x25, 0xbd, 0x07, 0xf9,
-	0x18, 0x4d, 0x6b, 0xb7, 0x4f, 0x1a, 0x79, 0xb1, 0xf8, 0xbf, 0xc9, 0xb4, 0x7c, 0x7b, 0x4e, 0xd0,
-	0x7c, 0x7f, 0x84, 0xa4, 0xf7, 0xe1, 0x4e, 0x0c, 0x7a, 0x78, 0xf4, 0x50, 0x6b, 0x3e, 0xce, 0x27,
-	0x8a, 0x85, 0xc9, 0xb4, 0x9c, 0x9f, 0x63, 0x0f, 0x89, 0x85, 0x4f, 0xc7, 0xd2, 0xbb, 0x70, 0x3b,
-	0x0e, 0xd6, 0x5a, 0x9d, 0x7c, 0xb2, 0x28, 0x4d, 0xa6, 0xe5, 0x8d, 0x18, 0x14, 0xbb, 0x74, 0x01,
-	0x58, 0x3b, 0xd1, 0x5b, 0xf9, 0xd4, 0x22, 0xb0, 0x36, 0xf2, 0xdc, 0x62, 0xea, 0xc9, 0xf7, 0x8a,
-	0xb0, 0xf3, 0x53, 0x02, 0xf2, 0x07, 0xa8, 0x6f, 0x98, 0xe3, 0xd8, 0xb3, 0xd7, 0x60, 0xfb, 0xa0,
-	0xf1, 0x68, 0xbf, 0xfe, 0xb8, 0xfb, 0xb7, 0x16, 0x94, 0x26, 0xd3, 0xf2, 0xdb, 0x8b, 0xc4, 0xb8,
-	0x11, 0x1f, 0xc1, 0x5b, 0xcb, 0x1a, 0x33, 0x3f, 0xb8, 0x81, 0x8b, 0xec, 0xc0, 0x95, 0x8f, 0x41,
-	0x5e, 0xe6, 0x45, 0xe6, 0x14, 0x27, 0xd3, 0xf2, 0xe6, 0x22, 0x31, 0xb4, 0xe8, 0x03, 0xd8, 0x5c,
-	0xc1, 0x0c, 0x9c, 0x92, 0x27, 0xd3, 0x72, 0x61, 0x89, 0xc7, 0xfc, 0x5a, 0xc9, 0x0a, 0x6d, 0x5b,
-	0xc9, 0xe2, 0xe6, 0x65, 0x99, 0x79, 0x4f, 0x7f, 0x50, 0x84, 0xda, 0xd1, 0xd5, 0x6f, 0x8a, 0xf0,
-	0xf4, 0x5a, 0x11, 0xae, 0xae, 0x15, 0xf1, 0xf9, 0xb5, 0x22, 0xfe, 0x7a, 0xad, 0x88, 0xdf, 0xde,
-	0x28, 0xc2, 0xf3, 0x1b, 0x45, 0xf8, 0xf9, 0x46, 0x11, 0xbe, 0xd8, 0x7d, 0xed, 0x27, 0xf7, 0x55,
-	0xec, 0xee, 0xee, 0x65, 0xf8, 0xc5, 0xfb, 0xe0, 0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x96, 0x09,
-	0xbe, 0x0d, 0xe2, 0x07, 0x00, 0x00,
+	// 973 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0xb6, 0xd3, 0xa4, 0x4d, 0x5e, 0xd8, 0x6e, 0xd6, 0x94, 0xe2, 0x1a, 0xea, 0x04, 0x73, 0x60,
+	0x29, 0x6a, 0xa2, 0xed, 0x2e, 0x08, 0x55, 0xe2, 0xd0, 0x64, 0x9b, 0x95, 0x57, 0x6d, 0x1a, 0x39,
+	0xe9, 0x61, 0xb9, 0x04, 0xc7, 0x9e, 0x26, 0xa3, 0xda, 0x9e, 0xc8, 0x9e, 0xb4, 0x84, 0x03, 0xe7,
+	0x55, 0x84, 0x04, 0x47, 0x2e, 0x91, 0x2a, 0xc1, 0x61, 0x2f, 0xdc, 0xf6, 0xcc, 0xb9, 0xc7, 0xd5,
+	0x9e, 0x10, 0x87, 0x15, 0xb4, 0x17, 0xee, 0xfc, 0x01, 0x34, 0x63, 0x27, 0xb1, 0x92, 0xb0, 0xbb,
+	0x02, 0xc1, 0xed, 0xbd, 0x37, 0xdf, 0xf7, 0xe6, 0x9b, 0x6f, 0xde, 0x58, 0x06, 0xcd, 0x69, 0xbb,
+	0x25, 0x8b, 0x38, 0x0e, 0xb2, 0x28, 0x26, 0x5e, 0xe9, 0xec, 0x4e, 0x2c, 0x2b, 0xf6, 0x7c, 0x42,
+	0x89, 0x74, 0xcb, 0x69, 0xbb, 0xc5, 0x58, 0xf5, 0xec, 0x8e, 0xb2, 0xd6, 0x21, 0x1d, 0xc2, 0x57,
+	0x4b, 0x2c, 0x0a, 0x81, 0xca, 0x86, 0x45, 0x02, 0x97, 0x04, 0xad, 0x70, 0x21, 0x4c, 0xc2, 0x25,
+	0xcd, 0x80, 0xe5, 0xba, 0xe9, 0x9b, 0x6e, 0x20, 0xbd, 0x0f, 0x59, 0x1b, 0xf5, 0x68, 0xb7, 0xe5,
+	0x60, 0x17, 0x53, 0x59, 0x2c, 0x88, 0xb7, 0x6f, 0x94, 0x13, 0xb2, 0x68, 0x00, 0x2f, 0x1f, 0xb0,
+	0x2a, 0x03, 0x9d, 0x63, 0x7b, 0x02, 0x4a, 0x4c, 0x41, 0xbc, 0xcc, 0x41, 0x5a, 0x13, 0xd2, 0x15,
+	0xe2, 0x51, 0xdf, 0xb4, 0xa8, 0xb4, 0x0a, 0x09, 0x6c, 0xf3, 0x66, 0x19, 0x23, 0x81, 0x6d, 0x49,
+	0x82, 0xa4, 0x67, 0xba, 0x88, 0x33, 0x33, 0x06, 0x8f, 0x59, 0xcd, 0x45, 0xd4, 0x94, 0x97, 0xc2,
+	0x1a, 0x8b, 0xa5, 0x1c, 0x2c, 0xf5, 0x7d, 0x2c, 0x27, 0x79, 0x89, 0x85, 0xda, 0xb7, 0x22, 0xac,
+	0x54, 0x9b, 0x15, 0xc7, 0x0c, 0x82, 0x7f, 0xdc, 0x55, 0x81, 0xb4, 0x8d, 0x2c, 0xec, 0x9a, 0x4e,
+	0xc0, 0x5b, 0xa7, 0x8c, 0x49, 0xce, 0xd6, 0x5c, 0xec, 0x51, 0xb3, 0xed, 0x20, 0x39, 0x55, 0x10,
+	0x6f, 0xa7, 0x8d, 0x49, 0xbe, 0xbb, 0xf6, 0xf8, 0x22, 0x2f, 0x3e, 0x7f, 0xba, 0x0d, 0x4d, 0x72,
+	0x8a, 0x3c, 0xae, 0x41, 0x16, 0xb5, 0x6f, 0x44, 0x48, 0xd7, 0xfe, 0xad, 0xa4, 0x7b, 0xb0, 0xe2,
+	0x93, 0x81, 0xe9, 0xd0, 0x01, 0x57, 0x94, 0xdd, 0x51, 0x8a, 0x73, 0xd7, 0x5a, 0x34, 0x42, 0x84,
+	0x31, 0x86, 0xee, 0x4a, 0xf3, 0x82, 0xb4, 0xaf, 0x61, 0xa9, 0x56, 0x6d, 0x4a, 0x1b, 0x90, 0xa6,
+	0xac, 0xd8, 0x9a, 0xc8, 0x59, 0xe1, 0xb9, 0xfe, 0x1f, 0x6b, 0xd2, 0x1e, 0xc2, 0x4a, 0x54, 0x93,
+	0xde, 0x85, 0x8c, 0x8f, 0x2c, 0xdc, 0xc3, 0xc8, 0xa3, 0x91, 0x88, 0x69, 0x41, 0x7a, 0x0f, 0xde,
+	0x68, 0x9b, 0x01, 0x0e, 0x5a, 0x3d, 0x82, 0x3d, 0x1a, 0x84, 0x53, 0x64, 0x64, 0x79, 0xad, 0xce,
+	0x4b, 0x5a, 0x1b, 0xb2, 0xe3, 0x11, 0x62, 0x67, 0xca, 0x43, 0xd6, 0x8a, 0xd2, 0xe9, 0xb1, 0x60,
+	0x5c, 0xd2, 0x6d, 0x69, 0x07, 0x52, 0xfc, 0x90, 0xbc, 0x57, 0x76, 0x67, 0x7d, 0x81, 0xde, 0x5a,
+	0xb5, 0x59, 0x4e, 0x5e, 0xbe, 0xc8, 0x0b, 0x46, 0x08, 0x65, 0x03, 0x95, 0x3e, 0x3a, 0xf7, 0x90,
+	0xff, 0x5a, 0x3b, 0xc4, 0x6d, 0x4d, 0x2c, 0xb6, 0x75, 0x69, 0x81, 0xad, 0xc9, 0x98, 0xad, 0x6b,
+	0x90, 0x22, 0x6c, 0x3f, 0x3e, 0x5e, 0x19, 0x23, 0x4c, 0x76, 0x33, 0xcf, 0x9f, 0x6e, 0xa7, 0xf8,
+	0x35, 0x6a, 0x3f, 0x89, 0x90, 0xf8, 0x9f, 0xb4, 0xc4, 0x5f, 0x42, 0xea, 0x25, 0x2f, 0x61, 0x79,
+	0xe6, 0x25, 0x64, 0x27, 0x6a, 0x65, 0x51, 0x0b, 0x20, 0xc3, 0xc3, 0xe6, 0xa0, 0x87, 0x5e, 0xad,
+	0x7a, 0x13, 0x20, 0x54, 0x4d, 0x07, 0xbd, 0xf1, 0x0c, 0x66, 0xe8, 0x84, 0xff, 0x9a, 0xca, 0xb5,
+	0x73, 0x48, 0x56, 0x08, 0xf6, 0x5e, 0x36, 0xe7, 0x0f, 0x61, 0xd9, 0x74, 0x49, 0xdf, 0x0b, 0x3f,
+	0x50, 0x99, 0xf2, 0x0e, 0xbb, 0xf6, 0x5f, 0x5f, 0xe4, 0xb7, 0x3a, 0x98, 0x76, 0xfb, 0xed, 0xa2,
+	0x45, 0xdc, 0x52, 0x15, 0x7b, 0x81, 0xd5, 0xc5, 0x66, 0xe9, 0x24, 0x0a, 0xb6, 0x03, 0xfb, 0xb4,
+	0xc4, 0xa4, 0x05, 0x45, 0xdd, 0xa3, 0x46, 0xd4, 0x61, 0x37, 0xfd, 0xfd, 0x45, 0x5e, 0xf8, 0xe3,
+	0x22, 0x2f, 0x6a, 0x5f, 0x40, 0xea, 0x81, 0x6f, 0x7a, 0x54, 0x92, 0x61, 0xa5, 0xc3, 0x02, 0x84,
+	0xc6, 0x1b, 0x47, 0xa9, 0xf4, 0x19, 0x40, 0x0f, 0xf9, 0x2e, 0x0e, 0x02, 0x4c, 0xc2, 0x59, 0x5c,
+	0xdd, 0xd9, 0x5c, 0x30, 0x8b, 0xf5, 0x09, 0xc8, 0x88, 0x11, 0xb4, 0x0a, 0xdc, 0xd8, 0xeb, 0xd3,
+	0x2e, 0xf1, 0xf1, 0x57, 0x26, 0x83, 0x4a, 0xeb, 0xb0, 0xdc, 0x25, 0x8e, 0x8d, 0xfc, 0x68, 0xa3,
+	0x28, 0x63, 0x37, 0x44, 0x7a, 0xc8, 0x37, 0x29, 0xf1, 0x23, 0x23, 0x27, 0xb9, 0x76, 0x17, 0x32,
+	0x7b, 0x94, 0xfa, 0xb8, 0xdd, 0xa7, 0x88, 0x7d, 0x46, 0x4f, 0xd1, 0x20, 0x62, 0xb3, 0x90, 0x0d,
+	0xe1, 0x99, 0xe9, 0xf4, 0xc7, 0x17, 0x10, 0x26, 0x5b, 0x7f, 0x8a, 0x00, 0x53, 0x51, 0xd2, 0xc7,
+	0xb0, 0x5e, 0xdf, 0x37, 0x0e, 0xf5, 0x46, 0x43, 0x3f, 0xaa, 0xb5, 0x8e, 0x6b, 0x8d, 0xfa, 0x7e,
+	0x45, 0xaf, 0xea, 0xfb, 0xf7, 0x73, 0x82, 0xb2, 0x31, 0x1c, 0x15, 0xde, 0x9a, 0x62, 0x8f, 0xbd,
+	0xa0, 0x87, 0x2c, 0x7c, 0x82, 0x91, 0x2d, 0x7d, 0x08, 0xb9, 0x18, 0x4d, 0x6f, 0x34, 0x8e, 0xf7,
+	0x73, 0xa2, 0xf2, 0xe6, 0x70, 0x54, 0xb8, 0x39, 0x25, 0xe8, 0x41, 0xd0, 0x47, 0xd2, 0x47, 0x70,
+	0x2b, 0x06, 0x3d, 0x3c, 0xba, 0xaf, 0x57, 0x1f, 0xe5, 0x12, 0xca, 0xda, 0x70, 0x54, 0xc8, 0x4d,
+	0xb1, 0x87, 0xc4, 0xc6, 0x27, 0x03, 0xe9, 0x03, 0xb8, 0x19, 0x07, 0xeb, 0xb5, 0x66, 0x6e, 0x49,
+	0x91, 0x86, 0xa3, 0xc2, 0x6a, 0x0c, 0x8a, 0x3d, 0x3a, 0x03, 0x2c, 0x1f, 0x1b, 0xb5, 0x5c, 0x72,
+	0x16, 0x58, 0xee, 0xfb, 0x9e, 0x92, 0x7c, 0xfc, 0x83, 0x2a, 0x6c, 0xfd, 0x9c, 0x80, 0xdc, 0x01,
+	0xea, 0x98, 0xd6, 0x20, 0x76, 0xf6, 0x32, 0x6c, 0x1e, 0xec, 0x3f, 0xd8, 0xab, 0x3c, 0x6a, 0xfd,
+	0xad, 0x05, 0xf9, 0xe1, 0xa8, 0xf0, 0xce, 0x2c, 0x31, 0x6e, 0xc4, 0x27, 0xf0, 0xf6, 0x7c, 0x8f,
+	0xb1, 0x1f, 0xdc, 0xc0, 0x59, 0x76, 0xe8, 0xca, 0xa7, 0x20, 0xcf, 0xf3, 0x26, 0xe6, 0x28, 0xc3,
+	0x51, 0x61, 0x7d, 0x96, 0x18, 0x59, 0x74, 0x0f, 0xd6, 0x17, 0x30, 0x43, 0xa7, 0xe4, 0xe1, 0xa8,
+	0xb0, 0x36, 0xc7, 0x63, 0x7e, 0x2d, 0x64, 0x45, 0xb6, 0x2d, 0x64, 0x71, 0xf3, 0xd2, 0xcc, 0xbc,
+	0x27, 0x3f, 0xaa, 0x42, 0xf9, 0xe8, 0xf2, 0x77, 0x55, 0x78, 0x72, 0xa5, 0x0a, 0x97, 0x57, 0xaa,
+	0xf8, 0xec, 0x4a, 0x15, 0x7f, 0xbb, 0x52, 0xc5, 0xef, 0xae, 0x55, 0xe1, 0xd9, 0xb5, 0x2a, 0xfc,
+	0x72, 0xad, 0x0a, 0x9f, 0x6f, 0xbf, 0xf2, 0xc9, 0x7d, 0x19, 0xfb, 0xb1, 0x69, 0x2f, 0xf3, 0xbf,
+	0x92, 0xbb, 0x7f, 0x0d, 0x00, 0x49, 0x8e, 0x5b, 0xa9, 0xff, 0x08, 0x00, 0x00,
 }
 
 func (this *Coin) Equal(that interface{}) bool {
@@ -976,6 +1084,18 @@ func (m *NFTClass) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Royalty != nil {
+		{
+			size, err := m.Royalty.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCollection(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Meta) > 0 {
 		i -= len(m.Meta)
 		copy(dAtA[i:], m.Meta)
@@ -1020,6 +1140,18 @@ func (m *NFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Royalty != nil {
+		{
+			size, err := m.Royalty.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCollection(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Meta) > 0 {
 		i -= len(m.Meta)
 		copy(dAtA[i:], m.Meta)
@@ -1044,6 +1176,81 @@ func (m *NFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Royalty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Royalty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Royalty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BasisPoints != 0 {
+		i = encodeVarintCollection(dAtA, i, uint64(m.BasisPoints))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintCollection(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractNFT) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractNFT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCollection(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintCollection(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *OwnerNFT) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1464,6 +1671,10 @@ func (m *NFTClass) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCollection(uint64(l))
 	}
+	if m.Royalty != nil {
+		l = m.Royalty.Size()
+		n += 1 + l + sovCollection(uint64(l))
+	}
 	return n
 }
 
@@ -1485,6 +1696,41 @@ func (m *NFT) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCollection(uint64(l))
 	}
+	if m.Royalty != nil {
+		l = m.Royalty.Size()
+		n += 1 + l + sovCollection(uint64(l))
+	}
+	return n
+}
+
+func (m *Royalty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovCollection(uint64(l))
+	}
+	if m.BasisPoints != 0 {
+		n += 1 + sovCollection(uint64(m.BasisPoints))
+	}
+	return n
+}
+
+func (m *ContractNFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovCollection(uint64(l))
+	}
+	l = m.Token.Size()
+	n += 1 + l + sovCollection(uint64(l))
 	return n
 }
 
@@ -2220,6 +2466,42 @@ func (m *NFTClass) Unmarshal(dAtA []byte) error {
 			}
 			m.Meta = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Royalty == nil {
+				m.Royalty = &Royalty{}
+			}
+			if err := m.Royalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCollection(dAtA[iNdEx:])
@@ -2366,6 +2648,258 @@ func (m *NFT) Unmarshal(dAtA []byte) error {
 			}
 			m.Meta = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Royalty == nil {
+				m.Royalty = &Royalty{}
+			}
+			if err := m.Royalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCollection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Royalty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCollection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Royalty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Royalty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasisPoints", wireType)
+			}
+			m.BasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BasisPoints |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCollection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractNFT) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCollection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractNFT: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractNFT: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCollection(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package collection_test
 
 import (
 	"fmt"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -64,9 +65,10 @@ func TestFTClass(t *testing.T) {
 func TestNFTClass(t *testing.T) {
 	nextIDs := collection.DefaultNextClassIDs(TestContractID)
 	testCases := map[string]struct {
-		name  string
-		meta  string
-		valid bool
+		name    string
+		meta    string
+		royalty *collection.Royalty
+		valid   bool
 	}{
 		"valid class": {
 			valid: true,
@@ -77,11 +79,25 @@ func TestNFTClass(t *testing.T) {
 		"invalid meta": {
 			meta: string(make([]rune, 1001)),
 		},
+		"valid royalty": {
+			royalty: &collection.Royalty{
+				Recipient:   sdk.AccAddress("recipient").String(),
+				BasisPoints: 250,
+			},
+			valid: true,
+		},
+		"invalid royalty": {
+			royalty: &collection.Royalty{
+				Recipient: sdk.AccAddress("recipient").String(),
+			},
+		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			class := &collection.NFTClass{}
+			class := &collection.NFTClass{
+				Royalty: tc.royalty,
+			}
 			class.SetId(&nextIDs)
 			class.SetName(tc.name)
 			class.SetMeta(tc.meta)
@@ -96,6 +112,140 @@ func TestNFTClass(t *testing.T) {
 	}
 }
 
+func TestRoyalty(t *testing.T) {
+	recipient := sdk.AccAddress("recipient").String()
+	testCases := map[string]struct {
+		royalty collection.Royalty
+		valid   bool
+	}{
+		"valid royalty": {
+			royalty: collection.Royalty{
+				Recipient:   recipient,
+				BasisPoints: 250,
+			},
+			valid: true,
+		},
+		"valid royalty of 100%": {
+			royalty: collection.Royalty{
+				Recipient:   recipient,
+				BasisPoints: collection.MaxRoyaltyBasisPoints,
+			},
+			valid: true,
+		},
+		"invalid recipient": {
+			royalty: collection.Royalty{
+				BasisPoints: 250,
+			},
+		},
+		"zero basis points": {
+			royalty: collection.Royalty{
+				Recipient: recipient,
+			},
+		},
+		"basis points over 100%": {
+			royalty: collection.Royalty{
+				Recipient:   recipient,
+				BasisPoints: collection.MaxRoyaltyBasisPoints + 1,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.royalty.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			value := collection.RoyaltyToAttributeValue(&tc.royalty)
+			parsed, err := collection.RoyaltyFromAttributeValue(value)
+			require.NoError(t, err)
+			require.Equal(t, tc.royalty, *parsed)
+		})
+	}
+}
+
+func TestRoyaltyAmount(t *testing.T) {
+	// the max of sdk.Int, 2^256 - 1
+	maxInt := sdk.NewIntFromBigInt(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1)))
+	royalty := collection.Royalty{
+		Recipient:   sdk.AccAddress("recipient").String(),
+		BasisPoints: 250,
+	}
+
+	testCases := map[string]struct {
+		salePrice sdk.Int
+		expected  sdk.Int
+	}{
+		"zero": {
+			salePrice: sdk.ZeroInt(),
+			expected:  sdk.ZeroInt(),
+		},
+		"exact": {
+			salePrice: sdk.NewInt(10000),
+			expected:  sdk.NewInt(250),
+		},
+		"rounded down": {
+			salePrice: sdk.NewInt(39),
+			expected:  sdk.ZeroInt(),
+		},
+		"max sale price": {
+			salePrice: maxInt,
+			expected:  sdk.NewIntFromBigInt(new(big.Int).Quo(new(big.Int).Mul(maxInt.BigInt(), big.NewInt(250)), big.NewInt(10000))),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.True(t, tc.expected.Equal(royalty.Amount(tc.salePrice)))
+		})
+	}
+}
+
+func TestRoyaltyFromAttributeValue(t *testing.T) {
+	recipient := sdk.AccAddress("recipient").String()
+	testCases := map[string]struct {
+		value    string
+		valid    bool
+		expected *collection.Royalty
+	}{
+		"valid value": {
+			value: recipient + ":250",
+			valid: true,
+			expected: &collection.Royalty{
+				Recipient:   recipient,
+				BasisPoints: 250,
+			},
+		},
+		"empty value": {
+			valid: true,
+		},
+		"no separator": {
+			value: recipient,
+		},
+		"invalid basis points": {
+			value: recipient + ":-1",
+		},
+		"invalid royalty": {
+			value: recipient + ":10001",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			royalty, err := collection.RoyaltyFromAttributeValue(tc.value)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, royalty)
+		})
+	}
+}
+
 func TestParseCoin(t *testing.T) {
 	testCases := map[string]struct {
 		input    string
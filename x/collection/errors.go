@@ -53,4 +53,5 @@ var (
 	ErrCompositionTooDeep            = sdkerrors.Register(collectionCodespace, 45, "cannot attach token (composition too deep)")
 	ErrCompositionTooWide            = sdkerrors.Register(collectionCodespace, 46, "cannot attach token (composition too wide)")
 	ErrBurnNonRootNFT                = sdkerrors.Register(collectionCodespace, 47, "cannot burn non-root NFTs")
+	ErrInvalidRoyalty                = sdkerrors.Register(collectionCodespace, 48, "invalid royalty")
 )
@@ -33,6 +33,8 @@ const (
 	// deprecated: use ATTRIBUTE_KEY_URI
 	AttributeKeyBaseImgURI AttributeKey = 8
 	AttributeKeyURI        AttributeKey = 20
+	// Since: 0.49.0 (finschia)
+	AttributeKeyRoyalty AttributeKey = 21
 )
 
 var AttributeKey_name = map[int32]string{
@@ -41,6 +43,7 @@ var AttributeKey_name = map[int32]string{
 	2:  "ATTRIBUTE_KEY_META",
 	8:  "ATTRIBUTE_KEY_BASE_IMG_URI",
 	20: "ATTRIBUTE_KEY_URI",
+	21: "ATTRIBUTE_KEY_ROYALTY",
 }
 
 var AttributeKey_value = map[string]int32{
@@ -49,6 +52,7 @@ var AttributeKey_value = map[string]int32{
 	"ATTRIBUTE_KEY_META":         2,
 	"ATTRIBUTE_KEY_BASE_IMG_URI": 8,
 	"ATTRIBUTE_KEY_URI":          20,
+	"ATTRIBUTE_KEY_ROYALTY":      21,
 }
 
 func (AttributeKey) EnumDescriptor() ([]byte, []int) {
@@ -271,6 +275,156 @@ func (m *EventRevokedOperator) GetOperator() string {
 	return ""
 }
 
+// EventApprovedNFT is emitted when an owner approves an address to manipulate its non-fungible token.
+//
+// Since: 0.49.0 (finschia)
+type EventApprovedNFT struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// address of the owner of the token.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// address which became approved on the token.
+	Approved string `protobuf:"bytes,3,opt,name=approved,proto3" json:"approved,omitempty"`
+	// token id of the token.
+	TokenId string `protobuf:"bytes,4,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+}
+
+func (m *EventApprovedNFT) Reset()         { *m = EventApprovedNFT{} }
+func (m *EventApprovedNFT) String() string { return proto.CompactTextString(m) }
+func (*EventApprovedNFT) ProtoMessage()    {}
+func (*EventApprovedNFT) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478cfab12ea1b00e, []int{3}
+}
+func (m *EventApprovedNFT) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventApprovedNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventApprovedNFT.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventApprovedNFT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventApprovedNFT.Merge(m, src)
+}
+func (m *EventApprovedNFT) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventApprovedNFT) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventApprovedNFT.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventApprovedNFT proto.InternalMessageInfo
+
+func (m *EventApprovedNFT) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *EventApprovedNFT) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *EventApprovedNFT) GetApproved() string {
+	if m != nil {
+		return m.Approved
+	}
+	return ""
+}
+
+func (m *EventApprovedNFT) GetTokenId() string {
+	if m != nil {
+		return m.TokenId
+	}
+	return ""
+}
+
+// EventRevokedNFTApproval is emitted when an approval on a non-fungible token is revoked.
+//
+// Since: 0.49.0 (finschia)
+type EventRevokedNFTApproval struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// address of the owner of the token.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// address which was approved on the token.
+	Approved string `protobuf:"bytes,3,opt,name=approved,proto3" json:"approved,omitempty"`
+	// token id of the token.
+	TokenId string `protobuf:"bytes,4,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+}
+
+func (m *EventRevokedNFTApproval) Reset()         { *m = EventRevokedNFTApproval{} }
+func (m *EventRevokedNFTApproval) String() string { return proto.CompactTextString(m) }
+func (*EventRevokedNFTApproval) ProtoMessage()    {}
+func (*EventRevokedNFTApproval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478cfab12ea1b00e, []int{4}
+}
+func (m *EventRevokedNFTApproval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRevokedNFTApproval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRevokedNFTApproval.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRevokedNFTApproval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRevokedNFTApproval.Merge(m, src)
+}
+func (m *EventRevokedNFTApproval) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRevokedNFTApproval) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRevokedNFTApproval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRevokedNFTApproval proto.InternalMessageInfo
+
+func (m *EventRevokedNFTApproval) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *EventRevokedNFTApproval) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *EventRevokedNFTApproval) GetApproved() string {
+	if m != nil {
+		return m.Approved
+	}
+	return ""
+}
+
+func (m *EventRevokedNFTApproval) GetTokenId() string {
+	if m != nil {
+		return m.TokenId
+	}
+	return ""
+}
+
 // EventCreatedContract is emitted when a new contract is created.
 //
 // Since: 0.46.0 (finschia)
@@ -291,7 +445,7 @@ func (m *EventCreatedContract) Reset()         { *m = EventCreatedContract{} }
 func (m *EventCreatedContract) String() string { return proto.CompactTextString(m) }
 func (*EventCreatedContract) ProtoMessage()    {}
 func (*EventCreatedContract) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{3}
+	return fileDescriptor_478cfab12ea1b00e, []int{5}
 }
 func (m *EventCreatedContract) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -381,7 +535,7 @@ func (m *EventCreatedFTClass) Reset()         { *m = EventCreatedFTClass{} }
 func (m *EventCreatedFTClass) String() string { return proto.CompactTextString(m) }
 func (*EventCreatedFTClass) ProtoMessage()    {}
 func (*EventCreatedFTClass) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{4}
+	return fileDescriptor_478cfab12ea1b00e, []int{6}
 }
 func (m *EventCreatedFTClass) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -474,13 +628,17 @@ type EventCreatedNFTClass struct {
 	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
 	// metadata of the token class.
 	Meta string `protobuf:"bytes,5,opt,name=meta,proto3" json:"meta,omitempty"`
+	// royalty of the token class.
+	//
+	// Since: 0.49.0 (finschia)
+	Royalty *Royalty `protobuf:"bytes,6,opt,name=royalty,proto3" json:"royalty,omitempty"`
 }
 
 func (m *EventCreatedNFTClass) Reset()         { *m = EventCreatedNFTClass{} }
 func (m *EventCreatedNFTClass) String() string { return proto.CompactTextString(m) }
 func (*EventCreatedNFTClass) ProtoMessage()    {}
 func (*EventCreatedNFTClass) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{5}
+	return fileDescriptor_478cfab12ea1b00e, []int{7}
 }
 func (m *EventCreatedNFTClass) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -544,6 +702,13 @@ func (m *EventCreatedNFTClass) GetMeta() string {
 	return ""
 }
 
+func (m *EventCreatedNFTClass) GetRoyalty() *Royalty {
+	if m != nil {
+		return m.Royalty
+	}
+	return nil
+}
+
 // EventGranted is emitted when a granter grants its permission to a grantee.
 //
 // Info: `granter` would be empty if the permission is granted by an issuance.
@@ -564,7 +729,7 @@ func (m *EventGranted) Reset()         { *m = EventGranted{} }
 func (m *EventGranted) String() string { return proto.CompactTextString(m) }
 func (*EventGranted) ProtoMessage()    {}
 func (*EventGranted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{6}
+	return fileDescriptor_478cfab12ea1b00e, []int{8}
 }
 func (m *EventGranted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -637,7 +802,7 @@ func (m *EventRenounced) Reset()         { *m = EventRenounced{} }
 func (m *EventRenounced) String() string { return proto.CompactTextString(m) }
 func (*EventRenounced) ProtoMessage()    {}
 func (*EventRenounced) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{7}
+	return fileDescriptor_478cfab12ea1b00e, []int{9}
 }
 func (m *EventRenounced) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -707,7 +872,7 @@ func (m *EventMintedFT) Reset()         { *m = EventMintedFT{} }
 func (m *EventMintedFT) String() string { return proto.CompactTextString(m) }
 func (*EventMintedFT) ProtoMessage()    {}
 func (*EventMintedFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{8}
+	return fileDescriptor_478cfab12ea1b00e, []int{10}
 }
 func (m *EventMintedFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -782,7 +947,7 @@ func (m *EventMintedNFT) Reset()         { *m = EventMintedNFT{} }
 func (m *EventMintedNFT) String() string { return proto.CompactTextString(m) }
 func (*EventMintedNFT) ProtoMessage()    {}
 func (*EventMintedNFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{9}
+	return fileDescriptor_478cfab12ea1b00e, []int{11}
 }
 func (m *EventMintedNFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -857,7 +1022,7 @@ func (m *EventBurned) Reset()         { *m = EventBurned{} }
 func (m *EventBurned) String() string { return proto.CompactTextString(m) }
 func (*EventBurned) ProtoMessage()    {}
 func (*EventBurned) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{10}
+	return fileDescriptor_478cfab12ea1b00e, []int{12}
 }
 func (m *EventBurned) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -932,7 +1097,7 @@ func (m *EventModifiedContract) Reset()         { *m = EventModifiedContract{} }
 func (m *EventModifiedContract) String() string { return proto.CompactTextString(m) }
 func (*EventModifiedContract) ProtoMessage()    {}
 func (*EventModifiedContract) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{11}
+	return fileDescriptor_478cfab12ea1b00e, []int{13}
 }
 func (m *EventModifiedContract) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1004,7 +1169,7 @@ func (m *EventModifiedTokenClass) Reset()         { *m = EventModifiedTokenClass
 func (m *EventModifiedTokenClass) String() string { return proto.CompactTextString(m) }
 func (*EventModifiedTokenClass) ProtoMessage()    {}
 func (*EventModifiedTokenClass) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{12}
+	return fileDescriptor_478cfab12ea1b00e, []int{14}
 }
 func (m *EventModifiedTokenClass) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1087,7 +1252,7 @@ func (m *EventModifiedNFT) Reset()         { *m = EventModifiedNFT{} }
 func (m *EventModifiedNFT) String() string { return proto.CompactTextString(m) }
 func (*EventModifiedNFT) ProtoMessage()    {}
 func (*EventModifiedNFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{13}
+	return fileDescriptor_478cfab12ea1b00e, []int{15}
 }
 func (m *EventModifiedNFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1166,7 +1331,7 @@ func (m *EventAttached) Reset()         { *m = EventAttached{} }
 func (m *EventAttached) String() string { return proto.CompactTextString(m) }
 func (*EventAttached) ProtoMessage()    {}
 func (*EventAttached) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{14}
+	return fileDescriptor_478cfab12ea1b00e, []int{16}
 }
 func (m *EventAttached) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1252,7 +1417,7 @@ func (m *EventDetached) Reset()         { *m = EventDetached{} }
 func (m *EventDetached) String() string { return proto.CompactTextString(m) }
 func (*EventDetached) ProtoMessage()    {}
 func (*EventDetached) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{15}
+	return fileDescriptor_478cfab12ea1b00e, []int{17}
 }
 func (m *EventDetached) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1336,7 +1501,7 @@ func (m *EventOwnerChanged) Reset()         { *m = EventOwnerChanged{} }
 func (m *EventOwnerChanged) String() string { return proto.CompactTextString(m) }
 func (*EventOwnerChanged) ProtoMessage()    {}
 func (*EventOwnerChanged) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{16}
+	return fileDescriptor_478cfab12ea1b00e, []int{18}
 }
 func (m *EventOwnerChanged) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1413,7 +1578,7 @@ func (m *EventRootChanged) Reset()         { *m = EventRootChanged{} }
 func (m *EventRootChanged) String() string { return proto.CompactTextString(m) }
 func (*EventRootChanged) ProtoMessage()    {}
 func (*EventRootChanged) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{17}
+	return fileDescriptor_478cfab12ea1b00e, []int{19}
 }
 func (m *EventRootChanged) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1475,6 +1640,8 @@ func init() {
 	proto.RegisterType((*EventSent)(nil), "lbm.collection.v1.EventSent")
 	proto.RegisterType((*EventAuthorizedOperator)(nil), "lbm.collection.v1.EventAuthorizedOperator")
 	proto.RegisterType((*EventRevokedOperator)(nil), "lbm.collection.v1.EventRevokedOperator")
+	proto.RegisterType((*EventApprovedNFT)(nil), "lbm.collection.v1.EventApprovedNFT")
+	proto.RegisterType((*EventRevokedNFTApproval)(nil), "lbm.collection.v1.EventRevokedNFTApproval")
 	proto.RegisterType((*EventCreatedContract)(nil), "lbm.collection.v1.EventCreatedContract")
 	proto.RegisterType((*EventCreatedFTClass)(nil), "lbm.collection.v1.EventCreatedFTClass")
 	proto.RegisterType((*EventCreatedNFTClass)(nil), "lbm.collection.v1.EventCreatedNFTClass")
@@ -1495,70 +1662,76 @@ func init() {
 func init() { proto.RegisterFile("lbm/collection/v1/event.proto", fileDescriptor_478cfab12ea1b00e) }
 
 var fileDescriptor_478cfab12ea1b00e = []byte{
-	// 998 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x97, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xc0, 0x3d, 0xb6, 0xe3, 0x3f, 0x2f, 0x25, 0xdd, 0x6c, 0x42, 0xb2, 0xdd, 0x12, 0xd7, 0xda,
-	0x0b, 0x51, 0x45, 0x6d, 0xb5, 0xc0, 0x25, 0x82, 0x83, 0xed, 0x3a, 0xd1, 0xaa, 0xb2, 0x1b, 0x6d,
-	0xec, 0x03, 0x5c, 0xac, 0xf5, 0x7a, 0x62, 0x2f, 0xf1, 0xce, 0x58, 0xbb, 0x63, 0x97, 0xf0, 0x09,
-	0x90, 0xb9, 0x20, 0x10, 0x42, 0x42, 0xca, 0x85, 0xf6, 0x50, 0x89, 0x2f, 0xd2, 0x63, 0xb8, 0x71,
-	0x42, 0x28, 0xf9, 0x22, 0x68, 0x67, 0x77, 0x9c, 0x75, 0x6c, 0x91, 0x18, 0xb7, 0x70, 0x9b, 0xf7,
-	0xe6, 0xbd, 0x99, 0xdf, 0x7b, 0xf3, 0xe6, 0xcd, 0x2e, 0xec, 0xf4, 0xdb, 0x4e, 0xd1, 0xa2, 0xfd,
-	0x3e, 0xb6, 0x98, 0x4d, 0x49, 0x71, 0xf4, 0xb8, 0x88, 0x47, 0x98, 0xb0, 0xc2, 0xc0, 0xa5, 0x8c,
-	0xca, 0xeb, 0xfd, 0xb6, 0x53, 0xb8, 0x9a, 0x2e, 0x8c, 0x1e, 0xab, 0x9b, 0x5d, 0xda, 0xa5, 0x7c,
-	0xb6, 0xe8, 0x8f, 0x02, 0x43, 0x55, 0x9b, 0x5d, 0x27, 0xe2, 0xc6, 0x6d, 0xb4, 0x97, 0x08, 0xb2,
-	0x55, 0x7f, 0xf1, 0x23, 0x4c, 0x98, 0xfc, 0x00, 0x56, 0x2d, 0x4a, 0x98, 0x6b, 0x5a, 0xac, 0x65,
-	0x77, 0x14, 0x94, 0x47, 0xbb, 0x59, 0x03, 0x84, 0x4a, 0xef, 0xc8, 0x2a, 0x64, 0xe8, 0x00, 0xbb,
-	0x26, 0xa3, 0xae, 0x12, 0xe7, 0xb3, 0x13, 0x59, 0x96, 0x21, 0x79, 0xec, 0x52, 0x47, 0x49, 0x70,
-	0x3d, 0x1f, 0xcb, 0x6b, 0x10, 0x67, 0x54, 0x49, 0x72, 0x4d, 0x9c, 0x51, 0xf9, 0x53, 0x48, 0x99,
-	0x0e, 0x1d, 0x12, 0xa6, 0xac, 0xe4, 0x13, 0xbb, 0xab, 0x4f, 0xb6, 0x0b, 0x33, 0xc1, 0x14, 0x2a,
-	0xd4, 0x26, 0xe5, 0xe4, 0x9b, 0x3f, 0x1f, 0xc4, 0x8c, 0xd0, 0x58, 0x23, 0xb0, 0xcd, 0x21, 0x4b,
-	0x43, 0xd6, 0xa3, 0xae, 0xfd, 0x0d, 0xee, 0x3c, 0x17, 0xbb, 0xde, 0x88, 0xbc, 0x05, 0xa9, 0x1e,
-	0xed, 0x77, 0xb0, 0x00, 0x0e, 0xa5, 0xa9, 0x50, 0x12, 0xd3, 0xa1, 0x68, 0x27, 0xb0, 0xc9, 0xf7,
-	0x33, 0xf0, 0x88, 0x9e, 0xbc, 0xeb, 0xcd, 0xbe, 0x43, 0xe1, 0x6e, 0x15, 0x17, 0x9b, 0x0c, 0x77,
-	0x2a, 0xe1, 0x72, 0xb2, 0x02, 0x69, 0xcb, 0x57, 0x51, 0x37, 0xdc, 0x49, 0x88, 0xd7, 0x39, 0xe2,
-	0x33, 0x1c, 0x32, 0x24, 0x89, 0xe9, 0x60, 0x71, 0x16, 0xfe, 0xd8, 0xd7, 0x39, 0x98, 0x99, 0xe1,
-	0x69, 0xf0, 0xb1, 0x2c, 0x41, 0x62, 0xe8, 0xda, 0xca, 0x0a, 0x57, 0xf9, 0x43, 0xed, 0x77, 0x04,
-	0x1b, 0x51, 0x9a, 0xfd, 0x46, 0xa5, 0x6f, 0x7a, 0xde, 0x72, 0xa5, 0x71, 0x0f, 0x32, 0x8c, 0x9e,
-	0x60, 0xe2, 0x7b, 0x06, 0x48, 0x69, 0x2e, 0x47, 0x48, 0x93, 0x73, 0x48, 0x57, 0x22, 0xa4, 0x2a,
-	0x64, 0x3a, 0xd8, 0xb2, 0x1d, 0xb3, 0xef, 0x29, 0xa9, 0x3c, 0xda, 0x5d, 0x31, 0x26, 0xb2, 0x3f,
-	0xe7, 0xd8, 0x84, 0x99, 0xed, 0x3e, 0x56, 0xd2, 0x79, 0xb4, 0x9b, 0x31, 0x26, 0xf2, 0x5e, 0x5c,
-	0x41, 0xda, 0x2f, 0xd7, 0x32, 0x5c, 0x7f, 0x2b, 0x41, 0xed, 0x00, 0x04, 0x41, 0xb1, 0xd3, 0x81,
-	0xc8, 0x74, 0x96, 0x6b, 0x1a, 0xa7, 0x03, 0x7c, 0xdb, 0xc0, 0xb4, 0x5f, 0x11, 0xdc, 0xe1, 0x70,
-	0x07, 0xae, 0x49, 0x18, 0xee, 0xdc, 0x0c, 0xa5, 0x40, 0xba, 0xcb, 0x6d, 0x05, 0x93, 0x10, 0xaf,
-	0x66, 0x04, 0x8f, 0x10, 0xe5, 0xcf, 0x01, 0x06, 0xd8, 0x75, 0x6c, 0xcf, 0xb3, 0x29, 0xe1, 0x4c,
-	0x6b, 0x4f, 0x76, 0xe6, 0x5c, 0xbe, 0xc3, 0x89, 0x91, 0x11, 0x71, 0xd0, 0xc6, 0x08, 0xd6, 0xc2,
-	0x1b, 0x41, 0xe8, 0x90, 0x58, 0x0b, 0x61, 0xe2, 0x69, 0xcc, 0xeb, 0x30, 0x89, 0x45, 0x61, 0x7e,
-	0x46, 0xf0, 0x1e, 0x87, 0xa9, 0xd9, 0x84, 0x57, 0xe8, 0x72, 0xe7, 0x18, 0xf4, 0xa8, 0xc4, 0x9c,
-	0x1e, 0x95, 0x5c, 0xa0, 0x47, 0xf1, 0x42, 0xfb, 0x51, 0xa4, 0x29, 0x20, 0xab, 0xbf, 0x6d, 0xb4,
-	0x4f, 0x20, 0xc5, 0x0b, 0xcc, 0x0b, 0xd1, 0xb6, 0xe6, 0xa0, 0xd5, 0xf7, 0x1b, 0x82, 0x2c, 0xb0,
-	0xd5, 0x7e, 0x42, 0xb0, 0xca, 0xa9, 0xca, 0x43, 0x97, 0xdc, 0xe6, 0xe4, 0x16, 0xed, 0xf2, 0xff,
-	0x2e, 0x63, 0xda, 0x0f, 0x08, 0xde, 0x0f, 0xb2, 0x45, 0x3b, 0xf6, 0xb1, 0x1d, 0xe9, 0x7c, 0x4b,
-	0x11, 0x7e, 0x06, 0x69, 0xab, 0x67, 0x92, 0x2e, 0xf6, 0x94, 0x04, 0xc7, 0xf9, 0x60, 0x0e, 0x4e,
-	0x89, 0x31, 0xd7, 0x6e, 0x0f, 0x19, 0x0e, 0x99, 0x84, 0x8b, 0x76, 0x8e, 0xc2, 0xb7, 0x46, 0x40,
-	0x35, 0xfc, 0x24, 0xbe, 0xfb, 0x76, 0x11, 0xa1, 0x4e, 0x2e, 0x4c, 0x2d, 0xdf, 0x87, 0xac, 0xbf,
-	0x6c, 0x8b, 0x77, 0x9c, 0xa0, 0xbb, 0x64, 0x7c, 0x45, 0xdd, 0x74, 0xb0, 0xf6, 0x1a, 0x81, 0x34,
-	0x15, 0xd2, 0xd2, 0x75, 0xf9, 0x0f, 0xfd, 0x7c, 0xa9, 0x38, 0xfc, 0x4e, 0x1d, 0x5c, 0xed, 0x12,
-	0x63, 0xa6, 0xd5, 0x5b, 0xb6, 0x58, 0xaf, 0x9e, 0xe3, 0xc4, 0xd4, 0x73, 0xac, 0x40, 0xda, 0x1b,
-	0xb6, 0xbf, 0xc2, 0x16, 0x0b, 0xdb, 0xb3, 0x10, 0x7d, 0x0f, 0x66, 0xba, 0x5d, 0xcc, 0xc2, 0x2c,
-	0x86, 0x12, 0xbf, 0xdd, 0xbf, 0x09, 0xb8, 0xa7, 0xf8, 0xff, 0x81, 0xfb, 0x10, 0xee, 0x0e, 0x5c,
-	0x3c, 0xb2, 0xe9, 0xd0, 0x6b, 0x0d, 0x4c, 0x17, 0x13, 0x41, 0xb9, 0x26, 0xd4, 0x87, 0x5c, 0xcb,
-	0x69, 0x5f, 0xc0, 0x3a, 0x87, 0x7d, 0xfe, 0x82, 0x60, 0xb7, 0xc2, 0xf3, 0x7b, 0x0b, 0xe0, 0xe8,
-	0xc9, 0xc6, 0x67, 0x5e, 0xea, 0x9b, 0xbe, 0xef, 0xf8, 0xc6, 0xa3, 0xb0, 0xda, 0x0c, 0x4a, 0xd9,
-	0x7f, 0xb8, 0xef, 0xc3, 0x57, 0x71, 0xb8, 0x33, 0x29, 0xac, 0x67, 0xf8, 0x54, 0xde, 0x83, 0x7b,
-	0xa5, 0x46, 0xc3, 0xd0, 0xcb, 0xcd, 0x46, 0xb5, 0xf5, 0xac, 0xfa, 0x45, 0xab, 0x59, 0x3f, 0x3a,
-	0xac, 0x56, 0xf4, 0x7d, 0xbd, 0xfa, 0x54, 0x8a, 0xa9, 0xf7, 0xc7, 0x67, 0xf9, 0xed, 0xa8, 0x43,
-	0x93, 0x78, 0x03, 0x6c, 0xf1, 0x1b, 0x22, 0x7f, 0x04, 0xf2, 0xb4, 0x6f, 0xbd, 0x54, 0xab, 0x4a,
-	0x48, 0xdd, 0x1c, 0x9f, 0xe5, 0xa5, 0xa8, 0x93, 0x7f, 0xc3, 0x66, 0xad, 0x6b, 0xd5, 0x46, 0x49,
-	0x8a, 0xcf, 0x5a, 0xd7, 0xfc, 0x4f, 0x99, 0x3d, 0x50, 0xa7, 0xad, 0xcb, 0xa5, 0xa3, 0x6a, 0x4b,
-	0xaf, 0x1d, 0xb4, 0x9a, 0x86, 0x2e, 0x65, 0x54, 0x75, 0x7c, 0x96, 0xdf, 0x8a, 0x7a, 0x95, 0x4d,
-	0x0f, 0xeb, 0x4e, 0xb7, 0x69, 0xe8, 0xf2, 0x43, 0x58, 0xbf, 0x16, 0x93, 0xa1, 0x4b, 0x9b, 0xea,
-	0xc6, 0xf8, 0x2c, 0x7f, 0x77, 0x2a, 0x16, 0x43, 0x57, 0x33, 0xdf, 0xbe, 0xcc, 0xc5, 0x5e, 0xbf,
-	0xca, 0xc5, 0xb4, 0x64, 0x26, 0x21, 0xa5, 0xb5, 0x64, 0x26, 0x2b, 0x6d, 0x94, 0x0f, 0xde, 0x5c,
-	0xe4, 0xd0, 0xf9, 0x45, 0x0e, 0xfd, 0x75, 0x91, 0x43, 0xdf, 0x5f, 0xe6, 0x62, 0xe7, 0x97, 0xb9,
-	0xd8, 0x1f, 0x97, 0xb9, 0xd8, 0x97, 0x8f, 0xba, 0x36, 0xeb, 0x0d, 0xdb, 0x05, 0x8b, 0x3a, 0xc5,
-	0x7d, 0x9b, 0x78, 0x56, 0xcf, 0x36, 0x8b, 0xc7, 0xe1, 0xe0, 0x91, 0xd7, 0x39, 0x29, 0x7e, 0x1d,
-	0xf9, 0x81, 0x68, 0xa7, 0xf8, 0x1f, 0xc4, 0xc7, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0xab, 0x08,
-	0x5b, 0x3e, 0xaf, 0x0c, 0x00, 0x00,
+	// 1094 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x97, 0xbf, 0x6f, 0xdb, 0x46,
+	0x14, 0xc7, 0x45, 0x49, 0xb6, 0xe4, 0xe7, 0xd4, 0x61, 0xe8, 0x5f, 0x0c, 0x53, 0x2b, 0x02, 0x97,
+	0x1a, 0x41, 0x23, 0x21, 0x6e, 0xba, 0x18, 0xed, 0x20, 0x29, 0x92, 0x41, 0xa4, 0x96, 0x0d, 0x9a,
+	0x1e, 0xdc, 0x45, 0xa0, 0xc8, 0xb3, 0xc4, 0x5a, 0xbc, 0x13, 0xc8, 0x93, 0x52, 0x77, 0xea, 0xd6,
+	0x42, 0x5d, 0x8a, 0x16, 0x45, 0x81, 0x02, 0x5a, 0x9a, 0x0e, 0x01, 0xfa, 0x8f, 0x64, 0x74, 0xb7,
+	0x4c, 0x45, 0x61, 0xff, 0x23, 0x05, 0x8f, 0x3c, 0x99, 0xb2, 0x84, 0x38, 0xae, 0x92, 0x74, 0xbb,
+	0xf7, 0xee, 0xdd, 0xbd, 0xcf, 0xf7, 0xf1, 0x7e, 0x11, 0x36, 0x3a, 0x4d, 0xb7, 0x68, 0x91, 0x4e,
+	0x07, 0x59, 0xd4, 0x21, 0xb8, 0xd8, 0x7f, 0x54, 0x44, 0x7d, 0x84, 0x69, 0xa1, 0xeb, 0x11, 0x4a,
+	0xa4, 0x3b, 0x9d, 0xa6, 0x5b, 0xb8, 0xec, 0x2e, 0xf4, 0x1f, 0x29, 0x2b, 0x2d, 0xd2, 0x22, 0xac,
+	0xb7, 0x18, 0xb4, 0xc2, 0x40, 0x45, 0x9d, 0x9c, 0x27, 0x36, 0x8c, 0xc5, 0xa8, 0xcf, 0x05, 0x58,
+	0xa8, 0x06, 0x93, 0x1f, 0x20, 0x4c, 0xa5, 0xfb, 0xb0, 0x68, 0x11, 0x4c, 0x3d, 0xd3, 0xa2, 0x0d,
+	0xc7, 0x96, 0x85, 0xbc, 0xb0, 0xb9, 0xa0, 0x03, 0x77, 0x69, 0xb6, 0xa4, 0x40, 0x96, 0x74, 0x91,
+	0x67, 0x52, 0xe2, 0xc9, 0x49, 0xd6, 0x3b, 0xb2, 0x25, 0x09, 0xd2, 0xc7, 0x1e, 0x71, 0xe5, 0x14,
+	0xf3, 0xb3, 0xb6, 0xb4, 0x04, 0x49, 0x4a, 0xe4, 0x34, 0xf3, 0x24, 0x29, 0x91, 0x3e, 0x85, 0x79,
+	0xd3, 0x25, 0x3d, 0x4c, 0xe5, 0xb9, 0x7c, 0x6a, 0x73, 0x71, 0x6b, 0xbd, 0x30, 0x21, 0xa6, 0x50,
+	0x21, 0x0e, 0x2e, 0xa7, 0x5f, 0xfe, 0x7d, 0x3f, 0xa1, 0x47, 0xc1, 0x2a, 0x86, 0x75, 0x06, 0x59,
+	0xea, 0xd1, 0x36, 0xf1, 0x9c, 0x6f, 0x90, 0xbd, 0xc7, 0xb3, 0x5e, 0x8b, 0xbc, 0x06, 0xf3, 0x6d,
+	0xd2, 0xb1, 0x11, 0x07, 0x8e, 0xac, 0x31, 0x29, 0xa9, 0x71, 0x29, 0xea, 0x09, 0xac, 0xb0, 0x7c,
+	0x3a, 0xea, 0x93, 0x93, 0x77, 0x9d, 0xec, 0x5b, 0x01, 0xc4, 0x50, 0x5d, 0xb7, 0xeb, 0x91, 0x3e,
+	0xb2, 0xeb, 0x35, 0xe3, 0xfa, 0x4c, 0x2b, 0x30, 0x47, 0x9e, 0xe1, 0x51, 0xa2, 0xd0, 0x08, 0xf2,
+	0x98, 0xd1, 0x2c, 0x3c, 0x0f, 0xb7, 0xa5, 0xbb, 0x90, 0xa5, 0xe4, 0x04, 0xe1, 0x60, 0xbe, 0xf0,
+	0x8b, 0x64, 0x98, 0xad, 0xd9, 0xea, 0x77, 0x02, 0xac, 0xc7, 0x05, 0xd7, 0x6b, 0x46, 0x08, 0x63,
+	0x76, 0xde, 0x33, 0xc9, 0x0f, 0x42, 0x54, 0xfa, 0x8a, 0x87, 0x4c, 0x8a, 0xec, 0x4a, 0x94, 0x47,
+	0x92, 0x21, 0x63, 0x05, 0x2e, 0xe2, 0x45, 0x08, 0xdc, 0xbc, 0x0a, 0x98, 0x9c, 0x00, 0x94, 0x20,
+	0x8d, 0x4d, 0x17, 0xf1, 0x85, 0x19, 0xb4, 0x03, 0x9f, 0x8b, 0xa8, 0x19, 0xa5, 0x67, 0x6d, 0x49,
+	0x84, 0x54, 0xcf, 0x73, 0xe4, 0x39, 0xe6, 0x0a, 0x9a, 0xea, 0x5f, 0x02, 0x2c, 0xc7, 0x69, 0x6a,
+	0x46, 0xa5, 0x63, 0xfa, 0xfe, 0x6c, 0xfb, 0x24, 0xae, 0x3e, 0x35, 0xa6, 0x7e, 0x44, 0x9a, 0x9e,
+	0x42, 0x3a, 0x17, 0x23, 0x55, 0x20, 0x6b, 0x23, 0xcb, 0x71, 0xcd, 0x8e, 0x2f, 0xcf, 0xe7, 0x85,
+	0xcd, 0x39, 0x7d, 0x64, 0x07, 0x7d, 0xae, 0x83, 0xa9, 0xd9, 0xec, 0x20, 0x39, 0x93, 0x17, 0x36,
+	0xb3, 0xfa, 0xc8, 0xde, 0x4e, 0xca, 0x82, 0x7a, 0x76, 0xa5, 0xc2, 0xf5, 0xb7, 0x22, 0x6a, 0x03,
+	0x20, 0x14, 0x45, 0x4f, 0xbb, 0xbc, 0xd2, 0x0b, 0xcc, 0x63, 0x9c, 0x76, 0xd1, 0x1b, 0x0b, 0x7b,
+	0x0c, 0x19, 0x8f, 0x9c, 0x9a, 0x1d, 0x7a, 0xca, 0x74, 0x2d, 0x6e, 0x29, 0x53, 0x0e, 0x08, 0x3d,
+	0x8c, 0xd0, 0x79, 0xa8, 0xfa, 0xbb, 0x00, 0xb7, 0x98, 0xa4, 0x1d, 0xcf, 0xc4, 0x14, 0xd9, 0xd7,
+	0x4b, 0x91, 0x21, 0xd3, 0x62, 0xb1, 0x5c, 0x09, 0x37, 0x2f, 0x7b, 0xb8, 0x0a, 0x6e, 0x4a, 0x9f,
+	0x03, 0x74, 0x91, 0xe7, 0x3a, 0xbe, 0xef, 0x10, 0xcc, 0x94, 0x2c, 0x6d, 0x6d, 0x4c, 0xc1, 0xdb,
+	0x1f, 0x05, 0xe9, 0xb1, 0x01, 0xea, 0x40, 0x80, 0xa5, 0x68, 0x8f, 0x61, 0xd2, 0xc3, 0xd6, 0x8d,
+	0x30, 0x91, 0x9c, 0x7c, 0x1d, 0x4c, 0xea, 0xa6, 0x30, 0xbf, 0x0a, 0xf0, 0x01, 0x83, 0xd9, 0x75,
+	0x30, 0x5b, 0xd7, 0xb3, 0x7d, 0xfd, 0xf0, 0x98, 0x4f, 0x4d, 0x39, 0xe6, 0xd3, 0x37, 0x38, 0xe6,
+	0xd9, 0xf2, 0xfc, 0x99, 0x97, 0x29, 0x24, 0xab, 0xbf, 0x6d, 0xb4, 0xc7, 0x30, 0xcf, 0x96, 0xa5,
+	0x1f, 0xa1, 0xad, 0x4d, 0x41, 0xab, 0xd7, 0x0c, 0x4e, 0x16, 0xc6, 0xaa, 0xbf, 0x08, 0xb0, 0xc8,
+	0xa8, 0xca, 0x3d, 0x0f, 0x23, 0x7b, 0x36, 0xa4, 0x69, 0x17, 0xe5, 0x7f, 0xab, 0x98, 0xfa, 0x93,
+	0x00, 0xab, 0x61, 0xb5, 0x88, 0xed, 0x1c, 0x3b, 0xb1, 0xf3, 0x72, 0x26, 0xc2, 0xcf, 0x20, 0x63,
+	0xb5, 0x4d, 0xdc, 0x42, 0xbe, 0x9c, 0x62, 0x38, 0x1f, 0x4e, 0xc1, 0x29, 0x51, 0xea, 0x39, 0xcd,
+	0x1e, 0x45, 0x11, 0x13, 0x1f, 0xa2, 0x9e, 0xf1, 0xdb, 0x84, 0x43, 0x19, 0x41, 0x11, 0xdf, 0xfd,
+	0x21, 0x13, 0xa3, 0x4e, 0xdf, 0x98, 0x5a, 0xba, 0x07, 0x0b, 0xc1, 0xb4, 0x0d, 0x76, 0x4e, 0x85,
+	0x67, 0x52, 0x36, 0x70, 0xd4, 0x4d, 0x17, 0xa9, 0x2f, 0xf8, 0x1d, 0xcd, 0x25, 0xcd, 0xbc, 0x2e,
+	0x5f, 0x73, 0x0b, 0xcc, 0xa4, 0x43, 0xfd, 0x8d, 0x6f, 0xed, 0x12, 0xa5, 0xa6, 0xd5, 0x9e, 0x75,
+	0xb1, 0x5e, 0xbe, 0x68, 0x52, 0x63, 0x2f, 0x1a, 0x19, 0x32, 0x7e, 0xaf, 0xf9, 0x15, 0xb2, 0x28,
+	0xbf, 0xc2, 0x23, 0x33, 0x18, 0x41, 0x4d, 0xaf, 0x85, 0x68, 0x54, 0xc5, 0xc8, 0x62, 0xbb, 0xfb,
+	0x4f, 0x0e, 0xf7, 0x04, 0xfd, 0x3f, 0x70, 0x1f, 0xc1, 0xed, 0xae, 0x87, 0xfa, 0x0e, 0xe9, 0xf9,
+	0x8d, 0xae, 0xe9, 0x21, 0xcc, 0x29, 0x97, 0xb8, 0x7b, 0x9f, 0x79, 0x19, 0xed, 0x33, 0xb8, 0xc3,
+	0x60, 0xf7, 0x82, 0x17, 0x4d, 0x85, 0xd5, 0xf7, 0x0d, 0x80, 0xe3, 0x5f, 0x36, 0x39, 0x71, 0xbf,
+	0x5f, 0xf7, 0x44, 0x66, 0x89, 0xfb, 0xd1, 0x6a, 0xd3, 0x09, 0xa1, 0xef, 0x31, 0xef, 0x83, 0x57,
+	0x49, 0xb8, 0x35, 0x5a, 0x58, 0x4f, 0xd1, 0xa9, 0xb4, 0x0d, 0x77, 0x4b, 0x86, 0xa1, 0x6b, 0xe5,
+	0x43, 0xa3, 0xda, 0x78, 0x5a, 0x3d, 0x6a, 0x1c, 0xd6, 0x0f, 0xf6, 0xab, 0x15, 0xad, 0xa6, 0x55,
+	0x9f, 0x88, 0x09, 0xe5, 0xde, 0x60, 0x98, 0x5f, 0x8f, 0x0f, 0x38, 0xc4, 0x7e, 0x17, 0x59, 0x6c,
+	0x87, 0x48, 0x1f, 0x83, 0x34, 0x3e, 0xb6, 0x5e, 0xda, 0xad, 0x8a, 0x82, 0xb2, 0x32, 0x18, 0xe6,
+	0xc5, 0xf8, 0xa0, 0x60, 0x87, 0x4d, 0x46, 0xef, 0x56, 0x8d, 0x92, 0x98, 0x9c, 0x8c, 0xde, 0x0d,
+	0xde, 0x09, 0xdb, 0xa0, 0x8c, 0x47, 0x97, 0x4b, 0x07, 0xd5, 0x86, 0xb6, 0xbb, 0xd3, 0x38, 0xd4,
+	0x35, 0x31, 0xab, 0x28, 0x83, 0x61, 0x7e, 0x2d, 0x3e, 0xaa, 0x6c, 0xfa, 0x48, 0x73, 0x5b, 0x87,
+	0xba, 0x26, 0x3d, 0x80, 0x3b, 0x57, 0x34, 0xe9, 0x9a, 0xb8, 0xa2, 0x2c, 0x0f, 0x86, 0xf9, 0xdb,
+	0x63, 0x5a, 0x74, 0x4d, 0xda, 0x82, 0xd5, 0xf1, 0x58, 0x7d, 0xef, 0xa8, 0xf4, 0x85, 0x71, 0x24,
+	0xae, 0x2a, 0xeb, 0x83, 0x61, 0x7e, 0x39, 0x1e, 0x1f, 0x3d, 0x4b, 0x94, 0xec, 0xf7, 0xcf, 0x73,
+	0x89, 0x17, 0x7f, 0xe4, 0x12, 0x6a, 0x3a, 0x9b, 0x12, 0x33, 0x6a, 0x3a, 0xbb, 0x20, 0x2e, 0x97,
+	0x77, 0x5e, 0x9e, 0xe7, 0x84, 0xb3, 0xf3, 0x9c, 0xf0, 0xcf, 0x79, 0x4e, 0xf8, 0xf1, 0x22, 0x97,
+	0x38, 0xbb, 0xc8, 0x25, 0x5e, 0x5d, 0xe4, 0x12, 0x5f, 0x3e, 0x6c, 0x39, 0xb4, 0xdd, 0x6b, 0x16,
+	0x2c, 0xe2, 0x16, 0x6b, 0x0e, 0xf6, 0xad, 0xb6, 0x63, 0x16, 0x8f, 0xa3, 0xc6, 0x43, 0xdf, 0x3e,
+	0x29, 0x7e, 0x1d, 0xfb, 0x6f, 0x6b, 0xce, 0xb3, 0x1f, 0xb7, 0x4f, 0xfe, 0x1d, 0x00, 0x17, 0x8b,
+	0xc1, 0xc8, 0x26, 0x0e, 0x00, 0x00,
 }
 
 func (m *EventSent) Marshal() (dAtA []byte, err error) {
@@ -1714,6 +1887,108 @@ func (m *EventRevokedOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventApprovedNFT) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventApprovedNFT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventApprovedNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Approved) > 0 {
+		i -= len(m.Approved)
+		copy(dAtA[i:], m.Approved)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Approved)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRevokedNFTApproval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRevokedNFTApproval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRevokedNFTApproval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Approved) > 0 {
+		i -= len(m.Approved)
+		copy(dAtA[i:], m.Approved)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Approved)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventCreatedContract) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1865,6 +2140,18 @@ func (m *EventCreatedNFTClass) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Royalty != nil {
+		{
+			size, err := m.Royalty.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Meta) > 0 {
 		i -= len(m.Meta)
 		copy(dAtA[i:], m.Meta)
@@ -2644,6 +2931,56 @@ func (m *EventRevokedOperator) Size() (n int) {
 	return n
 }
 
+func (m *EventApprovedNFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Approved)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRevokedNFTApproval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Approved)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
 func (m *EventCreatedContract) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2734,6 +3071,10 @@ func (m *EventCreatedNFTClass) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvent(uint64(l))
 	}
+	if m.Royalty != nil {
+		l = m.Royalty.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
 	return n
 }
 
@@ -3561,7 +3902,7 @@ func (m *EventRevokedOperator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCreatedContract) Unmarshal(dAtA []byte) error {
+func (m *EventApprovedNFT) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3584,7 +3925,363 @@ func (m *EventCreatedContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCreatedContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventApprovedNFT: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventApprovedNFT: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approved", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approved = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRevokedNFTApproval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRevokedNFTApproval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRevokedNFTApproval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approved", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approved = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCreatedContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreatedContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
 			return fmt.Errorf("proto: EventCreatedContract: illegal tag %d (wire type %d)", fieldNum, wire)
@@ -4209,6 +4906,42 @@ func (m *EventCreatedNFTClass) Unmarshal(dAtA []byte) error {
 			}
 			m.Meta = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Royalty == nil {
+				m.Royalty = &Royalty{}
+			}
+			if err := m.Royalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package collection
 
 import (
 	sdk "github.com/Finschia/finschia-sdk/types"
+	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
 )
 
 type (
@@ -10,4 +11,16 @@ type (
 		NewID(ctx sdk.Context) string
 		HasID(ctx sdk.Context, id string) bool
 	}
+
+	// AccountKeeper defines the account keeper interface contract needed by the
+	// collection module, on simulations.
+	AccountKeeper interface {
+		GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
+	}
+
+	// BankKeeper defines the bank module interface contract needed by the
+	// collection module, on simulations.
+	BankKeeper interface {
+		SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	}
 )
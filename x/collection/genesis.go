@@ -110,6 +110,11 @@ func ValidateGenesis(data GenesisState) error {
 			if err := validateMeta(token.Meta); err != nil {
 				return err
 			}
+			if token.Royalty != nil {
+				if err := token.Royalty.ValidateBasic(); err != nil {
+					return err
+				}
+			}
 		}
 	}
 
@@ -149,6 +154,24 @@ func ValidateGenesis(data GenesisState) error {
 		}
 	}
 
+	for _, contractApprovals := range data.Approvals {
+		if err := ValidateContractID(contractApprovals.ContractId); err != nil {
+			return err
+		}
+
+		if len(contractApprovals.Approvals) == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrap("approvals cannot be empty")
+		}
+		for _, approval := range contractApprovals.Approvals {
+			if err := ValidateTokenID(approval.TokenId); err != nil {
+				return err
+			}
+			if _, err := sdk.AccAddressFromBech32(approval.Approved); err != nil {
+				return err
+			}
+		}
+	}
+
 	for _, contractGrants := range data.Grants {
 		if err := ValidateContractID(contractGrants.ContractId); err != nil {
 			return err
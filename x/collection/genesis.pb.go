@@ -52,6 +52,10 @@ type GenesisState struct {
 	Supplies []ContractStatistics `protobuf:"bytes,11,rep,name=supplies,proto3" json:"supplies"`
 	// burnts represents the total amount of burnt tokens.
 	Burnts []ContractStatistics `protobuf:"bytes,12,rep,name=burnts,proto3" json:"burnts"`
+	// approvals defines the approvals on non-fungible tokens.
+	//
+	// Since: 0.49.0 (finschia)
+	Approvals []ContractNFTApprovals `protobuf:"bytes,13,rep,name=approvals,proto3" json:"approvals"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -172,6 +176,13 @@ func (m *GenesisState) GetBurnts() []ContractStatistics {
 	return nil
 }
 
+func (m *GenesisState) GetApprovals() []ContractNFTApprovals {
+	if m != nil {
+		return m.Approvals
+	}
+	return nil
+}
+
 // ContractBalances defines balances belong to a contract.
 // genesis state.
 type ContractBalances struct {
@@ -549,6 +560,120 @@ func (m *ContractAuthorizations) GetAuthorizations() []Authorization {
 	return nil
 }
 
+// ContractNFTApprovals defines approvals on non-fungible tokens belong to a contract.
+//
+// Since: 0.49.0 (finschia)
+type ContractNFTApprovals struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// approvals
+	Approvals []NFTApproval `protobuf:"bytes,2,rep,name=approvals,proto3" json:"approvals"`
+}
+
+func (m *ContractNFTApprovals) Reset()         { *m = ContractNFTApprovals{} }
+func (m *ContractNFTApprovals) String() string { return proto.CompactTextString(m) }
+func (*ContractNFTApprovals) ProtoMessage()    {}
+func (*ContractNFTApprovals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b8b3f666cffb1ec, []int{8}
+}
+func (m *ContractNFTApprovals) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractNFTApprovals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractNFTApprovals.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractNFTApprovals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractNFTApprovals.Merge(m, src)
+}
+func (m *ContractNFTApprovals) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractNFTApprovals) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractNFTApprovals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractNFTApprovals proto.InternalMessageInfo
+
+func (m *ContractNFTApprovals) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *ContractNFTApprovals) GetApprovals() []NFTApproval {
+	if m != nil {
+		return m.Approvals
+	}
+	return nil
+}
+
+// NFTApproval defines an approval on a non-fungible token.
+//
+// Since: 0.49.0 (finschia)
+type NFTApproval struct {
+	// token id associated with the non-fungible token.
+	TokenId string `protobuf:"bytes,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	// address approved on the token.
+	Approved string `protobuf:"bytes,2,opt,name=approved,proto3" json:"approved,omitempty"`
+}
+
+func (m *NFTApproval) Reset()         { *m = NFTApproval{} }
+func (m *NFTApproval) String() string { return proto.CompactTextString(m) }
+func (*NFTApproval) ProtoMessage()    {}
+func (*NFTApproval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b8b3f666cffb1ec, []int{9}
+}
+func (m *NFTApproval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NFTApproval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NFTApproval.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NFTApproval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NFTApproval.Merge(m, src)
+}
+func (m *NFTApproval) XXX_Size() int {
+	return m.Size()
+}
+func (m *NFTApproval) XXX_DiscardUnknown() {
+	xxx_messageInfo_NFTApproval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NFTApproval proto.InternalMessageInfo
+
+func (m *NFTApproval) GetTokenId() string {
+	if m != nil {
+		return m.TokenId
+	}
+	return ""
+}
+
+func (m *NFTApproval) GetApproved() string {
+	if m != nil {
+		return m.Approved
+	}
+	return ""
+}
+
 // ContractGrant defines grants belong to a contract.
 type ContractGrants struct {
 	// contract id associated with the contract.
@@ -561,7 +686,7 @@ func (m *ContractGrants) Reset()         { *m = ContractGrants{} }
 func (m *ContractGrants) String() string { return proto.CompactTextString(m) }
 func (*ContractGrants) ProtoMessage()    {}
 func (*ContractGrants) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b8b3f666cffb1ec, []int{8}
+	return fileDescriptor_2b8b3f666cffb1ec, []int{10}
 }
 func (m *ContractGrants) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -618,7 +743,7 @@ func (m *NextClassIDs) Reset()         { *m = NextClassIDs{} }
 func (m *NextClassIDs) String() string { return proto.CompactTextString(m) }
 func (*NextClassIDs) ProtoMessage()    {}
 func (*NextClassIDs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b8b3f666cffb1ec, []int{9}
+	return fileDescriptor_2b8b3f666cffb1ec, []int{11}
 }
 func (m *NextClassIDs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -664,7 +789,7 @@ func (m *ContractNextTokenIDs) Reset()         { *m = ContractNextTokenIDs{} }
 func (m *ContractNextTokenIDs) String() string { return proto.CompactTextString(m) }
 func (*ContractNextTokenIDs) ProtoMessage()    {}
 func (*ContractNextTokenIDs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b8b3f666cffb1ec, []int{10}
+	return fileDescriptor_2b8b3f666cffb1ec, []int{12}
 }
 func (m *ContractNextTokenIDs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -719,7 +844,7 @@ func (m *NextTokenID) Reset()         { *m = NextTokenID{} }
 func (m *NextTokenID) String() string { return proto.CompactTextString(m) }
 func (*NextTokenID) ProtoMessage()    {}
 func (*NextTokenID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b8b3f666cffb1ec, []int{11}
+	return fileDescriptor_2b8b3f666cffb1ec, []int{13}
 }
 func (m *NextTokenID) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -769,7 +894,7 @@ func (m *ContractTokenRelations) Reset()         { *m = ContractTokenRelations{}
 func (m *ContractTokenRelations) String() string { return proto.CompactTextString(m) }
 func (*ContractTokenRelations) ProtoMessage()    {}
 func (*ContractTokenRelations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b8b3f666cffb1ec, []int{12}
+	return fileDescriptor_2b8b3f666cffb1ec, []int{14}
 }
 func (m *ContractTokenRelations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -826,7 +951,7 @@ func (m *TokenRelation) Reset()         { *m = TokenRelation{} }
 func (m *TokenRelation) String() string { return proto.CompactTextString(m) }
 func (*TokenRelation) ProtoMessage()    {}
 func (*TokenRelation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b8b3f666cffb1ec, []int{13}
+	return fileDescriptor_2b8b3f666cffb1ec, []int{15}
 }
 func (m *TokenRelation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -878,6 +1003,8 @@ func init() {
 	proto.RegisterType((*ContractClasses)(nil), "lbm.collection.v1.ContractClasses")
 	proto.RegisterType((*ContractNFTs)(nil), "lbm.collection.v1.ContractNFTs")
 	proto.RegisterType((*ContractAuthorizations)(nil), "lbm.collection.v1.ContractAuthorizations")
+	proto.RegisterType((*ContractNFTApprovals)(nil), "lbm.collection.v1.ContractNFTApprovals")
+	proto.RegisterType((*NFTApproval)(nil), "lbm.collection.v1.NFTApproval")
 	proto.RegisterType((*ContractGrants)(nil), "lbm.collection.v1.ContractGrants")
 	proto.RegisterType((*NextClassIDs)(nil), "lbm.collection.v1.NextClassIDs")
 	proto.RegisterType((*ContractNextTokenIDs)(nil), "lbm.collection.v1.ContractNextTokenIDs")
@@ -889,66 +1016,70 @@ func init() {
 func init() { proto.RegisterFile("lbm/collection/v1/genesis.proto", fileDescriptor_2b8b3f666cffb1ec) }
 
 var fileDescriptor_2b8b3f666cffb1ec = []byte{
-	// 940 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0xb3, 0x8e, 0xe3, 0x1f, 0xcf, 0x6e, 0x0a, 0xa3, 0xa8, 0x6c, 0x82, 0x64, 0x87, 0x45,
-	0x88, 0x02, 0xca, 0x2e, 0x4d, 0x05, 0x88, 0x0a, 0xa8, 0x62, 0x97, 0x84, 0x50, 0xa9, 0x02, 0x37,
-	0x80, 0xc4, 0xc5, 0x5a, 0xef, 0x8e, 0x9d, 0x51, 0xd7, 0x33, 0x66, 0x67, 0x1c, 0x25, 0xbd, 0x70,
-	0xe0, 0xc4, 0x8d, 0x3f, 0x81, 0x33, 0x67, 0xfe, 0x88, 0x8a, 0x53, 0x8f, 0xa8, 0x87, 0x82, 0x12,
-	0x21, 0xf1, 0x67, 0xa0, 0xf9, 0xb1, 0x9b, 0xb5, 0xbd, 0xf5, 0xd2, 0xdc, 0x76, 0x77, 0xde, 0xf7,
-	0xf3, 0x7d, 0x33, 0x7e, 0xef, 0x8d, 0xa1, 0x1d, 0x0d, 0xc6, 0x5e, 0xc0, 0xa2, 0x08, 0x07, 0x82,
-	0x30, 0xea, 0x9d, 0xdc, 0xf2, 0x46, 0x98, 0x62, 0x4e, 0xb8, 0x3b, 0x89, 0x99, 0x60, 0xe8, 0xd5,
-	0x68, 0x30, 0x76, 0x2f, 0x03, 0xdc, 0x93, 0x5b, 0x5b, 0x9b, 0x23, 0xc6, 0x46, 0x11, 0xf6, 0x54,
-	0xc0, 0x60, 0x3a, 0xf4, 0x7c, 0x7a, 0xa6, 0xa3, 0xb7, 0x36, 0x46, 0x6c, 0xc4, 0xd4, 0xa3, 0x27,
-	0x9f, 0xcc, 0xd7, 0xcd, 0x80, 0xf1, 0x31, 0xe3, 0x7d, 0xbd, 0xa0, 0x5f, 0xcc, 0x92, 0xb3, 0xe8,
-	0x9f, 0x31, 0x53, 0x31, 0xce, 0x3f, 0x15, 0x68, 0x1e, 0xe8, 0xa4, 0x1e, 0x0a, 0x5f, 0x60, 0xf4,
-	0x11, 0x54, 0x26, 0x7e, 0xec, 0x8f, 0xb9, 0x6d, 0x6d, 0x5b, 0x37, 0x1b, 0xbb, 0x9b, 0xee, 0x42,
-	0x92, 0xee, 0x57, 0x2a, 0xa0, 0x53, 0x7e, 0xf2, 0xbc, 0xbd, 0xd2, 0x33, 0xe1, 0xe8, 0x2e, 0xd4,
-	0x03, 0x46, 0x45, 0xec, 0x07, 0x82, 0xdb, 0xa5, 0xed, 0xd5, 0x9b, 0x8d, 0xdd, 0xd7, 0x73, 0xb4,
-	0x5d, 0x13, 0x63, 0xd4, 0x97, 0x1a, 0x74, 0x1f, 0xd6, 0x29, 0x3e, 0x15, 0xfd, 0x20, 0xf2, 0x39,
-	0xef, 0x93, 0x90, 0xdb, 0xab, 0x8a, 0xd2, 0xce, 0xa1, 0x3c, 0xc0, 0xa7, 0xa2, 0x2b, 0xe3, 0x0e,
-	0xef, 0x25, 0x79, 0x34, 0x69, 0xfa, 0x2d, 0xe4, 0xa8, 0x03, 0x55, 0xc5, 0xc1, 0xdc, 0x2e, 0x2b,
-	0x8a, 0xb3, 0x24, 0x97, 0xae, 0x8e, 0x34, 0xa0, 0x44, 0x88, 0x1e, 0x9a, 0x84, 0x04, 0x7b, 0x84,
-	0xa9, 0x4a, 0x68, 0x4d, 0xa1, 0xde, 0x5e, 0x82, 0x92, 0x89, 0x1d, 0xc9, 0xf8, 0xb9, 0xc4, 0xf4,
-	0xb7, 0x90, 0xa3, 0xcf, 0xa1, 0x36, 0xf0, 0x23, 0x9f, 0x06, 0x98, 0xdb, 0x15, 0x85, 0x7b, 0x73,
-	0xd9, 0x29, 0x99, 0x50, 0x83, 0x4a, 0xa5, 0xe8, 0x63, 0x28, 0xd3, 0xa1, 0xe0, 0x76, 0xf5, 0x85,
-	0x47, 0x94, 0x66, 0xb4, 0x7f, 0x94, 0xc8, 0x95, 0x04, 0xdd, 0x87, 0xea, 0xc4, 0x8f, 0x31, 0x15,
-	0xdc, 0xae, 0x29, 0xf5, 0x3b, 0x4b, 0xd4, 0x2a, 0xef, 0x1e, 0x8e, 0x7c, 0xb9, 0xc0, 0x3b, 0x15,
-	0xc9, 0xb1, 0xad, 0x5e, 0x42, 0x40, 0x77, 0xa1, 0x32, 0x8a, 0x7d, 0xc9, 0xaa, 0x2b, 0xd6, 0x1b,
-	0x4b, 0x58, 0x07, 0x2a, 0x30, 0x29, 0x1b, 0x2d, 0x43, 0xdf, 0xc1, 0xba, 0x3f, 0x15, 0xc7, 0x2c,
-	0x26, 0x8f, 0xb5, 0x87, 0x0d, 0x85, 0x49, 0xed, 0xcd, 0x08, 0x0c, 0x70, 0x0e, 0x83, 0x0e, 0xa0,
-	0xc6, 0xa7, 0x93, 0x49, 0x44, 0x30, 0xb7, 0x1b, 0x0a, 0xf9, 0xd6, 0x12, 0xa4, 0x2c, 0x7e, 0xc2,
-	0x05, 0x09, 0xd2, 0xa3, 0x4e, 0xc4, 0xa8, 0x0b, 0x95, 0xc1, 0x34, 0x96, 0x5b, 0x6c, 0xbe, 0x3c,
-	0xc6, 0x48, 0x9d, 0x1f, 0xe0, 0x95, 0xf9, 0xdf, 0x14, 0xb5, 0xa1, 0x91, 0x54, 0x7f, 0x9f, 0x84,
-	0xaa, 0xdf, 0xea, 0x3d, 0x48, 0x3e, 0x1d, 0x86, 0xe8, 0x93, 0x4c, 0xad, 0xe8, 0x8e, 0xda, 0xca,
-	0xf1, 0x36, 0xbc, 0xf9, 0x12, 0x71, 0x7e, 0x04, 0xb4, 0x98, 0x56, 0xb1, 0xe9, 0x17, 0x00, 0x3c,
-	0x0d, 0x37, 0xb6, 0xb9, 0xcd, 0x23, 0xbb, 0x64, 0x61, 0xbf, 0x19, 0xad, 0x73, 0x0a, 0xd7, 0xe7,
-	0x82, 0xd0, 0x26, 0xd4, 0x92, 0xf6, 0x36, 0xd6, 0xba, 0xdb, 0x0e, 0x43, 0xf4, 0x25, 0x54, 0xfc,
-	0x31, 0x9b, 0x52, 0x61, 0x97, 0xe4, 0x42, 0x67, 0x57, 0xf2, 0x9e, 0x3d, 0x6f, 0xbf, 0x3b, 0x22,
-	0xe2, 0x78, 0x3a, 0x70, 0x03, 0x36, 0xf6, 0xf6, 0x09, 0xe5, 0xc1, 0x31, 0xf1, 0xbd, 0xa1, 0x79,
-	0xd8, 0xe1, 0xe1, 0x23, 0x4f, 0x9c, 0x4d, 0x30, 0x77, 0x0f, 0xa9, 0xe8, 0x19, 0x82, 0x43, 0xa0,
-	0x6a, 0x4e, 0x05, 0xd9, 0x50, 0xf5, 0xc3, 0x30, 0xc6, 0x9c, 0x27, 0x86, 0xe6, 0x15, 0x7d, 0x96,
-	0x31, 0x94, 0x9b, 0x7c, 0x2d, 0xf7, 0x77, 0x25, 0xb4, 0x73, 0x4d, 0x66, 0xf2, 0xdb, 0x5f, 0xed,
-	0x35, 0xf9, 0xc6, 0x13, 0x93, 0x3b, 0xe5, 0x7f, 0x7f, 0x6d, 0x5b, 0xce, 0x09, 0x5c, 0x9f, 0x1b,
-	0x23, 0xc5, 0x47, 0x9c, 0x19, 0x4e, 0xda, 0x7a, 0xc3, 0xd5, 0x63, 0xdf, 0x4d, 0xc6, 0xbe, 0xbb,
-	0x47, 0xcf, 0x3a, 0x48, 0xfa, 0xfe, 0xf1, 0xfb, 0x0e, 0xa8, 0x26, 0x54, 0xf4, 0x74, 0x38, 0x39,
-	0x3e, 0x34, 0xb3, 0x1d, 0x5e, 0x6c, 0xfa, 0xbe, 0x99, 0x18, 0xda, 0xf1, 0x46, 0xde, 0x50, 0xdd,
-	0x3f, 0xca, 0x0e, 0x0a, 0xe7, 0x67, 0x0b, 0x6e, 0xe4, 0xb7, 0x5c, 0xb1, 0xdb, 0x83, 0x85, 0xb6,
-	0xd6, 0xbe, 0xdb, 0x39, 0xbe, 0x33, 0xec, 0xfc, 0x6e, 0x76, 0x08, 0xac, 0xcf, 0x8e, 0x91, 0xe2,
-	0x14, 0x3e, 0x4c, 0x47, 0x93, 0xb6, 0xb6, 0x73, 0xac, 0x15, 0x6b, 0x76, 0x22, 0x39, 0xcf, 0x2c,
-	0x68, 0x66, 0xef, 0x97, 0x62, 0xa7, 0xaf, 0xa1, 0x36, 0x9c, 0xd2, 0x11, 0x19, 0x44, 0xd8, 0x14,
-	0xef, 0x07, 0xa6, 0x78, 0xdf, 0xfb, 0x9f, 0xc5, 0xfb, 0x0d, 0xa1, 0xc2, 0xb6, 0x7a, 0x29, 0x06,
-	0x7d, 0x0b, 0x4d, 0xca, 0x68, 0x3f, 0xc5, 0xae, 0x2a, 0xec, 0xed, 0x2b, 0x60, 0x7b, 0x0d, 0xca,
-	0xe8, 0xbe, 0xe1, 0x38, 0x8f, 0x61, 0x23, 0xef, 0xaa, 0x2a, 0xde, 0xe3, 0x1e, 0xd4, 0x2f, 0xef,
-	0x41, 0x7d, 0xa0, 0xad, 0x17, 0x5c, 0xcc, 0x06, 0x9a, 0x0c, 0x24, 0x61, 0xae, 0x3e, 0x67, 0x0c,
-	0x8d, 0xcc, 0xf2, 0xb2, 0x59, 0xd0, 0x85, 0x12, 0x09, 0xcd, 0x51, 0x5e, 0x69, 0xcf, 0x25, 0x12,
-	0x3a, 0x3f, 0x65, 0xca, 0x77, 0xf6, 0x1a, 0x2b, 0xde, 0xed, 0x3d, 0xa8, 0xc7, 0x49, 0xf4, 0x92,
-	0xca, 0x9d, 0xc1, 0x26, 0xff, 0x68, 0x52, 0xe1, 0x9d, 0x92, 0x6d, 0x39, 0x9f, 0xc2, 0xb5, 0x99,
-	0x28, 0x84, 0xa0, 0xcc, 0x71, 0x34, 0x34, 0xa6, 0xea, 0x19, 0x6d, 0xc0, 0x1a, 0x13, 0xc7, 0x38,
-	0xd6, 0x5b, 0xee, 0xe9, 0x17, 0x29, 0xef, 0x1c, 0x3c, 0x39, 0x6f, 0x59, 0x4f, 0xcf, 0x5b, 0xd6,
-	0xdf, 0xe7, 0x2d, 0xeb, 0x97, 0x8b, 0xd6, 0xca, 0xd3, 0x8b, 0xd6, 0xca, 0x9f, 0x17, 0xad, 0x95,
-	0xef, 0x77, 0x0a, 0xcf, 0xe3, 0x34, 0xf3, 0x77, 0x6f, 0x50, 0x51, 0xa3, 0xe5, 0xf6, 0x7f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xa8, 0x3f, 0x52, 0xda, 0x95, 0x0a, 0x00, 0x00,
+	// 1002 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4d, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xcf, 0x3a, 0x8e, 0x5f, 0x1e, 0x3b, 0xe9, 0xff, 0x3f, 0x8a, 0xca, 0x26, 0x48, 0x76, 0x58,
+	0x84, 0x28, 0xa0, 0xac, 0x69, 0x2a, 0x40, 0x54, 0x40, 0x15, 0x3b, 0x38, 0x84, 0x48, 0x15, 0xb8,
+	0x01, 0x24, 0x2e, 0xd6, 0x7a, 0x77, 0xec, 0xac, 0xba, 0x9e, 0x31, 0x3b, 0xe3, 0x28, 0x29, 0x07,
+	0x0e, 0x9c, 0xb8, 0xf1, 0x11, 0x38, 0x73, 0x44, 0x7c, 0x88, 0x8a, 0x53, 0x8f, 0xa8, 0x87, 0x82,
+	0x92, 0x0b, 0x1f, 0x03, 0xcd, 0xdb, 0x66, 0x6d, 0x6f, 0xbd, 0xa5, 0xb7, 0xdd, 0x9d, 0xe7, 0xf7,
+	0x32, 0xe3, 0xe7, 0xf7, 0x8c, 0xa1, 0x19, 0x0d, 0xc6, 0x2d, 0x9f, 0x46, 0x11, 0xf6, 0x79, 0x48,
+	0x49, 0xeb, 0xec, 0x76, 0x6b, 0x84, 0x09, 0x66, 0x21, 0x73, 0x27, 0x31, 0xe5, 0x14, 0xfd, 0x3f,
+	0x1a, 0x8c, 0xdd, 0xeb, 0x02, 0xf7, 0xec, 0xf6, 0xf6, 0xd6, 0x88, 0xd2, 0x51, 0x84, 0x5b, 0xb2,
+	0x60, 0x30, 0x1d, 0xb6, 0x3c, 0x72, 0xa1, 0xaa, 0xb7, 0x37, 0x47, 0x74, 0x44, 0xe5, 0x63, 0x4b,
+	0x3c, 0xe9, 0xaf, 0x5b, 0x3e, 0x65, 0x63, 0xca, 0xfa, 0x6a, 0x41, 0xbd, 0xe8, 0x25, 0x67, 0x51,
+	0x3f, 0x25, 0x26, 0x6b, 0x9c, 0xdf, 0xca, 0x50, 0x3f, 0x54, 0xa6, 0x1e, 0x70, 0x8f, 0x63, 0xf4,
+	0x01, 0x94, 0x26, 0x5e, 0xec, 0x8d, 0x99, 0x6d, 0xed, 0x58, 0xb7, 0x6a, 0x7b, 0x5b, 0xee, 0x82,
+	0x49, 0xf7, 0x0b, 0x59, 0xd0, 0x2e, 0x3e, 0x7e, 0xd6, 0x5c, 0xe9, 0xe9, 0x72, 0x74, 0x0f, 0xaa,
+	0x3e, 0x25, 0x3c, 0xf6, 0x7c, 0xce, 0xec, 0xc2, 0xce, 0xea, 0xad, 0xda, 0xde, 0xab, 0x19, 0xd8,
+	0x8e, 0xae, 0xd1, 0xe8, 0x6b, 0x0c, 0x3a, 0x86, 0x0d, 0x82, 0xcf, 0x79, 0xdf, 0x8f, 0x3c, 0xc6,
+	0xfa, 0x61, 0xc0, 0xec, 0x55, 0xc9, 0xd2, 0xcc, 0x60, 0xb9, 0x8f, 0xcf, 0x79, 0x47, 0xd4, 0x1d,
+	0x1d, 0x18, 0x1f, 0x75, 0x92, 0x7c, 0x0b, 0x18, 0x6a, 0x43, 0x59, 0xf2, 0x60, 0x66, 0x17, 0x25,
+	0x8b, 0xb3, 0xc4, 0x4b, 0x47, 0x55, 0x6a, 0x22, 0x03, 0x44, 0x0f, 0xb4, 0x21, 0x4e, 0x1f, 0x62,
+	0x22, 0x0d, 0xad, 0x49, 0xaa, 0x37, 0x97, 0x50, 0x09, 0x63, 0x27, 0xa2, 0x7e, 0xce, 0x98, 0xfa,
+	0x16, 0x30, 0xf4, 0x29, 0x54, 0x06, 0x5e, 0xe4, 0x11, 0x1f, 0x33, 0xbb, 0x24, 0xe9, 0x5e, 0x5f,
+	0x76, 0x4a, 0xba, 0x54, 0x53, 0x25, 0x50, 0xf4, 0x21, 0x14, 0xc9, 0x90, 0x33, 0xbb, 0xfc, 0xdc,
+	0x23, 0x4a, 0x1c, 0x75, 0x4f, 0x0c, 0x5c, 0x42, 0xd0, 0x31, 0x94, 0x27, 0x5e, 0x8c, 0x09, 0x67,
+	0x76, 0x45, 0xa2, 0xdf, 0x5a, 0x82, 0x96, 0xbe, 0x7b, 0x38, 0xf2, 0xc4, 0x02, 0x6b, 0x97, 0x04,
+	0x8f, 0x6d, 0xf5, 0x0c, 0x03, 0xba, 0x07, 0xa5, 0x51, 0xec, 0x09, 0xae, 0xaa, 0xe4, 0x7a, 0x6d,
+	0x09, 0xd7, 0xa1, 0x2c, 0x34, 0x6d, 0xa3, 0x60, 0xe8, 0x1b, 0xd8, 0xf0, 0xa6, 0xfc, 0x94, 0xc6,
+	0xe1, 0x23, 0xa5, 0x61, 0x43, 0xae, 0xa9, 0xfd, 0x19, 0x80, 0x26, 0x9c, 0xa3, 0x41, 0x87, 0x50,
+	0x61, 0xd3, 0xc9, 0x24, 0x0a, 0x31, 0xb3, 0x6b, 0x92, 0xf2, 0x8d, 0x25, 0x94, 0xa2, 0xf9, 0x43,
+	0xc6, 0x43, 0x3f, 0x39, 0x6a, 0x03, 0x46, 0x1d, 0x28, 0x0d, 0xa6, 0xb1, 0xd8, 0x62, 0xfd, 0xbf,
+	0xd3, 0x68, 0x28, 0x3a, 0x86, 0xaa, 0x37, 0x99, 0xc4, 0xf4, 0xcc, 0x8b, 0x98, 0xbd, 0x9e, 0xdf,
+	0x46, 0xdd, 0x93, 0x7d, 0x53, 0x6e, 0x92, 0x92, 0xe0, 0x9d, 0xef, 0xe0, 0x7f, 0xf3, 0x0d, 0x82,
+	0x9a, 0x50, 0x33, 0x51, 0xea, 0x87, 0x81, 0x0c, 0x6f, 0xb5, 0x07, 0xe6, 0xd3, 0x51, 0x80, 0x3e,
+	0x4a, 0x35, 0x9e, 0x8a, 0xe7, 0x76, 0x86, 0x01, 0xcd, 0x37, 0xdf, 0x6f, 0xce, 0x0f, 0x80, 0x16,
+	0xf7, 0x98, 0x2f, 0xfa, 0x19, 0x00, 0x4b, 0xca, 0xed, 0xc2, 0xf3, 0x93, 0x28, 0x22, 0xb7, 0x70,
+	0x78, 0x29, 0xac, 0x73, 0x0e, 0x37, 0xe6, 0x8a, 0xd0, 0x16, 0x54, 0xcc, 0xac, 0xd0, 0xd2, 0x2a,
+	0xba, 0x47, 0x01, 0xfa, 0x1c, 0x4a, 0xde, 0x98, 0x4e, 0x09, 0xb7, 0x0b, 0x62, 0xa1, 0xbd, 0x27,
+	0xf8, 0x9e, 0x3e, 0x6b, 0xbe, 0x3d, 0x0a, 0xf9, 0xe9, 0x74, 0xe0, 0xfa, 0x74, 0xdc, 0xea, 0x86,
+	0x84, 0xf9, 0xa7, 0xa1, 0xd7, 0x1a, 0xea, 0x87, 0x5d, 0x16, 0x3c, 0x6c, 0xf1, 0x8b, 0x09, 0x66,
+	0xee, 0x11, 0xe1, 0x3d, 0xcd, 0xe0, 0x84, 0x50, 0xd6, 0xa7, 0x82, 0x6c, 0x28, 0x7b, 0x41, 0x10,
+	0x63, 0xc6, 0x8c, 0xa0, 0x7e, 0x45, 0x9f, 0xa4, 0x04, 0xc5, 0x26, 0x5f, 0xc9, 0xfc, 0x71, 0x43,
+	0xd2, 0x5e, 0x17, 0x4e, 0x7e, 0xfd, 0xab, 0xb9, 0x26, 0xde, 0x98, 0x11, 0xb9, 0x5b, 0xfc, 0xe7,
+	0x97, 0xa6, 0xe5, 0x9c, 0xc1, 0x8d, 0xb9, 0x99, 0x94, 0x7f, 0xc4, 0xa9, 0x49, 0xa7, 0xa4, 0x37,
+	0x5d, 0x75, 0x87, 0xb8, 0xe6, 0x0e, 0x71, 0xf7, 0xc9, 0x45, 0x1b, 0x09, 0xdd, 0x3f, 0x7e, 0xdf,
+	0x05, 0x99, 0x68, 0xc9, 0x9e, 0x4c, 0x3a, 0xc7, 0x83, 0x7a, 0x7a, 0x5c, 0xe4, 0x8b, 0xbe, 0xab,
+	0xc7, 0x8f, 0x52, 0xbc, 0x99, 0x35, 0xa1, 0xbb, 0x27, 0xe9, 0xa9, 0xe3, 0xfc, 0x64, 0xc1, 0xcd,
+	0xec, 0xfc, 0xe6, 0xab, 0xdd, 0x5f, 0x98, 0x11, 0x4a, 0x77, 0x27, 0x43, 0x77, 0x86, 0x3b, 0x7b,
+	0x34, 0x38, 0xdf, 0xc3, 0x66, 0x56, 0xd0, 0x5e, 0xe4, 0xac, 0x53, 0x29, 0x56, 0x1e, 0x1a, 0xd9,
+	0x7b, 0x37, 0xa4, 0x8b, 0xe1, 0x3d, 0x80, 0x5a, 0x6a, 0x5d, 0x34, 0xb1, 0xb9, 0x5f, 0x4c, 0x4f,
+	0x71, 0x75, 0x57, 0xa0, 0x6d, 0xa8, 0x28, 0x18, 0x0e, 0x54, 0x1b, 0xf7, 0x92, 0x77, 0x27, 0x84,
+	0x8d, 0xd9, 0xb1, 0x9a, 0x6f, 0xfe, 0xfd, 0x64, 0x54, 0x2b, 0xe7, 0x76, 0x86, 0x73, 0xc9, 0x35,
+	0x3b, 0xa1, 0x9d, 0xa7, 0x16, 0xd4, 0xd3, 0xf7, 0x6d, 0xbe, 0xd2, 0x97, 0x50, 0x19, 0x4e, 0xc9,
+	0x28, 0x1c, 0x44, 0x58, 0xe7, 0xef, 0x3d, 0x9d, 0xbf, 0x77, 0x5e, 0x30, 0x7f, 0x5f, 0x85, 0x84,
+	0xdb, 0x56, 0x2f, 0xa1, 0x41, 0x5f, 0x43, 0x9d, 0x50, 0xd2, 0x4f, 0x68, 0x57, 0x25, 0xed, 0x9d,
+	0x97, 0xa0, 0xed, 0xd5, 0x08, 0x25, 0x5d, 0xcd, 0xe3, 0x3c, 0x4a, 0xb5, 0x42, 0xea, 0xea, 0xce,
+	0xdf, 0xe3, 0x3e, 0x54, 0xaf, 0xff, 0x17, 0x2c, 0x69, 0x85, 0x6b, 0x52, 0x33, 0x53, 0xf5, 0xcf,
+	0xcb, 0x9c, 0x31, 0xd4, 0x52, 0xcb, 0xcb, 0xc6, 0x59, 0x07, 0x0a, 0xa1, 0xee, 0x81, 0x97, 0xdb,
+	0x73, 0x21, 0x0c, 0x9c, 0x1f, 0x53, 0x09, 0x9c, 0xbd, 0xd6, 0xf3, 0x77, 0x7b, 0x00, 0xd5, 0xd8,
+	0x54, 0x2f, 0x09, 0xdf, 0x0c, 0xad, 0x69, 0xfd, 0x04, 0x78, 0xb7, 0x60, 0x5b, 0xce, 0xc7, 0xb0,
+	0x3e, 0x53, 0x85, 0x10, 0x14, 0x19, 0x8e, 0x86, 0x5a, 0x54, 0x3e, 0xa3, 0x4d, 0x58, 0xa3, 0xfc,
+	0x14, 0xc7, 0xba, 0xed, 0xd5, 0x8b, 0x80, 0xb7, 0x0f, 0x1f, 0x5f, 0x36, 0xac, 0x27, 0x97, 0x0d,
+	0xeb, 0xef, 0xcb, 0x86, 0xf5, 0xf3, 0x55, 0x63, 0xe5, 0xc9, 0x55, 0x63, 0xe5, 0xcf, 0xab, 0xc6,
+	0xca, 0xb7, 0xbb, 0xb9, 0xe7, 0x71, 0x9e, 0xfa, 0xfb, 0x3b, 0x28, 0xc9, 0xe9, 0x78, 0xe7, 0xdf,
+	0x01, 0x00, 0xd5, 0xc9, 0xce, 0xd8, 0xa5, 0x0b, 0x00, 0x00,
 }
 
 func (this *Balance) Equal(that interface{}) bool {
@@ -1003,6 +1134,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Approvals) > 0 {
+		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Approvals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
 	if len(m.Burnts) > 0 {
 		for iNdEx := len(m.Burnts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1474,6 +1619,87 @@ func (m *ContractAuthorizations) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ContractNFTApprovals) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractNFTApprovals) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractNFTApprovals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Approvals) > 0 {
+		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Approvals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NFTApproval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NFTApproval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NFTApproval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Approved) > 0 {
+		i -= len(m.Approved)
+		copy(dAtA[i:], m.Approved)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Approved)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ContractGrants) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1818,6 +2044,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Approvals) > 0 {
+		for _, e := range m.Approvals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1950,6 +2182,42 @@ func (m *ContractAuthorizations) Size() (n int) {
 	return n
 }
 
+func (m *ContractNFTApprovals) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Approvals) > 0 {
+		for _, e := range m.Approvals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NFTApproval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Approved)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
 func (m *ContractGrants) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2498,6 +2766,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approvals = append(m.Approvals, ContractNFTApprovals{})
+			if err := m.Approvals[len(m.Approvals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3331,6 +3633,236 @@ func (m *ContractAuthorizations) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ContractNFTApprovals) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractNFTApprovals: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractNFTApprovals: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approvals = append(m.Approvals, NFTApproval{})
+			if err := m.Approvals[len(m.Approvals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NFTApproval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NFTApproval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NFTApproval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approved", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approved = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ContractGrants) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
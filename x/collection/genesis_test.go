@@ -238,6 +238,21 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			false,
 		},
+		"contract nfts of invalid royalty": {
+			&collection.GenesisState{
+				Nfts: []collection.ContractNFTs{{
+					ContractId: "deadbeef",
+					Nfts: []collection.NFT{{
+						TokenId: collection.NewNFTID("deadbeef", 1),
+						Name:    "tibetian fox",
+						Royalty: &collection.Royalty{
+							Recipient: addr.String(),
+						},
+					}},
+				}},
+			},
+			false,
+		},
 		"contract parents of invalid contract id": {
 			&collection.GenesisState{
 				Parents: []collection.ContractTokenRelations{{
@@ -320,6 +335,47 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			false,
 		},
+		"contract approvals of invalid contract id": {
+			&collection.GenesisState{
+				Approvals: []collection.ContractNFTApprovals{{
+					Approvals: []collection.NFTApproval{{
+						TokenId:  collection.NewNFTID("deadbeef", 1),
+						Approved: addr.String(),
+					}},
+				}},
+			},
+			false,
+		},
+		"contract approvals of empty approvals": {
+			&collection.GenesisState{
+				Approvals: []collection.ContractNFTApprovals{{
+					ContractId: "deadbeef",
+				}},
+			},
+			false,
+		},
+		"contract approvals of invalid token id": {
+			&collection.GenesisState{
+				Approvals: []collection.ContractNFTApprovals{{
+					ContractId: "deadbeef",
+					Approvals: []collection.NFTApproval{{
+						Approved: addr.String(),
+					}},
+				}},
+			},
+			false,
+		},
+		"contract approvals of invalid approved": {
+			&collection.GenesisState{
+				Approvals: []collection.ContractNFTApprovals{{
+					ContractId: "deadbeef",
+					Approvals: []collection.NFTApproval{{
+						TokenId: collection.NewNFTID("deadbeef", 1),
+					}},
+				}},
+			},
+			false,
+		},
 		"contract grants of invalid contract id": {
 			&collection.GenesisState{
 				Grants: []collection.ContractGrants{{
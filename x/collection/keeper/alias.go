@@ -39,7 +39,7 @@ func (k Keeper) iterateBalancesImpl(ctx sdk.Context, prefix []byte, fn func(cont
 func (k Keeper) iterateContracts(ctx sdk.Context, fn func(contract collection.Contract) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
 
-	iterator := sdk.KVStorePrefixIterator(store, contractKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, ContractKeyPrefix)
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
@@ -152,6 +152,35 @@ func (k Keeper) iterateNFTsImpl(ctx sdk.Context, prefix []byte, fn func(contract
 	}
 }
 
+func (k Keeper) iterateContractApprovals(ctx sdk.Context, contractID string, fn func(approval collection.NFTApproval) (stop bool)) {
+	k.iterateApprovalsImpl(ctx, approvalKeyPrefixByContractID(contractID), func(_ string, approval collection.NFTApproval) (stop bool) {
+		return fn(approval)
+	})
+}
+
+func (k Keeper) iterateApprovalsImpl(ctx sdk.Context, prefix []byte, fn func(contractID string, approval collection.NFTApproval) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		contractID, tokenID := splitApprovalKey(iter.Key())
+
+		var approved sdk.AccAddress
+		if err := approved.Unmarshal(iter.Value()); err != nil {
+			panic(err)
+		}
+		approval := collection.NFTApproval{
+			TokenId:  tokenID,
+			Approved: approved.String(),
+		}
+
+		if fn(contractID, approval) {
+			break
+		}
+	}
+}
+
 func (k Keeper) iterateContractParents(ctx sdk.Context, contractID string, fn func(tokenID, parentID string) (stop bool)) {
 	k.iterateParentsImpl(ctx, parentKeyPrefixByContractID(contractID), func(_, tokenID, parentID string) (stop bool) {
 		return fn(tokenID, parentID)
@@ -175,6 +204,12 @@ func (k Keeper) iterateParentsImpl(ctx sdk.Context, prefix []byte, fn func(contr
 	}
 }
 
+func (k Keeper) iterateContractChildren(ctx sdk.Context, contractID string, fn func(tokenID, childID string) (stop bool)) {
+	k.iterateChildrenImpl(ctx, childKeyPrefixByContractID(contractID), func(_, tokenID, childID string) (stop bool) {
+		return fn(tokenID, childID)
+	})
+}
+
 func (k Keeper) iterateChildrenImpl(ctx sdk.Context, prefix []byte, fn func(contractID, tokenID, childID string) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
 	iter := sdk.KVStorePrefixIterator(store, prefix)
@@ -211,13 +246,13 @@ func (k Keeper) iterateStatisticsImpl(ctx sdk.Context, prefix []byte, fn func(co
 }
 
 func (k Keeper) iterateContractSupplies(ctx sdk.Context, contractID string, fn func(classID string, amount sdk.Int) (stop bool)) {
-	k.iterateStatisticsImpl(ctx, statisticKeyPrefixByContractID(supplyKeyPrefix, contractID), func(_, classID string, amount sdk.Int) (stop bool) {
+	k.iterateStatisticsImpl(ctx, statisticKeyPrefixByContractID(SupplyKeyPrefix, contractID), func(_, classID string, amount sdk.Int) (stop bool) {
 		return fn(classID, amount)
 	})
 }
 
 func (k Keeper) iterateContractBurnts(ctx sdk.Context, contractID string, fn func(classID string, amount sdk.Int) (stop bool)) {
-	k.iterateStatisticsImpl(ctx, statisticKeyPrefixByContractID(burntKeyPrefix, contractID), func(_, classID string, amount sdk.Int) (stop bool) {
+	k.iterateStatisticsImpl(ctx, statisticKeyPrefixByContractID(BurntKeyPrefix, contractID), func(_, classID string, amount sdk.Int) (stop bool) {
 		return fn(classID, amount)
 	})
 }
@@ -226,7 +261,7 @@ func (k Keeper) iterateContractBurnts(ctx sdk.Context, contractID string, fn fun
 func (k Keeper) iterateNextTokenClassIDs(ctx sdk.Context, fn func(class collection.NextClassIDs) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
 
-	iterator := sdk.KVStorePrefixIterator(store, nextClassIDKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, NextClassIDKeyPrefix)
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
@@ -150,6 +150,18 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *collection.GenesisState) {
 		reporter.Tick()
 	}
 
+	// the children are indexed by the owners of their roots, which are known
+	// only after all the relations have been imported
+	store := ctx.KVStore(k.storeKey)
+	for _, contractParents := range data.Parents {
+		contractID := contractParents.ContractId
+
+		for _, relation := range contractParents.Relations {
+			owner := k.GetRootOwner(ctx, contractID, relation.Self)
+			store.Set(nftByOwnerKey(owner, contractID, relation.Self), []byte{})
+		}
+	}
+
 	reporter = newProgressReporter(k.Logger(ctx), "import authorizations", len(data.Authorizations))
 	for _, contractAuthorizations := range data.Authorizations {
 		for _, authorization := range contractAuthorizations.Authorizations {
@@ -167,6 +179,19 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *collection.GenesisState) {
 		reporter.Tick()
 	}
 
+	reporter = newProgressReporter(k.Logger(ctx), "import approvals", len(data.Approvals))
+	for _, contractApprovals := range data.Approvals {
+		for _, approval := range contractApprovals.Approvals {
+			approvedAddr, err := sdk.AccAddressFromBech32(approval.Approved)
+			if err != nil {
+				panic(err)
+			}
+			k.setApproval(ctx, contractApprovals.ContractId, approval.TokenId, approvedAddr)
+		}
+
+		reporter.Tick()
+	}
+
 	reporter = newProgressReporter(k.Logger(ctx), "import grants", len(data.Grants))
 	for _, contractGrants := range data.Grants {
 		for _, grant := range contractGrants.Grants {
@@ -221,6 +246,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *collection.GenesisState {
 		Parents:        k.getParents(ctx, contracts),
 		Grants:         k.getGrants(ctx, contracts),
 		Authorizations: k.getAuthorizations(ctx, contracts),
+		Approvals:      k.getApprovals(ctx, contracts),
 		Supplies:       k.getSupplies(ctx, contracts),
 		Burnts:         k.getBurnts(ctx, contracts),
 	}
@@ -391,6 +417,26 @@ func (k Keeper) getAuthorizations(ctx sdk.Context, contracts []collection.Contra
 	return authorizations
 }
 
+func (k Keeper) getApprovals(ctx sdk.Context, contracts []collection.Contract) []collection.ContractNFTApprovals {
+	var approvals []collection.ContractNFTApprovals
+	for _, contract := range contracts {
+		contractID := contract.Id
+		contractApprovals := collection.ContractNFTApprovals{
+			ContractId: contractID,
+		}
+
+		k.iterateContractApprovals(ctx, contractID, func(approval collection.NFTApproval) (stop bool) {
+			contractApprovals.Approvals = append(contractApprovals.Approvals, approval)
+			return false
+		})
+		if len(contractApprovals.Approvals) != 0 {
+			approvals = append(approvals, contractApprovals)
+		}
+	}
+
+	return approvals
+}
+
 func (k Keeper) getGrants(ctx sdk.Context, contracts []collection.Contract) []collection.ContractGrants {
 	var grants []collection.ContractGrants
 	for _, contract := range contracts {
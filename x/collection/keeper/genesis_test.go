@@ -1,16 +1,26 @@
 package keeper_test
 
 import (
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/Finschia/finschia-sdk/simapp"
+	sdk "github.com/Finschia/finschia-sdk/types"
 	"github.com/Finschia/finschia-sdk/x/collection"
+	"github.com/Finschia/finschia-sdk/x/collection/keeper"
 )
 
 func (s *KeeperTestSuite) TestImportExportGenesis() {
+	// approve a nft to export the approval
+	err := s.keeper.ApproveNFT(s.ctx, s.contractID, s.customer, s.stranger, collection.NewNFTID(s.nftClassID, 1))
+	s.Require().NoError(err)
+
 	// export
 	genesis := s.keeper.ExportGenesis(s.ctx)
+	s.Require().NotEmpty(genesis.Approvals)
 
 	// forge
 	amount := collection.NewCoins(collection.NewFTCoin(s.ftClassID, s.balance))
-	err := s.keeper.SendCoins(s.ctx, s.contractID, s.vendor, s.customer, amount)
+	err = s.keeper.SendCoins(s.ctx, s.contractID, s.vendor, s.customer, amount)
 	s.Require().NoError(err)
 
 	err = s.keeper.SendCoins(s.ctx, s.contractID, s.customer, s.operator, amount)
@@ -29,6 +39,23 @@ func (s *KeeperTestSuite) TestImportExportGenesis() {
 	s.Require().Equal(genesis, newGenesis)
 }
 
+func (s *KeeperTestSuite) TestInitGenesisNFTsByOwner() {
+	genesis := s.keeper.ExportGenesis(s.ctx)
+
+	// import into a fresh chain
+	checkTx := false
+	app := simapp.Setup(checkTx)
+	ctx := app.BaseApp.NewContext(checkTx, tmproto.Header{})
+	app.CollectionKeeper.InitGenesis(ctx, genesis)
+
+	// the attached tokens are indexed by the owners of their roots
+	res, err := keeper.NewQueryServer(app.CollectionKeeper).NFTsByOwner(sdk.WrapSDKContext(ctx), &collection.QueryNFTsByOwnerRequest{
+		Owner: s.customer.String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Tokens, s.numNFTs)
+}
+
 func (s *KeeperTestSuite) TestExportGenesis() {
 	genesis := s.keeper.ExportGenesis(s.ctx)
 
@@ -622,3 +622,118 @@ func (s queryServer) HoldersByOperator(c context.Context, req *collection.QueryH
 
 	return &collection.QueryHoldersByOperatorResponse{Holders: holders, Pagination: pageRes}, nil
 }
+
+func (s queryServer) NFTsByOwner(c context.Context, req *collection.QueryNFTsByOwnerRequest) (*collection.QueryNFTsByOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	owner, err := s.addressFromBech32GRPC(req.Owner, "owner")
+	if err != nil {
+		return nil, err
+	}
+
+	keyPrefix := nftByOwnerKeyPrefixByOwner(owner)
+	if len(req.ContractId) != 0 {
+		if err := collection.ValidateContractID(req.ContractId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		keyPrefix = nftByOwnerKeyPrefixByContractID(owner, req.ContractId)
+	}
+
+	if len(req.TokenType) != 0 {
+		if len(req.ContractId) == 0 {
+			return nil, status.Error(codes.InvalidArgument, "token type requires contract id")
+		}
+		if err := collection.ValidateLegacyNFTClassID(req.TokenType); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		keyPrefix = append(keyPrefix, req.TokenType...)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	indexStore := prefix.NewStore(store, keyPrefix)
+	var tokens []collection.ContractNFT
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key, _ []byte) error {
+		fullKey := make([]byte, len(keyPrefix)+len(key))
+		copy(fullKey, keyPrefix)
+		copy(fullKey[len(keyPrefix):], key)
+		_, contractID, tokenID := splitNFTByOwnerKey(fullKey)
+
+		token, err := s.keeper.GetNFT(ctx, contractID, tokenID)
+		if err != nil {
+			return err
+		}
+
+		tokens = append(tokens, collection.ContractNFT{
+			ContractId: contractID,
+			Token:      *token,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &collection.QueryNFTsByOwnerResponse{Tokens: tokens, Pagination: pageRes}, nil
+}
+
+func (s queryServer) RoyaltyInfo(c context.Context, req *collection.QueryRoyaltyInfoRequest) (*collection.QueryRoyaltyInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := collection.ValidateContractID(req.ContractId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := collection.ValidateNFTID(req.TokenId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.SalePrice.IsNil() || req.SalePrice.IsNegative() {
+		return nil, status.Error(codes.InvalidArgument, "invalid sale price")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	royalty, err := s.keeper.GetRoyalty(ctx, req.ContractId, req.TokenId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	if royalty == nil {
+		return &collection.QueryRoyaltyInfoResponse{RoyaltyAmount: sdk.ZeroInt()}, nil
+	}
+
+	return &collection.QueryRoyaltyInfoResponse{
+		Recipient:     royalty.Recipient,
+		RoyaltyAmount: royalty.Amount(req.SalePrice),
+	}, nil
+}
+
+func (s queryServer) Approved(c context.Context, req *collection.QueryApprovedRequest) (*collection.QueryApprovedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := collection.ValidateContractID(req.ContractId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := collection.ValidateNFTID(req.TokenId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := s.keeper.hasNFT(ctx, req.ContractId, req.TokenId); err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	approved, err := s.keeper.GetApproved(ctx, req.ContractId, req.TokenId)
+	if err != nil {
+		return &collection.QueryApprovedResponse{}, nil
+	}
+
+	return &collection.QueryApprovedResponse{Approved: approved.String()}, nil
+}
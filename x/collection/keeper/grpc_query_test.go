@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"math/big"
+
 	"github.com/gogo/protobuf/proto"
 
 	sdk "github.com/Finschia/finschia-sdk/types"
@@ -1232,3 +1234,278 @@ func (s *KeeperTestSuite) TestQueryHoldersByOperator() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestQueryNFTsByOwner() {
+	// empty request
+	_, err := s.queryServer.NFTsByOwner(s.goCtx, nil)
+	s.Require().Error(err)
+
+	testCases := map[string]struct {
+		owner      sdk.AccAddress
+		contractID string
+		tokenType  string
+		valid      bool
+		count      uint64
+		postTest   func(res *collection.QueryNFTsByOwnerResponse)
+	}{
+		"valid request": {
+			owner: s.customer,
+			valid: true,
+			postTest: func(res *collection.QueryNFTsByOwnerResponse) {
+				s.Require().Equal(s.numNFTs, len(res.Tokens))
+				numChildren := 0
+				for _, token := range res.Tokens {
+					s.Require().Equal(s.contractID, token.ContractId)
+					s.Require().Equal(s.nftClassID, collection.SplitTokenID(token.Token.TokenId))
+
+					// the attached tokens are included
+					if _, err := s.keeper.GetParent(s.ctx, token.ContractId, token.Token.TokenId); err == nil {
+						numChildren++
+					}
+				}
+				s.Require().Equal(s.numNFTs-s.numRoots, numChildren)
+			},
+		},
+		"valid request with contract id": {
+			owner:      s.customer,
+			contractID: s.contractID,
+			valid:      true,
+			postTest: func(res *collection.QueryNFTsByOwnerResponse) {
+				s.Require().Equal(s.numNFTs, len(res.Tokens))
+			},
+		},
+		"valid request with token type": {
+			owner:      s.customer,
+			contractID: s.contractID,
+			tokenType:  s.nftClassID,
+			valid:      true,
+			postTest: func(res *collection.QueryNFTsByOwnerResponse) {
+				s.Require().Equal(s.numNFTs, len(res.Tokens))
+			},
+		},
+		"valid request with limit": {
+			owner: s.customer,
+			valid: true,
+			count: 1,
+			postTest: func(res *collection.QueryNFTsByOwnerResponse) {
+				s.Require().Equal(1, len(res.Tokens))
+				s.Require().NotNil(res.Pagination.NextKey)
+			},
+		},
+		"collection not found": {
+			owner:      s.customer,
+			contractID: "deadbeef",
+			valid:      true,
+			postTest: func(res *collection.QueryNFTsByOwnerResponse) {
+				s.Require().Equal(0, len(res.Tokens))
+			},
+		},
+		"token type not found": {
+			owner:      s.customer,
+			contractID: s.contractID,
+			tokenType:  "deadbeef",
+			valid:      true,
+			postTest: func(res *collection.QueryNFTsByOwnerResponse) {
+				s.Require().Equal(0, len(res.Tokens))
+			},
+		},
+		"no nfts": {
+			owner: s.stranger,
+			valid: true,
+			postTest: func(res *collection.QueryNFTsByOwnerResponse) {
+				s.Require().Equal(0, len(res.Tokens))
+			},
+		},
+		"invalid owner": {},
+		"invalid contract id": {
+			owner:      s.customer,
+			contractID: "invalid",
+		},
+		"token type without contract id": {
+			owner:     s.customer,
+			tokenType: s.nftClassID,
+		},
+		"invalid token type": {
+			owner:      s.customer,
+			contractID: s.contractID,
+			tokenType:  "invalid",
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			pageReq := &query.PageRequest{}
+			if tc.count != 0 {
+				pageReq.Limit = tc.count
+			}
+			req := &collection.QueryNFTsByOwnerRequest{
+				Owner:      tc.owner.String(),
+				ContractId: tc.contractID,
+				TokenType:  tc.tokenType,
+				Pagination: pageReq,
+			}
+			res, err := s.queryServer.NFTsByOwner(s.goCtx, req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			tc.postTest(res)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryRoyaltyInfo() {
+	// empty request
+	_, err := s.queryServer.RoyaltyInfo(s.goCtx, nil)
+	s.Require().Error(err)
+
+	royaltyTokenID := collection.NewNFTID(s.nftClassID, 1)
+	err = s.keeper.ModifyNFT(s.ctx, s.contractID, royaltyTokenID, s.vendor, []collection.Attribute{{
+		Key:   collection.AttributeKeyRoyalty.String(),
+		Value: s.vendor.String() + ":250",
+	}})
+	s.Require().NoError(err)
+
+	testCases := map[string]struct {
+		contractID string
+		tokenID    string
+		salePrice  sdk.Int
+		valid      bool
+		postTest   func(res *collection.QueryRoyaltyInfoResponse)
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			tokenID:    royaltyTokenID,
+			salePrice:  sdk.NewInt(10000),
+			valid:      true,
+			postTest: func(res *collection.QueryRoyaltyInfoResponse) {
+				s.Require().Equal(s.vendor.String(), res.Recipient)
+				s.Require().Equal(sdk.NewInt(250), res.RoyaltyAmount)
+			},
+		},
+		"no royalty": {
+			contractID: s.contractID,
+			tokenID:    collection.NewNFTID(s.nftClassID, 2),
+			salePrice:  sdk.NewInt(10000),
+			valid:      true,
+			postTest: func(res *collection.QueryRoyaltyInfoResponse) {
+				s.Require().Empty(res.Recipient)
+				s.Require().Equal(sdk.ZeroInt(), res.RoyaltyAmount)
+			},
+		},
+		"invalid contract id": {
+			tokenID:   royaltyTokenID,
+			salePrice: sdk.NewInt(10000),
+		},
+		"invalid token id": {
+			contractID: s.contractID,
+			tokenID:    collection.NewFTID(s.ftClassID),
+			salePrice:  sdk.NewInt(10000),
+		},
+		"max sale price": {
+			contractID: s.contractID,
+			tokenID:    royaltyTokenID,
+			salePrice:  sdk.NewIntFromBigInt(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))),
+			valid:      true,
+			postTest: func(res *collection.QueryRoyaltyInfoResponse) {
+				s.Require().True(res.RoyaltyAmount.IsPositive())
+			},
+		},
+		"no sale price": {
+			contractID: s.contractID,
+			tokenID:    royaltyTokenID,
+		},
+		"negative sale price": {
+			contractID: s.contractID,
+			tokenID:    royaltyTokenID,
+			salePrice:  sdk.NewInt(-1),
+		},
+		"token not found": {
+			contractID: s.contractID,
+			tokenID:    collection.NewNFTID(s.nftClassID, s.numNFTs*3+1),
+			salePrice:  sdk.NewInt(10000),
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			req := &collection.QueryRoyaltyInfoRequest{
+				ContractId: tc.contractID,
+				TokenId:    tc.tokenID,
+				SalePrice:  tc.salePrice,
+			}
+			res, err := s.queryServer.RoyaltyInfo(s.goCtx, req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			tc.postTest(res)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryApproved() {
+	// empty request
+	_, err := s.queryServer.Approved(s.goCtx, nil)
+	s.Require().Error(err)
+
+	approvedTokenID := collection.NewNFTID(s.nftClassID, 1)
+	err = s.keeper.ApproveNFT(s.ctx, s.contractID, s.customer, s.stranger, approvedTokenID)
+	s.Require().NoError(err)
+
+	testCases := map[string]struct {
+		contractID string
+		tokenID    string
+		valid      bool
+		postTest   func(res *collection.QueryApprovedResponse)
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			tokenID:    approvedTokenID,
+			valid:      true,
+			postTest: func(res *collection.QueryApprovedResponse) {
+				s.Require().Equal(s.stranger.String(), res.Approved)
+			},
+		},
+		"no approval": {
+			contractID: s.contractID,
+			tokenID:    collection.NewNFTID(s.nftClassID, 2),
+			valid:      true,
+			postTest: func(res *collection.QueryApprovedResponse) {
+				s.Require().Empty(res.Approved)
+			},
+		},
+		"invalid contract id": {
+			tokenID: approvedTokenID,
+		},
+		"invalid token id": {
+			contractID: s.contractID,
+			tokenID:    collection.NewFTID(s.ftClassID),
+		},
+		"token not found": {
+			contractID: s.contractID,
+			tokenID:    collection.NewNFTID(s.nftClassID, s.numNFTs*3+1),
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			req := &collection.QueryApprovedRequest{
+				ContractId: tc.contractID,
+				TokenId:    tc.tokenID,
+			}
+			res, err := s.queryServer.Approved(s.goCtx, req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			tc.postTest(res)
+		})
+	}
+}
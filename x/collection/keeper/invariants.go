@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/collection"
+)
+
+const (
+	supplyInvariant     = "supply"
+	statisticsInvariant = "statistics"
+	relationInvariant   = "relation"
+)
+
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(collection.ModuleName, supplyInvariant, SupplyInvariant(k))
+	ir.RegisterRoute(collection.ModuleName, statisticsInvariant, StatisticsInvariant(k))
+	ir.RegisterRoute(collection.ModuleName, relationInvariant, RelationInvariant(k))
+}
+
+// SupplyInvariant checks that the supply of each token class equals to the sum of its balances.
+// The children nfts have no balance records, so they are counted through their parent records.
+func SupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg := ""
+		broken := false
+
+		k.iterateContracts(ctx, func(contract collection.Contract) (stop bool) {
+			sums := map[string]sdk.Int{}
+			add := func(tokenID string, amount sdk.Int) {
+				classID := collection.SplitTokenID(tokenID)
+				if sum, ok := sums[classID]; ok {
+					amount = sum.Add(amount)
+				}
+				sums[classID] = amount
+			}
+
+			k.iterateContractBalances(ctx, contract.Id, func(_ sdk.AccAddress, balance collection.Coin) (stop bool) {
+				add(balance.TokenId, balance.Amount)
+				return false
+			})
+			k.iterateContractParents(ctx, contract.Id, func(tokenID, _ string) (stop bool) {
+				add(tokenID, sdk.OneInt())
+				return false
+			})
+
+			// the classes having no balances at all must have no supply either
+			k.iterateContractSupplies(ctx, contract.Id, func(classID string, _ sdk.Int) (stop bool) {
+				if _, ok := sums[classID]; !ok {
+					sums[classID] = sdk.ZeroInt()
+				}
+				return false
+			})
+
+			classIDs := make([]string, 0, len(sums))
+			for classID := range sums {
+				classIDs = append(classIDs, classID)
+			}
+			sort.Strings(classIDs)
+
+			for _, classID := range classIDs {
+				sum := sums[classID]
+				supply := k.GetSupply(ctx, contract.Id, classID)
+				if !supply.Equal(sum) {
+					msg += fmt.Sprintf("supply of %s in %s; expected %s, got %s\n", classID, contract.Id, sum, supply)
+					broken = true
+				}
+			}
+
+			return false
+		})
+
+		return sdk.FormatInvariant(collection.ModuleName, supplyInvariant, msg), broken
+	}
+}
+
+// StatisticsInvariant checks that the supply of each token class equals to
+// the amount minted minus the amount burnt.
+func StatisticsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg := ""
+		broken := false
+
+		k.iterateContracts(ctx, func(contract collection.Contract) (stop bool) {
+			k.iterateContractClasses(ctx, contract.Id, func(class collection.TokenClass) (stop bool) {
+				classID := class.GetId()
+				minted := k.GetMinted(ctx, contract.Id, classID)
+				burnt := k.GetBurnt(ctx, contract.Id, classID)
+				expected := minted.Sub(burnt)
+
+				supply := k.GetSupply(ctx, contract.Id, classID)
+				if !supply.Equal(expected) {
+					msg += fmt.Sprintf("statistics of %s in %s; minted %s, burnt %s, got supply %s\n", classID, contract.Id, minted, burnt, supply)
+					broken = true
+				}
+
+				return false
+			})
+
+			return false
+		})
+
+		return sdk.FormatInvariant(collection.ModuleName, statisticsInvariant, msg), broken
+	}
+}
+
+// RelationInvariant checks that the parent and child records of the nfts agree with each other,
+// and that only the root nfts have their owners.
+func RelationInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg := ""
+		broken := false
+
+		store := ctx.KVStore(k.storeKey)
+		k.iterateContracts(ctx, func(contract collection.Contract) (stop bool) {
+			k.iterateContractParents(ctx, contract.Id, func(tokenID, parentID string) (stop bool) {
+				if !store.Has(childKey(contract.Id, parentID, tokenID)) {
+					msg += fmt.Sprintf("%s in %s; no child record on its parent %s\n", tokenID, contract.Id, parentID)
+					broken = true
+				}
+				if err := k.hasNFT(ctx, contract.Id, parentID); err != nil {
+					msg += fmt.Sprintf("%s in %s; its parent %s does not exist\n", tokenID, contract.Id, parentID)
+					broken = true
+				}
+				return false
+			})
+
+			k.iterateContractChildren(ctx, contract.Id, func(tokenID, childID string) (stop bool) {
+				parentID, err := k.GetParent(ctx, contract.Id, childID)
+				if err != nil || *parentID != tokenID {
+					msg += fmt.Sprintf("%s in %s; no parent record on its child %s\n", tokenID, contract.Id, childID)
+					broken = true
+				}
+				return false
+			})
+
+			k.iterateContractNFTs(ctx, contract.Id, func(nft collection.NFT) (stop bool) {
+				_, err := k.GetParent(ctx, contract.Id, nft.TokenId)
+				isRoot := err != nil
+				hasOwner := store.Has(ownerKey(contract.Id, nft.TokenId))
+				if isRoot != hasOwner {
+					msg += fmt.Sprintf("%s in %s; root %t, but owner exists %t\n", nft.TokenId, contract.Id, isRoot, hasOwner)
+					broken = true
+				}
+				return false
+			})
+
+			return false
+		})
+
+		return sdk.FormatInvariant(collection.ModuleName, relationInvariant, msg), broken
+	}
+}
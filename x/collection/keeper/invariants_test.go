@@ -0,0 +1,154 @@
+package keeper_test
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/collection"
+	"github.com/Finschia/finschia-sdk/x/collection/keeper"
+)
+
+func (s *KeeperTestSuite) TestSupplyInvariant() {
+	testCases := map[string]struct {
+		malleate func(ctx sdk.Context)
+		valid    bool
+	}{
+		"invariant not broken": {
+			valid: true,
+		},
+		"after burning a tree of nfts": {
+			malleate: func(ctx sdk.Context) {
+				amount := collection.NewCoins(collection.NewCoin(collection.NewNFTID(s.nftClassID, 1), sdk.OneInt()))
+				_, err := s.keeper.BurnCoins(ctx, s.contractID, s.customer, amount)
+				s.Require().NoError(err)
+			},
+			valid: true,
+		},
+		"supply not matching the balances": {
+			malleate: func(ctx sdk.Context) {
+				s.keeper.InitGenesis(ctx, &collection.GenesisState{
+					Supplies: []collection.ContractStatistics{{
+						ContractId: s.contractID,
+						Statistics: []collection.ClassStatistics{{
+							ClassId: s.ftClassID,
+							Amount:  s.balance,
+						}},
+					}},
+				})
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			if tc.malleate != nil {
+				tc.malleate(ctx)
+			}
+
+			invariant := keeper.SupplyInvariant(s.keeper)
+			_, broken := invariant(ctx)
+			s.Require().Equal(!tc.valid, broken)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestStatisticsInvariant() {
+	testCases := map[string]struct {
+		malleate func(ctx sdk.Context)
+		valid    bool
+	}{
+		"invariant not broken": {
+			valid: true,
+		},
+		"after burning a tree of nfts": {
+			malleate: func(ctx sdk.Context) {
+				amount := collection.NewCoins(collection.NewCoin(collection.NewNFTID(s.nftClassID, 1), sdk.OneInt()))
+				_, err := s.keeper.BurnCoins(ctx, s.contractID, s.customer, amount)
+				s.Require().NoError(err)
+			},
+			valid: true,
+		},
+		"burnt not matching the supply": {
+			malleate: func(ctx sdk.Context) {
+				s.keeper.InitGenesis(ctx, &collection.GenesisState{
+					Burnts: []collection.ContractStatistics{{
+						ContractId: s.contractID,
+						Statistics: []collection.ClassStatistics{{
+							ClassId: s.ftClassID,
+							Amount:  s.balance,
+						}},
+					}},
+				})
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			if tc.malleate != nil {
+				tc.malleate(ctx)
+			}
+
+			invariant := keeper.StatisticsInvariant(s.keeper)
+			_, broken := invariant(ctx)
+			s.Require().Equal(!tc.valid, broken)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestRelationInvariant() {
+	testCases := map[string]struct {
+		malleate func(ctx sdk.Context)
+		valid    bool
+	}{
+		"invariant not broken": {
+			valid: true,
+		},
+		"after detaching": {
+			malleate: func(ctx sdk.Context) {
+				err := s.keeper.Detach(ctx, s.contractID, s.customer, collection.NewNFTID(s.nftClassID, 2))
+				s.Require().NoError(err)
+			},
+			valid: true,
+		},
+		"root having its parent": {
+			malleate: func(ctx sdk.Context) {
+				s.keeper.InitGenesis(ctx, &collection.GenesisState{
+					Parents: []collection.ContractTokenRelations{{
+						ContractId: s.contractID,
+						Relations: []collection.TokenRelation{{
+							Self:  collection.NewNFTID(s.nftClassID, 5),
+							Other: collection.NewNFTID(s.nftClassID, 1),
+						}},
+					}},
+				})
+			},
+		},
+		"child record left on its former parent": {
+			malleate: func(ctx sdk.Context) {
+				s.keeper.InitGenesis(ctx, &collection.GenesisState{
+					Parents: []collection.ContractTokenRelations{{
+						ContractId: s.contractID,
+						Relations: []collection.TokenRelation{{
+							Self:  collection.NewNFTID(s.nftClassID, 3),
+							Other: collection.NewNFTID(s.nftClassID, 1),
+						}},
+					}},
+				})
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			if tc.malleate != nil {
+				tc.malleate(ctx)
+			}
+
+			invariant := keeper.RelationInvariant(s.keeper)
+			_, broken := invariant(ctx)
+			s.Require().Equal(!tc.valid, broken)
+		})
+	}
+}
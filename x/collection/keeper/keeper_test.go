@@ -10,6 +10,7 @@ import (
 	"github.com/Finschia/finschia-sdk/crypto/keys/secp256k1"
 	"github.com/Finschia/finschia-sdk/simapp"
 	sdk "github.com/Finschia/finschia-sdk/types"
+	authzkeeper "github.com/Finschia/finschia-sdk/x/authz/keeper"
 	"github.com/Finschia/finschia-sdk/x/collection"
 	"github.com/Finschia/finschia-sdk/x/collection/keeper"
 )
@@ -20,6 +21,7 @@ type KeeperTestSuite struct {
 	ctx         sdk.Context
 	goCtx       context.Context
 	keeper      keeper.Keeper
+	authzKeeper authzkeeper.Keeper
 	queryServer collection.QueryServer
 	msgServer   collection.MsgServer
 
@@ -64,6 +66,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	s.ctx = app.BaseApp.NewContext(checkTx, tmproto.Header{})
 	s.goCtx = sdk.WrapSDKContext(s.ctx)
 	s.keeper = app.CollectionKeeper
+	s.authzKeeper = app.AuthzKeeper
 
 	s.queryServer = keeper.NewQueryServer(s.keeper)
 	s.msgServer = keeper.NewMsgServer(s.keeper)
@@ -6,28 +6,32 @@ import (
 )
 
 var (
-	paramsKey = []byte{0x00}
+	ParamsKey = []byte{0x00}
 
-	contractKeyPrefix    = []byte{0x10}
-	classKeyPrefix       = []byte{0x11}
-	nextClassIDKeyPrefix = []byte{0x12}
-	nextTokenIDKeyPrefix = []byte{0x13}
+	ContractKeyPrefix    = []byte{0x10}
+	ClassKeyPrefix       = []byte{0x11}
+	NextClassIDKeyPrefix = []byte{0x12}
+	NextTokenIDKeyPrefix = []byte{0x13}
 
-	balanceKeyPrefix = []byte{0x20}
-	ownerKeyPrefix   = []byte{0x21}
-	nftKeyPrefix     = []byte{0x22}
-	parentKeyPrefix  = []byte{0x23}
-	childKeyPrefix   = []byte{0x24}
+	BalanceKeyPrefix = []byte{0x20}
+	OwnerKeyPrefix   = []byte{0x21}
+	NFTKeyPrefix     = []byte{0x22}
+	ParentKeyPrefix  = []byte{0x23}
+	ChildKeyPrefix   = []byte{0x24}
 
-	authorizationKeyPrefix = []byte{0x30}
-	grantKeyPrefix         = []byte{0x31}
+	AuthorizationKeyPrefix = []byte{0x30}
+	GrantKeyPrefix         = []byte{0x31}
+	ApprovalKeyPrefix      = []byte{0x32}
 
-	supplyKeyPrefix = []byte{0x40}
-	mintedKeyPrefix = []byte{0x41}
-	burntKeyPrefix  = []byte{0x42}
+	SupplyKeyPrefix = []byte{0x40}
+	MintedKeyPrefix = []byte{0x41}
+	BurntKeyPrefix  = []byte{0x42}
 
-	legacyTokenKeyPrefix     = []byte{0xf0}
-	legacyTokenTypeKeyPrefix = []byte{0xf1}
+	// indexes
+	NFTByOwnerKeyPrefix = []byte{0x50}
+
+	LegacyTokenKeyPrefix     = []byte{0xf0}
+	LegacyTokenTypeKeyPrefix = []byte{0xf1}
 )
 
 func balanceKey(contractID string, address sdk.AccAddress, tokenID string) []byte {
@@ -57,12 +61,12 @@ func balanceKeyPrefixByAddress(contractID string, address sdk.AccAddress) []byte
 }
 
 func balanceKeyPrefixByContractID(contractID string) []byte {
-	key := make([]byte, len(balanceKeyPrefix)+1+len(contractID))
+	key := make([]byte, len(BalanceKeyPrefix)+1+len(contractID))
 
 	begin := 0
-	copy(key, balanceKeyPrefix)
+	copy(key, BalanceKeyPrefix)
 
-	begin += len(balanceKeyPrefix)
+	begin += len(BalanceKeyPrefix)
 	key[begin] = byte(len(contractID))
 
 	begin++
@@ -72,7 +76,7 @@ func balanceKeyPrefixByContractID(contractID string) []byte {
 }
 
 func splitBalanceKey(key []byte) (contractID string, address sdk.AccAddress, tokenID string) {
-	begin := len(balanceKeyPrefix) + 1
+	begin := len(BalanceKeyPrefix) + 1
 	end := begin + int(key[begin-1])
 	contractID = string(key[begin:end])
 
@@ -99,12 +103,12 @@ func ownerKey(contractID, tokenID string) []byte {
 }
 
 func ownerKeyPrefixByContractID(contractID string) []byte {
-	key := make([]byte, len(ownerKeyPrefix)+1+len(contractID))
+	key := make([]byte, len(OwnerKeyPrefix)+1+len(contractID))
 
 	begin := 0
-	copy(key, ownerKeyPrefix)
+	copy(key, OwnerKeyPrefix)
 
-	begin += len(ownerKeyPrefix)
+	begin += len(OwnerKeyPrefix)
 	key[begin] = byte(len(contractID))
 
 	begin++
@@ -113,6 +117,75 @@ func ownerKeyPrefixByContractID(contractID string) []byte {
 	return key
 }
 
+func splitOwnerKey(key []byte) (contractID, tokenID string) {
+	begin := len(OwnerKeyPrefix) + 1
+	end := begin + int(key[begin-1])
+	contractID = string(key[begin:end])
+
+	begin = end
+	tokenID = string(key[begin:])
+
+	return
+}
+
+// ----------------------------------------------------------------------------
+// nft by owner
+func nftByOwnerKey(owner sdk.AccAddress, contractID, tokenID string) []byte {
+	prefix := nftByOwnerKeyPrefixByContractID(owner, contractID)
+	key := make([]byte, len(prefix)+len(tokenID))
+
+	copy(key, prefix)
+	copy(key[len(prefix):], tokenID)
+
+	return key
+}
+
+func nftByOwnerKeyPrefixByContractID(owner sdk.AccAddress, contractID string) []byte {
+	prefix := nftByOwnerKeyPrefixByOwner(owner)
+	key := make([]byte, len(prefix)+1+len(contractID))
+
+	begin := 0
+	copy(key, prefix)
+
+	begin += len(prefix)
+	key[begin] = byte(len(contractID))
+
+	begin++
+	copy(key[begin:], contractID)
+
+	return key
+}
+
+func nftByOwnerKeyPrefixByOwner(owner sdk.AccAddress) []byte {
+	key := make([]byte, len(NFTByOwnerKeyPrefix)+1+len(owner))
+
+	begin := 0
+	copy(key, NFTByOwnerKeyPrefix)
+
+	begin += len(NFTByOwnerKeyPrefix)
+	key[begin] = byte(len(owner))
+
+	begin++
+	copy(key[begin:], owner)
+
+	return key
+}
+
+func splitNFTByOwnerKey(key []byte) (owner sdk.AccAddress, contractID, tokenID string) {
+	begin := len(NFTByOwnerKeyPrefix) + 1
+	end := begin + int(key[begin-1])
+	owner = sdk.AccAddress(key[begin:end])
+
+	begin = end + 1
+	end = begin + int(key[begin-1])
+	contractID = string(key[begin:end])
+
+	begin = end
+	tokenID = string(key[begin:])
+
+	return
+}
+
 // ----------------------------------------------------------------------------
 // nft
 func nftKey(contractID, tokenID string) []byte {
@@ -126,12 +199,12 @@ func nftKey(contractID, tokenID string) []byte {
 }
 
 func nftKeyPrefixByContractID(contractID string) []byte {
-	key := make([]byte, len(nftKeyPrefix)+1+len(contractID))
+	key := make([]byte, len(NFTKeyPrefix)+1+len(contractID))
 
 	begin := 0
-	copy(key, nftKeyPrefix)
+	copy(key, NFTKeyPrefix)
 
-	begin += len(nftKeyPrefix)
+	begin += len(NFTKeyPrefix)
 	key[begin] = byte(len(contractID))
 
 	begin++
@@ -141,7 +214,7 @@ func nftKeyPrefixByContractID(contractID string) []byte {
 }
 
 func splitNFTKey(key []byte) (contractID, tokenID string) {
-	begin := len(nftKeyPrefix) + 1
+	begin := len(NFTKeyPrefix) + 1
 	end := begin + int(key[begin-1])
 	contractID = string(key[begin:end])
 
@@ -164,12 +237,12 @@ func parentKey(contractID, tokenID string) []byte {
 }
 
 func parentKeyPrefixByContractID(contractID string) []byte {
-	key := make([]byte, len(parentKeyPrefix)+1+len(contractID))
+	key := make([]byte, len(ParentKeyPrefix)+1+len(contractID))
 
 	begin := 0
-	copy(key, parentKeyPrefix)
+	copy(key, ParentKeyPrefix)
 
-	begin += len(parentKeyPrefix)
+	begin += len(ParentKeyPrefix)
 	key[begin] = byte(len(contractID))
 
 	begin++
@@ -179,7 +252,7 @@ func parentKeyPrefixByContractID(contractID string) []byte {
 }
 
 func splitParentKey(key []byte) (contractID, tokenID string) {
-	begin := len(parentKeyPrefix) + 1
+	begin := len(ParentKeyPrefix) + 1
 	end := begin + int(key[begin-1])
 	contractID = string(key[begin:end])
 
@@ -218,12 +291,12 @@ func childKeyPrefixByTokenID(contractID, tokenID string) []byte {
 }
 
 func childKeyPrefixByContractID(contractID string) []byte {
-	key := make([]byte, len(childKeyPrefix)+1+len(contractID))
+	key := make([]byte, len(ChildKeyPrefix)+1+len(contractID))
 
 	begin := 0
-	copy(key, childKeyPrefix)
+	copy(key, ChildKeyPrefix)
 
-	begin += len(childKeyPrefix)
+	begin += len(ChildKeyPrefix)
 	key[begin] = byte(len(contractID))
 
 	begin++
@@ -233,7 +306,7 @@ func childKeyPrefixByContractID(contractID string) []byte {
 }
 
 func splitChildKey(key []byte) (contractID, tokenID, childID string) {
-	begin := len(childKeyPrefix) + 1
+	begin := len(ChildKeyPrefix) + 1
 	end := begin + int(key[begin-1])
 	contractID = string(key[begin:end])
 
@@ -249,10 +322,10 @@ func splitChildKey(key []byte) (contractID, tokenID, childID string) {
 
 // ----------------------------------------------------------------------------
 func contractKey(contractID string) []byte {
-	key := make([]byte, len(contractKeyPrefix)+len(contractID))
+	key := make([]byte, len(ContractKeyPrefix)+len(contractID))
 
-	copy(key, contractKeyPrefix)
-	copy(key[len(contractKeyPrefix):], contractID)
+	copy(key, ContractKeyPrefix)
+	copy(key[len(ContractKeyPrefix):], contractID)
 
 	return key
 }
@@ -268,12 +341,12 @@ func classKey(contractID, classID string) []byte {
 }
 
 func classKeyPrefixByContractID(contractID string) []byte {
-	key := make([]byte, len(classKeyPrefix)+1+len(contractID))
+	key := make([]byte, len(ClassKeyPrefix)+1+len(contractID))
 
 	begin := 0
-	copy(key, classKeyPrefix)
+	copy(key, ClassKeyPrefix)
 
-	begin += len(classKeyPrefix)
+	begin += len(ClassKeyPrefix)
 	key[begin] = byte(len(contractID))
 
 	begin++
@@ -293,12 +366,12 @@ func nextTokenIDKey(contractID, classID string) []byte {
 }
 
 func nextTokenIDKeyPrefixByContractID(contractID string) []byte {
-	key := make([]byte, len(nextTokenIDKeyPrefix)+1+len(contractID))
+	key := make([]byte, len(NextTokenIDKeyPrefix)+1+len(contractID))
 
 	begin := 0
-	copy(key, nextTokenIDKeyPrefix)
+	copy(key, NextTokenIDKeyPrefix)
 
-	begin += len(nextTokenIDKeyPrefix)
+	begin += len(NextTokenIDKeyPrefix)
 	key[begin] = byte(len(contractID))
 
 	begin++
@@ -308,7 +381,7 @@ func nextTokenIDKeyPrefixByContractID(contractID string) []byte {
 }
 
 func splitNextTokenIDKey(key []byte) (contractID, classID string) {
-	begin := len(nextTokenIDKeyPrefix) + 1
+	begin := len(NextTokenIDKeyPrefix) + 1
 	end := begin + int(key[begin-1])
 	contractID = string(key[begin:end])
 
@@ -319,10 +392,10 @@ func splitNextTokenIDKey(key []byte) (contractID, classID string) {
 }
 
 func nextClassIDKey(contractID string) []byte {
-	key := make([]byte, len(nextClassIDKeyPrefix)+len(contractID))
+	key := make([]byte, len(NextClassIDKeyPrefix)+len(contractID))
 
-	copy(key, nextClassIDKeyPrefix)
-	copy(key[len(nextClassIDKeyPrefix):], contractID)
+	copy(key, NextClassIDKeyPrefix)
+	copy(key[len(NextClassIDKeyPrefix):], contractID)
 
 	return key
 }
@@ -355,12 +428,12 @@ func authorizationKeyPrefixByOperator(contractID string, operator sdk.AccAddress
 }
 
 func authorizationKeyPrefixByContractID(contractID string) []byte {
-	key := make([]byte, len(authorizationKeyPrefix)+1+len(contractID))
+	key := make([]byte, len(AuthorizationKeyPrefix)+1+len(contractID))
 
 	begin := 0
-	copy(key, authorizationKeyPrefix)
+	copy(key, AuthorizationKeyPrefix)
 
-	begin += len(authorizationKeyPrefix)
+	begin += len(AuthorizationKeyPrefix)
 	key[begin] = byte(len(contractID))
 
 	begin++
@@ -370,7 +443,7 @@ func authorizationKeyPrefixByContractID(contractID string) []byte {
 }
 
 func splitAuthorizationKey(key []byte) (contractID string, operator, holder sdk.AccAddress) {
-	begin := len(authorizationKeyPrefix) + 1
+	begin := len(AuthorizationKeyPrefix) + 1
 	end := begin + int(key[begin-1])
 	contractID = string(key[begin:end])
 
@@ -412,12 +485,12 @@ func grantKeyPrefixByGrantee(contractID string, grantee sdk.AccAddress) []byte {
 }
 
 func grantKeyPrefixByContractID(contractID string) []byte {
-	key := make([]byte, len(grantKeyPrefix)+1+len(contractID))
+	key := make([]byte, len(GrantKeyPrefix)+1+len(contractID))
 
 	begin := 0
-	copy(key, grantKeyPrefix)
+	copy(key, GrantKeyPrefix)
 
-	begin += len(grantKeyPrefix)
+	begin += len(GrantKeyPrefix)
 	key[begin] = byte(len(contractID))
 
 	begin++
@@ -427,7 +500,7 @@ func grantKeyPrefixByContractID(contractID string) []byte {
 }
 
 func splitGrantKey(key []byte) (contractID string, grantee sdk.AccAddress, permission collection.Permission) {
-	begin := len(grantKeyPrefix) + 1
+	begin := len(GrantKeyPrefix) + 1
 	end := begin + int(key[begin-1])
 	contractID = string(key[begin:end])
 
@@ -441,6 +514,44 @@ func splitGrantKey(key []byte) (contractID string, grantee sdk.AccAddress, permi
 	return
 }
 
+// ----------------------------------------------------------------------------
+// approval
+func approvalKey(contractID, tokenID string) []byte {
+	prefix := approvalKeyPrefixByContractID(contractID)
+	key := make([]byte, len(prefix)+len(tokenID))
+
+	copy(key, prefix)
+	copy(key[len(prefix):], tokenID)
+
+	return key
+}
+
+func approvalKeyPrefixByContractID(contractID string) []byte {
+	key := make([]byte, len(ApprovalKeyPrefix)+1+len(contractID))
+
+	begin := 0
+	copy(key, ApprovalKeyPrefix)
+
+	begin += len(ApprovalKeyPrefix)
+	key[begin] = byte(len(contractID))
+
+	begin++
+	copy(key[begin:], contractID)
+
+	return key
+}
+
+func splitApprovalKey(key []byte) (contractID, tokenID string) {
+	begin := len(ApprovalKeyPrefix) + 1
+	end := begin + int(key[begin-1])
+	contractID = string(key[begin:end])
+
+	begin = end
+	tokenID = string(key[begin:])
+
+	return
+}
+
 // ----------------------------------------------------------------------------
 // statistics
 func statisticKey(keyPrefix []byte, contractID, classID string) []byte {
@@ -492,12 +603,12 @@ func legacyTokenKey(contractID, tokenID string) []byte {
 }
 
 func legacyTokenKeyPrefixByContractID(contractID string) []byte {
-	key := make([]byte, len(legacyTokenKeyPrefix)+1+len(contractID))
+	key := make([]byte, len(LegacyTokenKeyPrefix)+1+len(contractID))
 
 	begin := 0
-	copy(key, legacyTokenKeyPrefix)
+	copy(key, LegacyTokenKeyPrefix)
 
-	begin += len(legacyTokenKeyPrefix)
+	begin += len(LegacyTokenKeyPrefix)
 	key[begin] = byte(len(contractID))
 
 	begin++
@@ -517,12 +628,12 @@ func legacyTokenTypeKey(contractID, tokenType string) []byte {
 }
 
 func legacyTokenTypeKeyPrefixByContractID(contractID string) []byte {
-	key := make([]byte, len(legacyTokenTypeKeyPrefix)+1+len(contractID))
+	key := make([]byte, len(LegacyTokenTypeKeyPrefix)+1+len(contractID))
 
 	begin := 0
-	copy(key, legacyTokenTypeKeyPrefix)
+	copy(key, LegacyTokenTypeKeyPrefix)
 
-	begin += len(legacyTokenTypeKeyPrefix)
+	begin += len(LegacyTokenTypeKeyPrefix)
 	key[begin] = byte(len(contractID))
 
 	begin++
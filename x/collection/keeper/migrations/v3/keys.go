@@ -0,0 +1,108 @@
+package v3
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+)
+
+var (
+	ownerKeyPrefix  = []byte{0x21}
+	parentKeyPrefix = []byte{0x23}
+
+	nftByOwnerKeyPrefix = []byte{0x50}
+)
+
+func OwnerKey(contractID, tokenID string) []byte {
+	prefix := ownerKeyPrefixByContractID(contractID)
+	key := make([]byte, len(prefix)+len(tokenID))
+
+	copy(key, prefix)
+	copy(key[len(prefix):], tokenID)
+
+	return key
+}
+
+func ownerKeyPrefixByContractID(contractID string) []byte {
+	key := make([]byte, len(ownerKeyPrefix)+1+len(contractID))
+
+	begin := 0
+	copy(key, ownerKeyPrefix)
+
+	begin += len(ownerKeyPrefix)
+	key[begin] = byte(len(contractID))
+
+	begin++
+	copy(key[begin:], contractID)
+
+	return key
+}
+
+func splitOwnerKey(key []byte) (contractID, tokenID string) {
+	begin := len(ownerKeyPrefix) + 1
+	end := begin + int(key[begin-1])
+	contractID = string(key[begin:end])
+
+	begin = end
+	tokenID = string(key[begin:])
+
+	return
+}
+
+func ParentKey(contractID, tokenID string) []byte {
+	prefix := parentKeyPrefixByContractID(contractID)
+	key := make([]byte, len(prefix)+len(tokenID))
+
+	copy(key, prefix)
+	copy(key[len(prefix):], tokenID)
+
+	return key
+}
+
+func parentKeyPrefixByContractID(contractID string) []byte {
+	key := make([]byte, len(parentKeyPrefix)+1+len(contractID))
+
+	begin := 0
+	copy(key, parentKeyPrefix)
+
+	begin += len(parentKeyPrefix)
+	key[begin] = byte(len(contractID))
+
+	begin++
+	copy(key[begin:], contractID)
+
+	return key
+}
+
+func splitParentKey(key []byte) (contractID, tokenID string) {
+	begin := len(parentKeyPrefix) + 1
+	end := begin + int(key[begin-1])
+	contractID = string(key[begin:end])
+
+	begin = end
+	tokenID = string(key[begin:])
+
+	return
+}
+
+func NFTByOwnerKey(owner sdk.AccAddress, contractID, tokenID string) []byte {
+	key := make([]byte, len(nftByOwnerKeyPrefix)+1+len(owner)+1+len(contractID)+len(tokenID))
+
+	begin := 0
+	copy(key, nftByOwnerKeyPrefix)
+
+	begin += len(nftByOwnerKeyPrefix)
+	key[begin] = byte(len(owner))
+
+	begin++
+	copy(key[begin:], owner)
+
+	begin += len(owner)
+	key[begin] = byte(len(contractID))
+
+	begin++
+	copy(key[begin:], contractID)
+
+	begin += len(contractID)
+	copy(key[begin:], tokenID)
+
+	return key
+}
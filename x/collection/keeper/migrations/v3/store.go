@@ -0,0 +1,129 @@
+package v3
+
+import (
+	"fmt"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	storetypes "github.com/Finschia/finschia-sdk/store/types"
+	sdk "github.com/Finschia/finschia-sdk/types"
+)
+
+// MigrateStore performs in-place store migrations from v2 to v3.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey) error {
+	store := ctx.KVStore(storeKey)
+
+	// build the index of nfts by owner
+	if err := indexNFTsByOwner(store); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func indexNFTsByOwner(store storetypes.KVStore) error {
+	owners, err := getNFTOwners(store)
+	if err != nil {
+		return err
+	}
+
+	relations, err := getNFTRelations(store)
+	if err != nil {
+		return err
+	}
+
+	// the children are indexed by the owners of their roots
+	rootOwners := make(map[string]sdk.AccAddress, len(owners))
+	for _, owner := range owners {
+		rootOwners[owner.contractID+owner.tokenID] = owner.owner
+	}
+	parents := make(map[string]string, len(relations))
+	for _, relation := range relations {
+		parents[relation.contractID+relation.tokenID] = relation.parentID
+	}
+	for _, relation := range relations {
+		rootID := relation.parentID
+		for {
+			parentID, ok := parents[relation.contractID+rootID]
+			if !ok {
+				break
+			}
+			rootID = parentID
+		}
+
+		owner, ok := rootOwners[relation.contractID+rootID]
+		if !ok {
+			return fmt.Errorf("no owner of %s in %s", rootID, relation.contractID)
+		}
+		owners = append(owners, nftOwner{
+			contractID: relation.contractID,
+			tokenID:    relation.tokenID,
+			owner:      owner,
+		})
+	}
+
+	for _, owner := range owners {
+		store.Set(NFTByOwnerKey(owner.owner, owner.contractID, owner.tokenID), []byte{})
+	}
+
+	return nil
+}
+
+type nftOwner struct {
+	contractID string
+	tokenID    string
+	owner      sdk.AccAddress
+}
+
+func getNFTOwners(store storetypes.KVStore) ([]nftOwner, error) {
+	iterator := sdk.KVStorePrefixIterator(store, ownerKeyPrefix)
+	defer iterator.Close()
+
+	var owners []nftOwner
+	for ; iterator.Valid(); iterator.Next() {
+		contractID, tokenID := splitOwnerKey(iterator.Key())
+
+		// the value may be reused by the iterator
+		var owner sdk.AccAddress
+		if err := owner.Unmarshal(append([]byte{}, iterator.Value()...)); err != nil {
+			return nil, err
+		}
+
+		owners = append(owners, nftOwner{
+			contractID: contractID,
+			tokenID:    tokenID,
+			owner:      owner,
+		})
+	}
+
+	return owners, nil
+}
+
+type nftRelation struct {
+	contractID string
+	tokenID    string
+	parentID   string
+}
+
+func getNFTRelations(store storetypes.KVStore) ([]nftRelation, error) {
+	iterator := sdk.KVStorePrefixIterator(store, parentKeyPrefix)
+	defer iterator.Close()
+
+	var relations []nftRelation
+	for ; iterator.Valid(); iterator.Next() {
+		contractID, tokenID := splitParentKey(iterator.Key())
+
+		var parent gogotypes.StringValue
+		if err := parent.Unmarshal(iterator.Value()); err != nil {
+			return nil, err
+		}
+
+		relations = append(relations, nftRelation{
+			contractID: contractID,
+			tokenID:    tokenID,
+			parentID:   parent.Value,
+		})
+	}
+
+	return relations, nil
+}
@@ -0,0 +1,77 @@
+package v3_test
+
+import (
+	"testing"
+
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/finschia-sdk/testutil"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/collection"
+	"github.com/Finschia/finschia-sdk/x/collection/keeper/migrations/v3"
+)
+
+func TestMigrateStore(t *testing.T) {
+	collectionKey := sdk.NewKVStoreKey(collection.StoreKey)
+	newKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(collectionKey, newKey)
+
+	// set state
+	store := ctx.KVStore(collectionKey)
+
+	contractIDs := []string{"deadbeef", "fee1dead"}
+	owners := []sdk.AccAddress{
+		sdk.AccAddress("fennec"),
+		sdk.AccAddress("penguin"),
+		sdk.AccAddress("cheetah"),
+	}
+	tokenIDs := map[string]sdk.AccAddress{}
+	for _, contractID := range contractIDs {
+		for i, owner := range owners {
+			tokenID := collection.NewNFTID("10000001", i+1)
+			tokenIDs[contractID+tokenID] = owner
+
+			bz, err := owner.Marshal()
+			require.NoError(t, err)
+			store.Set(v3.OwnerKey(contractID, tokenID), bz)
+		}
+
+		// a chain under the first token, whose children have no owner records
+		for i := range owners[:2] {
+			tokenID := collection.NewNFTID("10000001", len(owners)+i+1)
+			parentID := collection.NewNFTID("10000001", len(owners)+i)
+			if i == 0 {
+				parentID = collection.NewNFTID("10000001", 1)
+			}
+
+			bz, err := (&gogotypes.StringValue{Value: parentID}).Marshal()
+			require.NoError(t, err)
+			store.Set(v3.ParentKey(contractID, tokenID), bz)
+		}
+	}
+
+	// migrate
+	err := v3.MigrateStore(ctx, collectionKey)
+	require.NoError(t, err)
+
+	for _, contractID := range contractIDs {
+		for i, owner := range owners {
+			tokenID := collection.NewNFTID("10000001", i+1)
+			require.True(t, store.Has(v3.NFTByOwnerKey(owner, contractID, tokenID)))
+
+			for _, other := range owners {
+				if other.Equals(owner) {
+					continue
+				}
+				require.False(t, store.Has(v3.NFTByOwnerKey(other, contractID, tokenID)))
+			}
+		}
+
+		// the children are indexed by the owner of their root
+		for i := range owners[:2] {
+			tokenID := collection.NewNFTID("10000001", len(owners)+i+1)
+			require.True(t, store.Has(v3.NFTByOwnerKey(owners[0], contractID, tokenID)))
+		}
+	}
+}
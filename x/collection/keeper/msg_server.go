@@ -141,14 +141,19 @@ func (s msgServer) OperatorSendNFT(c context.Context, req *collection.MsgOperato
 	operatorAddr := sdk.MustAccAddressFromBech32(req.Operator)
 	fromAddr := sdk.MustAccAddressFromBech32(req.From)
 
-	if _, err := s.keeper.GetAuthorization(ctx, req.ContractId, fromAddr, operatorAddr); err != nil {
-		return nil, collection.ErrCollectionNotApproved.Wrap(err.Error())
-	}
+	// without the authorization, every token must be approved to the operator
+	_, authErr := s.keeper.GetAuthorization(ctx, req.ContractId, fromAddr, operatorAddr)
 
 	amount := make([]collection.Coin, len(req.TokenIds))
 	for i, id := range req.TokenIds {
 		amount[i] = collection.Coin{TokenId: id, Amount: sdk.OneInt()}
 
+		if authErr != nil {
+			if approved, err := s.keeper.GetApproved(ctx, req.ContractId, id); err != nil || !approved.Equals(operatorAddr) {
+				return nil, collection.ErrCollectionNotApproved.Wrap(authErr.Error())
+			}
+		}
+
 		// legacy
 		if err := s.keeper.hasNFT(ctx, req.ContractId, id); err != nil {
 			return nil, err
@@ -181,6 +186,60 @@ func (s msgServer) OperatorSendNFT(c context.Context, req *collection.MsgOperato
 	return &collection.MsgOperatorSendNFTResponse{}, nil
 }
 
+func (s msgServer) ApproveNFT(c context.Context, req *collection.MsgApproveNFT) (*collection.MsgApproveNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	ownerAddr := sdk.MustAccAddressFromBech32(req.Owner)
+	approvedAddr := sdk.MustAccAddressFromBech32(req.Approved)
+
+	if err := s.keeper.ApproveNFT(ctx, req.ContractId, ownerAddr, approvedAddr, req.TokenId); err != nil {
+		return nil, err
+	}
+
+	event := collection.EventApprovedNFT{
+		ContractId: req.ContractId,
+		Owner:      req.Owner,
+		Approved:   req.Approved,
+		TokenId:    req.TokenId,
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+
+	return &collection.MsgApproveNFTResponse{}, nil
+}
+
+func (s msgServer) RevokeNFTApproval(c context.Context, req *collection.MsgRevokeNFTApproval) (*collection.MsgRevokeNFTApprovalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	ownerAddr := sdk.MustAccAddressFromBech32(req.Owner)
+
+	approvedAddr, err := s.keeper.RevokeNFTApproval(ctx, req.ContractId, ownerAddr, req.TokenId)
+	if err != nil {
+		return nil, err
+	}
+
+	event := collection.EventRevokedNFTApproval{
+		ContractId: req.ContractId,
+		Owner:      req.Owner,
+		Approved:   approvedAddr.String(),
+		TokenId:    req.TokenId,
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+
+	return &collection.MsgRevokeNFTApprovalResponse{}, nil
+}
+
 func (s msgServer) AuthorizeOperator(c context.Context, req *collection.MsgAuthorizeOperator) (*collection.MsgAuthorizeOperatorResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -320,8 +379,9 @@ func (s msgServer) IssueNFT(c context.Context, req *collection.MsgIssueNFT) (*co
 	}
 
 	class := &collection.NFTClass{
-		Name: req.Name,
-		Meta: req.Meta,
+		Name:    req.Name,
+		Meta:    req.Meta,
+		Royalty: req.Royalty,
 	}
 	id, err := s.keeper.CreateTokenClass(ctx, req.ContractId, class)
 	if err != nil {
@@ -334,6 +394,7 @@ func (s msgServer) IssueNFT(c context.Context, req *collection.MsgIssueNFT) (*co
 		TokenType:  *id,
 		Name:       class.Name,
 		Meta:       class.Meta,
+		Royalty:    class.Royalty,
 	}
 	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
 		panic(err)
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"fmt"
+
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/Finschia/finschia-sdk/testutil"
@@ -297,12 +299,14 @@ func (s *KeeperTestSuite) TestMsgSendNFT() {
 func (s *KeeperTestSuite) TestMsgOperatorSendNFT() {
 	rootNFTID := collection.NewNFTID(s.nftClassID, 1)
 	issuedTokenIDs := s.extractChainedNFTIDs(rootNFTID)
+	singleNFTID := collection.NewNFTID(s.nftClassID, s.numNFTs-2)
 
 	testCases := map[string]struct {
 		contractID string
 		operator   sdk.AccAddress
 		from       sdk.AccAddress
 		tokenID    string
+		approved   sdk.AccAddress
 		err        error
 		events     sdk.Events
 	}{
@@ -351,6 +355,25 @@ func (s *KeeperTestSuite) TestMsgOperatorSendNFT() {
 				},
 			},
 		},
+		"valid request (approved on the token)": {
+			contractID: s.contractID,
+			operator:   s.vendor,
+			from:       s.customer,
+			tokenID:    singleNFTID,
+			approved:   s.vendor,
+			events: sdk.Events{
+				sdk.Event{
+					Type: "lbm.collection.v1.EventSent",
+					Attributes: []abci.EventAttribute{
+						{Key: []byte("amount"), Value: testutil.MustJSONMarshal(collection.NewCoins(collection.Coin{TokenId: singleNFTID, Amount: sdk.OneInt()})), Index: false},
+						{Key: []byte("contract_id"), Value: testutil.W(s.contractID), Index: false},
+						{Key: []byte("from"), Value: testutil.W(s.customer.String()), Index: false},
+						{Key: []byte("operator"), Value: testutil.W(s.vendor.String()), Index: false},
+						{Key: []byte("to"), Value: testutil.W(s.vendor.String()), Index: false},
+					},
+				},
+			},
+		},
 		"contract not found": {
 			contractID: "deadbeef",
 			operator:   s.operator,
@@ -365,6 +388,14 @@ func (s *KeeperTestSuite) TestMsgOperatorSendNFT() {
 			tokenID:    rootNFTID,
 			err:        collection.ErrCollectionNotApproved,
 		},
+		"approved to another": {
+			contractID: s.contractID,
+			operator:   s.vendor,
+			from:       s.customer,
+			tokenID:    singleNFTID,
+			approved:   s.stranger,
+			err:        collection.ErrCollectionNotApproved,
+		},
 		"not found": {
 			contractID: s.contractID,
 			operator:   s.operator,
@@ -392,6 +423,12 @@ func (s *KeeperTestSuite) TestMsgOperatorSendNFT() {
 		s.Run(name, func() {
 			ctx, _ := s.ctx.CacheContext()
 
+			if tc.approved != nil {
+				err := s.keeper.ApproveNFT(ctx, tc.contractID, tc.from, tc.approved, tc.tokenID)
+				s.Require().NoError(err)
+				ctx = ctx.WithEventManager(sdk.NewEventManager())
+			}
+
 			req := &collection.MsgOperatorSendNFT{
 				ContractId: tc.contractID,
 				Operator:   tc.operator.String(),
@@ -411,6 +448,125 @@ func (s *KeeperTestSuite) TestMsgOperatorSendNFT() {
 	}
 }
 
+func (s *KeeperTestSuite) TestMsgApproveNFT() {
+	tokenID := collection.NewNFTID(s.nftClassID, 1)
+	testCases := map[string]struct {
+		contractID string
+		tokenID    string
+		err        error
+		events     sdk.Events
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			tokenID:    tokenID,
+			events: sdk.Events{sdk.Event{
+				Type: "lbm.collection.v1.EventApprovedNFT",
+				Attributes: []abci.EventAttribute{
+					{Key: []byte("approved"), Value: testutil.W(s.stranger.String()), Index: false},
+					{Key: []byte("contract_id"), Value: testutil.W(s.contractID), Index: false},
+					{Key: []byte("owner"), Value: testutil.W(s.customer.String()), Index: false},
+					{Key: []byte("token_id"), Value: testutil.W(tokenID), Index: false},
+				},
+			}},
+		},
+		"contract not found": {
+			contractID: "deadbeef",
+			tokenID:    tokenID,
+			err:        class.ErrContractNotExist,
+		},
+		"not owned by": {
+			contractID: s.contractID,
+			tokenID:    collection.NewNFTID(s.nftClassID, s.numNFTs+1),
+			err:        collection.ErrTokenNotOwnedBy,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			req := &collection.MsgApproveNFT{
+				ContractId: tc.contractID,
+				Owner:      s.customer.String(),
+				Approved:   s.stranger.String(),
+				TokenId:    tc.tokenID,
+			}
+			res, err := s.msgServer.ApproveNFT(sdk.WrapSDKContext(ctx), req)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().NotNil(res)
+			s.Require().Equal(tc.events, ctx.EventManager().Events())
+
+			approved, err := s.keeper.GetApproved(ctx, tc.contractID, tc.tokenID)
+			s.Require().NoError(err)
+			s.Require().Equal(s.stranger, approved)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgRevokeNFTApproval() {
+	tokenID := collection.NewNFTID(s.nftClassID, 1)
+	testCases := map[string]struct {
+		contractID string
+		approve    bool
+		err        error
+		events     sdk.Events
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			approve:    true,
+			events: sdk.Events{sdk.Event{
+				Type: "lbm.collection.v1.EventRevokedNFTApproval",
+				Attributes: []abci.EventAttribute{
+					{Key: []byte("approved"), Value: testutil.W(s.stranger.String()), Index: false},
+					{Key: []byte("contract_id"), Value: testutil.W(s.contractID), Index: false},
+					{Key: []byte("owner"), Value: testutil.W(s.customer.String()), Index: false},
+					{Key: []byte("token_id"), Value: testutil.W(tokenID), Index: false},
+				},
+			}},
+		},
+		"contract not found": {
+			contractID: "deadbeef",
+			err:        class.ErrContractNotExist,
+		},
+		"no approval": {
+			contractID: s.contractID,
+			err:        collection.ErrCollectionNotApproved,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			if tc.approve {
+				err := s.keeper.ApproveNFT(ctx, tc.contractID, s.customer, s.stranger, tokenID)
+				s.Require().NoError(err)
+			}
+
+			req := &collection.MsgRevokeNFTApproval{
+				ContractId: tc.contractID,
+				Owner:      s.customer.String(),
+				TokenId:    tokenID,
+			}
+			res, err := s.msgServer.RevokeNFTApproval(sdk.WrapSDKContext(ctx), req)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().NotNil(res)
+			s.Require().Equal(tc.events, ctx.EventManager().Events())
+
+			_, err = s.keeper.GetApproved(ctx, tc.contractID, tokenID)
+			s.Require().ErrorIs(err, collection.ErrCollectionNotApproved)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestMsgAuthorizeOperator() {
 	testCases := map[string]struct {
 		isNegativeCase bool
@@ -763,6 +919,7 @@ func (s *KeeperTestSuite) TestMsgIssueNFT() {
 						{Key: []byte("meta"), Value: testutil.W(""), Index: false},
 						{Key: []byte("name"), Value: testutil.W(""), Index: false},
 						{Key: []byte("operator"), Value: testutil.W(s.vendor.String()), Index: false},
+						{Key: []byte("royalty"), Value: []byte("null"), Index: false},
 						{Key: []byte("token_type"), Value: testutil.W(expectedTokenType), Index: false},
 					},
 				},
@@ -1049,7 +1206,7 @@ func (s *KeeperTestSuite) TestMsgMintNFT() {
 						{Key: []byte("contract_id"), Value: testutil.W(s.contractID), Index: false},
 						{Key: []byte("operator"), Value: testutil.W(s.vendor.String()), Index: false},
 						{Key: []byte("to"), Value: testutil.W(s.customer.String()), Index: false},
-						{Key: []byte("tokens"), Value: testutil.MustJSONMarshal(expectedTokens), Index: false},
+						{Key: []byte("tokens"), Value: []byte(fmt.Sprintf(`[{"token_id":%q,"name":%q,"meta":%q,"royalty":null}]`, expectedTokens[0].TokenId, expectedTokens[0].Name, expectedTokens[0].Meta)), Index: false},
 					},
 				},
 			},
@@ -4,6 +4,7 @@ import (
 	gogotypes "github.com/gogo/protobuf/types"
 
 	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 	"github.com/Finschia/finschia-sdk/x/collection"
 )
 
@@ -46,6 +47,31 @@ func (k Keeper) GetNFT(ctx sdk.Context, contractID, tokenID string) (*collection
 	return &token, nil
 }
 
+// GetRoyalty returns the royalty of the nft, which falls back to the one of its class.
+// It returns nil if neither of them has a royalty.
+func (k Keeper) GetRoyalty(ctx sdk.Context, contractID, tokenID string) (*collection.Royalty, error) {
+	token, err := k.GetNFT(ctx, contractID, tokenID)
+	if err != nil {
+		return nil, err
+	}
+	if token.Royalty != nil {
+		return token.Royalty, nil
+	}
+
+	classID := collection.SplitTokenID(tokenID)
+	class, err := k.GetTokenClass(ctx, contractID, classID)
+	if err != nil {
+		panic(err)
+	}
+
+	nftClass, ok := class.(*collection.NFTClass)
+	if !ok {
+		panic(sdkerrors.ErrInvalidType.Wrapf("not a class of non-fungible token: %s", classID))
+	}
+
+	return nftClass.Royalty, nil
+}
+
 func (k Keeper) setNFT(ctx sdk.Context, contractID string, token collection.NFT) {
 	store := ctx.KVStore(k.storeKey)
 	key := nftKey(contractID, token.TokenId)
@@ -112,6 +138,12 @@ func (k Keeper) Attach(ctx sdk.Context, contractID string, owner sdk.AccAddress,
 	k.setParent(ctx, contractID, subject, target)
 	k.setChild(ctx, contractID, target, subject)
 
+	// the subject has been removed from the index by subtractCoins
+	k.setNFTsByOwner(ctx, contractID, subject, owner)
+
+	// the approval on the subject has been cleared by subtractCoins
+	k.deleteApproval(ctx, contractID, root)
+
 	// finally, check the invariant
 	if err := k.validateDepthAndWidth(ctx, contractID, root); err != nil {
 		return err
@@ -154,8 +186,10 @@ func (k Keeper) Detach(ctx sdk.Context, contractID string, owner sdk.AccAddress,
 	k.deleteParent(ctx, contractID, subject)
 	k.deleteChild(ctx, contractID, *parent, subject)
 
-	// legacy
 	root := k.GetRoot(ctx, contractID, *parent)
+	k.deleteApproval(ctx, contractID, root)
+
+	// legacy
 	k.iterateDescendants(ctx, contractID, subject, func(descendantID string, _ int) (stop bool) {
 		event := collection.EventRootChanged{
 			ContractId: contractID,
@@ -204,6 +238,8 @@ func (k Keeper) getOwner(ctx sdk.Context, contractID, tokenID string) sdk.AccAdd
 }
 
 func (k Keeper) setOwner(ctx sdk.Context, contractID, tokenID string, owner sdk.AccAddress) {
+	k.deleteOwner(ctx, contractID, tokenID)
+
 	store := ctx.KVStore(k.storeKey)
 	key := ownerKey(contractID, tokenID)
 
@@ -212,11 +248,128 @@ func (k Keeper) setOwner(ctx sdk.Context, contractID, tokenID string, owner sdk.
 		panic(err)
 	}
 	store.Set(key, bz)
+
+	// update the index
+	k.setNFTsByOwner(ctx, contractID, tokenID, owner)
 }
 
 func (k Keeper) deleteOwner(ctx sdk.Context, contractID, tokenID string) {
 	store := ctx.KVStore(k.storeKey)
 	key := ownerKey(contractID, tokenID)
+	bz := store.Get(key)
+	if bz == nil {
+		return
+	}
+
+	var owner sdk.AccAddress
+	if err := owner.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	store.Delete(key)
+
+	// update the index
+	k.deleteNFTsByOwner(ctx, contractID, tokenID, owner)
+}
+
+// setNFTsByOwner indexes the token and its descendants by the owner of their root.
+func (k Keeper) setNFTsByOwner(ctx sdk.Context, contractID, tokenID string, owner sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	for _, id := range k.getSelfAndDescendants(ctx, contractID, tokenID) {
+		store.Set(nftByOwnerKey(owner, contractID, id), []byte{})
+	}
+}
+
+func (k Keeper) deleteNFTsByOwner(ctx sdk.Context, contractID, tokenID string, owner sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	for _, id := range k.getSelfAndDescendants(ctx, contractID, tokenID) {
+		store.Delete(nftByOwnerKey(owner, contractID, id))
+	}
+}
+
+// getSelfAndDescendants returns the token id followed by the ids of its descendants.
+func (k Keeper) getSelfAndDescendants(ctx sdk.Context, contractID, tokenID string) []string {
+	ids := []string{tokenID}
+	k.iterateDescendants(ctx, contractID, tokenID, func(descendantID string, _ int) (stop bool) {
+		ids = append(ids, descendantID)
+		return false
+	})
+	return ids
+}
+
+// ApproveNFT allows approved to send the token on behalf of its owner.
+// The approval is cleared once the token is transferred, attached or detached.
+func (k Keeper) ApproveNFT(ctx sdk.Context, contractID string, owner, approved sdk.AccAddress, tokenID string) error {
+	if err := k.hasNFT(ctx, contractID, tokenID); err != nil {
+		return err
+	}
+
+	if _, err := k.GetParent(ctx, contractID, tokenID); err == nil {
+		return collection.ErrTokenCannotTransferChildToken.Wrap(tokenID)
+	}
+
+	if !owner.Equals(k.getOwner(ctx, contractID, tokenID)) {
+		return collection.ErrTokenNotOwnedBy.Wrapf("%s is not owner of %s", owner, tokenID)
+	}
+
+	if approved.Equals(owner) {
+		return collection.ErrApproverProxySame.Wrap(owner.String())
+	}
+
+	k.setApproval(ctx, contractID, tokenID, approved)
+
+	return nil
+}
+
+// RevokeNFTApproval revokes the approval on the token, returning the address which was approved.
+func (k Keeper) RevokeNFTApproval(ctx sdk.Context, contractID string, owner sdk.AccAddress, tokenID string) (sdk.AccAddress, error) {
+	if err := k.hasNFT(ctx, contractID, tokenID); err != nil {
+		return nil, err
+	}
+
+	approved, err := k.GetApproved(ctx, contractID, tokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	// only root tokens could have approvals
+	if !owner.Equals(k.getOwner(ctx, contractID, tokenID)) {
+		return nil, collection.ErrTokenNotOwnedBy.Wrapf("%s is not owner of %s", owner, tokenID)
+	}
+
+	k.deleteApproval(ctx, contractID, tokenID)
+
+	return approved, nil
+}
+
+func (k Keeper) GetApproved(ctx sdk.Context, contractID, tokenID string) (sdk.AccAddress, error) {
+	store := ctx.KVStore(k.storeKey)
+	key := approvalKey(contractID, tokenID)
+	bz := store.Get(key)
+	if bz == nil {
+		return nil, collection.ErrCollectionNotApproved.Wrapf("no approval on %s", tokenID)
+	}
+
+	var approved sdk.AccAddress
+	if err := approved.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return approved, nil
+}
+
+func (k Keeper) setApproval(ctx sdk.Context, contractID, tokenID string, approved sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	key := approvalKey(contractID, tokenID)
+
+	bz, err := approved.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(key, bz)
+}
+
+func (k Keeper) deleteApproval(ctx sdk.Context, contractID, tokenID string) {
+	store := ctx.KVStore(k.storeKey)
+	key := approvalKey(contractID, tokenID)
 	store.Delete(key)
 }
 
@@ -1,7 +1,9 @@
 package keeper_test
 
 import (
+	sdk "github.com/Finschia/finschia-sdk/types"
 	"github.com/Finschia/finschia-sdk/x/collection"
+	"github.com/Finschia/finschia-sdk/x/collection/keeper"
 )
 
 func (s *KeeperTestSuite) TestAttach() {
@@ -140,3 +142,253 @@ func (s *KeeperTestSuite) TestGetNFT() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestNFTsByOwnerIndex() {
+	ctx, _ := s.ctx.CacheContext()
+	queryServer := keeper.NewQueryServer(s.keeper)
+	numNFTsOf := func(owner sdk.AccAddress) int {
+		res, err := queryServer.NFTsByOwner(sdk.WrapSDKContext(ctx), &collection.QueryNFTsByOwnerRequest{
+			Owner: owner.String(),
+		})
+		s.Require().NoError(err)
+		return len(res.Tokens)
+	}
+	// the attached tokens are counted too
+	s.Require().Equal(s.numNFTs, numNFTsOf(s.customer))
+	s.Require().Zero(numNFTsOf(s.stranger))
+
+	// send, with the descendants of the root
+	root := collection.NewNFTID(s.nftClassID, 1)
+	err := s.keeper.SendCoins(ctx, s.contractID, s.customer, s.stranger, collection.NewCoins(collection.NewCoin(root, sdk.OneInt())))
+	s.Require().NoError(err)
+	s.Require().Equal(s.numNFTs-s.depthLimit, numNFTsOf(s.customer))
+	s.Require().Equal(s.depthLimit, numNFTsOf(s.stranger))
+
+	// detach, which does not change the owner
+	child := collection.NewNFTID(s.nftClassID, 2)
+	err = s.keeper.Detach(ctx, s.contractID, s.stranger, child)
+	s.Require().NoError(err)
+	s.Require().Equal(s.depthLimit, numNFTsOf(s.stranger))
+
+	// attach, which does not change the owner
+	err = s.keeper.Attach(ctx, s.contractID, s.stranger, child, root)
+	s.Require().NoError(err)
+	s.Require().Equal(s.depthLimit, numNFTsOf(s.stranger))
+
+	// burn, with the descendants of the root
+	_, err = s.keeper.BurnCoins(ctx, s.contractID, s.stranger, collection.NewCoins(collection.NewCoin(root, sdk.OneInt())))
+	s.Require().NoError(err)
+	s.Require().Zero(numNFTsOf(s.stranger))
+}
+
+func (s *KeeperTestSuite) TestGetRoyalty() {
+	tokenID := collection.NewNFTID(s.nftClassID, 1)
+	classRoyalty := &collection.Royalty{
+		Recipient:   s.vendor.String(),
+		BasisPoints: 250,
+	}
+	tokenRoyalty := &collection.Royalty{
+		Recipient:   s.operator.String(),
+		BasisPoints: 500,
+	}
+
+	testCases := map[string]struct {
+		tokenID      string
+		classRoyalty *collection.Royalty
+		tokenRoyalty *collection.Royalty
+		err          error
+		expected     *collection.Royalty
+	}{
+		"no royalty": {
+			tokenID: tokenID,
+		},
+		"royalty of the class": {
+			tokenID:      tokenID,
+			classRoyalty: classRoyalty,
+			expected:     classRoyalty,
+		},
+		"royalty of the token": {
+			tokenID:      tokenID,
+			tokenRoyalty: tokenRoyalty,
+			expected:     tokenRoyalty,
+		},
+		"royalty of the token overrides the one of the class": {
+			tokenID:      tokenID,
+			classRoyalty: classRoyalty,
+			tokenRoyalty: tokenRoyalty,
+			expected:     tokenRoyalty,
+		},
+		"token not found": {
+			tokenID: collection.NewNFTID(s.nftClassID, s.numNFTs*3+1),
+			err:     collection.ErrTokenNotExist,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			err := s.keeper.ModifyTokenClass(ctx, s.contractID, s.nftClassID, s.vendor, []collection.Attribute{{
+				Key:   collection.AttributeKeyRoyalty.String(),
+				Value: collection.RoyaltyToAttributeValue(tc.classRoyalty),
+			}})
+			s.Require().NoError(err)
+			err = s.keeper.ModifyNFT(ctx, s.contractID, tokenID, s.vendor, []collection.Attribute{{
+				Key:   collection.AttributeKeyRoyalty.String(),
+				Value: collection.RoyaltyToAttributeValue(tc.tokenRoyalty),
+			}})
+			s.Require().NoError(err)
+
+			royalty, err := s.keeper.GetRoyalty(ctx, s.contractID, tc.tokenID)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+			s.Require().Equal(tc.expected, royalty)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestApproveNFT() {
+	testCases := map[string]struct {
+		tokenID  string
+		approved sdk.AccAddress
+		err      error
+	}{
+		"valid request": {
+			tokenID:  collection.NewNFTID(s.nftClassID, 1),
+			approved: s.stranger,
+		},
+		"not found": {
+			tokenID:  collection.NewNFTID(s.nftClassID, s.numNFTs*3+1),
+			approved: s.stranger,
+			err:      collection.ErrTokenNotExist,
+		},
+		"child token": {
+			tokenID:  collection.NewNFTID(s.nftClassID, 2),
+			approved: s.stranger,
+			err:      collection.ErrTokenCannotTransferChildToken,
+		},
+		"not owner": {
+			tokenID:  collection.NewNFTID(s.nftClassID, s.numNFTs+1),
+			approved: s.stranger,
+			err:      collection.ErrTokenNotOwnedBy,
+		},
+		"approve the owner": {
+			tokenID:  collection.NewNFTID(s.nftClassID, 1),
+			approved: s.customer,
+			err:      collection.ErrApproverProxySame,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			err := s.keeper.ApproveNFT(ctx, s.contractID, s.customer, tc.approved, tc.tokenID)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			approved, err := s.keeper.GetApproved(ctx, s.contractID, tc.tokenID)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.approved, approved)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestRevokeNFTApproval() {
+	tokenID := collection.NewNFTID(s.nftClassID, 1)
+	testCases := map[string]struct {
+		tokenID string
+		owner   sdk.AccAddress
+		approve bool
+		err     error
+	}{
+		"valid request": {
+			tokenID: tokenID,
+			owner:   s.customer,
+			approve: true,
+		},
+		"not found": {
+			tokenID: collection.NewNFTID(s.nftClassID, s.numNFTs*3+1),
+			owner:   s.customer,
+			err:     collection.ErrTokenNotExist,
+		},
+		"no approval": {
+			tokenID: tokenID,
+			owner:   s.customer,
+			err:     collection.ErrCollectionNotApproved,
+		},
+		"not owner": {
+			tokenID: tokenID,
+			owner:   s.vendor,
+			approve: true,
+			err:     collection.ErrTokenNotOwnedBy,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			if tc.approve {
+				err := s.keeper.ApproveNFT(ctx, s.contractID, s.customer, s.stranger, tokenID)
+				s.Require().NoError(err)
+			}
+
+			approved, err := s.keeper.RevokeNFTApproval(ctx, s.contractID, tc.owner, tc.tokenID)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+			s.Require().Equal(s.stranger, approved)
+
+			_, err = s.keeper.GetApproved(ctx, s.contractID, tc.tokenID)
+			s.Require().ErrorIs(err, collection.ErrCollectionNotApproved)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestNFTApprovalCleared() {
+	ctx, _ := s.ctx.CacheContext()
+	approve := func(tokenID string) {
+		err := s.keeper.ApproveNFT(ctx, s.contractID, s.customer, s.stranger, tokenID)
+		s.Require().NoError(err)
+	}
+	requireCleared := func(tokenID string) {
+		_, err := s.keeper.GetApproved(ctx, s.contractID, tokenID)
+		s.Require().ErrorIs(err, collection.ErrCollectionNotApproved)
+	}
+
+	// send
+	sent := collection.NewNFTID(s.nftClassID, 1)
+	approve(sent)
+	err := s.keeper.SendCoins(ctx, s.contractID, s.customer, s.vendor, collection.NewCoins(collection.NewCoin(sent, sdk.OneInt())))
+	s.Require().NoError(err)
+	requireCleared(sent)
+
+	// attach
+	subject := collection.NewNFTID(s.nftClassID, s.numNFTs-2)
+	root := collection.NewNFTID(s.nftClassID, s.numNFTs-1)
+	target := collection.NewNFTID(s.nftClassID, s.numNFTs)
+	approve(subject)
+	approve(root)
+	err = s.keeper.Attach(ctx, s.contractID, s.customer, subject, target)
+	s.Require().NoError(err)
+	requireCleared(subject)
+	requireCleared(root)
+
+	// detach
+	approve(root)
+	err = s.keeper.Detach(ctx, s.contractID, s.customer, target)
+	s.Require().NoError(err)
+	requireCleared(root)
+
+	// burn
+	approve(target)
+	_, err = s.keeper.BurnCoins(ctx, s.contractID, s.customer, collection.NewCoins(collection.NewCoin(target, sdk.OneInt())))
+	s.Require().NoError(err)
+	requireCleared(target)
+}
@@ -8,7 +8,7 @@ import (
 
 func (k Keeper) GetParams(ctx sdk.Context) collection.Params {
 	store := ctx.KVStore(k.storeKey)
-	key := paramsKey
+	key := ParamsKey
 	bz := store.Get(key)
 	if bz == nil {
 		panic(sdkerrors.ErrNotFound.Wrap("params does not exist"))
@@ -22,7 +22,7 @@ func (k Keeper) GetParams(ctx sdk.Context) collection.Params {
 
 func (k Keeper) SetParams(ctx sdk.Context, params collection.Params) {
 	store := ctx.KVStore(k.storeKey)
-	key := paramsKey
+	key := ParamsKey
 
 	bz, err := params.Marshal()
 	if err != nil {
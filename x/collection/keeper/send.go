@@ -55,6 +55,7 @@ func (k Keeper) subtractCoins(ctx sdk.Context, contractID string, address sdk.Ac
 
 		if err := collection.ValidateNFTID(coin.TokenId); err == nil {
 			k.deleteOwner(ctx, contractID, coin.TokenId)
+			k.deleteApproval(ctx, contractID, coin.TokenId)
 		}
 	}
 
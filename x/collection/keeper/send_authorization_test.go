@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/collection"
+)
+
+func (s *KeeperTestSuite) TestExecFTSendAuthorization() {
+	tokenID := collection.NewFTID(s.ftClassID)
+	testCases := map[string]struct {
+		amount sdk.Int
+		to     sdk.AccAddress
+		err    error
+		left   sdk.Int
+	}{
+		"send": {
+			amount: sdk.OneInt(),
+			to:     s.stranger,
+			left:   s.balance.Sub(sdk.OneInt()),
+		},
+		"send all": {
+			amount: s.balance,
+			to:     s.stranger,
+		},
+		"recipient not allowed": {
+			amount: sdk.OneInt(),
+			to:     s.vendor,
+			err:    sdkerrors.ErrUnauthorized,
+		},
+		"exceeding the limit": {
+			amount: s.balance.Add(sdk.OneInt()),
+			to:     s.stranger,
+			err:    sdkerrors.ErrInsufficientFunds,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			// customer allows operator to send its tokens to stranger
+			authorization := collection.NewFTSendAuthorization(
+				[]collection.FTSpendLimit{{
+					ContractId: s.contractID,
+					Amount:     collection.NewCoins(collection.NewCoin(tokenID, s.balance)),
+				}},
+				[]sdk.AccAddress{s.stranger},
+			)
+			err := s.authzKeeper.SaveGrant(ctx, s.operator, s.customer, authorization, ctx.BlockTime().Add(time.Hour))
+			s.Require().NoError(err)
+
+			strangerBalance := s.keeper.GetBalance(ctx, s.contractID, s.stranger, tokenID)
+
+			msg := &collection.MsgSendFT{
+				ContractId: s.contractID,
+				From:       s.customer.String(),
+				To:         tc.to.String(),
+				Amount:     collection.NewCoins(collection.NewCoin(tokenID, tc.amount)),
+			}
+			_, err = s.authzKeeper.DispatchActions(ctx, s.operator, []sdk.Msg{msg})
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().Equal(strangerBalance.Add(tc.amount), s.keeper.GetBalance(ctx, s.contractID, s.stranger, tokenID))
+
+			updated, _ := s.authzKeeper.GetCleanAuthorization(ctx, s.operator, s.customer, authorization.MsgTypeURL())
+			if tc.left.IsNil() {
+				s.Require().Nil(updated)
+				return
+			}
+			s.Require().NotNil(updated)
+			limits := updated.(*collection.FTSendAuthorization).SpendLimits
+			s.Require().Len(limits, 1)
+			s.Require().Len(limits[0].Amount, 1)
+			s.Require().True(tc.left.Equal(limits[0].Amount[0].Amount))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestExecNFTSendAuthorization() {
+	allowedID := collection.NewNFTID(s.nftClassID, 1)
+	otherID := collection.NewNFTID(s.nftClassID, s.numNFTs-1)
+	// the allowed token is the root of a chain
+	s.Require().NotEmpty(s.keeper.GetChildren(s.ctx, s.contractID, allowedID))
+
+	testCases := map[string]struct {
+		tokenTypes []string
+		tokenID    string
+		to         sdk.AccAddress
+		err        error
+		deleted    bool
+	}{
+		"send the allowed token with its descendants": {
+			tokenID: allowedID,
+			to:      s.stranger,
+			deleted: true,
+		},
+		"send by the token type": {
+			tokenTypes: []string{s.nftClassID},
+			tokenID:    otherID,
+			to:         s.stranger,
+		},
+		"token not allowed": {
+			tokenID: otherID,
+			to:      s.stranger,
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		"recipient not allowed": {
+			tokenID: allowedID,
+			to:      s.vendor,
+			err:     sdkerrors.ErrUnauthorized,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			// customer allows operator to send its tokens to stranger
+			authorization := collection.NewNFTSendAuthorization(
+				[]collection.NFTSendAllowance{{
+					ContractId: s.contractID,
+					TokenIds:   []string{allowedID},
+					TokenTypes: tc.tokenTypes,
+				}},
+				[]sdk.AccAddress{s.stranger},
+			)
+			err := s.authzKeeper.SaveGrant(ctx, s.operator, s.customer, authorization, ctx.BlockTime().Add(time.Hour))
+			s.Require().NoError(err)
+
+			msg := &collection.MsgSendNFT{
+				ContractId: s.contractID,
+				From:       s.customer.String(),
+				To:         tc.to.String(),
+				TokenIds:   []string{tc.tokenID},
+			}
+			_, err = s.authzKeeper.DispatchActions(ctx, s.operator, []sdk.Msg{msg})
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().Equal(s.stranger, s.keeper.GetRootOwner(ctx, s.contractID, tc.tokenID))
+
+			// the descendants follow the root, though they are not in the allowance
+			descendants := s.keeper.GetChildren(ctx, s.contractID, tc.tokenID)
+			for len(descendants) != 0 {
+				descendant := descendants[0]
+				descendants = append(descendants[1:], s.keeper.GetChildren(ctx, s.contractID, descendant)...)
+				s.Require().Equal(s.stranger, s.keeper.GetRootOwner(ctx, s.contractID, descendant))
+			}
+
+			updated, _ := s.authzKeeper.GetCleanAuthorization(ctx, s.operator, s.customer, authorization.MsgTypeURL())
+			if tc.deleted {
+				s.Require().Nil(updated)
+				return
+			}
+			s.Require().Equal(authorization, updated)
+		})
+	}
+}
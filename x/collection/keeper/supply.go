@@ -349,6 +349,11 @@ func (k Keeper) ModifyTokenClass(ctx sdk.Context, contractID, classID string, op
 			class.SetMeta(meta)
 		},
 	}
+	if nftClass, ok := class.(*collection.NFTClass); ok {
+		modifiers[collection.AttributeKeyRoyalty] = func(royalty string) {
+			nftClass.Royalty = mustRoyaltyFromAttributeValue(royalty)
+		}
+	}
 	for _, change := range changes {
 		key := collection.AttributeKeyFromString(change.Key)
 		modifiers[key](change.Value)
@@ -372,6 +377,9 @@ func (k Keeper) ModifyNFT(ctx sdk.Context, contractID, tokenID string, operator
 		collection.AttributeKeyMeta: func(meta string) {
 			token.Meta = meta
 		},
+		collection.AttributeKeyRoyalty: func(royalty string) {
+			token.Royalty = mustRoyaltyFromAttributeValue(royalty)
+		},
 	}
 	for _, change := range changes {
 		key := collection.AttributeKeyFromString(change.Key)
@@ -383,6 +391,15 @@ func (k Keeper) ModifyNFT(ctx sdk.Context, contractID, tokenID string, operator
 	return nil
 }
 
+// the value must have been validated by MsgModify.ValidateBasic.
+func mustRoyaltyFromAttributeValue(value string) *collection.Royalty {
+	royalty, err := collection.RoyaltyFromAttributeValue(value)
+	if err != nil {
+		panic(err)
+	}
+	return royalty
+}
+
 func (k Keeper) Grant(ctx sdk.Context, contractID string, granter, grantee sdk.AccAddress, permission collection.Permission) {
 	k.grant(ctx, contractID, grantee, permission)
 
@@ -465,25 +482,25 @@ func (k Keeper) setStatistic(ctx sdk.Context, keyPrefix []byte, contractID, clas
 }
 
 func (k Keeper) GetSupply(ctx sdk.Context, contractID, classID string) sdk.Int {
-	return k.getStatistic(ctx, supplyKeyPrefix, contractID, classID)
+	return k.getStatistic(ctx, SupplyKeyPrefix, contractID, classID)
 }
 
 func (k Keeper) GetMinted(ctx sdk.Context, contractID, classID string) sdk.Int {
-	return k.getStatistic(ctx, mintedKeyPrefix, contractID, classID)
+	return k.getStatistic(ctx, MintedKeyPrefix, contractID, classID)
 }
 
 func (k Keeper) GetBurnt(ctx sdk.Context, contractID, classID string) sdk.Int {
-	return k.getStatistic(ctx, burntKeyPrefix, contractID, classID)
+	return k.getStatistic(ctx, BurntKeyPrefix, contractID, classID)
 }
 
 func (k Keeper) setSupply(ctx sdk.Context, contractID, classID string, amount sdk.Int) {
-	k.setStatistic(ctx, supplyKeyPrefix, contractID, classID, amount)
+	k.setStatistic(ctx, SupplyKeyPrefix, contractID, classID, amount)
 }
 
 func (k Keeper) setMinted(ctx sdk.Context, contractID, classID string, amount sdk.Int) {
-	k.setStatistic(ctx, mintedKeyPrefix, contractID, classID, amount)
+	k.setStatistic(ctx, MintedKeyPrefix, contractID, classID, amount)
 }
 
 func (k Keeper) setBurnt(ctx sdk.Context, contractID, classID string, amount sdk.Int) {
-	k.setStatistic(ctx, burntKeyPrefix, contractID, classID, amount)
+	k.setStatistic(ctx, BurntKeyPrefix, contractID, classID, amount)
 }
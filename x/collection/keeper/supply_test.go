@@ -295,6 +295,7 @@ func (s *KeeperTestSuite) TestModifyTokenClass() {
 	changes := []collection.Attribute{
 		{Key: collection.AttributeKeyName.String(), Value: "arctic fox"},
 		{Key: collection.AttributeKeyMeta.String(), Value: "Arctic Fox"},
+		{Key: collection.AttributeKeyRoyalty.String(), Value: s.vendor.String() + ":250"},
 	}
 
 	for classID, classDesc := range classDescriptions {
@@ -317,6 +318,7 @@ func (s *KeeperTestSuite) TestModifyTokenClass() {
 
 			s.Require().Equal(changes[0].Value, nftClass.Name)
 			s.Require().Equal(changes[1].Value, nftClass.Meta)
+			s.Require().Equal(changes[2].Value, collection.RoyaltyToAttributeValue(nftClass.Royalty))
 		})
 	}
 }
@@ -330,6 +332,7 @@ func (s *KeeperTestSuite) TestModifyNFT() {
 	changes := []collection.Attribute{
 		{Key: collection.AttributeKeyName.String(), Value: "fennec fox 1"},
 		{Key: collection.AttributeKeyMeta.String(), Value: "Fennec Fox 1"},
+		{Key: collection.AttributeKeyRoyalty.String(), Value: s.vendor.String() + ":250"},
 	}
 
 	for tokenID, tokenDesc := range tokenDescriptions {
@@ -349,6 +352,7 @@ func (s *KeeperTestSuite) TestModifyNFT() {
 
 			s.Require().Equal(changes[0].Value, nft.Name)
 			s.Require().Equal(changes[1].Value, nft.Meta)
+			s.Require().Equal(changes[2].Value, collection.RoyaltyToAttributeValue(nft.Royalty))
 		})
 	}
 }
@@ -214,6 +214,21 @@ func validateTokenClassChange(change Attribute) error {
 	return validateChange(change, validators)
 }
 
+func validateNFTChange(change Attribute) error {
+	validators := map[string]func(string) error{
+		AttributeKeyName.String():    validateName,
+		AttributeKeyMeta.String():    validateMeta,
+		AttributeKeyRoyalty.String(): validateRoyalty,
+	}
+
+	return validateChange(change, validators)
+}
+
+func validateRoyalty(royalty string) error {
+	_, err := RoyaltyFromAttributeValue(royalty)
+	return err
+}
+
 func validateChange(change Attribute, validators map[string]func(string) error) error {
 	validator, ok := validators[change.Key]
 	if !ok {
@@ -420,6 +435,96 @@ func (m MsgOperatorSendNFT) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
 }
 
+var _ sdk.Msg = (*MsgApproveNFT)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgApproveNFT) ValidateBasic() error {
+	if err := ValidateContractID(m.ContractId); err != nil {
+		return err
+	}
+
+	ownerAcc, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", m.Owner)
+	}
+
+	approvedAcc, err := sdk.AccAddressFromBech32(m.Approved)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid approved address: %s", m.Approved)
+	}
+
+	if ownerAcc.Equals(approvedAcc) {
+		return ErrApproverProxySame
+	}
+
+	if err := ValidateTokenID(m.TokenId); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgApproveNFT) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgApproveNFT) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgApproveNFT) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgApproveNFT) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgRevokeNFTApproval)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgRevokeNFTApproval) ValidateBasic() error {
+	if err := ValidateContractID(m.ContractId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", m.Owner)
+	}
+
+	if err := ValidateTokenID(m.TokenId); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgRevokeNFTApproval) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgRevokeNFTApproval) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgRevokeNFTApproval) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgRevokeNFTApproval) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
 var _ sdk.Msg = (*MsgAuthorizeOperator)(nil)
 
 // ValidateBasic implements Msg.
@@ -636,6 +741,12 @@ func (m MsgIssueNFT) ValidateBasic() error {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", m.Owner)
 	}
 
+	if m.Royalty != nil {
+		if err := m.Royalty.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -981,6 +1092,8 @@ func (m MsgModify) ValidateBasic() error {
 		} else {
 			return ErrTokenIndexWithoutType.Wrap("token index without type")
 		}
+	} else if ValidateLegacyNFTClassID(m.TokenType) == nil {
+		validator = validateNFTChange
 	}
 	if len(m.Changes) == 0 {
 		return ErrEmptyChanges.Wrap("empty changes")
@@ -394,6 +394,129 @@ func TestMsgOperatorSendNFT(t *testing.T) {
 	}
 }
 
+func TestMsgApproveNFT(t *testing.T) {
+	addrs := make([]string, 2)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	}
+
+	testCases := map[string]struct {
+		contractID string
+		owner      string
+		approved   string
+		tokenID    string
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			owner:      addrs[0],
+			approved:   addrs[1],
+			tokenID:    collection.NewNFTID("deadbeef", 1),
+		},
+		"invalid contract id": {
+			owner:    addrs[0],
+			approved: addrs[1],
+			tokenID:  collection.NewNFTID("deadbeef", 1),
+			err:      class.ErrInvalidContractID,
+		},
+		"invalid owner": {
+			contractID: "deadbeef",
+			approved:   addrs[1],
+			tokenID:    collection.NewNFTID("deadbeef", 1),
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"empty approved": {
+			contractID: "deadbeef",
+			owner:      addrs[0],
+			tokenID:    collection.NewNFTID("deadbeef", 1),
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"owner and approved should be different": {
+			contractID: "deadbeef",
+			owner:      addrs[0],
+			approved:   addrs[0],
+			tokenID:    collection.NewNFTID("deadbeef", 1),
+			err:        collection.ErrApproverProxySame,
+		},
+		"invalid token id": {
+			contractID: "deadbeef",
+			owner:      addrs[0],
+			approved:   addrs[1],
+			err:        collection.ErrInvalidTokenID,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := collection.MsgApproveNFT{
+				ContractId: tc.contractID,
+				Owner:      tc.owner,
+				Approved:   tc.approved,
+				TokenId:    tc.tokenID,
+			}
+
+			require.ErrorIs(t, msg.ValidateBasic(), tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{sdk.MustAccAddressFromBech32(tc.owner)}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgRevokeNFTApproval(t *testing.T) {
+	addrs := make([]string, 1)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	}
+
+	testCases := map[string]struct {
+		contractID string
+		owner      string
+		tokenID    string
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			owner:      addrs[0],
+			tokenID:    collection.NewNFTID("deadbeef", 1),
+		},
+		"invalid contract id": {
+			owner:   addrs[0],
+			tokenID: collection.NewNFTID("deadbeef", 1),
+			err:     class.ErrInvalidContractID,
+		},
+		"invalid owner": {
+			contractID: "deadbeef",
+			tokenID:    collection.NewNFTID("deadbeef", 1),
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"invalid token id": {
+			contractID: "deadbeef",
+			owner:      addrs[0],
+			err:        collection.ErrInvalidTokenID,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := collection.MsgRevokeNFTApproval{
+				ContractId: tc.contractID,
+				Owner:      tc.owner,
+				TokenId:    tc.tokenID,
+			}
+
+			require.ErrorIs(t, msg.ValidateBasic(), tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{sdk.MustAccAddressFromBech32(tc.owner)}, msg.GetSigners())
+		})
+	}
+}
+
 func TestMsgAuthorizeOperator(t *testing.T) {
 	addrs := make([]string, 2)
 	for i := range addrs {
@@ -745,6 +868,7 @@ func TestMsgIssueNFT(t *testing.T) {
 		operator   sdk.AccAddress
 		name       string
 		meta       string
+		royalty    *collection.Royalty
 		err        error
 	}{
 		"valid msg": {
@@ -779,6 +903,27 @@ func TestMsgIssueNFT(t *testing.T) {
 			meta:       string(make([]rune, 1001)),
 			err:        collection.ErrInvalidMetaLength,
 		},
+		"valid royalty": {
+			contractID: contractID,
+			operator:   addrs[0],
+			name:       name,
+			meta:       meta,
+			royalty: &collection.Royalty{
+				Recipient:   addrs[0].String(),
+				BasisPoints: 250,
+			},
+		},
+		"invalid royalty": {
+			contractID: contractID,
+			operator:   addrs[0],
+			name:       name,
+			meta:       meta,
+			royalty: &collection.Royalty{
+				Recipient:   addrs[0].String(),
+				BasisPoints: collection.MaxRoyaltyBasisPoints + 1,
+			},
+			err: collection.ErrInvalidRoyalty,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -788,6 +933,7 @@ func TestMsgIssueNFT(t *testing.T) {
 				Owner:      tc.operator.String(),
 				Name:       tc.name,
 				Meta:       tc.meta,
+				Royalty:    tc.royalty,
 			}
 
 			require.ErrorIs(t, msg.ValidateBasic(), tc.err)
@@ -1350,6 +1496,40 @@ func TestMsgModify(t *testing.T) {
 			owner:      addrs[0],
 			changes:    changes,
 		},
+		"valid nft class royalty modification": {
+			contractID: "deadbeef",
+			tokenType:  "deadbeef",
+			owner:      addrs[0],
+			changes:    []collection.Attribute{{Key: collection.AttributeKeyRoyalty.String(), Value: addrs[0].String() + ":250"}},
+		},
+		"valid nft royalty removal": {
+			contractID: "deadbeef",
+			tokenType:  "deadbeef",
+			tokenIndex: "deadbeef",
+			owner:      addrs[0],
+			changes:    []collection.Attribute{{Key: collection.AttributeKeyRoyalty.String()}},
+		},
+		"royalty of ft class": {
+			contractID: "deadbeef",
+			tokenType:  "0eadbeef",
+			tokenIndex: "00000000",
+			owner:      addrs[0],
+			changes:    []collection.Attribute{{Key: collection.AttributeKeyRoyalty.String(), Value: addrs[0].String() + ":250"}},
+			err:        collection.ErrInvalidChangesField,
+		},
+		"royalty of contract": {
+			contractID: "deadbeef",
+			owner:      addrs[0],
+			changes:    []collection.Attribute{{Key: collection.AttributeKeyRoyalty.String(), Value: addrs[0].String() + ":250"}},
+			err:        collection.ErrInvalidChangesField,
+		},
+		"invalid royalty": {
+			contractID: "deadbeef",
+			tokenType:  "deadbeef",
+			owner:      addrs[0],
+			changes:    []collection.Attribute{{Key: collection.AttributeKeyRoyalty.String(), Value: addrs[0].String() + ":10001"}},
+			err:        collection.ErrInvalidRoyalty,
+		},
 		"invalid contract id": {
 			owner:   addrs[0],
 			changes: changes,
@@ -1884,6 +2064,25 @@ func TestAminoJSON(t *testing.T) {
 			"/lbm.collection.v1.MsgOperatorSendNFT",
 			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgOperatorSendNFT\",\"value\":{\"contract_id\":\"deadbeef\",\"from\":\"%s\",\"operator\":\"%s\",\"to\":\"%s\",\"token_ids\":[\"deadbeef00000001\"]}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[1].String(), addrs[0].String(), addrs[2].String()),
 		},
+		"MsgApproveNFT": {
+			&collection.MsgApproveNFT{
+				ContractId: contractId,
+				Owner:      addrs[0].String(),
+				Approved:   addrs[1].String(),
+				TokenId:    tokenIds[0],
+			},
+			"/lbm.collection.v1.MsgApproveNFT",
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgApproveNFT\",\"value\":{\"approved\":\"%s\",\"contract_id\":\"deadbeef\",\"owner\":\"%s\",\"token_id\":\"deadbeef00000001\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[1].String(), addrs[0].String()),
+		},
+		"MsgRevokeNFTApproval": {
+			&collection.MsgRevokeNFTApproval{
+				ContractId: contractId,
+				Owner:      addrs[0].String(),
+				TokenId:    tokenIds[0],
+			},
+			"/lbm.collection.v1.MsgRevokeNFTApproval",
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgRevokeNFTApproval\",\"value\":{\"contract_id\":\"deadbeef\",\"owner\":\"%s\",\"token_id\":\"deadbeef00000001\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String()),
+		},
 		"MsgAuthorizeOperator": {
 			&collection.MsgAuthorizeOperator{
 				ContractId: contractId,
@@ -2024,6 +2024,355 @@ func (m *QueryHoldersByOperatorResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryNFTsByOwnerRequest is the request type for the Query/NFTsByOwner RPC method.
+//
+// Since: 0.49.0 (finschia)
+type QueryNFTsByOwnerRequest struct {
+	// address of the owner.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// contract id associated with the contract (optional).
+	ContractId string `protobuf:"bytes,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// token type associated with the token type (optional).
+	// Note: requires contract_id.
+	TokenType string `protobuf:"bytes,3,opt,name=token_type,json=tokenType,proto3" json:"token_type,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryNFTsByOwnerRequest) Reset()         { *m = QueryNFTsByOwnerRequest{} }
+func (m *QueryNFTsByOwnerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNFTsByOwnerRequest) ProtoMessage()    {}
+func (*QueryNFTsByOwnerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{38}
+}
+func (m *QueryNFTsByOwnerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNFTsByOwnerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNFTsByOwnerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNFTsByOwnerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNFTsByOwnerRequest.Merge(m, src)
+}
+func (m *QueryNFTsByOwnerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNFTsByOwnerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNFTsByOwnerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNFTsByOwnerRequest proto.InternalMessageInfo
+
+func (m *QueryNFTsByOwnerRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryNFTsByOwnerRequest) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *QueryNFTsByOwnerRequest) GetTokenType() string {
+	if m != nil {
+		return m.TokenType
+	}
+	return ""
+}
+
+func (m *QueryNFTsByOwnerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryNFTsByOwnerResponse is the response type for the Query/NFTsByOwner RPC method.
+//
+// Since: 0.49.0 (finschia)
+type QueryNFTsByOwnerResponse struct {
+	// tokens is the information of the non-fungible tokens.
+	Tokens []ContractNFT `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryNFTsByOwnerResponse) Reset()         { *m = QueryNFTsByOwnerResponse{} }
+func (m *QueryNFTsByOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNFTsByOwnerResponse) ProtoMessage()    {}
+func (*QueryNFTsByOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{39}
+}
+func (m *QueryNFTsByOwnerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNFTsByOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNFTsByOwnerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNFTsByOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNFTsByOwnerResponse.Merge(m, src)
+}
+func (m *QueryNFTsByOwnerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNFTsByOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNFTsByOwnerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNFTsByOwnerResponse proto.InternalMessageInfo
+
+func (m *QueryNFTsByOwnerResponse) GetTokens() []ContractNFT {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+func (m *QueryNFTsByOwnerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryRoyaltyInfoRequest is the request type for the Query/RoyaltyInfo RPC method.
+//
+// Since: 0.49.0 (finschia)
+type QueryRoyaltyInfoRequest struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// token id associated with the non-fungible token.
+	TokenId string `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	// price of the sale.
+	SalePrice github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,3,opt,name=sale_price,json=salePrice,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"sale_price"`
+}
+
+func (m *QueryRoyaltyInfoRequest) Reset()         { *m = QueryRoyaltyInfoRequest{} }
+func (m *QueryRoyaltyInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRoyaltyInfoRequest) ProtoMessage()    {}
+func (*QueryRoyaltyInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{40}
+}
+func (m *QueryRoyaltyInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRoyaltyInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoyaltyInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRoyaltyInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoyaltyInfoRequest.Merge(m, src)
+}
+func (m *QueryRoyaltyInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRoyaltyInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoyaltyInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRoyaltyInfoRequest proto.InternalMessageInfo
+
+func (m *QueryRoyaltyInfoRequest) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *QueryRoyaltyInfoRequest) GetTokenId() string {
+	if m != nil {
+		return m.TokenId
+	}
+	return ""
+}
+
+// QueryRoyaltyInfoResponse is the response type for the Query/RoyaltyInfo RPC method.
+//
+// Since: 0.49.0 (finschia)
+type QueryRoyaltyInfoResponse struct {
+	// address which receives the royalty.
+	// empty if the token has no royalty.
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount of the royalty.
+	RoyaltyAmount github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,2,opt,name=royalty_amount,json=royaltyAmount,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"royalty_amount"`
+}
+
+func (m *QueryRoyaltyInfoResponse) Reset()         { *m = QueryRoyaltyInfoResponse{} }
+func (m *QueryRoyaltyInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRoyaltyInfoResponse) ProtoMessage()    {}
+func (*QueryRoyaltyInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{41}
+}
+func (m *QueryRoyaltyInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRoyaltyInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoyaltyInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRoyaltyInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoyaltyInfoResponse.Merge(m, src)
+}
+func (m *QueryRoyaltyInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRoyaltyInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoyaltyInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRoyaltyInfoResponse proto.InternalMessageInfo
+
+func (m *QueryRoyaltyInfoResponse) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+// QueryApprovedRequest is the request type for the Query/Approved RPC method.
+//
+// Since: 0.49.0 (finschia)
+type QueryApprovedRequest struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// token id associated with the non-fungible token.
+	TokenId string `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+}
+
+func (m *QueryApprovedRequest) Reset()         { *m = QueryApprovedRequest{} }
+func (m *QueryApprovedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryApprovedRequest) ProtoMessage()    {}
+func (*QueryApprovedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{42}
+}
+func (m *QueryApprovedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryApprovedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryApprovedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryApprovedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryApprovedRequest.Merge(m, src)
+}
+func (m *QueryApprovedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryApprovedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryApprovedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryApprovedRequest proto.InternalMessageInfo
+
+func (m *QueryApprovedRequest) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *QueryApprovedRequest) GetTokenId() string {
+	if m != nil {
+		return m.TokenId
+	}
+	return ""
+}
+
+// QueryApprovedResponse is the response type for the Query/Approved RPC method.
+//
+// Since: 0.49.0 (finschia)
+type QueryApprovedResponse struct {
+	// address approved on the token.
+	// empty if the token has no approval.
+	Approved string `protobuf:"bytes,1,opt,name=approved,proto3" json:"approved,omitempty"`
+}
+
+func (m *QueryApprovedResponse) Reset()         { *m = QueryApprovedResponse{} }
+func (m *QueryApprovedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryApprovedResponse) ProtoMessage()    {}
+func (*QueryApprovedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09de688aac2ee73, []int{43}
+}
+func (m *QueryApprovedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryApprovedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryApprovedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryApprovedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryApprovedResponse.Merge(m, src)
+}
+func (m *QueryApprovedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryApprovedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryApprovedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryApprovedResponse proto.InternalMessageInfo
+
+func (m *QueryApprovedResponse) GetApproved() string {
+	if m != nil {
+		return m.Approved
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryBalanceRequest)(nil), "lbm.collection.v1.QueryBalanceRequest")
 	proto.RegisterType((*QueryBalanceResponse)(nil), "lbm.collection.v1.QueryBalanceResponse")
@@ -2063,111 +2412,134 @@ func init() {
 	proto.RegisterType((*QueryIsOperatorForResponse)(nil), "lbm.collection.v1.QueryIsOperatorForResponse")
 	proto.RegisterType((*QueryHoldersByOperatorRequest)(nil), "lbm.collection.v1.QueryHoldersByOperatorRequest")
 	proto.RegisterType((*QueryHoldersByOperatorResponse)(nil), "lbm.collection.v1.QueryHoldersByOperatorResponse")
+	proto.RegisterType((*QueryNFTsByOwnerRequest)(nil), "lbm.collection.v1.QueryNFTsByOwnerRequest")
+	proto.RegisterType((*QueryNFTsByOwnerResponse)(nil), "lbm.collection.v1.QueryNFTsByOwnerResponse")
+	proto.RegisterType((*QueryRoyaltyInfoRequest)(nil), "lbm.collection.v1.QueryRoyaltyInfoRequest")
+	proto.RegisterType((*QueryRoyaltyInfoResponse)(nil), "lbm.collection.v1.QueryRoyaltyInfoResponse")
+	proto.RegisterType((*QueryApprovedRequest)(nil), "lbm.collection.v1.QueryApprovedRequest")
+	proto.RegisterType((*QueryApprovedResponse)(nil), "lbm.collection.v1.QueryApprovedResponse")
 }
 
 func init() { proto.RegisterFile("lbm/collection/v1/query.proto", fileDescriptor_a09de688aac2ee73) }
 
 var fileDescriptor_a09de688aac2ee73 = []byte{
-	// 1584 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x98, 0xdb, 0x6f, 0x1b, 0xc5,
-	0x17, 0xc7, 0x33, 0x69, 0x2e, 0xf6, 0x89, 0x2a, 0xfd, 0x3a, 0xbf, 0x5e, 0x9c, 0xa5, 0x71, 0xaa,
-	0xa5, 0xf4, 0x12, 0xda, 0xdd, 0x26, 0x5c, 0x0a, 0xb4, 0xa5, 0xc4, 0xa1, 0x49, 0x9d, 0xb6, 0x49,
-	0x6b, 0xd2, 0x22, 0x01, 0x52, 0xb5, 0xb6, 0xb7, 0xb6, 0x55, 0x7b, 0xc7, 0xdd, 0x5d, 0x57, 0xa4,
-	0x51, 0x5e, 0xe0, 0x85, 0x17, 0x24, 0x10, 0x6f, 0x88, 0xf6, 0x09, 0x78, 0x40, 0x54, 0x02, 0xc4,
-	0x1f, 0xd1, 0xc7, 0x0a, 0x5e, 0x10, 0x0f, 0x15, 0x6a, 0xf9, 0x43, 0xd0, 0xce, 0x9c, 0x59, 0xef,
-	0xae, 0xbd, 0xbe, 0x34, 0xdb, 0xa7, 0x78, 0x66, 0xcf, 0x9c, 0xf9, 0xcc, 0x39, 0x67, 0xcf, 0xce,
-	0x37, 0x30, 0x53, 0x2f, 0x36, 0xf4, 0x12, 0xab, 0xd7, 0xcd, 0x92, 0x5b, 0x63, 0x96, 0x7e, 0x77,
-	0x5e, 0xbf, 0xd3, 0x32, 0xed, 0x4d, 0xad, 0x69, 0x33, 0x97, 0xd1, 0x3d, 0xf5, 0x62, 0x43, 0x6b,
-	0x3f, 0xd6, 0xee, 0xce, 0x2b, 0x73, 0x25, 0xe6, 0x34, 0x98, 0xa3, 0x17, 0x0d, 0xc7, 0x14, 0xb6,
-	0xfa, 0xdd, 0xf9, 0xa2, 0xe9, 0x1a, 0xf3, 0x7a, 0xd3, 0xa8, 0xd4, 0x2c, 0x83, 0x1b, 0xf2, 0xe5,
-	0xca, 0xc1, 0x0a, 0x63, 0x95, 0xba, 0xa9, 0x1b, 0xcd, 0x9a, 0x6e, 0x58, 0x16, 0x73, 0xf9, 0x43,
-	0x07, 0x9f, 0xaa, 0x9d, 0x7b, 0x07, 0xb6, 0x12, 0x36, 0xd3, 0xe8, 0x81, 0x8f, 0x8a, 0xad, 0x5b,
-	0xba, 0x61, 0x21, 0x9b, 0xb2, 0xb7, 0xc2, 0x2a, 0x8c, 0xff, 0xd4, 0xbd, 0x5f, 0x62, 0x56, 0xbd,
-	0x0d, 0xff, 0xbf, 0xe6, 0x41, 0xe5, 0x8c, 0xba, 0x61, 0x95, 0xcc, 0x82, 0x79, 0xa7, 0x65, 0x3a,
-	0x2e, 0x9d, 0x85, 0xa9, 0x12, 0xb3, 0x5c, 0xdb, 0x28, 0xb9, 0x37, 0x6b, 0xe5, 0x0c, 0x39, 0x44,
-	0x8e, 0xa5, 0x0b, 0x20, 0xa7, 0xf2, 0x65, 0x9a, 0x81, 0x49, 0xa3, 0x5c, 0xb6, 0x4d, 0xc7, 0xc9,
-	0x8c, 0xf2, 0x87, 0x72, 0x48, 0xa7, 0x21, 0xe5, 0xb2, 0xdb, 0xa6, 0xe5, 0xad, 0xdb, 0x25, 0x1e,
-	0xf1, 0x71, 0xbe, 0xac, 0xae, 0xc3, 0xde, 0xf0, 0x66, 0x4e, 0x93, 0x59, 0x8e, 0x49, 0x4f, 0xc3,
-	0x64, 0x51, 0x4c, 0xf1, 0x9d, 0xa6, 0x16, 0x0e, 0x68, 0x1d, 0x81, 0xd4, 0x96, 0x58, 0xcd, 0xca,
-	0x8d, 0x3d, 0x7a, 0x32, 0x3b, 0x52, 0x90, 0xd6, 0xea, 0x77, 0x04, 0x0e, 0x70, 0x8f, 0x8b, 0xf5,
-	0x3a, 0x3a, 0x75, 0x12, 0x38, 0xc2, 0x32, 0x40, 0x3b, 0x37, 0xfc, 0x10, 0x53, 0x0b, 0x47, 0x34,
-	0x91, 0x48, 0xcd, 0x4b, 0xa4, 0x26, 0x92, 0x8e, 0x89, 0xd4, 0xae, 0x1a, 0x15, 0x19, 0xb9, 0x42,
-	0x60, 0xa5, 0xfa, 0x80, 0x40, 0xa6, 0x13, 0x0f, 0x0f, 0xfd, 0x36, 0xa4, 0xf0, 0x18, 0x4e, 0x86,
-	0x1c, 0xda, 0xd5, 0xff, 0xd4, 0xbe, 0x39, 0x5d, 0x09, 0xf1, 0x8d, 0x72, 0xbe, 0xa3, 0x7d, 0xf9,
-	0xc4, 0xbe, 0x21, 0xc0, 0x1b, 0x98, 0x90, 0xe5, 0x8d, 0x0f, 0x5a, 0xcd, 0x66, 0x7d, 0x73, 0xe0,
-	0xd8, 0x05, 0x93, 0x3c, 0x1a, 0x4a, 0xf2, 0x3b, 0xa3, 0x19, 0xa2, 0x56, 0x60, 0x5f, 0xc4, 0x2f,
-	0x1e, 0x7a, 0x15, 0x26, 0x1c, 0x3e, 0x23, 0x7c, 0xe6, 0x16, 0xbc, 0x93, 0xfd, 0xfd, 0x64, 0x76,
-	0xae, 0x52, 0x73, 0xab, 0xad, 0xa2, 0x56, 0x62, 0x0d, 0x7d, 0xb9, 0x66, 0x39, 0xa5, 0x6a, 0xcd,
-	0xd0, 0x6f, 0xe1, 0x8f, 0x93, 0x4e, 0xf9, 0xb6, 0xee, 0x6e, 0x36, 0x4d, 0x47, 0xcb, 0x5b, 0x6e,
-	0x01, 0x3d, 0xf0, 0x8d, 0xda, 0x07, 0xb8, 0x52, 0xb3, 0x5c, 0xb3, 0x9c, 0xfc, 0x01, 0xa4, 0xdf,
-	0xf6, 0x01, 0x1a, 0x7c, 0x66, 0x27, 0x07, 0x10, 0x1e, 0xf8, 0x46, 0xd7, 0xf1, 0xfd, 0x5b, 0xde,
-	0xc8, 0xb5, 0x6c, 0xcb, 0x4d, 0x8a, 0xbf, 0xec, 0xc7, 0x05, 0xdd, 0x22, 0xfe, 0x45, 0x18, 0x2f,
-	0x7a, 0x13, 0x3b, 0xa0, 0x17, 0x0e, 0xf8, 0x2e, 0x1f, 0x62, 0x94, 0xd6, 0x86, 0xae, 0x9f, 0x19,
-	0x00, 0x81, 0xef, 0xf9, 0xc5, 0x03, 0xa4, 0xf9, 0xcc, 0xc6, 0x66, 0xd3, 0x54, 0xcb, 0xb0, 0x3f,
-	0xea, 0x38, 0xf9, 0x02, 0x0a, 0xe2, 0x0f, 0x59, 0x3d, 0x83, 0xe3, 0xbf, 0xb8, 0xf2, 0xf1, 0x6b,
-	0x7f, 0x6d, 0xd8, 0xda, 0xe9, 0x43, 0x6f, 0xb4, 0xc3, 0xf2, 0x82, 0x8a, 0x47, 0x3d, 0x8d, 0xe8,
-	0x4b, 0x08, 0x35, 0x28, 0xba, 0x7a, 0x03, 0xd9, 0xda, 0x0b, 0x91, 0xed, 0x1c, 0xa4, 0xa4, 0x19,
-	0x7e, 0x43, 0x5e, 0xea, 0xda, 0x4d, 0x85, 0x89, 0xec, 0xa8, 0x72, 0x89, 0xfa, 0x09, 0x64, 0xb9,
-	0xdf, 0x0d, 0x2f, 0x0a, 0x4b, 0x75, 0xc3, 0x71, 0xbc, 0x50, 0xac, 0x19, 0x0d, 0x73, 0x98, 0x37,
-	0xb2, 0xe4, 0x2d, 0x0c, 0xbc, 0x91, 0x7c, 0x9c, 0x2f, 0xab, 0x6f, 0xc0, 0x6c, 0xac, 0x77, 0xe4,
-	0xa7, 0x30, 0x66, 0x19, 0x0d, 0x13, 0xfd, 0xf2, 0xdf, 0x7e, 0x7d, 0x6e, 0xc8, 0xd4, 0x24, 0x95,
-	0xe1, 0x8f, 0xb1, 0x3e, 0x03, 0x8e, 0x11, 0x63, 0x31, 0xb4, 0x50, 0x04, 0xf2, 0x60, 0x97, 0x40,
-	0xfa, 0x2b, 0x31, 0x92, 0x01, 0xe7, 0xeb, 0xb0, 0xa7, 0xed, 0x3c, 0x81, 0x7e, 0xa6, 0x2e, 0x03,
-	0x0d, 0x3a, 0x44, 0xd2, 0x53, 0x30, 0xce, 0x0d, 0x10, 0x72, 0xaf, 0x26, 0x6e, 0x3e, 0x9a, 0xbc,
-	0xf9, 0x68, 0x8b, 0xd6, 0x26, 0xc2, 0x09, 0x43, 0xb5, 0x00, 0xff, 0xe3, 0x7e, 0x0a, 0x8c, 0x25,
-	0xd6, 0x67, 0xf3, 0x78, 0x58, 0xe1, 0xd3, 0x47, 0x1b, 0xb3, 0x19, 0x93, 0x75, 0xb8, 0xbf, 0x4b,
-	0xf8, 0xbc, 0x57, 0x4b, 0xb0, 0x71, 0xcb, 0x50, 0x33, 0xbd, 0x68, 0x38, 0x57, 0x0d, 0xdb, 0x4c,
-	0xee, 0x5b, 0x70, 0x06, 0xb3, 0x1d, 0x70, 0x8c, 0xa0, 0x33, 0x00, 0x55, 0xc3, 0xb9, 0xd9, 0xe4,
-	0xb3, 0xdc, 0x71, 0xaa, 0x90, 0xae, 0x4a, 0x33, 0xbe, 0x78, 0x03, 0x83, 0x9f, 0x2c, 0xd2, 0x3a,
-	0x7e, 0xf5, 0x22, 0x3c, 0xaf, 0xc3, 0x44, 0x80, 0xa5, 0x5f, 0xe8, 0xd0, 0x96, 0x3b, 0x7c, 0x40,
-	0x64, 0x47, 0xa9, 0xd6, 0xea, 0x65, 0x3b, 0x91, 0xc2, 0x4b, 0xea, 0x1a, 0x28, 0x01, 0xf7, 0x45,
-	0x00, 0xf1, 0xd0, 0x6f, 0x41, 0xaa, 0x84, 0x73, 0x78, 0x0f, 0xec, 0x7d, 0x6c, 0xdf, 0x3a, 0xb1,
-	0x6b, 0xa0, 0x04, 0x9c, 0xe6, 0x80, 0x2b, 0xb6, 0x61, 0xb9, 0xa6, 0xc9, 0xff, 0x0c, 0x75, 0x99,
-	0xae, 0x88, 0x85, 0x32, 0x8a, 0x38, 0x4c, 0xec, 0x32, 0x7d, 0x9f, 0x80, 0xd2, 0x0d, 0x10, 0xc3,
-	0xf8, 0x26, 0x4c, 0xf0, 0x1d, 0xe5, 0x65, 0x3a, 0xd3, 0x25, 0x88, 0x7c, 0x89, 0xac, 0x1e, 0x61,
-	0x9d, 0xdc, 0x5d, 0xba, 0x89, 0xf1, 0xcb, 0x3b, 0xeb, 0x4d, 0xd3, 0x36, 0x5c, 0x66, 0x2f, 0x33,
-	0x7b, 0xe0, 0xf8, 0x29, 0x90, 0x62, 0xb8, 0x0c, 0x03, 0xe8, 0x8f, 0xe9, 0x7e, 0x98, 0xa8, 0xb2,
-	0x7a, 0xd9, 0xb4, 0x51, 0x4f, 0xe1, 0x48, 0x3d, 0x8b, 0x01, 0x89, 0xec, 0x88, 0x01, 0xc9, 0x02,
-	0x18, 0x2d, 0xb7, 0xca, 0xec, 0xda, 0x3d, 0xbc, 0x6e, 0xa4, 0x0a, 0x81, 0x19, 0xf5, 0x7b, 0x02,
-	0x33, 0xa2, 0x2f, 0x70, 0x6f, 0x4e, 0x6e, 0x53, 0x7a, 0x49, 0x04, 0x3a, 0xa9, 0xb4, 0x7f, 0x4e,
-	0xf0, 0xd3, 0xdc, 0x05, 0x13, 0x4f, 0x9a, 0x81, 0x49, 0x11, 0x11, 0x91, 0xfb, 0x74, 0x41, 0x0e,
-	0x13, 0x4b, 0xee, 0xc2, 0x97, 0xd3, 0x30, 0xce, 0x29, 0xe8, 0xcf, 0x04, 0x26, 0x51, 0xcb, 0xd1,
-	0x23, 0x5d, 0x6a, 0xac, 0x8b, 0x9a, 0x56, 0x8e, 0xf6, 0xb5, 0x13, 0x5b, 0xaa, 0x57, 0x3f, 0xfb,
-	0xf3, 0xdf, 0x6f, 0x46, 0x57, 0xe9, 0x45, 0xbd, 0x9b, 0xd6, 0x17, 0x71, 0x77, 0xf4, 0xad, 0x40,
-	0x56, 0xb6, 0x75, 0xa9, 0x0a, 0xf5, 0x2d, 0x94, 0xaf, 0xdb, 0xfa, 0x96, 0xec, 0x68, 0xdb, 0xf4,
-	0x21, 0x81, 0xa9, 0x80, 0xfa, 0xa4, 0x73, 0x71, 0x28, 0x9d, 0x0a, 0x5a, 0x79, 0x75, 0x20, 0x5b,
-	0x44, 0xbf, 0xc0, 0xd1, 0xcf, 0xd3, 0x73, 0x3b, 0x42, 0xa7, 0x3f, 0x11, 0x48, 0xc9, 0x4b, 0x3f,
-	0x8d, 0x8d, 0x5b, 0x44, 0x6f, 0x28, 0xc7, 0xfa, 0x1b, 0x22, 0xe6, 0x25, 0x8e, 0x99, 0xa3, 0xef,
-	0x0d, 0x81, 0x79, 0xcb, 0x9b, 0xf1, 0x43, 0xaa, 0x0b, 0xf5, 0xf0, 0xc5, 0x28, 0x41, 0x58, 0x71,
-	0xc5, 0xef, 0x05, 0x1b, 0x52, 0x17, 0xbd, 0x60, 0xc3, 0x6a, 0x21, 0x09, 0x58, 0xa1, 0x15, 0x3c,
-	0xd8, 0x1f, 0x09, 0x4c, 0xe2, 0x8d, 0x3e, 0xbe, 0x70, 0xc3, 0x52, 0x42, 0x39, 0xda, 0xd7, 0x0e,
-	0x49, 0x57, 0x39, 0xe9, 0x22, 0x3d, 0xff, 0xfc, 0xa4, 0x5c, 0x19, 0x78, 0xa0, 0xbf, 0x13, 0x48,
-	0xfb, 0xc2, 0x8f, 0xc6, 0x46, 0x2b, 0x2a, 0x3a, 0x95, 0xe3, 0x03, 0x58, 0x22, 0x6e, 0x81, 0xe3,
-	0x5e, 0xa6, 0xab, 0x43, 0xe0, 0xb6, 0xef, 0xc5, 0x3e, 0xb6, 0x37, 0x90, 0xf5, 0x20, 0xb1, 0xb1,
-	0x1a, 0x7a, 0x61, 0x87, 0xcb, 0xe1, 0xf8, 0x00, 0x96, 0x2f, 0x02, 0x5b, 0x54, 0x06, 0xfd, 0x85,
-	0x40, 0x4a, 0x2a, 0xbd, 0xf8, 0x1a, 0x8e, 0x68, 0x4c, 0xe5, 0x58, 0x7f, 0x43, 0x64, 0xbe, 0xc6,
-	0x99, 0x2f, 0xd1, 0x7c, 0x12, 0xcc, 0xbc, 0x46, 0xe8, 0xd7, 0x04, 0x52, 0x52, 0xc9, 0xc5, 0x23,
-	0x47, 0xb4, 0x65, 0x3c, 0x72, 0x54, 0x4b, 0xaa, 0x0b, 0x1c, 0xf9, 0x04, 0x9d, 0x1b, 0x1c, 0x99,
-	0xfe, 0x41, 0x80, 0x76, 0xca, 0x3b, 0x3a, 0x1f, 0xb7, 0x69, 0xac, 0xd0, 0x54, 0x16, 0x86, 0x59,
-	0x82, 0xc4, 0xd7, 0x39, 0xf1, 0x3a, 0xbd, 0x32, 0x74, 0x90, 0xb9, 0x44, 0xf5, 0xc2, 0x2c, 0xb5,
-	0xeb, 0x36, 0x57, 0xeb, 0x37, 0x3d, 0x01, 0xea, 0x7d, 0x3c, 0xd2, 0xbe, 0xd2, 0x8b, 0x2f, 0xe9,
-	0xa8, 0x3e, 0x8d, 0x2f, 0xe9, 0x0e, 0xc1, 0x89, 0x2d, 0xee, 0x02, 0x5d, 0x4a, 0xa0, 0x3c, 0xe8,
-	0xb7, 0x04, 0xc6, 0xf9, 0x16, 0xf4, 0x70, 0x4f, 0x02, 0xc9, 0xf9, 0x4a, 0x1f, 0x2b, 0x64, 0x7c,
-	0x9f, 0x33, 0xbe, 0x4b, 0xcf, 0x0e, 0xcb, 0x18, 0xec, 0x6f, 0xf4, 0x3e, 0x81, 0x31, 0x4f, 0x26,
-	0xd2, 0x97, 0xe3, 0x76, 0x0d, 0x08, 0x53, 0xe5, 0x70, 0x6f, 0xa3, 0x1d, 0xb4, 0x5d, 0x2b, 0xd2,
-	0x77, 0x3d, 0x01, 0xea, 0xb5, 0xdd, 0xdf, 0x08, 0xa4, 0x7d, 0x89, 0x18, 0x9f, 0xec, 0xa8, 0x3c,
-	0x8d, 0x4f, 0x76, 0x87, 0xde, 0xc4, 0xeb, 0xcd, 0x0a, 0xbd, 0xb0, 0x03, 0xdc, 0xb6, 0x60, 0xf5,
-	0xa0, 0x7f, 0x20, 0x30, 0x81, 0xc4, 0xb1, 0xc9, 0x0c, 0xe3, 0x1e, 0xe9, 0x67, 0x86, 0xac, 0x97,
-	0x39, 0xeb, 0x12, 0x5d, 0xdc, 0x01, 0x6b, 0x9b, 0xf3, 0xa1, 0xd7, 0xb2, 0xa4, 0x6e, 0x8b, 0x6f,
-	0x59, 0x61, 0xf1, 0xda, 0xa3, 0x65, 0x45, 0x44, 0xa4, 0xba, 0xf6, 0x1c, 0xaf, 0x51, 0x94, 0x56,
-	0xea, 0x4a, 0x8f, 0xf7, 0x57, 0x02, 0xbb, 0x43, 0x3a, 0x8b, 0x9e, 0x88, 0x63, 0xe9, 0xa6, 0x17,
-	0x95, 0x93, 0x03, 0x5a, 0x23, 0xfe, 0x12, 0xc7, 0x3f, 0x47, 0xcf, 0x0c, 0x81, 0x2f, 0xf4, 0x9b,
-	0xbe, 0x85, 0x3a, 0x73, 0x9b, 0x5a, 0xb0, 0x3b, 0xa4, 0x84, 0xe2, 0x91, 0xbb, 0x49, 0xb4, 0x78,
-	0xe4, 0xae, 0xf2, 0x4a, 0x1d, 0xa1, 0xf7, 0x60, 0x4f, 0x87, 0x26, 0xa1, 0xa7, 0x62, 0xdf, 0x86,
-	0x18, 0x95, 0xa5, 0xcc, 0x0f, 0xb1, 0x42, 0xee, 0x9d, 0x5b, 0x79, 0xf4, 0x34, 0x4b, 0x1e, 0x3f,
-	0xcd, 0x92, 0x7f, 0x9e, 0x66, 0xc9, 0x57, 0xcf, 0xb2, 0x23, 0x8f, 0x9f, 0x65, 0x47, 0xfe, 0x7a,
-	0x96, 0x1d, 0xf9, 0xe8, 0x64, 0xdf, 0xff, 0xc6, 0x7e, 0x1a, 0x08, 0x70, 0x71, 0x82, 0xff, 0xbf,
-	0xec, 0xb5, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xd7, 0x78, 0xf1, 0xf0, 0xd9, 0x1c, 0x00, 0x00,
+	// 1845 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x99, 0xdd, 0x6f, 0x1b, 0xc7,
+	0x11, 0xc0, 0x75, 0xb4, 0x3e, 0xc8, 0x11, 0x5c, 0x54, 0x6b, 0x49, 0xa6, 0xaf, 0x16, 0xe5, 0x5e,
+	0x5d, 0xeb, 0xc3, 0x16, 0xcf, 0x92, 0xdb, 0xba, 0xad, 0xed, 0xba, 0x92, 0x6a, 0xc9, 0x94, 0x6d,
+	0x49, 0x66, 0x65, 0x17, 0xfd, 0x00, 0x84, 0x23, 0x79, 0xa2, 0x08, 0x93, 0xb7, 0xf4, 0xdd, 0x51,
+	0x2d, 0x2d, 0xe8, 0xa5, 0x05, 0x8a, 0xbe, 0x35, 0x81, 0xdf, 0x82, 0xd8, 0x40, 0x80, 0x24, 0x08,
+	0x82, 0x18, 0x48, 0x82, 0x00, 0xfe, 0x17, 0xfc, 0x68, 0x24, 0x2f, 0x41, 0x1e, 0x8c, 0xc0, 0xce,
+	0x1f, 0x12, 0xdc, 0xee, 0x2c, 0x79, 0x77, 0xe4, 0xe9, 0x48, 0xf1, 0xfc, 0x24, 0xee, 0xde, 0xec,
+	0xec, 0x6f, 0x66, 0x67, 0x3f, 0x66, 0x04, 0x13, 0xe5, 0x5c, 0x45, 0xcd, 0xd3, 0x72, 0x59, 0xcf,
+	0xdb, 0x25, 0x6a, 0xa8, 0x7b, 0xf3, 0xea, 0xc3, 0x9a, 0x6e, 0xd6, 0xd3, 0x55, 0x93, 0xda, 0x94,
+	0x8c, 0x94, 0x73, 0x95, 0x74, 0xf3, 0x73, 0x7a, 0x6f, 0x5e, 0x9e, 0xcd, 0x53, 0xab, 0x42, 0x2d,
+	0x35, 0xa7, 0x59, 0x3a, 0x97, 0x55, 0xf7, 0xe6, 0x73, 0xba, 0xad, 0xcd, 0xab, 0x55, 0xad, 0x58,
+	0x32, 0x34, 0x26, 0xc8, 0x86, 0xcb, 0xa7, 0x8b, 0x94, 0x16, 0xcb, 0xba, 0xaa, 0x55, 0x4b, 0xaa,
+	0x66, 0x18, 0xd4, 0x66, 0x1f, 0x2d, 0xfc, 0xaa, 0xb4, 0xce, 0xed, 0x9a, 0x8a, 0xcb, 0x9c, 0x42,
+	0x0d, 0xac, 0x95, 0xab, 0xed, 0xa8, 0x9a, 0x81, 0x6c, 0xf2, 0x68, 0x91, 0x16, 0x29, 0xfb, 0xa9,
+	0x3a, 0xbf, 0x78, 0xaf, 0xf2, 0x00, 0x4e, 0xdc, 0x75, 0xa0, 0x96, 0xb4, 0xb2, 0x66, 0xe4, 0xf5,
+	0xac, 0xfe, 0xb0, 0xa6, 0x5b, 0x36, 0x99, 0x84, 0xe1, 0x3c, 0x35, 0x6c, 0x53, 0xcb, 0xdb, 0xdb,
+	0xa5, 0x42, 0x52, 0x3a, 0x23, 0x4d, 0x27, 0xb2, 0x20, 0xba, 0x32, 0x05, 0x92, 0x84, 0x21, 0xad,
+	0x50, 0x30, 0x75, 0xcb, 0x4a, 0xc6, 0xd8, 0x47, 0xd1, 0x24, 0xa7, 0x20, 0x6e, 0xd3, 0x07, 0xba,
+	0xe1, 0x8c, 0x3b, 0xc6, 0x3f, 0xb1, 0x76, 0xa6, 0xa0, 0x6c, 0xc0, 0xa8, 0x77, 0x32, 0xab, 0x4a,
+	0x0d, 0x4b, 0x27, 0x97, 0x61, 0x28, 0xc7, 0xbb, 0xd8, 0x4c, 0xc3, 0x0b, 0x27, 0xd3, 0x2d, 0x8e,
+	0x4c, 0x2f, 0xd3, 0x92, 0xb1, 0xd4, 0xff, 0xe2, 0xd5, 0x64, 0x5f, 0x56, 0x48, 0x2b, 0xef, 0x4b,
+	0x70, 0x92, 0x69, 0x5c, 0x2c, 0x97, 0x51, 0xa9, 0x15, 0x81, 0x09, 0x2b, 0x00, 0xcd, 0xb5, 0x61,
+	0x46, 0x0c, 0x2f, 0x9c, 0x4b, 0xf3, 0x85, 0x4c, 0x3b, 0x0b, 0x99, 0xe6, 0x8b, 0x8e, 0x0b, 0x99,
+	0xde, 0xd4, 0x8a, 0xc2, 0x73, 0x59, 0xd7, 0x48, 0xe5, 0xa9, 0x04, 0xc9, 0x56, 0x3c, 0x34, 0xfa,
+	0x77, 0x10, 0x47, 0x33, 0xac, 0xa4, 0x74, 0xe6, 0x58, 0xb8, 0xd5, 0x0d, 0x71, 0xb2, 0xea, 0xe1,
+	0x8b, 0x31, 0xbe, 0xa9, 0x50, 0x3e, 0x3e, 0xaf, 0x07, 0xf0, 0x3e, 0x2e, 0xc8, 0xca, 0xd6, 0x9f,
+	0x6b, 0xd5, 0x6a, 0xb9, 0xde, 0xb1, 0xef, 0xdc, 0x8b, 0x1c, 0xf3, 0x2c, 0xf2, 0xef, 0x63, 0x49,
+	0x49, 0x29, 0xc2, 0x98, 0x4f, 0x2f, 0x1a, 0xbd, 0x06, 0x83, 0x16, 0xeb, 0xe1, 0x3a, 0x97, 0x16,
+	0x1c, 0xcb, 0xbe, 0x7b, 0x35, 0x39, 0x5b, 0x2c, 0xd9, 0xbb, 0xb5, 0x5c, 0x3a, 0x4f, 0x2b, 0xea,
+	0x4a, 0xc9, 0xb0, 0xf2, 0xbb, 0x25, 0x4d, 0xdd, 0xc1, 0x1f, 0x73, 0x56, 0xe1, 0x81, 0x6a, 0xd7,
+	0xab, 0xba, 0x95, 0xce, 0x18, 0x76, 0x16, 0x35, 0xb0, 0x89, 0x9a, 0x06, 0xdc, 0x29, 0x19, 0xb6,
+	0x5e, 0x88, 0xde, 0x00, 0xa1, 0xb7, 0x69, 0x40, 0x85, 0xf5, 0xf4, 0x62, 0x00, 0xd7, 0xc0, 0x26,
+	0xba, 0x87, 0xfb, 0x6f, 0x65, 0x6b, 0xa9, 0x66, 0x1a, 0x76, 0x54, 0xfc, 0x05, 0x18, 0xf5, 0xaa,
+	0x45, 0xfc, 0x9b, 0x30, 0x90, 0x73, 0x3a, 0x7a, 0xa0, 0xe7, 0x0a, 0xd8, 0x2c, 0x7f, 0x41, 0x2f,
+	0xad, 0x77, 0x1d, 0x3f, 0x13, 0x00, 0x1c, 0xdf, 0xd1, 0x8b, 0x06, 0x24, 0x58, 0xcf, 0x56, 0xbd,
+	0xaa, 0x2b, 0x05, 0x18, 0xf7, 0x2b, 0x8e, 0x3e, 0x80, 0xdc, 0xf8, 0x5d, 0x46, 0x4f, 0xe7, 0xf8,
+	0x6f, 0x2f, 0x7c, 0x1a, 0xb1, 0xbf, 0xde, 0x6d, 0xec, 0x84, 0xd0, 0x6b, 0x30, 0xe6, 0xd3, 0x1b,
+	0x75, 0xf0, 0x28, 0x97, 0x11, 0x7d, 0x19, 0xa1, 0x3a, 0x45, 0x57, 0xee, 0xc3, 0x98, 0x6f, 0x20,
+	0xb2, 0x5d, 0x83, 0xb8, 0x10, 0xc3, 0x3b, 0xe4, 0x67, 0x6d, 0x4f, 0x53, 0x2e, 0x22, 0x4e, 0x54,
+	0x31, 0x44, 0xf9, 0x07, 0xa4, 0x98, 0xde, 0x2d, 0xc7, 0x0b, 0xcb, 0x65, 0xcd, 0xb2, 0x1c, 0x57,
+	0xac, 0x6b, 0x15, 0xbd, 0x9b, 0x1d, 0x99, 0x77, 0x06, 0xba, 0x76, 0x24, 0x6b, 0x67, 0x0a, 0xca,
+	0xaf, 0x61, 0x32, 0x50, 0x3b, 0xf2, 0x13, 0xe8, 0x37, 0xb4, 0x8a, 0x8e, 0x7a, 0xd9, 0xef, 0x46,
+	0x7c, 0x6e, 0x89, 0xa5, 0x89, 0x6a, 0x85, 0xff, 0x0e, 0xe3, 0x7e, 0xc5, 0x88, 0xb1, 0xe8, 0x19,
+	0xc8, 0x1d, 0x79, 0xba, 0x8d, 0x23, 0x1b, 0x23, 0xd1, 0x93, 0x2e, 0xe5, 0x1b, 0x30, 0xd2, 0x54,
+	0x1e, 0xc1, 0x79, 0xa6, 0xac, 0x00, 0x71, 0x2b, 0x44, 0xd2, 0x8b, 0x30, 0xc0, 0x04, 0x10, 0x72,
+	0x34, 0xcd, 0x5f, 0x3e, 0x69, 0xf1, 0xf2, 0x49, 0x2f, 0x1a, 0x75, 0x84, 0xe3, 0x82, 0x4a, 0x16,
+	0x7e, 0xca, 0xf4, 0x64, 0x29, 0x8d, 0xec, 0x9c, 0xcd, 0xc0, 0x88, 0x4b, 0x67, 0x03, 0xad, 0xdf,
+	0xa4, 0x54, 0xc4, 0xe1, 0x78, 0x1b, 0xf7, 0x39, 0x5b, 0x8b, 0xb3, 0x31, 0x49, 0xcf, 0x61, 0x7a,
+	0x53, 0xb3, 0x36, 0x35, 0x53, 0x8f, 0xee, 0x2e, 0xb8, 0x02, 0xe3, 0x7e, 0xc5, 0x08, 0x3a, 0x01,
+	0xb0, 0xab, 0x59, 0xdb, 0x55, 0xd6, 0xcb, 0x14, 0xc7, 0xb3, 0x89, 0x5d, 0x21, 0xc6, 0x06, 0x6f,
+	0xa1, 0xf3, 0xa3, 0x45, 0xda, 0x80, 0x13, 0x1e, 0xad, 0xc8, 0xf3, 0x2b, 0x18, 0x74, 0xb1, 0x84,
+	0xb9, 0x0e, 0x65, 0x99, 0xc2, 0xa7, 0x92, 0x38, 0x51, 0x76, 0x4b, 0xe5, 0x82, 0x19, 0x49, 0xe0,
+	0x45, 0xf5, 0x0c, 0x14, 0x80, 0x63, 0x3e, 0x40, 0x34, 0xfa, 0xb7, 0x10, 0xcf, 0x63, 0x1f, 0xbe,
+	0x03, 0x0f, 0x37, 0xbb, 0x21, 0x1d, 0xd9, 0x33, 0x50, 0x00, 0x9e, 0x62, 0x80, 0xab, 0xa6, 0x66,
+	0xd8, 0xba, 0xce, 0xfe, 0x74, 0xf5, 0x98, 0x2e, 0xf2, 0x81, 0xc2, 0x8b, 0xd8, 0x8c, 0xec, 0x31,
+	0xfd, 0x44, 0x02, 0xb9, 0x1d, 0x20, 0xba, 0xf1, 0x37, 0x30, 0xc8, 0x66, 0x14, 0x8f, 0xe9, 0x64,
+	0x1b, 0x27, 0xb2, 0x21, 0x22, 0x7a, 0xb8, 0x74, 0x74, 0x6f, 0xe9, 0x2a, 0xfa, 0x2f, 0x63, 0x6d,
+	0x54, 0x75, 0x53, 0xb3, 0xa9, 0xb9, 0x42, 0xcd, 0x8e, 0xfd, 0x27, 0x43, 0x9c, 0xe2, 0x30, 0x74,
+	0x60, 0xa3, 0x4d, 0xc6, 0x61, 0x70, 0x97, 0x96, 0x0b, 0xba, 0x89, 0xf9, 0x14, 0xb6, 0x94, 0xab,
+	0x20, 0xb7, 0x9b, 0x11, 0x1d, 0x92, 0x02, 0xd0, 0x6a, 0xf6, 0x2e, 0x35, 0x4b, 0x8f, 0xf0, 0xb9,
+	0x11, 0xcf, 0xba, 0x7a, 0x94, 0x0f, 0x25, 0x98, 0xe0, 0xe7, 0x02, 0xd3, 0x66, 0x2d, 0xd5, 0x85,
+	0x96, 0x48, 0xa0, 0xa3, 0x5a, 0xf6, 0xff, 0x48, 0x90, 0x0a, 0xc2, 0x44, 0x4b, 0x93, 0x30, 0xc4,
+	0x3d, 0xc2, 0xd7, 0x3e, 0x91, 0x15, 0xcd, 0xe8, 0x16, 0xf7, 0xb9, 0x48, 0x34, 0xd7, 0x57, 0xb6,
+	0x1c, 0x84, 0x7f, 0x1a, 0x7a, 0xc3, 0x4d, 0xa3, 0x30, 0x40, 0x9d, 0x36, 0x3a, 0x88, 0x37, 0xfc,
+	0xce, 0x8b, 0x85, 0xdc, 0xd1, 0xc7, 0x7c, 0x77, 0xb4, 0xcf, 0x7f, 0xfd, 0x47, 0xf6, 0xdf, 0x07,
+	0x22, 0x07, 0xf5, 0x90, 0xa3, 0xe7, 0xae, 0xc2, 0x20, 0x9b, 0x51, 0x6c, 0x9a, 0xd4, 0x21, 0x6f,
+	0x26, 0xd7, 0xc1, 0xcb, 0xc7, 0x44, 0xe7, 0xdd, 0x4f, 0x84, 0x77, 0xb3, 0xb4, 0xae, 0x95, 0xed,
+	0x7a, 0xc6, 0xd8, 0xa1, 0x51, 0x1c, 0xe0, 0x77, 0x01, 0x2c, 0xad, 0xac, 0x6f, 0x57, 0xcd, 0x52,
+	0x1e, 0x5d, 0x7c, 0xa4, 0x57, 0x6b, 0xc2, 0xd1, 0xb2, 0xe9, 0x28, 0x51, 0x1e, 0x0b, 0x77, 0x7a,
+	0x50, 0xd1, 0x9d, 0xa7, 0x21, 0x61, 0xea, 0xf9, 0x52, 0xb5, 0x24, 0xae, 0xb0, 0x44, 0xb6, 0xd9,
+	0x41, 0xfe, 0x0a, 0x3f, 0x31, 0xf9, 0xa0, 0x6d, 0xad, 0x42, 0x6b, 0x86, 0x9d, 0x8c, 0x1d, 0x99,
+	0xe8, 0x38, 0x6a, 0x5a, 0x64, 0x8a, 0x94, 0x2c, 0xde, 0x7e, 0x8b, 0xd5, 0xaa, 0x49, 0xf7, 0x22,
+	0x49, 0x83, 0x95, 0x4b, 0x30, 0xe6, 0xd3, 0x89, 0x56, 0xca, 0x10, 0xd7, 0xb0, 0x0f, 0x35, 0x36,
+	0xda, 0x0b, 0xff, 0x9d, 0x80, 0x01, 0x36, 0x8a, 0x7c, 0x26, 0xc1, 0x10, 0xd6, 0x3c, 0xc8, 0xb9,
+	0x36, 0x61, 0xd5, 0xa6, 0xea, 0x24, 0x4f, 0x85, 0xca, 0x71, 0x04, 0x65, 0xf3, 0xdf, 0xdf, 0xfc,
+	0xf0, 0x38, 0xb6, 0x46, 0x6e, 0xaa, 0xed, 0x6a, 0x62, 0xdc, 0x3a, 0x4b, 0xdd, 0x77, 0xd9, 0x7e,
+	0xa0, 0x8a, 0xea, 0x89, 0xba, 0x8f, 0x65, 0x9e, 0x03, 0x75, 0x5f, 0xd8, 0x7e, 0x40, 0x9e, 0x49,
+	0x30, 0xec, 0xaa, 0xd2, 0x90, 0xd9, 0x20, 0x94, 0xd6, 0x4a, 0x93, 0x7c, 0xbe, 0x23, 0x59, 0x44,
+	0xbf, 0xc1, 0xd0, 0xaf, 0x93, 0x6b, 0x3d, 0xa1, 0x93, 0x4f, 0x25, 0x88, 0x8b, 0xe4, 0x98, 0x04,
+	0xfa, 0xcd, 0x97, 0x97, 0xcb, 0xd3, 0xe1, 0x82, 0x88, 0x79, 0x8b, 0x61, 0x2e, 0x91, 0x3f, 0x76,
+	0x81, 0xb9, 0x63, 0x5b, 0x2e, 0x97, 0xaa, 0x3c, 0xcb, 0xfe, 0x5f, 0x4c, 0x42, 0x58, 0x9e, 0x0a,
+	0x1f, 0x06, 0xeb, 0xc9, 0xc2, 0xe5, 0xe9, 0x70, 0xc1, 0xe8, 0x60, 0x79, 0x4e, 0xed, 0xc0, 0x7e,
+	0x2c, 0xc1, 0x10, 0x66, 0xbe, 0xc1, 0x81, 0xeb, 0x4d, 0xb9, 0xe5, 0xa9, 0x50, 0x39, 0x24, 0x5d,
+	0x63, 0xa4, 0x8b, 0xe4, 0xfa, 0xd1, 0x49, 0x59, 0x06, 0xed, 0x80, 0x7e, 0x25, 0x41, 0xa2, 0x51,
+	0x20, 0x21, 0x81, 0xde, 0xf2, 0x17, 0x67, 0xe4, 0x99, 0x0e, 0x24, 0x11, 0x37, 0xcb, 0x70, 0x6f,
+	0x93, 0xb5, 0x2e, 0x70, 0x9b, 0x97, 0x5a, 0x03, 0xdb, 0x69, 0x88, 0x78, 0x10, 0xd8, 0x18, 0x0d,
+	0x87, 0x61, 0x7b, 0xc3, 0x61, 0xa6, 0x03, 0xc9, 0xb7, 0x81, 0xcd, 0x23, 0x83, 0x7c, 0x2e, 0x41,
+	0x5c, 0x54, 0x44, 0x82, 0x63, 0xd8, 0x57, 0x8b, 0x91, 0xa7, 0xc3, 0x05, 0x91, 0xf9, 0x2e, 0x63,
+	0xbe, 0x45, 0x32, 0x51, 0x30, 0xb3, 0x18, 0x21, 0xef, 0x4a, 0x10, 0x17, 0xb7, 0x77, 0x30, 0xb2,
+	0xaf, 0x06, 0x23, 0x4f, 0x87, 0x0b, 0x22, 0xf2, 0x02, 0x43, 0xbe, 0x40, 0x66, 0x3b, 0x47, 0x26,
+	0x5f, 0x4b, 0x40, 0x5a, 0xcb, 0x20, 0x64, 0x3e, 0x68, 0xd2, 0xc0, 0x82, 0x8c, 0xbc, 0xd0, 0xcd,
+	0x10, 0x24, 0xbe, 0xc7, 0x88, 0x37, 0xc8, 0x9d, 0xae, 0x9d, 0xcc, 0x4a, 0x39, 0x8e, 0x9b, 0x45,
+	0x8d, 0xe7, 0x80, 0xdd, 0xc6, 0xdb, 0x4e, 0xa1, 0xc6, 0xb9, 0x3c, 0x12, 0x8d, 0x8a, 0x48, 0x70,
+	0x48, 0xfb, 0xeb, 0x38, 0xf2, 0x4c, 0x07, 0x92, 0x9e, 0x23, 0xee, 0x06, 0x59, 0x8e, 0x20, 0x3c,
+	0xc8, 0x7b, 0x12, 0x0c, 0xb0, 0x29, 0xc8, 0xd9, 0x43, 0x09, 0x04, 0xe7, 0x2f, 0x43, 0xa4, 0x90,
+	0xf1, 0x4f, 0x8c, 0xf1, 0x0f, 0xe4, 0x6a, 0xb7, 0x8c, 0xee, 0xf3, 0x8d, 0x3c, 0x91, 0xa0, 0x3f,
+	0x4b, 0xa9, 0x4d, 0x7e, 0x11, 0x34, 0xab, 0xab, 0x80, 0x23, 0x9f, 0x3d, 0x5c, 0xa8, 0x87, 0x63,
+	0xd7, 0xf0, 0x9d, 0xbb, 0x26, 0xa5, 0xec, 0xd8, 0xfd, 0x52, 0x82, 0x44, 0xa3, 0x94, 0x12, 0xbc,
+	0xd8, 0xfe, 0x32, 0x8e, 0x3c, 0xd3, 0x81, 0xa4, 0xe7, 0x79, 0xb3, 0x4a, 0x6e, 0xf4, 0x80, 0xdb,
+	0x2c, 0xec, 0x38, 0xd0, 0x1f, 0x49, 0x30, 0x88, 0xc4, 0x81, 0x8b, 0xe9, 0xc5, 0x3d, 0x17, 0x26,
+	0x86, 0xac, 0xb7, 0x19, 0xeb, 0x32, 0x59, 0xec, 0x81, 0xb5, 0xc9, 0xf9, 0xcc, 0x39, 0xb2, 0x44,
+	0x7d, 0x23, 0xf8, 0xc8, 0xf2, 0x16, 0x79, 0xe4, 0xe9, 0x70, 0x41, 0xa4, 0x5d, 0x3f, 0xc2, 0x36,
+	0xf2, 0xd3, 0x8a, 0xfa, 0x8b, 0xc3, 0xfb, 0x85, 0x04, 0xc7, 0x3d, 0xf5, 0x08, 0x72, 0x21, 0x88,
+	0xa5, 0x5d, 0x5d, 0x45, 0x9e, 0xeb, 0x50, 0x1a, 0xf1, 0x97, 0x19, 0xfe, 0x35, 0x72, 0xa5, 0x0b,
+	0x7c, 0x5e, 0xe7, 0x50, 0xf7, 0x8b, 0x5c, 0xe3, 0x01, 0x31, 0xe0, 0xb8, 0xa7, 0x62, 0x10, 0x8c,
+	0xdc, 0xae, 0x94, 0x21, 0xcf, 0x75, 0x28, 0x8d, 0xc8, 0x7d, 0xe4, 0x11, 0x8c, 0xb4, 0xe4, 0xee,
+	0xe4, 0x62, 0xe0, 0x6e, 0x08, 0xa8, 0x46, 0xc8, 0xf3, 0x5d, 0x8c, 0x68, 0xcc, 0xfd, 0x7f, 0x09,
+	0x86, 0x5d, 0x89, 0x6f, 0xf0, 0xb3, 0xbe, 0x35, 0xaf, 0x97, 0xcf, 0x77, 0x24, 0x8b, 0x53, 0x4d,
+	0xb1, 0x95, 0xf9, 0x39, 0x99, 0x6c, 0xb3, 0x32, 0x3c, 0x80, 0x58, 0x59, 0xe0, 0x80, 0x3c, 0x97,
+	0x60, 0xd8, 0x95, 0x3b, 0x06, 0x13, 0xb5, 0xe6, 0xc2, 0xf2, 0xf9, 0x8e, 0x64, 0x91, 0x68, 0x83,
+	0x11, 0x65, 0xc8, 0x6a, 0x4f, 0x67, 0x1e, 0xcf, 0x57, 0x4b, 0x0e, 0xa9, 0xf3, 0x8a, 0x17, 0xc9,
+	0x60, 0xf0, 0xde, 0xf4, 0xa5, 0xa0, 0xf2, 0x74, 0xb8, 0x60, 0x0f, 0x57, 0x9c, 0x1f, 0x58, 0x24,
+	0xa2, 0x4b, 0xab, 0x2f, 0x5e, 0xa7, 0xa4, 0x97, 0xaf, 0x53, 0xd2, 0xf7, 0xaf, 0x53, 0xd2, 0x3b,
+	0x6f, 0x52, 0x7d, 0x2f, 0xdf, 0xa4, 0xfa, 0xbe, 0x7d, 0x93, 0xea, 0xfb, 0xdb, 0x5c, 0x68, 0x9a,
+	0xfd, 0x2f, 0xd7, 0xe4, 0xb9, 0x41, 0xf6, 0x0f, 0x85, 0x4b, 0x3f, 0x0e, 0x00, 0xa5, 0xb3, 0xd3,
+	0x34, 0xfa, 0x21, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2222,6 +2594,22 @@ type QueryClient interface {
 	IsOperatorFor(ctx context.Context, in *QueryIsOperatorForRequest, opts ...grpc.CallOption) (*QueryIsOperatorForResponse, error)
 	// HoldersByOperator queries holders of a given operator.
 	HoldersByOperator(ctx context.Context, in *QueryHoldersByOperatorRequest, opts ...grpc.CallOption) (*QueryHoldersByOperatorResponse, error)
+	// NFTsByOwner queries all the non-fungible tokens owned by an address.
+	// The results could be narrowed down to a contract or a token type of it.
+	// The tokens attached to another token are included, as they are owned by the
+	// owner of their root.
+	//
+	// Since: 0.49.0 (finschia)
+	NFTsByOwner(ctx context.Context, in *QueryNFTsByOwnerRequest, opts ...grpc.CallOption) (*QueryNFTsByOwnerResponse, error)
+	// RoyaltyInfo queries the royalty of a non-fungible token for a given sale price.
+	// The royalty of the token overrides the one of its token type.
+	//
+	// Since: 0.49.0 (finschia)
+	RoyaltyInfo(ctx context.Context, in *QueryRoyaltyInfoRequest, opts ...grpc.CallOption) (*QueryRoyaltyInfoResponse, error)
+	// Approved queries the address approved on a non-fungible token.
+	//
+	// Since: 0.49.0 (finschia)
+	Approved(ctx context.Context, in *QueryApprovedRequest, opts ...grpc.CallOption) (*QueryApprovedResponse, error)
 }
 
 type queryClient struct {
@@ -2410,6 +2798,33 @@ func (c *queryClient) HoldersByOperator(ctx context.Context, in *QueryHoldersByO
 	return out, nil
 }
 
+func (c *queryClient) NFTsByOwner(ctx context.Context, in *QueryNFTsByOwnerRequest, opts ...grpc.CallOption) (*QueryNFTsByOwnerResponse, error) {
+	out := new(QueryNFTsByOwnerResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/NFTsByOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RoyaltyInfo(ctx context.Context, in *QueryRoyaltyInfoRequest, opts ...grpc.CallOption) (*QueryRoyaltyInfoResponse, error) {
+	out := new(QueryRoyaltyInfoResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/RoyaltyInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Approved(ctx context.Context, in *QueryApprovedRequest, opts ...grpc.CallOption) (*QueryApprovedResponse, error) {
+	out := new(QueryApprovedResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Query/Approved", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Balance queries the balance of a single token class for a single account.
@@ -2452,6 +2867,22 @@ type QueryServer interface {
 	IsOperatorFor(context.Context, *QueryIsOperatorForRequest) (*QueryIsOperatorForResponse, error)
 	// HoldersByOperator queries holders of a given operator.
 	HoldersByOperator(context.Context, *QueryHoldersByOperatorRequest) (*QueryHoldersByOperatorResponse, error)
+	// NFTsByOwner queries all the non-fungible tokens owned by an address.
+	// The results could be narrowed down to a contract or a token type of it.
+	// The tokens attached to another token are included, as they are owned by the
+	// owner of their root.
+	//
+	// Since: 0.49.0 (finschia)
+	NFTsByOwner(context.Context, *QueryNFTsByOwnerRequest) (*QueryNFTsByOwnerResponse, error)
+	// RoyaltyInfo queries the royalty of a non-fungible token for a given sale price.
+	// The royalty of the token overrides the one of its token type.
+	//
+	// Since: 0.49.0 (finschia)
+	RoyaltyInfo(context.Context, *QueryRoyaltyInfoRequest) (*QueryRoyaltyInfoResponse, error)
+	// Approved queries the address approved on a non-fungible token.
+	//
+	// Since: 0.49.0 (finschia)
+	Approved(context.Context, *QueryApprovedRequest) (*QueryApprovedResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -2515,6 +2946,15 @@ func (*UnimplementedQueryServer) IsOperatorFor(ctx context.Context, req *QueryIs
 func (*UnimplementedQueryServer) HoldersByOperator(ctx context.Context, req *QueryHoldersByOperatorRequest) (*QueryHoldersByOperatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HoldersByOperator not implemented")
 }
+func (*UnimplementedQueryServer) NFTsByOwner(ctx context.Context, req *QueryNFTsByOwnerRequest) (*QueryNFTsByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NFTsByOwner not implemented")
+}
+func (*UnimplementedQueryServer) RoyaltyInfo(ctx context.Context, req *QueryRoyaltyInfoRequest) (*QueryRoyaltyInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoyaltyInfo not implemented")
+}
+func (*UnimplementedQueryServer) Approved(ctx context.Context, req *QueryApprovedRequest) (*QueryApprovedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Approved not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2862,27 +3302,81 @@ func _Query_HoldersByOperator_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "lbm.collection.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Balance",
-			Handler:    _Query_Balance_Handler,
-		},
-		{
-			MethodName: "AllBalances",
-			Handler:    _Query_AllBalances_Handler,
-		},
-		{
-			MethodName: "FTSupply",
-			Handler:    _Query_FTSupply_Handler,
-		},
-		{
-			MethodName: "FTMinted",
-			Handler:    _Query_FTMinted_Handler,
-		},
-		{
+func _Query_NFTsByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNFTsByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NFTsByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Query/NFTsByOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NFTsByOwner(ctx, req.(*QueryNFTsByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RoyaltyInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRoyaltyInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RoyaltyInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Query/RoyaltyInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RoyaltyInfo(ctx, req.(*QueryRoyaltyInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Approved_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryApprovedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Approved(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Query/Approved",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Approved(ctx, req.(*QueryApprovedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lbm.collection.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Balance",
+			Handler:    _Query_Balance_Handler,
+		},
+		{
+			MethodName: "AllBalances",
+			Handler:    _Query_AllBalances_Handler,
+		},
+		{
+			MethodName: "FTSupply",
+			Handler:    _Query_FTSupply_Handler,
+		},
+		{
+			MethodName: "FTMinted",
+			Handler:    _Query_FTMinted_Handler,
+		},
+		{
 			MethodName: "FTBurnt",
 			Handler:    _Query_FTBurnt_Handler,
 		},
@@ -2942,6 +3436,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "HoldersByOperator",
 			Handler:    _Query_HoldersByOperator_Handler,
 		},
+		{
+			MethodName: "NFTsByOwner",
+			Handler:    _Query_NFTsByOwner_Handler,
+		},
+		{
+			MethodName: "RoyaltyInfo",
+			Handler:    _Query_RoyaltyInfo_Handler,
+		},
+		{
+			MethodName: "Approved",
+			Handler:    _Query_Approved_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lbm/collection/v1/query.proto",
@@ -4388,115 +4894,374 @@ func (m *QueryHoldersByOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryNFTsByOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryNFTsByOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNFTsByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.TokenType) > 0 {
+		i -= len(m.TokenType)
+		copy(dAtA[i:], m.TokenType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenType)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.TokenId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryNFTsByOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Balance.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllBalancesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryNFTsByOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllBalancesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryNFTsByOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Balances) > 0 {
-		for _, e := range m.Balances {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFTSupplyRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRoyaltyInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoyaltyInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoyaltyInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.SalePrice.Size()
+		i -= size
+		if _, err := m.SalePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	l = len(m.TokenId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFTSupplyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRoyaltyInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Supply.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoyaltyInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoyaltyInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RoyaltyAmount.Size()
+		i -= size
+		if _, err := m.RoyaltyAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryApprovedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryApprovedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryApprovedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryApprovedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryApprovedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryApprovedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Approved) > 0 {
+		i -= len(m.Approved)
+		copy(dAtA[i:], m.Approved)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Approved)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllBalancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllBalancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFTSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFTSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Supply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
 }
 
 func (m *QueryFTMintedRequest) Size() (n int) {
@@ -4980,20 +5745,741 @@ func (m *QueryHoldersByOperatorResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNFTsByOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNFTsByOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRoyaltyInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.SalePrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRoyaltyInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.RoyaltyAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryApprovedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryApprovedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Approved)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllBalancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllBalancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllBalancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllBalancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, Coin{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFTSupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFTSupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFTSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFTSupplyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5016,15 +6502,15 @@ func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBalanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFTSupplyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFTSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5052,11 +6538,63 @@ func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractId = string(dAtA[iNdEx:postIndex])
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFTMintedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFTMintedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFTMintedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5084,9 +6622,9 @@ func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.ContractId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
 			}
@@ -5139,7 +6677,7 @@ func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFTMintedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5162,17 +6700,17 @@ func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBalanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFTMintedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFTMintedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5182,22 +6720,23 @@ func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5222,7 +6761,7 @@ func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllBalancesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFTBurntRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5245,10 +6784,10 @@ func (m *QueryAllBalancesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllBalancesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFTBurntRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFTBurntRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5285,7 +6824,7 @@ func (m *QueryAllBalancesRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5313,43 +6852,7 @@ func (m *QueryAllBalancesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5372,7 +6875,7 @@ func (m *QueryAllBalancesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllBalancesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFTBurntResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5395,51 +6898,17 @@ func (m *QueryAllBalancesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllBalancesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFTBurntResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFTBurntResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Balances = append(m.Balances, Coin{})
-			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Burnt", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5449,25 +6918,23 @@ func (m *QueryAllBalancesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Burnt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5492,7 +6959,7 @@ func (m *QueryAllBalancesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFTSupplyRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNFTSupplyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5515,10 +6982,10 @@ func (m *QueryFTSupplyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFTSupplyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNFTSupplyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFTSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNFTSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5555,7 +7022,7 @@ func (m *QueryFTSupplyRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5583,7 +7050,7 @@ func (m *QueryFTSupplyRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenId = string(dAtA[iNdEx:postIndex])
+			m.TokenType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5606,7 +7073,7 @@ func (m *QueryFTSupplyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFTSupplyResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNFTSupplyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5629,10 +7096,10 @@ func (m *QueryFTSupplyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFTSupplyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNFTSupplyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFTSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNFTSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5690,7 +7157,7 @@ func (m *QueryFTSupplyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFTMintedRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNFTMintedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5713,10 +7180,10 @@ func (m *QueryFTMintedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFTMintedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNFTMintedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFTMintedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNFTMintedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5753,7 +7220,7 @@ func (m *QueryFTMintedRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5781,7 +7248,7 @@ func (m *QueryFTMintedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenId = string(dAtA[iNdEx:postIndex])
+			m.TokenType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5804,7 +7271,7 @@ func (m *QueryFTMintedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFTMintedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNFTMintedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5827,10 +7294,10 @@ func (m *QueryFTMintedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFTMintedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNFTMintedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFTMintedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNFTMintedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5888,7 +7355,7 @@ func (m *QueryFTMintedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFTBurntRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNFTBurntRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5911,10 +7378,10 @@ func (m *QueryFTBurntRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFTBurntRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNFTBurntRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFTBurntRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNFTBurntRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5951,7 +7418,7 @@ func (m *QueryFTBurntRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5979,7 +7446,7 @@ func (m *QueryFTBurntRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenId = string(dAtA[iNdEx:postIndex])
+			m.TokenType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6002,7 +7469,7 @@ func (m *QueryFTBurntRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFTBurntResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNFTBurntResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6025,10 +7492,10 @@ func (m *QueryFTBurntResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFTBurntResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNFTBurntResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFTBurntResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNFTBurntResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6086,7 +7553,7 @@ func (m *QueryFTBurntResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNFTSupplyRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryContractRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6109,10 +7576,10 @@ func (m *QueryNFTSupplyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNFTSupplyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNFTSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6147,38 +7614,6 @@ func (m *QueryNFTSupplyRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ContractId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokenType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6200,7 +7635,7 @@ func (m *QueryNFTSupplyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNFTSupplyResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6223,17 +7658,17 @@ func (m *QueryNFTSupplyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNFTSupplyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNFTSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6243,23 +7678,22 @@ func (m *QueryNFTSupplyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Contract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6284,7 +7718,7 @@ func (m *QueryNFTSupplyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNFTMintedRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTokenClassTypeNameRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6307,10 +7741,10 @@ func (m *QueryNFTMintedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNFTMintedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTokenClassTypeNameRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNFTMintedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTokenClassTypeNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6347,7 +7781,7 @@ func (m *QueryNFTMintedRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6375,7 +7809,7 @@ func (m *QueryNFTMintedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenType = string(dAtA[iNdEx:postIndex])
+			m.ClassId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6398,7 +7832,7 @@ func (m *QueryNFTMintedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNFTMintedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTokenClassTypeNameResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6421,15 +7855,15 @@ func (m *QueryNFTMintedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNFTMintedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTokenClassTypeNameResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNFTMintedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTokenClassTypeNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6457,9 +7891,7 @@ func (m *QueryNFTMintedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6482,7 +7914,7 @@ func (m *QueryNFTMintedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNFTBurntRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTokenTypeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6505,10 +7937,10 @@ func (m *QueryNFTBurntRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNFTBurntRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTokenTypeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNFTBurntRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTokenTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6596,7 +8028,7 @@ func (m *QueryNFTBurntRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNFTBurntResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTokenTypeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6619,17 +8051,17 @@ func (m *QueryNFTBurntResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNFTBurntResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTokenTypeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNFTBurntResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTokenTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Burnt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6639,23 +8071,22 @@ func (m *QueryNFTBurntResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Burnt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6680,7 +8111,7 @@ func (m *QueryNFTBurntResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryContractRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTokenRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6703,10 +8134,10 @@ func (m *QueryContractRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContractRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTokenRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6741,6 +8172,38 @@ func (m *QueryContractRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ContractId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6762,7 +8225,7 @@ func (m *QueryContractRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryContractResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTokenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6785,15 +8248,15 @@ func (m *QueryContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTokenResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6820,7 +8283,7 @@ func (m *QueryContractResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Contract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6845,7 +8308,7 @@ func (m *QueryContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenClassTypeNameRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRootRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6868,10 +8331,10 @@ func (m *QueryTokenClassTypeNameRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenClassTypeNameRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRootRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenClassTypeNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRootRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6908,7 +8371,7 @@ func (m *QueryTokenClassTypeNameRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6936,7 +8399,7 @@ func (m *QueryTokenClassTypeNameRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClassId = string(dAtA[iNdEx:postIndex])
+			m.TokenId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6959,7 +8422,7 @@ func (m *QueryTokenClassTypeNameRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenClassTypeNameResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRootResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6982,17 +8445,17 @@ func (m *QueryTokenClassTypeNameResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenClassTypeNameResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRootResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenClassTypeNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7002,23 +8465,24 @@ func (m *QueryTokenClassTypeNameResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.Root.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7041,7 +8505,7 @@ func (m *QueryTokenClassTypeNameResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenTypeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHasParentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7064,10 +8528,10 @@ func (m *QueryTokenTypeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenTypeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHasParentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHasParentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7104,7 +8568,7 @@ func (m *QueryTokenTypeRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7132,7 +8596,7 @@ func (m *QueryTokenTypeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenType = string(dAtA[iNdEx:postIndex])
+			m.TokenId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7155,7 +8619,7 @@ func (m *QueryTokenTypeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenTypeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHasParentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7178,17 +8642,17 @@ func (m *QueryTokenTypeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenTypeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHasParentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHasParentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasParent", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7198,25 +8662,12 @@ func (m *QueryTokenTypeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TokenType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.HasParent = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7238,7 +8689,7 @@ func (m *QueryTokenTypeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7261,10 +8712,10 @@ func (m *QueryTokenRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7352,7 +8803,7 @@ func (m *QueryTokenRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7375,15 +8826,15 @@ func (m *QueryTokenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7410,7 +8861,7 @@ func (m *QueryTokenResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7435,7 +8886,7 @@ func (m *QueryTokenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRootRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryChildrenRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7458,10 +8909,10 @@ func (m *QueryRootRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRootRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChildrenRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRootRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChildrenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7528,6 +8979,42 @@ func (m *QueryRootRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.TokenId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7549,7 +9036,7 @@ func (m *QueryRootRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRootResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryChildrenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7572,15 +9059,15 @@ func (m *QueryRootResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRootResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChildrenResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChildrenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7607,7 +9094,44 @@ func (m *QueryRootResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Root.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Children = append(m.Children, NFT{})
+			if err := m.Children[len(m.Children)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7632,7 +9156,7 @@ func (m *QueryRootResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHasParentRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGranteeGrantsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7655,10 +9179,10 @@ func (m *QueryHasParentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHasParentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGranteeGrantsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHasParentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGranteeGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7695,7 +9219,7 @@ func (m *QueryHasParentRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7723,7 +9247,43 @@ func (m *QueryHasParentRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenId = string(dAtA[iNdEx:postIndex])
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7746,7 +9306,7 @@ func (m *QueryHasParentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHasParentResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGranteeGrantsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7769,17 +9329,17 @@ func (m *QueryHasParentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHasParentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGranteeGrantsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHasParentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGranteeGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasParent", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7789,12 +9349,62 @@ func (m *QueryHasParentResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.HasParent = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, Grant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7816,7 +9426,7 @@ func (m *QueryHasParentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParentRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIsOperatorForRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7839,10 +9449,10 @@ func (m *QueryParentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIsOperatorForRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIsOperatorForRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7879,7 +9489,7 @@ func (m *QueryParentRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7907,7 +9517,39 @@ func (m *QueryParentRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenId = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7930,7 +9572,7 @@ func (m *QueryParentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParentResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIsOperatorForResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7953,17 +9595,17 @@ func (m *QueryParentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIsOperatorForResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIsOperatorForResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorized", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7973,25 +9615,12 @@ func (m *QueryParentResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Authorized = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8013,7 +9642,7 @@ func (m *QueryParentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryChildrenRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHoldersByOperatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8036,10 +9665,10 @@ func (m *QueryChildrenRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryChildrenRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHoldersByOperatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryChildrenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHoldersByOperatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8076,7 +9705,7 @@ func (m *QueryChildrenRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8104,7 +9733,7 @@ func (m *QueryChildrenRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenId = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -8163,7 +9792,7 @@ func (m *QueryChildrenRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryChildrenResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHoldersByOperatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8186,17 +9815,17 @@ func (m *QueryChildrenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryChildrenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHoldersByOperatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryChildrenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHoldersByOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holders", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8206,25 +9835,23 @@ func (m *QueryChildrenResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Children = append(m.Children, NFT{})
-			if err := m.Children[len(m.Children)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Holders = append(m.Holders, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -8283,7 +9910,7 @@ func (m *QueryChildrenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGranteeGrantsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNFTsByOwnerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8306,15 +9933,15 @@ func (m *QueryGranteeGrantsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGranteeGrantsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNFTsByOwnerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGranteeGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNFTsByOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8342,11 +9969,11 @@ func (m *QueryGranteeGrantsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractId = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8374,9 +10001,41 @@ func (m *QueryGranteeGrantsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
+			m.ContractId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -8433,7 +10092,7 @@ func (m *QueryGranteeGrantsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGranteeGrantsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNFTsByOwnerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8456,15 +10115,15 @@ func (m *QueryGranteeGrantsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGranteeGrantsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNFTsByOwnerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGranteeGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNFTsByOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8491,8 +10150,8 @@ func (m *QueryGranteeGrantsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grants = append(m.Grants, Grant{})
-			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tokens = append(m.Tokens, ContractNFT{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8553,7 +10212,7 @@ func (m *QueryGranteeGrantsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIsOperatorForRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRoyaltyInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8576,10 +10235,10 @@ func (m *QueryIsOperatorForRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIsOperatorForRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRoyaltyInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIsOperatorForRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRoyaltyInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8616,7 +10275,7 @@ func (m *QueryIsOperatorForRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8644,11 +10303,11 @@ func (m *QueryIsOperatorForRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.TokenId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SalePrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8676,7 +10335,9 @@ func (m *QueryIsOperatorForRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holder = string(dAtA[iNdEx:postIndex])
+			if err := m.SalePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8699,7 +10360,7 @@ func (m *QueryIsOperatorForRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIsOperatorForResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRoyaltyInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8722,17 +10383,17 @@ func (m *QueryIsOperatorForResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIsOperatorForResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRoyaltyInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIsOperatorForResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRoyaltyInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authorized", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8742,12 +10403,58 @@ func (m *QueryIsOperatorForResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Authorized = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RoyaltyAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8769,7 +10476,7 @@ func (m *QueryIsOperatorForResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHoldersByOperatorRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryApprovedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8792,10 +10499,10 @@ func (m *QueryHoldersByOperatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHoldersByOperatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryApprovedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHoldersByOperatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryApprovedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8832,7 +10539,7 @@ func (m *QueryHoldersByOperatorRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8860,43 +10567,7 @@ func (m *QueryHoldersByOperatorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8919,7 +10590,7 @@ func (m *QueryHoldersByOperatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHoldersByOperatorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryApprovedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8942,15 +10613,15 @@ func (m *QueryHoldersByOperatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHoldersByOperatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryApprovedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHoldersByOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryApprovedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Approved", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8978,43 +10649,7 @@ func (m *QueryHoldersByOperatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holders = append(m.Holders, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Approved = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
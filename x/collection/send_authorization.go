@@ -0,0 +1,266 @@
+package collection
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/authz"
+)
+
+var _ authz.Authorization = (*FTSendAuthorization)(nil)
+
+// NewFTSendAuthorization creates a new FTSendAuthorization object.
+func NewFTSendAuthorization(spendLimits []FTSpendLimit, allowList []sdk.AccAddress) *FTSendAuthorization {
+	return &FTSendAuthorization{
+		SpendLimits: spendLimits,
+		AllowList:   authz.NewAllowList(allowList),
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a FTSendAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgSendFT{})
+}
+
+// Accept implements Authorization.Accept.
+func (a FTSendAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	m, ok := msg.(*MsgSendFT)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	if !authz.IsAllowed(a.AllowList, m.To) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot send to %s", m.To)
+	}
+
+	limits := make([]FTSpendLimit, 0, len(a.SpendLimits))
+	found := false
+	for _, limit := range a.SpendLimits {
+		if limit.ContractId != m.ContractId {
+			limits = append(limits, limit)
+			continue
+		}
+		found = true
+
+		left, err := subtractSpendLimit(limit.Amount, m.Amount)
+		if err != nil {
+			return authz.AcceptResponse{}, err
+		}
+		if len(left) != 0 {
+			limits = append(limits, FTSpendLimit{ContractId: limit.ContractId, Amount: left})
+		}
+	}
+	if !found {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("no spend limit on %s", m.ContractId)
+	}
+
+	if len(limits) == 0 {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authz.AcceptResponse{Accept: true, Delete: false, Updated: &FTSendAuthorization{
+		SpendLimits: limits,
+		AllowList:   a.AllowList,
+	}}, nil
+}
+
+// subtractSpendLimit returns the spend limit left after sending the amount,
+// omitting the tokens whose limit has been exhausted.
+func subtractSpendLimit(limit Coins, amount []Coin) (Coins, error) {
+	left := make(map[string]sdk.Int, len(limit))
+	for _, coin := range limit {
+		left[coin.TokenId] = coin.Amount
+	}
+
+	for _, coin := range amount {
+		remaining, ok := left[coin.TokenId]
+		if !ok {
+			return nil, sdkerrors.ErrUnauthorized.Wrapf("no spend limit on %s", coin.TokenId)
+		}
+		if remaining.LT(coin.Amount) {
+			return nil, sdkerrors.ErrInsufficientFunds.Wrap("requested amount is more than spend limit")
+		}
+		left[coin.TokenId] = remaining.Sub(coin.Amount)
+	}
+
+	var res Coins
+	for _, coin := range limit {
+		if amount := left[coin.TokenId]; amount.IsPositive() {
+			res = append(res, Coin{TokenId: coin.TokenId, Amount: amount})
+		}
+	}
+
+	return res, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a FTSendAuthorization) ValidateBasic() error {
+	if len(a.SpendLimits) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("spend limits cannot be empty")
+	}
+
+	seenContracts := map[string]bool{}
+	for _, limit := range a.SpendLimits {
+		if err := ValidateContractID(limit.ContractId); err != nil {
+			return err
+		}
+		if seenContracts[limit.ContractId] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate spend limits on %s", limit.ContractId)
+		}
+		seenContracts[limit.ContractId] = true
+
+		if len(limit.Amount) == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrapf("empty spend limit on %s", limit.ContractId)
+		}
+		if err := limit.Amount.ValidateBasic(); err != nil {
+			return ErrInvalidCoin.Wrap(err.Error())
+		}
+		if err := validateFTCoins(limit.Amount); err != nil {
+			return err
+		}
+	}
+
+	return authz.ValidateAllowList(a.AllowList)
+}
+
+var _ authz.Authorization = (*NFTSendAuthorization)(nil)
+
+// NewNFTSendAuthorization creates a new NFTSendAuthorization object.
+func NewNFTSendAuthorization(allowances []NFTSendAllowance, allowList []sdk.AccAddress) *NFTSendAuthorization {
+	return &NFTSendAuthorization{
+		Allowances: allowances,
+		AllowList:  authz.NewAllowList(allowList),
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a NFTSendAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgSendNFT{})
+}
+
+// Accept implements Authorization.Accept.
+func (a NFTSendAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	m, ok := msg.(*MsgSendNFT)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	if !authz.IsAllowed(a.AllowList, m.To) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot send to %s", m.To)
+	}
+
+	allowances := make([]NFTSendAllowance, 0, len(a.Allowances))
+	found := false
+	for _, allowance := range a.Allowances {
+		if allowance.ContractId != m.ContractId {
+			allowances = append(allowances, allowance)
+			continue
+		}
+		found = true
+
+		left, err := allowance.spend(m.TokenIds)
+		if err != nil {
+			return authz.AcceptResponse{}, err
+		}
+		if len(left.TokenIds) != 0 || len(left.TokenTypes) != 0 {
+			allowances = append(allowances, *left)
+		}
+	}
+	if !found {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("no allowance on %s", m.ContractId)
+	}
+
+	if len(allowances) == 0 {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authz.AcceptResponse{Accept: true, Delete: false, Updated: &NFTSendAuthorization{
+		Allowances: allowances,
+		AllowList:  a.AllowList,
+	}}, nil
+}
+
+// spend returns the allowance left after sending the tokens.
+// The tokens allowed by their token types remain allowed.
+// Only the ids in the message are checked, so the descendants of an allowed
+// token are sent along with it, whatever their ids and token types.
+func (a NFTSendAllowance) spend(tokenIDs []string) (*NFTSendAllowance, error) {
+	allowedTypes := map[string]bool{}
+	for _, tokenType := range a.TokenTypes {
+		allowedTypes[tokenType] = true
+	}
+
+	spent := map[string]bool{}
+	allowedIDs := map[string]bool{}
+	for _, id := range a.TokenIds {
+		allowedIDs[id] = true
+	}
+
+	for _, id := range tokenIDs {
+		if err := ValidateNFTID(id); err == nil && allowedTypes[SplitTokenID(id)] {
+			continue
+		}
+		if !allowedIDs[id] {
+			return nil, sdkerrors.ErrUnauthorized.Wrapf("cannot send %s", id)
+		}
+		spent[id] = true
+	}
+
+	var ids []string
+	for _, id := range a.TokenIds {
+		if !spent[id] {
+			ids = append(ids, id)
+		}
+	}
+
+	return &NFTSendAllowance{
+		ContractId: a.ContractId,
+		TokenIds:   ids,
+		TokenTypes: a.TokenTypes,
+	}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a NFTSendAuthorization) ValidateBasic() error {
+	if len(a.Allowances) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("allowances cannot be empty")
+	}
+
+	seenContracts := map[string]bool{}
+	for _, allowance := range a.Allowances {
+		if err := ValidateContractID(allowance.ContractId); err != nil {
+			return err
+		}
+		if seenContracts[allowance.ContractId] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate allowances on %s", allowance.ContractId)
+		}
+		seenContracts[allowance.ContractId] = true
+
+		if len(allowance.TokenIds) == 0 && len(allowance.TokenTypes) == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrapf("empty allowance on %s", allowance.ContractId)
+		}
+
+		seenIDs := map[string]bool{}
+		for _, id := range allowance.TokenIds {
+			if err := ValidateNFTID(id); err != nil {
+				return err
+			}
+			if seenIDs[id] {
+				return sdkerrors.ErrInvalidRequest.Wrapf("duplicate token id: %s", id)
+			}
+			seenIDs[id] = true
+		}
+
+		seenTypes := map[string]bool{}
+		for _, tokenType := range allowance.TokenTypes {
+			if err := ValidateLegacyNFTClassID(tokenType); err != nil {
+				return err
+			}
+			if seenTypes[tokenType] {
+				return sdkerrors.ErrInvalidRequest.Wrapf("duplicate token type: %s", tokenType)
+			}
+			seenTypes[tokenType] = true
+		}
+	}
+
+	return authz.ValidateAllowList(a.AllowList)
+}
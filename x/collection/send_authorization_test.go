@@ -0,0 +1,433 @@
+package collection_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/Finschia/finschia-sdk/crypto/keys/secp256k1"
+	"github.com/Finschia/finschia-sdk/simapp"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/collection"
+	"github.com/Finschia/finschia-sdk/x/token/class"
+)
+
+func TestFTSendAuthorization(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := make([]sdk.AccAddress, 3)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+	contractID := "deadbeef"
+	tokenID := collection.NewFTID("00bab10c")
+	limit := sdk.NewInt(1000)
+
+	testCases := map[string]struct {
+		allowList []sdk.AccAddress
+		msg       sdk.Msg
+		err       error
+		deleted   bool
+		left      sdk.Int
+	}{
+		"send partially": {
+			msg: &collection.MsgSendFT{
+				ContractId: contractID,
+				From:       addrs[0].String(),
+				To:         addrs[1].String(),
+				Amount:     collection.NewCoins(collection.NewCoin(tokenID, sdk.OneInt())),
+			},
+			left: limit.Sub(sdk.OneInt()),
+		},
+		"send all": {
+			msg: &collection.MsgSendFT{
+				ContractId: contractID,
+				From:       addrs[0].String(),
+				To:         addrs[1].String(),
+				Amount:     collection.NewCoins(collection.NewCoin(tokenID, limit)),
+			},
+			deleted: true,
+		},
+		"allowed recipient": {
+			allowList: []sdk.AccAddress{addrs[1]},
+			msg: &collection.MsgSendFT{
+				ContractId: contractID,
+				From:       addrs[0].String(),
+				To:         addrs[1].String(),
+				Amount:     collection.NewCoins(collection.NewCoin(tokenID, sdk.OneInt())),
+			},
+			left: limit.Sub(sdk.OneInt()),
+		},
+		"recipient not allowed": {
+			allowList: []sdk.AccAddress{addrs[2]},
+			msg: &collection.MsgSendFT{
+				ContractId: contractID,
+				From:       addrs[0].String(),
+				To:         addrs[1].String(),
+				Amount:     collection.NewCoins(collection.NewCoin(tokenID, sdk.OneInt())),
+			},
+			err: sdkerrors.ErrUnauthorized,
+		},
+		"exceeding the limit": {
+			msg: &collection.MsgSendFT{
+				ContractId: contractID,
+				From:       addrs[0].String(),
+				To:         addrs[1].String(),
+				Amount:     collection.NewCoins(collection.NewCoin(tokenID, limit.Add(sdk.OneInt()))),
+			},
+			err: sdkerrors.ErrInsufficientFunds,
+		},
+		"exceeding the limit (duplicate token ids)": {
+			msg: &collection.MsgSendFT{
+				ContractId: contractID,
+				From:       addrs[0].String(),
+				To:         addrs[1].String(),
+				Amount: []collection.Coin{
+					collection.NewCoin(tokenID, limit),
+					collection.NewCoin(tokenID, sdk.OneInt()),
+				},
+			},
+			err: sdkerrors.ErrInsufficientFunds,
+		},
+		"no limit on the token": {
+			msg: &collection.MsgSendFT{
+				ContractId: contractID,
+				From:       addrs[0].String(),
+				To:         addrs[1].String(),
+				Amount:     collection.NewCoins(collection.NewFTCoin("deadbeef", sdk.OneInt())),
+			},
+			err: sdkerrors.ErrUnauthorized,
+		},
+		"no limit on the contract": {
+			msg: &collection.MsgSendFT{
+				ContractId: "fee1dead",
+				From:       addrs[0].String(),
+				To:         addrs[1].String(),
+				Amount:     collection.NewCoins(collection.NewCoin(tokenID, sdk.OneInt())),
+			},
+			err: sdkerrors.ErrUnauthorized,
+		},
+		"type mismatch": {
+			msg: &collection.MsgSendNFT{
+				ContractId: contractID,
+				From:       addrs[0].String(),
+				To:         addrs[1].String(),
+				TokenIds:   []string{collection.NewNFTID("deadbeef", 1)},
+			},
+			err: sdkerrors.ErrInvalidType,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			authorization := collection.NewFTSendAuthorization([]collection.FTSpendLimit{{
+				ContractId: contractID,
+				Amount:     collection.NewCoins(collection.NewCoin(tokenID, limit)),
+			}}, tc.allowList)
+			require.NoError(t, authorization.ValidateBasic())
+
+			resp, err := authorization.Accept(ctx, tc.msg)
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+			require.Equal(t, sdk.MsgTypeURL(tc.msg), authorization.MsgTypeURL())
+
+			require.True(t, resp.Accept)
+			require.Equal(t, tc.deleted, resp.Delete)
+			if tc.deleted {
+				require.Nil(t, resp.Updated)
+				return
+			}
+
+			updated, ok := resp.Updated.(*collection.FTSendAuthorization)
+			require.True(t, ok)
+			require.Len(t, updated.SpendLimits, 1)
+			require.Len(t, updated.SpendLimits[0].Amount, 1)
+			require.True(t, tc.left.Equal(updated.SpendLimits[0].Amount[0].Amount))
+		})
+	}
+}
+
+func TestFTSendAuthorizationValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	amount := collection.NewCoins(collection.NewFTCoin("00bab10c", sdk.OneInt()))
+
+	testCases := map[string]struct {
+		spendLimits []collection.FTSpendLimit
+		allowList   []string
+		err         error
+	}{
+		"valid authorization": {
+			spendLimits: []collection.FTSpendLimit{{ContractId: "deadbeef", Amount: amount}},
+			allowList:   []string{addr.String()},
+		},
+		"empty spend limits": {
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		"invalid contract id": {
+			spendLimits: []collection.FTSpendLimit{{Amount: amount}},
+			err:         class.ErrInvalidContractID,
+		},
+		"duplicate contracts": {
+			spendLimits: []collection.FTSpendLimit{
+				{ContractId: "deadbeef", Amount: amount},
+				{ContractId: "deadbeef", Amount: amount},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		"empty amount": {
+			spendLimits: []collection.FTSpendLimit{{ContractId: "deadbeef"}},
+			err:         sdkerrors.ErrInvalidRequest,
+		},
+		"nil amount": {
+			spendLimits: []collection.FTSpendLimit{{ContractId: "deadbeef", Amount: collection.Coins{{TokenId: collection.NewFTID("00bab10c")}}}},
+			err:         collection.ErrInvalidCoin,
+		},
+		"duplicate token ids": {
+			spendLimits: []collection.FTSpendLimit{{ContractId: "deadbeef", Amount: append(amount, amount...)}},
+			err:         collection.ErrInvalidCoin,
+		},
+		"non-fungible token": {
+			spendLimits: []collection.FTSpendLimit{{ContractId: "deadbeef", Amount: collection.NewCoins(collection.NewNFTCoin("deadbeef", 1))}},
+			err:         collection.ErrInvalidTokenID,
+		},
+		"invalid address in allow list": {
+			spendLimits: []collection.FTSpendLimit{{ContractId: "deadbeef", Amount: amount}},
+			allowList:   []string{"invalid"},
+			err:         sdkerrors.ErrInvalidAddress,
+		},
+		"duplicate addresses in allow list": {
+			spendLimits: []collection.FTSpendLimit{{ContractId: "deadbeef", Amount: amount}},
+			allowList:   []string{addr.String(), addr.String()},
+			err:         sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			authorization := collection.FTSendAuthorization{
+				SpendLimits: tc.spendLimits,
+				AllowList:   tc.allowList,
+			}
+			require.ErrorIs(t, authorization.ValidateBasic(), tc.err)
+		})
+	}
+}
+
+func TestNFTSendAuthorization(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := make([]sdk.AccAddress, 3)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+	contractID := "deadbeef"
+	classID := "10000001"
+	allowedIDs := []string{collection.NewNFTID(classID, 1), collection.NewNFTID(classID, 2)}
+	otherID := collection.NewNFTID("10000002", 1)
+
+	testCases := map[string]struct {
+		tokenTypes []string
+		allowList  []sdk.AccAddress
+		msg        sdk.Msg
+		err        error
+		deleted    bool
+		left       []string
+	}{
+		"send partially": {
+			msg: &collection.MsgSendNFT{
+				ContractId: contractID,
+				From:       addrs[0].String(),
+				To:         addrs[1].String(),
+				TokenIds:   allowedIDs[:1],
+			},
+			left: allowedIDs[1:],
+		},
+		"send all": {
+			msg: &collection.MsgSendNFT{
+				ContractId: contractID,
+				From:       addrs[0].String(),
+				To:         addrs[1].String(),
+				TokenIds:   allowedIDs,
+			},
+			deleted: true,
+		},
+		"send all but the token type": {
+			tokenTypes: []string{classID},
+			msg: &collection.MsgSendNFT{
+				ContractId: contractID,
+				From:       addrs[0].String(),
+				To:         addrs[1].String(),
+				TokenIds:   allowedIDs,
+			},
+			left: allowedIDs,
+		},
+		"allowed token type": {
+			tokenTypes: []string{"10000002"},
+			msg: &collection.MsgSendNFT{
+				ContractId: contractID,
+				From:       addrs[0].String(),
+				To:         addrs[1].String(),
+				TokenIds:   []string{otherID},
+			},
+			left: allowedIDs,
+		},
+		"token not allowed": {
+			msg: &collection.MsgSendNFT{
+				ContractId: contractID,
+				From:       addrs[0].String(),
+				To:         addrs[1].String(),
+				TokenIds:   []string{allowedIDs[0], otherID},
+			},
+			err: sdkerrors.ErrUnauthorized,
+		},
+		"allowed recipient": {
+			allowList: []sdk.AccAddress{addrs[1]},
+			msg: &collection.MsgSendNFT{
+				ContractId: contractID,
+				From:       addrs[0].String(),
+				To:         addrs[1].String(),
+				TokenIds:   allowedIDs[:1],
+			},
+			left: allowedIDs[1:],
+		},
+		"recipient not allowed": {
+			allowList: []sdk.AccAddress{addrs[2]},
+			msg: &collection.MsgSendNFT{
+				ContractId: contractID,
+				From:       addrs[0].String(),
+				To:         addrs[1].String(),
+				TokenIds:   allowedIDs[:1],
+			},
+			err: sdkerrors.ErrUnauthorized,
+		},
+		"no allowance on the contract": {
+			msg: &collection.MsgSendNFT{
+				ContractId: "fee1dead",
+				From:       addrs[0].String(),
+				To:         addrs[1].String(),
+				TokenIds:   allowedIDs[:1],
+			},
+			err: sdkerrors.ErrUnauthorized,
+		},
+		"type mismatch": {
+			msg: &collection.MsgOperatorSendNFT{
+				ContractId: contractID,
+				Operator:   addrs[0].String(),
+				From:       addrs[2].String(),
+				To:         addrs[1].String(),
+				TokenIds:   allowedIDs[:1],
+			},
+			err: sdkerrors.ErrInvalidType,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			authorization := collection.NewNFTSendAuthorization([]collection.NFTSendAllowance{{
+				ContractId: contractID,
+				TokenIds:   allowedIDs,
+				TokenTypes: tc.tokenTypes,
+			}}, tc.allowList)
+			require.NoError(t, authorization.ValidateBasic())
+
+			resp, err := authorization.Accept(ctx, tc.msg)
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+			require.Equal(t, sdk.MsgTypeURL(tc.msg), authorization.MsgTypeURL())
+
+			require.True(t, resp.Accept)
+			require.Equal(t, tc.deleted, resp.Delete)
+			if tc.deleted {
+				require.Nil(t, resp.Updated)
+				return
+			}
+
+			updated, ok := resp.Updated.(*collection.NFTSendAuthorization)
+			require.True(t, ok)
+			require.Len(t, updated.Allowances, 1)
+			require.Equal(t, tc.left, updated.Allowances[0].TokenIds)
+			require.Equal(t, tc.tokenTypes, updated.Allowances[0].TokenTypes)
+		})
+	}
+}
+
+func TestNFTSendAuthorizationValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	tokenIDs := []string{collection.NewNFTID("10000001", 1)}
+
+	testCases := map[string]struct {
+		allowances []collection.NFTSendAllowance
+		allowList  []string
+		err        error
+	}{
+		"valid authorization": {
+			allowances: []collection.NFTSendAllowance{{ContractId: "deadbeef", TokenIds: tokenIDs}},
+			allowList:  []string{addr.String()},
+		},
+		"valid authorization (token types)": {
+			allowances: []collection.NFTSendAllowance{{ContractId: "deadbeef", TokenTypes: []string{"10000001"}}},
+		},
+		"empty allowances": {
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		"invalid contract id": {
+			allowances: []collection.NFTSendAllowance{{TokenIds: tokenIDs}},
+			err:        class.ErrInvalidContractID,
+		},
+		"duplicate contracts": {
+			allowances: []collection.NFTSendAllowance{
+				{ContractId: "deadbeef", TokenIds: tokenIDs},
+				{ContractId: "deadbeef", TokenIds: tokenIDs},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		"empty allowance": {
+			allowances: []collection.NFTSendAllowance{{ContractId: "deadbeef"}},
+			err:        sdkerrors.ErrInvalidRequest,
+		},
+		"invalid token id": {
+			allowances: []collection.NFTSendAllowance{{ContractId: "deadbeef", TokenIds: []string{collection.NewFTID("00bab10c")}}},
+			err:        sdkerrors.ErrInvalidRequest,
+		},
+		"duplicate token ids": {
+			allowances: []collection.NFTSendAllowance{{ContractId: "deadbeef", TokenIds: append(tokenIDs, tokenIDs...)}},
+			err:        sdkerrors.ErrInvalidRequest,
+		},
+		"invalid token type": {
+			allowances: []collection.NFTSendAllowance{{ContractId: "deadbeef", TokenTypes: []string{"00bab10c"}}},
+			err:        collection.ErrInvalidTokenType,
+		},
+		"duplicate token types": {
+			allowances: []collection.NFTSendAllowance{{ContractId: "deadbeef", TokenTypes: []string{"10000001", "10000001"}}},
+			err:        sdkerrors.ErrInvalidRequest,
+		},
+		"invalid address in allow list": {
+			allowances: []collection.NFTSendAllowance{{ContractId: "deadbeef", TokenIds: tokenIDs}},
+			allowList:  []string{"invalid"},
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"duplicate addresses in allow list": {
+			allowances: []collection.NFTSendAllowance{{ContractId: "deadbeef", TokenIds: tokenIDs}},
+			allowList:  []string{addr.String(), addr.String()},
+			err:        sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			authorization := collection.NFTSendAuthorization{
+				Allowances: tc.allowances,
+				AllowList:  tc.allowList,
+			}
+			require.ErrorIs(t, authorization.ValidateBasic(), tc.err)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/Finschia/finschia-sdk/codec"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/types/kv"
+	"github.com/Finschia/finschia-sdk/x/collection"
+	"github.com/Finschia/finschia-sdk/x/collection/keeper"
+)
+
+// NewDecodeStore returns a decoder function closure that umarshals the KVPair's
+// Value to the corresponding collection type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], keeper.ParamsKey):
+			var paramsA, paramsB collection.Params
+			cdc.MustUnmarshal(kvA.Value, &paramsA)
+			cdc.MustUnmarshal(kvB.Value, &paramsB)
+			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+		case bytes.Equal(kvA.Key[:1], keeper.ContractKeyPrefix):
+			var contractA, contractB collection.Contract
+			cdc.MustUnmarshal(kvA.Value, &contractA)
+			cdc.MustUnmarshal(kvB.Value, &contractB)
+			return fmt.Sprintf("%v\n%v", contractA, contractB)
+		case bytes.Equal(kvA.Key[:1], keeper.ClassKeyPrefix):
+			var classA, classB collection.TokenClass
+			if err := cdc.UnmarshalInterface(kvA.Value, &classA); err != nil {
+				panic(err)
+			}
+			if err := cdc.UnmarshalInterface(kvB.Value, &classB); err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("%v\n%v", classA, classB)
+		case bytes.Equal(kvA.Key[:1], keeper.NextClassIDKeyPrefix):
+			var idsA, idsB collection.NextClassIDs
+			cdc.MustUnmarshal(kvA.Value, &idsA)
+			cdc.MustUnmarshal(kvB.Value, &idsB)
+			return fmt.Sprintf("%v\n%v", idsA, idsB)
+		case bytes.Equal(kvA.Key[:1], keeper.NFTKeyPrefix):
+			var nftA, nftB collection.NFT
+			cdc.MustUnmarshal(kvA.Value, &nftA)
+			cdc.MustUnmarshal(kvB.Value, &nftB)
+			return fmt.Sprintf("%v\n%v", nftA, nftB)
+		case bytes.Equal(kvA.Key[:1], keeper.ParentKeyPrefix):
+			var parentA, parentB gogotypes.StringValue
+			cdc.MustUnmarshal(kvA.Value, &parentA)
+			cdc.MustUnmarshal(kvB.Value, &parentB)
+			return fmt.Sprintf("%v\n%v", parentA.Value, parentB.Value)
+		case bytes.Equal(kvA.Key[:1], keeper.NextTokenIDKeyPrefix):
+			var idA, idB sdk.Uint
+			if err := idA.Unmarshal(kvA.Value); err != nil {
+				panic(err)
+			}
+			if err := idB.Unmarshal(kvB.Value); err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("%v\n%v", idA, idB)
+		case bytes.Equal(kvA.Key[:1], keeper.BalanceKeyPrefix),
+			bytes.Equal(kvA.Key[:1], keeper.SupplyKeyPrefix),
+			bytes.Equal(kvA.Key[:1], keeper.MintedKeyPrefix),
+			bytes.Equal(kvA.Key[:1], keeper.BurntKeyPrefix):
+			var amountA, amountB sdk.Int
+			if err := amountA.Unmarshal(kvA.Value); err != nil {
+				panic(err)
+			}
+			if err := amountB.Unmarshal(kvB.Value); err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("%v\n%v", amountA, amountB)
+		case bytes.Equal(kvA.Key[:1], keeper.OwnerKeyPrefix),
+			bytes.Equal(kvA.Key[:1], keeper.ApprovalKeyPrefix):
+			return fmt.Sprintf("%v\n%v", sdk.AccAddress(kvA.Value), sdk.AccAddress(kvB.Value))
+		case bytes.Equal(kvA.Key[:1], keeper.ChildKeyPrefix),
+			bytes.Equal(kvA.Key[:1], keeper.AuthorizationKeyPrefix),
+			bytes.Equal(kvA.Key[:1], keeper.GrantKeyPrefix),
+			bytes.Equal(kvA.Key[:1], keeper.NFTByOwnerKeyPrefix),
+			bytes.Equal(kvA.Key[:1], keeper.LegacyTokenKeyPrefix),
+			bytes.Equal(kvA.Key[:1], keeper.LegacyTokenTypeKeyPrefix):
+			// the existence of the key is the only information
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+		default:
+			panic(fmt.Sprintf("invalid collection key %X", kvA.Key))
+		}
+	}
+}
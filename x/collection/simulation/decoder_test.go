@@ -0,0 +1,94 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/finschia-sdk/crypto/keys/secp256k1"
+	"github.com/Finschia/finschia-sdk/simapp"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/types/kv"
+	"github.com/Finschia/finschia-sdk/x/collection"
+	"github.com/Finschia/finschia-sdk/x/collection/keeper"
+	"github.com/Finschia/finschia-sdk/x/collection/simulation"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := simapp.MakeTestEncodingConfig().Marshaler
+	dec := simulation.NewDecodeStore(cdc)
+
+	contract := collection.Contract{
+		Id:   "deadbeef",
+		Name: "Test",
+	}
+	contractBz, err := cdc.Marshal(&contract)
+	require.NoError(t, err)
+
+	var class collection.TokenClass = &collection.NFTClass{
+		Id:   "10000001",
+		Name: "Test",
+	}
+	classBz, err := cdc.MarshalInterface(class)
+	require.NoError(t, err)
+
+	nft := collection.NFT{
+		TokenId: collection.NewNFTID("10000001", 1),
+		Name:    "Test",
+	}
+	nftBz, err := cdc.Marshal(&nft)
+	require.NoError(t, err)
+
+	parent := gogotypes.StringValue{Value: nft.TokenId}
+	parentBz, err := cdc.Marshal(&parent)
+	require.NoError(t, err)
+
+	amount := sdk.NewInt(1000)
+	amountBz, err := amount.Marshal()
+	require.NoError(t, err)
+
+	owner := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: keeper.ContractKeyPrefix, Value: contractBz},
+			{Key: keeper.ClassKeyPrefix, Value: classBz},
+			{Key: keeper.NFTKeyPrefix, Value: nftBz},
+			{Key: keeper.ParentKeyPrefix, Value: parentBz},
+			{Key: keeper.BalanceKeyPrefix, Value: amountBz},
+			{Key: keeper.SupplyKeyPrefix, Value: amountBz},
+			{Key: keeper.OwnerKeyPrefix, Value: owner},
+			{Key: keeper.ChildKeyPrefix, Value: []byte{}},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectErr   bool
+		expectedLog string
+	}{
+		{"Contract", false, fmt.Sprintf("%v\n%v", contract, contract)},
+		{"Class", false, fmt.Sprintf("%v\n%v", class, class)},
+		{"NFT", false, fmt.Sprintf("%v\n%v", nft, nft)},
+		{"Parent", false, fmt.Sprintf("%v\n%v", nft.TokenId, nft.TokenId)},
+		{"Balance", false, fmt.Sprintf("%v\n%v", amount, amount)},
+		{"Supply", false, fmt.Sprintf("%v\n%v", amount, amount)},
+		{"Owner", false, fmt.Sprintf("%v\n%v", owner, owner)},
+		{"Child", false, "\n"},
+		{"other", true, ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.expectErr {
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			} else {
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/Finschia/finschia-sdk/types/module"
+	simtypes "github.com/Finschia/finschia-sdk/types/simulation"
+	"github.com/Finschia/finschia-sdk/x/collection"
+)
+
+const (
+	maxDepthLimit = 4
+	maxWidthLimit = 8
+)
+
+// genParams returns random limits on the composition of nfts.
+func genParams(r *rand.Rand) collection.Params {
+	return collection.Params{
+		DepthLimit: uint32(simtypes.RandIntBetween(r, 1, maxDepthLimit+1)),
+		WidthLimit: uint32(simtypes.RandIntBetween(r, 1, maxWidthLimit+1)),
+	}
+}
+
+// RandomizedGenState generates a random GenesisState for collection.
+// It does not generate any contracts, because their ids must be registered
+// in the class keeper, whose state belongs to the token module. The contracts
+// are created by the operations instead.
+func RandomizedGenState(simState *module.SimulationState) {
+	genState := collection.DefaultGenesisState()
+	genState.Params = genParams(simState.Rand)
+
+	simState.GenState[collection.ModuleName] = simState.Cdc.MustMarshalJSON(genState)
+}
@@ -0,0 +1,41 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/finschia-sdk/simapp"
+	"github.com/Finschia/finschia-sdk/types/module"
+	simtypes "github.com/Finschia/finschia-sdk/types/simulation"
+	"github.com/Finschia/finschia-sdk/x/collection"
+	"github.com/Finschia/finschia-sdk/x/collection/simulation"
+)
+
+func TestRandomizedGenState(t *testing.T) {
+	app := simapp.Setup(false)
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	simState := module.SimulationState{
+		AppParams:    make(simtypes.AppParams),
+		Cdc:          app.AppCodec(),
+		Rand:         r,
+		NumBonded:    3,
+		Accounts:     simtypes.RandomAccounts(r, 3),
+		InitialStake: 1000,
+		GenState:     make(map[string]json.RawMessage),
+	}
+
+	simulation.RandomizedGenState(&simState)
+	var collectionGenesis collection.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[collection.ModuleName], &collectionGenesis)
+
+	require.NoError(t, collection.ValidateGenesis(collectionGenesis))
+	require.NotZero(t, collectionGenesis.Params.DepthLimit)
+	require.NotZero(t, collectionGenesis.Params.WidthLimit)
+	require.Empty(t, collectionGenesis.Contracts)
+}
@@ -0,0 +1,1008 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/Finschia/finschia-sdk/baseapp"
+	"github.com/Finschia/finschia-sdk/codec"
+	simappparams "github.com/Finschia/finschia-sdk/simapp/params"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	simtypes "github.com/Finschia/finschia-sdk/types/simulation"
+	"github.com/Finschia/finschia-sdk/x/collection"
+	"github.com/Finschia/finschia-sdk/x/collection/keeper"
+	"github.com/Finschia/finschia-sdk/x/simulation"
+)
+
+// collection message types
+var (
+	TypeMsgSendFT            = sdk.MsgTypeURL(&collection.MsgSendFT{})
+	TypeMsgOperatorSendFT    = sdk.MsgTypeURL(&collection.MsgOperatorSendFT{})
+	TypeMsgSendNFT           = sdk.MsgTypeURL(&collection.MsgSendNFT{})
+	TypeMsgOperatorSendNFT   = sdk.MsgTypeURL(&collection.MsgOperatorSendNFT{})
+	TypeMsgApproveNFT        = sdk.MsgTypeURL(&collection.MsgApproveNFT{})
+	TypeMsgRevokeNFTApproval = sdk.MsgTypeURL(&collection.MsgRevokeNFTApproval{})
+	TypeMsgAuthorizeOperator = sdk.MsgTypeURL(&collection.MsgAuthorizeOperator{})
+	TypeMsgRevokeOperator    = sdk.MsgTypeURL(&collection.MsgRevokeOperator{})
+	TypeMsgCreateContract    = sdk.MsgTypeURL(&collection.MsgCreateContract{})
+	TypeMsgIssueFT           = sdk.MsgTypeURL(&collection.MsgIssueFT{})
+	TypeMsgIssueNFT          = sdk.MsgTypeURL(&collection.MsgIssueNFT{})
+	TypeMsgMintFT            = sdk.MsgTypeURL(&collection.MsgMintFT{})
+	TypeMsgMintNFT           = sdk.MsgTypeURL(&collection.MsgMintNFT{})
+	TypeMsgBurnFT            = sdk.MsgTypeURL(&collection.MsgBurnFT{})
+	TypeMsgOperatorBurnFT    = sdk.MsgTypeURL(&collection.MsgOperatorBurnFT{})
+	TypeMsgBurnNFT           = sdk.MsgTypeURL(&collection.MsgBurnNFT{})
+	TypeMsgOperatorBurnNFT   = sdk.MsgTypeURL(&collection.MsgOperatorBurnNFT{})
+	TypeMsgModify            = sdk.MsgTypeURL(&collection.MsgModify{})
+	TypeMsgGrantPermission   = sdk.MsgTypeURL(&collection.MsgGrantPermission{})
+	TypeMsgRevokePermission  = sdk.MsgTypeURL(&collection.MsgRevokePermission{})
+	TypeMsgAttach            = sdk.MsgTypeURL(&collection.MsgAttach{})
+	TypeMsgDetach            = sdk.MsgTypeURL(&collection.MsgDetach{})
+	TypeMsgOperatorAttach    = sdk.MsgTypeURL(&collection.MsgOperatorAttach{})
+	TypeMsgOperatorDetach    = sdk.MsgTypeURL(&collection.MsgOperatorDetach{})
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgSendFT            = "op_weight_msg_collection_send_ft"
+	OpWeightMsgOperatorSendFT    = "op_weight_msg_collection_operator_send_ft"
+	OpWeightMsgSendNFT           = "op_weight_msg_collection_send_nft"
+	OpWeightMsgOperatorSendNFT   = "op_weight_msg_collection_operator_send_nft"
+	OpWeightMsgApproveNFT        = "op_weight_msg_collection_approve_nft"
+	OpWeightMsgRevokeNFTApproval = "op_weight_msg_collection_revoke_nft_approval"
+	OpWeightMsgAuthorizeOperator = "op_weight_msg_collection_authorize_operator"
+	OpWeightMsgRevokeOperator    = "op_weight_msg_collection_revoke_operator"
+	OpWeightMsgCreateContract    = "op_weight_msg_collection_create_contract"
+	OpWeightMsgIssueFT           = "op_weight_msg_collection_issue_ft"
+	OpWeightMsgIssueNFT          = "op_weight_msg_collection_issue_nft"
+	OpWeightMsgMintFT            = "op_weight_msg_collection_mint_ft"
+	OpWeightMsgMintNFT           = "op_weight_msg_collection_mint_nft"
+	OpWeightMsgBurnFT            = "op_weight_msg_collection_burn_ft"
+	OpWeightMsgOperatorBurnFT    = "op_weight_msg_collection_operator_burn_ft"
+	OpWeightMsgBurnNFT           = "op_weight_msg_collection_burn_nft"
+	OpWeightMsgOperatorBurnNFT   = "op_weight_msg_collection_operator_burn_nft"
+	OpWeightMsgModify            = "op_weight_msg_collection_modify"
+	OpWeightMsgGrantPermission   = "op_weight_msg_collection_grant_permission"
+	OpWeightMsgRevokePermission  = "op_weight_msg_collection_revoke_permission"
+	OpWeightMsgAttach            = "op_weight_msg_collection_attach"
+	OpWeightMsgDetach            = "op_weight_msg_collection_detach"
+	OpWeightMsgOperatorAttach    = "op_weight_msg_collection_operator_attach"
+	OpWeightMsgOperatorDetach    = "op_weight_msg_collection_operator_detach"
+)
+
+// collection operations weights
+const (
+	WeightSendFT            = 100
+	WeightOperatorSendFT    = 50
+	WeightSendNFT           = 100
+	WeightOperatorSendNFT   = 50
+	WeightApproveNFT        = 30
+	WeightRevokeNFTApproval = 10
+	WeightAuthorizeOperator = 30
+	WeightRevokeOperator    = 10
+	WeightCreateContract    = 10
+	WeightIssueFT           = 20
+	WeightIssueNFT          = 20
+	WeightMintFT            = 50
+	WeightMintNFT           = 80
+	WeightBurnFT            = 30
+	WeightOperatorBurnFT    = 20
+	WeightBurnNFT           = 20
+	WeightOperatorBurnNFT   = 10
+	WeightModify            = 10
+	WeightGrantPermission   = 20
+	WeightRevokePermission  = 5
+	WeightAttach            = 50
+	WeightDetach            = 30
+	WeightOperatorAttach    = 30
+	WeightOperatorDetach    = 20
+)
+
+const (
+	maxAmount     = 1_000_000
+	maxNFTs       = 3
+	maxNameLength = 20
+	maxMetaLength = 100
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	operations := []struct {
+		key           string
+		defaultWeight int
+		operation     simtypes.Operation
+	}{
+		{OpWeightMsgSendFT, WeightSendFT, SimulateMsgSendFT(ak, bk, k)},
+		{OpWeightMsgOperatorSendFT, WeightOperatorSendFT, SimulateMsgOperatorSendFT(ak, bk, k)},
+		{OpWeightMsgSendNFT, WeightSendNFT, SimulateMsgSendNFT(ak, bk, k)},
+		{OpWeightMsgOperatorSendNFT, WeightOperatorSendNFT, SimulateMsgOperatorSendNFT(ak, bk, k)},
+		{OpWeightMsgApproveNFT, WeightApproveNFT, SimulateMsgApproveNFT(ak, bk, k)},
+		{OpWeightMsgRevokeNFTApproval, WeightRevokeNFTApproval, SimulateMsgRevokeNFTApproval(ak, bk, k)},
+		{OpWeightMsgAuthorizeOperator, WeightAuthorizeOperator, SimulateMsgAuthorizeOperator(ak, bk, k)},
+		{OpWeightMsgRevokeOperator, WeightRevokeOperator, SimulateMsgRevokeOperator(ak, bk, k)},
+		{OpWeightMsgCreateContract, WeightCreateContract, SimulateMsgCreateContract(ak, bk, k)},
+		{OpWeightMsgIssueFT, WeightIssueFT, SimulateMsgIssueFT(ak, bk, k)},
+		{OpWeightMsgIssueNFT, WeightIssueNFT, SimulateMsgIssueNFT(ak, bk, k)},
+		{OpWeightMsgMintFT, WeightMintFT, SimulateMsgMintFT(ak, bk, k)},
+		{OpWeightMsgMintNFT, WeightMintNFT, SimulateMsgMintNFT(ak, bk, k)},
+		{OpWeightMsgBurnFT, WeightBurnFT, SimulateMsgBurnFT(ak, bk, k)},
+		{OpWeightMsgOperatorBurnFT, WeightOperatorBurnFT, SimulateMsgOperatorBurnFT(ak, bk, k)},
+		{OpWeightMsgBurnNFT, WeightBurnNFT, SimulateMsgBurnNFT(ak, bk, k)},
+		{OpWeightMsgOperatorBurnNFT, WeightOperatorBurnNFT, SimulateMsgOperatorBurnNFT(ak, bk, k)},
+		{OpWeightMsgModify, WeightModify, SimulateMsgModify(ak, bk, k)},
+		{OpWeightMsgGrantPermission, WeightGrantPermission, SimulateMsgGrantPermission(ak, bk, k)},
+		{OpWeightMsgRevokePermission, WeightRevokePermission, SimulateMsgRevokePermission(ak, bk, k)},
+		{OpWeightMsgAttach, WeightAttach, SimulateMsgAttach(ak, bk, k)},
+		{OpWeightMsgDetach, WeightDetach, SimulateMsgDetach(ak, bk, k)},
+		{OpWeightMsgOperatorAttach, WeightOperatorAttach, SimulateMsgOperatorAttach(ak, bk, k)},
+		{OpWeightMsgOperatorDetach, WeightOperatorDetach, SimulateMsgOperatorDetach(ak, bk, k)},
+	}
+
+	weightedOperations := make(simulation.WeightedOperations, len(operations))
+	for i, op := range operations {
+		var weight int
+		appParams.GetOrGenerate(cdc, op.key, &weight, nil,
+			func(_ *rand.Rand) {
+				weight = op.defaultWeight
+			},
+		)
+
+		weightedOperations[i] = simulation.NewWeightedOperation(weight, op.operation)
+	}
+
+	return weightedOperations
+}
+
+// SimulateMsgSendFT generates a MsgSendFT with random values.
+func SimulateMsgSendFT(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		state, found := randomContract(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgSendFT, "no contract"), nil, nil
+		}
+
+		from, found := randomAccount(r, accs, state.hasFTs)
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgSendFT, "no holder"), nil, nil
+		}
+		to := state.randomCounterparty(r, accs, anyAccount)
+
+		msg := &collection.MsgSendFT{
+			ContractId: state.id,
+			From:       from.Address.String(),
+			To:         to.Address.String(),
+			Amount:     randomCoins(r, state.fts(from)),
+		}
+
+		return deliver(r, app, ctx, ak, bk, from, msg)
+	}
+}
+
+// SimulateMsgOperatorSendFT generates a MsgOperatorSendFT with random values.
+func SimulateMsgOperatorSendFT(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		state, found := randomContract(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgOperatorSendFT, "no contract"), nil, nil
+		}
+
+		from, operator, found := state.randomAuthorization(r, accs, state.hasFTs, anyAccount)
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgOperatorSendFT, "no authorization"), nil, nil
+		}
+		to := state.randomCounterparty(r, accs, anyAccount)
+
+		msg := &collection.MsgOperatorSendFT{
+			ContractId: state.id,
+			Operator:   operator.Address.String(),
+			From:       from.Address.String(),
+			To:         to.Address.String(),
+			Amount:     randomCoins(r, state.fts(from)),
+		}
+
+		return deliver(r, app, ctx, ak, bk, operator, msg)
+	}
+}
+
+// SimulateMsgSendNFT generates a MsgSendNFT with random values.
+func SimulateMsgSendNFT(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		state, found := randomContract(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgSendNFT, "no contract"), nil, nil
+		}
+
+		from, found := randomAccount(r, accs, state.hasNFTs)
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgSendNFT, "no holder"), nil, nil
+		}
+		to := state.randomCounterparty(r, accs, anyAccount)
+
+		msg := &collection.MsgSendNFT{
+			ContractId: state.id,
+			From:       from.Address.String(),
+			To:         to.Address.String(),
+			TokenIds:   randomTokenIDs(r, state.nfts(from)),
+		}
+
+		return deliver(r, app, ctx, ak, bk, from, msg)
+	}
+}
+
+// SimulateMsgOperatorSendNFT generates a MsgOperatorSendNFT with random values.
+// The operator is either authorized by the holder, or approved on the token.
+func SimulateMsgOperatorSendNFT(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		state, found := randomContract(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgOperatorSendNFT, "no contract"), nil, nil
+		}
+
+		var tokenIDs []string
+		from, operator, found := state.randomAuthorization(r, accs, state.hasNFTs, anyAccount)
+		if found {
+			tokenIDs = randomTokenIDs(r, state.nfts(from))
+		} else {
+			approval, found := state.randomApproval(r)
+			if !found {
+				return simtypes.NoOpMsg(collection.ModuleName, TypeMsgOperatorSendNFT, "no authorization nor approval"), nil, nil
+			}
+
+			from, found = findAccount(accs, state.owners[approval.TokenId])
+			if !found {
+				return simtypes.NoOpMsg(collection.ModuleName, TypeMsgOperatorSendNFT, "owner not found"), nil, nil
+			}
+			operator, found = findAccount(accs, approval.Approved)
+			if !found {
+				return simtypes.NoOpMsg(collection.ModuleName, TypeMsgOperatorSendNFT, "approved not found"), nil, nil
+			}
+			tokenIDs = []string{approval.TokenId}
+		}
+		to := state.randomCounterparty(r, accs, anyAccount)
+
+		msg := &collection.MsgOperatorSendNFT{
+			ContractId: state.id,
+			Operator:   operator.Address.String(),
+			From:       from.Address.String(),
+			To:         to.Address.String(),
+			TokenIds:   tokenIDs,
+		}
+
+		return deliver(r, app, ctx, ak, bk, operator, msg)
+	}
+}
+
+// SimulateMsgApproveNFT generates a MsgApproveNFT with random values.
+func SimulateMsgApproveNFT(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		state, found := randomContract(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgApproveNFT, "no contract"), nil, nil
+		}
+
+		owner, found := randomAccount(r, accs, state.hasNFTs)
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgApproveNFT, "no owner"), nil, nil
+		}
+		approved, found := state.randomCounterpartyIfAny(r, accs, otherThan(owner))
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgApproveNFT, "no one to approve"), nil, nil
+		}
+
+		tokenIDs := state.nfts(owner)
+		msg := &collection.MsgApproveNFT{
+			ContractId: state.id,
+			Owner:      owner.Address.String(),
+			Approved:   approved.Address.String(),
+			TokenId:    tokenIDs[r.Intn(len(tokenIDs))],
+		}
+
+		return deliver(r, app, ctx, ak, bk, owner, msg)
+	}
+}
+
+// SimulateMsgRevokeNFTApproval generates a MsgRevokeNFTApproval with random values.
+func SimulateMsgRevokeNFTApproval(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		state, found := randomContract(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgRevokeNFTApproval, "no contract"), nil, nil
+		}
+
+		approval, found := state.randomApproval(r)
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgRevokeNFTApproval, "no approval"), nil, nil
+		}
+		owner, found := findAccount(accs, state.owners[approval.TokenId])
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgRevokeNFTApproval, "owner not found"), nil, nil
+		}
+
+		msg := &collection.MsgRevokeNFTApproval{
+			ContractId: state.id,
+			Owner:      owner.Address.String(),
+			TokenId:    approval.TokenId,
+		}
+
+		return deliver(r, app, ctx, ak, bk, owner, msg)
+	}
+}
+
+// SimulateMsgAuthorizeOperator generates a MsgAuthorizeOperator with random values.
+func SimulateMsgAuthorizeOperator(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		state, found := randomContract(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgAuthorizeOperator, "no contract"), nil, nil
+		}
+
+		holder := state.randomCounterparty(r, accs, func(acc simtypes.Account) bool {
+			return state.hasFTs(acc) || state.hasNFTs(acc)
+		})
+		operator, found := state.randomCounterpartyIfAny(r, accs, func(acc simtypes.Account) bool {
+			return !acc.Address.Equals(holder.Address) && !state.isOperatorFor(acc, holder)
+		})
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgAuthorizeOperator, "no operator to authorize"), nil, nil
+		}
+
+		msg := &collection.MsgAuthorizeOperator{
+			ContractId: state.id,
+			Holder:     holder.Address.String(),
+			Operator:   operator.Address.String(),
+		}
+
+		return deliver(r, app, ctx, ak, bk, holder, msg)
+	}
+}
+
+// SimulateMsgRevokeOperator generates a MsgRevokeOperator with random values.
+func SimulateMsgRevokeOperator(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		state, found := randomContract(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgRevokeOperator, "no contract"), nil, nil
+		}
+
+		holder, operator, found := state.randomAuthorization(r, accs, anyAccount, anyAccount)
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgRevokeOperator, "no authorization"), nil, nil
+		}
+
+		msg := &collection.MsgRevokeOperator{
+			ContractId: state.id,
+			Holder:     holder.Address.String(),
+			Operator:   operator.Address.String(),
+		}
+
+		return deliver(r, app, ctx, ak, bk, holder, msg)
+	}
+}
+
+// SimulateMsgCreateContract generates a MsgCreateContract with random values.
+func SimulateMsgCreateContract(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		owner, _ := simtypes.RandomAcc(r, accs)
+
+		msg := &collection.MsgCreateContract{
+			Owner: owner.Address.String(),
+			Name:  randomName(r),
+			Uri:   randomMeta(r),
+			Meta:  randomMeta(r),
+		}
+
+		return deliver(r, app, ctx, ak, bk, owner, msg)
+	}
+}
+
+// SimulateMsgIssueFT generates a MsgIssueFT with random values.
+func SimulateMsgIssueFT(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		state, found := randomContract(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgIssueFT, "no contract"), nil, nil
+		}
+
+		owner, found := randomAccount(r, accs, state.hasPermission(collection.PermissionIssue))
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgIssueFT, "no issuer"), nil, nil
+		}
+		to := state.randomCounterparty(r, accs, anyAccount)
+
+		msg := &collection.MsgIssueFT{
+			ContractId: state.id,
+			Name:       randomName(r),
+			Meta:       randomMeta(r),
+			Decimals:   int32(r.Intn(19)),
+			Mintable:   r.Intn(2) == 0,
+			Owner:      owner.Address.String(),
+			To:         to.Address.String(),
+			Amount:     simtypes.RandomAmount(r, sdk.NewInt(maxAmount)),
+		}
+		// the issue of a single indivisible token must be mintable
+		if msg.Amount.Equal(sdk.OneInt()) && msg.Decimals == 0 {
+			msg.Mintable = true
+		}
+
+		return deliver(r, app, ctx, ak, bk, owner, msg)
+	}
+}
+
+// SimulateMsgIssueNFT generates a MsgIssueNFT with random values.
+func SimulateMsgIssueNFT(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		state, found := randomContract(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgIssueNFT, "no contract"), nil, nil
+		}
+
+		owner, found := randomAccount(r, accs, state.hasPermission(collection.PermissionIssue))
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgIssueNFT, "no issuer"), nil, nil
+		}
+
+		msg := &collection.MsgIssueNFT{
+			ContractId: state.id,
+			Name:       randomName(r),
+			Meta:       randomMeta(r),
+			Owner:      owner.Address.String(),
+		}
+		if r.Intn(2) == 0 {
+			recipient, _ := simtypes.RandomAcc(r, accs)
+			msg.Royalty = &collection.Royalty{
+				Recipient:   recipient.Address.String(),
+				BasisPoints: uint32(simtypes.RandIntBetween(r, 1, collection.MaxRoyaltyBasisPoints+1)),
+			}
+		}
+
+		return deliver(r, app, ctx, ak, bk, owner, msg)
+	}
+}
+
+// SimulateMsgMintFT generates a MsgMintFT with random values.
+func SimulateMsgMintFT(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		state, found := randomContract(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgMintFT, "no contract"), nil, nil
+		}
+
+		var limits []collection.Coin
+		for _, class := range state.ftClasses {
+			if class.Mintable {
+				limits = append(limits, collection.NewFTCoin(class.Id, sdk.NewInt(maxAmount)))
+			}
+		}
+		if len(limits) == 0 {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgMintFT, "no mintable class"), nil, nil
+		}
+
+		from, found := randomAccount(r, accs, state.hasPermission(collection.PermissionMint))
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgMintFT, "no minter"), nil, nil
+		}
+		to := state.randomCounterparty(r, accs, anyAccount)
+
+		msg := &collection.MsgMintFT{
+			ContractId: state.id,
+			From:       from.Address.String(),
+			To:         to.Address.String(),
+			Amount:     randomCoins(r, limits),
+		}
+
+		return deliver(r, app, ctx, ak, bk, from, msg)
+	}
+}
+
+// SimulateMsgMintNFT generates a MsgMintNFT with random values.
+func SimulateMsgMintNFT(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		state, found := randomContract(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgMintNFT, "no contract"), nil, nil
+		}
+		if len(state.nftClasses) == 0 {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgMintNFT, "no nft class"), nil, nil
+		}
+
+		from, found := randomAccount(r, accs, state.hasPermission(collection.PermissionMint))
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgMintNFT, "no minter"), nil, nil
+		}
+		to := state.randomCounterparty(r, accs, anyAccount)
+
+		params := make([]collection.MintNFTParam, simtypes.RandIntBetween(r, 1, maxNFTs+1))
+		for i := range params {
+			params[i] = collection.MintNFTParam{
+				TokenType: state.nftClasses[r.Intn(len(state.nftClasses))].Id,
+				Name:      randomName(r),
+				Meta:      randomMeta(r),
+			}
+		}
+
+		msg := &collection.MsgMintNFT{
+			ContractId: state.id,
+			From:       from.Address.String(),
+			To:         to.Address.String(),
+			Params:     params,
+		}
+
+		return deliver(r, app, ctx, ak, bk, from, msg)
+	}
+}
+
+// SimulateMsgBurnFT generates a MsgBurnFT with random values.
+func SimulateMsgBurnFT(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		state, found := randomContract(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgBurnFT, "no contract"), nil, nil
+		}
+
+		from, found := randomAccount(r, accs, func(acc simtypes.Account) bool {
+			return state.hasFTs(acc) && state.hasPermission(collection.PermissionBurn)(acc)
+		})
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgBurnFT, "no burner"), nil, nil
+		}
+
+		msg := &collection.MsgBurnFT{
+			ContractId: state.id,
+			From:       from.Address.String(),
+			Amount:     randomCoins(r, state.fts(from)),
+		}
+
+		return deliver(r, app, ctx, ak, bk, from, msg)
+	}
+}
+
+// SimulateMsgOperatorBurnFT generates a MsgOperatorBurnFT with random values.
+func SimulateMsgOperatorBurnFT(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		state, found := randomContract(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgOperatorBurnFT, "no contract"), nil, nil
+		}
+
+		from, operator, found := state.randomAuthorization(r, accs, state.hasFTs, state.hasPermission(collection.PermissionBurn))
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgOperatorBurnFT, "no authorization"), nil, nil
+		}
+
+		msg := &collection.MsgOperatorBurnFT{
+			ContractId: state.id,
+			Operator:   operator.Address.String(),
+			From:       from.Address.String(),
+			Amount:     randomCoins(r, state.fts(from)),
+		}
+
+		return deliver(r, app, ctx, ak, bk, operator, msg)
+	}
+}
+
+// SimulateMsgBurnNFT generates a MsgBurnNFT with random values.
+func SimulateMsgBurnNFT(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		state, found := randomContract(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgBurnNFT, "no contract"), nil, nil
+		}
+
+		from, found := randomAccount(r, accs, func(acc simtypes.Account) bool {
+			return state.hasNFTs(acc) && state.hasPermission(collection.PermissionBurn)(acc)
+		})
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgBurnNFT, "no burner"), nil, nil
+		}
+
+		msg := &collection.MsgBurnNFT{
+			ContractId: state.id,
+			From:       from.Address.String(),
+			TokenIds:   randomTokenIDs(r, state.nfts(from)),
+		}
+
+		return deliver(r, app, ctx, ak, bk, from, msg)
+	}
+}
+
+// SimulateMsgOperatorBurnNFT generates a MsgOperatorBurnNFT with random values.
+func SimulateMsgOperatorBurnNFT(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		state, found := randomContract(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgOperatorBurnNFT, "no contract"), nil, nil
+		}
+
+		from, operator, found := state.randomAuthorization(r, accs, state.hasNFTs, state.hasPermission(collection.PermissionBurn))
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgOperatorBurnNFT, "no authorization"), nil, nil
+		}
+
+		msg := &collection.MsgOperatorBurnNFT{
+			ContractId: state.id,
+			Operator:   operator.Address.String(),
+			From:       from.Address.String(),
+			TokenIds:   randomTokenIDs(r, state.nfts(from)),
+		}
+
+		return deliver(r, app, ctx, ak, bk, operator, msg)
+	}
+}
+
+// SimulateMsgModify generates a MsgModify with random values.
+// It modifies either the contract, one of its classes, or one of its nfts.
+func SimulateMsgModify(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		state, found := randomContract(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgModify, "no contract"), nil, nil
+		}
+
+		owner, found := randomAccount(r, accs, state.hasPermission(collection.PermissionModify))
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgModify, "no modifier"), nil, nil
+		}
+
+		msg := &collection.MsgModify{
+			ContractId: state.id,
+			Owner:      owner.Address.String(),
+		}
+		keys := []collection.AttributeKey{collection.AttributeKeyName, collection.AttributeKeyMeta}
+		switch target := r.Intn(4); {
+		case target == 1 && len(state.ftClasses) != 0:
+			class := state.ftClasses[r.Intn(len(state.ftClasses))]
+			tokenID := collection.NewFTID(class.Id)
+			msg.TokenType = class.Id
+			msg.TokenIndex = tokenID[len(class.Id):]
+		case target == 2 && len(state.nftClasses) != 0:
+			msg.TokenType = state.nftClasses[r.Intn(len(state.nftClasses))].Id
+		case target == 3 && len(state.nftIDs) != 0:
+			tokenID := state.nftIDs[r.Intn(len(state.nftIDs))]
+			msg.TokenType = collection.SplitTokenID(tokenID)
+			msg.TokenIndex = tokenID[len(msg.TokenType):]
+		default:
+			keys = append(keys, collection.AttributeKeyURI)
+		}
+
+		for _, i := range r.Perm(len(keys))[:simtypes.RandIntBetween(r, 1, len(keys)+1)] {
+			value := randomMeta(r)
+			if keys[i] == collection.AttributeKeyName {
+				value = randomName(r)
+			}
+
+			msg.Changes = append(msg.Changes, collection.Attribute{
+				Key:   keys[i].String(),
+				Value: value,
+			})
+		}
+
+		return deliver(r, app, ctx, ak, bk, owner, msg)
+	}
+}
+
+// SimulateMsgGrantPermission generates a MsgGrantPermission with random values.
+func SimulateMsgGrantPermission(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		state, found := randomContract(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgGrantPermission, "no contract"), nil, nil
+		}
+
+		grant, found := state.randomGrant(r)
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgGrantPermission, "no grant"), nil, nil
+		}
+		granter, found := findAccount(accs, grant.Grantee)
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgGrantPermission, "granter not found"), nil, nil
+		}
+		grantee, found := state.randomCounterpartyIfAny(r, accs, otherThan(granter))
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgGrantPermission, "no grantee"), nil, nil
+		}
+
+		msg := &collection.MsgGrantPermission{
+			ContractId: state.id,
+			From:       granter.Address.String(),
+			To:         grantee.Address.String(),
+			Permission: collection.LegacyPermission(grant.Permission).String(),
+		}
+
+		return deliver(r, app, ctx, ak, bk, granter, msg)
+	}
+}
+
+// SimulateMsgRevokePermission generates a MsgRevokePermission with random values.
+func SimulateMsgRevokePermission(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		state, found := randomContract(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgRevokePermission, "no contract"), nil, nil
+		}
+
+		grant, found := state.randomGrant(r)
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgRevokePermission, "no grant"), nil, nil
+		}
+		grantee, found := findAccount(accs, grant.Grantee)
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgRevokePermission, "grantee not found"), nil, nil
+		}
+
+		msg := &collection.MsgRevokePermission{
+			ContractId: state.id,
+			From:       grantee.Address.String(),
+			Permission: collection.LegacyPermission(grant.Permission).String(),
+		}
+
+		return deliver(r, app, ctx, ak, bk, grantee, msg)
+	}
+}
+
+// SimulateMsgAttach generates a MsgAttach with random values.
+func SimulateMsgAttach(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		state, found := randomContract(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgAttach, "no contract"), nil, nil
+		}
+
+		params := k.GetParams(ctx)
+		var from simtypes.Account
+		var subject, target string
+		for _, i := range r.Perm(len(accs)) {
+			if subject, target, found = state.randomAttachment(r, params, accs[i]); found {
+				from = accs[i]
+				break
+			}
+		}
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgAttach, "no nfts to attach"), nil, nil
+		}
+
+		msg := &collection.MsgAttach{
+			ContractId: state.id,
+			From:       from.Address.String(),
+			TokenId:    subject,
+			ToTokenId:  target,
+		}
+
+		return deliver(r, app, ctx, ak, bk, from, msg)
+	}
+}
+
+// SimulateMsgDetach generates a MsgDetach with random values.
+func SimulateMsgDetach(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		state, found := randomContract(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgDetach, "no contract"), nil, nil
+		}
+
+		subject, found := state.randomChild(r)
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgDetach, "no child nft"), nil, nil
+		}
+		from, found := findAccount(accs, state.owners[state.root(subject)])
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgDetach, "owner not found"), nil, nil
+		}
+
+		msg := &collection.MsgDetach{
+			ContractId: state.id,
+			From:       from.Address.String(),
+			TokenId:    subject,
+		}
+
+		return deliver(r, app, ctx, ak, bk, from, msg)
+	}
+}
+
+// SimulateMsgOperatorAttach generates a MsgOperatorAttach with random values.
+func SimulateMsgOperatorAttach(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		state, found := randomContract(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgOperatorAttach, "no contract"), nil, nil
+		}
+
+		from, operator, found := state.randomAuthorization(r, accs, state.hasNFTs, anyAccount)
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgOperatorAttach, "no authorization"), nil, nil
+		}
+		subject, target, found := state.randomAttachment(r, k.GetParams(ctx), from)
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgOperatorAttach, "no nfts to attach"), nil, nil
+		}
+
+		msg := &collection.MsgOperatorAttach{
+			ContractId: state.id,
+			Operator:   operator.Address.String(),
+			From:       from.Address.String(),
+			TokenId:    subject,
+			ToTokenId:  target,
+		}
+
+		return deliver(r, app, ctx, ak, bk, operator, msg)
+	}
+}
+
+// SimulateMsgOperatorDetach generates a MsgOperatorDetach with random values.
+func SimulateMsgOperatorDetach(ak collection.AccountKeeper, bk collection.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		state, found := randomContract(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgOperatorDetach, "no contract"), nil, nil
+		}
+
+		subject, found := state.randomChild(r)
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgOperatorDetach, "no child nft"), nil, nil
+		}
+		from, found := findAccount(accs, state.owners[state.root(subject)])
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgOperatorDetach, "owner not found"), nil, nil
+		}
+		operator, found := randomAccount(r, accs, func(acc simtypes.Account) bool {
+			return state.isOperatorFor(acc, from)
+		})
+		if !found {
+			return simtypes.NoOpMsg(collection.ModuleName, TypeMsgOperatorDetach, "no authorization"), nil, nil
+		}
+
+		msg := &collection.MsgOperatorDetach{
+			ContractId: state.id,
+			Operator:   operator.Address.String(),
+			From:       from.Address.String(),
+			TokenId:    subject,
+		}
+
+		return deliver(r, app, ctx, ak, bk, operator, msg)
+	}
+}
+
+// deliver generates a transaction of the message with random fees and delivers it.
+func deliver(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak collection.AccountKeeper, bk collection.BankKeeper,
+	signer simtypes.Account, msg sdk.Msg,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+		Cdc:             nil,
+		Msg:             msg,
+		MsgType:         sdk.MsgTypeURL(msg),
+		Context:         ctx,
+		SimAccount:      signer,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      collection.ModuleName,
+		CoinsSpentInMsg: nil,
+	}
+
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
+
+// randomAccount returns a random account satisfying the condition, if any.
+func randomAccount(r *rand.Rand, accs []simtypes.Account, cond func(acc simtypes.Account) bool) (simtypes.Account, bool) {
+	for _, i := range r.Perm(len(accs)) {
+		if cond(accs[i]) {
+			return accs[i], true
+		}
+	}
+
+	return simtypes.Account{}, false
+}
+
+// findAccount returns the simulation account of the address, if any.
+func findAccount(accs []simtypes.Account, address string) (simtypes.Account, bool) {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return simtypes.Account{}, false
+	}
+
+	return simtypes.FindAccount(accs, addr)
+}
+
+func anyAccount(acc simtypes.Account) bool {
+	return true
+}
+
+func otherThan(other simtypes.Account) func(acc simtypes.Account) bool {
+	return func(acc simtypes.Account) bool {
+		return !acc.Address.Equals(other.Address)
+	}
+}
+
+// randomCoins returns a random non-empty subset of the coins, with random amounts in [1, limit].
+func randomCoins(r *rand.Rand, limits []collection.Coin) collection.Coins {
+	perm := r.Perm(len(limits))
+	coins := make(collection.Coins, simtypes.RandIntBetween(r, 1, len(limits)+1))
+	for i := range coins {
+		limit := limits[perm[i]]
+		coins[i] = collection.NewCoin(limit.TokenId, randomPositiveAmount(r, limit.Amount))
+	}
+
+	return coins
+}
+
+// randomTokenIDs returns a random non-empty subset of the token ids, whose size is at most maxNFTs.
+func randomTokenIDs(r *rand.Rand, tokenIDs []string) []string {
+	size := len(tokenIDs)
+	if size > maxNFTs {
+		size = maxNFTs
+	}
+
+	perm := r.Perm(len(tokenIDs))
+	res := make([]string, simtypes.RandIntBetween(r, 1, size+1))
+	for i := range res {
+		res[i] = tokenIDs[perm[i]]
+	}
+
+	return res
+}
+
+// randomPositiveAmount returns a random amount in [1, max].
+func randomPositiveAmount(r *rand.Rand, max sdk.Int) sdk.Int {
+	return simtypes.RandomAmount(r, max.SubRaw(1)).AddRaw(1)
+}
+
+// randomName returns a random non-empty name.
+func randomName(r *rand.Rand) string {
+	return simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 1, maxNameLength+1))
+}
+
+// randomMeta returns a random string for the meta or uri fields.
+func randomMeta(r *rand.Rand) string {
+	return simtypes.RandStringOfLength(r, r.Intn(maxMetaLength))
+}
@@ -0,0 +1,367 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/Finschia/finschia-sdk/simapp"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	simtypes "github.com/Finschia/finschia-sdk/types/simulation"
+	"github.com/Finschia/finschia-sdk/x/collection"
+	"github.com/Finschia/finschia-sdk/x/collection/simulation"
+)
+
+type SimTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *simapp.SimApp
+}
+
+func (suite *SimTestSuite) SetupTest() {
+	checkTx := false
+	app := simapp.Setup(checkTx)
+	suite.app = app
+	suite.ctx = app.BaseApp.NewContext(checkTx, tmproto.Header{})
+}
+
+func (suite *SimTestSuite) TestWeightedOperations() {
+	cdc := suite.app.AppCodec()
+	appParams := make(simtypes.AppParams)
+
+	weightedOps := simulation.WeightedOperations(appParams, cdc, suite.app.AccountKeeper,
+		suite.app.BankKeeper, suite.app.CollectionKeeper,
+	)
+
+	// setup 3 accounts
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accs := suite.getTestingAccounts(r, 3)
+
+	suite.beginBlock()
+
+	expected := []struct {
+		weight     int
+		opMsgRoute string
+		opMsgName  string
+	}{
+		{simulation.WeightSendFT, collection.ModuleName, simulation.TypeMsgSendFT},
+		{simulation.WeightOperatorSendFT, collection.ModuleName, simulation.TypeMsgOperatorSendFT},
+		{simulation.WeightSendNFT, collection.ModuleName, simulation.TypeMsgSendNFT},
+		{simulation.WeightOperatorSendNFT, collection.ModuleName, simulation.TypeMsgOperatorSendNFT},
+		{simulation.WeightApproveNFT, collection.ModuleName, simulation.TypeMsgApproveNFT},
+		{simulation.WeightRevokeNFTApproval, collection.ModuleName, simulation.TypeMsgRevokeNFTApproval},
+		{simulation.WeightAuthorizeOperator, collection.ModuleName, simulation.TypeMsgAuthorizeOperator},
+		{simulation.WeightRevokeOperator, collection.ModuleName, simulation.TypeMsgRevokeOperator},
+		{simulation.WeightCreateContract, collection.ModuleName, simulation.TypeMsgCreateContract},
+		{simulation.WeightIssueFT, collection.ModuleName, simulation.TypeMsgIssueFT},
+		{simulation.WeightIssueNFT, collection.ModuleName, simulation.TypeMsgIssueNFT},
+		{simulation.WeightMintFT, collection.ModuleName, simulation.TypeMsgMintFT},
+		{simulation.WeightMintNFT, collection.ModuleName, simulation.TypeMsgMintNFT},
+		{simulation.WeightBurnFT, collection.ModuleName, simulation.TypeMsgBurnFT},
+		{simulation.WeightOperatorBurnFT, collection.ModuleName, simulation.TypeMsgOperatorBurnFT},
+		{simulation.WeightBurnNFT, collection.ModuleName, simulation.TypeMsgBurnNFT},
+		{simulation.WeightOperatorBurnNFT, collection.ModuleName, simulation.TypeMsgOperatorBurnNFT},
+		{simulation.WeightModify, collection.ModuleName, simulation.TypeMsgModify},
+		{simulation.WeightGrantPermission, collection.ModuleName, simulation.TypeMsgGrantPermission},
+		{simulation.WeightRevokePermission, collection.ModuleName, simulation.TypeMsgRevokePermission},
+		{simulation.WeightAttach, collection.ModuleName, simulation.TypeMsgAttach},
+		{simulation.WeightDetach, collection.ModuleName, simulation.TypeMsgDetach},
+		{simulation.WeightOperatorAttach, collection.ModuleName, simulation.TypeMsgOperatorAttach},
+		{simulation.WeightOperatorDetach, collection.ModuleName, simulation.TypeMsgOperatorDetach},
+	}
+	suite.Require().Len(weightedOps, len(expected))
+
+	for i, w := range weightedOps {
+		operationMsg, _, err := w.Op()(r, suite.app.BaseApp, suite.ctx, accs, "")
+		suite.Require().NoError(err)
+		// the following checks are very much dependent from the ordering of the output given
+		// by WeightedOperations. if the ordering in WeightedOperations changes some tests
+		// will fail
+		suite.Require().Equal(expected[i].weight, w.Weight(), "weight should be the same")
+		suite.Require().Equal(expected[i].opMsgRoute, operationMsg.Route, "route should be the same")
+		suite.Require().Equal(expected[i].opMsgName, operationMsg.Name, "operation Msg name should be the same")
+	}
+}
+
+func (suite *SimTestSuite) getTestingAccounts(r *rand.Rand, n int) []simtypes.Account {
+	accounts := simtypes.RandomAccounts(r, n)
+
+	initAmt := suite.app.StakingKeeper.TokensFromConsensusPower(suite.ctx, 200000)
+	initCoins := sdk.NewCoins(sdk.NewCoin("stake", initAmt))
+
+	// add coins to the accounts
+	for _, account := range accounts {
+		acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, account.Address)
+		suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+		suite.Require().NoError(simapp.FundAccount(suite.app, suite.ctx, account.Address, initCoins))
+	}
+
+	return accounts
+}
+
+func (suite *SimTestSuite) beginBlock() {
+	suite.app.BeginBlock(abci.RequestBeginBlock{
+		Header: tmproto.Header{
+			Height:  suite.app.LastBlockHeight() + 1,
+			AppHash: suite.app.LastCommitID().Hash,
+		},
+	})
+}
+
+// createContract creates a contract of the owner, which has a mintable ft class
+// and an nft class, and mints some of them to the owner.
+func (suite *SimTestSuite) createContract(owner sdk.AccAddress, amount sdk.Int, numNFTs int) (contractID, ftClassID string, nftIDs []string) {
+	k := suite.app.CollectionKeeper
+
+	contractID = k.CreateContract(suite.ctx, owner, collection.Contract{Name: "Test"})
+
+	ftClass := &collection.FTClass{
+		Name:     "Test",
+		Mintable: true,
+	}
+	id, err := k.CreateTokenClass(suite.ctx, contractID, ftClass)
+	suite.Require().NoError(err)
+	ftClassID = *id
+	if amount.IsPositive() {
+		suite.Require().NoError(k.MintFT(suite.ctx, contractID, owner, collection.NewCoins(collection.NewFTCoin(ftClassID, amount))))
+	}
+
+	nftClass := &collection.NFTClass{
+		Name: "Test",
+	}
+	id, err = k.CreateTokenClass(suite.ctx, contractID, nftClass)
+	suite.Require().NoError(err)
+
+	params := make([]collection.MintNFTParam, numNFTs)
+	for i := range params {
+		params[i] = collection.MintNFTParam{
+			TokenType: *id,
+			Name:      "Test",
+		}
+	}
+	nfts, err := k.MintNFT(suite.ctx, contractID, owner, params)
+	suite.Require().NoError(err)
+	for _, nft := range nfts {
+		nftIDs = append(nftIDs, nft.TokenId)
+	}
+
+	return contractID, ftClassID, nftIDs
+}
+
+func (suite *SimTestSuite) TestSimulateMsgCreateContract() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 3)
+
+	suite.beginBlock()
+
+	// execute operation
+	op := simulation.SimulateMsgCreateContract(suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.CollectionKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg collection.MsgCreateContract
+	err = collection.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+	suite.Require().NoError(err)
+
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Equal(simulation.TypeMsgCreateContract, operationMsg.Name)
+	suite.Require().NoError(msg.ValidateBasic())
+	suite.Require().Len(futureOperations, 0)
+}
+
+func (suite *SimTestSuite) TestSimulateMsgSendFT() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 3)
+
+	suite.beginBlock()
+
+	holder := accounts[0]
+	amount := sdk.NewInt(1000)
+	contractID, ftClassID, _ := suite.createContract(holder.Address, amount, 0)
+
+	// execute operation
+	op := simulation.SimulateMsgSendFT(suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.CollectionKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg collection.MsgSendFT
+	err = collection.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+	suite.Require().NoError(err)
+
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Equal(contractID, msg.ContractId)
+	suite.Require().Equal(holder.Address.String(), msg.From)
+	suite.Require().Len(msg.Amount, 1)
+	suite.Require().Equal(collection.NewFTID(ftClassID), msg.Amount[0].TokenId)
+	suite.Require().True(msg.Amount[0].Amount.IsPositive())
+	suite.Require().True(msg.Amount[0].Amount.LTE(amount))
+	suite.Require().Len(futureOperations, 0)
+}
+
+func (suite *SimTestSuite) TestSimulateMsgSendNFT() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 3)
+
+	suite.beginBlock()
+
+	holder := accounts[0]
+	contractID, _, nftIDs := suite.createContract(holder.Address, sdk.ZeroInt(), 5)
+
+	// execute operation
+	op := simulation.SimulateMsgSendNFT(suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.CollectionKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg collection.MsgSendNFT
+	err = collection.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+	suite.Require().NoError(err)
+
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Equal(contractID, msg.ContractId)
+	suite.Require().Equal(holder.Address.String(), msg.From)
+	suite.Require().NotEmpty(msg.TokenIds)
+	suite.Require().LessOrEqual(len(msg.TokenIds), 3)
+	suite.Require().Subset(nftIDs, msg.TokenIds)
+	suite.Require().Len(futureOperations, 0)
+}
+
+func (suite *SimTestSuite) TestSimulateMsgOperatorSendNFT() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 3)
+
+	suite.beginBlock()
+
+	holder, approved := accounts[0], accounts[1]
+	contractID, _, nftIDs := suite.createContract(holder.Address, sdk.ZeroInt(), 2)
+	suite.Require().NoError(suite.app.CollectionKeeper.ApproveNFT(suite.ctx, contractID, holder.Address, approved.Address, nftIDs[0]))
+
+	// execute operation
+	op := simulation.SimulateMsgOperatorSendNFT(suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.CollectionKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg collection.MsgOperatorSendNFT
+	err = collection.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+	suite.Require().NoError(err)
+
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Equal(contractID, msg.ContractId)
+	suite.Require().Equal(approved.Address.String(), msg.Operator)
+	suite.Require().Equal(holder.Address.String(), msg.From)
+	suite.Require().Equal([]string{nftIDs[0]}, msg.TokenIds)
+	suite.Require().Len(futureOperations, 0)
+}
+
+func (suite *SimTestSuite) TestSimulateMsgMintNFT() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 3)
+
+	suite.beginBlock()
+
+	owner := accounts[0]
+	contractID, _, _ := suite.createContract(owner.Address, sdk.ZeroInt(), 0)
+
+	// execute operation
+	op := simulation.SimulateMsgMintNFT(suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.CollectionKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg collection.MsgMintNFT
+	err = collection.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+	suite.Require().NoError(err)
+
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Equal(contractID, msg.ContractId)
+	suite.Require().Equal(owner.Address.String(), msg.From)
+	suite.Require().NotEmpty(msg.Params)
+	suite.Require().Len(futureOperations, 0)
+}
+
+func (suite *SimTestSuite) TestSimulateMsgAttach() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 3)
+
+	suite.beginBlock()
+
+	holder := accounts[0]
+	contractID, _, nftIDs := suite.createContract(holder.Address, sdk.ZeroInt(), 2)
+
+	// execute operation
+	op := simulation.SimulateMsgAttach(suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.CollectionKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg collection.MsgAttach
+	err = collection.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+	suite.Require().NoError(err)
+
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Equal(contractID, msg.ContractId)
+	suite.Require().Equal(holder.Address.String(), msg.From)
+	suite.Require().ElementsMatch(nftIDs, []string{msg.TokenId, msg.ToTokenId})
+	suite.Require().Len(futureOperations, 0)
+}
+
+func (suite *SimTestSuite) TestSimulateMsgAttachExceedingLimits() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 3)
+
+	suite.beginBlock()
+
+	holder := accounts[0]
+	contractID, _, nftIDs := suite.createContract(holder.Address, sdk.ZeroInt(), 3)
+	suite.app.CollectionKeeper.SetParams(suite.ctx, collection.Params{DepthLimit: 1, WidthLimit: 1})
+	suite.Require().NoError(suite.app.CollectionKeeper.Attach(suite.ctx, contractID, holder.Address, nftIDs[1], nftIDs[0]))
+
+	// any attachment would exceed the limits
+	op := simulation.SimulateMsgAttach(suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.CollectionKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	suite.Require().False(operationMsg.OK)
+	suite.Require().Equal(simulation.TypeMsgAttach, operationMsg.Name)
+	suite.Require().Len(futureOperations, 0)
+}
+
+func (suite *SimTestSuite) TestSimulateMsgDetach() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 3)
+
+	suite.beginBlock()
+
+	holder := accounts[0]
+	contractID, _, nftIDs := suite.createContract(holder.Address, sdk.ZeroInt(), 2)
+	suite.Require().NoError(suite.app.CollectionKeeper.Attach(suite.ctx, contractID, holder.Address, nftIDs[1], nftIDs[0]))
+
+	// execute operation
+	op := simulation.SimulateMsgDetach(suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.CollectionKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg collection.MsgDetach
+	err = collection.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+	suite.Require().NoError(err)
+
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Equal(contractID, msg.ContractId)
+	suite.Require().Equal(holder.Address.String(), msg.From)
+	suite.Require().Equal(nftIDs[1], msg.TokenId)
+	suite.Require().Len(futureOperations, 0)
+}
+
+func TestSimTestSuite(t *testing.T) {
+	suite.Run(t, new(SimTestSuite))
+}
@@ -0,0 +1,346 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	simtypes "github.com/Finschia/finschia-sdk/types/simulation"
+	"github.com/Finschia/finschia-sdk/x/collection"
+	"github.com/Finschia/finschia-sdk/x/collection/keeper"
+)
+
+// contractState is a snapshot of a contract, which the operations use to
+// generate the messages which would pass the checks of the keeper.
+type contractState struct {
+	id string
+
+	ftClasses  []collection.FTClass
+	nftClasses []collection.NFTClass
+
+	// balances of the fts and the root nfts, by address
+	balances map[string]collection.Coins
+
+	nftIDs []string
+	// owners of the root nfts
+	owners map[string]string
+
+	// childIDs keeps the order of the export, for the determinism
+	childIDs []string
+	parents  map[string]string
+	children map[string][]string
+
+	grants         []collection.Grant
+	authorizations []collection.Authorization
+	approvals      []collection.NFTApproval
+}
+
+// randomContract returns the snapshot of a random contract, if any.
+func randomContract(r *rand.Rand, ctx sdk.Context, k keeper.Keeper) (*contractState, bool) {
+	genesis := k.ExportGenesis(ctx)
+	if len(genesis.Contracts) == 0 {
+		return nil, false
+	}
+
+	contractID := genesis.Contracts[r.Intn(len(genesis.Contracts))].Id
+	state := &contractState{
+		id:       contractID,
+		balances: map[string]collection.Coins{},
+		owners:   map[string]string{},
+		parents:  map[string]string{},
+		children: map[string][]string{},
+	}
+
+	for _, contractClasses := range genesis.Classes {
+		if contractClasses.ContractId != contractID {
+			continue
+		}
+		for i := range contractClasses.Classes {
+			switch class := collection.TokenClassFromAny(&contractClasses.Classes[i]).(type) {
+			case *collection.FTClass:
+				state.ftClasses = append(state.ftClasses, *class)
+			case *collection.NFTClass:
+				state.nftClasses = append(state.nftClasses, *class)
+			}
+		}
+	}
+
+	for _, contractBalances := range genesis.Balances {
+		if contractBalances.ContractId != contractID {
+			continue
+		}
+		for _, balance := range contractBalances.Balances {
+			state.balances[balance.Address] = balance.Amount
+			for _, coin := range balance.Amount {
+				if collection.ValidateNFTID(coin.TokenId) == nil {
+					state.owners[coin.TokenId] = balance.Address
+				}
+			}
+		}
+	}
+
+	for _, contractNFTs := range genesis.Nfts {
+		if contractNFTs.ContractId != contractID {
+			continue
+		}
+		for _, nft := range contractNFTs.Nfts {
+			state.nftIDs = append(state.nftIDs, nft.TokenId)
+		}
+	}
+
+	for _, contractParents := range genesis.Parents {
+		if contractParents.ContractId != contractID {
+			continue
+		}
+		for _, relation := range contractParents.Relations {
+			state.childIDs = append(state.childIDs, relation.Self)
+			state.parents[relation.Self] = relation.Other
+			state.children[relation.Other] = append(state.children[relation.Other], relation.Self)
+		}
+	}
+
+	for _, contractGrants := range genesis.Grants {
+		if contractGrants.ContractId == contractID {
+			state.grants = contractGrants.Grants
+		}
+	}
+
+	for _, contractAuthorizations := range genesis.Authorizations {
+		if contractAuthorizations.ContractId == contractID {
+			state.authorizations = contractAuthorizations.Authorizations
+		}
+	}
+
+	for _, contractApprovals := range genesis.Approvals {
+		if contractApprovals.ContractId == contractID {
+			state.approvals = contractApprovals.Approvals
+		}
+	}
+
+	return state, true
+}
+
+// fts returns the ft balances of the account.
+func (s contractState) fts(acc simtypes.Account) []collection.Coin {
+	var coins []collection.Coin
+	for _, coin := range s.balances[acc.Address.String()] {
+		if collection.ValidateFTID(coin.TokenId) == nil {
+			coins = append(coins, coin)
+		}
+	}
+
+	return coins
+}
+
+func (s contractState) hasFTs(acc simtypes.Account) bool {
+	return len(s.fts(acc)) != 0
+}
+
+// nfts returns the ids of the root nfts owned by the account.
+func (s contractState) nfts(acc simtypes.Account) []string {
+	var tokenIDs []string
+	for _, coin := range s.balances[acc.Address.String()] {
+		if collection.ValidateNFTID(coin.TokenId) == nil {
+			tokenIDs = append(tokenIDs, coin.TokenId)
+		}
+	}
+
+	return tokenIDs
+}
+
+func (s contractState) hasNFTs(acc simtypes.Account) bool {
+	return len(s.nfts(acc)) != 0
+}
+
+func (s contractState) hasPermission(permission collection.Permission) func(acc simtypes.Account) bool {
+	return func(acc simtypes.Account) bool {
+		for _, grant := range s.grants {
+			if grant.Grantee == acc.Address.String() && grant.Permission == permission {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// isInvolved returns whether the account has any tokens or permissions on the contract.
+func (s contractState) isInvolved(acc simtypes.Account) bool {
+	if len(s.balances[acc.Address.String()]) != 0 {
+		return true
+	}
+
+	for _, grant := range s.grants {
+		if grant.Grantee == acc.Address.String() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// randomCounterpartyIfAny returns a random account satisfying the condition, if any.
+// It prefers the accounts involved in the contract for a half of the time, so the
+// operations depending on the others (e.g. the operator ones) would take place.
+func (s contractState) randomCounterpartyIfAny(r *rand.Rand, accs []simtypes.Account, cond func(acc simtypes.Account) bool) (simtypes.Account, bool) {
+	if r.Intn(2) == 0 {
+		if acc, found := randomAccount(r, accs, func(acc simtypes.Account) bool {
+			return s.isInvolved(acc) && cond(acc)
+		}); found {
+			return acc, true
+		}
+	}
+
+	return randomAccount(r, accs, cond)
+}
+
+// randomCounterparty is like randomCounterpartyIfAny, but it falls back on
+// a random account if no account satisfies the condition.
+func (s contractState) randomCounterparty(r *rand.Rand, accs []simtypes.Account, cond func(acc simtypes.Account) bool) simtypes.Account {
+	if acc, found := s.randomCounterpartyIfAny(r, accs, cond); found {
+		return acc
+	}
+
+	acc, _ := simtypes.RandomAcc(r, accs)
+	return acc
+}
+
+func (s contractState) isOperatorFor(operator, holder simtypes.Account) bool {
+	for _, authorization := range s.authorizations {
+		if authorization.Holder == holder.Address.String() && authorization.Operator == operator.Address.String() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// randomAuthorization returns a random pair of holder and operator satisfying the conditions, if any.
+func (s contractState) randomAuthorization(
+	r *rand.Rand, accs []simtypes.Account, holderCond, operatorCond func(acc simtypes.Account) bool,
+) (holder, operator simtypes.Account, found bool) {
+	for _, i := range r.Perm(len(s.authorizations)) {
+		authorization := s.authorizations[i]
+
+		holder, found = findAccount(accs, authorization.Holder)
+		if !found || !holderCond(holder) {
+			continue
+		}
+
+		operator, found = findAccount(accs, authorization.Operator)
+		if !found || !operatorCond(operator) {
+			continue
+		}
+
+		return holder, operator, true
+	}
+
+	return simtypes.Account{}, simtypes.Account{}, false
+}
+
+func (s contractState) randomGrant(r *rand.Rand) (collection.Grant, bool) {
+	if len(s.grants) == 0 {
+		return collection.Grant{}, false
+	}
+
+	return s.grants[r.Intn(len(s.grants))], true
+}
+
+func (s contractState) randomApproval(r *rand.Rand) (collection.NFTApproval, bool) {
+	if len(s.approvals) == 0 {
+		return collection.NFTApproval{}, false
+	}
+
+	return s.approvals[r.Intn(len(s.approvals))], true
+}
+
+// randomChild returns the id of a random nft which has its parent, if any.
+func (s contractState) randomChild(r *rand.Rand) (string, bool) {
+	if len(s.childIDs) == 0 {
+		return "", false
+	}
+
+	return s.childIDs[r.Intn(len(s.childIDs))], true
+}
+
+func (s contractState) root(tokenID string) string {
+	for {
+		parentID, ok := s.parents[tokenID]
+		if !ok {
+			return tokenID
+		}
+		tokenID = parentID
+	}
+}
+
+func (s contractState) depth(tokenID string) int {
+	depth := 0
+	for parentID, ok := s.parents[tokenID]; ok; parentID, ok = s.parents[parentID] {
+		depth++
+	}
+
+	return depth
+}
+
+// widths returns the number of nfts on each level of the tree rooted at the token.
+func (s contractState) widths(tokenID string) []int {
+	widths := []int{}
+	for level := []string{tokenID}; len(level) != 0; {
+		widths = append(widths, len(level))
+
+		var next []string
+		for _, id := range level {
+			next = append(next, s.children[id]...)
+		}
+		level = next
+	}
+
+	return widths
+}
+
+// randomAttachment returns a random pair of subject and target, owned by the account,
+// whose resulting tree would not exceed the limits of the params.
+func (s contractState) randomAttachment(r *rand.Rand, params collection.Params, owner simtypes.Account) (subject, target string, found bool) {
+	subjects := s.nfts(owner)
+	for _, i := range r.Perm(len(subjects)) {
+		subject = subjects[i]
+		subjectWidths := s.widths(subject)
+
+		for _, j := range r.Perm(len(s.nftIDs)) {
+			target = s.nftIDs[j]
+
+			root := s.root(target)
+			if root == subject || s.owners[root] != owner.Address.String() {
+				continue
+			}
+
+			widths := s.widths(root)
+			offset := s.depth(target) + 1
+			for level, width := range subjectWidths {
+				if offset+level == len(widths) {
+					widths = append(widths, 0)
+				}
+				widths[offset+level] += width
+			}
+
+			if withinLimits(params, widths) {
+				return subject, target, true
+			}
+		}
+	}
+
+	return "", "", false
+}
+
+func withinLimits(params collection.Params, widths []int) bool {
+	if len(widths)-1 > int(params.DepthLimit) {
+		return false
+	}
+
+	for _, width := range widths {
+		if width > int(params.WidthLimit) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -497,6 +497,225 @@ func (m *MsgOperatorSendNFTResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgOperatorSendNFTResponse proto.InternalMessageInfo
 
+// MsgApproveNFT is the Msg/ApproveNFT request type.
+//
+// Since: 0.49.0 (finschia)
+type MsgApproveNFT struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// address of the owner of the token.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// address which the manipulation of the token is allowed to.
+	Approved string `protobuf:"bytes,3,opt,name=approved,proto3" json:"approved,omitempty"`
+	// token id of the token to approve.
+	TokenId string `protobuf:"bytes,4,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+}
+
+func (m *MsgApproveNFT) Reset()         { *m = MsgApproveNFT{} }
+func (m *MsgApproveNFT) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveNFT) ProtoMessage()    {}
+func (*MsgApproveNFT) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{8}
+}
+func (m *MsgApproveNFT) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveNFT.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApproveNFT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveNFT.Merge(m, src)
+}
+func (m *MsgApproveNFT) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveNFT) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveNFT.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveNFT proto.InternalMessageInfo
+
+func (m *MsgApproveNFT) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *MsgApproveNFT) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgApproveNFT) GetApproved() string {
+	if m != nil {
+		return m.Approved
+	}
+	return ""
+}
+
+func (m *MsgApproveNFT) GetTokenId() string {
+	if m != nil {
+		return m.TokenId
+	}
+	return ""
+}
+
+// MsgApproveNFTResponse is the Msg/ApproveNFT response type.
+//
+// Since: 0.49.0 (finschia)
+type MsgApproveNFTResponse struct {
+}
+
+func (m *MsgApproveNFTResponse) Reset()         { *m = MsgApproveNFTResponse{} }
+func (m *MsgApproveNFTResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveNFTResponse) ProtoMessage()    {}
+func (*MsgApproveNFTResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{9}
+}
+func (m *MsgApproveNFTResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveNFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveNFTResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApproveNFTResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveNFTResponse.Merge(m, src)
+}
+func (m *MsgApproveNFTResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveNFTResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveNFTResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveNFTResponse proto.InternalMessageInfo
+
+// MsgRevokeNFTApproval is the Msg/RevokeNFTApproval request type.
+//
+// Since: 0.49.0 (finschia)
+type MsgRevokeNFTApproval struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// address of the owner of the token.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// token id of the token to revoke the approval on.
+	TokenId string `protobuf:"bytes,3,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+}
+
+func (m *MsgRevokeNFTApproval) Reset()         { *m = MsgRevokeNFTApproval{} }
+func (m *MsgRevokeNFTApproval) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeNFTApproval) ProtoMessage()    {}
+func (*MsgRevokeNFTApproval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{10}
+}
+func (m *MsgRevokeNFTApproval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeNFTApproval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeNFTApproval.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeNFTApproval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeNFTApproval.Merge(m, src)
+}
+func (m *MsgRevokeNFTApproval) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeNFTApproval) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeNFTApproval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeNFTApproval proto.InternalMessageInfo
+
+func (m *MsgRevokeNFTApproval) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *MsgRevokeNFTApproval) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgRevokeNFTApproval) GetTokenId() string {
+	if m != nil {
+		return m.TokenId
+	}
+	return ""
+}
+
+// MsgRevokeNFTApprovalResponse is the Msg/RevokeNFTApproval response type.
+//
+// Since: 0.49.0 (finschia)
+type MsgRevokeNFTApprovalResponse struct {
+}
+
+func (m *MsgRevokeNFTApprovalResponse) Reset()         { *m = MsgRevokeNFTApprovalResponse{} }
+func (m *MsgRevokeNFTApprovalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeNFTApprovalResponse) ProtoMessage()    {}
+func (*MsgRevokeNFTApprovalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{11}
+}
+func (m *MsgRevokeNFTApprovalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeNFTApprovalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeNFTApprovalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeNFTApprovalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeNFTApprovalResponse.Merge(m, src)
+}
+func (m *MsgRevokeNFTApprovalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeNFTApprovalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeNFTApprovalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeNFTApprovalResponse proto.InternalMessageInfo
+
 // MsgAuthorizeOperator is the Msg/AuthorizeOperator request type.
 type MsgAuthorizeOperator struct {
 	// contract id associated with the contract.
@@ -511,7 +730,7 @@ func (m *MsgAuthorizeOperator) Reset()         { *m = MsgAuthorizeOperator{} }
 func (m *MsgAuthorizeOperator) String() string { return proto.CompactTextString(m) }
 func (*MsgAuthorizeOperator) ProtoMessage()    {}
 func (*MsgAuthorizeOperator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{8}
+	return fileDescriptor_eaee77977a3cfe12, []int{12}
 }
 func (m *MsgAuthorizeOperator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -569,7 +788,7 @@ func (m *MsgAuthorizeOperatorResponse) Reset()         { *m = MsgAuthorizeOperat
 func (m *MsgAuthorizeOperatorResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAuthorizeOperatorResponse) ProtoMessage()    {}
 func (*MsgAuthorizeOperatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{9}
+	return fileDescriptor_eaee77977a3cfe12, []int{13}
 }
 func (m *MsgAuthorizeOperatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -612,7 +831,7 @@ func (m *MsgRevokeOperator) Reset()         { *m = MsgRevokeOperator{} }
 func (m *MsgRevokeOperator) String() string { return proto.CompactTextString(m) }
 func (*MsgRevokeOperator) ProtoMessage()    {}
 func (*MsgRevokeOperator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{10}
+	return fileDescriptor_eaee77977a3cfe12, []int{14}
 }
 func (m *MsgRevokeOperator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -670,7 +889,7 @@ func (m *MsgRevokeOperatorResponse) Reset()         { *m = MsgRevokeOperatorResp
 func (m *MsgRevokeOperatorResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRevokeOperatorResponse) ProtoMessage()    {}
 func (*MsgRevokeOperatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{11}
+	return fileDescriptor_eaee77977a3cfe12, []int{15}
 }
 func (m *MsgRevokeOperatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -717,7 +936,7 @@ func (m *MsgCreateContract) Reset()         { *m = MsgCreateContract{} }
 func (m *MsgCreateContract) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateContract) ProtoMessage()    {}
 func (*MsgCreateContract) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{12}
+	return fileDescriptor_eaee77977a3cfe12, []int{16}
 }
 func (m *MsgCreateContract) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -784,7 +1003,7 @@ func (m *MsgCreateContractResponse) Reset()         { *m = MsgCreateContractResp
 func (m *MsgCreateContractResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateContractResponse) ProtoMessage()    {}
 func (*MsgCreateContractResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{13}
+	return fileDescriptor_eaee77977a3cfe12, []int{17}
 }
 func (m *MsgCreateContractResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -850,7 +1069,7 @@ func (m *MsgIssueFT) Reset()         { *m = MsgIssueFT{} }
 func (m *MsgIssueFT) String() string { return proto.CompactTextString(m) }
 func (*MsgIssueFT) ProtoMessage()    {}
 func (*MsgIssueFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{14}
+	return fileDescriptor_eaee77977a3cfe12, []int{18}
 }
 func (m *MsgIssueFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -940,7 +1159,7 @@ func (m *MsgIssueFTResponse) Reset()         { *m = MsgIssueFTResponse{} }
 func (m *MsgIssueFTResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgIssueFTResponse) ProtoMessage()    {}
 func (*MsgIssueFTResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{15}
+	return fileDescriptor_eaee77977a3cfe12, []int{19}
 }
 func (m *MsgIssueFTResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -988,13 +1207,17 @@ type MsgIssueNFT struct {
 	Meta string `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`
 	// the address of the grantee which must have the permission to issue a token.
 	Owner string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	// royalty of the tokens of the token type (optional).
+	//
+	// Since: 0.49.0 (finschia)
+	Royalty *Royalty `protobuf:"bytes,5,opt,name=royalty,proto3" json:"royalty,omitempty"`
 }
 
 func (m *MsgIssueNFT) Reset()         { *m = MsgIssueNFT{} }
 func (m *MsgIssueNFT) String() string { return proto.CompactTextString(m) }
 func (*MsgIssueNFT) ProtoMessage()    {}
 func (*MsgIssueNFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{16}
+	return fileDescriptor_eaee77977a3cfe12, []int{20}
 }
 func (m *MsgIssueNFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1051,6 +1274,13 @@ func (m *MsgIssueNFT) GetOwner() string {
 	return ""
 }
 
+func (m *MsgIssueNFT) GetRoyalty() *Royalty {
+	if m != nil {
+		return m.Royalty
+	}
+	return nil
+}
+
 // MsgIssueNFTResponse is the Msg/IssueNFT response type.
 type MsgIssueNFTResponse struct {
 	// id of the new token type.
@@ -1062,7 +1292,7 @@ func (m *MsgIssueNFTResponse) Reset()         { *m = MsgIssueNFTResponse{} }
 func (m *MsgIssueNFTResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgIssueNFTResponse) ProtoMessage()    {}
 func (*MsgIssueNFTResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{17}
+	return fileDescriptor_eaee77977a3cfe12, []int{21}
 }
 func (m *MsgIssueNFTResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1119,7 +1349,7 @@ func (m *MsgMintFT) Reset()         { *m = MsgMintFT{} }
 func (m *MsgMintFT) String() string { return proto.CompactTextString(m) }
 func (*MsgMintFT) ProtoMessage()    {}
 func (*MsgMintFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{18}
+	return fileDescriptor_eaee77977a3cfe12, []int{22}
 }
 func (m *MsgMintFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1186,7 +1416,7 @@ func (m *MsgMintFTResponse) Reset()         { *m = MsgMintFTResponse{} }
 func (m *MsgMintFTResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMintFTResponse) ProtoMessage()    {}
 func (*MsgMintFTResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{19}
+	return fileDescriptor_eaee77977a3cfe12, []int{23}
 }
 func (m *MsgMintFTResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1233,7 +1463,7 @@ func (m *MsgMintNFT) Reset()         { *m = MsgMintNFT{} }
 func (m *MsgMintNFT) String() string { return proto.CompactTextString(m) }
 func (*MsgMintNFT) ProtoMessage()    {}
 func (*MsgMintNFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{20}
+	return fileDescriptor_eaee77977a3cfe12, []int{24}
 }
 func (m *MsgMintNFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1300,7 +1530,7 @@ func (m *MsgMintNFTResponse) Reset()         { *m = MsgMintNFTResponse{} }
 func (m *MsgMintNFTResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMintNFTResponse) ProtoMessage()    {}
 func (*MsgMintNFTResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{21}
+	return fileDescriptor_eaee77977a3cfe12, []int{25}
 }
 func (m *MsgMintNFTResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1354,7 +1584,7 @@ func (m *MintNFTParam) Reset()         { *m = MintNFTParam{} }
 func (m *MintNFTParam) String() string { return proto.CompactTextString(m) }
 func (*MintNFTParam) ProtoMessage()    {}
 func (*MintNFTParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{22}
+	return fileDescriptor_eaee77977a3cfe12, []int{26}
 }
 func (m *MintNFTParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1422,7 +1652,7 @@ func (m *MsgBurnFT) Reset()         { *m = MsgBurnFT{} }
 func (m *MsgBurnFT) String() string { return proto.CompactTextString(m) }
 func (*MsgBurnFT) ProtoMessage()    {}
 func (*MsgBurnFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{23}
+	return fileDescriptor_eaee77977a3cfe12, []int{27}
 }
 func (m *MsgBurnFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1482,7 +1712,7 @@ func (m *MsgBurnFTResponse) Reset()         { *m = MsgBurnFTResponse{} }
 func (m *MsgBurnFTResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgBurnFTResponse) ProtoMessage()    {}
 func (*MsgBurnFTResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{24}
+	return fileDescriptor_eaee77977a3cfe12, []int{28}
 }
 func (m *MsgBurnFTResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1532,7 +1762,7 @@ func (m *MsgOperatorBurnFT) Reset()         { *m = MsgOperatorBurnFT{} }
 func (m *MsgOperatorBurnFT) String() string { return proto.CompactTextString(m) }
 func (*MsgOperatorBurnFT) ProtoMessage()    {}
 func (*MsgOperatorBurnFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{25}
+	return fileDescriptor_eaee77977a3cfe12, []int{29}
 }
 func (m *MsgOperatorBurnFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1599,7 +1829,7 @@ func (m *MsgOperatorBurnFTResponse) Reset()         { *m = MsgOperatorBurnFTResp
 func (m *MsgOperatorBurnFTResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgOperatorBurnFTResponse) ProtoMessage()    {}
 func (*MsgOperatorBurnFTResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{26}
+	return fileDescriptor_eaee77977a3cfe12, []int{30}
 }
 func (m *MsgOperatorBurnFTResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1644,7 +1874,7 @@ func (m *MsgBurnNFT) Reset()         { *m = MsgBurnNFT{} }
 func (m *MsgBurnNFT) String() string { return proto.CompactTextString(m) }
 func (*MsgBurnNFT) ProtoMessage()    {}
 func (*MsgBurnNFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{27}
+	return fileDescriptor_eaee77977a3cfe12, []int{31}
 }
 func (m *MsgBurnNFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1702,7 +1932,7 @@ func (m *MsgBurnNFTResponse) Reset()         { *m = MsgBurnNFTResponse{} }
 func (m *MsgBurnNFTResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgBurnNFTResponse) ProtoMessage()    {}
 func (*MsgBurnNFTResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{28}
+	return fileDescriptor_eaee77977a3cfe12, []int{32}
 }
 func (m *MsgBurnNFTResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1750,7 +1980,7 @@ func (m *MsgOperatorBurnNFT) Reset()         { *m = MsgOperatorBurnNFT{} }
 func (m *MsgOperatorBurnNFT) String() string { return proto.CompactTextString(m) }
 func (*MsgOperatorBurnNFT) ProtoMessage()    {}
 func (*MsgOperatorBurnNFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{29}
+	return fileDescriptor_eaee77977a3cfe12, []int{33}
 }
 func (m *MsgOperatorBurnNFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1815,7 +2045,7 @@ func (m *MsgOperatorBurnNFTResponse) Reset()         { *m = MsgOperatorBurnNFTRe
 func (m *MsgOperatorBurnNFTResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgOperatorBurnNFTResponse) ProtoMessage()    {}
 func (*MsgOperatorBurnNFTResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{30}
+	return fileDescriptor_eaee77977a3cfe12, []int{34}
 }
 func (m *MsgOperatorBurnNFTResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1861,6 +2091,8 @@ type MsgModify struct {
 	// changes to apply.
 	// possible attribute keys on modifying collection: name, uri, base_img_uri (deprecated), meta.
 	// possible attribute keys on modifying token type and token: name, meta.
+	// possible attribute keys on modifying non-fungible token type and nft: name, meta, royalty.
+	// Note: the value of royalty is in the form of `<recipient>:<basis_points>`, and an empty value removes the royalty.
 	Changes []Attribute `protobuf:"bytes,5,rep,name=changes,proto3" json:"changes"`
 }
 
@@ -1868,7 +2100,7 @@ func (m *MsgModify) Reset()         { *m = MsgModify{} }
 func (m *MsgModify) String() string { return proto.CompactTextString(m) }
 func (*MsgModify) ProtoMessage()    {}
 func (*MsgModify) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{31}
+	return fileDescriptor_eaee77977a3cfe12, []int{35}
 }
 func (m *MsgModify) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1940,7 +2172,7 @@ func (m *MsgModifyResponse) Reset()         { *m = MsgModifyResponse{} }
 func (m *MsgModifyResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgModifyResponse) ProtoMessage()    {}
 func (*MsgModifyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{32}
+	return fileDescriptor_eaee77977a3cfe12, []int{36}
 }
 func (m *MsgModifyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1985,7 +2217,7 @@ func (m *MsgGrantPermission) Reset()         { *m = MsgGrantPermission{} }
 func (m *MsgGrantPermission) String() string { return proto.CompactTextString(m) }
 func (*MsgGrantPermission) ProtoMessage()    {}
 func (*MsgGrantPermission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{33}
+	return fileDescriptor_eaee77977a3cfe12, []int{37}
 }
 func (m *MsgGrantPermission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2050,7 +2282,7 @@ func (m *MsgGrantPermissionResponse) Reset()         { *m = MsgGrantPermissionRe
 func (m *MsgGrantPermissionResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgGrantPermissionResponse) ProtoMessage()    {}
 func (*MsgGrantPermissionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{34}
+	return fileDescriptor_eaee77977a3cfe12, []int{38}
 }
 func (m *MsgGrantPermissionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2093,7 +2325,7 @@ func (m *MsgRevokePermission) Reset()         { *m = MsgRevokePermission{} }
 func (m *MsgRevokePermission) String() string { return proto.CompactTextString(m) }
 func (*MsgRevokePermission) ProtoMessage()    {}
 func (*MsgRevokePermission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{35}
+	return fileDescriptor_eaee77977a3cfe12, []int{39}
 }
 func (m *MsgRevokePermission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2151,7 +2383,7 @@ func (m *MsgRevokePermissionResponse) Reset()         { *m = MsgRevokePermission
 func (m *MsgRevokePermissionResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRevokePermissionResponse) ProtoMessage()    {}
 func (*MsgRevokePermissionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{36}
+	return fileDescriptor_eaee77977a3cfe12, []int{40}
 }
 func (m *MsgRevokePermissionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2200,7 +2432,7 @@ func (m *MsgAttach) Reset()         { *m = MsgAttach{} }
 func (m *MsgAttach) String() string { return proto.CompactTextString(m) }
 func (*MsgAttach) ProtoMessage()    {}
 func (*MsgAttach) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{37}
+	return fileDescriptor_eaee77977a3cfe12, []int{41}
 }
 func (m *MsgAttach) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2267,7 +2499,7 @@ func (m *MsgAttachResponse) Reset()         { *m = MsgAttachResponse{} }
 func (m *MsgAttachResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAttachResponse) ProtoMessage()    {}
 func (*MsgAttachResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{38}
+	return fileDescriptor_eaee77977a3cfe12, []int{42}
 }
 func (m *MsgAttachResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2314,7 +2546,7 @@ func (m *MsgDetach) Reset()         { *m = MsgDetach{} }
 func (m *MsgDetach) String() string { return proto.CompactTextString(m) }
 func (*MsgDetach) ProtoMessage()    {}
 func (*MsgDetach) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{39}
+	return fileDescriptor_eaee77977a3cfe12, []int{43}
 }
 func (m *MsgDetach) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2374,7 +2606,7 @@ func (m *MsgDetachResponse) Reset()         { *m = MsgDetachResponse{} }
 func (m *MsgDetachResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDetachResponse) ProtoMessage()    {}
 func (*MsgDetachResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{40}
+	return fileDescriptor_eaee77977a3cfe12, []int{44}
 }
 func (m *MsgDetachResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2423,7 +2655,7 @@ func (m *MsgOperatorAttach) Reset()         { *m = MsgOperatorAttach{} }
 func (m *MsgOperatorAttach) String() string { return proto.CompactTextString(m) }
 func (*MsgOperatorAttach) ProtoMessage()    {}
 func (*MsgOperatorAttach) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{41}
+	return fileDescriptor_eaee77977a3cfe12, []int{45}
 }
 func (m *MsgOperatorAttach) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2497,7 +2729,7 @@ func (m *MsgOperatorAttachResponse) Reset()         { *m = MsgOperatorAttachResp
 func (m *MsgOperatorAttachResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgOperatorAttachResponse) ProtoMessage()    {}
 func (*MsgOperatorAttachResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{42}
+	return fileDescriptor_eaee77977a3cfe12, []int{46}
 }
 func (m *MsgOperatorAttachResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2544,7 +2776,7 @@ func (m *MsgOperatorDetach) Reset()         { *m = MsgOperatorDetach{} }
 func (m *MsgOperatorDetach) String() string { return proto.CompactTextString(m) }
 func (*MsgOperatorDetach) ProtoMessage()    {}
 func (*MsgOperatorDetach) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{43}
+	return fileDescriptor_eaee77977a3cfe12, []int{47}
 }
 func (m *MsgOperatorDetach) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2611,7 +2843,7 @@ func (m *MsgOperatorDetachResponse) Reset()         { *m = MsgOperatorDetachResp
 func (m *MsgOperatorDetachResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgOperatorDetachResponse) ProtoMessage()    {}
 func (*MsgOperatorDetachResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{44}
+	return fileDescriptor_eaee77977a3cfe12, []int{48}
 }
 func (m *MsgOperatorDetachResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2649,6 +2881,10 @@ func init() {
 	proto.RegisterType((*MsgSendNFTResponse)(nil), "lbm.collection.v1.MsgSendNFTResponse")
 	proto.RegisterType((*MsgOperatorSendNFT)(nil), "lbm.collection.v1.MsgOperatorSendNFT")
 	proto.RegisterType((*MsgOperatorSendNFTResponse)(nil), "lbm.collection.v1.MsgOperatorSendNFTResponse")
+	proto.RegisterType((*MsgApproveNFT)(nil), "lbm.collection.v1.MsgApproveNFT")
+	proto.RegisterType((*MsgApproveNFTResponse)(nil), "lbm.collection.v1.MsgApproveNFTResponse")
+	proto.RegisterType((*MsgRevokeNFTApproval)(nil), "lbm.collection.v1.MsgRevokeNFTApproval")
+	proto.RegisterType((*MsgRevokeNFTApprovalResponse)(nil), "lbm.collection.v1.MsgRevokeNFTApprovalResponse")
 	proto.RegisterType((*MsgAuthorizeOperator)(nil), "lbm.collection.v1.MsgAuthorizeOperator")
 	proto.RegisterType((*MsgAuthorizeOperatorResponse)(nil), "lbm.collection.v1.MsgAuthorizeOperatorResponse")
 	proto.RegisterType((*MsgRevokeOperator)(nil), "lbm.collection.v1.MsgRevokeOperator")
@@ -2691,92 +2927,98 @@ func init() {
 func init() { proto.RegisterFile("lbm/collection/v1/tx.proto", fileDescriptor_eaee77977a3cfe12) }
 
 var fileDescriptor_eaee77977a3cfe12 = []byte{
-	// 1357 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0xaf, 0xe3, 0x24, 0x4d, 0x5f, 0xa1, 0xb4, 0xde, 0x8a, 0xcd, 0xa6, 0x6d, 0x52, 0x59, 0x74,
-	0xa9, 0x50, 0x9b, 0xa8, 0x5d, 0xb8, 0xa0, 0x05, 0xa9, 0x2d, 0x2a, 0x2a, 0xd0, 0xee, 0x36, 0x94,
-	0x0b, 0x07, 0x2a, 0x27, 0x99, 0x26, 0xde, 0xc6, 0x9e, 0xc8, 0x9e, 0x94, 0x16, 0x0e, 0x48, 0xec,
-	0x05, 0x89, 0xcb, 0x0a, 0x71, 0x45, 0x1c, 0xb8, 0x20, 0x2e, 0x7c, 0x05, 0x8e, 0x7b, 0xdc, 0x23,
-	0xe2, 0xb0, 0xa0, 0xf6, 0x8b, 0x20, 0x8f, 0xc7, 0x13, 0x8f, 0xed, 0x49, 0xdc, 0x6e, 0x76, 0x6f,
-	0xb6, 0xdf, 0x9b, 0xf9, 0xfd, 0xde, 0x9f, 0x79, 0xef, 0x8d, 0xa1, 0xd4, 0x6d, 0x58, 0xb5, 0x26,
-	0xee, 0x76, 0x51, 0x93, 0x98, 0xd8, 0xae, 0x9d, 0x6d, 0xd4, 0xc8, 0x79, 0xb5, 0xe7, 0x60, 0x82,
-	0xb5, 0xb9, 0x6e, 0xc3, 0xaa, 0x0e, 0x64, 0xd5, 0xb3, 0x8d, 0xd2, 0x7c, 0x1b, 0xb7, 0x31, 0x95,
-	0xd6, 0xbc, 0x27, 0x5f, 0xb1, 0xa4, 0xc7, 0x37, 0x09, 0x2d, 0xa3, 0x3a, 0xfa, 0x8f, 0x0a, 0x4c,
-	0xed, 0xbb, 0xed, 0xcf, 0x91, 0xdd, 0xda, 0x3d, 0xd2, 0x2a, 0x30, 0xdd, 0xc4, 0x36, 0x71, 0x8c,
-	0x26, 0x39, 0x36, 0x5b, 0x45, 0x65, 0x59, 0x59, 0x9d, 0xaa, 0x43, 0xf0, 0x69, 0xaf, 0xa5, 0x69,
-	0x90, 0x3d, 0x71, 0xb0, 0x55, 0xcc, 0x50, 0x09, 0x7d, 0xd6, 0x66, 0x20, 0x43, 0x70, 0x51, 0xa5,
-	0x5f, 0x32, 0x04, 0x6b, 0xef, 0x41, 0xde, 0xb0, 0x70, 0xdf, 0x26, 0xc5, 0xec, 0xb2, 0xba, 0x3a,
-	0xbd, 0x79, 0xbb, 0x1a, 0x23, 0x5c, 0xdd, 0xc1, 0xa6, 0xbd, 0x9d, 0x7d, 0xfa, 0xbc, 0x32, 0x51,
-	0x67, 0xca, 0xef, 0x67, 0x8a, 0x8a, 0x7e, 0x1b, 0xe6, 0x38, 0x99, 0x3a, 0x72, 0x7b, 0xd8, 0x76,
-	0x11, 0x15, 0xfc, 0xa9, 0x50, 0xc9, 0x83, 0x1e, 0x72, 0x0c, 0x82, 0x9d, 0xb4, 0x74, 0x4b, 0x50,
-	0xc0, 0x6c, 0x09, 0xa3, 0xcc, 0xdf, 0xb9, 0x29, 0x6a, 0xcc, 0x94, 0x6c, 0x82, 0x29, 0xb9, 0xeb,
-	0x9a, 0x52, 0x81, 0x3b, 0x31, 0xc2, 0x82, 0x49, 0x36, 0x00, 0xb3, 0xf5, 0x60, 0x5c, 0x9e, 0x5f,
-	0x80, 0x29, 0x82, 0x4f, 0x91, 0x7d, 0x6c, 0xb6, 0x5c, 0xea, 0xfc, 0xa9, 0x7a, 0x81, 0x7e, 0xd8,
-	0x6b, 0xb9, 0xfa, 0x3c, 0x68, 0x03, 0xbc, 0x80, 0x89, 0xfe, 0x93, 0x42, 0x3f, 0x87, 0x79, 0x1e,
-	0xbc, 0x0a, 0xcf, 0x0a, 0x54, 0x73, 0x11, 0xaa, 0x8b, 0x50, 0x8a, 0x73, 0xe2, 0x94, 0x4f, 0x61,
-	0x7e, 0xdf, 0x6d, 0x6f, 0xf5, 0x49, 0x07, 0x3b, 0xe6, 0x37, 0x28, 0x50, 0x1b, 0xcd, 0xf9, 0x4d,
-	0xc8, 0x77, 0x70, 0xb7, 0x85, 0x02, 0xc6, 0xec, 0x4d, 0xb0, 0x45, 0x15, 0x6d, 0xd1, 0xcb, 0xb0,
-	0x98, 0x04, 0xc6, 0xc9, 0x74, 0x68, 0x5e, 0xd6, 0xd1, 0x19, 0x3e, 0x7d, 0xc9, 0x4c, 0x16, 0x68,
-	0x42, 0x89, 0x48, 0x9c, 0x46, 0x93, 0xd2, 0xd8, 0x71, 0x90, 0x41, 0xd0, 0x0e, 0xc3, 0xd1, 0xe6,
-	0x21, 0x87, 0xbf, 0xb6, 0x91, 0xc3, 0x08, 0xf8, 0x2f, 0x5e, 0x74, 0x6c, 0xc3, 0x42, 0x41, 0x22,
-	0x79, 0xcf, 0xda, 0x2c, 0xa8, 0x7d, 0xc7, 0x64, 0x90, 0xde, 0xa3, 0xa7, 0x65, 0x21, 0x62, 0xb0,
-	0x88, 0xd1, 0x67, 0xfd, 0x3e, 0x65, 0x20, 0x82, 0x04, 0x0c, 0x46, 0xda, 0xac, 0x3f, 0xce, 0xd0,
-	0x84, 0xdf, 0x73, 0xdd, 0x3e, 0x4a, 0x99, 0xf0, 0x31, 0x9e, 0x01, 0x2b, 0x75, 0xc0, 0xca, 0xf3,
-	0x59, 0x0b, 0x35, 0x4d, 0xcb, 0xe8, 0xba, 0x94, 0x6d, 0xae, 0xce, 0xdf, 0x3d, 0x99, 0x65, 0xda,
-	0xc4, 0x68, 0x74, 0x51, 0x31, 0xb7, 0xac, 0xac, 0x16, 0xea, 0xfc, 0x7d, 0xe0, 0x9d, 0x7c, 0xd8,
-	0x3b, 0x7e, 0x9e, 0x4e, 0xf2, 0x3c, 0xfd, 0x84, 0x57, 0x80, 0x82, 0xf7, 0x6d, 0x7b, 0xd3, 0x3b,
-	0xe8, 0xff, 0x3c, 0xaf, 0xbc, 0xd3, 0x36, 0x49, 0xa7, 0xdf, 0xa8, 0x36, 0xb1, 0x55, 0xdb, 0x35,
-	0x6d, 0xb7, 0xd9, 0x31, 0x8d, 0xda, 0x09, 0x7b, 0x58, 0x77, 0x5b, 0xa7, 0x35, 0x72, 0xd1, 0x43,
-	0x6e, 0x75, 0xcf, 0x26, 0x42, 0x59, 0xb8, 0x47, 0x8f, 0x1b, 0x73, 0x02, 0x77, 0xde, 0x1d, 0x28,
-	0x04, 0xa7, 0x81, 0x79, 0x62, 0x92, 0x1d, 0x06, 0xba, 0xa8, 0x0b, 0xd3, 0xc1, 0xa2, 0x83, 0x71,
-	0xba, 0x8e, 0xbb, 0x20, 0x1b, 0x72, 0x81, 0xfe, 0x2e, 0xdc, 0x0a, 0xa1, 0x71, 0x8e, 0x4b, 0x00,
-	0x3e, 0x47, 0xcf, 0x30, 0x06, 0xea, 0x9f, 0xe1, 0xa3, 0x8b, 0x1e, 0xd2, 0x7f, 0xf6, 0x1b, 0xc9,
-	0xbe, 0x69, 0x93, 0x71, 0x95, 0xb3, 0x0f, 0xd3, 0x36, 0x92, 0xd7, 0xbd, 0xa0, 0xfc, 0xf1, 0x6f,
-	0x25, 0xe7, 0xbd, 0xb9, 0x09, 0x1d, 0xc5, 0x67, 0x25, 0x94, 0xdf, 0x27, 0x0a, 0x4d, 0x47, 0x4f,
-	0x32, 0xb6, 0xfa, 0xfb, 0x01, 0xe4, 0x7b, 0x86, 0x63, 0x58, 0x2e, 0x23, 0x5c, 0x49, 0x20, 0xcc,
-	0x00, 0x1f, 0x7a, 0x7a, 0x41, 0xdb, 0xf0, 0x17, 0xe9, 0x1b, 0x34, 0x37, 0x98, 0x02, 0xf7, 0xbb,
-	0x50, 0x29, 0x95, 0x48, 0xa5, 0xfc, 0x02, 0x5e, 0x0b, 0x6f, 0x38, 0x22, 0x48, 0x69, 0x13, 0x43,
-	0xff, 0x96, 0xc6, 0x72, 0xbb, 0xef, 0xd8, 0x37, 0x75, 0xcd, 0xa0, 0x73, 0xaa, 0x37, 0x1b, 0x02,
-	0x7c, 0x70, 0x21, 0x64, 0xbf, 0x8a, 0x43, 0x40, 0x5a, 0x7a, 0xd7, 0x6d, 0x55, 0x2f, 0x30, 0xbf,
-	0x88, 0x4d, 0x3f, 0xc1, 0x84, 0xaf, 0x68, 0xd2, 0x79, 0x82, 0x1b, 0x27, 0x9d, 0x90, 0x0f, 0x6a,
-	0x62, 0x93, 0x67, 0xfb, 0xf3, 0xee, 0xf0, 0xbd, 0xd8, 0xe4, 0x53, 0xc3, 0x5f, 0xd7, 0x73, 0x43,
-	0xe7, 0x0f, 0xb1, 0xa9, 0x47, 0x29, 0xfe, 0xc5, 0xca, 0x07, 0x6e, 0x99, 0x27, 0x17, 0xa3, 0x99,
-	0xf1, 0xca, 0x95, 0x09, 0x17, 0x6f, 0x31, 0xfb, 0xd5, 0x68, 0xf6, 0x57, 0x60, 0x9a, 0xd1, 0xb3,
-	0x5b, 0xe8, 0x9c, 0x15, 0x3d, 0x7f, 0xc5, 0x9e, 0xf7, 0x45, 0xbb, 0x0f, 0x93, 0xcd, 0x8e, 0x61,
-	0xb7, 0x91, 0xcb, 0xe6, 0xbd, 0xc5, 0x84, 0xd0, 0x6f, 0x11, 0xe2, 0x98, 0x8d, 0x3e, 0x41, 0x2c,
-	0xfe, 0xc1, 0x12, 0xfd, 0x96, 0x5f, 0x6a, 0xa8, 0x05, 0xdc, 0xae, 0x0b, 0xea, 0xf9, 0x8f, 0x1d,
-	0xc3, 0x26, 0x0f, 0x91, 0x63, 0x99, 0xae, 0x6b, 0x62, 0x7b, 0x3c, 0xd5, 0xa6, 0x0c, 0xd0, 0xe3,
-	0x5b, 0x06, 0xd6, 0x0c, 0xbe, 0x30, 0x87, 0x47, 0xa0, 0x39, 0xb1, 0x47, 0xb4, 0xca, 0xfb, 0xe3,
-	0xc4, 0x8b, 0x32, 0x13, 0x99, 0xa8, 0x31, 0x26, 0x4b, 0xb0, 0x90, 0x80, 0xc5, 0xa9, 0x7c, 0x47,
-	0x43, 0xbf, 0x45, 0x88, 0xd1, 0xec, 0xdc, 0x8c, 0x40, 0xb8, 0x7f, 0xaa, 0x42, 0xff, 0xd4, 0xca,
-	0x5e, 0xd0, 0x8f, 0xb9, 0x34, 0x1b, 0x24, 0xc5, 0x51, 0xa8, 0xbf, 0xfa, 0x15, 0xc7, 0x27, 0x20,
-	0x1c, 0xd7, 0x63, 0xca, 0xec, 0x23, 0xf4, 0x32, 0x98, 0x85, 0x90, 0x7d, 0x00, 0x01, 0xf9, 0x37,
-	0xb1, 0xd6, 0xa5, 0x75, 0xce, 0x75, 0x4f, 0x6c, 0x98, 0x5e, 0x76, 0xa8, 0xe3, 0x72, 0x49, 0x8e,
-	0x13, 0xeb, 0x5d, 0x82, 0x03, 0x1f, 0x8b, 0x66, 0xa4, 0xf5, 0xe4, 0xf8, 0xcc, 0x48, 0xa0, 0x19,
-	0xf7, 0xf6, 0xe6, 0x2f, 0xb3, 0xa0, 0xee, 0xbb, 0x6d, 0xed, 0x10, 0xf2, 0xec, 0x6a, 0x99, 0x74,
-	0xf2, 0xf9, 0xd5, 0xb4, 0xf4, 0xd6, 0x30, 0x29, 0xcf, 0x6b, 0xf5, 0x87, 0x8c, 0xa2, 0x99, 0x30,
-	0x13, 0xb9, 0xb5, 0x4a, 0x16, 0x8b, 0x5a, 0xa5, 0xb5, 0x34, 0x5a, 0x22, 0xd4, 0x03, 0x98, 0x0c,
-	0xee, 0x6f, 0x4b, 0x72, 0x82, 0x07, 0xbb, 0x47, 0xa5, 0x95, 0xa1, 0x62, 0x3e, 0x7a, 0xb4, 0xe1,
-	0x8d, 0xe8, 0xc5, 0x70, 0x65, 0x34, 0x2d, 0x0f, 0x60, 0x3d, 0x95, 0x1a, 0x07, 0xb2, 0x60, 0x2e,
-	0x7e, 0x9f, 0x7b, 0x3b, 0x79, 0x8f, 0x98, 0x62, 0xa9, 0x96, 0x52, 0x91, 0xc3, 0xb5, 0x60, 0x26,
-	0x72, 0x63, 0x93, 0xc4, 0x44, 0xd4, 0x92, 0xc5, 0x24, 0xf9, 0x4e, 0xe6, 0xa1, 0x44, 0x2e, 0x64,
-	0x12, 0x14, 0x51, 0x4b, 0x86, 0x22, 0xb9, 0x77, 0x1d, 0xc1, 0x64, 0x70, 0xa5, 0x92, 0x04, 0x9d,
-	0x89, 0x65, 0x41, 0x8f, 0xdc, 0x45, 0xfc, 0x54, 0xaa, 0x43, 0x81, 0x5f, 0x37, 0xca, 0x43, 0xd6,
-	0x79, 0xb1, 0xbe, 0x3b, 0x5c, 0xce, 0x99, 0x1e, 0x42, 0x9e, 0xdd, 0x0e, 0x24, 0x87, 0xcb, 0x97,
-	0xca, 0x0e, 0x97, 0x38, 0xc3, 0xf3, 0x8c, 0x0f, 0x06, 0xf8, 0x25, 0xf9, 0xaa, 0x21, 0x19, 0x1f,
-	0x1d, 0xb6, 0x0f, 0x21, 0xcf, 0xc6, 0x4a, 0x09, 0x47, 0x5f, 0x2a, 0xe3, 0x28, 0x4e, 0x7c, 0xb1,
-	0x02, 0xc0, 0xb6, 0x1e, 0x51, 0x00, 0x18, 0xc4, 0x5a, 0x1a, 0xad, 0x98, 0x3b, 0x82, 0xd9, 0x6e,
-	0x49, 0x4e, 0x70, 0x88, 0x3b, 0x22, 0x53, 0x59, 0xb8, 0x00, 0x04, 0x1b, 0xaf, 0x8c, 0xa6, 0x95,
-	0xa2, 0x00, 0x44, 0x81, 0x3e, 0x83, 0x3c, 0x1b, 0xfd, 0x64, 0xb9, 0x41, 0xa5, 0xd2, 0xdc, 0x10,
-	0x86, 0x2e, 0x8f, 0x76, 0x74, 0xe2, 0x92, 0xd0, 0x8e, 0xa8, 0xc9, 0x68, 0x4b, 0x86, 0x28, 0xed,
-	0x11, 0xcc, 0xc6, 0x26, 0xa8, 0xbb, 0xc3, 0x8a, 0x44, 0x08, 0xaa, 0x9a, 0x4e, 0x2f, 0x9c, 0x9a,
-	0x6c, 0x0a, 0x90, 0xb8, 0xc8, 0x97, 0xca, 0x5c, 0x24, 0x36, 0x67, 0x3f, 0x5f, 0x0e, 0x21, 0xcf,
-	0x3a, 0xb2, 0x64, 0x4b, 0x5f, 0x2a, 0xdb, 0x52, 0x6c, 0xa4, 0xb1, 0x6c, 0x67, 0x6c, 0x47, 0x64,
-	0x3b, 0x63, 0xbd, 0x96, 0x46, 0x4b, 0x0a, 0xc5, 0xac, 0x18, 0x01, 0xc5, 0xac, 0x59, 0x4b, 0xa3,
-	0x25, 0x40, 0x6d, 0x7f, 0xfa, 0xfb, 0x65, 0x79, 0xe2, 0xe9, 0x65, 0x59, 0x79, 0x76, 0x59, 0x56,
-	0xfe, 0xbb, 0x2c, 0x2b, 0x4f, 0xae, 0xca, 0x13, 0xcf, 0xae, 0xca, 0x13, 0x7f, 0x5f, 0x95, 0x27,
-	0xbe, 0x5c, 0x1f, 0xf9, 0x2f, 0xe8, 0x3c, 0xf4, 0xe3, 0xbd, 0x91, 0xa7, 0x7f, 0xde, 0xef, 0xfd,
-	0x1f, 0x00, 0x00, 0xff, 0xff, 0x50, 0xa5, 0x9b, 0x1f, 0xe4, 0x17, 0x00, 0x00,
+	// 1450 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x99, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xc0, 0xb3, 0xd9, 0xd8, 0x49, 0x5e, 0x68, 0x69, 0xb6, 0x81, 0x26, 0xdb, 0xc6, 0x8e, 0x56,
+	0x4e, 0x89, 0x50, 0x62, 0xab, 0x69, 0xb9, 0xa0, 0x82, 0x94, 0xd4, 0x2a, 0x0a, 0x90, 0xb4, 0x31,
+	0x41, 0x42, 0x1c, 0x88, 0xd6, 0xde, 0xa9, 0xbd, 0x8d, 0x77, 0xc7, 0xda, 0x1d, 0x87, 0x1a, 0x84,
+	0x90, 0xe8, 0x05, 0x89, 0x4b, 0x85, 0xb8, 0x23, 0xc1, 0x05, 0x71, 0xe1, 0x2b, 0x70, 0xec, 0xb1,
+	0x47, 0xc4, 0xa1, 0xa0, 0x44, 0xe2, 0x73, 0xa0, 0x9d, 0x9d, 0x1d, 0xef, 0xec, 0x3f, 0x6f, 0xdc,
+	0xb4, 0xb7, 0xdd, 0x7d, 0x6f, 0xe6, 0xfd, 0xde, 0x9b, 0x37, 0x6f, 0xde, 0xd8, 0xa0, 0x76, 0x9b,
+	0x56, 0xad, 0x85, 0xbb, 0x5d, 0xd4, 0x22, 0x26, 0xb6, 0x6b, 0xc7, 0x37, 0x6a, 0xe4, 0x51, 0xb5,
+	0xe7, 0x60, 0x82, 0x95, 0xf9, 0x6e, 0xd3, 0xaa, 0x0e, 0x65, 0xd5, 0xe3, 0x1b, 0xea, 0x42, 0x1b,
+	0xb7, 0x31, 0x95, 0xd6, 0xbc, 0x27, 0x5f, 0x51, 0xd5, 0xe2, 0x93, 0x84, 0x86, 0x51, 0x1d, 0xed,
+	0x07, 0x09, 0x66, 0x77, 0xdd, 0xf6, 0x27, 0xc8, 0x36, 0xee, 0x1e, 0x28, 0x65, 0x98, 0x6b, 0x61,
+	0x9b, 0x38, 0x7a, 0x8b, 0x1c, 0x9a, 0xc6, 0xa2, 0xb4, 0x22, 0xad, 0xcd, 0x36, 0x20, 0xf8, 0xb4,
+	0x63, 0x28, 0x0a, 0x4c, 0x3d, 0x70, 0xb0, 0xb5, 0x38, 0x49, 0x25, 0xf4, 0x59, 0xb9, 0x08, 0x93,
+	0x04, 0x2f, 0xca, 0xf4, 0xcb, 0x24, 0xc1, 0xca, 0x3b, 0x50, 0xd4, 0x2d, 0xdc, 0xb7, 0xc9, 0xe2,
+	0xd4, 0x8a, 0xbc, 0x36, 0xb7, 0x79, 0xa5, 0x1a, 0x03, 0xae, 0xde, 0xc1, 0xa6, 0xbd, 0x3d, 0xf5,
+	0xf4, 0x79, 0x79, 0xa2, 0xc1, 0x94, 0xdf, 0x9d, 0x5c, 0x94, 0xb4, 0x2b, 0x30, 0xcf, 0x61, 0x1a,
+	0xc8, 0xed, 0x61, 0xdb, 0x45, 0x54, 0xf0, 0x87, 0x44, 0x25, 0xf7, 0x7a, 0xc8, 0xd1, 0x09, 0x76,
+	0xf2, 0xe2, 0xaa, 0x30, 0x83, 0xd9, 0x10, 0x86, 0xcc, 0xdf, 0xb9, 0x2b, 0x72, 0xcc, 0x95, 0xa9,
+	0x04, 0x57, 0x0a, 0x67, 0x75, 0xa5, 0x0c, 0x4b, 0x31, 0x60, 0xc1, 0x25, 0x1b, 0x80, 0xf9, 0xba,
+	0x77, 0x5e, 0x91, 0xbf, 0x0a, 0xb3, 0x04, 0x1f, 0x21, 0xfb, 0xd0, 0x34, 0x5c, 0x1a, 0xfc, 0xd9,
+	0xc6, 0x0c, 0xfd, 0xb0, 0x63, 0xb8, 0xda, 0x02, 0x28, 0x43, 0x7b, 0x01, 0x89, 0xf6, 0xa3, 0x04,
+	0x4a, 0x84, 0x73, 0xef, 0x55, 0x44, 0x56, 0x40, 0x2d, 0x44, 0x50, 0xaf, 0x81, 0x1a, 0x67, 0xe2,
+	0xc8, 0xdf, 0xc0, 0x85, 0x5d, 0xb7, 0xbd, 0xd5, 0xeb, 0x39, 0xf8, 0x18, 0xe5, 0x82, 0x5d, 0x80,
+	0x02, 0xfe, 0xd2, 0x46, 0x01, 0xa9, 0xff, 0xe2, 0xb9, 0xa0, 0xfb, 0x93, 0x18, 0x0c, 0x95, 0xbf,
+	0x2b, 0x4b, 0x30, 0x13, 0xe0, 0x31, 0xe8, 0x69, 0x46, 0xa7, 0x5d, 0x81, 0x37, 0x04, 0xf3, 0x9c,
+	0xab, 0x03, 0x0b, 0xbb, 0x6e, 0xbb, 0x81, 0x8e, 0xf1, 0x91, 0xf7, 0xdd, 0xd7, 0xd0, 0xbb, 0xe3,
+	0xe2, 0x85, 0x11, 0x64, 0x11, 0xa1, 0x04, 0xd7, 0x92, 0x2c, 0x71, 0x92, 0x23, 0x4a, 0xb2, 0xd5,
+	0x27, 0x1d, 0xec, 0x98, 0x5f, 0xa1, 0x20, 0x90, 0xa3, 0x49, 0xde, 0x84, 0x62, 0x07, 0x77, 0x0d,
+	0x8e, 0xc2, 0xde, 0x84, 0xd5, 0x96, 0xc5, 0xd5, 0x66, 0x30, 0x31, 0x63, 0xa1, 0xb0, 0xcc, 0x73,
+	0xd8, 0x97, 0x4b, 0x72, 0x15, 0x96, 0x62, 0x96, 0x38, 0x46, 0x8b, 0x62, 0xdc, 0x71, 0x90, 0x4e,
+	0xd0, 0x1d, 0x66, 0x67, 0x18, 0x79, 0x29, 0x1c, 0x79, 0x05, 0xa6, 0x6c, 0xdd, 0x42, 0xc1, 0x56,
+	0xf3, 0x9e, 0x95, 0x4b, 0x20, 0xf7, 0x1d, 0x93, 0x99, 0xf4, 0x1e, 0x3d, 0x2d, 0x0b, 0x11, 0x9d,
+	0xa5, 0x07, 0x7d, 0xd6, 0x6e, 0xc3, 0x52, 0xcc, 0x48, 0x40, 0x30, 0xd2, 0x67, 0xed, 0xf1, 0x24,
+	0x2d, 0x09, 0x3b, 0xae, 0xdb, 0x47, 0x39, 0x4b, 0x42, 0x8c, 0x33, 0xa0, 0x92, 0x87, 0x54, 0x5e,
+	0xcc, 0x0c, 0xd4, 0x32, 0x2d, 0xbd, 0xeb, 0x52, 0xda, 0x42, 0x83, 0xbf, 0x7b, 0x32, 0xcb, 0xb4,
+	0x89, 0xde, 0xec, 0xa2, 0xc5, 0xc2, 0x8a, 0xb4, 0x36, 0xd3, 0xe0, 0xef, 0xc3, 0xe8, 0x14, 0xc3,
+	0xd1, 0xf1, 0x77, 0xf2, 0x34, 0xdf, 0xc9, 0x1f, 0xf2, 0x1a, 0x39, 0xe3, 0x7d, 0xdb, 0xde, 0xf4,
+	0x4a, 0xe1, 0xdf, 0xcf, 0xcb, 0x6f, 0xb7, 0x4d, 0xd2, 0xe9, 0x37, 0xab, 0x2d, 0x6c, 0xd5, 0xee,
+	0x9a, 0xb6, 0xdb, 0xea, 0x98, 0x7a, 0xed, 0x01, 0x7b, 0xd8, 0x70, 0x8d, 0xa3, 0x1a, 0x19, 0xf4,
+	0x90, 0x5b, 0xdd, 0xb1, 0x89, 0x50, 0x38, 0x6f, 0x82, 0x32, 0x0c, 0x02, 0x0f, 0x5e, 0x78, 0x37,
+	0x48, 0xc2, 0x6e, 0xa0, 0x83, 0x7e, 0x91, 0x60, 0x2e, 0x18, 0xb5, 0x77, 0x9e, 0xb1, 0xe3, 0x31,
+	0x98, 0x0a, 0xc7, 0xe0, 0x16, 0x4c, 0x3b, 0x78, 0xa0, 0x77, 0xc9, 0x80, 0x06, 0x6d, 0x6e, 0x53,
+	0x4d, 0x38, 0x18, 0x1a, 0xbe, 0x46, 0x23, 0x50, 0xd5, 0x6e, 0xc1, 0xe5, 0x10, 0x23, 0x77, 0x6d,
+	0x19, 0xc0, 0x77, 0xcd, 0x8b, 0x07, 0x43, 0xf5, 0x8b, 0xe3, 0xc1, 0xa0, 0x87, 0xb4, 0x9f, 0xfc,
+	0x13, 0x7a, 0xd7, 0xb4, 0xc9, 0x79, 0x9d, 0x13, 0xef, 0xe7, 0x3d, 0xa1, 0x2f, 0x78, 0x6b, 0xf9,
+	0xfb, 0x3f, 0xe5, 0x82, 0xf7, 0xe6, 0x26, 0x1c, 0xd5, 0x3e, 0x95, 0x70, 0xae, 0x3d, 0x91, 0x00,
+	0x98, 0xe4, 0xdc, 0x0e, 0xb6, 0xf7, 0xa0, 0xd8, 0xd3, 0x1d, 0xdd, 0x72, 0x19, 0x70, 0x39, 0x01,
+	0x98, 0x19, 0xbc, 0xef, 0xe9, 0x05, 0xe7, 0xb1, 0x3f, 0x48, 0xbb, 0x41, 0x53, 0x8a, 0x29, 0xf0,
+	0xb8, 0x0b, 0x47, 0x90, 0x14, 0x39, 0x82, 0x3e, 0x85, 0xd7, 0xc2, 0x13, 0x8e, 0x58, 0xa4, 0xbc,
+	0xe9, 0xa4, 0x7d, 0x4d, 0xd7, 0x72, 0xbb, 0xef, 0xd8, 0xe3, 0x86, 0x66, 0xd8, 0x92, 0xc8, 0xe3,
+	0x75, 0x57, 0xbe, 0x71, 0x61, 0xc9, 0x7e, 0x16, 0xbb, 0xab, 0xbc, 0x78, 0x67, 0xed, 0x01, 0x5e,
+	0xa0, 0x31, 0x14, 0xbb, 0xa9, 0x04, 0x17, 0xbe, 0xa0, 0x49, 0xe7, 0x09, 0xc6, 0x4e, 0x3a, 0x21,
+	0x1f, 0xe4, 0xc4, 0xee, 0x89, 0xcd, 0xcf, 0x0f, 0x95, 0xef, 0xc4, 0xee, 0x29, 0xb7, 0xf9, 0xb3,
+	0x46, 0x2e, 0xb3, 0xb1, 0x13, 0xbb, 0xa5, 0x28, 0xe2, 0x9f, 0xac, 0x7c, 0x60, 0xc3, 0x7c, 0x30,
+	0x18, 0xb7, 0x17, 0x11, 0xb3, 0x5f, 0x8e, 0x66, 0x7f, 0x19, 0xe6, 0x18, 0x9e, 0x6d, 0xa0, 0x47,
+	0xac, 0x54, 0xfa, 0x23, 0x76, 0xbc, 0x2f, 0xca, 0x6d, 0x98, 0x6e, 0x75, 0x74, 0xbb, 0x8d, 0x5c,
+	0xd6, 0x48, 0x5f, 0x4b, 0x58, 0xfa, 0x2d, 0x42, 0x1c, 0xb3, 0xd9, 0x27, 0x88, 0xad, 0x7f, 0x30,
+	0x44, 0xbb, 0x0c, 0xf3, 0xdc, 0x03, 0xee, 0xd7, 0x80, 0x46, 0xfe, 0x03, 0x47, 0xb7, 0xc9, 0x7d,
+	0xe4, 0x58, 0xa6, 0xeb, 0x9a, 0xd8, 0x3e, 0x9f, 0x6a, 0x53, 0x02, 0xe8, 0xf1, 0x29, 0x03, 0x6f,
+	0x86, 0x5f, 0x58, 0xc0, 0x23, 0xa6, 0x39, 0xd8, 0x43, 0xb8, 0xcc, 0xbb, 0x90, 0x17, 0x25, 0x13,
+	0x49, 0xe4, 0x18, 0xc9, 0x32, 0x5c, 0x4d, 0xb0, 0xc5, 0x51, 0xbe, 0xa5, 0x4b, 0xbf, 0x45, 0x88,
+	0xde, 0xea, 0x8c, 0x07, 0x90, 0xde, 0x84, 0x2a, 0x25, 0x6f, 0xd1, 0x0f, 0x23, 0x5d, 0xf2, 0x2c,
+	0xc1, 0x07, 0xa1, 0x63, 0xd9, 0xaf, 0x38, 0x3e, 0x80, 0xb0, 0x5d, 0x0f, 0x29, 0x59, 0x1d, 0xbd,
+	0x0c, 0xb2, 0x90, 0xe5, 0x3a, 0x8a, 0x59, 0xfe, 0x55, 0xac, 0x75, 0x79, 0x83, 0x73, 0xd6, 0x1d,
+	0x9b, 0x7e, 0x81, 0x88, 0x06, 0xae, 0x90, 0x14, 0x38, 0xb1, 0xde, 0x25, 0x04, 0xf0, 0xb1, 0xe8,
+	0x46, 0x1d, 0xbd, 0x6a, 0x37, 0x12, 0x30, 0xe3, 0xd1, 0xde, 0xfc, 0x6f, 0x1e, 0xe4, 0x5d, 0xb7,
+	0xad, 0xec, 0x43, 0x91, 0xdd, 0xd9, 0x93, 0x76, 0x3e, 0xbf, 0xf3, 0xab, 0x95, 0x2c, 0x29, 0xcf,
+	0x6b, 0xf9, 0xfb, 0x49, 0x49, 0x31, 0xe1, 0x62, 0xe4, 0xe7, 0x80, 0x94, 0xc1, 0xa2, 0x96, 0xba,
+	0x9e, 0x47, 0x4b, 0x34, 0x75, 0x0f, 0xa6, 0x83, 0x8b, 0xf1, 0x72, 0x3a, 0xe0, 0xde, 0xdd, 0x03,
+	0x75, 0x35, 0x53, 0x1c, 0xcc, 0xaa, 0xb4, 0xe1, 0xf5, 0xe8, 0x8d, 0x7b, 0x75, 0x34, 0x96, 0x67,
+	0x60, 0x23, 0x97, 0x1a, 0x37, 0xf4, 0x19, 0x40, 0xe8, 0xa2, 0xbc, 0x92, 0x3c, 0x78, 0xa8, 0xa1,
+	0xae, 0x8d, 0xd2, 0xe0, 0x33, 0x5b, 0x30, 0x1f, 0xbf, 0xea, 0xbe, 0x95, 0x3c, 0x3c, 0xa6, 0xa8,
+	0xd6, 0x72, 0x2a, 0x86, 0xcd, 0xc5, 0xef, 0xb3, 0x29, 0xe6, 0x62, 0x8a, 0x6a, 0x2d, 0xa7, 0x22,
+	0x37, 0x67, 0xc0, 0xc5, 0xc8, 0x8d, 0xb5, 0x92, 0x45, 0xcc, 0x0d, 0xad, 0xe7, 0xd1, 0x0a, 0x5b,
+	0x89, 0x5c, 0x48, 0x53, 0xac, 0x88, 0x5a, 0xea, 0x7a, 0x1e, 0x2d, 0x6e, 0xe5, 0x00, 0xa6, 0x83,
+	0x2b, 0x65, 0x4a, 0xf6, 0x32, 0xb1, 0xba, 0x9a, 0x29, 0x16, 0xf7, 0x44, 0x03, 0x66, 0xf8, 0x6d,
+	0xab, 0x94, 0x31, 0xce, 0xcb, 0xaa, 0xeb, 0xd9, 0x72, 0x4e, 0xba, 0x0f, 0x45, 0x76, 0xcd, 0x49,
+	0xa9, 0x12, 0xbe, 0x54, 0xad, 0x64, 0x49, 0x63, 0x5b, 0x37, 0xb8, 0x89, 0x2c, 0xa7, 0x8f, 0xca,
+	0xd8, 0xba, 0xd1, 0x5b, 0xc3, 0x3e, 0x14, 0x59, 0x7f, 0x9c, 0xc2, 0xe8, 0x4b, 0xd5, 0x4a, 0x96,
+	0x34, 0xb5, 0x92, 0xb1, 0xa9, 0x47, 0x54, 0x32, 0x66, 0x62, 0x3d, 0x8f, 0x56, 0x2c, 0x1c, 0x41,
+	0x93, 0xba, 0x9c, 0x0e, 0x98, 0x11, 0x8e, 0x48, 0x7b, 0x19, 0xae, 0x64, 0xc1, 0xc4, 0xab, 0xa3,
+	0xb1, 0x72, 0x54, 0xb2, 0xa8, 0xa1, 0x8f, 0xa1, 0xc8, 0x7a, 0xd8, 0xb4, 0xdc, 0xa0, 0x52, 0xb5,
+	0x92, 0x25, 0x0d, 0x63, 0x47, 0x5b, 0xc7, 0x14, 0xec, 0x88, 0x9a, 0xba, 0x91, 0x4b, 0x8d, 0x1b,
+	0x7a, 0x08, 0x97, 0x62, 0xad, 0xe0, 0xf5, 0xac, 0x22, 0x11, 0x32, 0x55, 0xcd, 0xa7, 0x17, 0x4e,
+	0x4d, 0xd6, 0xce, 0xa4, 0x84, 0xc8, 0x97, 0xaa, 0x95, 0x2c, 0xa9, 0x98, 0x2f, 0xfb, 0x50, 0xac,
+	0xa3, 0xac, 0x29, 0xeb, 0x28, 0x6b, 0xca, 0x3a, 0x8a, 0x4f, 0x19, 0xca, 0x76, 0x46, 0x3b, 0x22,
+	0xdb, 0x19, 0xf5, 0x7a, 0x1e, 0xad, 0x54, 0x53, 0x75, 0x94, 0xc7, 0x54, 0x1d, 0xe5, 0x31, 0x95,
+	0xe0, 0xd5, 0xf6, 0x47, 0xbf, 0x9d, 0x94, 0x26, 0x9e, 0x9e, 0x94, 0xa4, 0x67, 0x27, 0x25, 0xe9,
+	0xdf, 0x93, 0x92, 0xf4, 0xe4, 0xb4, 0x34, 0xf1, 0xec, 0xb4, 0x34, 0xf1, 0xd7, 0x69, 0x69, 0xe2,
+	0xf3, 0x8d, 0x91, 0xbf, 0x85, 0x3d, 0x0a, 0xfd, 0x35, 0xd3, 0x2c, 0xd2, 0xff, 0x66, 0x6e, 0xfe,
+	0x3f, 0x00, 0x1a, 0x5c, 0x3c, 0xc0, 0x06, 0x1a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2813,6 +3055,19 @@ type MsgClient interface {
 	// - transfer_nft_from (deprecated, not typed)
 	// - operation_transfer_nft (deprecated, not typed)
 	OperatorSendNFT(ctx context.Context, in *MsgOperatorSendNFT, opts ...grpc.CallOption) (*MsgOperatorSendNFTResponse, error)
+	// ApproveNFT allows one to send a non-fungible token on behalf of its owner.
+	// The approval is cleared once the token is transferred, attached or detached.
+	// Fires:
+	// - EventApprovedNFT
+	//
+	// Since: 0.49.0 (finschia)
+	ApproveNFT(ctx context.Context, in *MsgApproveNFT, opts ...grpc.CallOption) (*MsgApproveNFTResponse, error)
+	// RevokeNFTApproval revokes the approval on a non-fungible token.
+	// Fires:
+	// - EventRevokedNFTApproval
+	//
+	// Since: 0.49.0 (finschia)
+	RevokeNFTApproval(ctx context.Context, in *MsgRevokeNFTApproval, opts ...grpc.CallOption) (*MsgRevokeNFTApprovalResponse, error)
 	// AuthorizeOperator allows one to send tokens on behalf of the holder.
 	// Fires:
 	// - EventAuthorizedOperator
@@ -2971,6 +3226,24 @@ func (c *msgClient) OperatorSendNFT(ctx context.Context, in *MsgOperatorSendNFT,
 	return out, nil
 }
 
+func (c *msgClient) ApproveNFT(ctx context.Context, in *MsgApproveNFT, opts ...grpc.CallOption) (*MsgApproveNFTResponse, error) {
+	out := new(MsgApproveNFTResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/ApproveNFT", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeNFTApproval(ctx context.Context, in *MsgRevokeNFTApproval, opts ...grpc.CallOption) (*MsgRevokeNFTApprovalResponse, error) {
+	out := new(MsgRevokeNFTApprovalResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/RevokeNFTApproval", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) AuthorizeOperator(ctx context.Context, in *MsgAuthorizeOperator, opts ...grpc.CallOption) (*MsgAuthorizeOperatorResponse, error) {
 	out := new(MsgAuthorizeOperatorResponse)
 	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/AuthorizeOperator", in, out, opts...)
@@ -3165,6 +3438,19 @@ type MsgServer interface {
 	// - transfer_nft_from (deprecated, not typed)
 	// - operation_transfer_nft (deprecated, not typed)
 	OperatorSendNFT(context.Context, *MsgOperatorSendNFT) (*MsgOperatorSendNFTResponse, error)
+	// ApproveNFT allows one to send a non-fungible token on behalf of its owner.
+	// The approval is cleared once the token is transferred, attached or detached.
+	// Fires:
+	// - EventApprovedNFT
+	//
+	// Since: 0.49.0 (finschia)
+	ApproveNFT(context.Context, *MsgApproveNFT) (*MsgApproveNFTResponse, error)
+	// RevokeNFTApproval revokes the approval on a non-fungible token.
+	// Fires:
+	// - EventRevokedNFTApproval
+	//
+	// Since: 0.49.0 (finschia)
+	RevokeNFTApproval(context.Context, *MsgRevokeNFTApproval) (*MsgRevokeNFTApprovalResponse, error)
 	// AuthorizeOperator allows one to send tokens on behalf of the holder.
 	// Fires:
 	// - EventAuthorizedOperator
@@ -3293,6 +3579,12 @@ func (*UnimplementedMsgServer) SendNFT(ctx context.Context, req *MsgSendNFT) (*M
 func (*UnimplementedMsgServer) OperatorSendNFT(ctx context.Context, req *MsgOperatorSendNFT) (*MsgOperatorSendNFTResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OperatorSendNFT not implemented")
 }
+func (*UnimplementedMsgServer) ApproveNFT(ctx context.Context, req *MsgApproveNFT) (*MsgApproveNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveNFT not implemented")
+}
+func (*UnimplementedMsgServer) RevokeNFTApproval(ctx context.Context, req *MsgRevokeNFTApproval) (*MsgRevokeNFTApprovalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeNFTApproval not implemented")
+}
 func (*UnimplementedMsgServer) AuthorizeOperator(ctx context.Context, req *MsgAuthorizeOperator) (*MsgAuthorizeOperatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AuthorizeOperator not implemented")
 }
@@ -3424,56 +3716,92 @@ func _Msg_OperatorSendNFT_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_AuthorizeOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAuthorizeOperator)
+func _Msg_ApproveNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveNFT)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).AuthorizeOperator(ctx, in)
+		return srv.(MsgServer).ApproveNFT(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Msg/AuthorizeOperator",
+		FullMethod: "/lbm.collection.v1.Msg/ApproveNFT",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AuthorizeOperator(ctx, req.(*MsgAuthorizeOperator))
+		return srv.(MsgServer).ApproveNFT(ctx, req.(*MsgApproveNFT))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_RevokeOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRevokeOperator)
+func _Msg_RevokeNFTApproval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeNFTApproval)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).RevokeOperator(ctx, in)
+		return srv.(MsgServer).RevokeNFTApproval(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Msg/RevokeOperator",
+		FullMethod: "/lbm.collection.v1.Msg/RevokeNFTApproval",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RevokeOperator(ctx, req.(*MsgRevokeOperator))
+		return srv.(MsgServer).RevokeNFTApproval(ctx, req.(*MsgRevokeNFTApproval))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_CreateContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateContract)
+func _Msg_AuthorizeOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAuthorizeOperator)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).CreateContract(ctx, in)
+		return srv.(MsgServer).AuthorizeOperator(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lbm.collection.v1.Msg/CreateContract",
+		FullMethod: "/lbm.collection.v1.Msg/AuthorizeOperator",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateContract(ctx, req.(*MsgCreateContract))
+		return srv.(MsgServer).AuthorizeOperator(ctx, req.(*MsgAuthorizeOperator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeOperator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Msg/RevokeOperator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeOperator(ctx, req.(*MsgRevokeOperator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Msg/CreateContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateContract(ctx, req.(*MsgCreateContract))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -3768,6 +4096,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "OperatorSendNFT",
 			Handler:    _Msg_OperatorSendNFT_Handler,
 		},
+		{
+			MethodName: "ApproveNFT",
+			Handler:    _Msg_ApproveNFT_Handler,
+		},
+		{
+			MethodName: "RevokeNFTApproval",
+			Handler:    _Msg_RevokeNFTApproval_Handler,
+		},
 		{
 			MethodName: "AuthorizeOperator",
 			Handler:    _Msg_AuthorizeOperator_Handler,
@@ -4173,6 +4509,147 @@ func (m *MsgOperatorSendNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgApproveNFT) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveNFT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Approved) > 0 {
+		i -= len(m.Approved)
+		copy(dAtA[i:], m.Approved)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Approved)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveNFTResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveNFTResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeNFTApproval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeNFTApproval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeNFTApproval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeNFTApprovalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeNFTApprovalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeNFTApprovalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgAuthorizeOperator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4521,6 +4998,18 @@ func (m *MsgIssueNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Royalty != nil {
+		{
+			size, err := m.Royalty.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Owner) > 0 {
 		i -= len(m.Owner)
 		copy(dAtA[i:], m.Owner)
@@ -5785,6 +6274,70 @@ func (m *MsgOperatorSendNFTResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgApproveNFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Approved)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgApproveNFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeNFTApproval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeNFTApprovalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgAuthorizeOperator) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5955,6 +6508,10 @@ func (m *MsgIssueNFT) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.Royalty != nil {
+		l = m.Royalty.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -7432,7 +7989,7 @@ func (m *MsgOperatorSendNFTResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAuthorizeOperator) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveNFT) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7455,10 +8012,10 @@ func (m *MsgAuthorizeOperator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAuthorizeOperator: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveNFT: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAuthorizeOperator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveNFT: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7495,7 +8052,7 @@ func (m *MsgAuthorizeOperator) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7523,11 +8080,11 @@ func (m *MsgAuthorizeOperator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holder = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Approved", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7555,19 +8112,443 @@ func (m *MsgAuthorizeOperator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.Approved = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApproveNFTResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveNFTResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeNFTApproval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeNFTApproval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeNFTApproval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeNFTApprovalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeNFTApprovalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeNFTApprovalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAuthorizeOperator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAuthorizeOperator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAuthorizeOperator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -8606,6 +9587,42 @@ func (m *MsgIssueNFT) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Royalty == nil {
+				m.Royalty = &Royalty{}
+			}
+			if err := m.Royalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
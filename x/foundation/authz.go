@@ -1,6 +1,8 @@
 package foundation
 
 import (
+	"time"
+
 	"github.com/gogo/protobuf/proto"
 
 	sdk "github.com/Finschia/finschia-sdk/types"
@@ -39,21 +41,163 @@ type AcceptResponse struct {
 	Updated Authorization
 }
 
-var _ Authorization = (*ReceiveFromTreasuryAuthorization)(nil)
+// ExpirableAuthorization is an Authorization which may expire at some time.
+// The keeper prunes the expired ones at the end of each block.
+type ExpirableAuthorization interface {
+	Authorization
+
+	// GetExpiration returns the time when the authorization expires.
+	// It returns nil if the authorization never expires.
+	GetExpiration() *time.Time
+}
+
+var _ ExpirableAuthorization = (*ReceiveFromTreasuryAuthorization)(nil)
 
 func (a ReceiveFromTreasuryAuthorization) MsgTypeURL() string {
 	return sdk.MsgTypeURL(&MsgWithdrawFromTreasury{})
 }
 
 func (a ReceiveFromTreasuryAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (AcceptResponse, error) {
-	_, ok := msg.(*MsgWithdrawFromTreasury)
+	withdraw, ok := msg.(*MsgWithdrawFromTreasury)
 	if !ok {
 		return AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
 	}
 
-	return AcceptResponse{Accept: true}, nil
+	if isExpired(a.Expiration, ctx.BlockTime()) {
+		return AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("authorization expired")
+	}
+
+	if a.SpendLimit.Empty() {
+		return AcceptResponse{Accept: true}, nil
+	}
+
+	left, isNeg := a.SpendLimit.SafeSub(withdraw.Amount)
+	if isNeg {
+		return AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("spend limit exceeded; %s < %s", a.SpendLimit, withdraw.Amount)
+	}
+
+	if left.IsZero() {
+		return AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	a.SpendLimit = left
+	return AcceptResponse{Accept: true, Updated: &a}, nil
 }
 
 func (a ReceiveFromTreasuryAuthorization) ValidateBasic() error {
+	if !a.SpendLimit.Empty() {
+		if err := validateSpendLimit(a.SpendLimit); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var _ ExpirableAuthorization = (*PeriodicReceiveFromTreasuryAuthorization)(nil)
+
+func (a PeriodicReceiveFromTreasuryAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgWithdrawFromTreasury{})
+}
+
+func (a PeriodicReceiveFromTreasuryAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (AcceptResponse, error) {
+	withdraw, ok := msg.(*MsgWithdrawFromTreasury)
+	if !ok {
+		return AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	blockTime := ctx.BlockTime()
+	if isExpired(a.Basic.Expiration, blockTime) {
+		return AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("authorization expired")
+	}
+
+	a.tryResetPeriod(blockTime)
+
+	// deduct from both the current period and the max amount
+	var isNeg bool
+	a.PeriodCanSpend, isNeg = a.PeriodCanSpend.SafeSub(withdraw.Amount)
+	if isNeg {
+		return AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrap("period spend limit exceeded")
+	}
+
+	if !a.Basic.SpendLimit.Empty() {
+		a.Basic.SpendLimit, isNeg = a.Basic.SpendLimit.SafeSub(withdraw.Amount)
+		if isNeg {
+			return AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrap("spend limit exceeded")
+		}
+
+		if a.Basic.SpendLimit.IsZero() {
+			return AcceptResponse{Accept: true, Delete: true}, nil
+		}
+	}
+
+	return AcceptResponse{Accept: true, Updated: &a}, nil
+}
+
+// tryResetPeriod tops up PeriodCanSpend to min(PeriodSpendLimit, Basic.SpendLimit)
+// and updates PeriodReset, if PeriodReset has been hit. Otherwise, it is a no-op.
+// If we are more than one period out, the next reset is one period from blockTime.
+func (a *PeriodicReceiveFromTreasuryAuthorization) tryResetPeriod(blockTime time.Time) {
+	if blockTime.Before(a.PeriodReset) {
+		return
+	}
+
+	// set PeriodCanSpend to the lesser of Basic.SpendLimit and PeriodSpendLimit
+	if _, isNeg := a.Basic.SpendLimit.SafeSub(a.PeriodSpendLimit); isNeg && !a.Basic.SpendLimit.Empty() {
+		a.PeriodCanSpend = a.Basic.SpendLimit
+	} else {
+		a.PeriodCanSpend = a.PeriodSpendLimit
+	}
+
+	a.PeriodReset = a.PeriodReset.Add(a.Period)
+	if blockTime.After(a.PeriodReset) {
+		a.PeriodReset = blockTime.Add(a.Period)
+	}
+}
+
+func (a PeriodicReceiveFromTreasuryAuthorization) ValidateBasic() error {
+	if err := a.Basic.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if a.Period <= 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("period must be positive")
+	}
+
+	if err := validateSpendLimit(a.PeriodSpendLimit); err != nil {
+		return err
+	}
+
+	if err := a.PeriodCanSpend.Validate(); err != nil {
+		return sdkerrors.ErrInvalidCoins.Wrap(err.Error())
+	}
+
+	// ensure PeriodSpendLimit can be subtracted from total (same coin types)
+	if !a.Basic.SpendLimit.Empty() {
+		if !a.PeriodSpendLimit.DenomsSubsetOf(a.Basic.SpendLimit) {
+			return sdkerrors.ErrInvalidCoins.Wrap("period spend limit has different currency than basic spend limit")
+		}
+	}
+
 	return nil
 }
+
+// GetExpiration returns the expiration of the basic authorization.
+func (a *PeriodicReceiveFromTreasuryAuthorization) GetExpiration() *time.Time {
+	return a.Basic.Expiration
+}
+
+func validateSpendLimit(limit sdk.Coins) error {
+	if err := limit.Validate(); err != nil {
+		return sdkerrors.ErrInvalidCoins.Wrap(err.Error())
+	}
+	if limit.Empty() {
+		return sdkerrors.ErrInvalidCoins.Wrap("empty spend limit")
+	}
+
+	return nil
+}
+
+func isExpired(expiration *time.Time, blockTime time.Time) bool {
+	return expiration != nil && !blockTime.Before(*expiration)
+}
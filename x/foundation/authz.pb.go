@@ -5,18 +5,25 @@ package foundation
 
 import (
 	fmt "fmt"
+	github_com_Finschia_finschia_sdk_types "github.com/Finschia/finschia-sdk/types"
+	types "github.com/Finschia/finschia-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,8 +32,14 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // ReceiveFromTreasuryAuthorization allows the grantee to receive coins
-// up to receive_limit from the treasury.
+// up to spend_limit from the treasury.
 type ReceiveFromTreasuryAuthorization struct {
+	// spend_limit specifies the maximum amount of coins that can be received
+	// by this authorization and will be updated as coins are received. If it is
+	// empty, there is no spend limit and any amount of coins can be received.
+	SpendLimit github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,1,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"spend_limit,omitempty"`
+	// expiration specifies an optional time when this authorization expires.
+	Expiration *time.Time `protobuf:"bytes,2,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
 }
 
 func (m *ReceiveFromTreasuryAuthorization) Reset()         { *m = ReceiveFromTreasuryAuthorization{} }
@@ -62,27 +75,150 @@ func (m *ReceiveFromTreasuryAuthorization) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ReceiveFromTreasuryAuthorization proto.InternalMessageInfo
 
+func (m *ReceiveFromTreasuryAuthorization) GetSpendLimit() github_com_Finschia_finschia_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+func (m *ReceiveFromTreasuryAuthorization) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+// PeriodicReceiveFromTreasuryAuthorization extends ReceiveFromTreasuryAuthorization
+// to allow for both a maximum cap, as well as a limit per time period.
+type PeriodicReceiveFromTreasuryAuthorization struct {
+	// basic specifies a struct of `ReceiveFromTreasuryAuthorization`
+	Basic ReceiveFromTreasuryAuthorization `protobuf:"bytes,1,opt,name=basic,proto3" json:"basic"`
+	// period specifies the time duration in which period_spend_limit coins can
+	// be received before that authorization is reset
+	Period time.Duration `protobuf:"bytes,2,opt,name=period,proto3,stdduration" json:"period"`
+	// period_spend_limit specifies the maximum number of coins that can be received
+	// in the period
+	PeriodSpendLimit github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,3,rep,name=period_spend_limit,json=periodSpendLimit,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"period_spend_limit"`
+	// period_can_spend is the number of coins left to be received before the period_reset time
+	PeriodCanSpend github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,4,rep,name=period_can_spend,json=periodCanSpend,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"period_can_spend"`
+	// period_reset is the time at which this period resets and a new one begins,
+	// it is calculated from the start time of the first withdrawal after the
+	// last period ended
+	PeriodReset time.Time `protobuf:"bytes,5,opt,name=period_reset,json=periodReset,proto3,stdtime" json:"period_reset"`
+}
+
+func (m *PeriodicReceiveFromTreasuryAuthorization) Reset() {
+	*m = PeriodicReceiveFromTreasuryAuthorization{}
+}
+func (m *PeriodicReceiveFromTreasuryAuthorization) String() string { return proto.CompactTextString(m) }
+func (*PeriodicReceiveFromTreasuryAuthorization) ProtoMessage()    {}
+func (*PeriodicReceiveFromTreasuryAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bdb89c90659aa0e, []int{1}
+}
+func (m *PeriodicReceiveFromTreasuryAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeriodicReceiveFromTreasuryAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeriodicReceiveFromTreasuryAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeriodicReceiveFromTreasuryAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeriodicReceiveFromTreasuryAuthorization.Merge(m, src)
+}
+func (m *PeriodicReceiveFromTreasuryAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeriodicReceiveFromTreasuryAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeriodicReceiveFromTreasuryAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeriodicReceiveFromTreasuryAuthorization proto.InternalMessageInfo
+
+func (m *PeriodicReceiveFromTreasuryAuthorization) GetBasic() ReceiveFromTreasuryAuthorization {
+	if m != nil {
+		return m.Basic
+	}
+	return ReceiveFromTreasuryAuthorization{}
+}
+
+func (m *PeriodicReceiveFromTreasuryAuthorization) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *PeriodicReceiveFromTreasuryAuthorization) GetPeriodSpendLimit() github_com_Finschia_finschia_sdk_types.Coins {
+	if m != nil {
+		return m.PeriodSpendLimit
+	}
+	return nil
+}
+
+func (m *PeriodicReceiveFromTreasuryAuthorization) GetPeriodCanSpend() github_com_Finschia_finschia_sdk_types.Coins {
+	if m != nil {
+		return m.PeriodCanSpend
+	}
+	return nil
+}
+
+func (m *PeriodicReceiveFromTreasuryAuthorization) GetPeriodReset() time.Time {
+	if m != nil {
+		return m.PeriodReset
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*ReceiveFromTreasuryAuthorization)(nil), "lbm.foundation.v1.ReceiveFromTreasuryAuthorization")
+	proto.RegisterType((*PeriodicReceiveFromTreasuryAuthorization)(nil), "lbm.foundation.v1.PeriodicReceiveFromTreasuryAuthorization")
 }
 
 func init() { proto.RegisterFile("lbm/foundation/v1/authz.proto", fileDescriptor_8bdb89c90659aa0e) }
 
 var fileDescriptor_8bdb89c90659aa0e = []byte{
-	// 204 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcd, 0x49, 0xca, 0xd5,
-	0x4f, 0xcb, 0x2f, 0xcd, 0x4b, 0x49, 0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x2f, 0x33, 0xd4, 0x4f, 0x2c,
-	0x2d, 0xc9, 0xa8, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0xcc, 0x49, 0xca, 0xd5, 0x43,
-	0x48, 0xeb, 0x95, 0x19, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x65, 0xf5, 0x41, 0x2c, 0x88,
-	0x42, 0x29, 0xc9, 0xe4, 0xfc, 0xe2, 0xdc, 0xfc, 0xe2, 0x78, 0x88, 0x04, 0x84, 0x03, 0x91, 0x52,
-	0x4a, 0xe6, 0x52, 0x08, 0x4a, 0x4d, 0x4e, 0xcd, 0x2c, 0x4b, 0x75, 0x2b, 0xca, 0xcf, 0x0d, 0x29,
-	0x4a, 0x4d, 0x2c, 0x2e, 0x2d, 0xaa, 0x74, 0x2c, 0x2d, 0xc9, 0xc8, 0x2f, 0xca, 0xac, 0x02, 0x9b,
-	0x6b, 0x65, 0x7f, 0x69, 0x8b, 0xae, 0x75, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e,
-	0xae, 0xbe, 0x5b, 0x66, 0x5e, 0x71, 0x72, 0x46, 0x66, 0xa2, 0x7e, 0x1a, 0x94, 0xa1, 0x5b, 0x9c,
-	0x92, 0xad, 0x5f, 0x81, 0xe4, 0x52, 0x3d, 0x14, 0x03, 0x9c, 0xdc, 0x4f, 0x3c, 0x92, 0x63, 0xbc,
-	0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63,
-	0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x97, 0x24, 0x63, 0x93, 0xd8, 0xc0, 0x8e, 0x36, 0x06, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0x3d, 0x24, 0x13, 0x54, 0x19, 0x01, 0x00, 0x00,
+	// 509 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x52, 0xbf, 0x6e, 0xd3, 0x40,
+	0x18, 0x8f, 0xdb, 0xb4, 0x42, 0x17, 0x84, 0xc0, 0x02, 0xc9, 0x89, 0x84, 0x1d, 0x75, 0xca, 0xd0,
+	0xdc, 0x29, 0x2d, 0x13, 0x1d, 0x00, 0x17, 0xb5, 0x0b, 0x08, 0x64, 0x3a, 0xb1, 0x44, 0x67, 0xfb,
+	0xe2, 0x9c, 0xc8, 0xf9, 0x2c, 0xdf, 0x39, 0x6a, 0x2a, 0xf1, 0x00, 0x6c, 0x1d, 0x79, 0x06, 0x36,
+	0x24, 0x46, 0x1e, 0xa0, 0x63, 0xc5, 0xc4, 0xd4, 0xa2, 0x64, 0xe3, 0x29, 0xd0, 0xfd, 0x89, 0x1a,
+	0xe8, 0x60, 0x55, 0xa8, 0xdb, 0xdd, 0x7d, 0xf7, 0xfd, 0xfe, 0x7c, 0xbf, 0x0f, 0x3c, 0x9e, 0xc4,
+	0x0c, 0x8d, 0x78, 0x95, 0xa7, 0x58, 0x52, 0x9e, 0xa3, 0xe9, 0x00, 0xe1, 0x4a, 0x8e, 0x4f, 0x60,
+	0x51, 0x72, 0xc9, 0xdd, 0x07, 0x93, 0x98, 0xc1, 0xab, 0x32, 0x9c, 0x0e, 0x3a, 0x0f, 0x33, 0x9e,
+	0x71, 0x5d, 0x45, 0xea, 0x64, 0x3e, 0x76, 0xda, 0x09, 0x17, 0x8c, 0x8b, 0xa1, 0x29, 0x98, 0x8b,
+	0x2d, 0xf9, 0xe6, 0x86, 0x62, 0x2c, 0x08, 0x9a, 0x0e, 0x62, 0x22, 0xf1, 0x00, 0x25, 0x9c, 0xe6,
+	0xb6, 0x1e, 0x64, 0x9c, 0x67, 0x13, 0x82, 0xf4, 0x2d, 0xae, 0x46, 0x48, 0x52, 0x46, 0x84, 0xc4,
+	0xac, 0x58, 0x02, 0xfc, 0xfb, 0x21, 0xad, 0x4a, 0x23, 0x47, 0xbf, 0x6c, 0x7d, 0x5d, 0x03, 0xdd,
+	0x88, 0x24, 0x84, 0x4e, 0xc9, 0x41, 0xc9, 0xd9, 0x51, 0x49, 0xb0, 0xa8, 0xca, 0xd9, 0x8b, 0x4a,
+	0x8e, 0x79, 0x49, 0x4f, 0xf4, 0x57, 0xf7, 0x93, 0x03, 0x5a, 0xa2, 0x20, 0x79, 0x3a, 0x9c, 0x50,
+	0x46, 0xa5, 0xe7, 0x74, 0xd7, 0x7b, 0xad, 0x9d, 0x36, 0xb4, 0x52, 0x95, 0x38, 0x68, 0xc5, 0xc1,
+	0x7d, 0x4e, 0xf3, 0xf0, 0xf5, 0xd9, 0x45, 0xd0, 0xf8, 0x7d, 0x11, 0x3c, 0x5a, 0xe9, 0xda, 0xe6,
+	0x8c, 0x4a, 0xc2, 0x0a, 0x39, 0xfb, 0x72, 0x19, 0x6c, 0x67, 0x54, 0x8e, 0xab, 0x18, 0x26, 0x9c,
+	0xa1, 0x03, 0x9a, 0x8b, 0x64, 0x4c, 0x31, 0x1a, 0xd9, 0x43, 0x5f, 0xa4, 0x1f, 0x90, 0x9c, 0x15,
+	0x44, 0x68, 0x34, 0x11, 0x01, 0x0d, 0xf3, 0x4a, 0xa1, 0xb8, 0xcf, 0x01, 0x20, 0xc7, 0x05, 0x35,
+	0x26, 0xbc, 0xb5, 0xae, 0xd3, 0x6b, 0xed, 0x74, 0xa0, 0x71, 0x09, 0x97, 0x2e, 0xe1, 0xd1, 0x72,
+	0x0c, 0x61, 0xf3, 0xf4, 0x32, 0x70, 0xa2, 0x95, 0x9e, 0xa7, 0xcf, 0x7e, 0x7c, 0xeb, 0xef, 0xd5,
+	0xf2, 0x1f, 0xaf, 0x24, 0x0b, 0xff, 0x1a, 0xc7, 0xd6, 0xf7, 0x26, 0xe8, 0xbd, 0x25, 0x25, 0xe5,
+	0x29, 0x4d, 0x6a, 0x67, 0xf7, 0x06, 0x6c, 0xc4, 0x58, 0xd0, 0xc4, 0x73, 0xb4, 0xd4, 0x5d, 0x78,
+	0x6d, 0x2b, 0x60, 0x1d, 0x46, 0xd8, 0x54, 0xe3, 0x8c, 0x0c, 0x8e, 0xbb, 0x07, 0x36, 0x0b, 0x4d,
+	0x6e, 0xcd, 0xb7, 0xaf, 0x99, 0x7f, 0x69, 0x23, 0x0e, 0xef, 0xa8, 0xbe, 0xcf, 0xca, 0xbf, 0x6d,
+	0x71, 0x3f, 0x02, 0xd7, 0x9c, 0x86, 0xab, 0x79, 0xae, 0xd7, 0xe5, 0xf9, 0x44, 0x01, 0xdd, 0x38,
+	0xb6, 0xfb, 0x86, 0xea, 0xdd, 0x55, 0x78, 0x33, 0x60, 0xdf, 0x86, 0x09, 0xce, 0x8d, 0x04, 0xaf,
+	0x79, 0x3b, 0xe4, 0xf7, 0x0c, 0xd1, 0x3e, 0xce, 0x35, 0xbf, 0x7b, 0x08, 0xee, 0x5a, 0xea, 0x92,
+	0x08, 0x22, 0xbd, 0x8d, 0xda, 0xcd, 0xd1, 0xd3, 0xd3, 0xdb, 0xd3, 0x32, 0x9d, 0x91, 0x6a, 0xfc,
+	0xef, 0xf5, 0x09, 0x0f, 0xcf, 0xe6, 0xbe, 0x73, 0x3e, 0xf7, 0x9d, 0x5f, 0x73, 0xdf, 0x39, 0x5d,
+	0xf8, 0x8d, 0xf3, 0x85, 0xdf, 0xf8, 0xb9, 0xf0, 0x1b, 0xef, 0xfb, 0x37, 0x82, 0x8d, 0x37, 0xb5,
+	0xe8, 0xdd, 0x3f, 0x03, 0x00, 0x84, 0x85, 0x9f, 0x03, 0x88, 0x04, 0x00, 0x00,
 }
 
 func (m *ReceiveFromTreasuryAuthorization) Marshal() (dAtA []byte, err error) {
@@ -105,6 +241,107 @@ func (m *ReceiveFromTreasuryAuthorization) MarshalToSizedBuffer(dAtA []byte) (in
 	_ = i
 	var l int
 	_ = l
+	if m.Expiration != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintAuthz(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PeriodicReceiveFromTreasuryAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeriodicReceiveFromTreasuryAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeriodicReceiveFromTreasuryAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.PeriodReset, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.PeriodReset):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintAuthz(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.PeriodCanSpend) > 0 {
+		for iNdEx := len(m.PeriodCanSpend) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodCanSpend[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PeriodSpendLimit) > 0 {
+		for iNdEx := len(m.PeriodSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintAuthz(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Basic.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuthz(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -125,6 +362,43 @@ func (m *ReceiveFromTreasuryAuthorization) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
+func (m *PeriodicReceiveFromTreasuryAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Basic.Size()
+	n += 1 + l + sovAuthz(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovAuthz(uint64(l))
+	if len(m.PeriodSpendLimit) > 0 {
+		for _, e := range m.PeriodSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.PeriodCanSpend) > 0 {
+		for _, e := range m.PeriodCanSpend {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.PeriodReset)
+	n += 1 + l + sovAuthz(uint64(l))
 	return n
 }
 
@@ -163,6 +437,293 @@ func (m *ReceiveFromTreasuryAuthorization) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: ReceiveFromTreasuryAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeriodicReceiveFromTreasuryAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeriodicReceiveFromTreasuryAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeriodicReceiveFromTreasuryAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Basic", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Basic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodSpendLimit = append(m.PeriodSpendLimit, types.Coin{})
+			if err := m.PeriodSpendLimit[len(m.PeriodSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodCanSpend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodCanSpend = append(m.PeriodCanSpend, types.Coin{})
+			if err := m.PeriodCanSpend[len(m.PeriodCanSpend)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.PeriodReset, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthz(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package foundation_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -10,10 +11,23 @@ import (
 )
 
 func TestReceiveFromTreasuryAuthorization(t *testing.T) {
+	now := time.Now().UTC()
+	ctx := sdk.Context{}.WithBlockTime(now)
+
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+	future := now.Add(time.Hour)
+	past := now.Add(-time.Hour)
+
 	testCases := map[string]struct {
-		msg    sdk.Msg
-		valid  bool
-		accept bool
+		spendLimit sdk.Coins
+		expiration *time.Time
+		msg        sdk.Msg
+		valid      bool
+		accept     bool
+		delete     bool
+		updated    foundation.Authorization
 	}{
 		"valid": {
 			msg:    &foundation.MsgWithdrawFromTreasury{},
@@ -23,13 +37,48 @@ func TestReceiveFromTreasuryAuthorization(t *testing.T) {
 		"msg mismatch": {
 			msg: &foundation.MsgVote{},
 		},
+		"within the spend limit": {
+			spendLimit: coins(10),
+			expiration: &future,
+			msg: &foundation.MsgWithdrawFromTreasury{
+				Amount: coins(3),
+			},
+			valid:  true,
+			accept: true,
+			updated: &foundation.ReceiveFromTreasuryAuthorization{
+				SpendLimit: coins(7),
+				Expiration: &future,
+			},
+		},
+		"exhaust the spend limit": {
+			spendLimit: coins(10),
+			msg: &foundation.MsgWithdrawFromTreasury{
+				Amount: coins(10),
+			},
+			valid:  true,
+			accept: true,
+			delete: true,
+		},
+		"exceed the spend limit": {
+			spendLimit: coins(10),
+			msg: &foundation.MsgWithdrawFromTreasury{
+				Amount: coins(11),
+			},
+		},
+		"expired": {
+			expiration: &past,
+			msg:        &foundation.MsgWithdrawFromTreasury{},
+		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			authorization := &foundation.ReceiveFromTreasuryAuthorization{}
+			authorization := &foundation.ReceiveFromTreasuryAuthorization{
+				SpendLimit: tc.spendLimit,
+				Expiration: tc.expiration,
+			}
 
-			resp, err := authorization.Accept(sdk.Context{}, tc.msg)
+			resp, err := authorization.Accept(ctx, tc.msg)
 			if !tc.valid {
 				require.Error(t, err)
 				return
@@ -37,6 +86,188 @@ func TestReceiveFromTreasuryAuthorization(t *testing.T) {
 			require.NoError(t, err)
 
 			require.Equal(t, tc.accept, resp.Accept)
+			require.Equal(t, tc.delete, resp.Delete)
+			require.Equal(t, tc.updated, resp.Updated)
+		})
+	}
+}
+
+func TestPeriodicReceiveFromTreasuryAuthorization(t *testing.T) {
+	now := time.Now().UTC()
+	ctx := sdk.Context{}.WithBlockTime(now)
+
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+	period := time.Hour
+	past := now.Add(-time.Minute)
+
+	testCases := map[string]struct {
+		authorization foundation.PeriodicReceiveFromTreasuryAuthorization
+		msg           sdk.Msg
+		valid         bool
+		delete        bool
+		updated       foundation.Authorization
+	}{
+		"first withdrawal resets the period": {
+			authorization: foundation.PeriodicReceiveFromTreasuryAuthorization{
+				Period:           period,
+				PeriodSpendLimit: coins(5),
+			},
+			msg: &foundation.MsgWithdrawFromTreasury{
+				Amount: coins(3),
+			},
+			valid: true,
+			updated: &foundation.PeriodicReceiveFromTreasuryAuthorization{
+				Period:           period,
+				PeriodSpendLimit: coins(5),
+				PeriodCanSpend:   coins(2),
+				PeriodReset:      now.Add(period),
+			},
+		},
+		"within the period": {
+			authorization: foundation.PeriodicReceiveFromTreasuryAuthorization{
+				Basic: foundation.ReceiveFromTreasuryAuthorization{
+					SpendLimit: coins(10),
+				},
+				Period:           period,
+				PeriodSpendLimit: coins(5),
+				PeriodCanSpend:   coins(2),
+				PeriodReset:      now.Add(time.Minute),
+			},
+			msg: &foundation.MsgWithdrawFromTreasury{
+				Amount: coins(2),
+			},
+			valid: true,
+			updated: &foundation.PeriodicReceiveFromTreasuryAuthorization{
+				Basic: foundation.ReceiveFromTreasuryAuthorization{
+					SpendLimit: coins(8),
+				},
+				Period:           period,
+				PeriodSpendLimit: coins(5),
+				PeriodReset:      now.Add(time.Minute),
+			},
+		},
+		"exceed the period spend limit": {
+			authorization: foundation.PeriodicReceiveFromTreasuryAuthorization{
+				Period:           period,
+				PeriodSpendLimit: coins(5),
+				PeriodCanSpend:   coins(2),
+				PeriodReset:      now.Add(time.Minute),
+			},
+			msg: &foundation.MsgWithdrawFromTreasury{
+				Amount: coins(3),
+			},
+		},
+		"new period tops up to the spend limit": {
+			authorization: foundation.PeriodicReceiveFromTreasuryAuthorization{
+				Basic: foundation.ReceiveFromTreasuryAuthorization{
+					SpendLimit: coins(3),
+				},
+				Period:           period,
+				PeriodSpendLimit: coins(5),
+				PeriodReset:      past,
+			},
+			msg: &foundation.MsgWithdrawFromTreasury{
+				Amount: coins(3),
+			},
+			valid:  true,
+			delete: true,
+		},
+		"expired": {
+			authorization: foundation.PeriodicReceiveFromTreasuryAuthorization{
+				Basic: foundation.ReceiveFromTreasuryAuthorization{
+					Expiration: &past,
+				},
+				Period:           period,
+				PeriodSpendLimit: coins(5),
+			},
+			msg: &foundation.MsgWithdrawFromTreasury{
+				Amount: coins(1),
+			},
+		},
+		"msg mismatch": {
+			authorization: foundation.PeriodicReceiveFromTreasuryAuthorization{
+				Period:           period,
+				PeriodSpendLimit: coins(5),
+			},
+			msg: &foundation.MsgVote{},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resp, err := tc.authorization.Accept(ctx, tc.msg)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.True(t, resp.Accept)
+			require.Equal(t, tc.delete, resp.Delete)
+			require.Equal(t, tc.updated, resp.Updated)
+		})
+	}
+}
+
+func TestPeriodicReceiveFromTreasuryAuthorizationValidateBasic(t *testing.T) {
+	coins := func(denom string, amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(denom, amount))
+	}
+
+	testCases := map[string]struct {
+		authorization foundation.PeriodicReceiveFromTreasuryAuthorization
+		valid         bool
+	}{
+		"valid": {
+			authorization: foundation.PeriodicReceiveFromTreasuryAuthorization{
+				Basic: foundation.ReceiveFromTreasuryAuthorization{
+					SpendLimit: coins(sdk.DefaultBondDenom, 10),
+				},
+				Period:           time.Hour,
+				PeriodSpendLimit: coins(sdk.DefaultBondDenom, 5),
+			},
+			valid: true,
+		},
+		"invalid basic": {
+			authorization: foundation.PeriodicReceiveFromTreasuryAuthorization{
+				Basic: foundation.ReceiveFromTreasuryAuthorization{
+					SpendLimit: sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.ZeroInt()}},
+				},
+				Period:           time.Hour,
+				PeriodSpendLimit: coins(sdk.DefaultBondDenom, 5),
+			},
+		},
+		"zero period": {
+			authorization: foundation.PeriodicReceiveFromTreasuryAuthorization{
+				PeriodSpendLimit: coins(sdk.DefaultBondDenom, 5),
+			},
+		},
+		"empty period spend limit": {
+			authorization: foundation.PeriodicReceiveFromTreasuryAuthorization{
+				Period: time.Hour,
+			},
+		},
+		"denom mismatch": {
+			authorization: foundation.PeriodicReceiveFromTreasuryAuthorization{
+				Basic: foundation.ReceiveFromTreasuryAuthorization{
+					SpendLimit: coins(sdk.DefaultBondDenom, 10),
+				},
+				Period:           time.Hour,
+				PeriodSpendLimit: coins("foo", 5),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.authorization.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
 		})
 	}
 }
@@ -2,7 +2,10 @@ package cli
 
 import (
 	"context"
+	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -12,6 +15,80 @@ import (
 	"github.com/Finschia/finschia-sdk/x/foundation"
 )
 
+// Proposal filter flags
+const (
+	FlagStatus         = "status"
+	FlagProposer       = "proposer"
+	FlagSubmitTimeFrom = "submit-time-from"
+	FlagSubmitTimeTo   = "submit-time-to"
+)
+
+func addProposalFilterFlagsToCmd(cmd *cobra.Command) {
+	cmd.Flags().String(FlagStatus, "", "Filter the proposals by the status (submitted|accepted|rejected|aborted|withdrawn)")
+	cmd.Flags().String(FlagProposer, "", "Filter the proposals by the proposer")
+	cmd.Flags().String(FlagSubmitTimeFrom, "", "Filter out the proposals submitted before the time in RFC3339 format")
+	cmd.Flags().String(FlagSubmitTimeTo, "", "Filter out the proposals submitted at or after the time in RFC3339 format")
+}
+
+// proposalStatusFromString returns a ProposalStatus from a string, which may omit the enum prefix.
+func proposalStatusFromString(str string) (foundation.ProposalStatus, error) {
+	prefix := getEnumPrefix(foundation.ProposalStatus_name[0])
+	candidate := strings.ToUpper(str)
+	if !strings.HasPrefix(candidate, prefix) {
+		candidate = prefix + candidate
+	}
+
+	ps, ok := foundation.ProposalStatus_value[candidate]
+	if !ok {
+		return foundation.PROPOSAL_STATUS_UNSPECIFIED, fmt.Errorf("'%s' is not a valid proposal status", str)
+	}
+	return foundation.ProposalStatus(ps), nil
+}
+
+func parseTimeFlag(cmd *cobra.Command, flag string) (*time.Time, error) {
+	str, err := cmd.Flags().GetString(flag)
+	if err != nil {
+		return nil, err
+	}
+	if str == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, str)
+	if err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+func readProposalFilter(cmd *cobra.Command) (proposalStatus foundation.ProposalStatus, proposer string, submitTimeFrom, submitTimeTo *time.Time, err error) {
+	statusStr, err := cmd.Flags().GetString(FlagStatus)
+	if err != nil {
+		return
+	}
+	if statusStr != "" {
+		if proposalStatus, err = proposalStatusFromString(statusStr); err != nil {
+			return
+		}
+	}
+
+	if proposer, err = cmd.Flags().GetString(FlagProposer); err != nil {
+		return
+	}
+	if proposer != "" {
+		if _, err = sdk.AccAddressFromBech32(proposer); err != nil {
+			return
+		}
+	}
+
+	if submitTimeFrom, err = parseTimeFlag(cmd, FlagSubmitTimeFrom); err != nil {
+		return
+	}
+	submitTimeTo, err = parseTimeFlag(cmd, FlagSubmitTimeTo)
+	return
+}
+
 // NewQueryCmd returns the parent command for all x/foundation CLi query commands.
 func NewQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -25,13 +102,20 @@ func NewQueryCmd() *cobra.Command {
 		NewQueryCmdFoundationInfo(),
 		NewQueryCmdMember(),
 		NewQueryCmdMembers(),
+		NewQueryCmdExpiringMembers(),
 		NewQueryCmdProposal(),
 		NewQueryCmdProposals(),
+		NewQueryCmdArchivedProposals(),
 		NewQueryCmdVote(),
 		NewQueryCmdVotes(),
+		NewQueryCmdVoteDelegations(),
 		NewQueryCmdTallyResult(),
+		NewQueryCmdSimulateProposal(),
+		NewQueryCmdMsgTypeDecisionPolicies(),
 		NewQueryCmdCensorships(),
 		NewQueryCmdGrants(),
+		NewQueryCmdTreasuryOutflowLimits(),
+		NewQueryCmdTreasuryOutflowHeadroom(),
 	)
 
 	return cmd
@@ -187,6 +271,43 @@ func NewQueryCmdMembers() *cobra.Command {
 	return cmd
 }
 
+// NewQueryCmdExpiringMembers returns the members with expiring memberships.
+func NewQueryCmdExpiringMembers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "expiring-members",
+		Short: "Query foundation members with expiring memberships",
+		Long:  "Gets the foundation members with expiring memberships, sorted by the expiration time",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := foundation.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := foundation.QueryExpiringMembersRequest{
+				Pagination: pageReq,
+			}
+			res, err := queryClient.ExpiringMembers(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "expiring-members")
+
+	return cmd
+}
+
 // NewQueryCmdProposal returns a proposal baesd on proposal id.
 func NewQueryCmdProposal() *cobra.Command {
 	cmd := &cobra.Command{
@@ -227,7 +348,7 @@ func NewQueryCmdProposals() *cobra.Command {
 		Use:   "proposals",
 		Args:  cobra.NoArgs,
 		Short: "Query all proposals",
-		Long: `Query all proposals
+		Long: `Query all proposals, optionally filtered by the status, the proposer and the submit time
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -236,7 +357,23 @@ func NewQueryCmdProposals() *cobra.Command {
 			}
 			queryClient := foundation.NewQueryClient(clientCtx)
 
-			req := foundation.QueryProposalsRequest{}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			proposalStatus, proposer, submitTimeFrom, submitTimeTo, err := readProposalFilter(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := foundation.QueryProposalsRequest{
+				Pagination:     pageReq,
+				Status:         proposalStatus,
+				Proposer:       proposer,
+				SubmitTimeFrom: submitTimeFrom,
+				SubmitTimeTo:   submitTimeTo,
+			}
 			res, err := queryClient.Proposals(context.Background(), &req)
 			if err != nil {
 				return err
@@ -247,6 +384,58 @@ func NewQueryCmdProposals() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "proposals")
+	addProposalFilterFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewQueryCmdArchivedProposals returns the finished proposals kept in the node's proposal archive.
+func NewQueryCmdArchivedProposals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "archived-proposals",
+		Args:  cobra.NoArgs,
+		Short: "Query the archived proposals",
+		Long: `Query the finished proposals kept in the node's proposal archive, optionally filtered by the status, the proposer and the submit time.
+The node must have enabled the archive.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := foundation.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			proposalStatus, proposer, submitTimeFrom, submitTimeTo, err := readProposalFilter(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := foundation.QueryArchivedProposalsRequest{
+				Pagination:     pageReq,
+				Status:         proposalStatus,
+				Proposer:       proposer,
+				SubmitTimeFrom: submitTimeFrom,
+				SubmitTimeTo:   submitTimeTo,
+			}
+			res, err := queryClient.ArchivedProposals(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "archived-proposals")
+	addProposalFilterFlagsToCmd(cmd)
+
 	return cmd
 }
 
@@ -323,6 +512,43 @@ func NewQueryCmdVotes() *cobra.Command {
 	return cmd
 }
 
+// NewQueryCmdVoteDelegations returns the vote delegations between the members.
+func NewQueryCmdVoteDelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-delegations",
+		Short: "Query vote delegations",
+		Long:  "Gets the vote delegations between the members",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := foundation.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := foundation.QueryVoteDelegationsRequest{
+				Pagination: pageReq,
+			}
+			res, err := queryClient.VoteDelegations(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "vote-delegations")
+
+	return cmd
+}
+
 // NewQueryCmdTallyResult returns the tally of proposal votes.
 func NewQueryCmdTallyResult() *cobra.Command {
 	cmd := &cobra.Command{
@@ -357,6 +583,77 @@ func NewQueryCmdTallyResult() *cobra.Command {
 	return cmd
 }
 
+// NewQueryCmdSimulateProposal returns the simulation result of a proposal.
+func NewQueryCmdSimulateProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-proposal [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Simulate the execution of a proposal",
+		Long: `Simulate the execution of a proposal at the current height, without changing the state
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := foundation.NewQueryClient(clientCtx)
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := foundation.QuerySimulateProposalRequest{ProposalId: proposalID}
+			res, err := queryClient.SimulateProposal(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewQueryCmdMsgTypeDecisionPolicies returns the query decision policies per message type command.
+func NewQueryCmdMsgTypeDecisionPolicies() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "msg-type-decision-policies",
+		Short: "Query decision policies per message type",
+		Long:  "Gets the current decision policies per message type",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := foundation.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := foundation.QueryMsgTypeDecisionPoliciesRequest{
+				Pagination: pageReq,
+			}
+			res, err := queryClient.MsgTypeDecisionPolicies(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "msg-type-decision-policies")
+
+	return cmd
+}
+
 // NewQueryCmdCensorships returns the query censorships command.
 func NewQueryCmdCensorships() *cobra.Command {
 	cmd := &cobra.Command{
@@ -443,3 +740,72 @@ func NewQueryCmdGrants() *cobra.Command {
 
 	return cmd
 }
+
+// NewQueryCmdTreasuryOutflowLimits returns the outflow limits of the treasury.
+func NewQueryCmdTreasuryOutflowLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "treasury-outflow-limits",
+		Short: "Query the outflow limits of the treasury",
+		Long:  "Gets the outflow limits of the foundation treasury",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := foundation.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := foundation.QueryTreasuryOutflowLimitsRequest{
+				Pagination: pageReq,
+			}
+			res, err := queryClient.TreasuryOutflowLimits(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "treasury-outflow-limits")
+
+	return cmd
+}
+
+// NewQueryCmdTreasuryOutflowHeadroom returns the amount of a denom which
+// could be withdrawn from the treasury under its outflow limit.
+func NewQueryCmdTreasuryOutflowHeadroom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "treasury-outflow-headroom [denom]",
+		Short: "Query the outflow headroom of a denom",
+		Long:  "Gets the amount of a denom which could be withdrawn from the treasury under its outflow limit",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := foundation.NewQueryClient(clientCtx)
+
+			req := foundation.QueryTreasuryOutflowHeadroomRequest{
+				Denom: args[0],
+			}
+			res, err := queryClient.TreasuryOutflowHeadroom(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
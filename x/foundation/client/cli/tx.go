@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -20,8 +21,15 @@ import (
 
 // Proposal flags
 const (
-	FlagExec = "exec"
-	ExecTry  = "try"
+	FlagExec               = "exec"
+	ExecTry                = "try"
+	FlagExecuteAfter       = "execute-after"
+	FlagExecuteAfterHeight = "execute-after-height"
+)
+
+// Treasury outflow limit flags
+const (
+	FlagRemove = "remove"
 )
 
 func validateGenerateOnly(cmd *cobra.Command) error {
@@ -91,6 +99,23 @@ func execFromString(execStr string) foundation.Exec {
 	return exec
 }
 
+func parseExecuteAfter(cmd *cobra.Command) (*time.Time, error) {
+	executeAfterStr, err := cmd.Flags().GetString(FlagExecuteAfter)
+	if err != nil {
+		return nil, err
+	}
+	if executeAfterStr == "" {
+		return nil, nil
+	}
+
+	executeAfter, err := time.Parse(time.RFC3339, executeAfterStr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &executeAfter, nil
+}
+
 func normalizeCensorshipAuthority(option string) string {
 	prefix := getEnumPrefix(foundation.CensorshipAuthority_name[0])
 	candidate := strings.ToUpper(prefix + option)
@@ -161,13 +186,17 @@ func NewTxCmd() *cobra.Command {
 		NewTxCmdWithdrawFromTreasury(),
 		NewTxCmdUpdateMembers(),
 		NewTxCmdUpdateDecisionPolicy(),
+		NewTxCmdUpdateMsgTypeDecisionPolicy(),
 		NewTxCmdSubmitProposal(),
 		NewTxCmdWithdrawProposal(),
 		NewTxCmdVote(),
+		NewTxCmdDelegateVote(),
+		NewTxCmdUndelegateVote(),
 		NewTxCmdExec(),
 		NewTxCmdLeaveFoundation(),
 		NewTxCmdGrant(),
 		NewTxCmdRevoke(),
+		NewTxCmdUpdateTreasuryOutflowLimit(),
 	)
 
 	return txCmd
@@ -261,17 +290,22 @@ Example of the content of members-json:
 [
   {
     "address": "addr1",
-    "participating": true,
+    "weight": "1",
     "metadata": "some new metadata"
   },
   {
     "address": "addr2",
-    "participating": false,
-    "metadata": "some metadata"
+    "weight": "1",
+    "expires_at": "2024-01-01T00:00:00Z"
+  },
+  {
+    "address": "addr3",
+    "remove": true
   }
 ]
 
-Set a member's participating to false to delete it.
+Set a member's remove to true to delete it.
+Set a member's expires_at to remove it automatically after that time.
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := validateGenerateOnly(cmd); err != nil {
@@ -353,6 +387,61 @@ Example of the content of policy-json:
 	return cmd
 }
 
+func NewTxCmdUpdateMsgTypeDecisionPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-msg-type-decision-policy [authority] [msg-type-url] [policy-json]",
+		Args:  cobra.RangeArgs(2, 3),
+		Short: "Update the decision policy of a message type",
+		Long: `Update the decision policy of a message type.
+Omit policy-json to remove the decision policy of the message type,
+then the foundation decision policy would be applied to the message type.
+
+Example of the content of policy-json:
+
+{
+  "@type": "/lbm.foundation.v1.ThresholdDecisionPolicy",
+  "threshold": "10",
+  "windows": {
+    "voting_period": "24h",
+    "min_execution_period": "0s"
+  }
+}
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateGenerateOnly(cmd); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := foundation.MsgUpdateMsgTypeDecisionPolicy{
+				Authority:  args[0],
+				MsgTypeUrl: args[1],
+			}
+			if len(args) > 2 {
+				policy, err := parseDecisionPolicy(clientCtx.Codec, args[2])
+				if err != nil {
+					return err
+				}
+				if err := msg.SetDecisionPolicy(policy); err != nil {
+					return err
+				}
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func NewTxCmdSubmitProposal() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "submit-proposal [metadata] [proposers-json] [messages-json]",
@@ -410,10 +499,22 @@ Example of the content of messages-json:
 			}
 			exec := execFromString(execStr)
 
+			executeAfter, err := parseExecuteAfter(cmd)
+			if err != nil {
+				return err
+			}
+
+			executeAfterHeight, err := cmd.Flags().GetUint64(FlagExecuteAfterHeight)
+			if err != nil {
+				return err
+			}
+
 			msg := foundation.MsgSubmitProposal{
-				Proposers: proposers,
-				Metadata:  args[0],
-				Exec:      exec,
+				Proposers:          proposers,
+				Metadata:           args[0],
+				Exec:               exec,
+				ExecuteAfter:       executeAfter,
+				ExecuteAfterHeight: executeAfterHeight,
 			}
 			if err := msg.SetMsgs(messages); err != nil {
 				return err
@@ -427,6 +528,8 @@ Example of the content of messages-json:
 
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().String(FlagExec, "", "Set to 'try' to try to execute proposal immediately after creation (proposers signatures are considered as Yes votes)")
+	cmd.Flags().String(FlagExecuteAfter, "", "Set the time in RFC3339 format, after which the proposal would be executed automatically once accepted")
+	cmd.Flags().Uint64(FlagExecuteAfterHeight, 0, "Set the block height, from which the proposal would be executed automatically once accepted")
 
 	return cmd
 }
@@ -538,6 +641,70 @@ Parameters:
 	return cmd
 }
 
+func NewTxCmdDelegateVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate-vote [delegator] [delegate]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Delegate the voting power to another member",
+		Long: `Delegate the voting power to another member.
+The delegate would vote on behalf of the delegator, unless the delegator votes by itself.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			delegator := args[0]
+			if err := cmd.Flags().Set(flags.FlagFrom, delegator); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := foundation.MsgDelegateVote{
+				Delegator: delegator,
+				Delegate:  args[1],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdUndelegateVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "undelegate-vote [delegator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Remove the vote delegation",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			delegator := args[0]
+			if err := cmd.Flags().Set(flags.FlagFrom, delegator); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := foundation.MsgUndelegateVote{
+				Delegator: delegator,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func NewTxCmdExec() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "exec [proposal-id] [signer]",
@@ -622,6 +789,78 @@ Parameters:
 	return cmd
 }
 
+func NewTxCmdUpdateTreasuryOutflowLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-treasury-outflow-limit [authority] [denom] [ratio] [period]",
+		Args:  cobra.RangeArgs(2, 4),
+		Short: "Update the outflow limit of a denom from the treasury",
+		Long: `Update the outflow limit of a denom from the treasury
+
+Parameters:
+    authority: the address of x/gov
+    denom: the denom of the coins to limit
+    ratio: the max ratio of the outflow within the period to the treasury
+    period: the length of the rolling window (e.g. 720h)
+        ratio and period must be omitted if --remove is set
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateGenerateOnly(cmd); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			remove, err := cmd.Flags().GetBool(FlagRemove)
+			if err != nil {
+				return err
+			}
+
+			msg := foundation.MsgUpdateTreasuryOutflowLimit{
+				Authority: args[0],
+				Limit: foundation.TreasuryOutflowLimit{
+					Denom: args[1],
+				},
+				Remove: remove,
+			}
+
+			if remove {
+				if len(args) != 2 {
+					return fmt.Errorf("accepts 2 arg(s) with --%s, received %d", FlagRemove, len(args))
+				}
+			} else {
+				if len(args) != 4 {
+					return fmt.Errorf("accepts 4 arg(s), received %d", len(args))
+				}
+
+				ratio, err := sdk.NewDecFromStr(args[2])
+				if err != nil {
+					return err
+				}
+				msg.Limit.Ratio = ratio
+
+				period, err := time.ParseDuration(args[3])
+				if err != nil {
+					return err
+				}
+				msg.Limit.Period = period
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Bool(FlagRemove, false, "remove the outflow limit of the denom")
+
+	return cmd
+}
+
 func NewTxCmdLeaveFoundation() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "leave-foundation [address]",
@@ -663,10 +902,13 @@ Example of the content of authorization-json:
 
 {
   "@type": "/lbm.foundation.v1.ReceiveFromTreasuryAuthorization",
-  "receive_limit": [
-    "denom": "stake",
-    "amount": "10000"
-  ]
+  "spend_limit": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ],
+  "expiration": "2030-01-01T00:00:00Z"
 }
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
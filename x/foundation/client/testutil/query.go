@@ -164,6 +164,7 @@ func (s *IntegrationTestSuite) TestNewQueryCmdMember() {
 			&foundation.Member{
 				Address:  s.permanentMember.String(),
 				Metadata: "permanent member",
+				Weight:   sdk.OneDec(),
 			},
 		},
 		"wrong number of args": {
@@ -243,6 +244,55 @@ func (s *IntegrationTestSuite) TestNewQueryCmdMembers() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestNewQueryCmdExpiringMembers() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, s.setupHeight),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	testCases := map[string]struct {
+		args     []string
+		valid    bool
+		expected []string
+	}{
+		"valid query": {
+			[]string{},
+			true,
+			[]string{s.delegator.String()},
+		},
+		"wrong number of args": {
+			[]string{
+				"extra",
+			},
+			false,
+			nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewQueryCmdExpiringMembers()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var actual foundation.QueryExpiringMembersResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &actual), out.String())
+			addresses := make([]string, len(actual.Members))
+			for i, member := range actual.Members {
+				addresses[i] = member.Address
+			}
+			s.Require().Equal(tc.expected, addresses)
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestNewQueryCmdProposal() {
 	val := s.network.Validators[0]
 	commonArgs := []string{
@@ -308,12 +358,38 @@ func (s *IntegrationTestSuite) TestNewQueryCmdProposals() {
 			[]string{},
 			true,
 		},
+		"valid query with filters": {
+			[]string{
+				fmt.Sprintf("--%s=submitted", cli.FlagStatus),
+				fmt.Sprintf("--%s=%s", cli.FlagProposer, s.permanentMember),
+				fmt.Sprintf("--%s=2000-01-01T00:00:00Z", cli.FlagSubmitTimeFrom),
+			},
+			true,
+		},
 		"wrong number of args": {
 			[]string{
 				"extra",
 			},
 			false,
 		},
+		"invalid status": {
+			[]string{
+				fmt.Sprintf("--%s=unknown", cli.FlagStatus),
+			},
+			false,
+		},
+		"invalid proposer": {
+			[]string{
+				fmt.Sprintf("--%s=invalid", cli.FlagProposer),
+			},
+			false,
+		},
+		"invalid submit time": {
+			[]string{
+				fmt.Sprintf("--%s=yesterday", cli.FlagSubmitTimeTo),
+			},
+			false,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -334,6 +410,43 @@ func (s *IntegrationTestSuite) TestNewQueryCmdProposals() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestNewQueryCmdArchivedProposals() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, s.setupHeight),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	// the nodes of the test network do not enable the proposal archive
+	testCases := map[string]struct {
+		args []string
+	}{
+		"archive not enabled": {
+			[]string{},
+		},
+		"wrong number of args": {
+			[]string{
+				"extra",
+			},
+		},
+		"invalid status": {
+			[]string{
+				fmt.Sprintf("--%s=unknown", cli.FlagStatus),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewQueryCmdArchivedProposals()
+			_, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			s.Require().Error(err)
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestNewQueryCmdVote() {
 	val := s.network.Validators[0]
 	commonArgs := []string{
@@ -444,6 +557,51 @@ func (s *IntegrationTestSuite) TestNewQueryCmdVotes() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestNewQueryCmdVoteDelegations() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, s.setupHeight),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	testCases := map[string]struct {
+		args     []string
+		valid    bool
+		expected int
+	}{
+		"valid query": {
+			[]string{},
+			true,
+			1,
+		},
+		"wrong number of args": {
+			[]string{
+				"extra",
+			},
+			false,
+			0,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewQueryCmdVoteDelegations()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var actual foundation.QueryVoteDelegationsResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &actual), out.String())
+			s.Require().Len(actual.VoteDelegations, tc.expected)
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestNewQueryCmdTallyResult() {
 	val := s.network.Validators[0]
 	commonArgs := []string{
@@ -494,6 +652,103 @@ func (s *IntegrationTestSuite) TestNewQueryCmdTallyResult() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestNewQueryCmdSimulateProposal() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, s.setupHeight),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid query": {
+			[]string{
+				fmt.Sprintf("%d", s.proposalID),
+			},
+			true,
+		},
+		"wrong number of args": {
+			[]string{
+				fmt.Sprintf("%d", s.proposalID),
+				"extra",
+			},
+			false,
+		},
+		"invalid proposal id": {
+			[]string{
+				fmt.Sprintf("%d", -1),
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewQueryCmdSimulateProposal()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var actual foundation.QuerySimulateProposalResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &actual), out.String())
+			// the proposal has a message without any handler
+			s.Require().NotEmpty(actual.Error)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewQueryCmdMsgTypeDecisionPolicies() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, s.setupHeight),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	testCases := map[string]struct {
+		args     []string
+		valid    bool
+		expected int
+	}{
+		"valid query": {
+			[]string{},
+			true,
+			1,
+		},
+		"wrong number of args": {
+			[]string{
+				"extra",
+			},
+			false,
+			0,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewQueryCmdMsgTypeDecisionPolicies()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var actual foundation.QueryMsgTypeDecisionPoliciesResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &actual), out.String())
+			s.Require().Len(actual.Policies, tc.expected)
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestNewQueryCmdCensorships() {
 	val := s.network.Validators[0]
 	commonArgs := []string{
@@ -603,3 +858,48 @@ func (s *IntegrationTestSuite) TestNewQueryCmdGrants() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestNewQueryCmdTreasuryOutflowLimits() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, s.setupHeight),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	testCases := map[string]struct {
+		args     []string
+		valid    bool
+		expected int
+	}{
+		"valid query": {
+			[]string{},
+			true,
+			0,
+		},
+		"wrong number of args": {
+			[]string{
+				"extra",
+			},
+			false,
+			0,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewQueryCmdTreasuryOutflowLimits()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var actual foundation.QueryTreasuryOutflowLimitsResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &actual), out.String())
+			s.Require().Len(actual.Limits, tc.expected)
+		})
+	}
+}
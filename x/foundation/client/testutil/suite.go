@@ -31,6 +31,7 @@ type IntegrationTestSuite struct {
 	comingMember    sdk.AccAddress
 	leavingMember   sdk.AccAddress
 	permanentMember sdk.AccAddress
+	delegator       sdk.AccAddress
 	stranger        sdk.AccAddress
 
 	proposalID uint64
@@ -67,20 +68,40 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	leavingMemberMnemonic, s.leavingMember = s.createMnemonic("leavingmember")
 	var permanentMemberMnemonic string
 	permanentMemberMnemonic, s.permanentMember = s.createMnemonic("permanentmember")
+	var delegatorMnemonic string
+	delegatorMnemonic, s.delegator = s.createMnemonic("delegator")
 
+	// the membership of the delegator would expire in a year
+	delegatorExpiration := time.Now().Add(365 * 24 * time.Hour)
 	foundationData.Members = []foundation.Member{
 		{
 			Address:  s.leavingMember.String(),
 			Metadata: "leaving member",
+			Weight:   sdk.OneDec(),
 		},
 		{
 			Address:  s.permanentMember.String(),
 			Metadata: "permanent member",
+			Weight:   sdk.OneDec(),
+		},
+		{
+			Address:   s.delegator.String(),
+			Metadata:  "delegator",
+			Weight:    sdk.OneDec(),
+			ExpiresAt: &delegatorExpiration,
+		},
+	}
+
+	// delegate the vote of the delegator
+	foundationData.VoteDelegations = []foundation.VoteDelegation{
+		{
+			Delegator: s.delegator.String(),
+			Delegate:  s.permanentMember.String(),
 		},
 	}
 
 	info := foundation.DefaultFoundation()
-	info.TotalWeight = sdk.NewDecFromInt(sdk.NewInt(int64(len(foundationData.Members))))
+	info.TotalWeight = foundation.Members{Members: foundationData.Members}.TotalWeight()
 	err := info.SetDecisionPolicy(&foundation.ThresholdDecisionPolicy{
 		Threshold: sdk.OneDec(),
 		Windows: &foundation.DecisionPolicyWindows{
@@ -99,6 +120,18 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	}
 	foundationData.Censorships = censorships
 
+	// set a decision policy for the withdrawal
+	msgTypePolicy := foundation.MsgTypeDecisionPolicy{
+		MsgTypeUrl: sdk.MsgTypeURL((*foundation.MsgWithdrawFromTreasury)(nil)),
+	}.WithDecisionPolicy(&foundation.ThresholdDecisionPolicy{
+		Threshold: sdk.OneDec(),
+		Windows: &foundation.DecisionPolicyWindows{
+			VotingPeriod: 7 * 24 * time.Hour,
+		},
+	})
+	s.Require().NotNil(msgTypePolicy)
+	foundationData.MsgTypeDecisionPolicies = []foundation.MsgTypeDecisionPolicy{*msgTypePolicy}
+
 	treasuryReceivers := []sdk.AccAddress{s.stranger, s.leavingMember}
 	for _, receiver := range treasuryReceivers {
 		ga := foundation.GrantAuthorization{
@@ -126,6 +159,7 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	s.createAccount("comingmember", comingMemberMnemonic)
 	s.createAccount("leavingmember", leavingMemberMnemonic)
 	s.createAccount("permanentmember", permanentMemberMnemonic)
+	s.createAccount("delegator", delegatorMnemonic)
 
 	s.proposalID = s.submitProposal(testdata.NewTestMsg(s.authority), false)
 	s.vote(s.proposalID, []sdk.AccAddress{s.leavingMember, s.permanentMember})
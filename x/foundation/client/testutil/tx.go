@@ -115,7 +115,7 @@ func (s *IntegrationTestSuite) TestNewTxCmdUpdateMembers() {
 		fmt.Sprintf("--%s", flags.FlagGenerateOnly),
 	}
 
-	updates := `[{"address":"%s"}]`
+	updates := `[{"address":"%s","weight":"1"}]`
 	testCases := map[string]struct {
 		args  []string
 		valid bool
@@ -215,6 +215,75 @@ func (s *IntegrationTestSuite) TestNewTxCmdUpdateDecisionPolicy() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestNewTxCmdUpdateMsgTypeDecisionPolicy() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s", flags.FlagGenerateOnly),
+	}
+
+	doMarshal := func(policy foundation.DecisionPolicy) string {
+		bz, err := val.ClientCtx.Codec.MarshalInterfaceJSON(policy)
+		s.Require().NoError(err)
+		return string(bz)
+	}
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				s.authority.String(),
+				sdk.MsgTypeURL((*foundation.MsgWithdrawFromTreasury)(nil)),
+				doMarshal(&foundation.ThresholdDecisionPolicy{
+					Threshold: sdk.NewDec(10),
+					Windows: &foundation.DecisionPolicyWindows{
+						VotingPeriod: time.Hour,
+					},
+				}),
+			},
+			true,
+		},
+		"valid removal": {
+			[]string{
+				s.authority.String(),
+				sdk.MsgTypeURL((*foundation.MsgWithdrawFromTreasury)(nil)),
+			},
+			true,
+		},
+		"wrong number of args": {
+			[]string{
+				s.authority.String(),
+				sdk.MsgTypeURL((*foundation.MsgWithdrawFromTreasury)(nil)),
+				doMarshal(&foundation.ThresholdDecisionPolicy{
+					Threshold: sdk.NewDec(10),
+					Windows: &foundation.DecisionPolicyWindows{
+						VotingPeriod: time.Hour,
+					},
+				}),
+				"extra",
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdUpdateMsgTypeDecisionPolicy()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res txtypes.Tx
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out)
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestNewTxCmdSubmitProposal() {
 	val := s.network.Validators[0]
 	commonArgs := []string{
@@ -224,6 +293,9 @@ func (s *IntegrationTestSuite) TestNewTxCmdSubmitProposal() {
 		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10)))),
 	}
 
+	height, err := s.network.LatestHeight()
+	s.Require().NoError(err)
+
 	proposers := `["%s"]`
 	testCases := map[string]struct {
 		args  []string
@@ -237,6 +309,24 @@ func (s *IntegrationTestSuite) TestNewTxCmdSubmitProposal() {
 			},
 			true,
 		},
+		"valid timelocked transaction": {
+			[]string{
+				"test proposal",
+				fmt.Sprintf(proposers, s.permanentMember),
+				s.msgToString(testdata.NewTestMsg()),
+				fmt.Sprintf("--%s=%s", cli.FlagExecuteAfter, time.Now().Add(time.Hour).UTC().Format(time.RFC3339)),
+			},
+			true,
+		},
+		"valid transaction timelocked by height": {
+			[]string{
+				"test proposal",
+				fmt.Sprintf(proposers, s.permanentMember),
+				s.msgToString(testdata.NewTestMsg()),
+				fmt.Sprintf("--%s=%d", cli.FlagExecuteAfterHeight, height+100),
+			},
+			true,
+		},
 		"wrong number of args": {
 			[]string{
 				"test proposal",
@@ -246,6 +336,25 @@ func (s *IntegrationTestSuite) TestNewTxCmdSubmitProposal() {
 			},
 			false,
 		},
+		"invalid execute after": {
+			[]string{
+				"test proposal",
+				fmt.Sprintf(proposers, s.permanentMember),
+				s.msgToString(testdata.NewTestMsg()),
+				fmt.Sprintf("--%s=%s", cli.FlagExecuteAfter, "tomorrow"),
+			},
+			false,
+		},
+		"both execute after and execute after height": {
+			[]string{
+				"test proposal",
+				fmt.Sprintf(proposers, s.permanentMember),
+				s.msgToString(testdata.NewTestMsg()),
+				fmt.Sprintf("--%s=%s", cli.FlagExecuteAfter, time.Now().Add(time.Hour).UTC().Format(time.RFC3339)),
+				fmt.Sprintf("--%s=%d", cli.FlagExecuteAfterHeight, height+100),
+			},
+			false,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -371,6 +480,109 @@ func (s *IntegrationTestSuite) TestNewTxCmdVote() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestNewTxCmdDelegateVote() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, s.leavingMember),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10)))),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				s.leavingMember.String(),
+				s.permanentMember.String(),
+			},
+			true,
+		},
+		"wrong number of args": {
+			[]string{
+				s.leavingMember.String(),
+				s.permanentMember.String(),
+				"extra",
+			},
+			false,
+		},
+		"delegate to oneself": {
+			[]string{
+				s.leavingMember.String(),
+				s.leavingMember.String(),
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdDelegateVote()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res sdk.TxResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out)
+			s.Require().Zero(res.Code, out)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewTxCmdUndelegateVote() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, s.delegator),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10)))),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				s.delegator.String(),
+			},
+			true,
+		},
+		"wrong number of args": {
+			[]string{
+				s.delegator.String(),
+				"extra",
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdUndelegateVote()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res sdk.TxResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out)
+			s.Require().Zero(res.Code, out)
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestNewTxCmdExec() {
 	val := s.network.Validators[0]
 	commonArgs := []string{
@@ -433,7 +645,7 @@ func (s *IntegrationTestSuite) TestNewTxCmdUpdateCensorship() {
 		"valid transaction": {
 			[]string{
 				s.authority.String(),
-				foundation.ReceiveFromTreasuryAuthorization{}.MsgTypeURL(),
+				sdk.MsgTypeURL((*foundation.MsgWithdrawFromTreasury)(nil)),
 				foundation.CensorshipAuthorityGovernance.String(),
 			},
 			true,
@@ -441,7 +653,7 @@ func (s *IntegrationTestSuite) TestNewTxCmdUpdateCensorship() {
 		"valid abbreviation": {
 			[]string{
 				s.authority.String(),
-				foundation.ReceiveFromTreasuryAuthorization{}.MsgTypeURL(),
+				sdk.MsgTypeURL((*foundation.MsgWithdrawFromTreasury)(nil)),
 				"governance",
 			},
 			true,
@@ -449,7 +661,7 @@ func (s *IntegrationTestSuite) TestNewTxCmdUpdateCensorship() {
 		"wrong number of args": {
 			[]string{
 				s.authority.String(),
-				foundation.ReceiveFromTreasuryAuthorization{}.MsgTypeURL(),
+				sdk.MsgTypeURL((*foundation.MsgWithdrawFromTreasury)(nil)),
 				foundation.CensorshipAuthorityGovernance.String(),
 				"extra",
 			},
@@ -458,7 +670,7 @@ func (s *IntegrationTestSuite) TestNewTxCmdUpdateCensorship() {
 		"invalid new authority": {
 			[]string{
 				s.authority.String(),
-				foundation.ReceiveFromTreasuryAuthorization{}.MsgTypeURL(),
+				sdk.MsgTypeURL((*foundation.MsgWithdrawFromTreasury)(nil)),
 				"invalid-new-authority",
 			},
 			false,
@@ -596,7 +808,7 @@ func (s *IntegrationTestSuite) TestNewTxCmdRevoke() {
 			[]string{
 				s.authority.String(),
 				s.leavingMember.String(),
-				foundation.ReceiveFromTreasuryAuthorization{}.MsgTypeURL(),
+				sdk.MsgTypeURL((*foundation.MsgWithdrawFromTreasury)(nil)),
 			},
 			true,
 		},
@@ -604,7 +816,7 @@ func (s *IntegrationTestSuite) TestNewTxCmdRevoke() {
 			[]string{
 				s.authority.String(),
 				s.leavingMember.String(),
-				foundation.ReceiveFromTreasuryAuthorization{}.MsgTypeURL(),
+				sdk.MsgTypeURL((*foundation.MsgWithdrawFromTreasury)(nil)),
 				"extra",
 			},
 			false,
@@ -628,3 +840,86 @@ func (s *IntegrationTestSuite) TestNewTxCmdRevoke() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestNewTxCmdUpdateTreasuryOutflowLimit() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s", flags.FlagGenerateOnly),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				s.authority.String(),
+				s.cfg.BondDenom,
+				"0.05",
+				"720h",
+			},
+			true,
+		},
+		"valid removal": {
+			[]string{
+				s.authority.String(),
+				s.cfg.BondDenom,
+				fmt.Sprintf("--%s", cli.FlagRemove),
+			},
+			true,
+		},
+		"ratio and period with removal": {
+			[]string{
+				s.authority.String(),
+				s.cfg.BondDenom,
+				"0.05",
+				"720h",
+				fmt.Sprintf("--%s", cli.FlagRemove),
+			},
+			false,
+		},
+		"no period": {
+			[]string{
+				s.authority.String(),
+				s.cfg.BondDenom,
+				"0.05",
+			},
+			false,
+		},
+		"invalid ratio": {
+			[]string{
+				s.authority.String(),
+				s.cfg.BondDenom,
+				"five",
+				"720h",
+			},
+			false,
+		},
+		"invalid period": {
+			[]string{
+				s.authority.String(),
+				s.cfg.BondDenom,
+				"0.05",
+				"month",
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdUpdateTreasuryOutflowLimit()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res txtypes.Tx
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out)
+		})
+	}
+}
@@ -17,6 +17,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgFundTreasury{}, "lbm-sdk/MsgFundTreasury")
 	legacy.RegisterAminoMsg(cdc, &MsgSubmitProposal{}, "lbm-sdk/MsgSubmitProposal")
 	legacy.RegisterAminoMsg(cdc, &MsgVote{}, "lbm-sdk/MsgVote")
+	legacy.RegisterAminoMsg(cdc, &MsgDelegateVote{}, "lbm-sdk/MsgDelegateVote")
+	legacy.RegisterAminoMsg(cdc, &MsgUndelegateVote{}, "lbm-sdk/MsgUndelegateVote")
 	legacy.RegisterAminoMsg(cdc, &MsgExec{}, "lbm-sdk/MsgExec")
 	legacy.RegisterAminoMsg(cdc, &MsgLeaveFoundation{}, "lbm-sdk/MsgLeaveFoundation")
 	legacy.RegisterAminoMsg(cdc, &MsgWithdrawProposal{}, "lbm-sdk/MsgWithdrawProposal")
@@ -26,15 +28,21 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgWithdrawFromTreasury{}, "lbm-sdk/MsgWithdrawFromTreasury")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateMembers{}, "lbm-sdk/MsgUpdateMembers")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateDecisionPolicy{}, "lbm-sdk/MsgUpdateDecisionPolicy")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateMsgTypeDecisionPolicy{}, "lbm-sdk/MsgUpdateMsgTypeDecisionPolicy")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateCensorship{}, "lbm-sdk/MsgUpdateCensorship")
 	legacy.RegisterAminoMsg(cdc, &MsgGrant{}, "lbm-sdk/MsgGrant")
 	legacy.RegisterAminoMsg(cdc, &MsgRevoke{}, "lbm-sdk/MsgRevoke")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateTreasuryOutflowLimit{}, "lbm-sdk/MsgUpdateTreasuryOutflowLimit")
+
+	// proposal from the group policy which the foundation has been outsourced to
+	legacy.RegisterAminoMsg(cdc, &MsgExecAsAuthority{}, "lbm-sdk/MsgExecAsAuthority")
 
 	cdc.RegisterInterface((*Authorization)(nil), nil)
 	cdc.RegisterInterface((*DecisionPolicy)(nil), nil)
 	cdc.RegisterConcrete(&ThresholdDecisionPolicy{}, "lbm-sdk/ThresholdDecisionPolicy", nil)
 	cdc.RegisterConcrete(&PercentageDecisionPolicy{}, "lbm-sdk/PercentageDecisionPolicy", nil)
 	cdc.RegisterConcrete(&ReceiveFromTreasuryAuthorization{}, "lbm-sdk/ReceiveFromTreasuryAuthorization", nil)
+	cdc.RegisterConcrete(&PeriodicReceiveFromTreasuryAuthorization{}, "lbm-sdk/PeriodicReceiveFromTreasuryAuthorization", nil)
 
 	cdc.RegisterConcrete(&FoundationExecProposal{}, "lbm-sdk/FoundationExecProposal", nil)
 }
@@ -46,14 +54,19 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgWithdrawFromTreasury{},
 		&MsgUpdateMembers{},
 		&MsgUpdateDecisionPolicy{},
+		&MsgUpdateMsgTypeDecisionPolicy{},
 		&MsgSubmitProposal{},
 		&MsgWithdrawProposal{},
 		&MsgVote{},
+		&MsgDelegateVote{},
+		&MsgUndelegateVote{},
 		&MsgExec{},
 		&MsgLeaveFoundation{},
 		&MsgUpdateCensorship{},
 		&MsgGrant{},
 		&MsgRevoke{},
+		&MsgExecAsAuthority{},
+		&MsgUpdateTreasuryOutflowLimit{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -69,6 +82,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*Authorization)(nil),
 		&ReceiveFromTreasuryAuthorization{},
+		&PeriodicReceiveFromTreasuryAuthorization{},
 	)
 
 	registry.RegisterImplementations(
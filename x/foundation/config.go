@@ -2,6 +2,8 @@ package foundation
 
 import (
 	"time"
+
+	dbm "github.com/tendermint/tm-db"
 )
 
 // Config is a config struct used for intialising the group module to avoid using globals.
@@ -10,11 +12,22 @@ type Config struct {
 	MaxExecutionPeriod time.Duration
 	// MaxMetadataLen defines the max length of the metadata bytes field for various entities within the foundation module. Defaults to 255 if not explicitly set.
 	MaxMetadataLen uint64
+	// ProposalArchive defines the node-local database which keeps the finished proposals after they are pruned from the state. The archive is disabled if it is nil.
+	ProposalArchive dbm.DB
+	// MaxSimulationGas defines the gas limit of a proposal simulation by Query/SimulateProposal.
+	MaxSimulationGas uint64
+	// MaxTimelockedExecutionGas defines the gas limit of the automatic execution of a timelocked proposal at the end of a block.
+	MaxTimelockedExecutionGas uint64
+	// MinBlockTime defines the min duration of a block, which bounds the execute_after_height of a proposal by its expiration. The bound is disabled if it is zero.
+	MinBlockTime time.Duration
 }
 
 func DefaultConfig() Config {
 	return Config{
-		MaxExecutionPeriod: 2 * 7 * 24 * time.Hour, // two weeks
-		MaxMetadataLen:     255,
+		MaxExecutionPeriod:        2 * 7 * 24 * time.Hour, // two weeks
+		MaxMetadataLen:            255,
+		MaxSimulationGas:          10_000_000,
+		MaxTimelockedExecutionGas: 10_000_000,
+		MinBlockTime:              time.Second,
 	}
 }
@@ -216,6 +216,47 @@ func (m *EventUpdateDecisionPolicy) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EventUpdateDecisionPolicy proto.InternalMessageInfo
 
+// EventUpdateMsgTypeDecisionPolicy is an event emitted when the decision policy of a message type have been updated.
+type EventUpdateMsgTypeDecisionPolicy struct {
+	// msg_type_url is the type url of the message.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// decision_policy is the updated decision policy of the message type, which is empty on removal.
+	DecisionPolicy *types1.Any `protobuf:"bytes,2,opt,name=decision_policy,json=decisionPolicy,proto3" json:"decision_policy,omitempty"`
+}
+
+func (m *EventUpdateMsgTypeDecisionPolicy) Reset()         { *m = EventUpdateMsgTypeDecisionPolicy{} }
+func (m *EventUpdateMsgTypeDecisionPolicy) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateMsgTypeDecisionPolicy) ProtoMessage()    {}
+func (*EventUpdateMsgTypeDecisionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b66c645bbb34fbc, []int{4}
+}
+func (m *EventUpdateMsgTypeDecisionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateMsgTypeDecisionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateMsgTypeDecisionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUpdateMsgTypeDecisionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateMsgTypeDecisionPolicy.Merge(m, src)
+}
+func (m *EventUpdateMsgTypeDecisionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateMsgTypeDecisionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateMsgTypeDecisionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUpdateMsgTypeDecisionPolicy proto.InternalMessageInfo
+
 // EventSubmitProposal is an event emitted when a proposal is created.
 type EventSubmitProposal struct {
 	// proposal is the unique ID of the proposal.
@@ -226,7 +267,7 @@ func (m *EventSubmitProposal) Reset()         { *m = EventSubmitProposal{} }
 func (m *EventSubmitProposal) String() string { return proto.CompactTextString(m) }
 func (*EventSubmitProposal) ProtoMessage()    {}
 func (*EventSubmitProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b66c645bbb34fbc, []int{4}
+	return fileDescriptor_2b66c645bbb34fbc, []int{5}
 }
 func (m *EventSubmitProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -272,7 +313,7 @@ func (m *EventWithdrawProposal) Reset()         { *m = EventWithdrawProposal{} }
 func (m *EventWithdrawProposal) String() string { return proto.CompactTextString(m) }
 func (*EventWithdrawProposal) ProtoMessage()    {}
 func (*EventWithdrawProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b66c645bbb34fbc, []int{5}
+	return fileDescriptor_2b66c645bbb34fbc, []int{6}
 }
 func (m *EventWithdrawProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -317,7 +358,7 @@ func (m *EventVote) Reset()         { *m = EventVote{} }
 func (m *EventVote) String() string { return proto.CompactTextString(m) }
 func (*EventVote) ProtoMessage()    {}
 func (*EventVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b66c645bbb34fbc, []int{6}
+	return fileDescriptor_2b66c645bbb34fbc, []int{7}
 }
 func (m *EventVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -353,6 +394,107 @@ func (m *EventVote) GetVote() Vote {
 	return Vote{}
 }
 
+// EventDelegateVote is an event emitted when a member delegates its voting power.
+type EventDelegateVote struct {
+	// delegator is the account address of the member delegating its voting power.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// delegate is the account address of the member voting on behalf of the delegator.
+	Delegate string `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate,omitempty"`
+}
+
+func (m *EventDelegateVote) Reset()         { *m = EventDelegateVote{} }
+func (m *EventDelegateVote) String() string { return proto.CompactTextString(m) }
+func (*EventDelegateVote) ProtoMessage()    {}
+func (*EventDelegateVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b66c645bbb34fbc, []int{8}
+}
+func (m *EventDelegateVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDelegateVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDelegateVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDelegateVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDelegateVote.Merge(m, src)
+}
+func (m *EventDelegateVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDelegateVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDelegateVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDelegateVote proto.InternalMessageInfo
+
+func (m *EventDelegateVote) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *EventDelegateVote) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
+// EventUndelegateVote is an event emitted when a vote delegation is removed.
+type EventUndelegateVote struct {
+	// delegator is the account address of the member whose delegation has been removed.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+}
+
+func (m *EventUndelegateVote) Reset()         { *m = EventUndelegateVote{} }
+func (m *EventUndelegateVote) String() string { return proto.CompactTextString(m) }
+func (*EventUndelegateVote) ProtoMessage()    {}
+func (*EventUndelegateVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b66c645bbb34fbc, []int{9}
+}
+func (m *EventUndelegateVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUndelegateVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUndelegateVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUndelegateVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUndelegateVote.Merge(m, src)
+}
+func (m *EventUndelegateVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUndelegateVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUndelegateVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUndelegateVote proto.InternalMessageInfo
+
+func (m *EventUndelegateVote) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
 // EventExec is an event emitted when a proposal is executed.
 type EventExec struct {
 	// proposal_id is the unique ID of the proposal.
@@ -367,7 +509,7 @@ func (m *EventExec) Reset()         { *m = EventExec{} }
 func (m *EventExec) String() string { return proto.CompactTextString(m) }
 func (*EventExec) ProtoMessage()    {}
 func (*EventExec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b66c645bbb34fbc, []int{7}
+	return fileDescriptor_2b66c645bbb34fbc, []int{10}
 }
 func (m *EventExec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -427,7 +569,7 @@ func (m *EventLeaveFoundation) Reset()         { *m = EventLeaveFoundation{} }
 func (m *EventLeaveFoundation) String() string { return proto.CompactTextString(m) }
 func (*EventLeaveFoundation) ProtoMessage()    {}
 func (*EventLeaveFoundation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b66c645bbb34fbc, []int{8}
+	return fileDescriptor_2b66c645bbb34fbc, []int{11}
 }
 func (m *EventLeaveFoundation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -463,6 +605,61 @@ func (m *EventLeaveFoundation) GetAddress() string {
 	return ""
 }
 
+// EventExpireMember is an event emitted when a membership expires.
+type EventExpireMember struct {
+	// address is the account address of the expired member.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// error is the reason why the member could not be removed, which is empty on success.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *EventExpireMember) Reset()         { *m = EventExpireMember{} }
+func (m *EventExpireMember) String() string { return proto.CompactTextString(m) }
+func (*EventExpireMember) ProtoMessage()    {}
+func (*EventExpireMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b66c645bbb34fbc, []int{12}
+}
+func (m *EventExpireMember) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventExpireMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventExpireMember.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventExpireMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventExpireMember.Merge(m, src)
+}
+func (m *EventExpireMember) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventExpireMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventExpireMember.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventExpireMember proto.InternalMessageInfo
+
+func (m *EventExpireMember) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventExpireMember) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 // EventUpdateCensorship is emitted when a censorship information updated.
 type EventUpdateCensorship struct {
 	Censorship Censorship `protobuf:"bytes,1,opt,name=censorship,proto3" json:"censorship"`
@@ -472,7 +669,7 @@ func (m *EventUpdateCensorship) Reset()         { *m = EventUpdateCensorship{} }
 func (m *EventUpdateCensorship) String() string { return proto.CompactTextString(m) }
 func (*EventUpdateCensorship) ProtoMessage()    {}
 func (*EventUpdateCensorship) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b66c645bbb34fbc, []int{9}
+	return fileDescriptor_2b66c645bbb34fbc, []int{13}
 }
 func (m *EventUpdateCensorship) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -520,7 +717,7 @@ func (m *EventGrant) Reset()         { *m = EventGrant{} }
 func (m *EventGrant) String() string { return proto.CompactTextString(m) }
 func (*EventGrant) ProtoMessage()    {}
 func (*EventGrant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b66c645bbb34fbc, []int{10}
+	return fileDescriptor_2b66c645bbb34fbc, []int{14}
 }
 func (m *EventGrant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -575,7 +772,7 @@ func (m *EventRevoke) Reset()         { *m = EventRevoke{} }
 func (m *EventRevoke) String() string { return proto.CompactTextString(m) }
 func (*EventRevoke) ProtoMessage()    {}
 func (*EventRevoke) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b66c645bbb34fbc, []int{11}
+	return fileDescriptor_2b66c645bbb34fbc, []int{15}
 }
 func (m *EventRevoke) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -618,70 +815,246 @@ func (m *EventRevoke) GetMsgTypeUrl() string {
 	return ""
 }
 
+// EventMigrateProposal is emitted when a proposal is migrated into x/group
+// on outsourcing the proposal feature.
+type EventMigrateProposal struct {
+	// proposal_id is the unique ID of the foundation proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// group_proposal_id is the unique ID of the migrated proposal in x/group.
+	GroupProposalId uint64 `protobuf:"varint,2,opt,name=group_proposal_id,json=groupProposalId,proto3" json:"group_proposal_id,omitempty"`
+}
+
+func (m *EventMigrateProposal) Reset()         { *m = EventMigrateProposal{} }
+func (m *EventMigrateProposal) String() string { return proto.CompactTextString(m) }
+func (*EventMigrateProposal) ProtoMessage()    {}
+func (*EventMigrateProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b66c645bbb34fbc, []int{16}
+}
+func (m *EventMigrateProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMigrateProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMigrateProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMigrateProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMigrateProposal.Merge(m, src)
+}
+func (m *EventMigrateProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMigrateProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMigrateProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMigrateProposal proto.InternalMessageInfo
+
+func (m *EventMigrateProposal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *EventMigrateProposal) GetGroupProposalId() uint64 {
+	if m != nil {
+		return m.GroupProposalId
+	}
+	return 0
+}
+
+// EventExecAsAuthority is emitted on Msg/ExecAsAuthority.
+type EventExecAsAuthority struct {
+	// group_policy_address is the address of the group policy account.
+	GroupPolicyAddress string `protobuf:"bytes,1,opt,name=group_policy_address,json=groupPolicyAddress,proto3" json:"group_policy_address,omitempty"`
+}
+
+func (m *EventExecAsAuthority) Reset()         { *m = EventExecAsAuthority{} }
+func (m *EventExecAsAuthority) String() string { return proto.CompactTextString(m) }
+func (*EventExecAsAuthority) ProtoMessage()    {}
+func (*EventExecAsAuthority) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b66c645bbb34fbc, []int{17}
+}
+func (m *EventExecAsAuthority) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventExecAsAuthority) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventExecAsAuthority.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventExecAsAuthority) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventExecAsAuthority.Merge(m, src)
+}
+func (m *EventExecAsAuthority) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventExecAsAuthority) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventExecAsAuthority.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventExecAsAuthority proto.InternalMessageInfo
+
+func (m *EventExecAsAuthority) GetGroupPolicyAddress() string {
+	if m != nil {
+		return m.GroupPolicyAddress
+	}
+	return ""
+}
+
+// EventUpdateTreasuryOutflowLimit is emitted on Msg/UpdateTreasuryOutflowLimit.
+type EventUpdateTreasuryOutflowLimit struct {
+	// limit is the new outflow limit.
+	Limit TreasuryOutflowLimit `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit"`
+	// remove is true if the limit has been removed.
+	Remove bool `protobuf:"varint,2,opt,name=remove,proto3" json:"remove,omitempty"`
+}
+
+func (m *EventUpdateTreasuryOutflowLimit) Reset()         { *m = EventUpdateTreasuryOutflowLimit{} }
+func (m *EventUpdateTreasuryOutflowLimit) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateTreasuryOutflowLimit) ProtoMessage()    {}
+func (*EventUpdateTreasuryOutflowLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b66c645bbb34fbc, []int{18}
+}
+func (m *EventUpdateTreasuryOutflowLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateTreasuryOutflowLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateTreasuryOutflowLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUpdateTreasuryOutflowLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateTreasuryOutflowLimit.Merge(m, src)
+}
+func (m *EventUpdateTreasuryOutflowLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateTreasuryOutflowLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateTreasuryOutflowLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUpdateTreasuryOutflowLimit proto.InternalMessageInfo
+
+func (m *EventUpdateTreasuryOutflowLimit) GetLimit() TreasuryOutflowLimit {
+	if m != nil {
+		return m.Limit
+	}
+	return TreasuryOutflowLimit{}
+}
+
+func (m *EventUpdateTreasuryOutflowLimit) GetRemove() bool {
+	if m != nil {
+		return m.Remove
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*EventFundTreasury)(nil), "lbm.foundation.v1.EventFundTreasury")
 	proto.RegisterType((*EventWithdrawFromTreasury)(nil), "lbm.foundation.v1.EventWithdrawFromTreasury")
 	proto.RegisterType((*EventUpdateMembers)(nil), "lbm.foundation.v1.EventUpdateMembers")
 	proto.RegisterType((*EventUpdateDecisionPolicy)(nil), "lbm.foundation.v1.EventUpdateDecisionPolicy")
+	proto.RegisterType((*EventUpdateMsgTypeDecisionPolicy)(nil), "lbm.foundation.v1.EventUpdateMsgTypeDecisionPolicy")
 	proto.RegisterType((*EventSubmitProposal)(nil), "lbm.foundation.v1.EventSubmitProposal")
 	proto.RegisterType((*EventWithdrawProposal)(nil), "lbm.foundation.v1.EventWithdrawProposal")
 	proto.RegisterType((*EventVote)(nil), "lbm.foundation.v1.EventVote")
+	proto.RegisterType((*EventDelegateVote)(nil), "lbm.foundation.v1.EventDelegateVote")
+	proto.RegisterType((*EventUndelegateVote)(nil), "lbm.foundation.v1.EventUndelegateVote")
 	proto.RegisterType((*EventExec)(nil), "lbm.foundation.v1.EventExec")
 	proto.RegisterType((*EventLeaveFoundation)(nil), "lbm.foundation.v1.EventLeaveFoundation")
+	proto.RegisterType((*EventExpireMember)(nil), "lbm.foundation.v1.EventExpireMember")
 	proto.RegisterType((*EventUpdateCensorship)(nil), "lbm.foundation.v1.EventUpdateCensorship")
 	proto.RegisterType((*EventGrant)(nil), "lbm.foundation.v1.EventGrant")
 	proto.RegisterType((*EventRevoke)(nil), "lbm.foundation.v1.EventRevoke")
+	proto.RegisterType((*EventMigrateProposal)(nil), "lbm.foundation.v1.EventMigrateProposal")
+	proto.RegisterType((*EventExecAsAuthority)(nil), "lbm.foundation.v1.EventExecAsAuthority")
+	proto.RegisterType((*EventUpdateTreasuryOutflowLimit)(nil), "lbm.foundation.v1.EventUpdateTreasuryOutflowLimit")
 }
 
 func init() { proto.RegisterFile("lbm/foundation/v1/event.proto", fileDescriptor_2b66c645bbb34fbc) }
 
 var fileDescriptor_2b66c645bbb34fbc = []byte{
-	// 720 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0xc1, 0x4f, 0x13, 0x4f,
-	0x14, 0xc7, 0xbb, 0xd0, 0xf0, 0xfb, 0x31, 0x95, 0x1a, 0x56, 0x8c, 0x05, 0x43, 0xdb, 0xec, 0x09,
-	0x13, 0xbb, 0x6b, 0xd1, 0x18, 0x43, 0xa2, 0x09, 0x45, 0x4a, 0x48, 0x24, 0xc1, 0x15, 0x34, 0x31,
-	0x26, 0xcd, 0xec, 0xee, 0x74, 0x3b, 0x61, 0x77, 0xdf, 0x3a, 0x33, 0xbb, 0x52, 0xae, 0x5e, 0x3c,
-	0x72, 0xf0, 0x6c, 0x3c, 0x7b, 0xe6, 0x8f, 0x20, 0x9c, 0x38, 0x7a, 0x52, 0x03, 0xff, 0x88, 0xd9,
-	0xd9, 0x69, 0xa1, 0x01, 0xc1, 0x93, 0xb7, 0xf7, 0x66, 0xde, 0xf7, 0xfb, 0x3e, 0xf3, 0x66, 0x06,
-	0xcd, 0x07, 0x4e, 0x68, 0x75, 0x21, 0x89, 0x3c, 0x2c, 0x28, 0x44, 0x56, 0xda, 0xb4, 0x48, 0x4a,
-	0x22, 0x61, 0xc6, 0x0c, 0x04, 0xe8, 0xd3, 0x81, 0x13, 0x9a, 0x67, 0xdb, 0x66, 0xda, 0x9c, 0x9b,
-	0xf1, 0xc1, 0x07, 0xb9, 0x6b, 0x65, 0x51, 0x5e, 0x38, 0x37, 0xeb, 0x03, 0xf8, 0x01, 0xb1, 0x64,
-	0xe6, 0x24, 0x5d, 0x0b, 0x47, 0xfd, 0xc1, 0x96, 0x0b, 0x3c, 0x04, 0xde, 0xc9, 0x35, 0x79, 0xa2,
-	0xb6, 0xaa, 0x79, 0x66, 0x39, 0x98, 0x13, 0x2b, 0x6d, 0x3a, 0x44, 0xe0, 0xa6, 0xe5, 0x02, 0x8d,
-	0xd4, 0xbe, 0x71, 0x91, 0xee, 0x1c, 0x8c, 0xac, 0x31, 0xf6, 0x35, 0x34, 0xbd, 0x9a, 0x21, 0xb7,
-	0x93, 0xc8, 0xdb, 0x62, 0x04, 0xf3, 0x84, 0xf5, 0x75, 0x1d, 0x15, 0xbb, 0x0c, 0xc2, 0x8a, 0x56,
-	0xd7, 0x16, 0x26, 0x6d, 0x19, 0xeb, 0x3e, 0x9a, 0xc0, 0x21, 0x24, 0x91, 0xa8, 0x8c, 0xd5, 0xc7,
-	0x17, 0x4a, 0x8b, 0xb3, 0xa6, 0x82, 0xc9, 0xda, 0x9b, 0xaa, 0xbd, 0xb9, 0x02, 0x34, 0x6a, 0x3d,
-	0x3a, 0xfc, 0x51, 0x2b, 0x7c, 0xfb, 0x59, 0xbb, 0xef, 0x53, 0xd1, 0x4b, 0x1c, 0xd3, 0x85, 0xd0,
-	0x6a, 0xd3, 0x88, 0xbb, 0x3d, 0x8a, 0xad, 0xae, 0x0a, 0x1a, 0xdc, 0xdb, 0xb1, 0x44, 0x3f, 0x26,
-	0x5c, 0x8a, 0xb8, 0xad, 0xec, 0x8d, 0xcf, 0x1a, 0x9a, 0x95, 0x48, 0x6f, 0xa8, 0xe8, 0x79, 0x0c,
-	0x7f, 0x68, 0x33, 0x08, 0x87, 0x68, 0x65, 0x34, 0x26, 0x40, 0x81, 0x8d, 0x09, 0xf8, 0x77, 0x58,
-	0x2e, 0xd2, 0x25, 0xd5, 0x76, 0xec, 0x61, 0x41, 0x36, 0x48, 0xe8, 0x10, 0xc6, 0xf5, 0x0d, 0x54,
-	0x0e, 0x65, 0xd8, 0x49, 0xe4, 0x3a, 0xaf, 0x68, 0x12, 0xa3, 0x6e, 0x5e, 0xb8, 0x7b, 0x33, 0xd7,
-	0xd8, 0xe4, 0x7d, 0x42, 0xb8, 0x68, 0x15, 0x33, 0x1a, 0x7b, 0x2a, 0x57, 0xe7, 0xa6, 0xdc, 0x10,
-	0xea, 0xe8, 0x79, 0xfe, 0x9c, 0xb8, 0x94, 0x53, 0x88, 0x36, 0x21, 0xa0, 0x6e, 0x5f, 0x7f, 0x89,
-	0x6e, 0x7a, 0x6a, 0xa5, 0x13, 0xcb, 0x25, 0x39, 0x87, 0xd2, 0xe2, 0x8c, 0x99, 0xbf, 0x1f, 0x73,
-	0xf0, 0x7e, 0xcc, 0xe5, 0xa8, 0xdf, 0xd2, 0x8f, 0x0e, 0x1a, 0xe5, 0x51, 0x0b, 0xbb, 0xec, 0x8d,
-	0xe4, 0x4b, 0xc5, 0x4f, 0x5f, 0x6b, 0x05, 0x63, 0x0b, 0xdd, 0x92, 0x5d, 0x5f, 0x25, 0x4e, 0x48,
-	0xc5, 0x26, 0x83, 0x18, 0x38, 0x0e, 0xf4, 0xa7, 0xe8, 0xff, 0x58, 0xc5, 0xaa, 0xd1, 0xdd, 0x4b,
-	0x4e, 0x35, 0x28, 0x57, 0x07, 0x1a, 0x4a, 0x8c, 0x27, 0xe8, 0xf6, 0xc8, 0x35, 0x0e, 0x7d, 0x6b,
-	0xa8, 0x34, 0x28, 0xea, 0x50, 0x4f, 0x5a, 0x17, 0x6d, 0x34, 0x58, 0x5a, 0xf7, 0x8c, 0x67, 0x68,
-	0x52, 0x2a, 0x5f, 0x83, 0x20, 0x7a, 0x13, 0x15, 0x53, 0x10, 0x44, 0x11, 0xdc, 0xb9, 0x84, 0x20,
-	0x2b, 0x53, 0xdd, 0x65, 0xa9, 0xf1, 0x51, 0x53, 0x06, 0xab, 0xbb, 0xc4, 0xbd, 0xb6, 0x9d, 0xbe,
-	0x8c, 0x26, 0x18, 0xe1, 0x49, 0x90, 0x3d, 0x21, 0x6d, 0xa1, 0xbc, 0x78, 0xef, 0x8a, 0x53, 0x66,
-	0x8e, 0x89, 0x00, 0x66, 0x4b, 0x81, 0xad, 0x84, 0xd9, 0x87, 0x09, 0xc0, 0xe7, 0x95, 0xf1, 0xfc,
-	0xc3, 0x64, 0xb1, 0xf1, 0x00, 0xcd, 0x48, 0x88, 0x17, 0x04, 0xa7, 0xa4, 0x3d, 0x74, 0xd3, 0x2b,
-	0xe8, 0x3f, 0xec, 0x79, 0x8c, 0x70, 0xae, 0x9e, 0xf1, 0x20, 0x35, 0xde, 0xa9, 0x89, 0xe5, 0xb7,
-	0xbf, 0x42, 0x22, 0x0e, 0x8c, 0xf7, 0x68, 0xac, 0xaf, 0x20, 0xe4, 0x0e, 0x33, 0x35, 0x89, 0xf9,
-	0x4b, 0x28, 0xcf, 0x24, 0x6a, 0x1e, 0xe7, 0x64, 0xc6, 0x17, 0x0d, 0x21, 0x69, 0xbf, 0xc6, 0x70,
-	0x24, 0x32, 0x0c, 0x3f, 0x0b, 0x08, 0x19, 0x60, 0xa8, 0x54, 0x4f, 0xd1, 0x14, 0x4e, 0x44, 0x0f,
-	0x18, 0xdd, 0x93, 0xce, 0x72, 0x2c, 0x7f, 0x7a, 0x65, 0x4b, 0x47, 0x07, 0x8d, 0xc7, 0xd7, 0x7e,
-	0xa8, 0x5d, 0x2b, 0x73, 0xdc, 0x33, 0x97, 0xcf, 0xfb, 0xda, 0xa3, 0x6d, 0x8c, 0x75, 0x54, 0x92,
-	0x7c, 0x36, 0x49, 0x61, 0x87, 0x5c, 0x01, 0x58, 0x47, 0x37, 0x42, 0xee, 0x77, 0xb2, 0x5f, 0xda,
-	0x49, 0x58, 0x20, 0xf9, 0x26, 0x6d, 0x14, 0x72, 0x7f, 0xab, 0x1f, 0x93, 0x6d, 0x16, 0xb4, 0xd6,
-	0x0e, 0x4f, 0xaa, 0xda, 0xf1, 0x49, 0x55, 0xfb, 0x75, 0x52, 0xd5, 0xf6, 0x4f, 0xab, 0x85, 0xe3,
-	0xd3, 0x6a, 0xe1, 0xfb, 0x69, 0xb5, 0xf0, 0xb6, 0xf1, 0x17, 0xac, 0x67, 0x43, 0x75, 0x26, 0xe4,
-	0x61, 0x1f, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xdd, 0x6e, 0x65, 0xdb, 0xea, 0x05, 0x00, 0x00,
+	// 911 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf7, 0xba, 0x6e, 0xbe, 0xc9, 0xcb, 0xb7, 0xae, 0x32, 0xb8, 0xe0, 0x04, 0x6a, 0x5b, 0x7b,
+	0xa1, 0x20, 0xb2, 0x5b, 0xa7, 0x08, 0xa1, 0x4a, 0x20, 0x39, 0x6e, 0x52, 0x2a, 0x35, 0x22, 0x2c,
+	0x09, 0x48, 0x08, 0xc9, 0xda, 0x1f, 0xe3, 0xf5, 0xa8, 0xbb, 0x3b, 0xcb, 0xcc, 0xec, 0x36, 0xee,
+	0x81, 0x0b, 0x17, 0x8e, 0x3d, 0x70, 0x05, 0x71, 0xe6, 0xdc, 0x3f, 0xa2, 0xea, 0xa9, 0x47, 0x4e,
+	0x80, 0x92, 0x7f, 0x04, 0xed, 0xec, 0xf3, 0xaf, 0xd4, 0xa4, 0x39, 0x20, 0x6e, 0xef, 0x33, 0xf3,
+	0xde, 0xe7, 0x7d, 0xe6, 0xfd, 0xd8, 0x85, 0x9b, 0x91, 0x17, 0xdb, 0x43, 0x9e, 0x25, 0x81, 0xab,
+	0x18, 0x4f, 0xec, 0xbc, 0x6b, 0xd3, 0x9c, 0x26, 0xca, 0x4a, 0x05, 0x57, 0x9c, 0x6c, 0x44, 0x5e,
+	0x6c, 0xcd, 0xae, 0xad, 0xbc, 0xbb, 0xd5, 0x08, 0x79, 0xc8, 0xf5, 0xad, 0x5d, 0x58, 0xa5, 0xe3,
+	0xd6, 0x66, 0xc8, 0x79, 0x18, 0x51, 0x5b, 0x23, 0x2f, 0x1b, 0xda, 0x6e, 0x32, 0x9e, 0x5c, 0xf9,
+	0x5c, 0xc6, 0x5c, 0x0e, 0xca, 0x98, 0x12, 0xe0, 0x55, 0xab, 0x44, 0xb6, 0xe7, 0x4a, 0x6a, 0xe7,
+	0x5d, 0x8f, 0x2a, 0xb7, 0x6b, 0xfb, 0x9c, 0x25, 0x78, 0x6f, 0xbe, 0xaa, 0x6e, 0x86, 0x4a, 0x1f,
+	0xf3, 0xa9, 0x01, 0x1b, 0x7b, 0x85, 0xe4, 0xfd, 0x2c, 0x09, 0x8e, 0x04, 0x75, 0x65, 0x26, 0xc6,
+	0x84, 0x40, 0x6d, 0x28, 0x78, 0xdc, 0x34, 0x3a, 0xc6, 0xad, 0x35, 0x47, 0xdb, 0x24, 0x84, 0x15,
+	0x37, 0xe6, 0x59, 0xa2, 0x9a, 0xd5, 0xce, 0x95, 0x5b, 0xeb, 0x3b, 0x9b, 0x16, 0x8a, 0x29, 0xd2,
+	0x5b, 0x98, 0xde, 0xea, 0x73, 0x96, 0xec, 0x7e, 0xf8, 0xfc, 0x8f, 0x76, 0xe5, 0xb7, 0x3f, 0xdb,
+	0x1f, 0x84, 0x4c, 0x8d, 0x32, 0xcf, 0xf2, 0x79, 0x6c, 0xef, 0xb3, 0x44, 0xfa, 0x23, 0xe6, 0xda,
+	0x43, 0x34, 0xb6, 0x65, 0xf0, 0xc8, 0x56, 0xe3, 0x94, 0x4a, 0x1d, 0x24, 0x1d, 0xa4, 0x37, 0x7f,
+	0x32, 0x60, 0x53, 0x4b, 0xfa, 0x9a, 0xa9, 0x51, 0x20, 0xdc, 0xc7, 0xfb, 0x82, 0xc7, 0x53, 0x69,
+	0x75, 0xa8, 0x2a, 0x8e, 0xc2, 0xaa, 0x8a, 0xff, 0x77, 0xb2, 0x7c, 0x20, 0x5a, 0xd5, 0x71, 0x1a,
+	0xb8, 0x8a, 0x1e, 0xd0, 0xd8, 0xa3, 0x42, 0x92, 0x03, 0xa8, 0xc7, 0xda, 0x1c, 0x64, 0xfa, 0x5c,
+	0x36, 0x0d, 0x2d, 0xa3, 0x63, 0xbd, 0xd2, 0x7b, 0xab, 0x8c, 0x71, 0xe8, 0x77, 0x19, 0x95, 0x6a,
+	0xb7, 0x56, 0xa8, 0x71, 0xae, 0x95, 0xd1, 0x25, 0xa9, 0x34, 0x15, 0x3e, 0xbd, 0xc4, 0xf7, 0xa8,
+	0xcf, 0x24, 0xe3, 0xc9, 0x21, 0x8f, 0x98, 0x3f, 0x26, 0x5f, 0xc0, 0xf5, 0x00, 0x4f, 0x06, 0xa9,
+	0x3e, 0xd2, 0x75, 0x58, 0xdf, 0x69, 0x58, 0xe5, 0xfc, 0x58, 0x93, 0xf9, 0xb1, 0x7a, 0xc9, 0x78,
+	0x97, 0xbc, 0x78, 0xb6, 0x5d, 0x5f, 0xa4, 0x70, 0xea, 0xc1, 0x02, 0xbe, 0x5b, 0xfb, 0xf1, 0xd7,
+	0x76, 0xc5, 0xfc, 0xd9, 0x80, 0xce, 0xfc, 0xdb, 0x64, 0x78, 0x34, 0x4e, 0xcf, 0x67, 0xef, 0xc0,
+	0xff, 0x63, 0x19, 0x0e, 0x8a, 0xd2, 0x0c, 0x32, 0x11, 0x61, 0x0b, 0x20, 0x2e, 0x9d, 0x8f, 0x45,
+	0xb4, 0x4c, 0x5f, 0xf5, 0x5f, 0xd1, 0x77, 0x04, 0x6f, 0x68, 0x79, 0x5f, 0x66, 0x5e, 0xcc, 0xd4,
+	0xa1, 0xe0, 0x29, 0x97, 0x6e, 0x44, 0x3e, 0x81, 0xd5, 0x14, 0x6d, 0x2c, 0xc4, 0xdb, 0x4b, 0xaa,
+	0x3e, 0x71, 0xc7, 0x82, 0x4f, 0x43, 0xcc, 0x8f, 0xe1, 0xc6, 0xc2, 0x98, 0x4d, 0x79, 0xdb, 0xb0,
+	0x3e, 0x71, 0x1a, 0xb0, 0x40, 0x53, 0xd7, 0x1c, 0x98, 0x1c, 0x3d, 0x08, 0xcc, 0x4f, 0x61, 0x4d,
+	0x47, 0x7e, 0xc5, 0x15, 0x25, 0x5d, 0xa8, 0xe5, 0x5c, 0x51, 0x54, 0xf0, 0xd6, 0x12, 0x05, 0x85,
+	0x1b, 0x66, 0xd7, 0xae, 0xe6, 0x01, 0xee, 0xdc, 0x3d, 0x1a, 0xd1, 0xd0, 0x55, 0x54, 0xf3, 0xbc,
+	0x03, 0x6b, 0x41, 0x89, 0xb9, 0xc0, 0xe2, 0xce, 0x0e, 0xc8, 0x16, 0xac, 0x22, 0xa0, 0xba, 0xa8,
+	0x6b, 0xce, 0x14, 0x9b, 0x77, 0xb0, 0x3c, 0xc7, 0x49, 0x70, 0x69, 0x42, 0xf3, 0x07, 0x03, 0x1f,
+	0xb1, 0x77, 0x42, 0xfd, 0xd7, 0x3e, 0x99, 0xf4, 0x60, 0x45, 0x50, 0x99, 0x45, 0x4a, 0x67, 0xaf,
+	0xef, 0xbc, 0x77, 0x41, 0xa5, 0x0b, 0xc6, 0x4c, 0x71, 0xe1, 0xe8, 0x00, 0x07, 0x03, 0x8b, 0x8f,
+	0x4a, 0xc4, 0x43, 0xd9, 0xbc, 0x52, 0x7e, 0x54, 0x0a, 0xdb, 0xbc, 0x0d, 0x0d, 0x2d, 0xe2, 0x21,
+	0x75, 0x73, 0xba, 0x3f, 0x65, 0x23, 0x4d, 0xf8, 0x9f, 0x1b, 0x04, 0x82, 0x4a, 0x89, 0xca, 0x27,
+	0xd0, 0xec, 0x63, 0xed, 0xf6, 0x4e, 0x52, 0x26, 0x70, 0x0d, 0xff, 0xd9, 0x9d, 0x34, 0xe0, 0x2a,
+	0x15, 0x82, 0x0b, 0x2c, 0x5a, 0x09, 0xcc, 0x6f, 0xe1, 0xc6, 0xdc, 0xbc, 0xf7, 0x69, 0x22, 0xb9,
+	0x90, 0x23, 0x96, 0x92, 0x3e, 0x80, 0x3f, 0x45, 0xd8, 0xd2, 0x9b, 0x4b, 0x9e, 0x3a, 0x0b, 0xc1,
+	0xc6, 0xce, 0x85, 0x99, 0xbf, 0x18, 0x00, 0x9a, 0xfe, 0xbe, 0x70, 0x13, 0x55, 0x88, 0x0b, 0x0b,
+	0x83, 0xd2, 0x89, 0x38, 0x84, 0x24, 0x87, 0x6b, 0x6e, 0xa6, 0x46, 0x5c, 0xb0, 0x27, 0x9a, 0xf9,
+	0xc2, 0x75, 0xb9, 0xfb, 0xe2, 0xd9, 0xf6, 0x47, 0xaf, 0xfd, 0x72, 0x9d, 0xd8, 0x05, 0xe3, 0x13,
+	0xab, 0x37, 0xcf, 0xeb, 0x2c, 0xa6, 0x31, 0x1f, 0xc0, 0xba, 0xd6, 0xe7, 0xd0, 0x9c, 0x3f, 0xa2,
+	0x17, 0x08, 0x3c, 0xbf, 0xf3, 0xd5, 0xf3, 0x3b, 0x6f, 0xfa, 0xd8, 0xc0, 0x03, 0x16, 0x0a, 0x57,
+	0xd1, 0x4b, 0xef, 0x10, 0x79, 0x1f, 0x36, 0x42, 0xc1, 0xb3, 0x74, 0x30, 0xef, 0x56, 0xd5, 0x6e,
+	0xd7, 0xf5, 0xc5, 0xe1, 0x6c, 0xdf, 0x3e, 0xc3, 0x24, 0xc5, 0x60, 0xf5, 0x24, 0x3e, 0x4d, 0x8d,
+	0xc9, 0x6d, 0x68, 0x20, 0x87, 0xfe, 0x5a, 0x0c, 0x16, 0x67, 0x80, 0x94, 0x34, 0xfa, 0xaa, 0x87,
+	0xd3, 0xf3, 0x3d, 0xb4, 0xe7, 0x1a, 0x3f, 0xf9, 0xa9, 0x7c, 0x9e, 0xa9, 0x61, 0xc4, 0x1f, 0x3f,
+	0x64, 0x31, 0x53, 0xa4, 0x0f, 0x57, 0xa3, 0xc2, 0xc0, 0xee, 0xbf, 0xbb, 0xa4, 0xfb, 0xcb, 0xe2,
+	0x70, 0x0e, 0xca, 0x58, 0xf2, 0x66, 0xb1, 0x2e, 0x31, 0xcf, 0xcb, 0x65, 0x5d, 0x75, 0x10, 0xed,
+	0xde, 0x7f, 0x7e, 0xda, 0x32, 0x5e, 0x9e, 0xb6, 0x8c, 0xbf, 0x4e, 0x5b, 0xc6, 0xd3, 0xb3, 0x56,
+	0xe5, 0xe5, 0x59, 0xab, 0xf2, 0xfb, 0x59, 0xab, 0xf2, 0xcd, 0xf6, 0x25, 0x5a, 0x3b, 0x53, 0xe1,
+	0xad, 0xe8, 0xd9, 0xb8, 0xf3, 0xf7, 0x00, 0x87, 0x26, 0xb3, 0x7c, 0x82, 0x08, 0x00, 0x00,
 }
 
 func (m *EventFundTreasury) Marshal() (dAtA []byte, err error) {
@@ -844,6 +1217,48 @@ func (m *EventUpdateDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *EventUpdateMsgTypeDecisionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateMsgTypeDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateMsgTypeDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DecisionPolicy != nil {
+		{
+			size, err := m.DecisionPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventSubmitProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -938,7 +1353,7 @@ func (m *EventVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventExec) Marshal() (dAtA []byte, err error) {
+func (m *EventDelegateVote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -948,17 +1363,84 @@ func (m *EventExec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventExec) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventDelegateVote) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventExec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventDelegateVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Logs) > 0 {
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUndelegateVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUndelegateVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUndelegateVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventExec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventExec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventExec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Logs) > 0 {
 		i -= len(m.Logs)
 		copy(dAtA[i:], m.Logs)
 		i = encodeVarintEvent(dAtA, i, uint64(len(m.Logs)))
@@ -1008,6 +1490,43 @@ func (m *EventLeaveFoundation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventExpireMember) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventExpireMember) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventExpireMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventUpdateCensorship) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1120,6 +1639,112 @@ func (m *EventRevoke) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventMigrateProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMigrateProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMigrateProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GroupProposalId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.GroupProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventExecAsAuthority) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventExecAsAuthority) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventExecAsAuthority) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GroupPolicyAddress) > 0 {
+		i -= len(m.GroupPolicyAddress)
+		copy(dAtA[i:], m.GroupPolicyAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.GroupPolicyAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpdateTreasuryOutflowLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateTreasuryOutflowLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateTreasuryOutflowLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Remove {
+		i--
+		if m.Remove {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Limit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -1197,6 +1822,23 @@ func (m *EventUpdateDecisionPolicy) Size() (n int) {
 	return n
 }
 
+func (m *EventUpdateMsgTypeDecisionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.DecisionPolicy != nil {
+		l = m.DecisionPolicy.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
 func (m *EventSubmitProposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1231,6 +1873,36 @@ func (m *EventVote) Size() (n int) {
 	return n
 }
 
+func (m *EventDelegateVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventUndelegateVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
 func (m *EventExec) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1263,6 +1935,23 @@ func (m *EventLeaveFoundation) Size() (n int) {
 	return n
 }
 
+func (m *EventExpireMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
 func (m *EventUpdateCensorship) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1288,33 +1977,678 @@ func (m *EventGrant) Size() (n int) {
 		l = m.Authorization.Size()
 		n += 1 + l + sovEvent(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *EventRevoke) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMigrateProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovEvent(uint64(m.ProposalId))
+	}
+	if m.GroupProposalId != 0 {
+		n += 1 + sovEvent(uint64(m.GroupProposalId))
+	}
+	return n
+}
+
+func (m *EventExecAsAuthority) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GroupPolicyAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventUpdateTreasuryOutflowLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Limit.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.Remove {
+		n += 2
+	}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventFundTreasury) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFundTreasury: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFundTreasury: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventWithdrawFromTreasury) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventWithdrawFromTreasury: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventWithdrawFromTreasury: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdateMembers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateMembers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateMembers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemberUpdates = append(m.MemberUpdates, MemberRequest{})
+			if err := m.MemberUpdates[len(m.MemberUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdateDecisionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateDecisionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DecisionPolicy == nil {
+				m.DecisionPolicy = &types1.Any{}
+			}
+			if err := m.DecisionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdateMsgTypeDecisionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateMsgTypeDecisionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateMsgTypeDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DecisionPolicy == nil {
+				m.DecisionPolicy = &types1.Any{}
+			}
+			if err := m.DecisionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSubmitProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSubmitProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSubmitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *EventRevoke) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.MsgTypeUrl)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvent(x uint64) (n int) {
-	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventFundTreasury) Unmarshal(dAtA []byte) error {
+func (m *EventWithdrawProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1337,17 +2671,17 @@ func (m *EventFundTreasury) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventFundTreasury: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventWithdrawProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventFundTreasury: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventWithdrawProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var stringLen uint64
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1357,27 +2691,64 @@ func (m *EventFundTreasury) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvent
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1404,8 +2775,7 @@ func (m *EventFundTreasury) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Vote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1430,7 +2800,7 @@ func (m *EventFundTreasury) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventWithdrawFromTreasury) Unmarshal(dAtA []byte) error {
+func (m *EventDelegateVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1453,15 +2823,15 @@ func (m *EventWithdrawFromTreasury) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventWithdrawFromTreasury: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDelegateVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventWithdrawFromTreasury: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDelegateVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1489,13 +2859,13 @@ func (m *EventWithdrawFromTreasury) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1505,25 +2875,23 @@ func (m *EventWithdrawFromTreasury) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1546,7 +2914,7 @@ func (m *EventWithdrawFromTreasury) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUpdateMembers) Unmarshal(dAtA []byte) error {
+func (m *EventUndelegateVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1569,17 +2937,17 @@ func (m *EventUpdateMembers) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateMembers: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUndelegateVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateMembers: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUndelegateVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemberUpdates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1589,25 +2957,23 @@ func (m *EventUpdateMembers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MemberUpdates = append(m.MemberUpdates, MemberRequest{})
-			if err := m.MemberUpdates[len(m.MemberUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1630,7 +2996,7 @@ func (m *EventUpdateMembers) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUpdateDecisionPolicy) Unmarshal(dAtA []byte) error {
+func (m *EventExec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1653,17 +3019,55 @@ func (m *EventUpdateDecisionPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateDecisionPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventExec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventExec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= ProposalExecutorResult(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DecisionPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1673,27 +3077,23 @@ func (m *EventUpdateDecisionPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DecisionPolicy == nil {
-				m.DecisionPolicy = &types1.Any{}
-			}
-			if err := m.DecisionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Logs = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1716,7 +3116,7 @@ func (m *EventUpdateDecisionPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSubmitProposal) Unmarshal(dAtA []byte) error {
+func (m *EventLeaveFoundation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1739,17 +3139,17 @@ func (m *EventSubmitProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSubmitProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventLeaveFoundation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSubmitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventLeaveFoundation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1759,24 +3159,23 @@ func (m *EventSubmitProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1799,7 +3198,7 @@ func (m *EventSubmitProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventWithdrawProposal) Unmarshal(dAtA []byte) error {
+func (m *EventExpireMember) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1822,17 +3221,49 @@ func (m *EventWithdrawProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventWithdrawProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventExpireMember: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventWithdrawProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventExpireMember: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
 			}
-			m.ProposalId = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1842,11 +3273,24 @@ func (m *EventWithdrawProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -1868,7 +3312,7 @@ func (m *EventWithdrawProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventVote) Unmarshal(dAtA []byte) error {
+func (m *EventUpdateCensorship) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1891,15 +3335,15 @@ func (m *EventVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUpdateCensorship: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUpdateCensorship: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Censorship", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1926,7 +3370,7 @@ func (m *EventVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Vote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Censorship.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1951,7 +3395,7 @@ func (m *EventVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventExec) Unmarshal(dAtA []byte) error {
+func (m *EventGrant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1974,17 +3418,17 @@ func (m *EventExec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventExec: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventGrant: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventExec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventGrant: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
-			m.ProposalId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1994,35 +3438,29 @@ func (m *EventExec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
 			}
-			m.Result = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Result |= ProposalExecutorResult(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
 			}
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2032,23 +3470,27 @@ func (m *EventExec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Logs = string(dAtA[iNdEx:postIndex])
+			if m.Authorization == nil {
+				m.Authorization = &types1.Any{}
+			}
+			if err := m.Authorization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2071,7 +3513,7 @@ func (m *EventExec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventLeaveFoundation) Unmarshal(dAtA []byte) error {
+func (m *EventRevoke) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2094,15 +3536,15 @@ func (m *EventLeaveFoundation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventLeaveFoundation: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRevoke: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventLeaveFoundation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRevoke: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2130,7 +3572,39 @@ func (m *EventLeaveFoundation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2153,7 +3627,7 @@ func (m *EventLeaveFoundation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUpdateCensorship) Unmarshal(dAtA []byte) error {
+func (m *EventMigrateProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2176,17 +3650,17 @@ func (m *EventUpdateCensorship) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateCensorship: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMigrateProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateCensorship: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMigrateProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Censorship", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var msglen int
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2196,25 +3670,30 @@ func (m *EventUpdateCensorship) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupProposalId", wireType)
 			}
-			if err := m.Censorship.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.GroupProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -2236,7 +3715,7 @@ func (m *EventUpdateCensorship) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventGrant) Unmarshal(dAtA []byte) error {
+func (m *EventExecAsAuthority) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2259,15 +3738,15 @@ func (m *EventGrant) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventGrant: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventExecAsAuthority: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventExecAsAuthority: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2295,43 +3774,7 @@ func (m *EventGrant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Authorization == nil {
-				m.Authorization = &types1.Any{}
-			}
-			if err := m.Authorization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.GroupPolicyAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2354,7 +3797,7 @@ func (m *EventGrant) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRevoke) Unmarshal(dAtA []byte) error {
+func (m *EventUpdateTreasuryOutflowLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2377,17 +3820,17 @@ func (m *EventRevoke) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRevoke: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUpdateTreasuryOutflowLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRevoke: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUpdateTreasuryOutflowLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2397,29 +3840,30 @@ func (m *EventRevoke) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2429,24 +3873,12 @@ func (m *EventRevoke) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Remove = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -38,3 +38,35 @@ func (m EventUpdateDecisionPolicy) UnpackInterfaces(unpacker codectypes.AnyUnpac
 	var policy DecisionPolicy
 	return unpacker.UnpackAny(m.DecisionPolicy, &policy)
 }
+
+func (m EventUpdateMsgTypeDecisionPolicy) GetDecisionPolicy() DecisionPolicy {
+	if m.DecisionPolicy == nil {
+		return nil
+	}
+
+	policy, ok := m.DecisionPolicy.GetCachedValue().(DecisionPolicy)
+	if !ok {
+		return nil
+	}
+	return policy
+}
+
+func (m *EventUpdateMsgTypeDecisionPolicy) SetDecisionPolicy(policy DecisionPolicy) error {
+	event, ok := policy.(proto.Message)
+	if !ok {
+		return sdkerrors.ErrInvalidType.Wrapf("can't proto marshal %T", event)
+	}
+
+	any, err := codectypes.NewAnyWithValue(event)
+	if err != nil {
+		return err
+	}
+	m.DecisionPolicy = any
+
+	return nil
+}
+
+func (m EventUpdateMsgTypeDecisionPolicy) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var policy DecisionPolicy
+	return unpacker.UnpackAny(m.DecisionPolicy, &policy)
+}
@@ -3,6 +3,7 @@ package foundation
 import (
 	sdk "github.com/Finschia/finschia-sdk/types"
 	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
+	"github.com/Finschia/finschia-sdk/x/group"
 )
 
 type (
@@ -20,4 +21,12 @@ type (
 		SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 		SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	}
+
+	// GroupKeeper defines the group module interface contract needed by the
+	// foundation module, on outsourcing the proposal feature to x/group.
+	GroupKeeper interface {
+		CreateGroup(ctx sdk.Context, admin sdk.AccAddress, members []group.MemberRequest, metadata string) (uint64, error)
+		CreateGroupPolicy(ctx sdk.Context, admin sdk.AccAddress, groupID uint64, metadata string, policy group.DecisionPolicy) (sdk.AccAddress, error)
+		ImportProposal(ctx sdk.Context, proposal group.Proposal, votes []group.Vote) (uint64, error)
+	}
 )
@@ -103,6 +103,10 @@ func (m Member) ValidateBasic() error {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid member address: %s", m.Address)
 	}
 
+	if err := validateMemberWeight(m.Weight); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -112,6 +116,40 @@ func (m MemberRequest) ValidateBasic() error {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid member address: %s", m.Address)
 	}
 
+	// the weight of the member to be removed is meaningless
+	if !m.Remove {
+		if err := validateMemberWeight(m.Weight); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateBasic performs stateless validation on a vote delegation.
+func (d VoteDelegation) ValidateBasic() error {
+	delegator, err := sdk.AccAddressFromBech32(d.Delegator)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", d.Delegator)
+	}
+
+	delegate, err := sdk.AccAddressFromBech32(d.Delegate)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegate address: %s", d.Delegate)
+	}
+
+	if delegator.Equals(delegate) {
+		return sdkerrors.ErrInvalidRequest.Wrap("cannot delegate to oneself")
+	}
+
+	return nil
+}
+
+func validateMemberWeight(weight sdk.Dec) error {
+	if weight.IsNil() || !weight.IsPositive() {
+		return sdkerrors.ErrInvalidRequest.Wrap("member weight must be a positive number")
+	}
+
 	return nil
 }
 
@@ -126,6 +164,9 @@ type DecisionPolicy interface {
 	// GetVotingPeriod returns the duration after proposal submission where
 	// votes are accepted.
 	GetVotingPeriod() time.Duration
+	// GetMinExecutionPeriod returns the minimum duration after proposal
+	// submission where the proposal can be executed.
+	GetMinExecutionPeriod() time.Duration
 	// Allow defines policy-specific logic to allow a proposal to pass or not,
 	// based on its tally result, the foundation's total power and the time since
 	// the proposal was submitted.
@@ -149,9 +190,8 @@ func NewTallyResult(yes, abstain, no, veto sdk.Dec) TallyResult {
 	}
 }
 
-func (t *TallyResult) Add(option VoteOption) error {
-	weight := sdk.OneDec()
-
+// Add adds the weight of a voter to the count of the given option.
+func (t *TallyResult) Add(option VoteOption, weight sdk.Dec) error {
 	switch option {
 	case VOTE_OPTION_YES:
 		t.YesCount = t.YesCount.Add(weight)
@@ -316,6 +356,10 @@ func (p ThresholdDecisionPolicy) GetVotingPeriod() time.Duration {
 	return p.Windows.VotingPeriod
 }
 
+func (p ThresholdDecisionPolicy) GetMinExecutionPeriod() time.Duration {
+	return p.Windows.MinExecutionPeriod
+}
+
 func (p ThresholdDecisionPolicy) ValidateBasic() error {
 	if p.Threshold.IsNil() || !p.Threshold.IsPositive() {
 		return sdkerrors.ErrInvalidRequest.Wrap("threshold must be a positive number")
@@ -372,6 +416,10 @@ func (p PercentageDecisionPolicy) GetVotingPeriod() time.Duration {
 	return p.Windows.VotingPeriod
 }
 
+func (p PercentageDecisionPolicy) GetMinExecutionPeriod() time.Duration {
+	return p.Windows.MinExecutionPeriod
+}
+
 func (p PercentageDecisionPolicy) ValidateBasic() error {
 	if err := validateDecisionPolicyWindowsBasic(p.Windows); err != nil {
 		return err
@@ -419,7 +467,17 @@ func (p OutsourcingDecisionPolicy) GetVotingPeriod() time.Duration {
 	return 0
 }
 
+func (p OutsourcingDecisionPolicy) GetMinExecutionPeriod() time.Duration {
+	return 0
+}
+
 func (p OutsourcingDecisionPolicy) ValidateBasic() error {
+	if len(p.GroupPolicyAddress) != 0 {
+		if _, err := sdk.AccAddressFromBech32(p.GroupPolicyAddress); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid group policy address: %s", p.GroupPolicyAddress)
+		}
+	}
+
 	return nil
 }
 
@@ -470,6 +528,73 @@ func (i *FoundationInfo) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error
 	return unpacker.UnpackAny(i.DecisionPolicy, &policy)
 }
 
+var _ codectypes.UnpackInterfacesMessage = (*MsgTypeDecisionPolicy)(nil)
+
+func (p MsgTypeDecisionPolicy) ValidateBasic() error {
+	if len(p.MsgTypeUrl) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty msg type url")
+	}
+
+	policy := p.GetDecisionPolicy()
+	if policy == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("must provide decision policy")
+	}
+	if err := validateMsgTypeDecisionPolicy(policy); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func validateMsgTypeDecisionPolicy(policy DecisionPolicy) error {
+	if _, isOutsourcing := policy.(*OutsourcingDecisionPolicy); isOutsourcing {
+		return sdkerrors.ErrInvalidRequest.Wrap("outsourcing policy not allowed for a message type")
+	}
+
+	return policy.ValidateBasic()
+}
+
+func (p MsgTypeDecisionPolicy) GetDecisionPolicy() DecisionPolicy {
+	if p.DecisionPolicy == nil {
+		return nil
+	}
+
+	policy, ok := p.DecisionPolicy.GetCachedValue().(DecisionPolicy)
+	if !ok {
+		return nil
+	}
+	return policy
+}
+
+func (p *MsgTypeDecisionPolicy) SetDecisionPolicy(policy DecisionPolicy) error {
+	msg, ok := policy.(proto.Message)
+	if !ok {
+		return sdkerrors.ErrInvalidType.Wrapf("can't proto marshal %T", msg)
+	}
+
+	any, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return err
+	}
+	p.DecisionPolicy = any
+
+	return nil
+}
+
+// for the tests
+func (p MsgTypeDecisionPolicy) WithDecisionPolicy(policy DecisionPolicy) *MsgTypeDecisionPolicy {
+	msgTypePolicy := p
+	if err := msgTypePolicy.SetDecisionPolicy(policy); err != nil {
+		return nil
+	}
+	return &msgTypePolicy
+}
+
+func (p MsgTypeDecisionPolicy) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var policy DecisionPolicy
+	return unpacker.UnpackAny(p.DecisionPolicy, &policy)
+}
+
 func GetAuthorization(any *codectypes.Any, name string) (Authorization, error) {
 	cached := any.GetCachedValue()
 	if cached == nil {
@@ -509,6 +634,16 @@ type Members struct {
 	Members []Member
 }
 
+// TotalWeight returns the sum of the weights of the members.
+func (ms Members) TotalWeight() sdk.Dec {
+	totalWeight := sdk.ZeroDec()
+	for _, member := range ms.Members {
+		totalWeight = totalWeight.Add(member.Weight)
+	}
+
+	return totalWeight
+}
+
 // ValidateBasic performs stateless validation on an array of members. On top
 // of validating each member individually, it also makes sure there are no
 // duplicate addresses.
@@ -551,3 +686,19 @@ func (ms MemberRequests) ValidateBasic() error {
 	}
 	return nil
 }
+
+func (l TreasuryOutflowLimit) ValidateBasic() error {
+	if err := sdk.ValidateDenom(l.Denom); err != nil {
+		return err
+	}
+
+	if err := validateRatio(l.Ratio, "ratio"); err != nil {
+		return err
+	}
+
+	if l.Period <= 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("period must be positive")
+	}
+
+	return nil
+}
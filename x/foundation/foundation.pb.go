@@ -277,7 +277,7 @@ func (m *Censorship) GetAuthority() CensorshipAuthority {
 	return CensorshipAuthorityUnspecified
 }
 
-// Member represents a foundation member with an account address and metadata.
+// Member represents a foundation member with an account address, weight and metadata.
 type Member struct {
 	// address is the member's account address.
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
@@ -285,6 +285,11 @@ type Member struct {
 	Metadata string `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	// added_at is a timestamp specifying when a member was added.
 	AddedAt time.Time `protobuf:"bytes,4,opt,name=added_at,json=addedAt,proto3,stdtime" json:"added_at"`
+	// weight is the member's voting weight that should be greater than 0.
+	Weight github_com_Finschia_finschia_sdk_types.Dec `protobuf:"bytes,5,opt,name=weight,proto3,customtype=github.com/Finschia/finschia-sdk/types.Dec" json:"weight"`
+	// expires_at is an optional timestamp specifying when the membership expires.
+	// The member would be removed at the end of the first block at or after it.
+	ExpiresAt *time.Time `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
 }
 
 func (m *Member) Reset()         { *m = Member{} }
@@ -341,6 +346,13 @@ func (m *Member) GetAddedAt() time.Time {
 	return time.Time{}
 }
 
+func (m *Member) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
 // MemberRequest represents a foundation member to be used in Msg server requests.
 // Contrary to `Member`, it doesn't have any `added_at` field
 // since this field cannot be set as part of requests.
@@ -351,6 +363,12 @@ type MemberRequest struct {
 	Remove bool `protobuf:"varint,2,opt,name=remove,proto3" json:"remove,omitempty"`
 	// metadata is any arbitrary metadata attached to the member.
 	Metadata string `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// weight is the member's voting weight that should be greater than 0.
+	// It is ignored when remove is set to true.
+	Weight github_com_Finschia_finschia_sdk_types.Dec `protobuf:"bytes,4,opt,name=weight,proto3,customtype=github.com/Finschia/finschia-sdk/types.Dec" json:"weight"`
+	// expires_at is an optional timestamp specifying when the membership expires.
+	// It is ignored when remove is set to true.
+	ExpiresAt *time.Time `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
 }
 
 func (m *MemberRequest) Reset()         { *m = MemberRequest{} }
@@ -407,12 +425,19 @@ func (m *MemberRequest) GetMetadata() string {
 	return ""
 }
 
+func (m *MemberRequest) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
 // ThresholdDecisionPolicy is a decision policy where a proposal passes when it
 // satisfies the two following conditions:
-// 1. The sum of all `YES` voters' weights is greater or equal than the defined
-//    `threshold`.
-// 2. The voting and execution periods of the proposal respect the parameters
-//    given by `windows`.
+//  1. The sum of all `YES` voters' weights is greater or equal than the defined
+//     `threshold`.
+//  2. The voting and execution periods of the proposal respect the parameters
+//     given by `windows`.
 type ThresholdDecisionPolicy struct {
 	// threshold is the minimum sum of yes votes that must be met or exceeded for a proposal to succeed.
 	Threshold github_com_Finschia_finschia_sdk_types.Dec `protobuf:"bytes,1,opt,name=threshold,proto3,customtype=github.com/Finschia/finschia-sdk/types.Dec" json:"threshold"`
@@ -462,10 +487,10 @@ func (m *ThresholdDecisionPolicy) GetWindows() *DecisionPolicyWindows {
 
 // PercentageDecisionPolicy is a decision policy where a proposal passes when
 // it satisfies the two following conditions:
-// 1. The percentage of all `YES` voters' weights out of the total group weight
-//    is greater or equal than the given `percentage`.
-// 2. The voting and execution periods of the proposal respect the parameters
-//    given by `windows`.
+//  1. The percentage of all `YES` voters' weights out of the total group weight
+//     is greater or equal than the given `percentage`.
+//  2. The voting and execution periods of the proposal respect the parameters
+//     given by `windows`.
 type PercentageDecisionPolicy struct {
 	// percentage is the minimum percentage the sum of yes votes must meet for a proposal to succeed.
 	Percentage github_com_Finschia_finschia_sdk_types.Dec `protobuf:"bytes,1,opt,name=percentage,proto3,customtype=github.com/Finschia/finschia-sdk/types.Dec" json:"percentage"`
@@ -583,6 +608,11 @@ func (m *DecisionPolicyWindows) GetMinExecutionPeriod() time.Duration {
 // the proposal feature has been outsourced to x/group.
 type OutsourcingDecisionPolicy struct {
 	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	// group_policy_address is the address of the group policy account which
+	// the foundation members and proposals have been migrated into.
+	// It is set by the module on the migration, and empty if the foundation
+	// has not been migrated yet.
+	GroupPolicyAddress string `protobuf:"bytes,2,opt,name=group_policy_address,json=groupPolicyAddress,proto3" json:"group_policy_address,omitempty"`
 }
 
 func (m *OutsourcingDecisionPolicy) Reset()         { *m = OutsourcingDecisionPolicy{} }
@@ -625,6 +655,13 @@ func (m *OutsourcingDecisionPolicy) GetDescription() string {
 	return ""
 }
 
+func (m *OutsourcingDecisionPolicy) GetGroupPolicyAddress() string {
+	if m != nil {
+		return m.GroupPolicyAddress
+	}
+	return ""
+}
+
 // FoundationInfo represents the high-level on-chain information for the foundation.
 type FoundationInfo struct {
 	// version is used to track changes to the foundation's membership structure that
@@ -632,7 +669,7 @@ type FoundationInfo struct {
 	// this version is incremented and will cause proposals based on older versions
 	// of the foundation to fail
 	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
-	// total_weight is the number of the foundation members.
+	// total_weight is the sum of the weights of the foundation members.
 	TotalWeight github_com_Finschia_finschia_sdk_types.Dec `protobuf:"bytes,2,opt,name=total_weight,json=totalWeight,proto3,customtype=github.com/Finschia/finschia-sdk/types.Dec" json:"total_weight"`
 	// decision_policy specifies the foundation's decision policy.
 	DecisionPolicy *types.Any `protobuf:"bytes,3,opt,name=decision_policy,json=decisionPolicy,proto3" json:"decision_policy,omitempty"`
@@ -671,6 +708,48 @@ func (m *FoundationInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_FoundationInfo proto.InternalMessageInfo
 
+// MsgTypeDecisionPolicy defines a decision policy applied to the proposals
+// which contain the messages of the given type.
+type MsgTypeDecisionPolicy struct {
+	// msg_type_url is the type url of the message.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// decision_policy is the decision policy applied to the message type.
+	DecisionPolicy *types.Any `protobuf:"bytes,2,opt,name=decision_policy,json=decisionPolicy,proto3" json:"decision_policy,omitempty"`
+}
+
+func (m *MsgTypeDecisionPolicy) Reset()         { *m = MsgTypeDecisionPolicy{} }
+func (m *MsgTypeDecisionPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgTypeDecisionPolicy) ProtoMessage()    {}
+func (*MsgTypeDecisionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1980496a233f02f4, []int{9}
+}
+func (m *MsgTypeDecisionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTypeDecisionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTypeDecisionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTypeDecisionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTypeDecisionPolicy.Merge(m, src)
+}
+func (m *MsgTypeDecisionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTypeDecisionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTypeDecisionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTypeDecisionPolicy proto.InternalMessageInfo
+
 // Proposal defines a foundation proposal. Any member of the foundation can submit a proposal
 // for a group policy to decide upon.
 // A proposal consists of a set of `sdk.Msg`s that will be executed if the proposal
@@ -704,13 +783,22 @@ type Proposal struct {
 	ExecutorResult ProposalExecutorResult `protobuf:"varint,9,opt,name=executor_result,json=executorResult,proto3,enum=lbm.foundation.v1.ProposalExecutorResult" json:"executor_result,omitempty"`
 	// messages is a list of Msgs that will be executed if the proposal passes.
 	Messages []*types.Any `protobuf:"bytes,10,rep,name=messages,proto3" json:"messages,omitempty"`
+	// execute_after is an optional timestamp before which the proposal cannot be executed.
+	// If it is set, the proposal will be executed automatically at the end of
+	// the first block after this time, once it has been accepted.
+	ExecuteAfter *time.Time `protobuf:"bytes,11,opt,name=execute_after,json=executeAfter,proto3,stdtime" json:"execute_after,omitempty"`
+	// execute_after_height is an optional block height before which the proposal cannot be executed.
+	// If it is set, the proposal will be executed automatically at the end of
+	// the first block at or after this height, once it has been accepted.
+	// It cannot be set together with execute_after.
+	ExecuteAfterHeight uint64 `protobuf:"varint,12,opt,name=execute_after_height,json=executeAfterHeight,proto3" json:"execute_after_height,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1980496a233f02f4, []int{9}
+	return fileDescriptor_1980496a233f02f4, []int{10}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -755,7 +843,7 @@ func (m *TallyResult) Reset()         { *m = TallyResult{} }
 func (m *TallyResult) String() string { return proto.CompactTextString(m) }
 func (*TallyResult) ProtoMessage()    {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1980496a233f02f4, []int{10}
+	return fileDescriptor_1980496a233f02f4, []int{11}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -802,7 +890,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1980496a233f02f4, []int{11}
+	return fileDescriptor_1980496a233f02f4, []int{12}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -866,6 +954,61 @@ func (m *Vote) GetSubmitTime() time.Time {
 	return time.Time{}
 }
 
+// VoteDelegation represents a delegation of the voting power of a member to another member.
+type VoteDelegation struct {
+	// delegator is the account address of the member delegating its voting power.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// delegate is the account address of the member voting on behalf of the delegator.
+	Delegate string `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate,omitempty"`
+}
+
+func (m *VoteDelegation) Reset()         { *m = VoteDelegation{} }
+func (m *VoteDelegation) String() string { return proto.CompactTextString(m) }
+func (*VoteDelegation) ProtoMessage()    {}
+func (*VoteDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1980496a233f02f4, []int{13}
+}
+func (m *VoteDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteDelegation.Merge(m, src)
+}
+func (m *VoteDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteDelegation proto.InternalMessageInfo
+
+func (m *VoteDelegation) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *VoteDelegation) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
 // Pool is used for tracking treasury.
 type Pool struct {
 	Treasury github_com_Finschia_finschia_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=treasury,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.DecCoins" json:"treasury"`
@@ -875,7 +1018,7 @@ func (m *Pool) Reset()         { *m = Pool{} }
 func (m *Pool) String() string { return proto.CompactTextString(m) }
 func (*Pool) ProtoMessage()    {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1980496a233f02f4, []int{12}
+	return fileDescriptor_1980496a233f02f4, []int{14}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -911,6 +1054,121 @@ func (m *Pool) GetTreasury() github_com_Finschia_finschia_sdk_types.DecCoins {
 	return nil
 }
 
+// TreasuryOutflowLimit defines the cap on the outflow of a denom from the
+// treasury over a rolling window.
+type TreasuryOutflowLimit struct {
+	// denom is the denom of the coins to limit.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// ratio is the max ratio of the outflow within the period to the treasury,
+	// as if the outflow had not happened. It must be in the range [0, 1].
+	Ratio github_com_Finschia_finschia_sdk_types.Dec `protobuf:"bytes,2,opt,name=ratio,proto3,customtype=github.com/Finschia/finschia-sdk/types.Dec" json:"ratio"`
+	// period is the length of the rolling window.
+	Period time.Duration `protobuf:"bytes,3,opt,name=period,proto3,stdduration" json:"period"`
+}
+
+func (m *TreasuryOutflowLimit) Reset()         { *m = TreasuryOutflowLimit{} }
+func (m *TreasuryOutflowLimit) String() string { return proto.CompactTextString(m) }
+func (*TreasuryOutflowLimit) ProtoMessage()    {}
+func (*TreasuryOutflowLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1980496a233f02f4, []int{15}
+}
+func (m *TreasuryOutflowLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TreasuryOutflowLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TreasuryOutflowLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TreasuryOutflowLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreasuryOutflowLimit.Merge(m, src)
+}
+func (m *TreasuryOutflowLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *TreasuryOutflowLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreasuryOutflowLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TreasuryOutflowLimit proto.InternalMessageInfo
+
+func (m *TreasuryOutflowLimit) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *TreasuryOutflowLimit) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+// TreasuryOutflow represents the coins withdrawn from the treasury at a time,
+// which are tracked for the limits.
+type TreasuryOutflow struct {
+	// time is the block time of the withdrawal.
+	Time time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	// amount is the amount of the coins withdrawn.
+	Amount types1.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *TreasuryOutflow) Reset()         { *m = TreasuryOutflow{} }
+func (m *TreasuryOutflow) String() string { return proto.CompactTextString(m) }
+func (*TreasuryOutflow) ProtoMessage()    {}
+func (*TreasuryOutflow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1980496a233f02f4, []int{16}
+}
+func (m *TreasuryOutflow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TreasuryOutflow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TreasuryOutflow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TreasuryOutflow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreasuryOutflow.Merge(m, src)
+}
+func (m *TreasuryOutflow) XXX_Size() int {
+	return m.Size()
+}
+func (m *TreasuryOutflow) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreasuryOutflow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TreasuryOutflow proto.InternalMessageInfo
+
+func (m *TreasuryOutflow) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *TreasuryOutflow) GetAmount() types1.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types1.Coin{}
+}
+
 // FoundationExecProposal is x/gov proposal to trigger the x/foundation messages on behalf of x/gov.
 type FoundationExecProposal struct {
 	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -924,7 +1182,7 @@ func (m *FoundationExecProposal) Reset()         { *m = FoundationExecProposal{}
 func (m *FoundationExecProposal) String() string { return proto.CompactTextString(m) }
 func (*FoundationExecProposal) ProtoMessage()    {}
 func (*FoundationExecProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1980496a233f02f4, []int{13}
+	return fileDescriptor_1980496a233f02f4, []int{17}
 }
 func (m *FoundationExecProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -988,10 +1246,14 @@ func init() {
 	proto.RegisterType((*DecisionPolicyWindows)(nil), "lbm.foundation.v1.DecisionPolicyWindows")
 	proto.RegisterType((*OutsourcingDecisionPolicy)(nil), "lbm.foundation.v1.OutsourcingDecisionPolicy")
 	proto.RegisterType((*FoundationInfo)(nil), "lbm.foundation.v1.FoundationInfo")
+	proto.RegisterType((*MsgTypeDecisionPolicy)(nil), "lbm.foundation.v1.MsgTypeDecisionPolicy")
 	proto.RegisterType((*Proposal)(nil), "lbm.foundation.v1.Proposal")
 	proto.RegisterType((*TallyResult)(nil), "lbm.foundation.v1.TallyResult")
 	proto.RegisterType((*Vote)(nil), "lbm.foundation.v1.Vote")
+	proto.RegisterType((*VoteDelegation)(nil), "lbm.foundation.v1.VoteDelegation")
 	proto.RegisterType((*Pool)(nil), "lbm.foundation.v1.Pool")
+	proto.RegisterType((*TreasuryOutflowLimit)(nil), "lbm.foundation.v1.TreasuryOutflowLimit")
+	proto.RegisterType((*TreasuryOutflow)(nil), "lbm.foundation.v1.TreasuryOutflow")
 	proto.RegisterType((*FoundationExecProposal)(nil), "lbm.foundation.v1.FoundationExecProposal")
 }
 
@@ -1000,102 +1262,117 @@ func init() {
 }
 
 var fileDescriptor_1980496a233f02f4 = []byte{
-	// 1506 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4d, 0x6c, 0x1b, 0xc5,
-	0x17, 0xcf, 0xda, 0x4e, 0x62, 0x3f, 0x27, 0x8e, 0x3b, 0xcd, 0xbf, 0x75, 0xdc, 0xd6, 0x76, 0xad,
-	0xea, 0xaf, 0x50, 0x29, 0x36, 0x09, 0x42, 0x88, 0x5e, 0x90, 0x3f, 0x36, 0x8d, 0x4b, 0xeb, 0x75,
-	0xc7, 0xeb, 0x84, 0x22, 0xa1, 0xd5, 0xda, 0x3b, 0xb1, 0x57, 0xd8, 0x3b, 0xee, 0xce, 0xd8, 0x89,
-	0x4f, 0x48, 0x9c, 0xaa, 0x5e, 0xe8, 0x91, 0x4b, 0x25, 0x24, 0x2e, 0x88, 0x33, 0x12, 0x88, 0x2b,
-	0x12, 0xaa, 0x38, 0xa0, 0x8a, 0x0b, 0xa8, 0x87, 0x16, 0xa5, 0x67, 0x8e, 0xdc, 0xd1, 0x7e, 0xf9,
-	0x2b, 0x4e, 0x68, 0x53, 0x71, 0xf3, 0x9b, 0xf7, 0xde, 0x6f, 0x7e, 0xef, 0x73, 0xd6, 0x90, 0x6e,
-	0xd7, 0x3b, 0xd9, 0x7d, 0xda, 0x33, 0x34, 0x95, 0xeb, 0xd4, 0xc8, 0xf6, 0x37, 0xc7, 0xa4, 0x4c,
-	0xd7, 0xa4, 0x9c, 0xa2, 0x73, 0xed, 0x7a, 0x27, 0x33, 0x76, 0xda, 0xdf, 0x8c, 0xaf, 0x36, 0x69,
-	0x93, 0xda, 0xda, 0xac, 0xf5, 0xcb, 0x31, 0x8c, 0x27, 0x9a, 0x94, 0x36, 0xdb, 0x24, 0x6b, 0x4b,
-	0xf5, 0xde, 0x7e, 0x56, 0xeb, 0x99, 0x63, 0x40, 0xf1, 0xe4, 0xb4, 0x9e, 0xeb, 0x1d, 0xc2, 0xb8,
-	0xda, 0xe9, 0xba, 0x06, 0x6b, 0xd3, 0x06, 0xaa, 0x31, 0xf0, 0xb0, 0x1b, 0x94, 0x75, 0x28, 0xcb,
-	0xd6, 0x55, 0x46, 0xb2, 0xfd, 0xcd, 0x3a, 0xe1, 0xea, 0x66, 0xb6, 0x41, 0x75, 0x0f, 0x7b, 0xcd,
-	0xd1, 0x2b, 0x0e, 0x29, 0x47, 0x70, 0x54, 0x69, 0x1d, 0x16, 0x2a, 0xaa, 0xa9, 0x76, 0x18, 0xba,
-	0x07, 0x91, 0x51, 0x1c, 0x0a, 0x57, 0x0f, 0x63, 0x42, 0x4a, 0x58, 0x0f, 0xe5, 0xb7, 0x9e, 0x3c,
-	0x4f, 0xce, 0x3d, 0x7b, 0x9e, 0xbc, 0xde, 0xd4, 0x79, 0xab, 0x57, 0xcf, 0x34, 0x68, 0x27, 0xbb,
-	0xad, 0x1b, 0xac, 0xd1, 0xd2, 0xd5, 0xec, 0xbe, 0xfb, 0x63, 0x83, 0x69, 0x9f, 0x66, 0xf9, 0xa0,
-	0x4b, 0x58, 0xa6, 0x48, 0x1a, 0x78, 0x79, 0x84, 0x24, 0xab, 0x87, 0xb7, 0x02, 0x41, 0x5f, 0xd4,
-	0x9f, 0xe6, 0x00, 0x05, 0x62, 0x30, 0x6a, 0xb2, 0x96, 0xde, 0x45, 0x29, 0x58, 0xea, 0xb0, 0xa6,
-	0x62, 0xf9, 0x28, 0x3d, 0xb3, 0xed, 0x5c, 0x86, 0xa1, 0xc3, 0x9a, 0xf2, 0xa0, 0x4b, 0x6a, 0x66,
-	0x1b, 0x15, 0x21, 0xa4, 0xf6, 0x78, 0x8b, 0x9a, 0x3a, 0x1f, 0xc4, 0x7c, 0x29, 0x61, 0x3d, 0xb2,
-	0xf5, 0xff, 0xcc, 0xb1, 0x74, 0x67, 0x46, 0x98, 0x39, 0xcf, 0x1a, 0x8f, 0x1c, 0xd3, 0x9f, 0xc1,
-	0xc2, 0x1d, 0xd2, 0xa9, 0x13, 0x13, 0xc5, 0x60, 0x51, 0xd5, 0x34, 0x93, 0x30, 0xe6, 0x5e, 0xe6,
-	0x89, 0x28, 0x0e, 0xc1, 0x0e, 0xe1, 0xaa, 0xa6, 0x72, 0xd5, 0xbe, 0x28, 0x84, 0x87, 0x32, 0xfa,
-	0x00, 0x82, 0xaa, 0xa6, 0x11, 0x4d, 0x51, 0x79, 0x2c, 0x90, 0x12, 0xd6, 0xc3, 0x5b, 0xf1, 0x8c,
-	0x53, 0x89, 0x8c, 0x57, 0x89, 0x8c, 0xec, 0x95, 0x2a, 0x1f, 0xb4, 0x92, 0xf5, 0xe8, 0x45, 0x52,
-	0xb0, 0xc1, 0x89, 0x96, 0xe3, 0xe9, 0x4f, 0x60, 0xd9, 0x21, 0x80, 0xc9, 0xfd, 0x1e, 0x61, 0xfc,
-	0x14, 0x1e, 0x17, 0x60, 0xc1, 0x24, 0x1d, 0xda, 0x27, 0x36, 0x8b, 0x20, 0x76, 0xa5, 0x09, 0x7e,
-	0xfe, 0x49, 0x7e, 0xe9, 0x9f, 0x04, 0xb8, 0x28, 0xb7, 0x4c, 0xc2, 0x5a, 0xb4, 0xad, 0x15, 0x49,
-	0x43, 0x67, 0x3a, 0x35, 0x2a, 0xb4, 0xad, 0x37, 0x06, 0xa8, 0x02, 0x21, 0xee, 0xa9, 0xde, 0xa0,
-	0x9a, 0x23, 0x10, 0x94, 0x87, 0xc5, 0x03, 0xdd, 0xd0, 0xe8, 0x01, 0xb3, 0x29, 0x86, 0xb7, 0xd6,
-	0x67, 0x54, 0x64, 0x92, 0xc5, 0x9e, 0x63, 0x8f, 0x3d, 0xc7, 0x1b, 0xe8, 0xb7, 0xef, 0x36, 0x22,
-	0x93, 0x36, 0xe9, 0x9f, 0x05, 0x88, 0x55, 0x88, 0xd9, 0x20, 0x06, 0x57, 0x9b, 0x64, 0x2a, 0x0c,
-	0x0c, 0xd0, 0x1d, 0xea, 0xde, 0x20, 0x8e, 0x31, 0x94, 0xff, 0x2c, 0x90, 0x1f, 0x04, 0xf8, 0xdf,
-	0x4c, 0x37, 0xb4, 0x03, 0xcb, 0x7d, 0xca, 0x75, 0xa3, 0xa9, 0x74, 0x89, 0xa9, 0x53, 0xa7, 0x20,
-	0xe1, 0xad, 0xb5, 0x63, 0xdd, 0x54, 0x74, 0x17, 0x83, 0xd3, 0x4c, 0x5f, 0x5a, 0xcd, 0xb4, 0xe4,
-	0x78, 0x56, 0x6c, 0x47, 0x54, 0x83, 0xd5, 0x8e, 0x6e, 0x28, 0xe4, 0x90, 0x34, 0x7a, 0xf6, 0xb0,
-	0xba, 0x80, 0xbe, 0x57, 0x07, 0x44, 0x1d, 0xdd, 0x10, 0x3d, 0x7f, 0x07, 0x36, 0x7d, 0x17, 0xd6,
-	0xa4, 0x1e, 0x67, 0xb4, 0x67, 0x36, 0x74, 0xa3, 0x39, 0x55, 0x83, 0x14, 0x84, 0x35, 0xc2, 0x1a,
-	0xa6, 0xde, 0xb5, 0x3c, 0xdc, 0xc6, 0x1d, 0x3f, 0x9a, 0x99, 0x8d, 0x67, 0x02, 0x44, 0xb6, 0x87,
-	0x29, 0x2d, 0x19, 0xfb, 0xd4, 0xea, 0xfe, 0x3e, 0x31, 0x99, 0x07, 0x12, 0xc0, 0x9e, 0x88, 0x6a,
-	0xb0, 0xc4, 0x29, 0x57, 0xdb, 0xca, 0x01, 0xd1, 0x9b, 0x2d, 0xee, 0x4c, 0xe2, 0x99, 0x0a, 0x1d,
-	0xb6, 0x71, 0xf6, 0x6c, 0x18, 0x74, 0x17, 0x56, 0x34, 0x97, 0x95, 0xd2, 0xb5, 0x69, 0xd9, 0x33,
-	0x14, 0xde, 0x5a, 0x3d, 0x96, 0xa8, 0x9c, 0x31, 0xc8, 0xa3, 0x5f, 0x8e, 0x85, 0x81, 0x23, 0xda,
-	0x84, 0x7c, 0x23, 0xf0, 0xe0, 0xab, 0xe4, 0x5c, 0xfa, 0xfb, 0x00, 0x04, 0x2b, 0x26, 0xed, 0x52,
-	0xa6, 0xb6, 0x51, 0x04, 0x7c, 0xba, 0xe6, 0x46, 0xe4, 0xd3, 0xb5, 0x53, 0x57, 0xca, 0x65, 0x08,
-	0x75, 0x6d, 0x3f, 0x62, 0xb2, 0x98, 0x3f, 0xe5, 0x5f, 0x0f, 0xe1, 0xd1, 0x01, 0x12, 0x21, 0xcc,
-	0x7a, 0xf5, 0x8e, 0xce, 0x15, 0xeb, 0x05, 0x78, 0xad, 0x9d, 0x03, 0x8e, 0xa3, 0xa5, 0x42, 0x1b,
-	0x80, 0xc6, 0xd6, 0xb9, 0x97, 0xf2, 0x79, 0x9b, 0xe0, 0xb9, 0x91, 0x66, 0xd7, 0x4d, 0xfe, 0xfb,
-	0xb0, 0xc0, 0xb8, 0xca, 0x7b, 0x2c, 0xb6, 0x60, 0x6f, 0xda, 0xab, 0x33, 0xc6, 0xc1, 0x0b, 0xb6,
-	0x6a, 0x1b, 0x62, 0xd7, 0x01, 0x61, 0x40, 0xfb, 0xba, 0xa1, 0xb6, 0x15, 0xae, 0xb6, 0xdb, 0x03,
-	0xc5, 0x24, 0xac, 0xd7, 0xe6, 0xb1, 0x45, 0x9b, 0x77, 0x62, 0x06, 0x8c, 0x6c, 0x99, 0x61, 0xdb,
-	0x2a, 0x1f, 0xb0, 0xb8, 0xe3, 0xa8, 0xed, 0x3f, 0x76, 0x8e, 0x2a, 0x70, 0x6e, 0x62, 0x58, 0x14,
-	0x62, 0x68, 0xb1, 0xe0, 0x6b, 0xa4, 0x62, 0x65, 0x7c, 0x62, 0x44, 0x43, 0x43, 0x18, 0x56, 0x9c,
-	0x81, 0xa1, 0xa6, 0x47, 0x31, 0x64, 0x47, 0xfa, 0xd6, 0x29, 0x91, 0x8a, 0xae, 0x87, 0xc3, 0x0a,
-	0x47, 0xc8, 0x84, 0x8c, 0xde, 0xb6, 0x8a, 0xcc, 0x98, 0xda, 0x24, 0x2c, 0x06, 0x29, 0xff, 0x49,
-	0x3d, 0x85, 0x87, 0x56, 0x6e, 0xe7, 0xfc, 0xe5, 0x83, 0xf0, 0x78, 0xb4, 0x12, 0x84, 0x06, 0x84,
-	0x29, 0x0d, 0xda, 0x33, 0xf8, 0x1b, 0xec, 0xb7, 0xe0, 0x80, 0xb0, 0x82, 0x85, 0x81, 0xf6, 0x60,
-	0x59, 0xad, 0x33, 0xae, 0xea, 0x86, 0x0b, 0x7a, 0xf6, 0x59, 0x5a, 0x72, 0x81, 0x1c, 0xe0, 0x3b,
-	0x10, 0x34, 0xa8, 0x8b, 0xe9, 0x3f, 0x33, 0xe6, 0xa2, 0x41, 0x1d, 0x38, 0x05, 0x90, 0x41, 0x95,
-	0x03, 0x9d, 0xb7, 0x94, 0x3e, 0xe1, 0x1e, 0x70, 0xe0, 0xcc, 0xc0, 0x2b, 0x06, 0xdd, 0xd3, 0x79,
-	0x6b, 0x97, 0x70, 0xe7, 0x02, 0x37, 0xdf, 0xbf, 0x0b, 0x10, 0xd8, 0xa5, 0x9c, 0xa0, 0x24, 0x84,
-	0xbb, 0x6e, 0x69, 0x95, 0xe1, 0xb8, 0x82, 0x77, 0x54, 0xd2, 0xd0, 0x2a, 0xcc, 0xf7, 0x29, 0x27,
-	0xa6, 0x3b, 0xb3, 0x8e, 0x80, 0xde, 0x85, 0x05, 0xea, 0xec, 0x3d, 0xbf, 0xdd, 0x32, 0x57, 0x66,
-	0xb4, 0x8c, 0x85, 0x2f, 0xd9, 0x46, 0xd8, 0x35, 0x9e, 0xd8, 0x01, 0x81, 0xa9, 0x1d, 0x30, 0x35,
-	0xe5, 0xf3, 0x67, 0x9b, 0xf2, 0xf4, 0x00, 0x02, 0x15, 0x4a, 0xdb, 0xe8, 0x3e, 0x04, 0xb9, 0x49,
-	0x54, 0xd6, 0x33, 0x07, 0x31, 0xc1, 0xee, 0xc4, 0xcb, 0x19, 0xf7, 0x3b, 0xcf, 0xfa, 0x28, 0xcc,
-	0xb8, 0x1f, 0x85, 0x56, 0x92, 0x0a, 0x54, 0x37, 0xf2, 0xef, 0x59, 0x68, 0xdf, 0xbe, 0x48, 0x66,
-	0x5f, 0x3d, 0xb9, 0x96, 0x1f, 0xc3, 0xc3, 0x6b, 0xd2, 0x9f, 0x0b, 0x70, 0x61, 0xb4, 0xdb, 0xad,
-	0x49, 0x19, 0x2e, 0xc3, 0x55, 0x98, 0xe7, 0x3a, 0x6f, 0xbb, 0x6f, 0x35, 0x76, 0x84, 0xe9, 0x27,
-	0xc4, 0x77, 0xec, 0x09, 0x99, 0x98, 0x27, 0xff, 0xab, 0xcc, 0xd3, 0xf5, 0xbf, 0x05, 0x38, 0x3f,
-	0xe3, 0x03, 0x10, 0xed, 0x40, 0xaa, 0x20, 0x96, 0xab, 0x12, 0xae, 0xee, 0x94, 0x2a, 0x4a, 0xae,
-	0x26, 0xef, 0x48, 0xb8, 0x24, 0xdf, 0x53, 0x6a, 0xe5, 0x6a, 0x45, 0x2c, 0x94, 0xb6, 0x4b, 0x62,
-	0x31, 0x3a, 0x17, 0x4f, 0x3f, 0x7c, 0x9c, 0x4a, 0xcc, 0x70, 0xaf, 0x19, 0xac, 0x4b, 0x1a, 0xfa,
-	0xbe, 0x4e, 0x34, 0xb4, 0x0d, 0xc9, 0x99, 0x48, 0x37, 0xa5, 0x5d, 0x11, 0x97, 0x73, 0xe5, 0x82,
-	0x18, 0x15, 0xe2, 0x57, 0x1f, 0x3e, 0x4e, 0x5d, 0x99, 0x01, 0x74, 0x93, 0xf6, 0x89, 0x69, 0xa8,
-	0x46, 0x83, 0x9c, 0x88, 0xb3, 0x2d, 0xd5, 0xca, 0xc5, 0x9c, 0x5c, 0x92, 0xca, 0x51, 0xdf, 0x89,
-	0x38, 0xa3, 0x3c, 0xc7, 0x03, 0x0f, 0xbe, 0x4e, 0xcc, 0x5d, 0xff, 0x42, 0x00, 0x18, 0x75, 0x1c,
-	0xba, 0x04, 0x17, 0x77, 0x25, 0x59, 0x54, 0xa4, 0x8a, 0x05, 0x34, 0x19, 0x25, 0x3a, 0x0f, 0x2b,
-	0xe3, 0xca, 0x7b, 0x62, 0x35, 0x2a, 0xa0, 0x8b, 0x70, 0x7e, 0xfc, 0x30, 0x97, 0xaf, 0xca, 0xb9,
-	0x52, 0x39, 0xea, 0x43, 0x08, 0x22, 0xe3, 0x8a, 0xb2, 0x14, 0xf5, 0xa3, 0xcb, 0x10, 0x9b, 0x3c,
-	0x53, 0xf6, 0x4a, 0xf2, 0x8e, 0xb2, 0x2b, 0xca, 0x52, 0x34, 0xe0, 0x32, 0xfa, 0x55, 0x80, 0xc8,
-	0xe4, 0x03, 0x81, 0x92, 0x70, 0xa9, 0x82, 0xa5, 0x8a, 0x54, 0xcd, 0xdd, 0x56, 0xaa, 0x72, 0x4e,
-	0xae, 0x55, 0xa7, 0x98, 0x5d, 0x81, 0xb5, 0x69, 0x83, 0x6a, 0x2d, 0x7f, 0xa7, 0x24, 0xcb, 0x62,
-	0x31, 0x2a, 0x58, 0xd7, 0x4e, 0xab, 0x73, 0x85, 0x82, 0x58, 0xb1, 0xb4, 0xbe, 0x59, 0x5a, 0x2c,
-	0xde, 0x12, 0x0b, 0x96, 0xd6, 0x6f, 0x65, 0xe4, 0x98, 0x6f, 0x5e, 0xc2, 0x96, 0x32, 0x30, 0xeb,
-	0x5e, 0x2b, 0xa0, 0x22, 0xce, 0xed, 0x95, 0xa3, 0xf3, 0x6e, 0x40, 0x3f, 0x0a, 0x70, 0x61, 0xf6,
-	0x3b, 0x80, 0xd6, 0xe1, 0xda, 0xd0, 0x5f, 0xfc, 0x48, 0x2c, 0xd4, 0x64, 0x09, 0x2b, 0x58, 0xac,
-	0xd6, 0x6e, 0xcb, 0x53, 0x11, 0x5e, 0x83, 0xd4, 0x89, 0x96, 0x65, 0x49, 0x56, 0x70, 0xad, 0x1c,
-	0x15, 0x4e, 0xb5, 0xaa, 0xd6, 0x0a, 0x05, 0xb1, 0x5a, 0x8d, 0xfa, 0x4e, 0xb5, 0xda, 0xce, 0x95,
-	0x6e, 0xd7, 0xb0, 0x18, 0xf5, 0x3b, 0xe4, 0xf3, 0x1f, 0x7e, 0x73, 0x94, 0x10, 0x9e, 0x1c, 0x25,
-	0x84, 0xa7, 0x47, 0x09, 0xe1, 0xcf, 0xa3, 0x84, 0xf0, 0xe8, 0x65, 0x62, 0xee, 0xe9, 0xcb, 0xc4,
-	0xdc, 0x1f, 0x2f, 0x13, 0x73, 0x1f, 0x6f, 0xfc, 0xeb, 0xd4, 0x1f, 0x8e, 0xfd, 0xd3, 0xad, 0x2f,
-	0xd8, 0xc3, 0xf7, 0xce, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xcd, 0xef, 0x06, 0x11, 0x10, 0x0f,
-	0x00, 0x00,
+	// 1752 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4d, 0x6c, 0x1b, 0xc7,
+	0xf5, 0xd7, 0xf2, 0xcb, 0xe4, 0xa3, 0x44, 0xd1, 0x63, 0xc5, 0xa6, 0x14, 0x9b, 0x64, 0x88, 0xe0,
+	0x0f, 0xfd, 0x0d, 0x98, 0x8c, 0x55, 0x14, 0x69, 0xd3, 0x43, 0xc0, 0x8f, 0x55, 0x44, 0xd7, 0xe6,
+	0xb2, 0xcb, 0xa5, 0x54, 0xf7, 0xb2, 0x58, 0x72, 0x47, 0xe4, 0xa2, 0xdc, 0x1d, 0x66, 0x67, 0x96,
+	0x12, 0xaf, 0x6d, 0x0f, 0x41, 0x50, 0xa0, 0x41, 0x4f, 0xbd, 0x04, 0x28, 0xd0, 0x4b, 0xd1, 0x53,
+	0x81, 0xf6, 0x50, 0xf4, 0x5a, 0xa0, 0x08, 0x7a, 0x28, 0x82, 0x5e, 0x5a, 0xe4, 0x90, 0x14, 0xf6,
+	0xad, 0x40, 0x8f, 0xbd, 0x17, 0xbb, 0x33, 0xcb, 0x2f, 0xd1, 0xb2, 0x2c, 0xa7, 0x37, 0xbe, 0x79,
+	0xef, 0xf7, 0x9b, 0xf7, 0x31, 0xef, 0xcd, 0x2c, 0xa1, 0x34, 0xea, 0xd9, 0x95, 0x53, 0xe2, 0x39,
+	0xa6, 0xc1, 0x2c, 0xe2, 0x54, 0x26, 0x0f, 0x17, 0xa4, 0xf2, 0xd8, 0x25, 0x8c, 0xa0, 0x9b, 0xa3,
+	0x9e, 0x5d, 0x5e, 0x58, 0x9d, 0x3c, 0xdc, 0xdb, 0x19, 0x90, 0x01, 0x09, 0xb4, 0x15, 0xff, 0x17,
+	0x37, 0xdc, 0xcb, 0x0f, 0x08, 0x19, 0x8c, 0x70, 0x25, 0x90, 0x7a, 0xde, 0x69, 0xc5, 0xf4, 0xdc,
+	0x05, 0xa2, 0xbd, 0xc2, 0xaa, 0x9e, 0x59, 0x36, 0xa6, 0xcc, 0xb0, 0xc7, 0xc2, 0x60, 0x77, 0xd5,
+	0xc0, 0x70, 0xa6, 0x21, 0x77, 0x9f, 0x50, 0x9b, 0xd0, 0x4a, 0xcf, 0xa0, 0xb8, 0x32, 0x79, 0xd8,
+	0xc3, 0xcc, 0x78, 0x58, 0xe9, 0x13, 0x2b, 0xe4, 0xde, 0xe5, 0x7a, 0x9d, 0x3b, 0xc5, 0x05, 0xae,
+	0x2a, 0x59, 0x90, 0x68, 0x1b, 0xae, 0x61, 0x53, 0xf4, 0x14, 0x32, 0xf3, 0x38, 0x74, 0x66, 0x9c,
+	0xe7, 0xa4, 0xa2, 0xb4, 0x9f, 0xaa, 0x1d, 0x7c, 0xf6, 0x65, 0x61, 0xe3, 0x8b, 0x2f, 0x0b, 0xf7,
+	0x07, 0x16, 0x1b, 0x7a, 0xbd, 0x72, 0x9f, 0xd8, 0x95, 0x43, 0xcb, 0xa1, 0xfd, 0xa1, 0x65, 0x54,
+	0x4e, 0xc5, 0x8f, 0x07, 0xd4, 0xfc, 0x61, 0x85, 0x4d, 0xc7, 0x98, 0x96, 0x1b, 0xb8, 0xaf, 0x6e,
+	0xcd, 0x99, 0x34, 0xe3, 0xfc, 0x51, 0x2c, 0x19, 0xc9, 0x46, 0x4b, 0x0c, 0xa0, 0x8e, 0x1d, 0x4a,
+	0x5c, 0x3a, 0xb4, 0xc6, 0xa8, 0x08, 0x9b, 0x36, 0x1d, 0xe8, 0x3e, 0x46, 0xf7, 0xdc, 0x11, 0xdf,
+	0x4c, 0x05, 0x9b, 0x0e, 0xb4, 0xe9, 0x18, 0x77, 0xdd, 0x11, 0x6a, 0x40, 0xca, 0xf0, 0xd8, 0x90,
+	0xb8, 0x16, 0x9b, 0xe6, 0x22, 0x45, 0x69, 0x3f, 0x73, 0xf0, 0x7f, 0xe5, 0x0b, 0xe9, 0x2e, 0xcf,
+	0x39, 0xab, 0xa1, 0xb5, 0x3a, 0x07, 0x96, 0x7e, 0x1a, 0x81, 0xc4, 0x13, 0x6c, 0xf7, 0xb0, 0x8b,
+	0x72, 0x70, 0xc3, 0x30, 0x4d, 0x17, 0x53, 0x2a, 0x76, 0x0b, 0x45, 0xb4, 0x07, 0x49, 0x1b, 0x33,
+	0xc3, 0x34, 0x98, 0x11, 0xec, 0x94, 0x52, 0x67, 0x32, 0x7a, 0x1f, 0x92, 0x86, 0x69, 0x62, 0x53,
+	0x37, 0x58, 0x2e, 0x56, 0x94, 0xf6, 0xd3, 0x07, 0x7b, 0x65, 0x5e, 0x8a, 0x72, 0x58, 0x8a, 0xb2,
+	0x16, 0xd6, 0xaa, 0x96, 0xf4, 0xb3, 0xf5, 0xc9, 0x57, 0x05, 0x29, 0x20, 0xc7, 0x66, 0x95, 0xa1,
+	0x47, 0x90, 0x38, 0xc3, 0xd6, 0x60, 0xc8, 0x72, 0xf1, 0x6b, 0x27, 0x54, 0x30, 0xa0, 0xf7, 0x01,
+	0xf0, 0xf9, 0xd8, 0x72, 0x31, 0xf5, 0xdd, 0x49, 0xbc, 0xd4, 0x9d, 0x58, 0xe0, 0x4a, 0x4a, 0x60,
+	0xaa, 0xac, 0xf4, 0x2f, 0x09, 0xb6, 0x78, 0x3a, 0x54, 0xfc, 0xa1, 0x87, 0x29, 0xbb, 0x24, 0x2b,
+	0xb7, 0x21, 0xe1, 0x62, 0x9b, 0x4c, 0x70, 0x90, 0x93, 0xa4, 0x2a, 0xa4, 0xa5, 0x6c, 0x45, 0x57,
+	0xb2, 0x35, 0x0f, 0x36, 0xf6, 0x35, 0x07, 0x1b, 0x7f, 0xf5, 0x60, 0xff, 0x24, 0xc1, 0x1d, 0x6d,
+	0xe8, 0x62, 0x3a, 0x24, 0x23, 0xb3, 0x81, 0xfb, 0x16, 0xb5, 0x88, 0xd3, 0x26, 0x23, 0xab, 0x3f,
+	0x45, 0x6d, 0x48, 0xb1, 0x50, 0xf5, 0x1a, 0x27, 0x7d, 0x4e, 0x82, 0x6a, 0x70, 0xe3, 0xcc, 0x72,
+	0x4c, 0x72, 0x46, 0x83, 0x7c, 0xa5, 0x0f, 0xf6, 0xd7, 0x9c, 0xd6, 0x65, 0x2f, 0x4e, 0xb8, 0xbd,
+	0x1a, 0x02, 0xdf, 0x43, 0x7f, 0xfb, 0xfd, 0x83, 0xcc, 0xb2, 0x4d, 0xe9, 0xcf, 0x12, 0xe4, 0xda,
+	0xd8, 0xed, 0x63, 0x87, 0x19, 0x03, 0xbc, 0x12, 0x86, 0x0a, 0x30, 0x9e, 0xe9, 0x5e, 0x23, 0x8e,
+	0x05, 0x96, 0xff, 0x59, 0x20, 0x7f, 0x90, 0xe0, 0x8d, 0xb5, 0x30, 0x74, 0x04, 0x5b, 0x13, 0xc2,
+	0x2c, 0x67, 0xa0, 0x8f, 0xb1, 0x6b, 0x11, 0x5e, 0x90, 0xf4, 0xc1, 0xee, 0x85, 0x62, 0x37, 0xc4,
+	0xd0, 0xe4, 0x7d, 0xf6, 0x0b, 0xbf, 0xde, 0x9b, 0x1c, 0xd9, 0x0e, 0x80, 0xa8, 0x0b, 0x3b, 0xb6,
+	0xe5, 0xe8, 0xf8, 0x1c, 0xf7, 0xbd, 0x60, 0x90, 0x09, 0xc2, 0xc8, 0xd5, 0x09, 0x91, 0x6d, 0x39,
+	0x72, 0x88, 0xe7, 0xb4, 0xa5, 0x1f, 0x4b, 0xb0, 0xab, 0x78, 0x8c, 0x12, 0xcf, 0xed, 0x5b, 0xce,
+	0x60, 0xa5, 0x08, 0x45, 0x48, 0x9b, 0x98, 0xf6, 0x5d, 0x6b, 0xec, 0x43, 0x44, 0x1b, 0x2d, 0x2e,
+	0xa1, 0x77, 0x60, 0x67, 0xe0, 0x12, 0x6f, 0xac, 0x8f, 0x03, 0x84, 0x1e, 0x76, 0x1c, 0x1f, 0x36,
+	0x28, 0xd0, 0x71, 0xb2, 0x2a, 0xd7, 0xac, 0x4d, 0xe0, 0x17, 0x12, 0x64, 0x0e, 0x67, 0x55, 0x68,
+	0x3a, 0xa7, 0xc4, 0xef, 0xde, 0x09, 0x76, 0x69, 0xb8, 0x6d, 0x4c, 0x0d, 0x45, 0xd4, 0x85, 0x4d,
+	0x46, 0x98, 0x31, 0xd2, 0x45, 0x3f, 0x46, 0xae, 0x7d, 0x36, 0xd2, 0x01, 0xcf, 0x09, 0x6f, 0xca,
+	0xef, 0xc1, 0xb6, 0x29, 0xbc, 0x12, 0xc1, 0x04, 0x33, 0x20, 0x7d, 0xb0, 0x73, 0x21, 0xb7, 0x55,
+	0x67, 0x5a, 0x43, 0x7f, 0xb9, 0x10, 0x86, 0x9a, 0x31, 0x97, 0xe4, 0xf7, 0x62, 0x1f, 0xfd, 0xb2,
+	0xb0, 0x51, 0xfa, 0xb9, 0x04, 0x6f, 0x3c, 0xe1, 0xd3, 0xff, 0x42, 0x7a, 0x5f, 0x76, 0x55, 0xac,
+	0x71, 0x2a, 0xf2, 0xb5, 0x38, 0xf5, 0xdb, 0x38, 0x24, 0xdb, 0x2e, 0x19, 0x13, 0x6a, 0x8c, 0x50,
+	0x06, 0x22, 0x96, 0x29, 0xd2, 0x1c, 0xb1, 0xcc, 0x4b, 0x6f, 0x8d, 0xbb, 0x90, 0x1a, 0x07, 0x38,
+	0xec, 0xd2, 0x5c, 0xb4, 0x18, 0xdd, 0x4f, 0xa9, 0xf3, 0x05, 0x24, 0x43, 0x9a, 0x7a, 0x3d, 0xdb,
+	0x62, 0xba, 0x7f, 0xcb, 0xbf, 0xd2, 0xb5, 0x02, 0x1c, 0xe8, 0xab, 0xd0, 0x03, 0x40, 0x0b, 0x57,
+	0x76, 0x78, 0x0e, 0xe2, 0x81, 0x83, 0x37, 0xe7, 0x9a, 0x63, 0x71, 0x22, 0xbe, 0x0d, 0x09, 0xca,
+	0x0c, 0xe6, 0xd1, 0xe0, 0xe2, 0xc8, 0x1c, 0xbc, 0xb5, 0xa6, 0xad, 0xc3, 0x60, 0x3b, 0x81, 0xa1,
+	0x2a, 0x00, 0x48, 0x05, 0x74, 0x6a, 0x39, 0xc6, 0x48, 0x67, 0xc6, 0x68, 0x34, 0xd5, 0x5d, 0x4c,
+	0xbd, 0x11, 0xcb, 0xdd, 0x08, 0xfc, 0xce, 0xaf, 0xa1, 0xd1, 0x7c, 0x33, 0x35, 0xb0, 0xaa, 0xc5,
+	0x7c, 0xdf, 0xd5, 0x6c, 0x80, 0x5f, 0x58, 0x47, 0x6d, 0xb8, 0xb9, 0xd4, 0xf4, 0x3a, 0x76, 0xcc,
+	0x5c, 0xf2, 0x15, 0x52, 0xb1, 0xbd, 0xd8, 0xf9, 0xb2, 0x63, 0x22, 0x15, 0xb6, 0x79, 0xe3, 0x13,
+	0x37, 0x74, 0x31, 0x15, 0x44, 0xfa, 0xff, 0x97, 0x44, 0x2a, 0x0b, 0x04, 0xf7, 0x4a, 0xcd, 0xe0,
+	0x25, 0x19, 0xbd, 0xe3, 0x17, 0x99, 0x52, 0x63, 0x80, 0x69, 0x0e, 0x8a, 0xd1, 0x17, 0x9d, 0x29,
+	0x75, 0x66, 0x85, 0x64, 0xd8, 0xe2, 0x1c, 0x58, 0x37, 0x4e, 0x19, 0x76, 0x73, 0xe9, 0x2b, 0xde,
+	0x5c, 0x9b, 0x02, 0x56, 0xf5, 0x51, 0xfe, 0xc8, 0x58, 0xa2, 0xd1, 0x87, 0xbc, 0x8f, 0x37, 0x83,
+	0xf2, 0xa2, 0x45, 0xdb, 0xa3, 0x40, 0x23, 0x8e, 0xec, 0xbf, 0x23, 0x90, 0x5e, 0x4c, 0xb3, 0x02,
+	0xa9, 0x29, 0xa6, 0x7a, 0x9f, 0x78, 0x0e, 0x7b, 0x8d, 0x0b, 0x22, 0x39, 0xc5, 0xb4, 0xee, 0x73,
+	0xa0, 0x13, 0xd8, 0x32, 0x7a, 0x94, 0x19, 0x96, 0x23, 0x48, 0xaf, 0x3f, 0x59, 0x36, 0x05, 0x11,
+	0x27, 0x7e, 0x02, 0x49, 0x87, 0x08, 0xce, 0xe8, 0xb5, 0x39, 0x6f, 0x38, 0x84, 0xd3, 0xe9, 0x80,
+	0x1c, 0xa2, 0x9f, 0x59, 0x6c, 0xa8, 0x4f, 0x30, 0x0b, 0x89, 0xaf, 0xff, 0x2c, 0xd9, 0x76, 0xc8,
+	0x89, 0xc5, 0x86, 0xc7, 0x98, 0xf1, 0x0d, 0x44, 0xbe, 0xff, 0x2e, 0x41, 0xec, 0x98, 0x30, 0x8c,
+	0x0a, 0x90, 0x1e, 0x8b, 0x33, 0xa5, 0xcf, 0xe6, 0x04, 0x84, 0x4b, 0x4d, 0x13, 0xed, 0x40, 0x7c,
+	0x42, 0xfc, 0x03, 0xc1, 0x87, 0x05, 0x17, 0xd0, 0x37, 0x21, 0x41, 0xf8, 0xbd, 0x11, 0x0d, 0xce,
+	0xea, 0xbd, 0x35, 0x67, 0xd5, 0xe7, 0x57, 0x02, 0x23, 0x55, 0x18, 0x2f, 0x0d, 0x9f, 0xd8, 0xca,
+	0xf0, 0x59, 0x19, 0x2f, 0xf1, 0xeb, 0x8d, 0x97, 0xd2, 0x23, 0xc8, 0xf8, 0x1b, 0x37, 0xf0, 0x08,
+	0x0f, 0x02, 0x57, 0xfc, 0xa9, 0x66, 0x72, 0x89, 0xb8, 0x62, 0x0c, 0xcf, 0x17, 0x7c, 0x97, 0x84,
+	0x80, 0xc3, 0x79, 0x18, 0xca, 0xa5, 0x29, 0xc4, 0xda, 0x84, 0x8c, 0xd0, 0x87, 0x90, 0x64, 0x2e,
+	0x36, 0xa8, 0xe7, 0x4e, 0x73, 0x52, 0xd0, 0x4e, 0x77, 0xcb, 0xe2, 0x83, 0xc4, 0xff, 0x7a, 0x29,
+	0x8b, 0xaf, 0x17, 0x3f, 0xe1, 0x75, 0x62, 0x39, 0xb5, 0x77, 0x7d, 0xcf, 0x7e, 0xf3, 0x55, 0xa1,
+	0x72, 0xf5, 0x42, 0xf9, 0x38, 0xaa, 0xce, 0xb6, 0x29, 0xfd, 0x4e, 0x82, 0x1d, 0x4d, 0x08, 0x8a,
+	0xc7, 0x4e, 0x47, 0xe4, 0xec, 0xb1, 0x65, 0x5b, 0xcc, 0xaf, 0x87, 0x89, 0x1d, 0x62, 0x8b, 0x48,
+	0xb8, 0x80, 0x8e, 0x20, 0x1e, 0x3c, 0x09, 0x5e, 0xe3, 0x58, 0x73, 0x02, 0xf4, 0x1d, 0x48, 0x88,
+	0xd7, 0x47, 0xf4, 0xea, 0xaf, 0x0f, 0x01, 0x29, 0xfd, 0x44, 0x82, 0xed, 0x15, 0xaf, 0xd1, 0xb7,
+	0x20, 0x16, 0x14, 0x54, 0x7a, 0x85, 0x82, 0x06, 0x08, 0xf4, 0x2e, 0x24, 0x0c, 0x7b, 0xd6, 0xac,
+	0xbe, 0x2b, 0xeb, 0x92, 0x1e, 0x64, 0x9c, 0x8f, 0x6b, 0x61, 0x5e, 0xfa, 0x91, 0x04, 0xb7, 0xe7,
+	0x4f, 0x0e, 0x7f, 0x56, 0xce, 0xae, 0xc3, 0x1d, 0x88, 0x33, 0x8b, 0x8d, 0x70, 0x98, 0xbe, 0x40,
+	0x58, 0x7d, 0x0b, 0x45, 0xd6, 0xbd, 0x85, 0xe6, 0x13, 0x35, 0x7a, 0x95, 0x89, 0x7a, 0xff, 0x3f,
+	0x12, 0xdc, 0x5a, 0xf3, 0x99, 0x87, 0x8e, 0xa0, 0x58, 0x97, 0x5b, 0x1d, 0x45, 0xed, 0x1c, 0x35,
+	0xdb, 0x7a, 0xb5, 0xab, 0x1d, 0x29, 0x6a, 0x53, 0x7b, 0xaa, 0x77, 0x5b, 0x9d, 0xb6, 0x5c, 0x6f,
+	0x1e, 0x36, 0xe5, 0x46, 0x76, 0x63, 0xaf, 0xf4, 0xf1, 0xa7, 0xc5, 0xfc, 0x1a, 0x78, 0xd7, 0xa1,
+	0x63, 0xdc, 0xb7, 0x4e, 0x2d, 0x6c, 0xa2, 0x43, 0x28, 0xac, 0x65, 0xfa, 0x40, 0x39, 0x96, 0xd5,
+	0x56, 0xb5, 0x55, 0x97, 0xb3, 0xd2, 0xde, 0x5b, 0x1f, 0x7f, 0x5a, 0xbc, 0xb7, 0x86, 0xe8, 0x03,
+	0x32, 0xc1, 0xae, 0x63, 0x38, 0x7d, 0xfc, 0x42, 0x9e, 0x43, 0xa5, 0xdb, 0x6a, 0x54, 0xb5, 0xa6,
+	0xd2, 0xca, 0x46, 0x5e, 0xc8, 0x33, 0xcf, 0xf3, 0x5e, 0xec, 0xa3, 0x5f, 0xe5, 0x37, 0xee, 0xff,
+	0x4c, 0x02, 0x98, 0xb7, 0x3e, 0x7a, 0x13, 0xee, 0x1c, 0x2b, 0x9a, 0xac, 0x2b, 0x6d, 0x9f, 0x68,
+	0x39, 0x4a, 0x74, 0x0b, 0xb6, 0x17, 0x95, 0x4f, 0xe5, 0x4e, 0x56, 0x42, 0x77, 0xe0, 0xd6, 0xe2,
+	0x62, 0xb5, 0xd6, 0xd1, 0xaa, 0xcd, 0x56, 0x36, 0x82, 0x10, 0x64, 0x16, 0x15, 0x2d, 0x25, 0x1b,
+	0x45, 0x77, 0x21, 0xb7, 0xbc, 0xa6, 0x9f, 0x34, 0xb5, 0x23, 0xfd, 0x58, 0xd6, 0x94, 0x6c, 0x4c,
+	0x78, 0xf4, 0x57, 0x09, 0x32, 0xcb, 0x4f, 0x04, 0x54, 0x80, 0x37, 0xdb, 0xaa, 0xd2, 0x56, 0x3a,
+	0xd5, 0xc7, 0x7a, 0x47, 0xab, 0x6a, 0xdd, 0xce, 0x8a, 0x67, 0xf7, 0x60, 0x77, 0xd5, 0xa0, 0xd3,
+	0xad, 0x3d, 0x69, 0x6a, 0x9a, 0xdc, 0xc8, 0x4a, 0xfe, 0xb6, 0xab, 0xea, 0x6a, 0xbd, 0x2e, 0xb7,
+	0x7d, 0x6d, 0x64, 0x9d, 0x56, 0x95, 0x1f, 0xc9, 0x75, 0x5f, 0x1b, 0xf5, 0x33, 0x72, 0x01, 0x5b,
+	0x53, 0x54, 0x5f, 0x19, 0x5b, 0xb7, 0xaf, 0x1f, 0x50, 0x43, 0xad, 0x9e, 0xb4, 0xb2, 0x71, 0x11,
+	0xd0, 0x1f, 0x25, 0xb8, 0xbd, 0xfe, 0x25, 0x80, 0xf6, 0xe1, 0xed, 0x19, 0x5e, 0xfe, 0xbe, 0x5c,
+	0xef, 0x6a, 0x8a, 0xaa, 0xab, 0x72, 0xa7, 0xfb, 0x58, 0x5b, 0x89, 0xf0, 0x6d, 0x28, 0xbe, 0xd0,
+	0xb2, 0xa5, 0x68, 0xba, 0xda, 0x6d, 0x65, 0xa5, 0x4b, 0xad, 0x3a, 0xdd, 0x7a, 0x5d, 0xee, 0x74,
+	0xb2, 0x91, 0x4b, 0xad, 0x0e, 0xab, 0xcd, 0xc7, 0x5d, 0x55, 0xce, 0x46, 0xb9, 0xf3, 0xb5, 0xef,
+	0xfe, 0xfa, 0x59, 0x5e, 0xfa, 0xec, 0x59, 0x5e, 0xfa, 0xfc, 0x59, 0x5e, 0xfa, 0xe7, 0xb3, 0xbc,
+	0xf4, 0xc9, 0xf3, 0xfc, 0xc6, 0xe7, 0xcf, 0xf3, 0x1b, 0xff, 0x78, 0x9e, 0xdf, 0xf8, 0xc1, 0x83,
+	0x97, 0x4e, 0xac, 0xf3, 0x85, 0xff, 0xb3, 0x7a, 0x89, 0xa0, 0xf9, 0xbe, 0xf1, 0xdf, 0x01, 0x00,
+	0x0d, 0xdf, 0x18, 0x0c, 0xf6, 0x12, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -1177,6 +1454,16 @@ func (this *Member) Equal(that interface{}) bool {
 	if !this.AddedAt.Equal(that1.AddedAt) {
 		return false
 	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
+	if that1.ExpiresAt == nil {
+		if this.ExpiresAt != nil {
+			return false
+		}
+	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
+		return false
+	}
 	return true
 }
 func (this *MemberRequest) Equal(that interface{}) bool {
@@ -1207,6 +1494,16 @@ func (this *MemberRequest) Equal(that interface{}) bool {
 	if this.Metadata != that1.Metadata {
 		return false
 	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
+	if that1.ExpiresAt == nil {
+		if this.ExpiresAt != nil {
+			return false
+		}
+	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
+		return false
+	}
 	return true
 }
 func (this *ThresholdDecisionPolicy) Equal(that interface{}) bool {
@@ -1312,6 +1609,9 @@ func (this *OutsourcingDecisionPolicy) Equal(that interface{}) bool {
 	if this.Description != that1.Description {
 		return false
 	}
+	if this.GroupPolicyAddress != that1.GroupPolicyAddress {
+		return false
+	}
 	return true
 }
 func (this *FoundationInfo) Equal(that interface{}) bool {
@@ -1344,6 +1644,33 @@ func (this *FoundationInfo) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgTypeDecisionPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgTypeDecisionPolicy)
+	if !ok {
+		that2, ok := that.(MsgTypeDecisionPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MsgTypeUrl != that1.MsgTypeUrl {
+		return false
+	}
+	if !this.DecisionPolicy.Equal(that1.DecisionPolicy) {
+		return false
+	}
+	return true
+}
 func (this *Proposal) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1403,6 +1730,16 @@ func (this *Proposal) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if that1.ExecuteAfter == nil {
+		if this.ExecuteAfter != nil {
+			return false
+		}
+	} else if !this.ExecuteAfter.Equal(*that1.ExecuteAfter) {
+		return false
+	}
+	if this.ExecuteAfterHeight != that1.ExecuteAfterHeight {
+		return false
+	}
 	return true
 }
 func (this *TallyResult) Equal(that interface{}) bool {
@@ -1474,6 +1811,33 @@ func (this *Vote) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *VoteDelegation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VoteDelegation)
+	if !ok {
+		that2, ok := that.(VoteDelegation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Delegator != that1.Delegator {
+		return false
+	}
+	if this.Delegate != that1.Delegate {
+		return false
+	}
+	return true
+}
 func (this *Pool) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1503,6 +1867,63 @@ func (this *Pool) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *TreasuryOutflowLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TreasuryOutflowLimit)
+	if !ok {
+		that2, ok := that.(TreasuryOutflowLimit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.Ratio.Equal(that1.Ratio) {
+		return false
+	}
+	if this.Period != that1.Period {
+		return false
+	}
+	return true
+}
+func (this *TreasuryOutflow) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TreasuryOutflow)
+	if !ok {
+		that2, ok := that.(TreasuryOutflow)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if !this.Amount.Equal(&that1.Amount) {
+		return false
+	}
+	return true
+}
 func (this *FoundationExecProposal) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1626,16 +2047,36 @@ func (m *Member) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.AddedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.AddedAt):])
-	if err1 != nil {
-		return 0, err1
+	if m.ExpiresAt != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintFoundation(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x32
 	}
-	i -= n1
-	i = encodeVarintFoundation(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x22
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFoundation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.AddedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.AddedAt):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintFoundation(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
 		copy(dAtA[i:], m.Metadata)
 		i = encodeVarintFoundation(dAtA, i, uint64(len(m.Metadata)))
 		i--
@@ -1671,6 +2112,26 @@ func (m *MemberRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiresAt != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintFoundation(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFoundation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.Metadata) > 0 {
 		i -= len(m.Metadata)
 		copy(dAtA[i:], m.Metadata)
@@ -1808,20 +2269,20 @@ func (m *DecisionPolicyWindows) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinExecutionPeriod):])
-	if err4 != nil {
-		return 0, err4
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinExecutionPeriod):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n4
-	i = encodeVarintFoundation(dAtA, i, uint64(n4))
+	i -= n6
+	i = encodeVarintFoundation(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x12
-	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod):])
-	if err5 != nil {
-		return 0, err5
+	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n5
-	i = encodeVarintFoundation(dAtA, i, uint64(n5))
+	i -= n7
+	i = encodeVarintFoundation(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -1847,6 +2308,13 @@ func (m *OutsourcingDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.GroupPolicyAddress) > 0 {
+		i -= len(m.GroupPolicyAddress)
+		copy(dAtA[i:], m.GroupPolicyAddress)
+		i = encodeVarintFoundation(dAtA, i, uint64(len(m.GroupPolicyAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Description) > 0 {
 		i -= len(m.Description)
 		copy(dAtA[i:], m.Description)
@@ -1907,6 +2375,48 @@ func (m *FoundationInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgTypeDecisionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTypeDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTypeDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DecisionPolicy != nil {
+		{
+			size, err := m.DecisionPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFoundation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintFoundation(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Proposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1927,6 +2437,21 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExecuteAfterHeight != 0 {
+		i = encodeVarintFoundation(dAtA, i, uint64(m.ExecuteAfterHeight))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.ExecuteAfter != nil {
+		n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExecuteAfter, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExecuteAfter):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintFoundation(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x5a
+	}
 	if len(m.Messages) > 0 {
 		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1946,12 +2471,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x48
 	}
-	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingPeriodEnd):])
-	if err7 != nil {
-		return 0, err7
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingPeriodEnd):])
+	if err11 != nil {
+		return 0, err11
 	}
-	i -= n7
-	i = encodeVarintFoundation(dAtA, i, uint64(n7))
+	i -= n11
+	i = encodeVarintFoundation(dAtA, i, uint64(n11))
 	i--
 	dAtA[i] = 0x42
 	{
@@ -1974,12 +2499,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x28
 	}
-	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
-	if err9 != nil {
-		return 0, err9
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
+	if err13 != nil {
+		return 0, err13
 	}
-	i -= n9
-	i = encodeVarintFoundation(dAtA, i, uint64(n9))
+	i -= n13
+	i = encodeVarintFoundation(dAtA, i, uint64(n13))
 	i--
 	dAtA[i] = 0x22
 	if len(m.Proposers) > 0 {
@@ -2089,12 +2614,12 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
-	if err10 != nil {
-		return 0, err10
+	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
+	if err14 != nil {
+		return 0, err14
 	}
-	i -= n10
-	i = encodeVarintFoundation(dAtA, i, uint64(n10))
+	i -= n14
+	i = encodeVarintFoundation(dAtA, i, uint64(n14))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Metadata) > 0 {
@@ -2124,6 +2649,43 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VoteDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintFoundation(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintFoundation(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Pool) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2161,6 +2723,95 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TreasuryOutflowLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TreasuryOutflowLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TreasuryOutflowLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n15, err15 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintFoundation(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Ratio.Size()
+		i -= size
+		if _, err := m.Ratio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFoundation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFoundation(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TreasuryOutflow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TreasuryOutflow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TreasuryOutflow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFoundation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintFoundation(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *FoundationExecProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2266,6 +2917,12 @@ func (m *Member) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.AddedAt)
 	n += 1 + l + sovFoundation(uint64(l))
+	l = m.Weight.Size()
+	n += 1 + l + sovFoundation(uint64(l))
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovFoundation(uint64(l))
+	}
 	return n
 }
 
@@ -2286,6 +2943,12 @@ func (m *MemberRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovFoundation(uint64(l))
 	}
+	l = m.Weight.Size()
+	n += 1 + l + sovFoundation(uint64(l))
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovFoundation(uint64(l))
+	}
 	return n
 }
 
@@ -2342,6 +3005,10 @@ func (m *OutsourcingDecisionPolicy) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovFoundation(uint64(l))
 	}
+	l = len(m.GroupPolicyAddress)
+	if l > 0 {
+		n += 1 + l + sovFoundation(uint64(l))
+	}
 	return n
 }
 
@@ -2363,6 +3030,23 @@ func (m *FoundationInfo) Size() (n int) {
 	return n
 }
 
+func (m *MsgTypeDecisionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovFoundation(uint64(l))
+	}
+	if m.DecisionPolicy != nil {
+		l = m.DecisionPolicy.Size()
+		n += 1 + l + sovFoundation(uint64(l))
+	}
+	return n
+}
+
 func (m *Proposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2403,6 +3087,13 @@ func (m *Proposal) Size() (n int) {
 			n += 1 + l + sovFoundation(uint64(l))
 		}
 	}
+	if m.ExecuteAfter != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExecuteAfter)
+		n += 1 + l + sovFoundation(uint64(l))
+	}
+	if m.ExecuteAfterHeight != 0 {
+		n += 1 + sovFoundation(uint64(m.ExecuteAfterHeight))
+	}
 	return n
 }
 
@@ -2448,6 +3139,23 @@ func (m *Vote) Size() (n int) {
 	return n
 }
 
+func (m *VoteDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovFoundation(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovFoundation(uint64(l))
+	}
+	return n
+}
+
 func (m *Pool) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2463,6 +3171,36 @@ func (m *Pool) Size() (n int) {
 	return n
 }
 
+func (m *TreasuryOutflowLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFoundation(uint64(l))
+	}
+	l = m.Ratio.Size()
+	n += 1 + l + sovFoundation(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovFoundation(uint64(l))
+	return n
+}
+
+func (m *TreasuryOutflow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovFoundation(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovFoundation(uint64(l))
+	return n
+}
+
 func (m *FoundationExecProposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2803,25 +3541,95 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFoundation(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthFoundation
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFoundation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
 	return nil
 }
 func (m *MemberRequest) Unmarshal(dAtA []byte) error {
@@ -2937,6 +3745,76 @@ func (m *MemberRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFoundation(dAtA[iNdEx:])
@@ -3375,6 +4253,38 @@ func (m *OutsourcingDecisionPolicy) Unmarshal(dAtA []byte) error {
 			}
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupPolicyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFoundation(dAtA[iNdEx:])
@@ -3535,7 +4445,7 @@ func (m *FoundationInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Proposal) Unmarshal(dAtA []byte) error {
+func (m *MsgTypeDecisionPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3558,66 +4468,15 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTypeDecisionPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTypeDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFoundation
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFoundation
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFoundation
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFoundation
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3645,11 +4504,11 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposers = append(m.Proposers, string(dAtA[iNdEx:postIndex]))
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionPolicy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3676,21 +4535,190 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+			if m.DecisionPolicy == nil {
+				m.DecisionPolicy = &types.Any{}
+			}
+			if err := m.DecisionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FoundationVersion", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFoundation(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.FoundationVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFoundation
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFoundation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposers = append(m.Proposers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FoundationVersion", wireType)
+			}
+			m.FoundationVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
@@ -3837,6 +4865,61 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecuteAfter == nil {
+				m.ExecuteAfter = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExecuteAfter, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteAfterHeight", wireType)
+			}
+			m.ExecuteAfterHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteAfterHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFoundation(dAtA[iNdEx:])
@@ -4229,7 +5312,7 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Pool) Unmarshal(dAtA []byte) error {
+func (m *VoteDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4252,17 +5335,17 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+			return fmt.Errorf("proto: VoteDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VoteDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFoundation
@@ -4272,23 +5355,402 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFoundation
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFoundation
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Treasury = append(m.Treasury, types1.DecCoin{})
-			if err := m.Treasury[len(m.Treasury)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFoundation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFoundation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Treasury = append(m.Treasury, types1.DecCoin{})
+			if err := m.Treasury[len(m.Treasury)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFoundation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TreasuryOutflowLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFoundation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TreasuryOutflowLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TreasuryOutflowLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Ratio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFoundation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TreasuryOutflow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFoundation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TreasuryOutflow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TreasuryOutflow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15,26 +15,26 @@ import (
 func TestTallyResult(t *testing.T) {
 	result := foundation.DefaultTallyResult()
 
-	err := result.Add(foundation.VOTE_OPTION_UNSPECIFIED)
+	err := result.Add(foundation.VOTE_OPTION_UNSPECIFIED, sdk.OneDec())
 	require.Error(t, err)
 
-	err = result.Add(foundation.VOTE_OPTION_YES)
+	err = result.Add(foundation.VOTE_OPTION_YES, sdk.OneDec())
 	require.NoError(t, err)
 	require.Equal(t, sdk.OneDec(), result.YesCount)
 
-	err = result.Add(foundation.VOTE_OPTION_ABSTAIN)
+	err = result.Add(foundation.VOTE_OPTION_ABSTAIN, sdk.NewDec(2))
 	require.NoError(t, err)
-	require.Equal(t, sdk.OneDec(), result.AbstainCount)
+	require.Equal(t, sdk.NewDec(2), result.AbstainCount)
 
-	err = result.Add(foundation.VOTE_OPTION_NO)
+	err = result.Add(foundation.VOTE_OPTION_NO, sdk.NewDec(3))
 	require.NoError(t, err)
-	require.Equal(t, sdk.OneDec(), result.NoCount)
+	require.Equal(t, sdk.NewDec(3), result.NoCount)
 
-	err = result.Add(foundation.VOTE_OPTION_NO_WITH_VETO)
+	err = result.Add(foundation.VOTE_OPTION_NO_WITH_VETO, sdk.NewDec(4))
 	require.NoError(t, err)
-	require.Equal(t, sdk.OneDec(), result.NoWithVetoCount)
+	require.Equal(t, sdk.NewDec(4), result.NoWithVetoCount)
 
-	require.Equal(t, sdk.NewDec(4), result.TotalCounts())
+	require.Equal(t, sdk.NewDec(10), result.TotalCounts())
 }
 
 func TestThresholdDecisionPolicy(t *testing.T) {
@@ -353,28 +353,50 @@ func TestMembers(t *testing.T) {
 	testCases := map[string]struct {
 		members []foundation.Member
 		valid   bool
+		weight  sdk.Dec
 	}{
 		"valid updates": {
 			members: []foundation.Member{
 				{
 					Address: addrs[0].String(),
+					Weight:  sdk.OneDec(),
 				},
 				{
 					Address: addrs[1].String(),
+					Weight:  sdk.NewDec(2),
 				},
 			},
-			valid: true,
+			valid:  true,
+			weight: sdk.NewDec(3),
 		},
 		"invalid member": {
 			members: []foundation.Member{{}},
 		},
+		"zero weight": {
+			members: []foundation.Member{
+				{
+					Address: addrs[0].String(),
+					Weight:  sdk.ZeroDec(),
+				},
+			},
+		},
+		"negative weight": {
+			members: []foundation.Member{
+				{
+					Address: addrs[0].String(),
+					Weight:  sdk.OneDec().Neg(),
+				},
+			},
+		},
 		"duplicate members": {
 			members: []foundation.Member{
 				{
 					Address: addrs[0].String(),
+					Weight:  sdk.OneDec(),
 				},
 				{
 					Address: addrs[0].String(),
+					Weight:  sdk.OneDec(),
 				},
 			},
 		},
@@ -389,6 +411,8 @@ func TestMembers(t *testing.T) {
 				return
 			}
 			require.NoError(t, err)
+
+			require.Equal(t, tc.weight, members.TotalWeight())
 		})
 	}
 }
@@ -407,6 +431,7 @@ func TestMemberRequests(t *testing.T) {
 			members: []foundation.MemberRequest{
 				{
 					Address: addrs[0].String(),
+					Weight:  sdk.OneDec(),
 				},
 				{
 					Address: addrs[1].String(),
@@ -418,10 +443,19 @@ func TestMemberRequests(t *testing.T) {
 		"invalid member": {
 			members: []foundation.MemberRequest{{}},
 		},
+		"zero weight": {
+			members: []foundation.MemberRequest{
+				{
+					Address: addrs[0].String(),
+					Weight:  sdk.ZeroDec(),
+				},
+			},
+		},
 		"duplicate requests": {
 			members: []foundation.MemberRequest{
 				{
 					Address: addrs[0].String(),
+					Weight:  sdk.OneDec(),
 				},
 				{
 					Address: addrs[0].String(),
@@ -57,6 +57,12 @@ func (data GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error
 		}
 	}
 
+	for _, p := range data.MsgTypeDecisionPolicies {
+		if err := p.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -104,13 +110,13 @@ func ValidateGenesis(data GenesisState) error {
 	// Is x/foundation outsourcing the proposal feature
 	isOutsourcing := info.TotalWeight.IsZero()
 
-	if realWeight := sdk.NewDec(int64(len(data.Members))); !info.TotalWeight.Equal(realWeight) {
-		return sdkerrors.ErrInvalidRequest.Wrapf("total weight not match, %s != %s", info.TotalWeight, realWeight)
-	}
 	members := Members{Members: data.Members}
 	if err := members.ValidateBasic(); err != nil {
 		return err
 	}
+	if realWeight := members.TotalWeight(); !info.TotalWeight.Equal(realWeight) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("total weight not match, %s != %s", info.TotalWeight, realWeight)
+	}
 
 	if isOutsourcing && len(data.Proposals) != 0 {
 		return sdkerrors.ErrInvalidRequest.Wrap("outsourcing policy not allows proposals")
@@ -181,10 +187,75 @@ func ValidateGenesis(data GenesisState) error {
 		}
 	}
 
+	if isOutsourcing && len(data.MsgTypeDecisionPolicies) != 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("outsourcing policy not allows decision policies per message type")
+	}
+	seenPolicyURLs := map[string]bool{}
+	for _, policy := range data.MsgTypeDecisionPolicies {
+		if err := policy.ValidateBasic(); err != nil {
+			return err
+		}
+
+		url := policy.MsgTypeUrl
+		if seenPolicyURLs[url] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate decision policy over %s", url)
+		}
+		seenPolicyURLs[url] = true
+	}
+
+	memberAddrs := map[string]bool{}
+	for _, member := range data.Members {
+		memberAddrs[member.Address] = true
+	}
+	delegates := map[string]string{}
+	for _, delegation := range data.VoteDelegations {
+		if err := delegation.ValidateBasic(); err != nil {
+			return err
+		}
+
+		for _, addr := range []string{delegation.Delegator, delegation.Delegate} {
+			if !memberAddrs[addr] {
+				return sdkerrors.ErrInvalidRequest.Wrapf("%s is not a member", addr)
+			}
+		}
+
+		if _, exists := delegates[delegation.Delegator]; exists {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate vote delegation of %s", delegation.Delegator)
+		}
+		delegates[delegation.Delegator] = delegation.Delegate
+	}
+	for _, delegate := range delegates {
+		if _, exists := delegates[delegate]; exists {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s is both a delegator and a delegate", delegate)
+		}
+	}
+
 	if err := data.Pool.ValidateBasic(); err != nil {
 		return err
 	}
 
+	limitedDenoms := map[string]bool{}
+	for _, limit := range data.TreasuryOutflowLimits {
+		if err := limit.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if limitedDenoms[limit.Denom] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate outflow limit of %s", limit.Denom)
+		}
+		limitedDenoms[limit.Denom] = true
+	}
+
+	for _, outflow := range data.TreasuryOutflows {
+		if err := outflow.Amount.Validate(); err != nil {
+			return err
+		}
+
+		if !limitedDenoms[outflow.Amount.Denom] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("no outflow limit of %s", outflow.Amount.Denom)
+		}
+	}
+
 	return nil
 }
 
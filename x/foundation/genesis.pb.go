@@ -44,6 +44,14 @@ type GenesisState struct {
 	// pool
 	Pool        Pool         `protobuf:"bytes,8,opt,name=pool,proto3" json:"pool"`
 	Censorships []Censorship `protobuf:"bytes,10,rep,name=censorships,proto3" json:"censorships"`
+	// msg_type_decision_policies is the list of the decision policies per message type.
+	MsgTypeDecisionPolicies []MsgTypeDecisionPolicy `protobuf:"bytes,11,rep,name=msg_type_decision_policies,json=msgTypeDecisionPolicies,proto3" json:"msg_type_decision_policies"`
+	// vote_delegations is the list of the vote delegations between the members.
+	VoteDelegations []VoteDelegation `protobuf:"bytes,12,rep,name=vote_delegations,json=voteDelegations,proto3" json:"vote_delegations"`
+	// treasury_outflow_limits is the list of the outflow limits of the treasury.
+	TreasuryOutflowLimits []TreasuryOutflowLimit `protobuf:"bytes,13,rep,name=treasury_outflow_limits,json=treasuryOutflowLimits,proto3" json:"treasury_outflow_limits"`
+	// treasury_outflows is the list of the outflows tracked for the limits.
+	TreasuryOutflows []TreasuryOutflow `protobuf:"bytes,14,rep,name=treasury_outflows,json=treasuryOutflows,proto3" json:"treasury_outflows"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -126,40 +134,48 @@ func init() {
 func init() { proto.RegisterFile("lbm/foundation/v1/genesis.proto", fileDescriptor_c5e13dd78b24d473) }
 
 var fileDescriptor_c5e13dd78b24d473 = []byte{
-	// 517 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0x87, 0x6d, 0xea, 0xe6, 0xcf, 0x15, 0x10, 0x9c, 0x22, 0x71, 0x2d, 0xc2, 0x09, 0x91, 0x90,
-	0xba, 0xc4, 0x26, 0x74, 0x40, 0x94, 0xa1, 0x6a, 0x10, 0x8d, 0x0a, 0x42, 0x8a, 0x52, 0x89, 0x81,
-	0xa5, 0xb2, 0x93, 0x8b, 0x73, 0x22, 0xbe, 0xd7, 0xf2, 0x9d, 0x23, 0x0a, 0x5f, 0x80, 0x91, 0x8f,
-	0xd0, 0x91, 0x15, 0x89, 0x0f, 0x51, 0x31, 0x55, 0x4c, 0x4c, 0x08, 0x25, 0x0b, 0x1f, 0x03, 0xe5,
-	0x7c, 0x6e, 0x1c, 0x62, 0x06, 0x36, 0x5f, 0xde, 0xe7, 0x79, 0xdf, 0xdf, 0x9d, 0xde, 0xa0, 0xfa,
-	0xc4, 0x0f, 0xdd, 0x11, 0x24, 0x7c, 0xe8, 0x49, 0x06, 0xdc, 0x9d, 0xb6, 0xdd, 0x80, 0x72, 0x2a,
-	0x98, 0x70, 0xa2, 0x18, 0x24, 0xe0, 0xdb, 0x13, 0x3f, 0x74, 0x96, 0x80, 0x33, 0x6d, 0xef, 0xd4,
-	0x02, 0x08, 0x40, 0x55, 0xdd, 0xc5, 0x57, 0x0a, 0xee, 0x34, 0xd7, 0x3b, 0xe5, 0xb4, 0x94, 0xd9,
-	0x1e, 0x80, 0x08, 0x41, 0x9c, 0xa6, 0x72, 0x7a, 0xc8, 0x4a, 0x01, 0x40, 0x30, 0xa1, 0xae, 0x3a,
-	0xf9, 0xc9, 0xc8, 0xf5, 0xf8, 0x59, 0x5a, 0x6a, 0x7e, 0xb7, 0xd0, 0xf5, 0x6e, 0x1a, 0xea, 0x44,
-	0x7a, 0x92, 0xe2, 0xc7, 0xa8, 0x14, 0x79, 0xb1, 0x17, 0x0a, 0x62, 0x36, 0xcc, 0xdd, 0xad, 0x47,
-	0xdb, 0xce, 0x5a, 0x48, 0xa7, 0xa7, 0x80, 0x8e, 0x75, 0xf1, 0xb3, 0x6e, 0xf4, 0x35, 0x8e, 0xbb,
-	0x08, 0x2d, 0x29, 0x72, 0x4d, 0xc9, 0xf7, 0x0b, 0xe4, 0xa3, 0xab, 0xd3, 0x31, 0x1f, 0x81, 0x6e,
-	0x92, 0x53, 0xf1, 0x13, 0x54, 0x0e, 0x69, 0xe8, 0xd3, 0x58, 0x90, 0x8d, 0xc6, 0xc6, 0x3f, 0x22,
-	0xbc, 0x52, 0x84, 0xb6, 0x33, 0x1e, 0x3f, 0x44, 0xb5, 0x28, 0xa6, 0x53, 0x06, 0x89, 0x7a, 0x87,
-	0x08, 0x84, 0x37, 0x39, 0x65, 0x43, 0x62, 0x35, 0xcc, 0x5d, 0xab, 0x8f, 0xb3, 0x5a, 0x4f, 0x97,
-	0x8e, 0x87, 0xf8, 0x00, 0x55, 0x33, 0x50, 0x90, 0x4d, 0x35, 0xee, 0x6e, 0xd1, 0x8d, 0x35, 0xa3,
-	0x07, 0x2e, 0x1d, 0xbc, 0x87, 0x36, 0xa7, 0x20, 0xa9, 0x20, 0x25, 0x25, 0xdf, 0x29, 0x90, 0x5f,
-	0x83, 0xa4, 0x5a, 0x4c, 0x59, 0x7c, 0x82, 0x6e, 0x7a, 0x89, 0x1c, 0x43, 0xcc, 0xde, 0x2b, 0x4a,
-	0x90, 0xb2, 0xb2, 0x1f, 0x14, 0xd8, 0xdd, 0xd8, 0xe3, 0xf2, 0x30, 0x4f, 0xeb, 0x5e, 0x7f, 0xb5,
-	0xc0, 0x6d, 0x64, 0x45, 0x00, 0x13, 0x52, 0x51, 0x4f, 0x5f, 0x14, 0xa4, 0x07, 0x90, 0xdd, 0x40,
-	0xa1, 0xf8, 0x39, 0xda, 0x1a, 0x50, 0x2e, 0x20, 0x16, 0x63, 0x16, 0x09, 0x82, 0x54, 0x88, 0x7b,
-	0x05, 0xe6, 0xb3, 0x2b, 0x4a, 0xfb, 0x79, 0x6f, 0xbf, 0xf2, 0xf1, 0xbc, 0x6e, 0xfc, 0x3e, 0xaf,
-	0x1b, 0x2f, 0xac, 0x4a, 0xf5, 0x16, 0x6a, 0x7e, 0x31, 0x11, 0x5e, 0x8f, 0x8d, 0x09, 0x2a, 0x07,
-	0x8b, 0x5f, 0x29, 0x55, 0xbb, 0x55, 0xed, 0x67, 0x47, 0xfc, 0x01, 0xdd, 0x58, 0xb9, 0x8c, 0x5e,
-	0x9f, 0x9a, 0x93, 0x2e, 0xae, 0x93, 0x2d, 0xae, 0x73, 0xc8, 0xcf, 0x3a, 0x07, 0xdf, 0xbe, 0xb6,
-	0x9e, 0x06, 0x4c, 0x8e, 0x13, 0xdf, 0x19, 0x40, 0xe8, 0x1e, 0x31, 0x2e, 0x06, 0x63, 0xe6, 0xb9,
-	0x23, 0xfd, 0xd1, 0x12, 0xc3, 0xb7, 0xee, 0xbb, 0xfc, 0x3f, 0x64, 0x25, 0x47, 0x7f, 0x75, 0xd6,
-	0xbe, 0xb5, 0x48, 0xdf, 0x79, 0xf9, 0x79, 0x66, 0x9b, 0x17, 0x33, 0xdb, 0xbc, 0x9c, 0xd9, 0xe6,
-	0xaf, 0x99, 0x6d, 0x7e, 0x9a, 0xdb, 0xc6, 0xe5, 0xdc, 0x36, 0x7e, 0xcc, 0x6d, 0xe3, 0x4d, 0xeb,
-	0xbf, 0xe6, 0xf9, 0x25, 0x15, 0x78, 0xef, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x52, 0x37, 0xe3,
-	0x2e, 0x02, 0x04, 0x00, 0x00,
+	// 654 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcd, 0x6e, 0xd3, 0x4e,
+	0x14, 0xc5, 0xe3, 0x7f, 0xd3, 0xa6, 0x9d, 0x7e, 0xfc, 0xdb, 0x51, 0x51, 0xdd, 0x22, 0x9c, 0x10,
+	0x09, 0xd1, 0x4d, 0x6d, 0x4a, 0x17, 0x88, 0xb2, 0xa8, 0x5a, 0x4a, 0xab, 0xf2, 0x21, 0xa2, 0xb4,
+	0xb0, 0x60, 0x63, 0x39, 0xc9, 0xc4, 0x19, 0xd5, 0xf6, 0xb5, 0x7c, 0xc7, 0x81, 0xc0, 0x0b, 0xb0,
+	0xe4, 0x11, 0xba, 0x64, 0x8b, 0xc4, 0x43, 0x54, 0xac, 0xca, 0x8e, 0x15, 0x42, 0xe9, 0x86, 0xc7,
+	0x40, 0x1e, 0x8f, 0xdb, 0xa4, 0x75, 0x84, 0xd8, 0x79, 0x7c, 0xcf, 0xef, 0x9c, 0xeb, 0xeb, 0x99,
+	0x21, 0x65, 0xaf, 0xe1, 0x5b, 0x6d, 0x88, 0x83, 0x96, 0x23, 0x38, 0x04, 0x56, 0x77, 0xdd, 0x72,
+	0x59, 0xc0, 0x90, 0xa3, 0x19, 0x46, 0x20, 0x80, 0x2e, 0x78, 0x0d, 0xdf, 0xbc, 0x14, 0x98, 0xdd,
+	0xf5, 0x95, 0x45, 0x17, 0x5c, 0x90, 0x55, 0x2b, 0x79, 0x4a, 0x85, 0x2b, 0xd5, 0xeb, 0x4e, 0x03,
+	0x58, 0xaa, 0x59, 0x6e, 0x02, 0xfa, 0x80, 0x76, 0x0a, 0xa7, 0x8b, 0xac, 0xe4, 0x02, 0xb8, 0x1e,
+	0xb3, 0xe4, 0xaa, 0x11, 0xb7, 0x2d, 0x27, 0xe8, 0xa5, 0xa5, 0xea, 0xf7, 0x12, 0x99, 0xd9, 0x4f,
+	0x9b, 0x3a, 0x14, 0x8e, 0x60, 0xf4, 0x01, 0x99, 0x08, 0x9d, 0xc8, 0xf1, 0x51, 0xd7, 0x2a, 0xda,
+	0xea, 0xf4, 0xfd, 0x65, 0xf3, 0x5a, 0x93, 0x66, 0x4d, 0x0a, 0x76, 0x8a, 0xa7, 0x3f, 0xcb, 0x85,
+	0xba, 0x92, 0xd3, 0x7d, 0x42, 0x2e, 0x55, 0xfa, 0x7f, 0x12, 0xbe, 0x9d, 0x03, 0xef, 0x5d, 0xac,
+	0x0e, 0x82, 0x36, 0x28, 0x93, 0x01, 0x94, 0x3e, 0x24, 0x25, 0x9f, 0xf9, 0x0d, 0x16, 0xa1, 0x3e,
+	0x56, 0x19, 0x1b, 0xd1, 0xc2, 0x0b, 0xa9, 0x50, 0x74, 0xa6, 0xa7, 0xf7, 0xc8, 0x62, 0x18, 0xb1,
+	0x2e, 0x87, 0x58, 0xce, 0x21, 0x04, 0x74, 0x3c, 0x9b, 0xb7, 0xf4, 0x62, 0x45, 0x5b, 0x2d, 0xd6,
+	0x69, 0x56, 0xab, 0xa9, 0xd2, 0x41, 0x8b, 0x6e, 0x91, 0xa9, 0x4c, 0x88, 0xfa, 0xb8, 0x8c, 0xbb,
+	0x99, 0xf7, 0xc5, 0x4a, 0xa3, 0x02, 0x2f, 0x19, 0xba, 0x41, 0xc6, 0xbb, 0x20, 0x18, 0xea, 0x13,
+	0x12, 0x5e, 0xca, 0x81, 0x5f, 0x83, 0x60, 0x0a, 0x4c, 0xb5, 0xf4, 0x90, 0xcc, 0x39, 0xb1, 0xe8,
+	0x40, 0xc4, 0xdf, 0x4b, 0x15, 0xea, 0x25, 0x49, 0xdf, 0xc9, 0xa1, 0xf7, 0x23, 0x27, 0x10, 0xdb,
+	0x83, 0x6a, 0xe5, 0x75, 0xc5, 0x82, 0xae, 0x93, 0x62, 0x08, 0xe0, 0xe9, 0x93, 0x15, 0x6d, 0x44,
+	0x23, 0x35, 0x80, 0xec, 0x0b, 0xa4, 0x94, 0x3e, 0x21, 0xd3, 0x4d, 0x16, 0x20, 0x44, 0xd8, 0xe1,
+	0x21, 0xea, 0x44, 0x36, 0x71, 0x2b, 0x87, 0x7c, 0x7c, 0xa1, 0x52, 0xfc, 0x20, 0x47, 0x8f, 0xc9,
+	0x8a, 0x8f, 0xae, 0x2d, 0x7a, 0x21, 0xb3, 0x5b, 0xac, 0xc9, 0x91, 0x43, 0x60, 0x87, 0xe0, 0xf1,
+	0x26, 0x67, 0xa8, 0x4f, 0x4b, 0xd7, 0xd5, 0xbc, 0x9f, 0x88, 0xee, 0x51, 0x2f, 0x64, 0xbb, 0x0a,
+	0xa9, 0x25, 0x44, 0x4f, 0x05, 0x2c, 0xf9, 0x39, 0x45, 0xce, 0x90, 0xd6, 0xc9, 0x7c, 0x32, 0x44,
+	0xbb, 0xc5, 0x3c, 0xe6, 0xaa, 0xe9, 0xcd, 0x54, 0xc6, 0x46, 0xec, 0xb6, 0x64, 0xf6, 0xbb, 0x17,
+	0x4a, 0xe5, 0xfd, 0x7f, 0x77, 0xe8, 0x2d, 0x52, 0x46, 0x96, 0x44, 0xc4, 0x1c, 0x8c, 0xa3, 0x9e,
+	0x0d, 0xb1, 0x68, 0x7b, 0xf0, 0xd6, 0xf6, 0xb8, 0xcf, 0x05, 0xea, 0xb3, 0xd2, 0xfa, 0x6e, 0x8e,
+	0xf5, 0x91, 0x22, 0x5e, 0xa6, 0xc0, 0xf3, 0x44, 0xaf, 0x02, 0x6e, 0x88, 0x9c, 0x1a, 0xd2, 0x57,
+	0x64, 0xe1, 0x6a, 0x0c, 0xea, 0x73, 0x32, 0xa0, 0xfa, 0xf7, 0x00, 0xe5, 0x3d, 0x7f, 0xc5, 0x1b,
+	0x37, 0x27, 0x3f, 0x9e, 0x94, 0x0b, 0xbf, 0x4f, 0xca, 0x85, 0xa7, 0xc5, 0xc9, 0xa9, 0x79, 0x52,
+	0xfd, 0xa2, 0x11, 0x7a, 0x7d, 0xd7, 0x50, 0x9d, 0x94, 0xdc, 0xe4, 0x2d, 0x63, 0xf2, 0x68, 0x4f,
+	0xd5, 0xb3, 0x25, 0xfd, 0x40, 0x66, 0x87, 0xf6, 0x92, 0x3a, 0xbd, 0x8b, 0x66, 0x7a, 0x6f, 0x98,
+	0xd9, 0xbd, 0x61, 0x6e, 0x07, 0xbd, 0x9d, 0xad, 0x6f, 0x5f, 0xd7, 0x1e, 0xb9, 0x5c, 0x74, 0xe2,
+	0x86, 0xd9, 0x04, 0xdf, 0xda, 0xe3, 0x01, 0x36, 0x3b, 0xdc, 0xb1, 0xda, 0xea, 0x61, 0x0d, 0x5b,
+	0xc7, 0xd6, 0xbb, 0xc1, 0x0b, 0x6a, 0xa8, 0x8f, 0xfa, 0x70, 0xd6, 0x66, 0x31, 0xe9, 0x7e, 0xe7,
+	0xd9, 0xe7, 0xbe, 0xa1, 0x9d, 0xf6, 0x0d, 0xed, 0xac, 0x6f, 0x68, 0xbf, 0xfa, 0x86, 0xf6, 0xe9,
+	0xdc, 0x28, 0x9c, 0x9d, 0x1b, 0x85, 0x1f, 0xe7, 0x46, 0xe1, 0xcd, 0xda, 0x3f, 0xe5, 0x35, 0x26,
+	0x64, 0xc3, 0x1b, 0x7f, 0x06, 0x00, 0x5b, 0x32, 0xd7, 0xa9, 0x81, 0x05, 0x00, 0x00,
 }
 
 func (this *GrantAuthorization) Equal(that interface{}) bool {
@@ -209,6 +225,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TreasuryOutflows) > 0 {
+		for iNdEx := len(m.TreasuryOutflows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TreasuryOutflows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.TreasuryOutflowLimits) > 0 {
+		for iNdEx := len(m.TreasuryOutflowLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TreasuryOutflowLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.VoteDelegations) > 0 {
+		for iNdEx := len(m.VoteDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.MsgTypeDecisionPolicies) > 0 {
+		for iNdEx := len(m.MsgTypeDecisionPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgTypeDecisionPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.Censorships) > 0 {
 		for iNdEx := len(m.Censorships) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -415,6 +487,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.MsgTypeDecisionPolicies) > 0 {
+		for _, e := range m.MsgTypeDecisionPolicies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VoteDelegations) > 0 {
+		for _, e := range m.VoteDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TreasuryOutflowLimits) > 0 {
+		for _, e := range m.TreasuryOutflowLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TreasuryOutflows) > 0 {
+		for _, e := range m.TreasuryOutflows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -758,6 +854,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeDecisionPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeDecisionPolicies = append(m.MsgTypeDecisionPolicies, MsgTypeDecisionPolicy{})
+			if err := m.MsgTypeDecisionPolicies[len(m.MsgTypeDecisionPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteDelegations = append(m.VoteDelegations, VoteDelegation{})
+			if err := m.VoteDelegations[len(m.VoteDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryOutflowLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TreasuryOutflowLimits = append(m.TreasuryOutflowLimits, TreasuryOutflowLimit{})
+			if err := m.TreasuryOutflowLimits[len(m.TreasuryOutflowLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryOutflows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TreasuryOutflows = append(m.TreasuryOutflows, TreasuryOutflow{})
+			if err := m.TreasuryOutflows[len(m.TreasuryOutflows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
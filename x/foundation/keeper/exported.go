@@ -25,9 +25,11 @@ type keeper struct {
 func NewKeeper(
 	cdc codec.Codec,
 	key sdk.StoreKey,
+	tkey sdk.StoreKey,
 	router *baseapp.MsgServiceRouter,
 	authKeeper foundation.AuthKeeper,
 	bankKeeper foundation.BankKeeper,
+	groupKeeper foundation.GroupKeeper,
 	feeCollectorName string,
 	config foundation.Config,
 	authority string,
@@ -37,9 +39,11 @@ func NewKeeper(
 		impl: internal.NewKeeper(
 			cdc,
 			key,
+			tkey,
 			router,
 			authKeeper,
 			bankKeeper,
+			groupKeeper,
 			feeCollectorName,
 			config,
 			authority,
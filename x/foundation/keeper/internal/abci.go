@@ -20,5 +20,9 @@ func BeginBlocker(ctx sdk.Context, k Keeper) {
 
 func EndBlocker(ctx sdk.Context, k Keeper) {
 	k.UpdateTallyOfVPEndProposals(ctx)
+	k.ExecTimelockedProposals(ctx)
+	k.RemoveExpiredMembers(ctx)
 	k.PruneExpiredProposals(ctx)
+	k.PruneExpiredAuthorizations(ctx)
+	k.ArchivePrunedProposals(ctx)
 }
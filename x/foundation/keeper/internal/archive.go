@@ -0,0 +1,71 @@
+package internal
+
+import (
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/Finschia/finschia-sdk/store/dbadapter"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+)
+
+// addProposalToArchiveQueue queues a pruned proposal for the proposal archive.
+// The queue lives in the transient store, so it never reaches the committed
+// state. It is written regardless of the node's configuration, so the gas
+// consumption does not depend on it.
+func (k Keeper) addProposalToArchiveQueue(ctx sdk.Context, proposal foundation.Proposal) {
+	store := ctx.KVStore(k.tStoreKey)
+	key := proposalToArchiveKey(proposal.Id)
+	bz := k.cdc.MustMarshal(&proposal)
+	store.Set(key, bz)
+}
+
+// ArchivePrunedProposals moves the proposals pruned in this block into the
+// proposal archive, if the node has enabled it.
+func (k Keeper) ArchivePrunedProposals(ctx sdk.Context) {
+	archive := k.config.ProposalArchive
+	if archive == nil {
+		return
+	}
+
+	store := ctx.KVStore(k.tStoreKey)
+	iterator := sdk.KVStorePrefixIterator(store, proposalToArchiveKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var proposal foundation.Proposal
+		k.cdc.MustUnmarshal(iterator.Value(), &proposal)
+
+		if err := archiveProposal(archive, proposal, iterator.Value()); err != nil {
+			k.Logger(ctx).Error("failed to archive proposal", "proposal_id", proposal.Id, "error", err)
+		}
+	}
+}
+
+// archiveProposal writes a proposal and its indexes into the archive at once.
+// The archived proposals never change, so their indexes are written only once.
+func archiveProposal(archive dbm.DB, proposal foundation.Proposal, bz []byte) error {
+	batch := archive.NewBatch()
+	defer batch.Close()
+
+	for _, indexKey := range proposalIndexKeys(proposal) {
+		if err := batch.Set(indexKey, []byte{}); err != nil {
+			return err
+		}
+	}
+	if err := batch.Set(proposalKey(proposal.Id), bz); err != nil {
+		return err
+	}
+
+	return batch.Write()
+}
+
+// archiveStore returns the store of the archive, if enabled. It has the
+// same layout of the proposals and their indexes as the module store.
+func (k Keeper) archiveStore() (sdk.KVStore, bool) {
+	archive := k.config.ProposalArchive
+	if archive == nil {
+		return nil, false
+	}
+
+	return dbadapter.Store{DB: archive}, true
+}
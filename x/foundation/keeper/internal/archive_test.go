@@ -0,0 +1,197 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/Finschia/finschia-sdk/crypto/keys/secp256k1"
+	"github.com/Finschia/finschia-sdk/simapp"
+	"github.com/Finschia/finschia-sdk/testutil/testdata"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+	"github.com/Finschia/finschia-sdk/x/foundation/keeper/internal"
+)
+
+func TestArchivePrunedProposals(t *testing.T) {
+	checkTx := false
+	app := simapp.Setup(checkTx)
+	testdata.RegisterInterfaces(app.InterfaceRegistry())
+	testdata.RegisterMsgServer(app.MsgServiceRouter(), testdata.MsgServerImpl{})
+
+	ctx := app.BaseApp.NewContext(checkTx, tmproto.Header{})
+	newKeeper := func(archive dbm.DB) internal.Keeper {
+		config := foundation.DefaultConfig()
+		config.ProposalArchive = archive
+
+		return internal.NewKeeper(
+			app.AppCodec(),
+			app.GetKey(foundation.ModuleName),
+			app.GetTKey(foundation.TStoreKey),
+			app.MsgServiceRouter(),
+			app.AccountKeeper,
+			app.BankKeeper,
+			app.GroupKeeper,
+			authtypes.FeeCollectorName,
+			config,
+			foundation.DefaultAuthority().String(),
+			app.GetSubspace(foundation.ModuleName),
+		)
+	}
+	impl := newKeeper(dbm.NewMemDB())
+	queryServer := internal.NewQueryServer(impl)
+
+	member := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	impl.SetMember(ctx, foundation.Member{
+		Address: member.String(),
+		Weight:  sdk.OneDec(),
+	})
+
+	info := foundation.DefaultFoundation()
+	info.TotalWeight = sdk.OneDec()
+	err := info.SetDecisionPolicy(workingPolicy())
+	require.NoError(t, err)
+	impl.SetFoundationInfo(ctx, info)
+
+	submitProposal := func(name string) uint64 {
+		id, err := impl.SubmitProposal(ctx, []string{member.String()}, "", []sdk.Msg{newMsgCreateDog(name)}, nil, 0)
+		require.NoError(t, err)
+		return *id
+	}
+
+	archived := func(req foundation.QueryArchivedProposalsRequest) []foundation.Proposal {
+		res, err := queryServer.ArchivedProposals(sdk.WrapSDKContext(ctx), &req)
+		require.NoError(t, err)
+		return res.Proposals
+	}
+
+	submitTime := ctx.BlockTime()
+
+	// executed in a tx
+	executedProposal := submitProposal("shiba1")
+	err = impl.Vote(ctx, foundation.Vote{
+		ProposalId: executedProposal,
+		Voter:      member.String(),
+		Option:     foundation.VOTE_OPTION_YES,
+	})
+	require.NoError(t, err)
+	err = impl.Exec(ctx, executedProposal)
+	require.NoError(t, err)
+
+	// pruned at the end of the voting period
+	withdrawnProposal := submitProposal("shiba2")
+	err = impl.WithdrawProposal(ctx, withdrawnProposal)
+	require.NoError(t, err)
+
+	// pruned at the end of the max execution period
+	rejectedProposal := submitProposal("shiba3")
+	err = impl.Vote(ctx, foundation.Vote{
+		ProposalId: rejectedProposal,
+		Voter:      member.String(),
+		Option:     foundation.VOTE_OPTION_NO,
+	})
+	require.NoError(t, err)
+
+	// the archive is written at the end of the block
+	require.Empty(t, archived(foundation.QueryArchivedProposalsRequest{}))
+	internal.EndBlocker(ctx, impl)
+	proposals := archived(foundation.QueryArchivedProposalsRequest{})
+	require.Len(t, proposals, 1)
+	require.Equal(t, executedProposal, proposals[0].Id)
+	require.Equal(t, foundation.PROPOSAL_STATUS_ACCEPTED, proposals[0].Status)
+	require.Equal(t, foundation.PROPOSAL_EXECUTOR_RESULT_SUCCESS, proposals[0].ExecutorResult)
+	require.Equal(t, sdk.OneDec(), proposals[0].FinalTallyResult.YesCount)
+
+	votingPeriod := workingPolicy().GetVotingPeriod()
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(votingPeriod))
+	internal.EndBlocker(ctx, impl)
+	require.Len(t, archived(foundation.QueryArchivedProposalsRequest{}), 2)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(foundation.DefaultConfig().MaxExecutionPeriod))
+	internal.EndBlocker(ctx, impl)
+	require.Empty(t, impl.GetProposals(ctx))
+	require.Len(t, archived(foundation.QueryArchivedProposalsRequest{}), 3)
+
+	// filter the archived proposals
+	for name, tc := range map[string]struct {
+		req foundation.QueryArchivedProposalsRequest
+		ids []uint64
+	}{
+		"by status": {
+			req: foundation.QueryArchivedProposalsRequest{Status: foundation.PROPOSAL_STATUS_WITHDRAWN},
+			ids: []uint64{withdrawnProposal},
+		},
+		"by status, rejected": {
+			req: foundation.QueryArchivedProposalsRequest{Status: foundation.PROPOSAL_STATUS_REJECTED},
+			ids: []uint64{rejectedProposal},
+		},
+		"by proposer": {
+			req: foundation.QueryArchivedProposalsRequest{Proposer: member.String()},
+			ids: []uint64{executedProposal, withdrawnProposal, rejectedProposal},
+		},
+		"by submit time": {
+			req: foundation.QueryArchivedProposalsRequest{SubmitTimeFrom: &submitTime},
+			ids: []uint64{executedProposal, withdrawnProposal, rejectedProposal},
+		},
+		"by submit time, no proposals": {
+			req: foundation.QueryArchivedProposalsRequest{SubmitTimeTo: &submitTime},
+			ids: []uint64{},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			proposals := archived(tc.req)
+			ids := make([]uint64, len(proposals))
+			for i, proposal := range proposals {
+				ids[i] = proposal.Id
+			}
+			require.Equal(t, tc.ids, ids)
+		})
+	}
+
+	// the nodes without the archive
+	_, err = internal.NewQueryServer(newKeeper(nil)).ArchivedProposals(sdk.WrapSDKContext(ctx), &foundation.QueryArchivedProposalsRequest{})
+	require.Error(t, err)
+
+	// the gas consumption does not depend on the archive
+	execGas := func(impl internal.Keeper) uint64 {
+		ctx, _ := ctx.CacheContext()
+		id, err := impl.SubmitProposal(ctx, []string{member.String()}, "", []sdk.Msg{newMsgCreateDog("shiba4")}, nil, 0)
+		require.NoError(t, err)
+		err = impl.Vote(ctx, foundation.Vote{
+			ProposalId: *id,
+			Voter:      member.String(),
+			Option:     foundation.VOTE_OPTION_YES,
+		})
+		require.NoError(t, err)
+
+		ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		err = impl.Exec(ctx, *id)
+		require.NoError(t, err)
+		return ctx.GasMeter().GasConsumed()
+	}
+	require.Equal(t, execGas(impl), execGas(newKeeper(nil)))
+
+	// the state does not depend on the archive
+	execState := func(impl internal.Keeper) map[string][]byte {
+		ctx, _ := ctx.CacheContext()
+		id, err := impl.SubmitProposal(ctx, []string{member.String()}, "", []sdk.Msg{newMsgCreateDog("shiba5")}, nil, 0)
+		require.NoError(t, err)
+		err = impl.WithdrawProposal(ctx, *id)
+		require.NoError(t, err)
+
+		ctx = ctx.WithBlockTime(ctx.BlockTime().Add(votingPeriod))
+		internal.EndBlocker(ctx, impl)
+
+		state := map[string][]byte{}
+		iterator := ctx.KVStore(app.GetKey(foundation.StoreKey)).Iterator(nil, nil)
+		defer iterator.Close()
+		for ; iterator.Valid(); iterator.Next() {
+			state[string(iterator.Key())] = iterator.Value()
+		}
+		return state
+	}
+	require.Equal(t, execState(impl), execState(newKeeper(nil)))
+}
@@ -1,6 +1,8 @@
 package internal
 
 import (
+	"time"
+
 	sdk "github.com/Finschia/finschia-sdk/types"
 	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 	"github.com/Finschia/finschia-sdk/x/foundation"
@@ -82,6 +84,10 @@ func (k Keeper) Grant(ctx sdk.Context, grantee sdk.AccAddress, authorization fou
 		return sdkerrors.ErrInvalidRequest.Wrapf("authorization for %s already exists", msgTypeURL)
 	}
 
+	if expiration := getExpiration(authorization); expiration != nil && !expiration.After(ctx.BlockTime()) {
+		return sdkerrors.ErrInvalidRequest.Wrap("expiration must be after the current block time")
+	}
+
 	k.setAuthorization(ctx, grantee, authorization)
 
 	any, err := foundation.SetAuthorization(authorization)
@@ -158,12 +164,70 @@ func (k Keeper) setAuthorization(ctx sdk.Context, grantee sdk.AccAddress, author
 		panic(err)
 	}
 	store.Set(key, bz)
+
+	if expiration := getExpiration(authorization); expiration != nil {
+		store.Set(grantByExpirationKey(*expiration, grantee, authorization.MsgTypeURL()), []byte{})
+	}
 }
 
 func (k Keeper) deleteAuthorization(ctx sdk.Context, grantee sdk.AccAddress, msgTypeURL string) {
+	authorization, err := k.GetAuthorization(ctx, grantee, msgTypeURL)
+	if err != nil {
+		return
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	key := grantKey(grantee, msgTypeURL)
 	store.Delete(key)
+
+	if expiration := getExpiration(authorization); expiration != nil {
+		store.Delete(grantByExpirationKey(*expiration, grantee, msgTypeURL))
+	}
+}
+
+func getExpiration(authorization foundation.Authorization) *time.Time {
+	expirable, ok := authorization.(foundation.ExpirableAuthorization)
+	if !ok {
+		return nil
+	}
+
+	return expirable.GetExpiration()
+}
+
+// PruneExpiredAuthorizations prunes all authorizations which are expired,
+// i.e. whose expiration is smaller than (or equal to) now.
+func (k Keeper) PruneExpiredAuthorizations(ctx sdk.Context) {
+	type grant struct {
+		grantee    sdk.AccAddress
+		msgTypeURL string
+	}
+
+	var pruning []grant
+	k.iterateAuthorizationsByExpiration(ctx, ctx.BlockTime(), func(grantee sdk.AccAddress, msgTypeURL string) (stop bool) {
+		pruning = append(pruning, grant{
+			grantee:    grantee,
+			msgTypeURL: msgTypeURL,
+		})
+		return false
+	})
+
+	for _, grant := range pruning {
+		k.deleteAuthorization(ctx, grant.grantee, grant.msgTypeURL)
+	}
+}
+
+func (k Keeper) iterateAuthorizationsByExpiration(ctx sdk.Context, endTime time.Time, fn func(grantee sdk.AccAddress, msgTypeURL string) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(grantByExpirationKeyPrefix, sdk.PrefixEndBytes(append(grantByExpirationKeyPrefix, sdk.FormatTimeBytes(endTime)...)))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		_, grantee, msgTypeURL := splitGrantByExpirationKey(iter.Key())
+
+		if stop := fn(grantee, msgTypeURL); stop {
+			break
+		}
+	}
 }
 
 func (k Keeper) Accept(ctx sdk.Context, grantee sdk.AccAddress, msg sdk.Msg) error {
@@ -1,6 +1,8 @@
 package internal_test
 
 import (
+	"time"
+
 	sdk "github.com/Finschia/finschia-sdk/types"
 	"github.com/Finschia/finschia-sdk/x/foundation"
 )
@@ -81,6 +83,15 @@ func (s *KeeperTestSuite) TestGrant() {
 			grantee: s.stranger,
 			auth:    &foundation.ReceiveFromTreasuryAuthorization{},
 		},
+		"already expired": {
+			grantee: s.members[0],
+			auth: &foundation.ReceiveFromTreasuryAuthorization{
+				Expiration: func() *time.Time {
+					expiration := s.ctx.BlockTime()
+					return &expiration
+				}(),
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -171,6 +182,20 @@ func (s *KeeperTestSuite) TestAccept() {
 				Amount:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())),
 			},
 		},
+		"spend limit exceeded": {
+			malleate: func(ctx sdk.Context) {
+				err := s.impl.Grant(ctx, s.members[0], &foundation.ReceiveFromTreasuryAuthorization{
+					SpendLimit: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())),
+				})
+				s.Require().NoError(err)
+			},
+			grantee: s.members[0],
+			msg: &foundation.MsgWithdrawFromTreasury{
+				Authority: s.authority.String(),
+				To:        s.members[0].String(),
+				Amount:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(2))),
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -189,3 +214,81 @@ func (s *KeeperTestSuite) TestAccept() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestAcceptSpendLimit() {
+	ctx, _ := s.ctx.CacheContext()
+
+	grantee := s.members[0]
+	msgTypeURL := foundation.ReceiveFromTreasuryAuthorization{}.MsgTypeURL()
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+	withdraw := func(amount int64) sdk.Msg {
+		return &foundation.MsgWithdrawFromTreasury{
+			Authority: s.authority.String(),
+			To:        grantee.String(),
+			Amount:    coins(amount),
+		}
+	}
+
+	err := s.impl.Grant(ctx, grantee, &foundation.ReceiveFromTreasuryAuthorization{
+		SpendLimit: coins(10),
+	})
+	s.Require().NoError(err)
+
+	// decrease the budget
+	err = s.impl.Accept(ctx, grantee, withdraw(7))
+	s.Require().NoError(err)
+
+	authorization, err := s.impl.GetAuthorization(ctx, grantee, msgTypeURL)
+	s.Require().NoError(err)
+	s.Require().Equal(coins(3), authorization.(*foundation.ReceiveFromTreasuryAuthorization).SpendLimit)
+
+	// exhaust the budget
+	err = s.impl.Accept(ctx, grantee, withdraw(3))
+	s.Require().NoError(err)
+
+	_, err = s.impl.GetAuthorization(ctx, grantee, msgTypeURL)
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestPruneExpiredAuthorizations() {
+	ctx, _ := s.ctx.CacheContext()
+
+	msgTypeURL := foundation.ReceiveFromTreasuryAuthorization{}.MsgTypeURL()
+	expiration := ctx.BlockTime().Add(time.Hour)
+	grantees := map[string]foundation.Authorization{
+		s.members[0].String(): &foundation.ReceiveFromTreasuryAuthorization{
+			Expiration: &expiration,
+		},
+		s.members[1].String(): &foundation.PeriodicReceiveFromTreasuryAuthorization{
+			Basic: foundation.ReceiveFromTreasuryAuthorization{
+				Expiration: &expiration,
+			},
+			Period:           time.Minute,
+			PeriodSpendLimit: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())),
+		},
+	}
+	for grantee, authorization := range grantees {
+		err := s.impl.Grant(ctx, sdk.MustAccAddressFromBech32(grantee), authorization)
+		s.Require().NoError(err)
+	}
+
+	// not expired yet
+	s.impl.PruneExpiredAuthorizations(ctx.WithBlockTime(expiration.Add(-time.Nanosecond)))
+	for grantee := range grantees {
+		_, err := s.impl.GetAuthorization(ctx, sdk.MustAccAddressFromBech32(grantee), msgTypeURL)
+		s.Require().NoError(err)
+	}
+
+	// expired
+	s.impl.PruneExpiredAuthorizations(ctx.WithBlockTime(expiration))
+	for grantee := range grantees {
+		_, err := s.impl.GetAuthorization(ctx, sdk.MustAccAddressFromBech32(grantee), msgTypeURL)
+		s.Require().Error(err)
+	}
+
+	// authorizations without expiration must survive
+	_, err := s.impl.GetAuthorization(ctx, s.stranger, msgTypeURL)
+	s.Require().NoError(err)
+}
@@ -0,0 +1,144 @@
+package internal
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+)
+
+// UpdateMsgTypeDecisionPolicy updates the decision policy of a message type.
+// The nil policy removes the policy of the message type, so the foundation's
+// decision policy would be applied to the message type.
+func (k Keeper) UpdateMsgTypeDecisionPolicy(ctx sdk.Context, msgTypeURL string, policy foundation.DecisionPolicy) error {
+	if policy == nil {
+		if _, err := k.GetMsgTypeDecisionPolicy(ctx, msgTypeURL); err != nil {
+			return err
+		}
+		k.deleteMsgTypeDecisionPolicy(ctx, msgTypeURL)
+	} else {
+		msgTypePolicy := foundation.MsgTypeDecisionPolicy{
+			MsgTypeUrl: msgTypeURL,
+		}
+		if err := msgTypePolicy.SetDecisionPolicy(policy); err != nil {
+			return err
+		}
+		k.SetMsgTypeDecisionPolicy(ctx, msgTypePolicy)
+	}
+
+	info := k.GetFoundationInfo(ctx)
+	info.Version++
+	k.SetFoundationInfo(ctx, info)
+
+	if policy != nil {
+		if err := policy.Validate(info, k.config); err != nil {
+			return err
+		}
+	}
+
+	// invalidate active proposals
+	k.abortOldProposals(ctx)
+
+	return nil
+}
+
+func (k Keeper) GetMsgTypeDecisionPolicy(ctx sdk.Context, msgTypeURL string) (*foundation.MsgTypeDecisionPolicy, error) {
+	store := ctx.KVStore(k.storeKey)
+	key := msgTypeDecisionPolicyKey(msgTypeURL)
+	bz := store.Get(key)
+	if bz == nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("no decision policy for %s", msgTypeURL)
+	}
+
+	var policy foundation.MsgTypeDecisionPolicy
+	k.cdc.MustUnmarshal(bz, &policy)
+
+	return &policy, nil
+}
+
+func (k Keeper) SetMsgTypeDecisionPolicy(ctx sdk.Context, policy foundation.MsgTypeDecisionPolicy) {
+	store := ctx.KVStore(k.storeKey)
+	key := msgTypeDecisionPolicyKey(policy.MsgTypeUrl)
+
+	bz := k.cdc.MustMarshal(&policy)
+	store.Set(key, bz)
+}
+
+func (k Keeper) deleteMsgTypeDecisionPolicy(ctx sdk.Context, msgTypeURL string) {
+	store := ctx.KVStore(k.storeKey)
+	key := msgTypeDecisionPolicyKey(msgTypeURL)
+	store.Delete(key)
+}
+
+func (k Keeper) iterateMsgTypeDecisionPolicies(ctx sdk.Context, fn func(policy foundation.MsgTypeDecisionPolicy) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, msgTypeDecisionPolicyKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var policy foundation.MsgTypeDecisionPolicy
+		k.cdc.MustUnmarshal(iterator.Value(), &policy)
+
+		if stop := fn(policy); stop {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetMsgTypeDecisionPolicies(ctx sdk.Context) []foundation.MsgTypeDecisionPolicy {
+	var policies []foundation.MsgTypeDecisionPolicy
+	k.iterateMsgTypeDecisionPolicies(ctx, func(policy foundation.MsgTypeDecisionPolicy) (stop bool) {
+		policies = append(policies, policy)
+
+		return false
+	})
+	return policies
+}
+
+// validateDecisionPolicies validates the foundation's decision policy and
+// the decision policies of all the message types.
+func (k Keeper) validateDecisionPolicies(ctx sdk.Context, info foundation.FoundationInfo) error {
+	if err := info.GetDecisionPolicy().Validate(info, k.config); err != nil {
+		return err
+	}
+
+	var errIter error
+	k.iterateMsgTypeDecisionPolicies(ctx, func(policy foundation.MsgTypeDecisionPolicy) (stop bool) {
+		if err := policy.GetDecisionPolicy().Validate(info, k.config); err != nil {
+			errIter = sdkerrors.Wrapf(err, "decision policy for %s", policy.MsgTypeUrl)
+			return true
+		}
+
+		return false
+	})
+
+	return errIter
+}
+
+// getDecisionPolicies returns the decision policies applied to the messages.
+// The foundation's decision policy is applied to the messages without any
+// decision policy of their types.
+func (k Keeper) getDecisionPolicies(ctx sdk.Context, info foundation.FoundationInfo, msgs []sdk.Msg) []foundation.DecisionPolicy {
+	var policies []foundation.DecisionPolicy
+	seenURLs := map[string]bool{}
+	fallback := len(msgs) == 0
+	for _, msg := range msgs {
+		url := sdk.MsgTypeURL(msg)
+		if seenURLs[url] {
+			continue
+		}
+		seenURLs[url] = true
+
+		policy, err := k.GetMsgTypeDecisionPolicy(ctx, url)
+		if err != nil {
+			fallback = true
+			continue
+		}
+		policies = append(policies, policy.GetDecisionPolicy())
+	}
+
+	if fallback {
+		policies = append(policies, info.GetDecisionPolicy())
+	}
+
+	return policies
+}
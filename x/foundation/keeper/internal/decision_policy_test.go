@@ -0,0 +1,121 @@
+package internal_test
+
+import (
+	"time"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+)
+
+func (s *KeeperTestSuite) TestUpdateMsgTypeDecisionPolicy() {
+	config := foundation.DefaultConfig()
+	msgTypeURL := sdk.MsgTypeURL((*foundation.MsgWithdrawFromTreasury)(nil))
+	testCases := map[string]struct {
+		preset bool
+		policy foundation.DecisionPolicy
+		valid  bool
+	}{
+		"valid policy": {
+			policy: &foundation.ThresholdDecisionPolicy{
+				Threshold: sdk.NewDec(3),
+				Windows: &foundation.DecisionPolicyWindows{
+					VotingPeriod: time.Hour,
+				},
+			},
+			valid: true,
+		},
+		"invalid policy (invalid min execution period)": {
+			policy: &foundation.ThresholdDecisionPolicy{
+				Threshold: sdk.OneDec(),
+				Windows: &foundation.DecisionPolicyWindows{
+					VotingPeriod:       time.Hour,
+					MinExecutionPeriod: time.Hour + config.MaxExecutionPeriod,
+				},
+			},
+		},
+		"remove the policy": {
+			preset: true,
+			valid:  true,
+		},
+		"remove the policy not found": {},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			if tc.preset {
+				err := s.impl.UpdateMsgTypeDecisionPolicy(ctx, msgTypeURL, workingPolicy())
+				s.Require().NoError(err)
+			}
+
+			err := s.impl.UpdateMsgTypeDecisionPolicy(ctx, msgTypeURL, tc.policy)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			policy, err := s.impl.GetMsgTypeDecisionPolicy(ctx, msgTypeURL)
+			if tc.policy == nil {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.policy, policy.GetDecisionPolicy())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgTypeDecisionPolicyOnProposals() {
+	ctx, _ := s.ctx.CacheContext()
+
+	// the policy for the withdrawal requires three yes votes, while the
+	// foundation's policy requires only one yes vote.
+	votingPeriod := 14 * 24 * time.Hour
+	err := s.impl.UpdateMsgTypeDecisionPolicy(ctx, sdk.MsgTypeURL((*foundation.MsgWithdrawFromTreasury)(nil)), &foundation.ThresholdDecisionPolicy{
+		Threshold: sdk.NewDec(3),
+		Windows: &foundation.DecisionPolicyWindows{
+			VotingPeriod: votingPeriod,
+		},
+	})
+	s.Require().NoError(err)
+
+	// the strictest policy applies to the proposal
+	id, err := s.impl.SubmitProposal(ctx, []string{s.members[0].String()}, "", []sdk.Msg{
+		newMsgCreateDog("shiba"),
+		&foundation.MsgWithdrawFromTreasury{
+			Authority: s.authority.String(),
+			To:        s.stranger.String(),
+			Amount:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())),
+		},
+	}, nil, 0)
+	s.Require().NoError(err)
+
+	proposal, err := s.impl.GetProposal(ctx, *id)
+	s.Require().NoError(err)
+	s.Require().Equal(ctx.BlockTime().Add(votingPeriod), proposal.VotingPeriodEnd)
+
+	for i, member := range s.members[:3] {
+		err := s.impl.Vote(ctx, foundation.Vote{
+			ProposalId: *id,
+			Voter:      member.String(),
+			Option:     foundation.VOTE_OPTION_YES,
+		})
+		s.Require().NoError(err)
+
+		err = s.impl.Exec(ctx, *id)
+		s.Require().NoError(err)
+
+		proposal, err := s.impl.GetProposal(ctx, *id)
+		if i < 2 {
+			// the foundation's policy allows, but the policy for the withdrawal does not
+			s.Require().NoError(err)
+			s.Require().Equal(foundation.PROPOSAL_STATUS_SUBMITTED, proposal.Status)
+			continue
+		}
+
+		// executed and pruned
+		s.Require().Error(err)
+	}
+}
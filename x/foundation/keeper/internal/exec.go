@@ -1,13 +1,31 @@
 package internal
 
 import (
+	"context"
 	"fmt"
 
+	abci "github.com/tendermint/tendermint/abci/types"
+
 	sdk "github.com/Finschia/finschia-sdk/types"
 	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 	"github.com/Finschia/finschia-sdk/x/foundation"
 )
 
+// executingProposalKey is the context key of the id of the proposal whose
+// messages are being executed.
+type executingProposalKey struct{}
+
+func withExecutingProposal(ctx sdk.Context, proposalID uint64) sdk.Context {
+	return ctx.WithContext(context.WithValue(ctx.Context(), executingProposalKey{}, proposalID))
+}
+
+// executingProposal returns the id of the proposal whose messages are being
+// executed, if any.
+func executingProposal(ctx sdk.Context) (uint64, bool) {
+	id, ok := ctx.Context().Value(executingProposalKey{}).(uint64)
+	return id, ok
+}
+
 // ensureMsgAuthz checks that if a message requires signers that all of them are equal to the given account address of the authority.
 func ensureMsgAuthz(msgs []sdk.Msg, authority sdk.AccAddress) error {
 	for _, msg := range msgs {
@@ -35,6 +53,13 @@ func (k Keeper) Exec(ctx sdk.Context, proposalID uint64) error {
 		return sdkerrors.ErrInvalidRequest.Wrapf("not possible with proposal status: %s", proposal.Status)
 	}
 
+	if proposal.ExecuteAfter != nil && ctx.BlockTime().Before(*proposal.ExecuteAfter) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("proposal is timelocked until %s", proposal.ExecuteAfter)
+	}
+	if proposal.ExecuteAfterHeight != 0 && uint64(ctx.BlockHeight()) < proposal.ExecuteAfterHeight {
+		return sdkerrors.ErrInvalidRequest.Wrapf("proposal is timelocked until height %d", proposal.ExecuteAfterHeight)
+	}
+
 	if proposal.Status == foundation.PROPOSAL_STATUS_SUBMITTED {
 		if err := k.doTallyAndUpdate(ctx, proposal); err != nil {
 			return err
@@ -49,7 +74,7 @@ func (k Keeper) Exec(ctx sdk.Context, proposalID uint64) error {
 		// Caching context so that we don't update the store in case of failure.
 		cacheCtx, flush := ctx.CacheContext()
 
-		if results, err := k.doExecuteMsgs(cacheCtx, *proposal); err != nil {
+		if results, err := k.doExecuteMsgs(withExecutingProposal(cacheCtx, proposal.Id), proposal.GetMsgs()); err != nil {
 			proposal.ExecutorResult = foundation.PROPOSAL_EXECUTOR_RESULT_FAILURE
 			logs = fmt.Sprintf("proposal execution failed on proposal %d, because of error %s", proposalID, err.Error())
 			logger.Info("proposal execution failed", "cause", err, "proposalID", proposal.Id)
@@ -82,9 +107,130 @@ func (k Keeper) Exec(ctx sdk.Context, proposalID uint64) error {
 	return nil
 }
 
+// ExecTimelockedProposals executes all the accepted proposals whose
+// `execute_after` or `execute_after_height` has come. The proposals not decided
+// yet remain in the queues, so they would be executed once they get accepted.
+// It must not panic, so the failed ones would be recorded and left to MsgExec.
+func (k Keeper) ExecTimelockedProposals(ctx sdk.Context) {
+	var proposals []foundation.Proposal
+	k.iterateProposalsByExecuteAfter(ctx, ctx.BlockTime(), func(proposal foundation.Proposal) (stop bool) {
+		proposals = append(proposals, proposal)
+		return false
+	})
+	k.iterateProposalsByExecuteAfterHeight(ctx, uint64(ctx.BlockHeight()), func(proposal foundation.Proposal) (stop bool) {
+		proposals = append(proposals, proposal)
+		return false
+	})
+
+	for _, proposal := range proposals {
+		proposal := proposal
+
+		if proposal.Status == foundation.PROPOSAL_STATUS_SUBMITTED {
+			if err := k.doTallyAndUpdate(ctx, &proposal); err != nil {
+				// it remains in the queue, because it may be tallied later
+				k.Logger(ctx).Info("timelocked proposal tally failed", "cause", err, "proposalID", proposal.Id)
+				continue
+			}
+			if proposal.Status == foundation.PROPOSAL_STATUS_SUBMITTED {
+				continue
+			}
+			k.setProposal(ctx, proposal)
+		}
+
+		// execute the proposal only once, the failed one can be retried by MsgExec
+		k.removeProposalFromExecuteAfterQueue(ctx, proposal)
+		k.removeProposalFromExecuteAfterHeightQueue(ctx, proposal)
+		if proposal.Status != foundation.PROPOSAL_STATUS_ACCEPTED ||
+			proposal.ExecutorResult != foundation.PROPOSAL_EXECUTOR_RESULT_NOT_RUN {
+			continue
+		}
+
+		if err := k.execTimelockedProposal(ctx, proposal.Id); err != nil {
+			k.failTimelockedProposal(ctx, proposal, err)
+		}
+	}
+}
+
+// execTimelockedProposal executes a proposal in a cached context with a
+// bounded gas meter. A panic in the execution, including out of gas, is
+// returned as an error, so it would not halt the chain.
+func (k Keeper) execTimelockedProposal(ctx sdk.Context, proposalID uint64) (err error) {
+	cacheCtx, flush := ctx.CacheContext()
+	gasMeter := sdk.NewGasMeter(k.config.MaxTimelockedExecutionGas)
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter).WithEventManager(sdk.NewEventManager())
+
+	defer func() {
+		if r := recover(); r != nil {
+			switch rType := r.(type) {
+			case sdk.ErrorOutOfGas:
+				err = sdkerrors.ErrOutOfGas.Wrapf("out of gas in location: %v; gasLimit: %d", rType.Descriptor, gasMeter.Limit())
+			default:
+				err = sdkerrors.ErrPanic.Wrapf("%v", r)
+			}
+		}
+	}()
+
+	if err := k.Exec(cacheCtx, proposalID); err != nil {
+		return err
+	}
+
+	flush()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return nil
+}
+
+// failTimelockedProposal records the failure of an accepted timelocked
+// proposal, whose execution has panicked or returned an error. It would not
+// be executed automatically again.
+func (k Keeper) failTimelockedProposal(ctx sdk.Context, proposal foundation.Proposal, cause error) {
+	k.Logger(ctx).Info("timelocked proposal execution failed", "cause", cause, "proposalID", proposal.Id)
+
+	proposal.ExecutorResult = foundation.PROPOSAL_EXECUTOR_RESULT_FAILURE
+	k.setProposal(ctx, proposal)
+
+	if err := ctx.EventManager().EmitTypedEvent(&foundation.EventExec{
+		ProposalId: proposal.Id,
+		Logs:       fmt.Sprintf("proposal execution failed on proposal %d, because of error %s", proposal.Id, cause.Error()),
+		Result:     proposal.ExecutorResult,
+	}); err != nil {
+		panic(err)
+	}
+}
+
+// simulateProposal executes the messages of a proposal in a cached context,
+// so the state would not be changed. A panic in the execution, including out
+// of gas, is returned as an error, so it would not break the query.
+func (k Keeper) simulateProposal(ctx sdk.Context, proposal foundation.Proposal) (results []sdk.Result, events []abci.Event, err error) {
+	cacheCtx, _ := ctx.CacheContext()
+	gasMeter := sdk.NewGasMeter(k.config.MaxSimulationGas)
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter).WithEventManager(sdk.NewEventManager())
+
+	defer func() {
+		if r := recover(); r != nil {
+			results, events = nil, nil
+			switch rType := r.(type) {
+			case sdk.ErrorOutOfGas:
+				err = sdkerrors.ErrOutOfGas.Wrapf("out of gas in location: %v; gasLimit: %d", rType.Descriptor, gasMeter.Limit())
+			default:
+				err = sdkerrors.ErrPanic.Wrapf("%v", r)
+			}
+		}
+	}()
+
+	results, err = k.doExecuteMsgs(cacheCtx, proposal.GetMsgs())
+
+	events = cacheCtx.EventManager().ABCIEvents()
+	for _, result := range results {
+		events = append(events, result.Events...)
+	}
+
+	return results, events, err
+}
+
 // doExecuteMsgs routes the messages to the registered handlers.
-func (k Keeper) doExecuteMsgs(ctx sdk.Context, proposal foundation.Proposal) ([]sdk.Result, error) {
-	msgs := proposal.GetMsgs()
+// On failure, it returns the results of the messages executed before the failed one.
+func (k Keeper) doExecuteMsgs(ctx sdk.Context, msgs []sdk.Msg) ([]sdk.Result, error) {
 	results := make([]sdk.Result, len(msgs))
 
 	authority := sdk.MustAccAddressFromBech32(k.GetAuthority())
@@ -99,7 +245,7 @@ func (k Keeper) doExecuteMsgs(ctx sdk.Context, proposal foundation.Proposal) ([]
 		}
 		r, err := handler(ctx, msg)
 		if err != nil {
-			return nil, sdkerrors.Wrapf(err, "message %q at position %d", msg, i)
+			return results[:i], sdkerrors.Wrapf(err, "message %q at position %d", msg, i)
 		}
 		if r != nil {
 			results[i] = *r
@@ -1,5 +1,22 @@
 package internal_test
 
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/Finschia/finschia-sdk/crypto/keys/secp256k1"
+	"github.com/Finschia/finschia-sdk/simapp"
+	"github.com/Finschia/finschia-sdk/testutil/testdata"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+	"github.com/Finschia/finschia-sdk/x/foundation/keeper/internal"
+)
+
 func (s *KeeperTestSuite) TestExec() {
 	testCases := map[string]struct {
 		proposalID uint64
@@ -42,3 +59,320 @@ func (s *KeeperTestSuite) TestExec() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestExecTimelockedProposals() {
+	ctx, _ := s.ctx.CacheContext()
+
+	executeAfter := ctx.BlockTime().Add(time.Hour)
+	submit := func(name string) uint64 {
+		id, err := s.impl.SubmitProposal(ctx, []string{s.members[0].String()}, "", []sdk.Msg{newMsgCreateDog(name)}, &executeAfter, 0)
+		s.Require().NoError(err)
+		return *id
+	}
+
+	// accepted one
+	accepted := submit("shiba3")
+	for _, member := range s.members {
+		err := s.impl.Vote(ctx, foundation.Vote{
+			ProposalId: accepted,
+			Voter:      member.String(),
+			Option:     foundation.VOTE_OPTION_YES,
+		})
+		s.Require().NoError(err)
+	}
+
+	// not decided yet
+	undecided := submit("shiba4")
+
+	// timelocked
+	err := s.impl.Exec(ctx, accepted)
+	s.Require().Error(err)
+
+	s.impl.ExecTimelockedProposals(ctx.WithBlockTime(executeAfter.Add(-time.Nanosecond)))
+	proposal, err := s.impl.GetProposal(ctx, accepted)
+	s.Require().NoError(err)
+	s.Require().Equal(foundation.PROPOSAL_STATUS_SUBMITTED, proposal.Status)
+	s.Require().Equal(foundation.PROPOSAL_EXECUTOR_RESULT_NOT_RUN, proposal.ExecutorResult)
+
+	// executed and pruned
+	s.impl.ExecTimelockedProposals(ctx.WithBlockTime(executeAfter))
+	_, err = s.impl.GetProposal(ctx, accepted)
+	s.Require().Error(err)
+
+	proposal, err = s.impl.GetProposal(ctx, undecided)
+	s.Require().NoError(err)
+	s.Require().Equal(foundation.PROPOSAL_STATUS_SUBMITTED, proposal.Status)
+	s.Require().Equal(foundation.PROPOSAL_EXECUTOR_RESULT_NOT_RUN, proposal.ExecutorResult)
+}
+
+func (s *KeeperTestSuite) TestExecTimelockedProposalsByHeight() {
+	ctx, _ := s.ctx.CacheContext()
+
+	executeAfterHeight := uint64(ctx.BlockHeight()) + 10
+	id, err := s.impl.SubmitProposal(ctx, []string{s.members[0].String()}, "", []sdk.Msg{newMsgCreateDog("shiba3")}, nil, executeAfterHeight)
+	s.Require().NoError(err)
+	for _, member := range s.members {
+		err := s.impl.Vote(ctx, foundation.Vote{
+			ProposalId: *id,
+			Voter:      member.String(),
+			Option:     foundation.VOTE_OPTION_YES,
+		})
+		s.Require().NoError(err)
+	}
+
+	// timelocked
+	err = s.impl.Exec(ctx, *id)
+	s.Require().Error(err)
+
+	s.impl.ExecTimelockedProposals(ctx.WithBlockHeight(int64(executeAfterHeight) - 1))
+	proposal, err := s.impl.GetProposal(ctx, *id)
+	s.Require().NoError(err)
+	s.Require().Equal(foundation.PROPOSAL_STATUS_SUBMITTED, proposal.Status)
+	s.Require().Equal(foundation.PROPOSAL_EXECUTOR_RESULT_NOT_RUN, proposal.ExecutorResult)
+
+	// executed and pruned
+	s.impl.ExecTimelockedProposals(ctx.WithBlockHeight(int64(executeAfterHeight)))
+	_, err = s.impl.GetProposal(ctx, *id)
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestSimulateProposal() {
+	testCases := map[string]struct {
+		proposalID uint64
+		valid      bool
+		success    bool
+	}{
+		"valid proposal": {
+			proposalID: s.activeProposal,
+			valid:      true,
+			success:    true,
+		},
+		"invalid msg in proposal": {
+			proposalID: s.invalidProposal,
+			valid:      true,
+		},
+		"no handler msg in proposal": {
+			proposalID: s.noHandlerProposal,
+			valid:      true,
+		},
+		"no such proposal": {
+			proposalID: s.nextProposal,
+		},
+		"withdrawn proposal": {
+			proposalID: s.withdrawnProposal,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			treasury := s.impl.GetTreasury(ctx)
+
+			req := &foundation.QuerySimulateProposalRequest{ProposalId: tc.proposalID}
+			res, err := s.queryServer.SimulateProposal(sdk.WrapSDKContext(ctx), req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+
+			if tc.success {
+				s.Require().Empty(res.Error)
+				s.Require().Len(res.Results, 1)
+				s.Require().NotEmpty(res.Results[0].Events)
+				s.Require().Subset(res.Events, res.Results[0].Events)
+			} else {
+				s.Require().NotEmpty(res.Error)
+				s.Require().Empty(res.Results)
+			}
+
+			// the simulation must not change the state
+			s.Require().Equal(treasury, s.impl.GetTreasury(ctx))
+			_, err = s.impl.GetProposal(ctx, tc.proposalID)
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSimulateProposalPanic() {
+	ctx, _ := s.ctx.CacheContext()
+
+	// the handler panics on the nil dog
+	id, err := s.impl.SubmitProposal(ctx, []string{s.members[0].String()}, "", []sdk.Msg{&testdata.MsgCreateDog{}}, nil, 0)
+	s.Require().NoError(err)
+
+	req := &foundation.QuerySimulateProposalRequest{ProposalId: *id}
+	var res *foundation.QuerySimulateProposalResponse
+	s.Require().NotPanics(func() {
+		res, err = s.queryServer.SimulateProposal(sdk.WrapSDKContext(ctx), req)
+	})
+	s.Require().NoError(err)
+	s.Require().NotNil(res)
+	s.Require().Contains(res.Error, sdkerrors.ErrPanic.Error())
+	s.Require().Empty(res.Results)
+	s.Require().Empty(res.Events)
+}
+
+func TestSimulateProposalGasLimit(t *testing.T) {
+	checkTx := false
+	app := simapp.Setup(checkTx)
+	testdata.RegisterInterfaces(app.InterfaceRegistry())
+	testdata.RegisterMsgServer(app.MsgServiceRouter(), testdata.MsgServerImpl{})
+
+	ctx := app.BaseApp.NewContext(checkTx, tmproto.Header{})
+	newKeeper := func(gasLimit uint64) internal.Keeper {
+		config := foundation.DefaultConfig()
+		config.MaxSimulationGas = gasLimit
+
+		return internal.NewKeeper(
+			app.AppCodec(),
+			app.GetKey(foundation.ModuleName),
+			app.GetTKey(foundation.TStoreKey),
+			app.MsgServiceRouter(),
+			app.AccountKeeper,
+			app.BankKeeper,
+			app.GroupKeeper,
+			authtypes.FeeCollectorName,
+			config,
+			foundation.DefaultAuthority().String(),
+			app.GetSubspace(foundation.ModuleName),
+		)
+	}
+	impl := newKeeper(foundation.DefaultConfig().MaxSimulationGas)
+
+	member := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	impl.SetMember(ctx, foundation.Member{
+		Address: member.String(),
+		Weight:  sdk.OneDec(),
+	})
+
+	info := foundation.DefaultFoundation()
+	info.TotalWeight = sdk.OneDec()
+	err := info.SetDecisionPolicy(workingPolicy())
+	require.NoError(t, err)
+	impl.SetFoundationInfo(ctx, info)
+
+	newMember := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	id, err := impl.SubmitProposal(ctx, []string{member.String()}, "", []sdk.Msg{
+		&foundation.MsgUpdateMembers{
+			Authority: impl.GetAuthority(),
+			MemberUpdates: []foundation.MemberRequest{{
+				Address: newMember.String(),
+				Weight:  sdk.OneDec(),
+			}},
+		},
+	}, nil, 0)
+	require.NoError(t, err)
+
+	testCases := map[string]struct {
+		gasLimit uint64
+		success  bool
+	}{
+		"enough gas": {
+			gasLimit: foundation.DefaultConfig().MaxSimulationGas,
+			success:  true,
+		},
+		"out of gas": {
+			gasLimit: 1,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+			queryServer := internal.NewQueryServer(newKeeper(tc.gasLimit))
+
+			req := &foundation.QuerySimulateProposalRequest{ProposalId: *id}
+			res, err := queryServer.SimulateProposal(sdk.WrapSDKContext(ctx), req)
+			require.NoError(t, err)
+			require.NotNil(t, res)
+
+			if tc.success {
+				require.Empty(t, res.Error)
+				require.Len(t, res.Results, 1)
+				require.NotEmpty(t, res.Events)
+			} else {
+				require.Contains(t, res.Error, "out of gas")
+				require.Empty(t, res.Results)
+				require.Empty(t, res.Events)
+			}
+		})
+	}
+}
+
+func TestExecTimelockedProposalsOutOfGas(t *testing.T) {
+	checkTx := false
+	app := simapp.Setup(checkTx)
+	testdata.RegisterInterfaces(app.InterfaceRegistry())
+
+	ctx := app.BaseApp.NewContext(checkTx, tmproto.Header{})
+	config := foundation.DefaultConfig()
+	config.MaxTimelockedExecutionGas = 1
+	impl := internal.NewKeeper(
+		app.AppCodec(),
+		app.GetKey(foundation.ModuleName),
+		app.GetTKey(foundation.TStoreKey),
+		app.MsgServiceRouter(),
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.GroupKeeper,
+		authtypes.FeeCollectorName,
+		config,
+		foundation.DefaultAuthority().String(),
+		app.GetSubspace(foundation.ModuleName),
+	)
+
+	member := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	impl.SetMember(ctx, foundation.Member{
+		Address: member.String(),
+		Weight:  sdk.OneDec(),
+	})
+
+	info := foundation.DefaultFoundation()
+	info.TotalWeight = sdk.OneDec()
+	err := info.SetDecisionPolicy(workingPolicy())
+	require.NoError(t, err)
+	impl.SetFoundationInfo(ctx, info)
+
+	executeAfter := ctx.BlockTime().Add(time.Hour)
+	newMember := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	id, err := impl.SubmitProposal(ctx, []string{member.String()}, "", []sdk.Msg{
+		&foundation.MsgUpdateMembers{
+			Authority: impl.GetAuthority(),
+			MemberUpdates: []foundation.MemberRequest{{
+				Address: newMember.String(),
+				Weight:  sdk.OneDec(),
+			}},
+		},
+	}, &executeAfter, 0)
+	require.NoError(t, err)
+
+	err = impl.Vote(ctx, foundation.Vote{
+		ProposalId: *id,
+		Voter:      member.String(),
+		Option:     foundation.VOTE_OPTION_YES,
+	})
+	require.NoError(t, err)
+
+	// recorded as failed, instead of panicking
+	ctx = ctx.WithBlockTime(executeAfter)
+	require.NotPanics(t, func() {
+		impl.ExecTimelockedProposals(ctx)
+	})
+	proposal, err := impl.GetProposal(ctx, *id)
+	require.NoError(t, err)
+	require.Equal(t, foundation.PROPOSAL_STATUS_ACCEPTED, proposal.Status)
+	require.Equal(t, foundation.PROPOSAL_EXECUTOR_RESULT_FAILURE, proposal.ExecutorResult)
+	_, err = impl.GetMember(ctx, newMember)
+	require.Error(t, err)
+
+	// but it can be retried by MsgExec
+	err = impl.Exec(ctx, *id)
+	require.NoError(t, err)
+	_, err = impl.GetProposal(ctx, *id)
+	require.Error(t, err)
+	_, err = impl.GetMember(ctx, newMember)
+	require.NoError(t, err)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 	"github.com/Finschia/finschia-sdk/x/foundation"
 )
 
@@ -29,6 +30,10 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *foundation.GenesisState) erro
 
 		k.setProposal(ctx, proposal)
 		k.addProposalToVPEndQueue(ctx, proposal)
+		if proposal.ExecutorResult == foundation.PROPOSAL_EXECUTOR_RESULT_NOT_RUN {
+			k.addProposalToExecuteAfterQueue(ctx, proposal)
+			k.addProposalToExecuteAfterHeightQueue(ctx, proposal)
+		}
 	}
 
 	for _, vote := range data.Votes {
@@ -43,6 +48,18 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *foundation.GenesisState) erro
 		k.SetCensorship(ctx, censorship)
 	}
 
+	for _, policy := range data.MsgTypeDecisionPolicies {
+		if err := policy.GetDecisionPolicy().Validate(data.Foundation, k.config); err != nil {
+			return sdkerrors.Wrapf(err, "decision policy for %s", policy.MsgTypeUrl)
+		}
+
+		k.SetMsgTypeDecisionPolicy(ctx, policy)
+	}
+
+	for _, delegation := range data.VoteDelegations {
+		k.setVoteDelegation(ctx, delegation)
+	}
+
 	for _, ga := range data.Authorizations {
 		grantee := sdk.MustAccAddressFromBech32(ga.Grantee)
 		k.setAuthorization(ctx, grantee, ga.GetAuthorization())
@@ -50,6 +67,14 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *foundation.GenesisState) erro
 
 	k.SetPool(ctx, data.Pool)
 
+	for _, limit := range data.TreasuryOutflowLimits {
+		k.setTreasuryOutflowLimit(ctx, limit)
+	}
+
+	for _, outflow := range data.TreasuryOutflows {
+		k.addTreasuryOutflow(ctx, outflow)
+	}
+
 	// init module accounts just in case
 	if acc := k.authKeeper.GetModuleAccount(ctx, foundation.ModuleName); acc == nil {
 		panic(fmt.Sprintf("failed to create module account=%s", foundation.ModuleName))
@@ -70,15 +95,19 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *foundation.GenesisState {
 	}
 
 	return &foundation.GenesisState{
-		Params:             k.GetParams(ctx),
-		Foundation:         info,
-		Members:            k.GetMembers(ctx),
-		PreviousProposalId: k.getPreviousProposalID(ctx),
-		Proposals:          proposals,
-		Votes:              votes,
-		Censorships:        k.GetCensorships(ctx),
-		Authorizations:     k.GetGrants(ctx),
-		Pool:               k.GetPool(ctx),
+		Params:                  k.GetParams(ctx),
+		Foundation:              info,
+		Members:                 k.GetMembers(ctx),
+		PreviousProposalId:      k.getPreviousProposalID(ctx),
+		Proposals:               proposals,
+		Votes:                   votes,
+		Censorships:             k.GetCensorships(ctx),
+		Authorizations:          k.GetGrants(ctx),
+		Pool:                    k.GetPool(ctx),
+		MsgTypeDecisionPolicies: k.GetMsgTypeDecisionPolicies(ctx),
+		VoteDelegations:         k.GetVoteDelegations(ctx),
+		TreasuryOutflowLimits:   k.GetTreasuryOutflowLimits(ctx),
+		TreasuryOutflows:        k.GetTreasuryOutflows(ctx),
 	}
 }
 
@@ -107,3 +136,23 @@ func (k Keeper) GetGrants(ctx sdk.Context) []foundation.GrantAuthorization {
 	})
 	return grantAuthorizations
 }
+
+func (k Keeper) GetTreasuryOutflowLimits(ctx sdk.Context) []foundation.TreasuryOutflowLimit {
+	var limits []foundation.TreasuryOutflowLimit
+	k.iterateTreasuryOutflowLimits(ctx, func(limit foundation.TreasuryOutflowLimit) (stop bool) {
+		limits = append(limits, limit)
+
+		return false
+	})
+	return limits
+}
+
+func (k Keeper) GetTreasuryOutflows(ctx sdk.Context) []foundation.TreasuryOutflow {
+	var outflows []foundation.TreasuryOutflow
+	k.iterateAllTreasuryOutflows(ctx, func(outflow foundation.TreasuryOutflow) (stop bool) {
+		outflows = append(outflows, outflow)
+
+		return false
+	})
+	return outflows
+}
@@ -71,6 +71,7 @@ func TestImportExportGenesis(t *testing.T) {
 				Members: []foundation.Member{
 					{
 						Address: member.String(),
+						Weight:  sdk.OneDec(),
 					},
 				},
 			},
@@ -84,10 +85,50 @@ func TestImportExportGenesis(t *testing.T) {
 				Members: []foundation.Member{
 					{
 						Address: member.String(),
+						Weight:  sdk.OneDec(),
 					},
 				},
 			},
 		},
+		"msg type decision policies": {
+			init: &foundation.GenesisState{
+				Params: foundation.DefaultParams(),
+				Foundation: *foundation.FoundationInfo{
+					Version:     1,
+					TotalWeight: sdk.OneDec(),
+				}.WithDecisionPolicy(workingPolicy()),
+				Members: []foundation.Member{
+					{
+						Address: member.String(),
+						Weight:  sdk.OneDec(),
+					},
+				},
+				MsgTypeDecisionPolicies: []foundation.MsgTypeDecisionPolicy{
+					*foundation.MsgTypeDecisionPolicy{
+						MsgTypeUrl: sdk.MsgTypeURL((*foundation.MsgWithdrawFromTreasury)(nil)),
+					}.WithDecisionPolicy(workingPolicy()),
+				},
+			},
+			valid: true,
+			export: &foundation.GenesisState{
+				Params: foundation.DefaultParams(),
+				Foundation: *foundation.FoundationInfo{
+					Version:     1,
+					TotalWeight: sdk.OneDec(),
+				}.WithDecisionPolicy(workingPolicy()),
+				Members: []foundation.Member{
+					{
+						Address: member.String(),
+						Weight:  sdk.OneDec(),
+					},
+				},
+				MsgTypeDecisionPolicies: []foundation.MsgTypeDecisionPolicy{
+					*foundation.MsgTypeDecisionPolicy{
+						MsgTypeUrl: sdk.MsgTypeURL((*foundation.MsgWithdrawFromTreasury)(nil)),
+					}.WithDecisionPolicy(workingPolicy()),
+				},
+			},
+		},
 		"proposals": {
 			init: &foundation.GenesisState{
 				Params: foundation.DefaultParams(),
@@ -98,6 +139,7 @@ func TestImportExportGenesis(t *testing.T) {
 				Members: []foundation.Member{
 					{
 						Address: member.String(),
+						Weight:  sdk.OneDec(),
 					},
 				},
 				PreviousProposalId: 1,
@@ -126,6 +168,7 @@ func TestImportExportGenesis(t *testing.T) {
 				Members: []foundation.Member{
 					{
 						Address: member.String(),
+						Weight:  sdk.OneDec(),
 					},
 				},
 				PreviousProposalId: 1,
@@ -212,6 +255,7 @@ func TestImportExportGenesis(t *testing.T) {
 					{
 						Address:  member.String(),
 						Metadata: string(make([]rune, 256)),
+						Weight:   sdk.OneDec(),
 					},
 				},
 			},
@@ -226,6 +270,7 @@ func TestImportExportGenesis(t *testing.T) {
 				Members: []foundation.Member{
 					{
 						Address: member.String(),
+						Weight:  sdk.OneDec(),
 					},
 				},
 				PreviousProposalId: 1,
@@ -239,6 +284,32 @@ func TestImportExportGenesis(t *testing.T) {
 				},
 			},
 		},
+		"msg type decision policy of invalid windows": {
+			init: &foundation.GenesisState{
+				Params: foundation.DefaultParams(),
+				Foundation: *foundation.FoundationInfo{
+					Version:     1,
+					TotalWeight: sdk.OneDec(),
+				}.WithDecisionPolicy(workingPolicy()),
+				Members: []foundation.Member{
+					{
+						Address: member.String(),
+						Weight:  sdk.OneDec(),
+					},
+				},
+				MsgTypeDecisionPolicies: []foundation.MsgTypeDecisionPolicy{
+					*foundation.MsgTypeDecisionPolicy{
+						MsgTypeUrl: sdk.MsgTypeURL((*foundation.MsgWithdrawFromTreasury)(nil)),
+					}.WithDecisionPolicy(&foundation.ThresholdDecisionPolicy{
+						Threshold: sdk.OneDec(),
+						Windows: &foundation.DecisionPolicyWindows{
+							VotingPeriod:       time.Hour,
+							MinExecutionPeriod: time.Hour + foundation.DefaultConfig().MaxExecutionPeriod,
+						},
+					}),
+				},
+			},
+		},
 		"vote of long metadata": {
 			init: &foundation.GenesisState{
 				Params: foundation.DefaultParams(),
@@ -249,6 +320,7 @@ func TestImportExportGenesis(t *testing.T) {
 				Members: []foundation.Member{
 					{
 						Address: member.String(),
+						Weight:  sdk.OneDec(),
 					},
 				},
 				PreviousProposalId: 1,
@@ -317,9 +389,11 @@ func TestShouldPanicWhenFailToGenerateFoundationModuleAccountInInitGenesis(t *te
 				k := internal.NewKeeper(
 					app.AppCodec(),
 					app.GetKey(foundation.ModuleName),
+					app.GetTKey(foundation.TStoreKey),
 					app.MsgServiceRouter(),
 					tc.mockAccKeeper,
 					app.BankKeeper,
+					app.GroupKeeper,
 					authtypes.FeeCollectorName,
 					foundation.DefaultConfig(),
 					foundation.DefaultAuthority().String(),
@@ -1,7 +1,9 @@
 package internal
 
 import (
+	"bytes"
 	"context"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"google.golang.org/grpc/codes"
@@ -99,6 +101,31 @@ func (s queryServer) Members(c context.Context, req *foundation.QueryMembersRequ
 	return &foundation.QueryMembersResponse{Members: members, Pagination: pageRes}, nil
 }
 
+func (s queryServer) ExpiringMembers(c context.Context, req *foundation.QueryExpiringMembersRequest) (*foundation.QueryExpiringMembersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var members []foundation.Member
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	memberStore := prefix.NewStore(store, memberByExpirationKeyPrefix)
+	pageRes, err := query.Paginate(memberStore, req.Pagination, func(key, value []byte) error {
+		_, address := splitMemberByExpirationKey(append(memberByExpirationKeyPrefix, key...))
+		member, err := s.keeper.GetMember(ctx, address)
+		if err != nil {
+			return err
+		}
+		members = append(members, *member)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &foundation.QueryExpiringMembersResponse{Members: members, Pagination: pageRes}, nil
+}
+
 func (s queryServer) Proposal(c context.Context, req *foundation.QueryProposalRequest) (*foundation.QueryProposalResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -118,16 +145,14 @@ func (s queryServer) Proposals(c context.Context, req *foundation.QueryProposals
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	var proposals []foundation.Proposal
+	filter, err := newProposalFilter(req.Status, req.Proposer, req.SubmitTimeFrom, req.SubmitTimeTo)
+	if err != nil {
+		return nil, err
+	}
+
 	ctx := sdk.UnwrapSDKContext(c)
 	store := ctx.KVStore(s.keeper.storeKey)
-	proposalStore := prefix.NewStore(store, proposalKeyPrefix)
-	pageRes, err := query.Paginate(proposalStore, req.Pagination, func(key, value []byte) error {
-		var proposal foundation.Proposal
-		s.keeper.cdc.MustUnmarshal(value, &proposal)
-		proposals = append(proposals, proposal)
-		return nil
-	})
+	proposals, pageRes, err := s.keeper.paginateProposals(store, req.Pagination, *filter)
 	if err != nil {
 		return nil, err
 	}
@@ -135,6 +160,179 @@ func (s queryServer) Proposals(c context.Context, req *foundation.QueryProposals
 	return &foundation.QueryProposalsResponse{Proposals: proposals, Pagination: pageRes}, nil
 }
 
+func (s queryServer) ArchivedProposals(c context.Context, req *foundation.QueryArchivedProposalsRequest) (*foundation.QueryArchivedProposalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	filter, err := newProposalFilter(req.Status, req.Proposer, req.SubmitTimeFrom, req.SubmitTimeTo)
+	if err != nil {
+		return nil, err
+	}
+
+	store, enabled := s.keeper.archiveStore()
+	if !enabled {
+		return nil, status.Error(codes.FailedPrecondition, "proposal archive is not enabled on this node")
+	}
+	proposals, pageRes, err := s.keeper.paginateProposals(store, req.Pagination, *filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &foundation.QueryArchivedProposalsResponse{Proposals: proposals, Pagination: pageRes}, nil
+}
+
+// proposalFilter filters the proposals on the queries.
+// The zero value of each field matches any proposal.
+type proposalFilter struct {
+	status         foundation.ProposalStatus
+	proposer       string
+	submitTimeFrom *time.Time
+	submitTimeTo   *time.Time
+}
+
+func newProposalFilter(proposalStatus foundation.ProposalStatus, proposer string, submitTimeFrom, submitTimeTo *time.Time) (*proposalFilter, error) {
+	if _, ok := foundation.ProposalStatus_name[int32(proposalStatus)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid proposal status: %d", proposalStatus)
+	}
+
+	if len(proposer) != 0 {
+		if _, err := sdk.AccAddressFromBech32(proposer); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid proposer address")
+		}
+	}
+
+	if submitTimeFrom != nil && submitTimeTo != nil && !submitTimeFrom.Before(*submitTimeTo) {
+		return nil, status.Error(codes.InvalidArgument, "submit_time_from must be before submit_time_to")
+	}
+
+	return &proposalFilter{
+		status:         proposalStatus,
+		proposer:       proposer,
+		submitTimeFrom: submitTimeFrom,
+		submitTimeTo:   submitTimeTo,
+	}, nil
+}
+
+func (f proposalFilter) match(proposal foundation.Proposal) bool {
+	if f.status != foundation.PROPOSAL_STATUS_UNSPECIFIED && proposal.Status != f.status {
+		return false
+	}
+
+	if len(f.proposer) != 0 {
+		found := false
+		for _, proposer := range proposal.Proposers {
+			if proposer == f.proposer {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if f.submitTimeFrom != nil && proposal.SubmitTime.Before(*f.submitTimeFrom) {
+		return false
+	}
+
+	if f.submitTimeTo != nil && !proposal.SubmitTime.Before(*f.submitTimeTo) {
+		return false
+	}
+
+	return true
+}
+
+// indexStore returns the secondary index of the proposals for the filter, in
+// which the last 8 bytes of each key is the proposal id. If no filter is set,
+// it returns the proposal store itself, with false.
+func (f proposalFilter) indexStore(store sdk.KVStore) (sdk.KVStore, bool) {
+	switch {
+	case len(f.proposer) != 0:
+		proposer := sdk.MustAccAddressFromBech32(f.proposer)
+		return prefix.NewStore(store, proposalByProposerPrefix(proposer)), true
+	case f.status != foundation.PROPOSAL_STATUS_UNSPECIFIED:
+		return prefix.NewStore(store, proposalByStatusPrefix(f.status)), true
+	case f.submitTimeFrom != nil || f.submitTimeTo != nil:
+		indexStore := rangeStore{KVStore: prefix.NewStore(store, proposalBySubmitTimeKeyPrefix)}
+		if f.submitTimeFrom != nil {
+			indexStore.start = sdk.FormatTimeBytes(*f.submitTimeFrom)
+		}
+		if f.submitTimeTo != nil {
+			indexStore.end = sdk.FormatTimeBytes(*f.submitTimeTo)
+		}
+		return indexStore, true
+	default:
+		return prefix.NewStore(store, proposalKeyPrefix), false
+	}
+}
+
+// paginateProposals paginates the proposals matching the filter. It iterates
+// the secondary index of one of the filters, and the others are checked on
+// each proposal.
+func (k Keeper) paginateProposals(store sdk.KVStore, pagination *query.PageRequest, filter proposalFilter) ([]foundation.Proposal, *query.PageResponse, error) {
+	indexStore, indexed := filter.indexStore(store)
+
+	var proposals []foundation.Proposal
+	pageRes, err := query.FilteredPaginate(indexStore, pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		bz := value
+		if indexed {
+			id := splitProposalIndexKey(key)
+			if bz = store.Get(proposalKey(id)); bz == nil {
+				return false, sdkerrors.ErrNotFound.Wrapf("No proposal for id: %d", id)
+			}
+		}
+
+		var proposal foundation.Proposal
+		k.cdc.MustUnmarshal(bz, &proposal)
+
+		if !filter.match(proposal) {
+			return false, nil
+		}
+
+		if accumulate {
+			proposals = append(proposals, proposal)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return proposals, pageRes, nil
+}
+
+// rangeStore restricts the iteration over the store into [start, end).
+// A nil bound means no restriction.
+type rangeStore struct {
+	sdk.KVStore
+	start, end []byte
+}
+
+func (s rangeStore) Iterator(start, end []byte) sdk.Iterator {
+	return s.KVStore.Iterator(s.clamp(start, end))
+}
+
+func (s rangeStore) ReverseIterator(start, end []byte) sdk.Iterator {
+	return s.KVStore.ReverseIterator(s.clamp(start, end))
+}
+
+func (s rangeStore) clamp(start, end []byte) ([]byte, []byte) {
+	if s.start != nil && (start == nil || bytes.Compare(start, s.start) < 0) {
+		start = s.start
+	}
+	if s.end != nil && (end == nil || bytes.Compare(end, s.end) > 0) {
+		end = s.end
+	}
+
+	// empty range
+	if start != nil && end != nil && bytes.Compare(start, end) > 0 {
+		end = start
+	}
+
+	return start, end
+}
+
 func (s queryServer) Vote(c context.Context, req *foundation.QueryVoteRequest) (*foundation.QueryVoteResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -175,6 +373,28 @@ func (s queryServer) Votes(c context.Context, req *foundation.QueryVotesRequest)
 	return &foundation.QueryVotesResponse{Votes: votes, Pagination: pageRes}, nil
 }
 
+func (s queryServer) VoteDelegations(c context.Context, req *foundation.QueryVoteDelegationsRequest) (*foundation.QueryVoteDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var delegations []foundation.VoteDelegation
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	delegationStore := prefix.NewStore(store, voteDelegationKeyPrefix)
+	pageRes, err := query.Paginate(delegationStore, req.Pagination, func(key, value []byte) error {
+		var delegation foundation.VoteDelegation
+		s.keeper.cdc.MustUnmarshal(value, &delegation)
+		delegations = append(delegations, delegation)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &foundation.QueryVoteDelegationsResponse{VoteDelegations: delegations, Pagination: pageRes}, nil
+}
+
 func (s queryServer) TallyResult(c context.Context, req *foundation.QueryTallyResultRequest) (*foundation.QueryTallyResultResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -194,6 +414,56 @@ func (s queryServer) TallyResult(c context.Context, req *foundation.QueryTallyRe
 	return &foundation.QueryTallyResultResponse{Tally: tally}, nil
 }
 
+func (s queryServer) SimulateProposal(c context.Context, req *foundation.QuerySimulateProposalRequest) (*foundation.QuerySimulateProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	proposal, err := s.keeper.GetProposal(ctx, req.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	if proposal.Status != foundation.PROPOSAL_STATUS_SUBMITTED &&
+		proposal.Status != foundation.PROPOSAL_STATUS_ACCEPTED {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("not possible with proposal status: %s", proposal.Status)
+	}
+
+	results, events, err := s.keeper.simulateProposal(ctx, *proposal)
+	res := &foundation.QuerySimulateProposalResponse{
+		Results: results,
+		Events:  events,
+	}
+	if err != nil {
+		res.Error = err.Error()
+	}
+
+	return res, nil
+}
+
+func (s queryServer) MsgTypeDecisionPolicies(c context.Context, req *foundation.QueryMsgTypeDecisionPoliciesRequest) (*foundation.QueryMsgTypeDecisionPoliciesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var policies []foundation.MsgTypeDecisionPolicy
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	policyStore := prefix.NewStore(store, msgTypeDecisionPolicyKeyPrefix)
+	pageRes, err := query.Paginate(policyStore, req.Pagination, func(key, value []byte) error {
+		var policy foundation.MsgTypeDecisionPolicy
+		s.keeper.cdc.MustUnmarshal(value, &policy)
+		policies = append(policies, policy)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &foundation.QueryMsgTypeDecisionPoliciesResponse{Policies: policies, Pagination: pageRes}, nil
+}
+
 func (s queryServer) Censorships(c context.Context, req *foundation.QueryCensorshipsRequest) (*foundation.QueryCensorshipsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -216,6 +486,52 @@ func (s queryServer) Censorships(c context.Context, req *foundation.QueryCensors
 	return &foundation.QueryCensorshipsResponse{Censorships: censorships, Pagination: pageRes}, nil
 }
 
+func (s queryServer) TreasuryOutflowLimits(c context.Context, req *foundation.QueryTreasuryOutflowLimitsRequest) (*foundation.QueryTreasuryOutflowLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var limits []foundation.TreasuryOutflowLimit
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	limitStore := prefix.NewStore(store, treasuryOutflowLimitKeyPrefix)
+	pageRes, err := query.Paginate(limitStore, req.Pagination, func(key, value []byte) error {
+		var limit foundation.TreasuryOutflowLimit
+		s.keeper.cdc.MustUnmarshal(value, &limit)
+		limits = append(limits, limit)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &foundation.QueryTreasuryOutflowLimitsResponse{Limits: limits, Pagination: pageRes}, nil
+}
+
+func (s queryServer) TreasuryOutflowHeadroom(c context.Context, req *foundation.QueryTreasuryOutflowHeadroomRequest) (*foundation.QueryTreasuryOutflowHeadroomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	limit, err := s.keeper.GetTreasuryOutflowLimit(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	outflow, headroom := s.keeper.GetTreasuryOutflowHeadroom(ctx, *limit)
+
+	return &foundation.QueryTreasuryOutflowHeadroomResponse{
+		Limit:    *limit,
+		Outflow:  sdk.NewCoin(req.Denom, outflow),
+		Headroom: sdk.NewCoin(req.Denom, headroom),
+	}, nil
+}
+
 func (s queryServer) Grants(c context.Context, req *foundation.QueryGrantsRequest) (*foundation.QueryGrantsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -4,13 +4,16 @@ import (
 	gocontext "context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/Finschia/finschia-sdk/baseapp"
 	"github.com/Finschia/finschia-sdk/simapp"
+	"github.com/Finschia/finschia-sdk/testutil/testdata"
 	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/types/query"
 	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
 	"github.com/Finschia/finschia-sdk/x/foundation"
 	"github.com/Finschia/finschia-sdk/x/foundation/keeper"
@@ -38,9 +41,11 @@ func (s *FoundationTestSuite) SetupTest() {
 	s.impl = internal.NewKeeper(
 		s.app.AppCodec(),
 		s.app.GetKey(foundation.ModuleName),
+		s.app.GetTKey(foundation.TStoreKey),
 		s.app.MsgServiceRouter(),
 		s.app.AccountKeeper,
 		s.app.BankKeeper,
+		s.app.GroupKeeper,
 		authtypes.FeeCollectorName,
 		foundation.DefaultConfig(),
 		foundation.DefaultAuthority().String(),
@@ -93,6 +98,186 @@ func (s *FoundationTestSuite) TestQueryParams() {
 	}
 }
 
+func (s *KeeperTestSuite) TestQueryProposals() {
+	submitTime := s.ctx.BlockTime()
+	nextTime := submitTime.Add(time.Nanosecond)
+	allProposals := []uint64{s.activeProposal, s.votedProposal, s.withdrawnProposal, s.invalidProposal, s.noHandlerProposal}
+
+	testCases := map[string]struct {
+		req   foundation.QueryProposalsRequest
+		valid bool
+		ids   []uint64
+	}{
+		"no filter": {
+			valid: true,
+			ids:   allProposals,
+		},
+		"by status": {
+			req:   foundation.QueryProposalsRequest{Status: foundation.PROPOSAL_STATUS_WITHDRAWN},
+			valid: true,
+			ids:   []uint64{s.withdrawnProposal},
+		},
+		"by proposer": {
+			req:   foundation.QueryProposalsRequest{Proposer: s.members[0].String()},
+			valid: true,
+			ids:   allProposals,
+		},
+		"by proposer, no proposals": {
+			req:   foundation.QueryProposalsRequest{Proposer: s.members[1].String()},
+			valid: true,
+		},
+		"by submit time": {
+			req:   foundation.QueryProposalsRequest{SubmitTimeFrom: &submitTime, SubmitTimeTo: &nextTime},
+			valid: true,
+			ids:   allProposals,
+		},
+		"by submit time, no proposals": {
+			req:   foundation.QueryProposalsRequest{SubmitTimeTo: &submitTime},
+			valid: true,
+		},
+		"combined": {
+			req: foundation.QueryProposalsRequest{
+				Status:         foundation.PROPOSAL_STATUS_SUBMITTED,
+				Proposer:       s.members[0].String(),
+				SubmitTimeFrom: &submitTime,
+			},
+			valid: true,
+			ids:   []uint64{s.activeProposal, s.votedProposal, s.invalidProposal, s.noHandlerProposal},
+		},
+		"invalid status": {
+			req: foundation.QueryProposalsRequest{Status: foundation.ProposalStatus(-1)},
+		},
+		"invalid proposer": {
+			req: foundation.QueryProposalsRequest{Proposer: "invalid"},
+		},
+		"invalid submit time range": {
+			req: foundation.QueryProposalsRequest{SubmitTimeFrom: &submitTime, SubmitTimeTo: &submitTime},
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			res, err := s.queryServer.Proposals(sdk.WrapSDKContext(s.ctx), &tc.req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			ids := make([]uint64, len(res.Proposals))
+			for i, proposal := range res.Proposals {
+				ids[i] = proposal.Id
+			}
+			s.Require().ElementsMatch(tc.ids, ids)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryProposalsIndexes() {
+	ctx, _ := s.ctx.CacheContext()
+
+	queryIDs := func(ctx sdk.Context, req foundation.QueryProposalsRequest) []uint64 {
+		res, err := s.queryServer.Proposals(sdk.WrapSDKContext(ctx), &req)
+		s.Require().NoError(err)
+
+		ids := make([]uint64, len(res.Proposals))
+		for i, proposal := range res.Proposals {
+			ids[i] = proposal.Id
+		}
+		return ids
+	}
+
+	// the status index follows the status change
+	err := s.impl.WithdrawProposal(ctx, s.activeProposal)
+	s.Require().NoError(err)
+	s.Require().NotContains(queryIDs(ctx, foundation.QueryProposalsRequest{Status: foundation.PROPOSAL_STATUS_SUBMITTED}), s.activeProposal)
+	s.Require().ElementsMatch([]uint64{s.activeProposal, s.withdrawnProposal}, queryIDs(ctx, foundation.QueryProposalsRequest{Status: foundation.PROPOSAL_STATUS_WITHDRAWN}))
+
+	// the indexes are removed on pruning
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(workingPolicy().GetVotingPeriod()))
+	s.impl.UpdateTallyOfVPEndProposals(ctx)
+	s.Require().Empty(queryIDs(ctx, foundation.QueryProposalsRequest{Status: foundation.PROPOSAL_STATUS_WITHDRAWN}))
+	s.Require().NotContains(queryIDs(ctx, foundation.QueryProposalsRequest{Proposer: s.members[0].String()}), s.activeProposal)
+
+	// paginate the submit time range
+	submitTimeFrom := ctx.BlockTime()
+	var newProposals []uint64
+	for i := 0; i < 3; i++ {
+		ctx = ctx.WithBlockTime(submitTimeFrom.Add(time.Duration(i) * time.Second))
+		id, err := s.impl.SubmitProposal(ctx, []string{s.members[0].String()}, "", []sdk.Msg{testdata.NewTestMsg(s.authority)}, nil, 0)
+		s.Require().NoError(err)
+		newProposals = append(newProposals, *id)
+	}
+	submitTimeTo := submitTimeFrom.Add(2 * time.Second)
+
+	var ids []uint64
+	req := foundation.QueryProposalsRequest{
+		SubmitTimeFrom: &submitTimeFrom,
+		SubmitTimeTo:   &submitTimeTo,
+		Pagination:     &query.PageRequest{Limit: 1},
+	}
+	for {
+		res, err := s.queryServer.Proposals(sdk.WrapSDKContext(ctx), &req)
+		s.Require().NoError(err)
+		s.Require().LessOrEqual(len(res.Proposals), 1)
+		for _, proposal := range res.Proposals {
+			ids = append(ids, proposal.Id)
+		}
+
+		if res.Pagination.NextKey == nil {
+			break
+		}
+		req.Pagination.Key = res.Pagination.NextKey
+	}
+	s.Require().Equal(newProposals[:2], ids)
+}
+
 func TestFoundationTestSuite(t *testing.T) {
 	suite.Run(t, new(FoundationTestSuite))
 }
+
+func (s *KeeperTestSuite) TestQueryTreasuryOutflowHeadroom() {
+	ctx, _ := s.ctx.CacheContext()
+
+	limit := foundation.TreasuryOutflowLimit{
+		Denom:  sdk.DefaultBondDenom,
+		Ratio:  sdk.MustNewDecFromStr("0.05"),
+		Period: time.Hour,
+	}
+	err := s.impl.UpdateTreasuryOutflowLimit(ctx, limit, false)
+	s.Require().NoError(err)
+
+	testCases := map[string]struct {
+		denom    string
+		valid    bool
+		headroom sdk.Int
+	}{
+		"valid request": {
+			denom:    limit.Denom,
+			valid:    true,
+			headroom: sdk.NewDecFromInt(s.balance).Mul(limit.Ratio).TruncateInt(),
+		},
+		"invalid denom": {
+			denom: "",
+		},
+		"no limit": {
+			denom: "nonexistent",
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			res, err := s.queryServer.TreasuryOutflowHeadroom(sdk.WrapSDKContext(ctx), &foundation.QueryTreasuryOutflowHeadroomRequest{Denom: tc.denom})
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+
+			s.Require().Equal(limit, res.Limit)
+			s.Require().True(res.Outflow.IsZero())
+			s.Require().Equal(sdk.NewCoin(tc.denom, tc.headroom), res.Headroom)
+		})
+	}
+}
@@ -39,7 +39,7 @@ func ModuleAccountInvariant(k Keeper) sdk.Invariant {
 func TotalWeightInvariant(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 		expected := k.GetFoundationInfo(ctx).TotalWeight
-		actual := sdk.NewDec(int64(len(k.GetMembers(ctx))))
+		actual := foundation.Members{Members: k.GetMembers(ctx)}.TotalWeight()
 
 		msg := fmt.Sprintf("total weight of foundation; expected %s, got %s\n", expected, actual)
 		broken := !actual.Equal(expected)
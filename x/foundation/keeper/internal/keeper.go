@@ -16,13 +16,15 @@ type Keeper struct {
 	cdc codec.Codec
 
 	// The (unexposed) keys used to access the stores from the Context.
-	storeKey sdk.StoreKey
+	storeKey  sdk.StoreKey
+	tStoreKey sdk.StoreKey
 
 	router *baseapp.MsgServiceRouter
 
 	// keepers
-	authKeeper foundation.AuthKeeper
-	bankKeeper foundation.BankKeeper
+	authKeeper  foundation.AuthKeeper
+	bankKeeper  foundation.BankKeeper
+	groupKeeper foundation.GroupKeeper
 
 	feeCollectorName string
 
@@ -43,9 +45,11 @@ type Keeper struct {
 func NewKeeper(
 	cdc codec.Codec,
 	key sdk.StoreKey,
+	tkey sdk.StoreKey,
 	router *baseapp.MsgServiceRouter,
 	authKeeper foundation.AuthKeeper,
 	bankKeeper foundation.BankKeeper,
+	groupKeeper foundation.GroupKeeper,
 	feeCollectorName string,
 	config foundation.Config,
 	authority string,
@@ -68,9 +72,11 @@ func NewKeeper(
 	return Keeper{
 		cdc:              cdc,
 		storeKey:         key,
+		tStoreKey:        tkey,
 		router:           router,
 		authKeeper:       authKeeper,
 		bankKeeper:       bankKeeper,
+		groupKeeper:      groupKeeper,
 		feeCollectorName: feeCollectorName,
 		config:           config,
 		authority:        authority,
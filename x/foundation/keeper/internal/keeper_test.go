@@ -17,6 +17,7 @@ import (
 	keeper "github.com/Finschia/finschia-sdk/x/foundation/keeper"
 	"github.com/Finschia/finschia-sdk/x/foundation/keeper/internal"
 	govtypes "github.com/Finschia/finschia-sdk/x/gov/types"
+	groupkeeper "github.com/Finschia/finschia-sdk/x/group/keeper"
 	minttypes "github.com/Finschia/finschia-sdk/x/mint/types"
 )
 
@@ -27,9 +28,10 @@ type KeeperTestSuite struct {
 
 	ctx sdk.Context
 
-	bankKeeper foundation.BankKeeper
-	keeper     keeper.Keeper
-	impl       internal.Keeper
+	bankKeeper  foundation.BankKeeper
+	groupKeeper groupkeeper.Keeper
+	keeper      keeper.Keeper
+	impl        internal.Keeper
 
 	queryServer     foundation.QueryServer
 	msgServer       foundation.MsgServer
@@ -91,13 +93,16 @@ func (s *KeeperTestSuite) SetupTest() {
 
 	s.ctx = app.BaseApp.NewContext(checkTx, tmproto.Header{})
 	s.bankKeeper = app.BankKeeper
+	s.groupKeeper = app.GroupKeeper
 	s.keeper = app.FoundationKeeper
 	s.impl = internal.NewKeeper(
 		app.AppCodec(),
 		app.GetKey(foundation.ModuleName),
+		app.GetTKey(foundation.TStoreKey),
 		app.MsgServiceRouter(),
 		app.AccountKeeper,
 		app.BankKeeper,
+		app.GroupKeeper,
 		authtypes.FeeCollectorName,
 		foundation.DefaultConfig(),
 		foundation.DefaultAuthority().String(),
@@ -126,6 +131,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	for i := range s.members {
 		member := foundation.Member{
 			Address: s.members[i].String(),
+			Weight:  sdk.OneDec(),
 		}
 		s.impl.SetMember(s.ctx, member)
 	}
@@ -169,7 +175,7 @@ func (s *KeeperTestSuite) SetupTest() {
 			To:        s.stranger.String(),
 			Amount:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, s.balance)),
 		},
-	})
+	}, nil, 0)
 	s.Require().NoError(err)
 	s.activeProposal = *activeProposal
 
@@ -183,7 +189,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	}
 
 	// create a proposal voted no by all members
-	votedProposal, err := s.impl.SubmitProposal(s.ctx, []string{s.members[0].String()}, "", []sdk.Msg{newMsgCreateDog("shiba1")})
+	votedProposal, err := s.impl.SubmitProposal(s.ctx, []string{s.members[0].String()}, "", []sdk.Msg{newMsgCreateDog("shiba1")}, nil, 0)
 	s.Require().NoError(err)
 	s.votedProposal = *votedProposal
 
@@ -197,7 +203,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	}
 
 	// create an withdrawn proposal
-	withdrawnProposal, err := s.impl.SubmitProposal(s.ctx, []string{s.members[0].String()}, "", []sdk.Msg{newMsgCreateDog("shiba2")})
+	withdrawnProposal, err := s.impl.SubmitProposal(s.ctx, []string{s.members[0].String()}, "", []sdk.Msg{newMsgCreateDog("shiba2")}, nil, 0)
 	s.Require().NoError(err)
 	s.withdrawnProposal = *withdrawnProposal
 
@@ -211,7 +217,7 @@ func (s *KeeperTestSuite) SetupTest() {
 			To:        s.stranger.String(),
 			Amount:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, s.balance.Add(sdk.OneInt()))),
 		},
-	})
+	}, nil, 0)
 	s.Require().NoError(err)
 	s.invalidProposal = *invalidProposal
 
@@ -225,7 +231,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	}
 
 	// create an invalid proposal which contains invalid message
-	noHandlerProposal, err := s.impl.SubmitProposal(s.ctx, []string{s.members[0].String()}, "", []sdk.Msg{testdata.NewTestMsg(s.authority)})
+	noHandlerProposal, err := s.impl.SubmitProposal(s.ctx, []string{s.members[0].String()}, "", []sdk.Msg{testdata.NewTestMsg(s.authority)}, nil, 0)
 	s.Require().NoError(err)
 	s.noHandlerProposal = *noHandlerProposal
 
@@ -282,7 +288,7 @@ func TestNewKeeper(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			newKeeper := func() keeper.Keeper {
 				app := simapp.Setup(false)
-				return keeper.NewKeeper(app.AppCodec(), sdk.NewKVStoreKey(foundation.StoreKey), app.MsgServiceRouter(), app.AccountKeeper, app.BankKeeper, authtypes.FeeCollectorName, foundation.DefaultConfig(), tc.authority.String(), app.GetSubspace(foundation.ModuleName))
+				return keeper.NewKeeper(app.AppCodec(), sdk.NewKVStoreKey(foundation.StoreKey), sdk.NewTransientStoreKey(foundation.TStoreKey), app.MsgServiceRouter(), app.AccountKeeper, app.BankKeeper, app.GroupKeeper, authtypes.FeeCollectorName, foundation.DefaultConfig(), tc.authority.String(), app.GetSubspace(foundation.ModuleName))
 			}
 
 			if tc.panics {
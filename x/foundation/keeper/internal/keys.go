@@ -5,6 +5,7 @@ import (
 	"time"
 
 	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/foundation"
 )
 
 // Keys for foundation store
@@ -12,24 +13,40 @@ import (
 var (
 	// reserved for the future usage (cosmos-sdk v0.47)
 	// paramsKey         = []byte{0x00}
-	foundationInfoKey = []byte{0x01}
-
-	memberKeyPrefix          = []byte{0x10}
-	previousProposalIDKey    = []byte{0x11}
-	proposalKeyPrefix        = []byte{0x12}
-	proposalByVPEndKeyPrefix = []byte{0x13}
-	voteKeyPrefix            = []byte{0x14}
-
-	censorshipKeyPrefix = []byte{0x20}
-	grantKeyPrefix      = []byte{0x21}
-
-	poolKey = []byte{0x30}
+	foundationInfoKey              = []byte{0x01}
+	msgTypeDecisionPolicyKeyPrefix = []byte{0x02}
+
+	memberKeyPrefix                       = []byte{0x10}
+	previousProposalIDKey                 = []byte{0x11}
+	proposalKeyPrefix                     = []byte{0x12}
+	proposalByVPEndKeyPrefix              = []byte{0x13}
+	voteKeyPrefix                         = []byte{0x14}
+	proposalByExecuteAfterKeyPrefix       = []byte{0x15}
+	voteDelegationKeyPrefix               = []byte{0x16}
+	memberByExpirationKeyPrefix           = []byte{0x17}
+	proposalByExecuteAfterHeightKeyPrefix = []byte{0x18}
+	proposalByStatusKeyPrefix             = []byte{0x19}
+	proposalByProposerKeyPrefix           = []byte{0x1a}
+	proposalBySubmitTimeKeyPrefix         = []byte{0x1b}
+
+	censorshipKeyPrefix        = []byte{0x20}
+	grantKeyPrefix             = []byte{0x21}
+	grantByExpirationKeyPrefix = []byte{0x22}
+
+	poolKey                       = []byte{0x30}
+	treasuryOutflowLimitKeyPrefix = []byte{0x31}
+	treasuryOutflowKeyPrefix      = []byte{0x32}
 
 	// deprecatedGovMintKey Deprecated. Don't use it again.
 	deprecatedGovMintKey = []byte{0x40}
 	_                    = deprecatedGovMintKey
 )
 
+// Keys for foundation transient store
+var (
+	proposalToArchiveKeyPrefix = []byte{0x00}
+)
+
 // must be constant
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
 
@@ -118,6 +135,208 @@ func splitProposalByVPEndKey(key []byte) (vpEnd time.Time, id uint64) {
 	return
 }
 
+func proposalByExecuteAfterKey(executeAfter time.Time, id uint64) []byte {
+	prefix := proposalByExecuteAfterKeyPrefix
+	executeAfterBz := sdk.FormatTimeBytes(executeAfter)
+	idBz := Uint64ToBytes(id)
+	key := make([]byte, len(prefix)+lenTime+len(idBz))
+
+	begin := 0
+	copy(key[begin:], prefix)
+
+	begin += len(prefix)
+	copy(key[begin:], executeAfterBz)
+
+	begin += len(executeAfterBz)
+	copy(key[begin:], idBz)
+
+	return key
+}
+
+func splitProposalByExecuteAfterKey(key []byte) (executeAfter time.Time, id uint64) {
+	prefix := proposalByExecuteAfterKeyPrefix
+	begin := len(prefix)
+	end := begin + lenTime
+	executeAfter, err := sdk.ParseTimeBytes(key[begin:end])
+	if err != nil {
+		panic(err)
+	}
+
+	begin = end
+	id = Uint64FromBytes(key[begin:])
+
+	return
+}
+
+func proposalByExecuteAfterHeightKey(height, id uint64) []byte {
+	prefix := proposalByExecuteAfterHeightKeyPrefix
+	heightBz := Uint64ToBytes(height)
+	idBz := Uint64ToBytes(id)
+	key := make([]byte, len(prefix)+len(heightBz)+len(idBz))
+
+	begin := 0
+	copy(key[begin:], prefix)
+
+	begin += len(prefix)
+	copy(key[begin:], heightBz)
+
+	begin += len(heightBz)
+	copy(key[begin:], idBz)
+
+	return key
+}
+
+func splitProposalByExecuteAfterHeightKey(key []byte) (height, id uint64) {
+	prefix := proposalByExecuteAfterHeightKeyPrefix
+	begin := len(prefix)
+	end := begin + 8
+	height = Uint64FromBytes(key[begin:end])
+
+	begin = end
+	id = Uint64FromBytes(key[begin:])
+
+	return
+}
+
+func memberByExpirationKey(expiration time.Time, address sdk.AccAddress) []byte {
+	prefix := memberByExpirationKeyPrefix
+	expirationBz := sdk.FormatTimeBytes(expiration)
+	key := make([]byte, len(prefix)+lenTime+len(address))
+
+	begin := 0
+	copy(key[begin:], prefix)
+
+	begin += len(prefix)
+	copy(key[begin:], expirationBz)
+
+	begin += len(expirationBz)
+	copy(key[begin:], address)
+
+	return key
+}
+
+func splitMemberByExpirationKey(key []byte) (expiration time.Time, address sdk.AccAddress) {
+	prefix := memberByExpirationKeyPrefix
+	begin := len(prefix)
+	end := begin + lenTime
+	expiration, err := sdk.ParseTimeBytes(key[begin:end])
+	if err != nil {
+		panic(err)
+	}
+
+	begin = end
+	address = key[begin:]
+
+	return
+}
+
+// proposalByStatusPrefix prefix for the proposals of a specific status
+func proposalByStatusPrefix(status foundation.ProposalStatus) []byte {
+	prefix := proposalByStatusKeyPrefix
+	key := make([]byte, len(prefix)+1)
+
+	copy(key, prefix)
+	key[len(prefix)] = byte(status)
+
+	return key
+}
+
+func proposalByStatusKey(status foundation.ProposalStatus, id uint64) []byte {
+	return append(proposalByStatusPrefix(status), Uint64ToBytes(id)...)
+}
+
+// proposalByProposerPrefix prefix for the proposals of a specific proposer
+func proposalByProposerPrefix(proposer sdk.AccAddress) []byte {
+	prefix := proposalByProposerKeyPrefix
+	key := make([]byte, len(prefix)+1+len(proposer))
+
+	begin := 0
+	copy(key[begin:], prefix)
+
+	begin += len(prefix)
+	key[begin] = byte(len(proposer))
+
+	begin++
+	copy(key[begin:], proposer)
+
+	return key
+}
+
+func proposalByProposerKey(proposer sdk.AccAddress, id uint64) []byte {
+	return append(proposalByProposerPrefix(proposer), Uint64ToBytes(id)...)
+}
+
+func proposalBySubmitTimeKey(submitTime time.Time, id uint64) []byte {
+	prefix := proposalBySubmitTimeKeyPrefix
+	submitTimeBz := sdk.FormatTimeBytes(submitTime)
+	idBz := Uint64ToBytes(id)
+	key := make([]byte, len(prefix)+lenTime+len(idBz))
+
+	begin := 0
+	copy(key[begin:], prefix)
+
+	begin += len(prefix)
+	copy(key[begin:], submitTimeBz)
+
+	begin += len(submitTimeBz)
+	copy(key[begin:], idBz)
+
+	return key
+}
+
+// proposalIndexKeys returns the keys of the secondary indexes of a proposal.
+func proposalIndexKeys(proposal foundation.Proposal) [][]byte {
+	keys := [][]byte{
+		proposalByStatusKey(proposal.Status, proposal.Id),
+		proposalBySubmitTimeKey(proposal.SubmitTime, proposal.Id),
+	}
+	for _, proposer := range proposal.Proposers {
+		keys = append(keys, proposalByProposerKey(sdk.MustAccAddressFromBech32(proposer), proposal.Id))
+	}
+
+	return keys
+}
+
+// splitProposalIndexKey returns the proposal id of any key of the secondary
+// indexes, which always ends with the id.
+func splitProposalIndexKey(key []byte) (id uint64) {
+	return Uint64FromBytes(key[len(key)-8:])
+}
+
+// proposalToArchiveKey key for a specific proposal waiting for the archive
+func proposalToArchiveKey(id uint64) []byte {
+	prefix := proposalToArchiveKeyPrefix
+	idBz := Uint64ToBytes(id)
+	key := make([]byte, len(prefix)+len(idBz))
+
+	copy(key, prefix)
+	copy(key[len(prefix):], idBz)
+
+	return key
+}
+
+// voteDelegationKey key for the vote delegation of a specific delegator from the store
+func voteDelegationKey(delegator sdk.AccAddress) []byte {
+	prefix := voteDelegationKeyPrefix
+	key := make([]byte, len(prefix)+len(delegator))
+
+	copy(key, prefix)
+	copy(key[len(prefix):], delegator)
+
+	return key
+}
+
+// msgTypeDecisionPolicyKey key for the decision policy of a specific message type from the store
+func msgTypeDecisionPolicyKey(url string) []byte {
+	prefix := msgTypeDecisionPolicyKeyPrefix
+	key := make([]byte, len(prefix)+len(url))
+
+	copy(key, prefix)
+	copy(key[len(prefix):], url)
+
+	return key
+}
+
 // memberKey key for a specific member from the store
 func censorshipKey(url string) []byte {
 	prefix := censorshipKeyPrefix
@@ -167,3 +386,83 @@ func splitGrantKey(key []byte) (grantee sdk.AccAddress, url string) {
 
 	return
 }
+
+func grantByExpirationKey(expiration time.Time, grantee sdk.AccAddress, url string) []byte {
+	prefix := grantByExpirationKeyPrefix
+	expirationBz := sdk.FormatTimeBytes(expiration)
+	key := make([]byte, len(prefix)+lenTime+1+len(grantee)+len(url))
+
+	begin := 0
+	copy(key[begin:], prefix)
+
+	begin += len(prefix)
+	copy(key[begin:], expirationBz)
+
+	begin += len(expirationBz)
+	key[begin] = byte(len(grantee))
+
+	begin++
+	copy(key[begin:], grantee)
+
+	begin += len(grantee)
+	copy(key[begin:], url)
+
+	return key
+}
+
+func splitGrantByExpirationKey(key []byte) (expiration time.Time, grantee sdk.AccAddress, url string) {
+	prefix := grantByExpirationKeyPrefix
+	begin := len(prefix)
+	end := begin + lenTime
+	expiration, err := sdk.ParseTimeBytes(key[begin:end])
+	if err != nil {
+		panic(err)
+	}
+
+	begin = end + 1
+	end = begin + int(key[begin-1])
+	grantee = key[begin:end]
+
+	begin = end
+	url = string(key[begin:])
+
+	return
+}
+
+// treasuryOutflowLimitKey key for the outflow limit of a specific denom from the store
+func treasuryOutflowLimitKey(denom string) []byte {
+	prefix := treasuryOutflowLimitKeyPrefix
+	key := make([]byte, len(prefix)+len(denom))
+
+	copy(key, prefix)
+	copy(key[len(prefix):], denom)
+
+	return key
+}
+
+func treasuryOutflowKey(denom string, time time.Time) []byte {
+	prefix := treasuryOutflowKeyPrefixByDenom(denom)
+	timeBz := sdk.FormatTimeBytes(time)
+	key := make([]byte, len(prefix)+lenTime)
+
+	copy(key, prefix)
+	copy(key[len(prefix):], timeBz)
+
+	return key
+}
+
+func treasuryOutflowKeyPrefixByDenom(denom string) []byte {
+	prefix := treasuryOutflowKeyPrefix
+	key := make([]byte, len(prefix)+1+len(denom))
+
+	begin := 0
+	copy(key[begin:], prefix)
+
+	begin += len(prefix)
+	key[begin] = byte(len(denom))
+
+	begin++
+	copy(key[begin:], denom)
+
+	return key
+}
@@ -1,6 +1,8 @@
 package internal
 
 import (
+	"time"
+
 	sdk "github.com/Finschia/finschia-sdk/types"
 	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
@@ -18,6 +20,12 @@ func validateMetadata(metadata string, config foundation.Config) error {
 
 func (k Keeper) UpdateDecisionPolicy(ctx sdk.Context, policy foundation.DecisionPolicy) error {
 	info := k.GetFoundationInfo(ctx)
+
+	// migrate the foundation into x/group on outsourcing
+	if outsourcing, ok := policy.(*foundation.OutsourcingDecisionPolicy); ok && !info.TotalWeight.IsZero() {
+		return k.outsource(ctx, outsourcing)
+	}
+
 	if err := info.SetDecisionPolicy(policy); err != nil {
 		return err
 	}
@@ -55,34 +63,45 @@ func (k Keeper) SetFoundationInfo(ctx sdk.Context, info foundation.FoundationInf
 func (k Keeper) UpdateMembers(ctx sdk.Context, members []foundation.MemberRequest) error {
 	weightUpdate := sdk.ZeroDec()
 	for _, request := range members {
-		new := foundation.Member{
-			Address:  request.Address,
-			Metadata: request.Metadata,
-			AddedAt:  ctx.BlockTime(),
-		}
-		if err := new.ValidateBasic(); err != nil {
-			panic(err)
-		}
-		if err := validateMetadata(new.Metadata, k.config); err != nil {
+		if err := validateMetadata(request.Metadata, k.config); err != nil {
 			return err
 		}
 
-		addr := sdk.MustAccAddressFromBech32(new.Address)
+		addr := sdk.MustAccAddressFromBech32(request.Address)
 		old, err := k.GetMember(ctx, addr)
 		if err != nil && request.Remove { // the member must exist
 			return err
 		}
-		if err == nil { // overwrite
-			weightUpdate = weightUpdate.Sub(sdk.OneDec())
-			new.AddedAt = old.AddedAt
-		}
 
 		if request.Remove {
+			weightUpdate = weightUpdate.Sub(old.Weight)
 			k.deleteMember(ctx, addr)
-		} else {
-			weightUpdate = weightUpdate.Add(sdk.OneDec())
-			k.SetMember(ctx, new)
+			k.removeVoteDelegationsOf(ctx, addr)
+			continue
+		}
+
+		if request.ExpiresAt != nil && !request.ExpiresAt.After(ctx.BlockTime()) {
+			return sdkerrors.ErrInvalidRequest.Wrapf("membership of %s must expire in the future", request.Address)
+		}
+
+		new := foundation.Member{
+			Address:   request.Address,
+			Metadata:  request.Metadata,
+			AddedAt:   ctx.BlockTime(),
+			Weight:    request.Weight,
+			ExpiresAt: request.ExpiresAt,
+		}
+		if err := new.ValidateBasic(); err != nil {
+			panic(err)
 		}
+
+		if err == nil { // overwrite
+			weightUpdate = weightUpdate.Sub(old.Weight)
+			new.AddedAt = old.AddedAt
+		}
+
+		weightUpdate = weightUpdate.Add(new.Weight)
+		k.SetMember(ctx, new)
 	}
 
 	info := k.GetFoundationInfo(ctx)
@@ -90,7 +109,7 @@ func (k Keeper) UpdateMembers(ctx sdk.Context, members []foundation.MemberReques
 	info.Version++
 	k.SetFoundationInfo(ctx, info)
 
-	if err := info.GetDecisionPolicy().Validate(info, k.config); err != nil {
+	if err := k.validateDecisionPolicies(ctx, info); err != nil {
 		return err
 	}
 
@@ -117,18 +136,124 @@ func (k Keeper) GetMember(ctx sdk.Context, address sdk.AccAddress) (*foundation.
 func (k Keeper) SetMember(ctx sdk.Context, member foundation.Member) {
 	store := ctx.KVStore(k.storeKey)
 	addr := sdk.MustAccAddressFromBech32(member.Address)
-	key := memberKey(addr)
 
+	// refresh the expiration queue
+	if old, err := k.GetMember(ctx, addr); err == nil && old.ExpiresAt != nil {
+		store.Delete(memberByExpirationKey(*old.ExpiresAt, addr))
+	}
+	if member.ExpiresAt != nil {
+		store.Set(memberByExpirationKey(*member.ExpiresAt, addr), []byte{})
+	}
+
+	key := memberKey(addr)
 	bz := k.cdc.MustMarshal(&member)
 	store.Set(key, bz)
 }
 
 func (k Keeper) deleteMember(ctx sdk.Context, address sdk.AccAddress) {
 	store := ctx.KVStore(k.storeKey)
+
+	if member, err := k.GetMember(ctx, address); err == nil && member.ExpiresAt != nil {
+		store.Delete(memberByExpirationKey(*member.ExpiresAt, address))
+	}
+
 	key := memberKey(address)
 	store.Delete(key)
 }
 
+// RemoveExpiredMembers removes the members whose memberships have expired
+// by the current block time, all at once. The removal is the same as the one
+// by UpdateMembers, so it aborts the active proposals only once per block.
+func (k Keeper) RemoveExpiredMembers(ctx sdk.Context) {
+	var addresses []sdk.AccAddress
+	k.iterateMembersByExpiration(ctx, ctx.BlockTime(), func(address sdk.AccAddress) (stop bool) {
+		addresses = append(addresses, address)
+		return false
+	})
+	if len(addresses) == 0 {
+		return
+	}
+
+	requests := make([]foundation.MemberRequest, len(addresses))
+	for i, address := range addresses {
+		requests[i] = foundation.MemberRequest{
+			Address: address.String(),
+			Remove:  true,
+		}
+	}
+
+	var errStr string
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.UpdateMembers(cacheCtx, requests); err != nil {
+		// the removal would invalidate the decision policies (e.g. all the
+		// members have expired), so the members are left to the authority.
+		// meanwhile, their weights are excluded from the tally.
+		k.Logger(ctx).Error("failed to remove expired members", "addresses", addresses, "error", err)
+		for _, address := range addresses {
+			k.dequeueExpiredMember(ctx, address)
+		}
+		errStr = err.Error()
+	} else {
+		writeCache()
+	}
+
+	for _, address := range addresses {
+		if err := ctx.EventManager().EmitTypedEvent(&foundation.EventExpireMember{
+			Address: address.String(),
+			Error:   errStr,
+		}); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// isExpired returns whether the membership has expired by the current block
+// time. The removal of the member may be pending.
+func isExpired(ctx sdk.Context, member foundation.Member) bool {
+	return member.ExpiresAt != nil && !ctx.BlockTime().Before(*member.ExpiresAt)
+}
+
+// getExpiredWeight returns the sum of the weights of the members whose
+// memberships have expired but who have not been removed yet.
+func (k Keeper) getExpiredWeight(ctx sdk.Context) sdk.Dec {
+	weight := sdk.ZeroDec()
+	k.iterateMembers(ctx, func(member foundation.Member) (stop bool) {
+		if isExpired(ctx, member) {
+			weight = weight.Add(member.Weight)
+		}
+		return false
+	})
+
+	return weight
+}
+
+// dequeueExpiredMember removes the member from the expiration queue, keeping
+// its membership.
+func (k Keeper) dequeueExpiredMember(ctx sdk.Context, address sdk.AccAddress) {
+	member, err := k.GetMember(ctx, address)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(memberByExpirationKey(*member.ExpiresAt, address))
+}
+
+// iterateMembersByExpiration iterates over the members whose memberships
+// expire at or before the given time, in chronological order.
+func (k Keeper) iterateMembersByExpiration(ctx sdk.Context, endTime time.Time, fn func(address sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(memberByExpirationKeyPrefix, sdk.PrefixEndBytes(append(memberByExpirationKeyPrefix, sdk.FormatTimeBytes(endTime)...)))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		_, address := splitMemberByExpirationKey(iter.Key())
+		if stop := fn(address); stop {
+			break
+		}
+	}
+}
+
 func (k Keeper) iterateMembers(ctx sdk.Context, fn func(member foundation.Member) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
 	prefix := memberKeyPrefix
@@ -162,6 +287,16 @@ func (k Keeper) validateAuthority(authority string) error {
 	return nil
 }
 
+// validateGovAuthority checks the authority is x/gov, which the foundation
+// cannot act on behalf of.
+func (k Keeper) validateGovAuthority(authority string) error {
+	if expected := authtypes.NewModuleAddress(govtypes.ModuleName).String(); authority != expected {
+		return sdkerrors.ErrUnauthorized.Wrapf("invalid authority; expected %s, got %s", expected, authority)
+	}
+
+	return nil
+}
+
 func (k Keeper) validateCensorshipAuthority(ctx sdk.Context, msgTypeURL, authority string) error {
 	censorship, err := k.GetCensorship(ctx, msgTypeURL)
 	if err != nil {
@@ -182,9 +317,15 @@ func (k Keeper) validateCensorshipAuthority(ctx sdk.Context, msgTypeURL, authori
 func (k Keeper) validateMembers(ctx sdk.Context, members []string) error {
 	for _, member := range members {
 		addr := sdk.MustAccAddressFromBech32(member)
-		if _, err := k.GetMember(ctx, addr); err != nil {
+		m, err := k.GetMember(ctx, addr)
+		if err != nil {
 			return sdkerrors.ErrUnauthorized.Wrapf("%s is not a member", member)
 		}
+
+		// the removal of an expired member may be pending
+		if isExpired(ctx, *m) {
+			return sdkerrors.ErrUnauthorized.Wrapf("membership of %s has expired", member)
+		}
 	}
 
 	return nil
@@ -3,7 +3,10 @@ package internal_test
 import (
 	"time"
 
+	abci "github.com/tendermint/tendermint/abci/types"
+
 	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 	"github.com/Finschia/finschia-sdk/x/foundation"
 )
 
@@ -48,6 +51,8 @@ func (s *KeeperTestSuite) TestUpdateDecisionPolicy() {
 }
 
 func (s *KeeperTestSuite) TestUpdateMembers() {
+	now := s.ctx.BlockTime()
+	future := now.Add(time.Hour)
 	testCases := map[string]struct {
 		updates []foundation.MemberRequest
 		valid   bool
@@ -56,6 +61,7 @@ func (s *KeeperTestSuite) TestUpdateMembers() {
 			updates: []foundation.MemberRequest{
 				{
 					Address: s.stranger.String(),
+					Weight:  sdk.OneDec(),
 				},
 			},
 			valid: true,
@@ -82,6 +88,26 @@ func (s *KeeperTestSuite) TestUpdateMembers() {
 				{
 					Address:  s.stranger.String(),
 					Metadata: string(make([]rune, 256)),
+					Weight:   sdk.OneDec(),
+				},
+			},
+		},
+		"add an expiring member": {
+			updates: []foundation.MemberRequest{
+				{
+					Address:   s.stranger.String(),
+					Weight:    sdk.OneDec(),
+					ExpiresAt: &future,
+				},
+			},
+			valid: true,
+		},
+		"already expired": {
+			updates: []foundation.MemberRequest{
+				{
+					Address:   s.stranger.String(),
+					Weight:    sdk.OneDec(),
+					ExpiresAt: &now,
 				},
 			},
 		},
@@ -100,3 +126,283 @@ func (s *KeeperTestSuite) TestUpdateMembers() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestUpdateMembersWeight() {
+	ctx, _ := s.ctx.CacheContext()
+	before := s.impl.GetFoundationInfo(ctx).TotalWeight
+
+	err := s.impl.UpdateMembers(ctx, []foundation.MemberRequest{
+		{
+			Address: s.stranger.String(),
+			Weight:  sdk.NewDec(3),
+		},
+		{
+			Address: s.members[0].String(),
+			Weight:  sdk.NewDec(5),
+		},
+		{
+			Address: s.members[1].String(),
+			Remove:  true,
+		},
+	})
+	s.Require().NoError(err)
+
+	// +3 (new member), +4 (1 -> 5), -1 (removed member)
+	expected := before.Add(sdk.NewDec(3 + 4 - 1))
+	s.Require().Equal(expected, s.impl.GetFoundationInfo(ctx).TotalWeight)
+
+	member, err := s.impl.GetMember(ctx, s.members[0])
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDec(5), member.Weight)
+}
+
+func (s *KeeperTestSuite) TestRemoveExpiredMembers() {
+	expiresAt := s.ctx.BlockTime().Add(time.Hour)
+	testCases := map[string]struct {
+		malleate func(ctx sdk.Context)
+		after    time.Duration
+		removed  bool
+	}{
+		"not expired yet": {
+			after: time.Hour - time.Nanosecond,
+		},
+		"expired": {
+			after:   time.Hour,
+			removed: true,
+		},
+		"renewed": {
+			malleate: func(ctx sdk.Context) {
+				err := s.impl.UpdateMembers(ctx, []foundation.MemberRequest{{
+					Address: s.stranger.String(),
+					Weight:  sdk.OneDec(),
+				}})
+				s.Require().NoError(err)
+			},
+			after: time.Hour,
+		},
+		"all the members expired": {
+			malleate: func(ctx sdk.Context) {
+				// the stranger would be the only member
+				requests := make([]foundation.MemberRequest, len(s.members))
+				for i, member := range s.members {
+					requests[i] = foundation.MemberRequest{
+						Address: member.String(),
+						Remove:  true,
+					}
+				}
+				err := s.impl.UpdateMembers(ctx, requests)
+				s.Require().NoError(err)
+			},
+			after: time.Hour,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			err := s.impl.UpdateMembers(ctx, []foundation.MemberRequest{{
+				Address:   s.stranger.String(),
+				Weight:    sdk.OneDec(),
+				ExpiresAt: &expiresAt,
+			}})
+			s.Require().NoError(err)
+
+			if tc.malleate != nil {
+				tc.malleate(ctx)
+			}
+			before := s.impl.GetFoundationInfo(ctx)
+
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(tc.after))
+			s.impl.RemoveExpiredMembers(ctx)
+
+			_, err = s.impl.GetMember(ctx, s.stranger)
+			info := s.impl.GetFoundationInfo(ctx)
+			if !tc.removed {
+				s.Require().NoError(err)
+				s.Require().Equal(before, info)
+				return
+			}
+			s.Require().Error(err)
+			s.Require().Equal(before.TotalWeight.Sub(sdk.OneDec()), info.TotalWeight)
+
+			// the active proposals are aborted
+			s.Require().Equal(before.Version+1, info.Version)
+			proposal, err := s.impl.GetProposal(ctx, s.activeProposal)
+			s.Require().NoError(err)
+			s.Require().Equal(foundation.PROPOSAL_STATUS_ABORTED, proposal.Status)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestRemoveExpiredMembersAtOnce() {
+	ctx, _ := s.ctx.CacheContext()
+
+	expiresAt := ctx.BlockTime().Add(time.Hour)
+	expiring := []sdk.AccAddress{s.stranger, s.members[0], s.members[1]}
+	requests := make([]foundation.MemberRequest, len(expiring))
+	for i, address := range expiring {
+		requests[i] = foundation.MemberRequest{
+			Address:   address.String(),
+			Weight:    sdk.OneDec(),
+			ExpiresAt: &expiresAt,
+		}
+	}
+	err := s.impl.UpdateMembers(ctx, requests)
+	s.Require().NoError(err)
+
+	before := s.impl.GetFoundationInfo(ctx)
+	ctx = ctx.WithBlockTime(expiresAt).WithEventManager(sdk.NewEventManager())
+	s.impl.RemoveExpiredMembers(ctx)
+
+	for _, address := range expiring {
+		_, err := s.impl.GetMember(ctx, address)
+		s.Require().Error(err)
+	}
+
+	// the active proposals are aborted only once
+	info := s.impl.GetFoundationInfo(ctx)
+	s.Require().Equal(before.TotalWeight.Sub(sdk.NewDec(int64(len(expiring)))), info.TotalWeight)
+	s.Require().Equal(before.Version+1, info.Version)
+	proposal, err := s.impl.GetProposal(ctx, s.activeProposal)
+	s.Require().NoError(err)
+	s.Require().Equal(foundation.PROPOSAL_STATUS_ABORTED, proposal.Status)
+
+	// the event is emitted for each of them
+	var expired []string
+	for _, e := range ctx.EventManager().Events() {
+		event, err := sdk.ParseTypedEvent(abci.Event(e))
+		s.Require().NoError(err)
+		if expire, ok := event.(*foundation.EventExpireMember); ok {
+			s.Require().Empty(expire.Error)
+			expired = append(expired, expire.Address)
+		}
+	}
+	expectedExpired := make([]string, len(expiring))
+	for i, address := range expiring {
+		expectedExpired[i] = address.String()
+	}
+	s.Require().ElementsMatch(expectedExpired, expired)
+}
+
+func (s *KeeperTestSuite) TestExpiredMemberPendingRemoval() {
+	ctx, _ := s.ctx.CacheContext()
+
+	// the stranger would be the only member
+	expiresAt := ctx.BlockTime().Add(time.Hour)
+	requests := []foundation.MemberRequest{{
+		Address:   s.stranger.String(),
+		Weight:    sdk.OneDec(),
+		ExpiresAt: &expiresAt,
+	}}
+	for _, member := range s.members {
+		requests = append(requests, foundation.MemberRequest{
+			Address: member.String(),
+			Remove:  true,
+		})
+	}
+	err := s.impl.UpdateMembers(ctx, requests)
+	s.Require().NoError(err)
+
+	// the removal would invalidate the decision policy
+	ctx = ctx.WithBlockTime(expiresAt).WithEventManager(sdk.NewEventManager())
+	s.impl.RemoveExpiredMembers(ctx)
+	_, err = s.impl.GetMember(ctx, s.stranger)
+	s.Require().NoError(err)
+
+	// the failure is emitted
+	events := ctx.EventManager().Events()
+	s.Require().Len(events, 1)
+	event, err := sdk.ParseTypedEvent(abci.Event(events[0]))
+	s.Require().NoError(err)
+	expired, ok := event.(*foundation.EventExpireMember)
+	s.Require().True(ok)
+	s.Require().Equal(s.stranger.String(), expired.Address)
+	s.Require().NotEmpty(expired.Error)
+
+	// not retried, but left to the authority
+	err = s.impl.UpdateMembers(ctx, []foundation.MemberRequest{{
+		Address: s.members[0].String(),
+		Weight:  sdk.OneDec(),
+	}})
+	s.Require().NoError(err)
+	s.impl.RemoveExpiredMembers(ctx)
+	_, err = s.impl.GetMember(ctx, s.stranger)
+	s.Require().NoError(err)
+
+	// and it cannot act
+	req := &foundation.MsgVote{
+		ProposalId: s.activeProposal,
+		Voter:      s.stranger.String(),
+		Option:     foundation.VOTE_OPTION_YES,
+	}
+	_, err = s.msgServer.Vote(sdk.WrapSDKContext(ctx), req)
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// but it can leave
+	_, err = s.msgServer.LeaveFoundation(sdk.WrapSDKContext(ctx), &foundation.MsgLeaveFoundation{
+		Address: s.stranger.String(),
+	})
+	s.Require().NoError(err)
+	_, err = s.impl.GetMember(ctx, s.stranger)
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestExpiredMemberWeight() {
+	ctx, _ := s.ctx.CacheContext()
+
+	// the stranger and the first member would be the only members
+	expiresAt := ctx.BlockTime().Add(time.Hour)
+	requests := []foundation.MemberRequest{
+		{
+			Address:   s.stranger.String(),
+			Weight:    sdk.OneDec(),
+			ExpiresAt: &expiresAt,
+		},
+		{
+			Address:   s.members[0].String(),
+			Weight:    sdk.OneDec(),
+			ExpiresAt: &expiresAt,
+		},
+	}
+	for _, member := range s.members[1:] {
+		requests = append(requests, foundation.MemberRequest{
+			Address: member.String(),
+			Remove:  true,
+		})
+	}
+	err := s.impl.UpdateMembers(ctx, requests)
+	s.Require().NoError(err)
+
+	// the first member delegates its vote to the stranger, who votes yes
+	err = s.impl.DelegateVote(ctx, s.members[0], s.stranger)
+	s.Require().NoError(err)
+	proposalID, err := s.impl.SubmitProposal(ctx, []string{s.stranger.String()}, "", []sdk.Msg{newMsgCreateDog("shiba5")}, nil, 0)
+	s.Require().NoError(err)
+	err = s.impl.Vote(ctx, foundation.Vote{
+		ProposalId: *proposalID,
+		Voter:      s.stranger.String(),
+		Option:     foundation.VOTE_OPTION_YES,
+	})
+	s.Require().NoError(err)
+
+	// the removal fails, because no member would be left
+	ctx = ctx.WithBlockTime(expiresAt)
+	s.impl.RemoveExpiredMembers(ctx)
+	_, err = s.impl.GetMember(ctx, s.stranger)
+	s.Require().NoError(err)
+
+	// neither the vote nor the delegation of the expired members is counted
+	res, err := s.queryServer.TallyResult(sdk.WrapSDKContext(ctx), &foundation.QueryTallyResultRequest{
+		ProposalId: *proposalID,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(foundation.DefaultTallyResult(), res.Tally)
+
+	// so the proposal cannot be accepted
+	err = s.impl.Exec(ctx, *proposalID)
+	s.Require().NoError(err)
+	proposal, err := s.impl.GetProposal(ctx, *proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(foundation.PROPOSAL_STATUS_SUBMITTED, proposal.Status)
+}
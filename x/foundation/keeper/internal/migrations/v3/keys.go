@@ -0,0 +1,3 @@
+package v3
+
+var MemberKeyPrefix = []byte{0x10}
@@ -0,0 +1,50 @@
+package v3
+
+import (
+	"github.com/Finschia/finschia-sdk/codec"
+	storetypes "github.com/Finschia/finschia-sdk/store/types"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+)
+
+// MigrateStore performs in-place store migrations from v2 to v3.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	// migrate members
+	if err := migrateMembers(store, cdc); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// migrateMembers sets the weight of the existing members to one, which keeps
+// the total weight of the foundation, the number of the members in v2.
+func migrateMembers(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	iterator := sdk.KVStorePrefixIterator(store, MemberKeyPrefix)
+	defer iterator.Close()
+
+	var keys [][]byte
+	var members []foundation.Member
+	for ; iterator.Valid(); iterator.Next() {
+		var member foundation.Member
+		if err := cdc.Unmarshal(iterator.Value(), &member); err != nil {
+			return err
+		}
+		member.Weight = sdk.OneDec()
+
+		keys = append(keys, iterator.Key())
+		members = append(members, member)
+	}
+
+	for i := range members {
+		bz, err := cdc.Marshal(&members[i])
+		if err != nil {
+			return err
+		}
+		store.Set(keys[i], bz)
+	}
+
+	return nil
+}
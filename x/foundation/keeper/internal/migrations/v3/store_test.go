@@ -0,0 +1,82 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/finschia-sdk/crypto/keys/secp256k1"
+	simappparams "github.com/Finschia/finschia-sdk/simapp/params"
+	"github.com/Finschia/finschia-sdk/testutil"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+	"github.com/Finschia/finschia-sdk/x/foundation/keeper/internal/migrations/v3"
+)
+
+func TestMigrateStore(t *testing.T) {
+	foundationKey := sdk.NewKVStoreKey(foundation.StoreKey)
+	newKey := sdk.NewTransientStoreKey("transient_test")
+	encCfg := simappparams.MakeTestEncodingConfig()
+	ctx := testutil.DefaultContext(foundationKey, newKey)
+
+	memberKey := func(addr sdk.AccAddress) []byte {
+		return append(append([]byte{}, v3.MemberKeyPrefix...), addr...)
+	}
+
+	for name, tc := range map[string]struct {
+		malleate func(ctx sdk.Context)
+		valid    bool
+	}{
+		"valid": {
+			valid: true,
+		},
+		"unmarshal fails": {
+			malleate: func(ctx sdk.Context) {
+				// invalid contents
+				store := ctx.KVStore(foundationKey)
+				store.Set(memberKey(sdk.AccAddress("invalid")), []byte("invalid"))
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+
+			// set old members, which have no weight
+			addrs := make([]sdk.AccAddress, 3)
+			for i := range addrs {
+				addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+				bz := encCfg.Marshaler.MustMarshal(&foundation.Member{
+					Address:  addrs[i].String(),
+					Metadata: "old member",
+				})
+				store := ctx.KVStore(foundationKey)
+				store.Set(memberKey(addrs[i]), bz)
+			}
+
+			if tc.malleate != nil {
+				tc.malleate(ctx)
+			}
+
+			// migrate
+			err := v3.MigrateStore(ctx, foundationKey, encCfg.Marshaler)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			store := ctx.KVStore(foundationKey)
+			for _, addr := range addrs {
+				bz := store.Get(memberKey(addr))
+				require.NotNil(t, bz)
+
+				var member foundation.Member
+				encCfg.Marshaler.MustUnmarshal(bz, &member)
+				require.Equal(t, addr.String(), member.Address)
+				require.Equal(t, "old member", member.Metadata)
+				require.Equal(t, sdk.OneDec(), member.Weight)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package v4
+
+import (
+	"encoding/binary"
+	"time"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+)
+
+var (
+	ProposalKeyPrefix             = []byte{0x12}
+	ProposalByStatusKeyPrefix     = []byte{0x19}
+	ProposalByProposerKeyPrefix   = []byte{0x1a}
+	ProposalBySubmitTimeKeyPrefix = []byte{0x1b}
+)
+
+func uint64ToBytes(number uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, number)
+	return bz
+}
+
+func ProposalByStatusKey(status foundation.ProposalStatus, id uint64) []byte {
+	key := append([]byte{}, ProposalByStatusKeyPrefix...)
+	key = append(key, byte(status))
+	return append(key, uint64ToBytes(id)...)
+}
+
+func ProposalByProposerKey(proposer sdk.AccAddress, id uint64) []byte {
+	key := append([]byte{}, ProposalByProposerKeyPrefix...)
+	key = append(key, byte(len(proposer)))
+	key = append(key, proposer...)
+	return append(key, uint64ToBytes(id)...)
+}
+
+func ProposalBySubmitTimeKey(submitTime time.Time, id uint64) []byte {
+	key := append([]byte{}, ProposalBySubmitTimeKeyPrefix...)
+	key = append(key, sdk.FormatTimeBytes(submitTime)...)
+	return append(key, uint64ToBytes(id)...)
+}
@@ -0,0 +1,53 @@
+package v4
+
+import (
+	"github.com/Finschia/finschia-sdk/codec"
+	storetypes "github.com/Finschia/finschia-sdk/store/types"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+)
+
+// MigrateStore performs in-place store migrations from v3 to v4.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	// index proposals
+	if err := indexProposals(store, cdc); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// indexProposals builds the indexes of the existing proposals by status,
+// proposer and submit time, which v3 does not have.
+func indexProposals(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	iterator := sdk.KVStorePrefixIterator(store, ProposalKeyPrefix)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		var proposal foundation.Proposal
+		if err := cdc.Unmarshal(iterator.Value(), &proposal); err != nil {
+			return err
+		}
+
+		keys = append(keys,
+			ProposalByStatusKey(proposal.Status, proposal.Id),
+			ProposalBySubmitTimeKey(proposal.SubmitTime, proposal.Id),
+		)
+		for _, proposer := range proposal.Proposers {
+			addr, err := sdk.AccAddressFromBech32(proposer)
+			if err != nil {
+				return err
+			}
+			keys = append(keys, ProposalByProposerKey(addr, proposal.Id))
+		}
+	}
+
+	for _, key := range keys {
+		store.Set(key, []byte{})
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package v4_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/finschia-sdk/crypto/keys/secp256k1"
+	simappparams "github.com/Finschia/finschia-sdk/simapp/params"
+	"github.com/Finschia/finschia-sdk/testutil"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+	"github.com/Finschia/finschia-sdk/x/foundation/keeper/internal/migrations/v4"
+)
+
+func TestMigrateStore(t *testing.T) {
+	foundationKey := sdk.NewKVStoreKey(foundation.StoreKey)
+	newKey := sdk.NewTransientStoreKey("transient_test")
+	encCfg := simappparams.MakeTestEncodingConfig()
+	ctx := testutil.DefaultContext(foundationKey, newKey)
+
+	proposalKey := func(id uint64) []byte {
+		return append(append([]byte{}, v4.ProposalKeyPrefix...), sdk.Uint64ToBigEndian(id)...)
+	}
+
+	for name, tc := range map[string]struct {
+		malleate func(ctx sdk.Context)
+		valid    bool
+	}{
+		"valid": {
+			valid: true,
+		},
+		"unmarshal fails": {
+			malleate: func(ctx sdk.Context) {
+				// invalid contents
+				store := ctx.KVStore(foundationKey)
+				store.Set(proposalKey(0), []byte("invalid"))
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+
+			// set old proposals, which have no index
+			proposer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+			submitTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+			statuses := []foundation.ProposalStatus{
+				foundation.PROPOSAL_STATUS_SUBMITTED,
+				foundation.PROPOSAL_STATUS_ACCEPTED,
+				foundation.PROPOSAL_STATUS_WITHDRAWN,
+			}
+			for i, status := range statuses {
+				id := uint64(i + 1)
+				bz := encCfg.Marshaler.MustMarshal(&foundation.Proposal{
+					Id:         id,
+					Proposers:  []string{proposer.String()},
+					SubmitTime: submitTime,
+					Status:     status,
+				})
+				store := ctx.KVStore(foundationKey)
+				store.Set(proposalKey(id), bz)
+			}
+
+			if tc.malleate != nil {
+				tc.malleate(ctx)
+			}
+
+			// migrate
+			err := v4.MigrateStore(ctx, foundationKey, encCfg.Marshaler)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			store := ctx.KVStore(foundationKey)
+			for i, status := range statuses {
+				id := uint64(i + 1)
+				require.True(t, store.Has(v4.ProposalByStatusKey(status, id)))
+				require.True(t, store.Has(v4.ProposalByProposerKey(proposer, id)))
+				require.True(t, store.Has(v4.ProposalBySubmitTimeKey(submitTime, id)))
+			}
+		})
+	}
+}
@@ -112,6 +112,33 @@ func (s msgServer) UpdateDecisionPolicy(c context.Context, req *foundation.MsgUp
 	return &foundation.MsgUpdateDecisionPolicyResponse{}, nil
 }
 
+func (s msgServer) UpdateMsgTypeDecisionPolicy(c context.Context, req *foundation.MsgUpdateMsgTypeDecisionPolicy) (*foundation.MsgUpdateMsgTypeDecisionPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := s.keeper.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	policy := req.GetDecisionPolicy()
+	if err := s.keeper.UpdateMsgTypeDecisionPolicy(ctx, req.MsgTypeUrl, policy); err != nil {
+		return nil, err
+	}
+
+	event := &foundation.EventUpdateMsgTypeDecisionPolicy{
+		MsgTypeUrl: req.MsgTypeUrl,
+	}
+	if policy != nil {
+		if err := event.SetDecisionPolicy(policy); err != nil {
+			return nil, err
+		}
+	}
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		panic(err)
+	}
+
+	return &foundation.MsgUpdateMsgTypeDecisionPolicyResponse{}, nil
+}
+
 func (s msgServer) SubmitProposal(c context.Context, req *foundation.MsgSubmitProposal) (*foundation.MsgSubmitProposalResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -119,7 +146,7 @@ func (s msgServer) SubmitProposal(c context.Context, req *foundation.MsgSubmitPr
 		return nil, err
 	}
 
-	id, err := s.keeper.SubmitProposal(ctx, req.Proposers, req.Metadata, req.GetMsgs())
+	id, err := s.keeper.SubmitProposal(ctx, req.Proposers, req.Metadata, req.GetMsgs(), req.ExecuteAfter, req.ExecuteAfterHeight)
 	if err != nil {
 		return nil, err
 	}
@@ -217,6 +244,42 @@ func (s msgServer) Vote(c context.Context, req *foundation.MsgVote) (*foundation
 	return &foundation.MsgVoteResponse{}, nil
 }
 
+func (s msgServer) DelegateVote(c context.Context, req *foundation.MsgDelegateVote) (*foundation.MsgDelegateVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	delegator := sdk.MustAccAddressFromBech32(req.Delegator)
+	delegate := sdk.MustAccAddressFromBech32(req.Delegate)
+	if err := s.keeper.DelegateVote(ctx, delegator, delegate); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&foundation.EventDelegateVote{
+		Delegator: req.Delegator,
+		Delegate:  req.Delegate,
+	}); err != nil {
+		panic(err)
+	}
+
+	return &foundation.MsgDelegateVoteResponse{}, nil
+}
+
+func (s msgServer) UndelegateVote(c context.Context, req *foundation.MsgUndelegateVote) (*foundation.MsgUndelegateVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	delegator := sdk.MustAccAddressFromBech32(req.Delegator)
+	if err := s.keeper.UndelegateVote(ctx, delegator); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&foundation.EventUndelegateVote{
+		Delegator: req.Delegator,
+	}); err != nil {
+		panic(err)
+	}
+
+	return &foundation.MsgUndelegateVoteResponse{}, nil
+}
+
 func (s msgServer) Exec(c context.Context, req *foundation.MsgExec) (*foundation.MsgExecResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -234,8 +297,9 @@ func (s msgServer) Exec(c context.Context, req *foundation.MsgExec) (*foundation
 func (s msgServer) LeaveFoundation(c context.Context, req *foundation.MsgLeaveFoundation) (*foundation.MsgLeaveFoundationResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
-	if err := s.keeper.validateMembers(ctx, []string{req.Address}); err != nil {
-		return nil, err
+	// the member whose membership has expired can also leave
+	if _, err := s.keeper.GetMember(ctx, sdk.MustAccAddressFromBech32(req.Address)); err != nil {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("%s is not a member", req.Address)
 	}
 
 	update := foundation.MemberRequest{
@@ -309,3 +373,47 @@ func (s msgServer) Revoke(c context.Context, req *foundation.MsgRevoke) (*founda
 
 	return &foundation.MsgRevokeResponse{}, nil
 }
+
+func (s msgServer) ExecAsAuthority(c context.Context, req *foundation.MsgExecAsAuthority) (*foundation.MsgExecAsAuthorityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	groupPolicy := sdk.MustAccAddressFromBech32(req.GroupPolicyAddress)
+	results, err := s.keeper.ExecAsAuthority(ctx, groupPolicy, req.GetMsgs())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, res := range results {
+		// NOTE: The sdk msg handler creates a new EventManager, so events must be correctly propagated back to the current context
+		ctx.EventManager().EmitEvents(res.GetEvents())
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&foundation.EventExecAsAuthority{
+		GroupPolicyAddress: req.GroupPolicyAddress,
+	}); err != nil {
+		panic(err)
+	}
+
+	return &foundation.MsgExecAsAuthorityResponse{}, nil
+}
+
+func (s msgServer) UpdateTreasuryOutflowLimit(c context.Context, req *foundation.MsgUpdateTreasuryOutflowLimit) (*foundation.MsgUpdateTreasuryOutflowLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := s.keeper.validateGovAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := s.keeper.UpdateTreasuryOutflowLimit(ctx, req.Limit, req.Remove); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&foundation.EventUpdateTreasuryOutflowLimit{
+		Limit:  req.Limit,
+		Remove: req.Remove,
+	}); err != nil {
+		panic(err)
+	}
+
+	return &foundation.MsgUpdateTreasuryOutflowLimitResponse{}, nil
+}
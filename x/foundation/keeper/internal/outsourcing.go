@@ -0,0 +1,227 @@
+package internal
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+	"github.com/Finschia/finschia-sdk/x/group"
+)
+
+// outsource migrates the members and the active proposals of the foundation
+// into a new group policy account of x/group, which takes over the proposal
+// feature of the foundation. The members, vote delegations and proposals of
+// the foundation would be cleared after the migration.
+//
+// The admin of both the group and the group policy is the authority of the
+// foundation, so the group policy can manage its group by MsgExecAsAuthority.
+func (k Keeper) outsource(ctx sdk.Context, policy *foundation.OutsourcingDecisionPolicy) error {
+	if len(policy.GroupPolicyAddress) != 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("group policy address is assigned by the migration")
+	}
+
+	if len(k.GetMsgTypeDecisionPolicies(ctx)) != 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("cannot migrate the message type decision policies; remove them first")
+	}
+
+	// the proposal outsourcing the foundation would not be migrated
+	executing, _ := executingProposal(ctx)
+
+	// x/group does not support the timelock
+	for _, proposal := range k.GetProposals(ctx) {
+		if proposal.Id == executing {
+			continue
+		}
+		if isPendingTimelocked(proposal) {
+			return sdkerrors.ErrInvalidRequest.Wrapf("cannot migrate the timelocked proposal %d; wait for its execution or withdraw it first", proposal.Id)
+		}
+	}
+
+	info := k.GetFoundationInfo(ctx)
+	groupPolicy, err := groupDecisionPolicy(info.GetDecisionPolicy())
+	if err != nil {
+		return err
+	}
+
+	members := k.GetMembers(ctx)
+	requests := make([]group.MemberRequest, len(members))
+	for i, member := range members {
+		requests[i] = group.MemberRequest{
+			Address:  member.Address,
+			Weight:   member.Weight,
+			Metadata: member.Metadata,
+		}
+	}
+
+	authority := sdk.MustAccAddressFromBech32(k.authority)
+	groupID, err := k.groupKeeper.CreateGroup(ctx, authority, requests, "")
+	if err != nil {
+		return err
+	}
+
+	policyAddress, err := k.groupKeeper.CreateGroupPolicy(ctx, authority, groupID, "", groupPolicy)
+	if err != nil {
+		return err
+	}
+
+	if err := k.migrateProposals(ctx, policyAddress, executing); err != nil {
+		return err
+	}
+
+	for _, member := range members {
+		k.deleteMember(ctx, sdk.MustAccAddressFromBech32(member.Address))
+	}
+	for _, delegation := range k.GetVoteDelegations(ctx) {
+		k.deleteVoteDelegation(ctx, sdk.MustAccAddressFromBech32(delegation.Delegator))
+	}
+
+	policy.GroupPolicyAddress = policyAddress.String()
+	if err := info.SetDecisionPolicy(policy); err != nil {
+		return err
+	}
+	info.TotalWeight = sdk.ZeroDec()
+	info.Version++
+	k.SetFoundationInfo(ctx, info)
+
+	// the migrated proposals would be aborted here
+	k.abortOldProposals(ctx)
+
+	return nil
+}
+
+// isPendingTimelocked returns whether the proposal is timelocked and still
+// waiting for its execution.
+func isPendingTimelocked(proposal foundation.Proposal) bool {
+	if !isTimelocked(proposal) {
+		return false
+	}
+
+	switch proposal.Status {
+	case foundation.PROPOSAL_STATUS_SUBMITTED:
+		return true
+	case foundation.PROPOSAL_STATUS_ACCEPTED:
+		return proposal.ExecutorResult != foundation.PROPOSAL_EXECUTOR_RESULT_SUCCESS
+	default:
+		return false
+	}
+}
+
+// migrateProposals imports the active proposals of the foundation into the
+// group policy, wrapping their messages by MsgExecAsAuthority. The proposal
+// under execution is skipped, because it is still stored as submitted until
+// its execution ends. The caller must ensure there is no pending timelocked
+// proposal.
+func (k Keeper) migrateProposals(ctx sdk.Context, policyAddress sdk.AccAddress, executing uint64) error {
+	delegations := k.GetVoteDelegations(ctx)
+
+	for _, proposal := range k.GetProposals(ctx) {
+		if proposal.Id == executing || proposal.Status != foundation.PROPOSAL_STATUS_SUBMITTED {
+			continue
+		}
+
+		wrapped := &foundation.MsgExecAsAuthority{
+			GroupPolicyAddress: policyAddress.String(),
+			Messages:           proposal.Messages,
+		}
+		imported := group.Proposal{
+			GroupPolicyAddress: policyAddress.String(),
+			Metadata:           proposal.Metadata,
+			Proposers:          proposal.Proposers,
+			SubmitTime:         proposal.SubmitTime,
+			VotingPeriodEnd:    proposal.VotingPeriodEnd,
+		}
+		if err := imported.SetMsgs([]sdk.Msg{wrapped}); err != nil {
+			return err
+		}
+
+		groupProposalID, err := k.groupKeeper.ImportProposal(ctx, imported, groupVotes(ctx, k.GetVotes(ctx, proposal.Id), delegations))
+		if err != nil {
+			return sdkerrors.Wrapf(err, "proposal %d", proposal.Id)
+		}
+
+		if err := ctx.EventManager().EmitTypedEvent(&foundation.EventMigrateProposal{
+			ProposalId:      proposal.Id,
+			GroupProposalId: groupProposalID,
+		}); err != nil {
+			panic(err)
+		}
+	}
+
+	return nil
+}
+
+// groupVotes converts the votes into the ones of x/group. The vote of a
+// delegate is copied to its delegators who have not voted by themselves,
+// because x/group does not support the vote delegation.
+func groupVotes(ctx sdk.Context, votes []foundation.Vote, delegations []foundation.VoteDelegation) []group.Vote {
+	options := make(map[string]foundation.VoteOption, len(votes))
+	res := make([]group.Vote, 0, len(votes))
+	for _, vote := range votes {
+		options[vote.Voter] = vote.Option
+		res = append(res, group.Vote{
+			Voter:      vote.Voter,
+			Option:     group.VoteOption(vote.Option),
+			Metadata:   vote.Metadata,
+			SubmitTime: vote.SubmitTime,
+		})
+	}
+
+	for _, delegation := range delegations {
+		if _, voted := options[delegation.Delegator]; voted {
+			continue
+		}
+
+		option, voted := options[delegation.Delegate]
+		if !voted {
+			continue
+		}
+
+		res = append(res, group.Vote{
+			Voter:      delegation.Delegator,
+			Option:     group.VoteOption(option),
+			SubmitTime: ctx.BlockTime(),
+		})
+	}
+
+	return res
+}
+
+// groupDecisionPolicy converts the decision policy of the foundation into the
+// one of x/group.
+func groupDecisionPolicy(policy foundation.DecisionPolicy) (group.DecisionPolicy, error) {
+	switch p := policy.(type) {
+	case *foundation.ThresholdDecisionPolicy:
+		return &group.ThresholdDecisionPolicy{
+			Threshold: p.Threshold,
+			Windows:   groupDecisionPolicyWindows(p.Windows),
+		}, nil
+	case *foundation.PercentageDecisionPolicy:
+		return &group.PercentageDecisionPolicy{
+			Percentage: p.Percentage,
+			Windows:    groupDecisionPolicyWindows(p.Windows),
+		}, nil
+	default:
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("cannot migrate the decision policy of %T", policy)
+	}
+}
+
+func groupDecisionPolicyWindows(windows *foundation.DecisionPolicyWindows) *group.DecisionPolicyWindows {
+	if windows == nil {
+		return nil
+	}
+
+	return &group.DecisionPolicyWindows{
+		VotingPeriod:       windows.VotingPeriod,
+		MinExecutionPeriod: windows.MinExecutionPeriod,
+	}
+}
+
+// ExecAsAuthority executes the messages with the authority of the foundation,
+// on behalf of the group policy which the foundation has been outsourced to.
+func (k Keeper) ExecAsAuthority(ctx sdk.Context, groupPolicy sdk.AccAddress, msgs []sdk.Msg) ([]sdk.Result, error) {
+	policy, ok := k.GetFoundationInfo(ctx).GetDecisionPolicy().(*foundation.OutsourcingDecisionPolicy)
+	if !ok || policy.GroupPolicyAddress != groupPolicy.String() {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("foundation has not been outsourced to %s", groupPolicy)
+	}
+
+	return k.doExecuteMsgs(ctx, msgs)
+}
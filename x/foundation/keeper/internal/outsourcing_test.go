@@ -0,0 +1,238 @@
+package internal_test
+
+import (
+	"time"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+	"github.com/Finschia/finschia-sdk/x/group"
+)
+
+func (s *KeeperTestSuite) TestOutsource() {
+	testCases := map[string]struct {
+		malleate func(ctx sdk.Context)
+		policy   *foundation.OutsourcingDecisionPolicy
+		valid    bool
+	}{
+		"valid request": {
+			policy: &foundation.OutsourcingDecisionPolicy{},
+			valid:  true,
+		},
+		"group policy address provided": {
+			policy: &foundation.OutsourcingDecisionPolicy{
+				GroupPolicyAddress: s.stranger.String(),
+			},
+		},
+		"message type decision policy exists": {
+			malleate: func(ctx sdk.Context) {
+				policy := foundation.MsgTypeDecisionPolicy{
+					MsgTypeUrl: sdk.MsgTypeURL((*foundation.MsgWithdrawFromTreasury)(nil)),
+				}
+				err := policy.SetDecisionPolicy(workingPolicy())
+				s.Require().NoError(err)
+				s.impl.SetMsgTypeDecisionPolicy(ctx, policy)
+			},
+			policy: &foundation.OutsourcingDecisionPolicy{},
+		},
+		"timelocked proposal pending": {
+			malleate: func(ctx sdk.Context) {
+				executeAfter := ctx.BlockTime().Add(time.Hour)
+				_, err := s.impl.SubmitProposal(ctx, []string{s.members[0].String()}, "", []sdk.Msg{newMsgCreateDog("shiba6")}, &executeAfter, 0)
+				s.Require().NoError(err)
+			},
+			policy: &foundation.OutsourcingDecisionPolicy{},
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			if tc.malleate != nil {
+				tc.malleate(ctx)
+			}
+
+			err := s.impl.UpdateDecisionPolicy(ctx, tc.policy)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			// the foundation has no members now
+			info := s.impl.GetFoundationInfo(ctx)
+			s.Require().True(info.TotalWeight.IsZero())
+			s.Require().Empty(s.impl.GetMembers(ctx))
+
+			policy, ok := info.GetDecisionPolicy().(*foundation.OutsourcingDecisionPolicy)
+			s.Require().True(ok)
+			policyAddress, err := sdk.AccAddressFromBech32(policy.GroupPolicyAddress)
+			s.Require().NoError(err)
+
+			// the members have been migrated
+			policyInfo, err := s.groupKeeper.GetGroupPolicyInfo(ctx, policyAddress)
+			s.Require().NoError(err)
+			s.Require().Equal(s.authority.String(), policyInfo.Admin)
+			s.Require().Len(s.groupKeeper.GetGroupMembers(ctx, policyInfo.GroupId), len(s.members))
+
+			// the active proposals have been migrated
+			for _, id := range []uint64{s.activeProposal, s.votedProposal, s.invalidProposal, s.noHandlerProposal} {
+				proposal, err := s.impl.GetProposal(ctx, id)
+				s.Require().NoError(err)
+				s.Require().Equal(foundation.PROPOSAL_STATUS_ABORTED, proposal.Status)
+			}
+			proposal, err := s.groupKeeper.GetProposal(ctx, 1)
+			s.Require().NoError(err)
+			s.Require().Equal(policyAddress.String(), proposal.GroupPolicyAddress)
+			s.Require().Equal(group.PROPOSAL_STATUS_SUBMITTED, proposal.Status)
+			s.Require().Len(s.groupKeeper.GetVotes(ctx, proposal.Id), len(s.members)-1)
+
+			msgs := proposal.GetMsgs()
+			s.Require().Len(msgs, 1)
+			wrapped, ok := msgs[0].(*foundation.MsgExecAsAuthority)
+			s.Require().True(ok)
+			s.Require().Equal(policyAddress.String(), wrapped.GroupPolicyAddress)
+
+			// the foundation cannot have members again
+			err = s.impl.UpdateMembers(ctx, []foundation.MemberRequest{{
+				Address: s.stranger.String(),
+				Weight:  sdk.OneDec(),
+			}})
+			s.Require().Error(err)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestOutsourceByProposal() {
+	testCases := map[string]struct {
+		timelocked bool
+	}{
+		"by vote": {},
+		"by exec of the timelocked proposal": {
+			timelocked: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			msg := &foundation.MsgUpdateDecisionPolicy{
+				Authority: s.authority.String(),
+			}
+			err := msg.SetDecisionPolicy(&foundation.OutsourcingDecisionPolicy{})
+			s.Require().NoError(err)
+
+			req := &foundation.MsgSubmitProposal{
+				Proposers: []string{s.members[0].String()},
+			}
+			executeAfter := ctx.BlockTime().Add(time.Hour)
+			if tc.timelocked {
+				req.ExecuteAfter = &executeAfter
+			}
+			err = req.SetMsgs([]sdk.Msg{msg})
+			s.Require().NoError(err)
+
+			res, err := s.msgServer.SubmitProposal(sdk.WrapSDKContext(ctx), req)
+			s.Require().NoError(err)
+			proposalID := res.ProposalId
+
+			vote := &foundation.MsgVote{
+				ProposalId: proposalID,
+				Voter:      s.members[0].String(),
+				Option:     foundation.VOTE_OPTION_YES,
+			}
+			if !tc.timelocked {
+				vote.Exec = foundation.Exec_EXEC_TRY
+			}
+			_, err = s.msgServer.Vote(sdk.WrapSDKContext(ctx), vote)
+			s.Require().NoError(err)
+
+			if tc.timelocked {
+				ctx = ctx.WithBlockTime(executeAfter)
+				_, err = s.msgServer.Exec(sdk.WrapSDKContext(ctx), &foundation.MsgExec{
+					ProposalId: proposalID,
+					Signer:     s.members[0].String(),
+				})
+				s.Require().NoError(err)
+			}
+
+			// the proposal has been executed and pruned
+			_, err = s.impl.GetProposal(ctx, proposalID)
+			s.Require().Error(err)
+			policy, ok := s.impl.GetFoundationInfo(ctx).GetDecisionPolicy().(*foundation.OutsourcingDecisionPolicy)
+			s.Require().True(ok)
+
+			// the group holds only the other active proposals
+			activeProposals := []uint64{s.activeProposal, s.votedProposal, s.invalidProposal, s.noHandlerProposal}
+			for i := range activeProposals {
+				proposal, err := s.groupKeeper.GetProposal(ctx, uint64(i+1))
+				s.Require().NoError(err)
+				s.Require().Equal(policy.GroupPolicyAddress, proposal.GroupPolicyAddress)
+
+				msgs := proposal.GetMsgs()
+				s.Require().Len(msgs, 1)
+				wrapped, ok := msgs[0].(*foundation.MsgExecAsAuthority)
+				s.Require().True(ok)
+				for _, msg := range wrapped.GetMsgs() {
+					s.Require().NotEqual(sdk.MsgTypeURL(&foundation.MsgUpdateDecisionPolicy{}), sdk.MsgTypeURL(msg))
+				}
+			}
+			_, err = s.groupKeeper.GetProposal(ctx, uint64(len(activeProposals)+1))
+			s.Require().Error(err)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgExecAsAuthority() {
+	ctx, _ := s.ctx.CacheContext()
+
+	err := s.impl.UpdateDecisionPolicy(ctx, &foundation.OutsourcingDecisionPolicy{})
+	s.Require().NoError(err)
+	policy := s.impl.GetFoundationInfo(ctx).GetDecisionPolicy().(*foundation.OutsourcingDecisionPolicy)
+
+	testCases := map[string]struct {
+		groupPolicy string
+		amount      sdk.Int
+		valid       bool
+	}{
+		"valid request": {
+			groupPolicy: policy.GroupPolicyAddress,
+			amount:      s.balance,
+			valid:       true,
+		},
+		"not the group policy": {
+			groupPolicy: s.stranger.String(),
+			amount:      s.balance,
+		},
+		"insufficient funds": {
+			groupPolicy: policy.GroupPolicyAddress,
+			amount:      s.balance.Add(sdk.OneInt()),
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := ctx.CacheContext()
+
+			req := &foundation.MsgExecAsAuthority{
+				GroupPolicyAddress: tc.groupPolicy,
+			}
+			err := req.SetMsgs([]sdk.Msg{
+				&foundation.MsgWithdrawFromTreasury{
+					Authority: s.authority.String(),
+					To:        s.stranger.String(),
+					Amount:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, tc.amount)),
+				},
+			})
+			s.Require().NoError(err)
+
+			res, err := s.msgServer.ExecAsAuthority(sdk.WrapSDKContext(ctx), req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+		})
+	}
+}
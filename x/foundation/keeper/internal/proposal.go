@@ -29,7 +29,7 @@ func (k Keeper) setPreviousProposalID(ctx sdk.Context, id uint64) {
 	store.Set(previousProposalIDKey, Uint64ToBytes(id))
 }
 
-func (k Keeper) SubmitProposal(ctx sdk.Context, proposers []string, metadata string, msgs []sdk.Msg) (*uint64, error) {
+func (k Keeper) SubmitProposal(ctx sdk.Context, proposers []string, metadata string, msgs []sdk.Msg, executeAfter *time.Time, executeAfterHeight uint64) (*uint64, error) {
 	if err := validateMetadata(metadata, k.config); err != nil {
 		return nil, err
 	}
@@ -41,22 +41,72 @@ func (k Keeper) SubmitProposal(ctx sdk.Context, proposers []string, metadata str
 	}
 
 	// Prevent proposal that can not succeed.
-	policy := foundationInfo.GetDecisionPolicy()
-	if err := policy.Validate(foundationInfo, k.config); err != nil {
-		return nil, err
+	var votingPeriod, minExecutionPeriod time.Duration
+	for _, policy := range k.getDecisionPolicies(ctx, foundationInfo, msgs) {
+		if err := policy.Validate(foundationInfo, k.config); err != nil {
+			return nil, err
+		}
+
+		// the longest voting period among the policies
+		if period := policy.GetVotingPeriod(); period > votingPeriod {
+			votingPeriod = period
+		}
+
+		// the longest min execution period among the policies
+		if period := policy.GetMinExecutionPeriod(); period > minExecutionPeriod {
+			minExecutionPeriod = period
+		}
+	}
+
+	votingPeriodEnd := ctx.BlockTime().Add(votingPeriod)
+	if executeAfter != nil && executeAfterHeight != 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("cannot set both execute after and execute after height")
+	}
+	if executeAfterHeight != 0 {
+		height := uint64(ctx.BlockHeight())
+		if executeAfterHeight <= height {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap("execute after height must be in the future")
+		}
+
+		// the proposal would be pruned before the height, even if the blocks
+		// are produced at the fastest pace
+		if k.config.MinBlockTime > 0 {
+			expiration := votingPeriodEnd.Add(k.config.MaxExecutionPeriod)
+			maxHeight := height + uint64((expiration.Sub(ctx.BlockTime())-1)/k.config.MinBlockTime)
+			if executeAfterHeight > maxHeight {
+				return nil, sdkerrors.ErrInvalidRequest.Wrapf("execute after height must be before the proposal expires: %d", maxHeight)
+			}
+		}
+	}
+	if executeAfter != nil {
+		if !executeAfter.After(ctx.BlockTime()) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap("execute after must be in the future")
+		}
+
+		// the proposal could not be tallied at the execution
+		if executable := ctx.BlockTime().Add(minExecutionPeriod); executeAfter.Before(executable) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("execute after must not be before the min execution period passes: %s", executable)
+		}
+
+		// the proposal would be pruned before the execution
+		if expiration := votingPeriodEnd.Add(k.config.MaxExecutionPeriod); !executeAfter.Before(expiration) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("execute after must be before the proposal expires: %s", expiration)
+		}
 	}
 
 	id := k.newProposalID(ctx)
 	proposal := foundation.Proposal{
-		Id:                id,
-		Metadata:          metadata,
-		Proposers:         proposers,
-		SubmitTime:        ctx.BlockTime(),
-		FoundationVersion: foundationInfo.Version,
-		Status:            foundation.PROPOSAL_STATUS_SUBMITTED,
-		ExecutorResult:    foundation.PROPOSAL_EXECUTOR_RESULT_NOT_RUN,
-		VotingPeriodEnd:   ctx.BlockTime().Add(policy.GetVotingPeriod()),
-		FinalTallyResult:  foundation.DefaultTallyResult(),
+		Id:                 id,
+		Metadata:           metadata,
+		Proposers:          proposers,
+		SubmitTime:         ctx.BlockTime(),
+		FoundationVersion:  foundationInfo.Version,
+		Status:             foundation.PROPOSAL_STATUS_SUBMITTED,
+		ExecutorResult:     foundation.PROPOSAL_EXECUTOR_RESULT_NOT_RUN,
+		VotingPeriodEnd:    votingPeriodEnd,
+		FinalTallyResult:   foundation.DefaultTallyResult(),
+		ExecuteAfter:       executeAfter,
+		ExecuteAfterHeight: executeAfterHeight,
 	}
 	if err := proposal.SetMsgs(msgs); err != nil {
 		return nil, err
@@ -64,6 +114,8 @@ func (k Keeper) SubmitProposal(ctx sdk.Context, proposers []string, metadata str
 
 	k.setProposal(ctx, proposal)
 	k.addProposalToVPEndQueue(ctx, proposal)
+	k.addProposalToExecuteAfterQueue(ctx, proposal)
+	k.addProposalToExecuteAfterHeightQueue(ctx, proposal)
 
 	return &id, nil
 }
@@ -85,11 +137,14 @@ func (k Keeper) WithdrawProposal(ctx sdk.Context, proposalID uint64) error {
 	return nil
 }
 
-// pruneProposal deletes a proposal from state.
+// pruneProposal deletes a proposal from state, queueing it for the proposal archive.
 func (k Keeper) pruneProposal(ctx sdk.Context, proposal foundation.Proposal) {
 	k.pruneVotes(ctx, proposal.Id)
 	k.removeProposalFromVPEndQueue(ctx, proposal)
+	k.removeProposalFromExecuteAfterQueue(ctx, proposal)
+	k.removeProposalFromExecuteAfterHeightQueue(ctx, proposal)
 	k.deleteProposal(ctx, proposal.Id)
+	k.addProposalToArchiveQueue(ctx, proposal)
 }
 
 // PruneExpiredProposals prunes all proposals which are expired,
@@ -212,12 +267,30 @@ func (k Keeper) setProposal(ctx sdk.Context, proposal foundation.Proposal) {
 	store := ctx.KVStore(k.storeKey)
 	key := proposalKey(proposal.Id)
 
+	// refresh the secondary indexes
+	if old, err := k.GetProposal(ctx, proposal.Id); err == nil {
+		for _, indexKey := range proposalIndexKeys(*old) {
+			store.Delete(indexKey)
+		}
+	}
+	for _, indexKey := range proposalIndexKeys(proposal) {
+		store.Set(indexKey, []byte{})
+	}
+
 	bz := k.cdc.MustMarshal(&proposal)
 	store.Set(key, bz)
 }
 
 func (k Keeper) deleteProposal(ctx sdk.Context, proposalID uint64) {
 	store := ctx.KVStore(k.storeKey)
+
+	// the stored one has the indexed values
+	if old, err := k.GetProposal(ctx, proposalID); err == nil {
+		for _, indexKey := range proposalIndexKeys(*old) {
+			store.Delete(indexKey)
+		}
+	}
+
 	key := proposalKey(proposalID)
 	store.Delete(key)
 }
@@ -234,6 +307,90 @@ func (k Keeper) removeProposalFromVPEndQueue(ctx sdk.Context, proposal foundatio
 	store.Delete(key)
 }
 
+func (k Keeper) addProposalToExecuteAfterQueue(ctx sdk.Context, proposal foundation.Proposal) {
+	if proposal.ExecuteAfter == nil {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := proposalByExecuteAfterKey(*proposal.ExecuteAfter, proposal.Id)
+	store.Set(key, []byte{})
+}
+
+func (k Keeper) removeProposalFromExecuteAfterQueue(ctx sdk.Context, proposal foundation.Proposal) {
+	if proposal.ExecuteAfter == nil {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := proposalByExecuteAfterKey(*proposal.ExecuteAfter, proposal.Id)
+	store.Delete(key)
+}
+
+func (k Keeper) iterateProposalsByExecuteAfter(ctx sdk.Context, endTime time.Time, fn func(proposal foundation.Proposal) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(proposalByExecuteAfterKeyPrefix, sdk.PrefixEndBytes(append(proposalByExecuteAfterKeyPrefix, sdk.FormatTimeBytes(endTime)...)))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		_, id := splitProposalByExecuteAfterKey(iter.Key())
+
+		proposal, err := k.GetProposal(ctx, id)
+		if err != nil {
+			panic(err)
+		}
+
+		if fn(*proposal) {
+			break
+		}
+	}
+}
+
+func (k Keeper) addProposalToExecuteAfterHeightQueue(ctx sdk.Context, proposal foundation.Proposal) {
+	if proposal.ExecuteAfterHeight == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := proposalByExecuteAfterHeightKey(proposal.ExecuteAfterHeight, proposal.Id)
+	store.Set(key, []byte{})
+}
+
+func (k Keeper) removeProposalFromExecuteAfterHeightQueue(ctx sdk.Context, proposal foundation.Proposal) {
+	if proposal.ExecuteAfterHeight == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := proposalByExecuteAfterHeightKey(proposal.ExecuteAfterHeight, proposal.Id)
+	store.Delete(key)
+}
+
+func (k Keeper) iterateProposalsByExecuteAfterHeight(ctx sdk.Context, height uint64, fn func(proposal foundation.Proposal) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(proposalByExecuteAfterHeightKeyPrefix, sdk.PrefixEndBytes(append(proposalByExecuteAfterHeightKeyPrefix, Uint64ToBytes(height)...)))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		_, id := splitProposalByExecuteAfterHeightKey(iter.Key())
+
+		proposal, err := k.GetProposal(ctx, id)
+		if err != nil {
+			panic(err)
+		}
+
+		if fn(*proposal) {
+			break
+		}
+	}
+}
+
+// isTimelocked returns whether the proposal has either of execute_after and
+// execute_after_height.
+func isTimelocked(proposal foundation.Proposal) bool {
+	return proposal.ExecuteAfter != nil || proposal.ExecuteAfterHeight != 0
+}
+
 func validateActorForProposal(address string, proposal foundation.Proposal) error {
 	for _, proposer := range proposal.Proposers {
 		if address == proposer {
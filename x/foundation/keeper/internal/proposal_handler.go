@@ -31,9 +31,10 @@ func handleFoundationExecProposal(ctx sdk.Context, k Keeper, proposal foundation
 
 	// allow the following messages
 	allowedUrls := map[string]bool{
-		sdk.MsgTypeURL((*foundation.MsgUpdateCensorship)(nil)): true,
-		sdk.MsgTypeURL((*foundation.MsgGrant)(nil)):            true,
-		sdk.MsgTypeURL((*foundation.MsgRevoke)(nil)):           true,
+		sdk.MsgTypeURL((*foundation.MsgUpdateCensorship)(nil)):           true,
+		sdk.MsgTypeURL((*foundation.MsgGrant)(nil)):                      true,
+		sdk.MsgTypeURL((*foundation.MsgRevoke)(nil)):                     true,
+		sdk.MsgTypeURL((*foundation.MsgUpdateTreasuryOutflowLimit)(nil)): true,
 	}
 
 	for i, msg := range msgs {
@@ -2,6 +2,7 @@ package internal_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -16,11 +17,22 @@ import (
 )
 
 func (s *KeeperTestSuite) TestSubmitProposal() {
+	future := s.ctx.BlockTime().Add(time.Hour)
+	now := s.ctx.BlockTime()
+	// voting period (1 week) + max execution period (2 weeks)
+	expiration := s.ctx.BlockTime().Add(3 * 7 * 24 * time.Hour)
+	height := uint64(10)
+	// the last height reachable before the expiration, at the fastest pace of blocks
+	lastHeight := height + uint64((expiration.Sub(now)-1)/foundation.DefaultConfig().MinBlockTime)
+
 	testCases := map[string]struct {
-		proposers []string
-		metadata  string
-		msg       sdk.Msg
-		valid     bool
+		proposers          []string
+		metadata           string
+		msg                sdk.Msg
+		executeAfter       *time.Time
+		executeAfterHeight uint64
+		minExecutionPeriod time.Duration
+		valid              bool
 	}{
 		"valid proposal": {
 			proposers: []string{s.members[0].String()},
@@ -36,13 +48,84 @@ func (s *KeeperTestSuite) TestSubmitProposal() {
 			proposers: []string{s.members[0].String()},
 			msg:       testdata.NewTestMsg(s.stranger),
 		},
+		"timelocked proposal": {
+			proposers:    []string{s.members[0].String()},
+			msg:          testdata.NewTestMsg(s.authority),
+			executeAfter: &future,
+			valid:        true,
+		},
+		"execute after in the past": {
+			proposers:    []string{s.members[0].String()},
+			msg:          testdata.NewTestMsg(s.authority),
+			executeAfter: &now,
+		},
+		"execute after the expiration": {
+			proposers:    []string{s.members[0].String()},
+			msg:          testdata.NewTestMsg(s.authority),
+			executeAfter: &expiration,
+		},
+		"execute after the min execution period": {
+			proposers:          []string{s.members[0].String()},
+			msg:                testdata.NewTestMsg(s.authority),
+			executeAfter:       &future,
+			minExecutionPeriod: time.Hour,
+			valid:              true,
+		},
+		"execute after before the min execution period": {
+			proposers:          []string{s.members[0].String()},
+			msg:                testdata.NewTestMsg(s.authority),
+			executeAfter:       &future,
+			minExecutionPeriod: time.Hour + time.Nanosecond,
+		},
+		"timelocked proposal by height": {
+			proposers:          []string{s.members[0].String()},
+			msg:                testdata.NewTestMsg(s.authority),
+			executeAfterHeight: height + 1,
+			valid:              true,
+		},
+		"execute after height in the past": {
+			proposers:          []string{s.members[0].String()},
+			msg:                testdata.NewTestMsg(s.authority),
+			executeAfterHeight: height,
+		},
+		"execute after height before the expiration": {
+			proposers:          []string{s.members[0].String()},
+			msg:                testdata.NewTestMsg(s.authority),
+			executeAfterHeight: lastHeight,
+			valid:              true,
+		},
+		"execute after height after the expiration": {
+			proposers:          []string{s.members[0].String()},
+			msg:                testdata.NewTestMsg(s.authority),
+			executeAfterHeight: lastHeight + 1,
+		},
+		"both execute after and execute after height": {
+			proposers:          []string{s.members[0].String()},
+			msg:                testdata.NewTestMsg(s.authority),
+			executeAfter:       &future,
+			executeAfterHeight: height + 1,
+		},
 	}
 
 	for name, tc := range testCases {
 		s.Run(name, func() {
 			ctx, _ := s.ctx.CacheContext()
+			ctx = ctx.WithBlockHeight(int64(height))
+
+			if tc.minExecutionPeriod != 0 {
+				info := s.impl.GetFoundationInfo(ctx)
+				err := info.SetDecisionPolicy(&foundation.ThresholdDecisionPolicy{
+					Threshold: sdk.OneDec(),
+					Windows: &foundation.DecisionPolicyWindows{
+						VotingPeriod:       7 * 24 * time.Hour,
+						MinExecutionPeriod: tc.minExecutionPeriod,
+					},
+				})
+				s.Require().NoError(err)
+				s.impl.SetFoundationInfo(ctx, info)
+			}
 
-			_, err := s.impl.SubmitProposal(ctx, tc.proposers, tc.metadata, []sdk.Msg{tc.msg})
+			_, err := s.impl.SubmitProposal(ctx, tc.proposers, tc.metadata, []sdk.Msg{tc.msg}, tc.executeAfter, tc.executeAfterHeight)
 			if tc.valid {
 				s.Require().NoError(err)
 			} else {
@@ -92,9 +175,11 @@ func TestAbortProposal(t *testing.T) {
 	impl := internal.NewKeeper(
 		app.AppCodec(),
 		app.GetKey(foundation.ModuleName),
+		app.GetTKey(foundation.TStoreKey),
 		app.MsgServiceRouter(),
 		app.AccountKeeper,
 		app.BankKeeper,
+		app.GroupKeeper,
 		authtypes.FeeCollectorName,
 		foundation.DefaultConfig(),
 		foundation.DefaultAuthority().String(),
@@ -113,6 +198,7 @@ func TestAbortProposal(t *testing.T) {
 	}
 	impl.SetMember(ctx, foundation.Member{
 		Address: members[0].String(),
+		Weight:  sdk.OneDec(),
 	})
 
 	info := foundation.DefaultFoundation()
@@ -123,12 +209,13 @@ func TestAbortProposal(t *testing.T) {
 
 	// create proposals of different versions and abort them
 	for _, newMember := range members[1:] {
-		_, err := impl.SubmitProposal(ctx, []string{members[0].String()}, "", []sdk.Msg{testdata.NewTestMsg(authority)})
+		_, err := impl.SubmitProposal(ctx, []string{members[0].String()}, "", []sdk.Msg{testdata.NewTestMsg(authority)}, nil, 0)
 		require.NoError(t, err)
 
 		err = impl.UpdateMembers(ctx, []foundation.MemberRequest{
 			{
 				Address: newMember.String(),
+				Weight:  sdk.OneDec(),
 			},
 		})
 		require.NoError(t, err)
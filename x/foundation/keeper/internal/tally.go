@@ -1,6 +1,8 @@
 package internal
 
 import (
+	"time"
+
 	sdk "github.com/Finschia/finschia-sdk/types"
 	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 	"github.com/Finschia/finschia-sdk/x/foundation"
@@ -16,9 +18,11 @@ func (k Keeper) doTallyAndUpdate(ctx sdk.Context, p *foundation.Proposal) error
 	}
 
 	info := k.GetFoundationInfo(ctx)
-	policy := info.GetDecisionPolicy()
+	policies := k.getDecisionPolicies(ctx, info, p.GetMsgs())
+	// the expired members cannot vote, so their weights are not counted
+	totalWeight := info.TotalWeight.Sub(k.getExpiredWeight(ctx))
 	sinceSubmission := ctx.BlockTime().Sub(p.SubmitTime) // duration passed since proposal submission.
-	result, err := policy.Allow(tallyResult, info.TotalWeight, sinceSubmission)
+	result, err := allow(policies, tallyResult, totalWeight, sinceSubmission)
 	if err != nil {
 		return err
 	}
@@ -38,6 +42,34 @@ func (k Keeper) doTallyAndUpdate(ctx sdk.Context, p *foundation.Proposal) error
 	return nil
 }
 
+// allow applies all the given decision policies to the tally result, so the
+// strictest one decides. The result is final only if the proposal gets
+// rejected by any policy or all the policies return the final results.
+func allow(policies []foundation.DecisionPolicy, tallyResult foundation.TallyResult, totalWeight sdk.Dec, sinceSubmission time.Duration) (*foundation.DecisionPolicyResult, error) {
+	// no one can vote (e.g. all the members have expired), so the proposal
+	// would be rejected at the end of its voting period
+	if totalWeight.IsZero() {
+		return &foundation.DecisionPolicyResult{}, nil
+	}
+
+	combined := foundation.DecisionPolicyResult{Allow: true, Final: true}
+	for _, policy := range policies {
+		result, err := policy.Allow(tallyResult, totalWeight, sinceSubmission)
+		if err != nil {
+			return nil, err
+		}
+
+		if result.Final && !result.Allow {
+			return result, nil
+		}
+
+		combined.Allow = combined.Allow && result.Allow
+		combined.Final = combined.Final && result.Final
+	}
+
+	return &combined, nil
+}
+
 // tally is a function that tallies a proposal by iterating through its votes,
 // and returns the tally result without modifying the proposal or any state.
 func (k Keeper) tally(ctx sdk.Context, p foundation.Proposal) (foundation.TallyResult, error) {
@@ -51,11 +83,12 @@ func (k Keeper) tally(ctx sdk.Context, p foundation.Proposal) (foundation.TallyR
 	}
 
 	tallyResult := foundation.DefaultTallyResult()
+	options := map[string]foundation.VoteOption{}
 	var errIter error
 	k.iterateVotes(ctx, p.Id, func(vote foundation.Vote) (stop bool) {
 		voter := sdk.MustAccAddressFromBech32(vote.Voter)
 
-		_, err := k.GetMember(ctx, voter)
+		member, err := k.GetMember(ctx, voter)
 		switch {
 		case sdkerrors.ErrNotFound.Is(err):
 			// If the member left the foundation after voting, then we simply skip the
@@ -67,7 +100,47 @@ func (k Keeper) tally(ctx sdk.Context, p foundation.Proposal) (foundation.TallyR
 			return true
 		}
 
-		if err := tallyResult.Add(vote.Option); err != nil {
+		// The vote of an expired member is skipped, as its removal may be
+		// pending.
+		if isExpired(ctx, *member) {
+			return false
+		}
+
+		if err := tallyResult.Add(vote.Option, member.Weight); err != nil {
+			panic(err)
+		}
+		options[vote.Voter] = vote.Option
+
+		return false
+	})
+
+	if errIter != nil {
+		return tallyResult, errIter
+	}
+
+	// The weight of a delegator goes to the option of its delegate, unless the
+	// delegator has voted by itself.
+	k.iterateVoteDelegations(ctx, func(delegation foundation.VoteDelegation) (stop bool) {
+		if _, voted := options[delegation.Delegator]; voted {
+			return false
+		}
+
+		option, voted := options[delegation.Delegate]
+		if !voted {
+			return false
+		}
+
+		delegator := sdk.MustAccAddressFromBech32(delegation.Delegator)
+		member, err := k.GetMember(ctx, delegator)
+		if err != nil {
+			errIter = err
+			return true
+		}
+		if isExpired(ctx, *member) {
+			return false
+		}
+
+		if err := tallyResult.Add(option, member.Weight); err != nil {
 			panic(err)
 		}
 
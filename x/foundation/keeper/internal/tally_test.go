@@ -0,0 +1,75 @@
+package internal_test
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+)
+
+func (s *KeeperTestSuite) TestTally() {
+	ctx, _ := s.ctx.CacheContext()
+
+	// give the first member more weight than the others combined
+	err := s.impl.UpdateMembers(ctx, []foundation.MemberRequest{{
+		Address: s.members[0].String(),
+		Weight:  sdk.NewDec(10),
+	}})
+	s.Require().NoError(err)
+
+	id, err := s.impl.SubmitProposal(ctx, []string{s.members[0].String()}, "", []sdk.Msg{newMsgCreateDog("shiba")}, nil, 0)
+	s.Require().NoError(err)
+
+	votes := map[int]foundation.VoteOption{
+		0: foundation.VOTE_OPTION_YES,
+		1: foundation.VOTE_OPTION_NO,
+		2: foundation.VOTE_OPTION_ABSTAIN,
+		3: foundation.VOTE_OPTION_NO_WITH_VETO,
+	}
+	for i, option := range votes {
+		err := s.impl.Vote(ctx, foundation.Vote{
+			ProposalId: *id,
+			Voter:      s.members[i].String(),
+			Option:     option,
+		})
+		s.Require().NoError(err)
+	}
+
+	res, err := s.queryServer.TallyResult(sdk.WrapSDKContext(ctx), &foundation.QueryTallyResultRequest{ProposalId: *id})
+	s.Require().NoError(err)
+	s.Require().Equal(foundation.NewTallyResult(sdk.NewDec(10), sdk.OneDec(), sdk.OneDec(), sdk.OneDec()), res.Tally)
+}
+
+func (s *KeeperTestSuite) TestTallyWithDelegations() {
+	ctx, _ := s.ctx.CacheContext()
+
+	id, err := s.impl.SubmitProposal(ctx, []string{s.members[0].String()}, "", []sdk.Msg{newMsgCreateDog("shiba")}, nil, 0)
+	s.Require().NoError(err)
+
+	// members[2] and members[3] delegate to members[0], members[4] delegates
+	// to members[1] who would not vote.
+	delegations := map[int]int{
+		2: 0,
+		3: 0,
+		4: 1,
+	}
+	for delegator, delegate := range delegations {
+		err := s.impl.DelegateVote(ctx, s.members[delegator], s.members[delegate])
+		s.Require().NoError(err)
+	}
+
+	votes := map[int]foundation.VoteOption{
+		0: foundation.VOTE_OPTION_YES,
+		3: foundation.VOTE_OPTION_NO, // overrides the delegation
+	}
+	for i, option := range votes {
+		err := s.impl.Vote(ctx, foundation.Vote{
+			ProposalId: *id,
+			Voter:      s.members[i].String(),
+			Option:     option,
+		})
+		s.Require().NoError(err)
+	}
+
+	res, err := s.queryServer.TallyResult(sdk.WrapSDKContext(ctx), &foundation.QueryTallyResultRequest{ProposalId: *id})
+	s.Require().NoError(err)
+	s.Require().Equal(foundation.NewTallyResult(sdk.NewDec(2), sdk.ZeroDec(), sdk.OneDec(), sdk.ZeroDec()), res.Tally)
+}
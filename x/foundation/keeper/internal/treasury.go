@@ -1,6 +1,8 @@
 package internal
 
 import (
+	"time"
+
 	sdk "github.com/Finschia/finschia-sdk/types"
 	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 	"github.com/Finschia/finschia-sdk/x/foundation"
@@ -47,12 +49,202 @@ func (k Keeper) WithdrawFromTreasury(ctx sdk.Context, to sdk.AccAddress, amt sdk
 	if hasNeg {
 		return sdkerrors.ErrInsufficientFunds.Wrapf("not enough coins in treasury, %s", pool.Treasury)
 	}
+	if err := k.trackTreasuryOutflows(ctx, amt); err != nil {
+		return err
+	}
+
 	pool.Treasury = remains
 	k.SetPool(ctx, pool)
 
 	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, foundation.TreasuryName, to, amt)
 }
 
+// trackTreasuryOutflows checks the outflow limits against the withdrawal, and
+// records the outflows of the limited denoms.
+func (k Keeper) trackTreasuryOutflows(ctx sdk.Context, amt sdk.Coins) error {
+	var limited sdk.Coins
+	for _, coin := range amt {
+		limit, err := k.GetTreasuryOutflowLimit(ctx, coin.Denom)
+		if err != nil {
+			continue
+		}
+
+		k.pruneTreasuryOutflows(ctx, *limit)
+
+		_, headroom := k.GetTreasuryOutflowHeadroom(ctx, *limit)
+		if coin.Amount.GT(headroom) {
+			return sdkerrors.ErrInvalidRequest.Wrapf("exceeds the outflow limit of %s; headroom %s", coin.Denom, headroom)
+		}
+
+		limited = append(limited, coin)
+	}
+
+	for _, coin := range limited {
+		k.addTreasuryOutflow(ctx, foundation.TreasuryOutflow{
+			Time:   ctx.BlockTime(),
+			Amount: coin,
+		})
+	}
+
+	return nil
+}
+
+// GetTreasuryOutflowHeadroom returns the outflow of the denom within the
+// current window, and the amount which could be withdrawn now.
+// The outflow within the window must not exceed the ratio of the treasury, as
+// if the outflow had not happened.
+func (k Keeper) GetTreasuryOutflowHeadroom(ctx sdk.Context, limit foundation.TreasuryOutflowLimit) (outflow, headroom sdk.Int) {
+	outflow = sdk.ZeroInt()
+	k.iterateTreasuryOutflows(ctx, limit.Denom, ctx.BlockTime().Add(-limit.Period), func(record foundation.TreasuryOutflow) (stop bool) {
+		outflow = outflow.Add(record.Amount.Amount)
+		return false
+	})
+
+	treasury := k.GetTreasury(ctx).AmountOf(limit.Denom)
+	ceiling := treasury.Add(outflow.ToDec()).Mul(limit.Ratio).TruncateInt()
+
+	headroom = ceiling.Sub(outflow)
+	if headroom.IsNegative() {
+		headroom = sdk.ZeroInt()
+	}
+
+	return outflow, headroom
+}
+
+func (k Keeper) GetTreasuryOutflowLimit(ctx sdk.Context, denom string) (*foundation.TreasuryOutflowLimit, error) {
+	store := ctx.KVStore(k.storeKey)
+	key := treasuryOutflowLimitKey(denom)
+	bz := store.Get(key)
+	if bz == nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("outflow limit of %s not found", denom)
+	}
+
+	var limit foundation.TreasuryOutflowLimit
+	k.cdc.MustUnmarshal(bz, &limit)
+
+	return &limit, nil
+}
+
+// UpdateTreasuryOutflowLimit sets the outflow limit of a denom, or removes it
+// with the outflows recorded for it.
+func (k Keeper) UpdateTreasuryOutflowLimit(ctx sdk.Context, limit foundation.TreasuryOutflowLimit, remove bool) error {
+	if !remove {
+		k.setTreasuryOutflowLimit(ctx, limit)
+		return nil
+	}
+
+	if _, err := k.GetTreasuryOutflowLimit(ctx, limit.Denom); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(treasuryOutflowLimitKey(limit.Denom))
+
+	var keys [][]byte
+	iterator := sdk.KVStorePrefixIterator(store, treasuryOutflowKeyPrefixByDenom(limit.Denom))
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	return nil
+}
+
+func (k Keeper) setTreasuryOutflowLimit(ctx sdk.Context, limit foundation.TreasuryOutflowLimit) {
+	bz := k.cdc.MustMarshal(&limit)
+
+	store := ctx.KVStore(k.storeKey)
+	key := treasuryOutflowLimitKey(limit.Denom)
+	store.Set(key, bz)
+}
+
+func (k Keeper) iterateTreasuryOutflowLimits(ctx sdk.Context, fn func(limit foundation.TreasuryOutflowLimit) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, treasuryOutflowLimitKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var limit foundation.TreasuryOutflowLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &limit)
+
+		if stop := fn(limit); stop {
+			break
+		}
+	}
+}
+
+// addTreasuryOutflow records an outflow, merging it into the one recorded at
+// the same time.
+func (k Keeper) addTreasuryOutflow(ctx sdk.Context, outflow foundation.TreasuryOutflow) {
+	store := ctx.KVStore(k.storeKey)
+	key := treasuryOutflowKey(outflow.Amount.Denom, outflow.Time)
+
+	if bz := store.Get(key); bz != nil {
+		var recorded foundation.TreasuryOutflow
+		k.cdc.MustUnmarshal(bz, &recorded)
+
+		outflow.Amount = outflow.Amount.Add(recorded.Amount)
+	}
+
+	store.Set(key, k.cdc.MustMarshal(&outflow))
+}
+
+// pruneTreasuryOutflows deletes the outflows of the denom which are out of
+// the current window.
+func (k Keeper) pruneTreasuryOutflows(ctx sdk.Context, limit foundation.TreasuryOutflowLimit) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := treasuryOutflowKeyPrefixByDenom(limit.Denom)
+	windowStart := ctx.BlockTime().Add(-limit.Period)
+
+	var keys [][]byte
+	iterator := store.Iterator(prefix, treasuryOutflowKey(limit.Denom, windowStart.Add(time.Nanosecond)))
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// iterateTreasuryOutflows iterates over the outflows of the denom, recorded
+// after the given time.
+func (k Keeper) iterateTreasuryOutflows(ctx sdk.Context, denom string, after time.Time, fn func(outflow foundation.TreasuryOutflow) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := treasuryOutflowKeyPrefixByDenom(denom)
+	iterator := store.Iterator(treasuryOutflowKey(denom, after.Add(time.Nanosecond)), sdk.PrefixEndBytes(prefix))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var outflow foundation.TreasuryOutflow
+		k.cdc.MustUnmarshal(iterator.Value(), &outflow)
+
+		if stop := fn(outflow); stop {
+			break
+		}
+	}
+}
+
+func (k Keeper) iterateAllTreasuryOutflows(ctx sdk.Context, fn func(outflow foundation.TreasuryOutflow) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, treasuryOutflowKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var outflow foundation.TreasuryOutflow
+		k.cdc.MustUnmarshal(iterator.Value(), &outflow)
+
+		if stop := fn(outflow); stop {
+			break
+		}
+	}
+}
+
 func (k Keeper) GetPool(ctx sdk.Context) foundation.Pool {
 	store := ctx.KVStore(k.storeKey)
 	key := poolKey
@@ -1,6 +1,8 @@
 package internal_test
 
 import (
+	"time"
+
 	sdk "github.com/Finschia/finschia-sdk/types"
 	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
 	"github.com/Finschia/finschia-sdk/x/foundation"
@@ -150,3 +152,107 @@ func (s *KeeperTestSuite) TestWithdrawFromTreasury() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestTreasuryOutflowLimit() {
+	period := 30 * 24 * time.Hour
+	limit := foundation.TreasuryOutflowLimit{
+		Denom:  sdk.DefaultBondDenom,
+		Ratio:  sdk.MustNewDecFromStr("0.05"),
+		Period: period,
+	}
+	ceiling := sdk.NewDecFromInt(s.balance).Mul(limit.Ratio).TruncateInt()
+
+	testCases := map[string]struct {
+		amounts []sdk.Int
+		elapsed time.Duration
+		remove  bool
+		valid   bool
+	}{
+		"within the limit": {
+			amounts: []sdk.Int{ceiling},
+			valid:   true,
+		},
+		"exceeds the limit": {
+			amounts: []sdk.Int{ceiling.Add(sdk.OneInt())},
+		},
+		"exceeds the limit in total": {
+			amounts: []sdk.Int{ceiling.QuoRaw(2), ceiling.QuoRaw(2).AddRaw(2)},
+		},
+		"within the limit after the period": {
+			amounts: []sdk.Int{ceiling.MulRaw(3).QuoRaw(4), ceiling.MulRaw(3).QuoRaw(4)},
+			elapsed: period,
+			valid:   true,
+		},
+		"limit removed": {
+			amounts: []sdk.Int{ceiling.MulRaw(3).QuoRaw(4), ceiling.MulRaw(3).QuoRaw(4)},
+			remove:  true,
+			valid:   true,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			err := s.impl.UpdateTreasuryOutflowLimit(ctx, limit, false)
+			s.Require().NoError(err)
+
+			for i, amount := range tc.amounts {
+				if i != 0 {
+					ctx = ctx.WithBlockTime(ctx.BlockTime().Add(tc.elapsed))
+				}
+				if tc.remove && i != 0 {
+					err := s.impl.UpdateTreasuryOutflowLimit(ctx, limit, true)
+					s.Require().NoError(err)
+				}
+
+				err = s.impl.WithdrawFromTreasury(ctx, s.stranger, sdk.NewCoins(sdk.NewCoin(limit.Denom, amount)))
+				if err != nil {
+					break
+				}
+			}
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGetTreasuryOutflowHeadroom() {
+	ctx, _ := s.ctx.CacheContext()
+
+	period := time.Hour
+	limit := foundation.TreasuryOutflowLimit{
+		Denom:  sdk.DefaultBondDenom,
+		Ratio:  sdk.MustNewDecFromStr("0.1"),
+		Period: period,
+	}
+	err := s.impl.UpdateTreasuryOutflowLimit(ctx, limit, false)
+	s.Require().NoError(err)
+
+	ceiling := sdk.NewDecFromInt(s.balance).Mul(limit.Ratio).TruncateInt()
+	outflow, headroom := s.impl.GetTreasuryOutflowHeadroom(ctx, limit)
+	s.Require().True(outflow.IsZero())
+	s.Require().Equal(ceiling, headroom)
+
+	// the outflows within the period reduce the headroom
+	amount := sdk.NewInt(1000)
+	err = s.impl.WithdrawFromTreasury(ctx, s.stranger, sdk.NewCoins(sdk.NewCoin(limit.Denom, amount)))
+	s.Require().NoError(err)
+
+	outflow, headroom = s.impl.GetTreasuryOutflowHeadroom(ctx, limit)
+	s.Require().Equal(amount, outflow)
+	s.Require().Equal(ceiling.Sub(amount), headroom)
+
+	// the outflows out of the period are not counted
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(period))
+	outflow, headroom = s.impl.GetTreasuryOutflowHeadroom(ctx, limit)
+	s.Require().True(outflow.IsZero())
+	s.Require().Equal(sdk.NewDecFromInt(s.balance.Sub(amount)).Mul(limit.Ratio).TruncateInt(), headroom)
+
+	// removing non-existent limit
+	err = s.impl.UpdateTreasuryOutflowLimit(ctx, foundation.TreasuryOutflowLimit{Denom: "nonexistent"}, true)
+	s.Require().Error(err)
+}
@@ -0,0 +1,122 @@
+package internal
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+)
+
+func (k Keeper) DelegateVote(ctx sdk.Context, delegator, delegate sdk.AccAddress) error {
+	if delegator.Equals(delegate) {
+		return sdkerrors.ErrInvalidRequest.Wrap("cannot delegate to oneself")
+	}
+
+	if err := k.validateMembers(ctx, []string{delegator.String(), delegate.String()}); err != nil {
+		return err
+	}
+
+	// delegations are not transitive, so a delegate cannot be a delegator at
+	// the same time, and vice versa.
+	if _, err := k.GetVoteDelegation(ctx, delegate); err == nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("%s has delegated its vote already", delegate)
+	}
+	if k.hasVoteDelegators(ctx, delegator) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("%s has its own delegators", delegator)
+	}
+
+	k.setVoteDelegation(ctx, foundation.VoteDelegation{
+		Delegator: delegator.String(),
+		Delegate:  delegate.String(),
+	})
+
+	return nil
+}
+
+func (k Keeper) UndelegateVote(ctx sdk.Context, delegator sdk.AccAddress) error {
+	if _, err := k.GetVoteDelegation(ctx, delegator); err != nil {
+		return err
+	}
+	k.deleteVoteDelegation(ctx, delegator)
+
+	return nil
+}
+
+// removeVoteDelegationsOf removes all the vote delegations the given member
+// takes part in, as either delegator or delegate.
+func (k Keeper) removeVoteDelegationsOf(ctx sdk.Context, member sdk.AccAddress) {
+	var delegators []sdk.AccAddress
+	k.iterateVoteDelegations(ctx, func(delegation foundation.VoteDelegation) (stop bool) {
+		if delegation.Delegator == member.String() || delegation.Delegate == member.String() {
+			delegators = append(delegators, sdk.MustAccAddressFromBech32(delegation.Delegator))
+		}
+		return false
+	})
+
+	for _, delegator := range delegators {
+		k.deleteVoteDelegation(ctx, delegator)
+	}
+}
+
+func (k Keeper) hasVoteDelegators(ctx sdk.Context, delegate sdk.AccAddress) bool {
+	found := false
+	k.iterateVoteDelegations(ctx, func(delegation foundation.VoteDelegation) (stop bool) {
+		found = delegation.Delegate == delegate.String()
+		return found
+	})
+
+	return found
+}
+
+func (k Keeper) GetVoteDelegation(ctx sdk.Context, delegator sdk.AccAddress) (*foundation.VoteDelegation, error) {
+	store := ctx.KVStore(k.storeKey)
+	key := voteDelegationKey(delegator)
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return nil, sdkerrors.ErrNotFound.Wrapf("No vote delegation of %s", delegator)
+	}
+
+	var delegation foundation.VoteDelegation
+	k.cdc.MustUnmarshal(bz, &delegation)
+
+	return &delegation, nil
+}
+
+func (k Keeper) setVoteDelegation(ctx sdk.Context, delegation foundation.VoteDelegation) {
+	store := ctx.KVStore(k.storeKey)
+	delegator := sdk.MustAccAddressFromBech32(delegation.Delegator)
+	key := voteDelegationKey(delegator)
+
+	bz := k.cdc.MustMarshal(&delegation)
+	store.Set(key, bz)
+}
+
+func (k Keeper) deleteVoteDelegation(ctx sdk.Context, delegator sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	key := voteDelegationKey(delegator)
+	store.Delete(key)
+}
+
+func (k Keeper) iterateVoteDelegations(ctx sdk.Context, fn func(delegation foundation.VoteDelegation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := voteDelegationKeyPrefix
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation foundation.VoteDelegation
+		k.cdc.MustUnmarshal(iterator.Value(), &delegation)
+		if stop := fn(delegation); stop {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetVoteDelegations(ctx sdk.Context) []foundation.VoteDelegation {
+	var delegations []foundation.VoteDelegation
+	k.iterateVoteDelegations(ctx, func(delegation foundation.VoteDelegation) (stop bool) {
+		delegations = append(delegations, delegation)
+		return false
+	})
+
+	return delegations
+}
@@ -0,0 +1,126 @@
+package internal_test
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+)
+
+func (s *KeeperTestSuite) TestDelegateVote() {
+	testCases := map[string]struct {
+		malleate  func(ctx sdk.Context)
+		delegator sdk.AccAddress
+		delegate  sdk.AccAddress
+		valid     bool
+	}{
+		"valid request": {
+			delegator: s.members[0],
+			delegate:  s.members[1],
+			valid:     true,
+		},
+		"overwrite": {
+			malleate: func(ctx sdk.Context) {
+				err := s.impl.DelegateVote(ctx, s.members[0], s.members[2])
+				s.Require().NoError(err)
+			},
+			delegator: s.members[0],
+			delegate:  s.members[1],
+			valid:     true,
+		},
+		"delegate to oneself": {
+			delegator: s.members[0],
+			delegate:  s.members[0],
+		},
+		"delegator not a member": {
+			delegator: s.stranger,
+			delegate:  s.members[1],
+		},
+		"delegate not a member": {
+			delegator: s.members[0],
+			delegate:  s.stranger,
+		},
+		"delegate has delegated": {
+			malleate: func(ctx sdk.Context) {
+				err := s.impl.DelegateVote(ctx, s.members[1], s.members[2])
+				s.Require().NoError(err)
+			},
+			delegator: s.members[0],
+			delegate:  s.members[1],
+		},
+		"delegator has delegators": {
+			malleate: func(ctx sdk.Context) {
+				err := s.impl.DelegateVote(ctx, s.members[2], s.members[0])
+				s.Require().NoError(err)
+			},
+			delegator: s.members[0],
+			delegate:  s.members[1],
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			if tc.malleate != nil {
+				tc.malleate(ctx)
+			}
+
+			err := s.impl.DelegateVote(ctx, tc.delegator, tc.delegate)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			delegation, err := s.impl.GetVoteDelegation(ctx, tc.delegator)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.delegate.String(), delegation.Delegate)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestUndelegateVote() {
+	ctx, _ := s.ctx.CacheContext()
+
+	// no such a delegation
+	err := s.impl.UndelegateVote(ctx, s.members[0])
+	s.Require().Error(err)
+
+	err = s.impl.DelegateVote(ctx, s.members[0], s.members[1])
+	s.Require().NoError(err)
+
+	err = s.impl.UndelegateVote(ctx, s.members[0])
+	s.Require().NoError(err)
+
+	_, err = s.impl.GetVoteDelegation(ctx, s.members[0])
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestRemoveVoteDelegations() {
+	testCases := map[string]struct {
+		leaving sdk.AccAddress
+	}{
+		"delegator leaves": {
+			leaving: s.members[0],
+		},
+		"delegate leaves": {
+			leaving: s.members[1],
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			err := s.impl.DelegateVote(ctx, s.members[0], s.members[1])
+			s.Require().NoError(err)
+
+			err = s.impl.UpdateMembers(ctx, []foundation.MemberRequest{{
+				Address: tc.leaving.String(),
+				Remove:  true,
+			}})
+			s.Require().NoError(err)
+
+			_, err = s.impl.GetVoteDelegation(ctx, s.members[0])
+			s.Require().Error(err)
+		})
+	}
+}
@@ -7,6 +7,9 @@ const (
 	// StoreKey defines the primary module store key
 	StoreKey = ModuleName
 
+	// TStoreKey defines the transient module store key
+	TStoreKey = "transient_" + ModuleName
+
 	// RouterKey defines the module's message routing key
 	RouterKey = ModuleName
 
@@ -20,7 +20,12 @@ import (
 )
 
 const (
-	consensusVersion uint64 = 2
+	consensusVersion uint64 = 4
+)
+
+// Module init related flags
+const (
+	FlagProposalArchive = "x-foundation-proposal-archive"
 )
 
 var (
@@ -33,6 +38,11 @@ var (
 // AppModuleBasic defines the basic application module used by the foundation module.
 type AppModuleBasic struct{}
 
+// AddModuleInitFlags implements servertypes.ModuleInitFlags interface.
+func AddModuleInitFlags(startCmd *cobra.Command) {
+	startCmd.Flags().Bool(FlagProposalArchive, false, "Keep the finished x/foundation proposals in a node-local archive")
+}
+
 // Name returns the ModuleName
 func (AppModuleBasic) Name() string {
 	return foundation.ModuleName
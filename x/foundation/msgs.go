@@ -224,6 +224,85 @@ func (m MsgUpdateDecisionPolicy) UnpackInterfaces(unpacker codectypes.AnyUnpacke
 	return unpacker.UnpackAny(m.DecisionPolicy, &policy)
 }
 
+var _ sdk.Msg = (*MsgUpdateMsgTypeDecisionPolicy)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgUpdateMsgTypeDecisionPolicy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", m.Authority)
+	}
+
+	if len(m.MsgTypeUrl) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty msg type url")
+	}
+
+	// nil decision policy removes the policy of the message type
+	if m.DecisionPolicy != nil {
+		policy := m.GetDecisionPolicy()
+		if policy == nil {
+			return sdkerrors.ErrInvalidType.Wrap("invalid decision policy")
+		}
+		if err := validateMsgTypeDecisionPolicy(policy); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg.
+func (m MsgUpdateMsgTypeDecisionPolicy) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgUpdateMsgTypeDecisionPolicy) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgUpdateMsgTypeDecisionPolicy) Route() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgUpdateMsgTypeDecisionPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(codec.ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgUpdateMsgTypeDecisionPolicy) GetDecisionPolicy() DecisionPolicy {
+	if m.DecisionPolicy == nil {
+		return nil
+	}
+
+	policy, ok := m.DecisionPolicy.GetCachedValue().(DecisionPolicy)
+	if !ok {
+		return nil
+	}
+	return policy
+}
+
+func (m *MsgUpdateMsgTypeDecisionPolicy) SetDecisionPolicy(policy DecisionPolicy) error {
+	msg, ok := policy.(proto.Message)
+	if !ok {
+		return sdkerrors.ErrInvalidType.Wrapf("can't proto marshal %T", msg)
+	}
+
+	any, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return err
+	}
+	m.DecisionPolicy = any
+
+	return nil
+}
+
+func (m MsgUpdateMsgTypeDecisionPolicy) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var policy DecisionPolicy
+	return unpacker.UnpackAny(m.DecisionPolicy, &policy)
+}
+
 var _ sdk.Msg = (*MsgSubmitProposal)(nil)
 
 // ValidateBasic implements Msg.
@@ -240,6 +319,14 @@ func (m MsgSubmitProposal) ValidateBasic() error {
 		return sdkerrors.ErrInvalidRequest.Wrap("invalid exec option")
 	}
 
+	if m.ExecuteAfter != nil && m.ExecuteAfterHeight != 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("cannot set both execute after and execute after height")
+	}
+
+	if (m.ExecuteAfter != nil || m.ExecuteAfterHeight != 0) && m.Exec == Exec_EXEC_TRY {
+		return sdkerrors.ErrInvalidRequest.Wrap("cannot try to execute a timelocked proposal")
+	}
+
 	return nil
 }
 
@@ -369,6 +456,74 @@ func (m MsgVote) GetSignBytes() []byte {
 	return sdk.MustSortJSON(codec.ModuleCdc.MustMarshalJSON(&m))
 }
 
+var _ sdk.Msg = (*MsgDelegateVote)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgDelegateVote) ValidateBasic() error {
+	delegation := VoteDelegation{
+		Delegator: m.Delegator,
+		Delegate:  m.Delegate,
+	}
+	if err := delegation.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg.
+func (m MsgDelegateVote) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Delegator)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgDelegateVote) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgDelegateVote) Route() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgDelegateVote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(codec.ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgUndelegateVote)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgUndelegateVote) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Delegator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", m.Delegator)
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg.
+func (m MsgUndelegateVote) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Delegator)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgUndelegateVote) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgUndelegateVote) Route() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgUndelegateVote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(codec.ModuleCdc.MustMarshalJSON(&m))
+}
+
 var _ sdk.Msg = (*MsgExec)(nil)
 
 // ValidateBasic implements Msg.
@@ -583,3 +738,103 @@ func (m MsgRevoke) Route() string {
 func (m MsgRevoke) GetSignBytes() []byte {
 	return sdk.MustSortJSON(codec.ModuleCdc.MustMarshalJSON(&m))
 }
+
+var _ sdk.Msg = (*MsgExecAsAuthority)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgExecAsAuthority) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.GroupPolicyAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid group policy address: %s", m.GroupPolicyAddress)
+	}
+
+	if err := validateMsgs(m.GetMsgs()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgExecAsAuthority) GetMsgs() []sdk.Msg {
+	msgs, err := GetMsgs(m.Messages, "exec as authority")
+	if err != nil {
+		panic(err)
+	}
+	return msgs
+}
+
+func (m *MsgExecAsAuthority) SetMsgs(msgs []sdk.Msg) error {
+	anys, err := SetMsgs(msgs)
+	if err != nil {
+		return err
+	}
+	m.Messages = anys
+	return nil
+}
+
+func (m MsgExecAsAuthority) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return UnpackInterfaces(unpacker, m.Messages)
+}
+
+// GetSigners implements Msg.
+func (m MsgExecAsAuthority) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.GroupPolicyAddress)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgExecAsAuthority) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgExecAsAuthority) Route() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgExecAsAuthority) GetSignBytes() []byte {
+	return sdk.MustSortJSON(codec.ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgUpdateTreasuryOutflowLimit)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgUpdateTreasuryOutflowLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", m.Authority)
+	}
+
+	if m.Remove {
+		if err := sdk.ValidateDenom(m.Limit.Denom); err != nil {
+			return err
+		}
+		return nil
+	}
+
+	if err := m.Limit.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg.
+func (m MsgUpdateTreasuryOutflowLimit) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgUpdateTreasuryOutflowLimit) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgUpdateTreasuryOutflowLimit) Route() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgUpdateTreasuryOutflowLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(codec.ModuleCdc.MustMarshalJSON(&m))
+}
@@ -177,12 +177,14 @@ func TestMsgUpdateMembers(t *testing.T) {
 			authority: addrs[0],
 			members: []foundation.MemberRequest{{
 				Address: addrs[1].String(),
+				Weight:  sdk.OneDec(),
 			}},
 			valid: true,
 		},
 		"empty authority": {
 			members: []foundation.MemberRequest{{
 				Address: addrs[1].String(),
+				Weight:  sdk.OneDec(),
 			}},
 		},
 		"empty requests": {
@@ -304,23 +306,125 @@ func TestMsgUpdateDecisionPolicy(t *testing.T) {
 	}
 }
 
+func TestMsgUpdateMsgTypeDecisionPolicy(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 1)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		authority  sdk.AccAddress
+		msgTypeURL string
+		policy     foundation.DecisionPolicy
+		valid      bool
+	}{
+		"valid threshold policy": {
+			authority:  addrs[0],
+			msgTypeURL: sdk.MsgTypeURL((*foundation.MsgWithdrawFromTreasury)(nil)),
+			policy: &foundation.ThresholdDecisionPolicy{
+				Threshold: sdk.OneDec(),
+				Windows: &foundation.DecisionPolicyWindows{
+					VotingPeriod: time.Hour,
+				},
+			},
+			valid: true,
+		},
+		"valid removal": {
+			authority:  addrs[0],
+			msgTypeURL: sdk.MsgTypeURL((*foundation.MsgWithdrawFromTreasury)(nil)),
+			valid:      true,
+		},
+		"empty authority": {
+			msgTypeURL: sdk.MsgTypeURL((*foundation.MsgWithdrawFromTreasury)(nil)),
+			policy: &foundation.ThresholdDecisionPolicy{
+				Threshold: sdk.OneDec(),
+				Windows: &foundation.DecisionPolicyWindows{
+					VotingPeriod: time.Hour,
+				},
+			},
+		},
+		"empty msg type url": {
+			authority: addrs[0],
+			policy: &foundation.ThresholdDecisionPolicy{
+				Threshold: sdk.OneDec(),
+				Windows: &foundation.DecisionPolicyWindows{
+					VotingPeriod: time.Hour,
+				},
+			},
+		},
+		"zero threshold": {
+			authority:  addrs[0],
+			msgTypeURL: sdk.MsgTypeURL((*foundation.MsgWithdrawFromTreasury)(nil)),
+			policy: &foundation.ThresholdDecisionPolicy{
+				Threshold: sdk.ZeroDec(),
+				Windows: &foundation.DecisionPolicyWindows{
+					VotingPeriod: time.Hour,
+				},
+			},
+		},
+		"outsourcing policy": {
+			authority:  addrs[0],
+			msgTypeURL: sdk.MsgTypeURL((*foundation.MsgWithdrawFromTreasury)(nil)),
+			policy:     foundation.DefaultDecisionPolicy(),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := foundation.MsgUpdateMsgTypeDecisionPolicy{
+				Authority:  tc.authority.String(),
+				MsgTypeUrl: tc.msgTypeURL,
+			}
+			if tc.policy != nil {
+				err := msg.SetDecisionPolicy(tc.policy)
+				require.NoError(t, err)
+			}
+
+			err := msg.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, []sdk.AccAddress{tc.authority}, msg.GetSigners())
+		})
+	}
+}
+
 func TestMsgSubmitProposal(t *testing.T) {
 	addrs := make([]sdk.AccAddress, 1)
 	for i := range addrs {
 		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
 	}
 
+	executeAfter := time.Now()
+
 	testCases := map[string]struct {
-		proposers []sdk.AccAddress
-		msgs      []sdk.Msg
-		exec      foundation.Exec
-		valid     bool
+		proposers          []sdk.AccAddress
+		msgs               []sdk.Msg
+		exec               foundation.Exec
+		executeAfter       *time.Time
+		executeAfterHeight uint64
+		valid              bool
 	}{
 		"valid msg": {
 			proposers: []sdk.AccAddress{addrs[0]},
 			msgs:      []sdk.Msg{testdata.NewTestMsg()},
 			valid:     true,
 		},
+		"valid timelocked msg": {
+			proposers:    []sdk.AccAddress{addrs[0]},
+			msgs:         []sdk.Msg{testdata.NewTestMsg()},
+			executeAfter: &executeAfter,
+			valid:        true,
+		},
+		"valid msg timelocked by height": {
+			proposers:          []sdk.AccAddress{addrs[0]},
+			msgs:               []sdk.Msg{testdata.NewTestMsg()},
+			executeAfterHeight: 100,
+			valid:              true,
+		},
 		"empty proposers": {
 			msgs: []sdk.Msg{testdata.NewTestMsg()},
 		},
@@ -344,6 +448,24 @@ func TestMsgSubmitProposal(t *testing.T) {
 			msgs:      []sdk.Msg{testdata.NewTestMsg()},
 			exec:      -1,
 		},
+		"try to execute a timelocked proposal": {
+			proposers:    []sdk.AccAddress{addrs[0]},
+			msgs:         []sdk.Msg{testdata.NewTestMsg()},
+			exec:         foundation.Exec_EXEC_TRY,
+			executeAfter: &executeAfter,
+		},
+		"try to execute a proposal timelocked by height": {
+			proposers:          []sdk.AccAddress{addrs[0]},
+			msgs:               []sdk.Msg{testdata.NewTestMsg()},
+			exec:               foundation.Exec_EXEC_TRY,
+			executeAfterHeight: 100,
+		},
+		"both execute after and execute after height": {
+			proposers:          []sdk.AccAddress{addrs[0]},
+			msgs:               []sdk.Msg{testdata.NewTestMsg()},
+			executeAfter:       &executeAfter,
+			executeAfterHeight: 100,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -354,8 +476,10 @@ func TestMsgSubmitProposal(t *testing.T) {
 			}
 
 			msg := foundation.MsgSubmitProposal{
-				Proposers: proposers,
-				Exec:      tc.exec,
+				Proposers:          proposers,
+				Exec:               tc.exec,
+				ExecuteAfter:       tc.executeAfter,
+				ExecuteAfterHeight: tc.executeAfterHeight,
 			}
 			err := msg.SetMsgs(tc.msgs)
 			require.NoError(t, err)
@@ -480,6 +604,88 @@ func TestMsgVote(t *testing.T) {
 	}
 }
 
+func TestMsgDelegateVote(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 2)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		delegator sdk.AccAddress
+		delegate  sdk.AccAddress
+		valid     bool
+	}{
+		"valid msg": {
+			delegator: addrs[0],
+			delegate:  addrs[1],
+			valid:     true,
+		},
+		"empty delegator": {
+			delegate: addrs[1],
+		},
+		"empty delegate": {
+			delegator: addrs[0],
+		},
+		"delegate to oneself": {
+			delegator: addrs[0],
+			delegate:  addrs[0],
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := foundation.MsgDelegateVote{
+				Delegator: tc.delegator.String(),
+				Delegate:  tc.delegate.String(),
+			}
+
+			err := msg.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, []sdk.AccAddress{tc.delegator}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgUndelegateVote(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 1)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		delegator sdk.AccAddress
+		valid     bool
+	}{
+		"valid msg": {
+			delegator: addrs[0],
+			valid:     true,
+		},
+		"empty delegator": {},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := foundation.MsgUndelegateVote{
+				Delegator: tc.delegator.String(),
+			}
+
+			err := msg.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, []sdk.AccAddress{tc.delegator}, msg.GetSigners())
+		})
+	}
+}
+
 func TestMsgExec(t *testing.T) {
 	addrs := make([]sdk.AccAddress, 1)
 	for i := range addrs {
@@ -523,6 +729,54 @@ func TestMsgExec(t *testing.T) {
 	}
 }
 
+func TestMsgExecAsAuthority(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 1)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		groupPolicy sdk.AccAddress
+		msgs        []sdk.Msg
+		valid       bool
+	}{
+		"valid msg": {
+			groupPolicy: addrs[0],
+			msgs:        []sdk.Msg{testdata.NewTestMsg()},
+			valid:       true,
+		},
+		"empty group policy": {
+			msgs: []sdk.Msg{testdata.NewTestMsg()},
+		},
+		"empty msgs": {
+			groupPolicy: addrs[0],
+		},
+		"invalid msg": {
+			groupPolicy: addrs[0],
+			msgs:        []sdk.Msg{&foundation.MsgWithdrawFromTreasury{}},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := foundation.MsgExecAsAuthority{
+				GroupPolicyAddress: tc.groupPolicy.String(),
+			}
+			err := msg.SetMsgs(tc.msgs)
+			require.NoError(t, err)
+
+			err = msg.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, []sdk.AccAddress{tc.groupPolicy}, msg.GetSigners())
+		})
+	}
+}
+
 func TestMsgLeaveFoundation(t *testing.T) {
 	addrs := make([]sdk.AccAddress, 1)
 	for i := range addrs {
@@ -665,6 +919,86 @@ func TestMsgRevoke(t *testing.T) {
 	}
 }
 
+func TestMsgUpdateTreasuryOutflowLimit(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 1)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		authority sdk.AccAddress
+		limit     foundation.TreasuryOutflowLimit
+		remove    bool
+		valid     bool
+	}{
+		"valid msg": {
+			authority: addrs[0],
+			limit: foundation.TreasuryOutflowLimit{
+				Denom:  sdk.DefaultBondDenom,
+				Ratio:  sdk.MustNewDecFromStr("0.05"),
+				Period: time.Hour,
+			},
+			valid: true,
+		},
+		"valid removal": {
+			authority: addrs[0],
+			limit: foundation.TreasuryOutflowLimit{
+				Denom: sdk.DefaultBondDenom,
+			},
+			remove: true,
+			valid:  true,
+		},
+		"empty authority": {
+			limit: foundation.TreasuryOutflowLimit{
+				Denom:  sdk.DefaultBondDenom,
+				Ratio:  sdk.MustNewDecFromStr("0.05"),
+				Period: time.Hour,
+			},
+		},
+		"invalid denom": {
+			authority: addrs[0],
+			limit: foundation.TreasuryOutflowLimit{
+				Ratio:  sdk.MustNewDecFromStr("0.05"),
+				Period: time.Hour,
+			},
+		},
+		"invalid ratio": {
+			authority: addrs[0],
+			limit: foundation.TreasuryOutflowLimit{
+				Denom:  sdk.DefaultBondDenom,
+				Ratio:  sdk.MustNewDecFromStr("1.1"),
+				Period: time.Hour,
+			},
+		},
+		"zero period": {
+			authority: addrs[0],
+			limit: foundation.TreasuryOutflowLimit{
+				Denom: sdk.DefaultBondDenom,
+				Ratio: sdk.MustNewDecFromStr("0.05"),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := foundation.MsgUpdateTreasuryOutflowLimit{
+				Authority: tc.authority.String(),
+				Limit:     tc.limit,
+				Remove:    tc.remove,
+			}
+
+			err := msg.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, []sdk.AccAddress{tc.authority}, msg.GetSigners())
+		})
+	}
+}
+
 func TestAminoJSON(t *testing.T) {
 	addrs := make([]sdk.AccAddress, 3)
 	for i := range addrs {
@@ -692,6 +1026,17 @@ func TestAminoJSON(t *testing.T) {
 			},
 			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgVote\",\"value\":{\"metadata\":\"I'm YES\",\"option\":1,\"proposal_id\":\"1\",\"voter\":\"%s\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String()),
 		},
+		"MsgDelegateVote": {
+			&foundation.MsgDelegateVote{
+				Delegator: addrs[0].String(),
+				Delegate:  addrs[1].String(),
+			},
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgDelegateVote\",\"value\":{\"delegate\":\"%s\",\"delegator\":\"%s\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[1].String(), addrs[0].String()),
+		},
+		"MsgUndelegateVote": {
+			&foundation.MsgUndelegateVote{Delegator: addrs[0].String()},
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgUndelegateVote\",\"value\":{\"delegator\":\"%s\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String()),
+		},
 		"MsgExec": {
 			&foundation.MsgExec{
 				ProposalId: 1,
@@ -753,9 +1098,10 @@ func TestMsgSubmitProposalAminoJSON(t *testing.T) {
 				Authority: addrs[0].String(),
 				MemberUpdates: []foundation.MemberRequest{{
 					Address: addrs[1].String(),
+					Weight:  sdk.OneDec(),
 				}},
 			},
-			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSubmitProposal\",\"value\":{\"exec\":1,\"messages\":[{\"type\":\"lbm-sdk/MsgUpdateMembers\",\"value\":{\"authority\":\"%s\",\"member_updates\":[{\"address\":\"%s\"}]}}],\"metadata\":\"MsgUpdateMembers\",\"proposers\":[\"%s\"]}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), addrs[1].String(), proposer.String()),
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSubmitProposal\",\"value\":{\"exec\":1,\"messages\":[{\"type\":\"lbm-sdk/MsgUpdateMembers\",\"value\":{\"authority\":\"%s\",\"member_updates\":[{\"address\":\"%s\",\"weight\":\"1.000000000000000000\"}]}}],\"metadata\":\"MsgUpdateMembers\",\"proposers\":[\"%s\"]}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), addrs[1].String(), proposer.String()),
 		},
 		"MsgUpdateCensorship": {
 			&foundation.MsgUpdateCensorship{
@@ -849,6 +1195,39 @@ func TestMsgUpdateDecisionPolicyAminoJson(t *testing.T) {
 	}
 }
 
+func TestMsgUpdateMsgTypeDecisionPolicyAminoJson(t *testing.T) {
+	var (
+		authority = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+		proposer  = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	)
+
+	policyMsg := &foundation.MsgUpdateMsgTypeDecisionPolicy{
+		Authority:  authority.String(),
+		MsgTypeUrl: sdk.MsgTypeURL((*foundation.MsgWithdrawFromTreasury)(nil)),
+	}
+	err := policyMsg.SetDecisionPolicy(&foundation.ThresholdDecisionPolicy{
+		Threshold: sdk.OneDec(),
+		Windows: &foundation.DecisionPolicyWindows{
+			VotingPeriod: time.Hour,
+		},
+	})
+	require.NoError(t, err)
+
+	err = policyMsg.ValidateBasic()
+	require.NoError(t, err)
+
+	proposalMsg := &foundation.MsgSubmitProposal{
+		Proposers: []string{proposer.String()},
+		Metadata:  "ThresholdDecisionPolicy",
+		Exec:      foundation.Exec_EXEC_TRY,
+	}
+	err = proposalMsg.SetMsgs([]sdk.Msg{policyMsg})
+	require.NoError(t, err)
+
+	expected := fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSubmitProposal\",\"value\":{\"exec\":1,\"messages\":[{\"type\":\"lbm-sdk/MsgUpdateMsgTypeDecisionPolicy\",\"value\":{\"authority\":\"%s\",\"decision_policy\":{\"type\":\"lbm-sdk/ThresholdDecisionPolicy\",\"value\":{\"threshold\":\"1.000000000000000000\",\"windows\":{\"min_execution_period\":\"0\",\"voting_period\":\"3600000000000\"}}},\"msg_type_url\":\"/lbm.foundation.v1.MsgWithdrawFromTreasury\"}}],\"metadata\":\"ThresholdDecisionPolicy\",\"proposers\":[\"%s\"]}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", authority, proposer)
+	require.Equal(t, expected, string(legacytx.StdSignBytes("foo", 1, 1, 1, legacytx.StdFee{}, []sdk.Msg{proposalMsg}, "memo")))
+}
+
 func TestMsgGrantAminoJson(t *testing.T) {
 	var (
 		operator = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
@@ -864,6 +1243,16 @@ func TestMsgGrantAminoJson(t *testing.T) {
 			&foundation.ReceiveFromTreasuryAuthorization{},
 			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSubmitProposal\",\"value\":{\"exec\":1,\"messages\":[{\"type\":\"lbm-sdk/MsgGrant\",\"value\":{\"authority\":\"%s\",\"authorization\":{\"type\":\"lbm-sdk/ReceiveFromTreasuryAuthorization\",\"value\":{}},\"grantee\":\"%s\"}}],\"metadata\":\"ReceiveFromTreasuryAuthorization\",\"proposers\":[\"%s\"]}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", operator.String(), grantee.String(), proposer.String()),
 		},
+		"PeriodicReceiveFromTreasuryAuthorization": {
+			&foundation.PeriodicReceiveFromTreasuryAuthorization{
+				Basic: foundation.ReceiveFromTreasuryAuthorization{
+					SpendLimit: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+				},
+				Period:           time.Hour,
+				PeriodSpendLimit: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
+			},
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSubmitProposal\",\"value\":{\"exec\":1,\"messages\":[{\"type\":\"lbm-sdk/MsgGrant\",\"value\":{\"authority\":\"%s\",\"authorization\":{\"type\":\"lbm-sdk/PeriodicReceiveFromTreasuryAuthorization\",\"value\":{\"basic\":{\"spend_limit\":[{\"amount\":\"100\",\"denom\":\"stake\"}]},\"period\":\"3600000000000\",\"period_can_spend\":[],\"period_reset\":\"0001-01-01T00:00:00Z\",\"period_spend_limit\":[{\"amount\":\"10\",\"denom\":\"stake\"}]}},\"grantee\":\"%s\"}}],\"metadata\":\"PeriodicReceiveFromTreasuryAuthorization\",\"proposers\":[\"%s\"]}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", operator.String(), grantee.String(), proposer.String()),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -6,27 +6,32 @@ package foundation
 import (
 	context "context"
 	fmt "fmt"
-	types1 "github.com/Finschia/finschia-sdk/codec/types"
+	types2 "github.com/Finschia/finschia-sdk/codec/types"
 	github_com_Finschia_finschia_sdk_types "github.com/Finschia/finschia-sdk/types"
 	types "github.com/Finschia/finschia-sdk/types"
 	query "github.com/Finschia/finschia-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
+	types1 "github.com/tendermint/tendermint/abci/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -475,6 +480,107 @@ func (m *QueryMembersResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryExpiringMembersRequest is the Query/ExpiringMembers request type.
+type QueryExpiringMembersRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryExpiringMembersRequest) Reset()         { *m = QueryExpiringMembersRequest{} }
+func (m *QueryExpiringMembersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExpiringMembersRequest) ProtoMessage()    {}
+func (*QueryExpiringMembersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15a18e20c0e403af, []int{10}
+}
+func (m *QueryExpiringMembersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExpiringMembersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExpiringMembersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExpiringMembersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExpiringMembersRequest.Merge(m, src)
+}
+func (m *QueryExpiringMembersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExpiringMembersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExpiringMembersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExpiringMembersRequest proto.InternalMessageInfo
+
+func (m *QueryExpiringMembersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryExpiringMembersResponse is the Query/ExpiringMembers response type.
+type QueryExpiringMembersResponse struct {
+	// members are the members with expiring memberships.
+	Members []Member `protobuf:"bytes,1,rep,name=members,proto3" json:"members"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryExpiringMembersResponse) Reset()         { *m = QueryExpiringMembersResponse{} }
+func (m *QueryExpiringMembersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExpiringMembersResponse) ProtoMessage()    {}
+func (*QueryExpiringMembersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15a18e20c0e403af, []int{11}
+}
+func (m *QueryExpiringMembersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExpiringMembersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExpiringMembersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExpiringMembersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExpiringMembersResponse.Merge(m, src)
+}
+func (m *QueryExpiringMembersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExpiringMembersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExpiringMembersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExpiringMembersResponse proto.InternalMessageInfo
+
+func (m *QueryExpiringMembersResponse) GetMembers() []Member {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *QueryExpiringMembersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryProposalRequest is the Query/Proposal request type.
 type QueryProposalRequest struct {
 	// proposal_id is the unique ID of a proposal.
@@ -485,7 +591,7 @@ func (m *QueryProposalRequest) Reset()         { *m = QueryProposalRequest{} }
 func (m *QueryProposalRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryProposalRequest) ProtoMessage()    {}
 func (*QueryProposalRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15a18e20c0e403af, []int{10}
+	return fileDescriptor_15a18e20c0e403af, []int{12}
 }
 func (m *QueryProposalRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -531,7 +637,7 @@ func (m *QueryProposalResponse) Reset()         { *m = QueryProposalResponse{} }
 func (m *QueryProposalResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryProposalResponse) ProtoMessage()    {}
 func (*QueryProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15a18e20c0e403af, []int{11}
+	return fileDescriptor_15a18e20c0e403af, []int{13}
 }
 func (m *QueryProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -571,13 +677,21 @@ func (m *QueryProposalResponse) GetProposal() *Proposal {
 type QueryProposalsRequest struct {
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// status filters the proposals by their status, if not unspecified.
+	Status ProposalStatus `protobuf:"varint,2,opt,name=status,proto3,enum=lbm.foundation.v1.ProposalStatus" json:"status,omitempty"`
+	// proposer filters the proposals by one of their proposers, if not empty.
+	Proposer string `protobuf:"bytes,3,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// submit_time_from filters out the proposals submitted before it, if set.
+	SubmitTimeFrom *time.Time `protobuf:"bytes,4,opt,name=submit_time_from,json=submitTimeFrom,proto3,stdtime" json:"submit_time_from,omitempty"`
+	// submit_time_to filters out the proposals submitted at or after it, if set.
+	SubmitTimeTo *time.Time `protobuf:"bytes,5,opt,name=submit_time_to,json=submitTimeTo,proto3,stdtime" json:"submit_time_to,omitempty"`
 }
 
 func (m *QueryProposalsRequest) Reset()         { *m = QueryProposalsRequest{} }
 func (m *QueryProposalsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryProposalsRequest) ProtoMessage()    {}
 func (*QueryProposalsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15a18e20c0e403af, []int{12}
+	return fileDescriptor_15a18e20c0e403af, []int{14}
 }
 func (m *QueryProposalsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -613,6 +727,34 @@ func (m *QueryProposalsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryProposalsRequest) GetStatus() ProposalStatus {
+	if m != nil {
+		return m.Status
+	}
+	return PROPOSAL_STATUS_UNSPECIFIED
+}
+
+func (m *QueryProposalsRequest) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *QueryProposalsRequest) GetSubmitTimeFrom() *time.Time {
+	if m != nil {
+		return m.SubmitTimeFrom
+	}
+	return nil
+}
+
+func (m *QueryProposalsRequest) GetSubmitTimeTo() *time.Time {
+	if m != nil {
+		return m.SubmitTimeTo
+	}
+	return nil
+}
+
 // QueryProposalsResponse is the Query/Proposals response type.
 type QueryProposalsResponse struct {
 	// proposals are the proposals of the foundation.
@@ -625,7 +767,7 @@ func (m *QueryProposalsResponse) Reset()         { *m = QueryProposalsResponse{}
 func (m *QueryProposalsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryProposalsResponse) ProtoMessage()    {}
 func (*QueryProposalsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15a18e20c0e403af, []int{13}
+	return fileDescriptor_15a18e20c0e403af, []int{15}
 }
 func (m *QueryProposalsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -668,6 +810,143 @@ func (m *QueryProposalsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryArchivedProposalsRequest is the Query/ArchivedProposals request type.
+type QueryArchivedProposalsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// status filters the proposals by their status, if not unspecified.
+	Status ProposalStatus `protobuf:"varint,2,opt,name=status,proto3,enum=lbm.foundation.v1.ProposalStatus" json:"status,omitempty"`
+	// proposer filters the proposals by one of their proposers, if not empty.
+	Proposer string `protobuf:"bytes,3,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// submit_time_from filters out the proposals submitted before it, if set.
+	SubmitTimeFrom *time.Time `protobuf:"bytes,4,opt,name=submit_time_from,json=submitTimeFrom,proto3,stdtime" json:"submit_time_from,omitempty"`
+	// submit_time_to filters out the proposals submitted at or after it, if set.
+	SubmitTimeTo *time.Time `protobuf:"bytes,5,opt,name=submit_time_to,json=submitTimeTo,proto3,stdtime" json:"submit_time_to,omitempty"`
+}
+
+func (m *QueryArchivedProposalsRequest) Reset()         { *m = QueryArchivedProposalsRequest{} }
+func (m *QueryArchivedProposalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryArchivedProposalsRequest) ProtoMessage()    {}
+func (*QueryArchivedProposalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15a18e20c0e403af, []int{16}
+}
+func (m *QueryArchivedProposalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryArchivedProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryArchivedProposalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryArchivedProposalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryArchivedProposalsRequest.Merge(m, src)
+}
+func (m *QueryArchivedProposalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryArchivedProposalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryArchivedProposalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryArchivedProposalsRequest proto.InternalMessageInfo
+
+func (m *QueryArchivedProposalsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryArchivedProposalsRequest) GetStatus() ProposalStatus {
+	if m != nil {
+		return m.Status
+	}
+	return PROPOSAL_STATUS_UNSPECIFIED
+}
+
+func (m *QueryArchivedProposalsRequest) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *QueryArchivedProposalsRequest) GetSubmitTimeFrom() *time.Time {
+	if m != nil {
+		return m.SubmitTimeFrom
+	}
+	return nil
+}
+
+func (m *QueryArchivedProposalsRequest) GetSubmitTimeTo() *time.Time {
+	if m != nil {
+		return m.SubmitTimeTo
+	}
+	return nil
+}
+
+// QueryArchivedProposalsResponse is the Query/ArchivedProposals response type.
+type QueryArchivedProposalsResponse struct {
+	// proposals are the archived proposals, with their final tally results.
+	Proposals []Proposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryArchivedProposalsResponse) Reset()         { *m = QueryArchivedProposalsResponse{} }
+func (m *QueryArchivedProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryArchivedProposalsResponse) ProtoMessage()    {}
+func (*QueryArchivedProposalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15a18e20c0e403af, []int{17}
+}
+func (m *QueryArchivedProposalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryArchivedProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryArchivedProposalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryArchivedProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryArchivedProposalsResponse.Merge(m, src)
+}
+func (m *QueryArchivedProposalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryArchivedProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryArchivedProposalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryArchivedProposalsResponse proto.InternalMessageInfo
+
+func (m *QueryArchivedProposalsResponse) GetProposals() []Proposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+func (m *QueryArchivedProposalsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryVote is the Query/Vote request type.
 type QueryVoteRequest struct {
 	// proposal_id is the unique ID of a proposal.
@@ -680,7 +959,7 @@ func (m *QueryVoteRequest) Reset()         { *m = QueryVoteRequest{} }
 func (m *QueryVoteRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVoteRequest) ProtoMessage()    {}
 func (*QueryVoteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15a18e20c0e403af, []int{14}
+	return fileDescriptor_15a18e20c0e403af, []int{18}
 }
 func (m *QueryVoteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -733,7 +1012,7 @@ func (m *QueryVoteResponse) Reset()         { *m = QueryVoteResponse{} }
 func (m *QueryVoteResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVoteResponse) ProtoMessage()    {}
 func (*QueryVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15a18e20c0e403af, []int{15}
+	return fileDescriptor_15a18e20c0e403af, []int{19}
 }
 func (m *QueryVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -781,7 +1060,7 @@ func (m *QueryVotesRequest) Reset()         { *m = QueryVotesRequest{} }
 func (m *QueryVotesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVotesRequest) ProtoMessage()    {}
 func (*QueryVotesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15a18e20c0e403af, []int{16}
+	return fileDescriptor_15a18e20c0e403af, []int{20}
 }
 func (m *QueryVotesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -836,7 +1115,7 @@ func (m *QueryVotesResponse) Reset()         { *m = QueryVotesResponse{} }
 func (m *QueryVotesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVotesResponse) ProtoMessage()    {}
 func (*QueryVotesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15a18e20c0e403af, []int{17}
+	return fileDescriptor_15a18e20c0e403af, []int{21}
 }
 func (m *QueryVotesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -879,6 +1158,107 @@ func (m *QueryVotesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryVoteDelegationsRequest is the Query/VoteDelegations request type.
+type QueryVoteDelegationsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVoteDelegationsRequest) Reset()         { *m = QueryVoteDelegationsRequest{} }
+func (m *QueryVoteDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteDelegationsRequest) ProtoMessage()    {}
+func (*QueryVoteDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15a18e20c0e403af, []int{22}
+}
+func (m *QueryVoteDelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteDelegationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVoteDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteDelegationsRequest.Merge(m, src)
+}
+func (m *QueryVoteDelegationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteDelegationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVoteDelegationsRequest proto.InternalMessageInfo
+
+func (m *QueryVoteDelegationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryVoteDelegationsResponse is the Query/VoteDelegations response type.
+type QueryVoteDelegationsResponse struct {
+	// vote_delegations are the list of the vote delegations.
+	VoteDelegations []VoteDelegation `protobuf:"bytes,1,rep,name=vote_delegations,json=voteDelegations,proto3" json:"vote_delegations"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVoteDelegationsResponse) Reset()         { *m = QueryVoteDelegationsResponse{} }
+func (m *QueryVoteDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteDelegationsResponse) ProtoMessage()    {}
+func (*QueryVoteDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15a18e20c0e403af, []int{23}
+}
+func (m *QueryVoteDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVoteDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteDelegationsResponse.Merge(m, src)
+}
+func (m *QueryVoteDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteDelegationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVoteDelegationsResponse proto.InternalMessageInfo
+
+func (m *QueryVoteDelegationsResponse) GetVoteDelegations() []VoteDelegation {
+	if m != nil {
+		return m.VoteDelegations
+	}
+	return nil
+}
+
+func (m *QueryVoteDelegationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryTallyResultRequest is the Query/TallyResult request type.
 type QueryTallyResultRequest struct {
 	// proposal_id is the unique id of a proposal.
@@ -889,7 +1269,7 @@ func (m *QueryTallyResultRequest) Reset()         { *m = QueryTallyResultRequest
 func (m *QueryTallyResultRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTallyResultRequest) ProtoMessage()    {}
 func (*QueryTallyResultRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15a18e20c0e403af, []int{18}
+	return fileDescriptor_15a18e20c0e403af, []int{24}
 }
 func (m *QueryTallyResultRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -935,7 +1315,7 @@ func (m *QueryTallyResultResponse) Reset()         { *m = QueryTallyResultRespon
 func (m *QueryTallyResultResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTallyResultResponse) ProtoMessage()    {}
 func (*QueryTallyResultResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15a18e20c0e403af, []int{19}
+	return fileDescriptor_15a18e20c0e403af, []int{25}
 }
 func (m *QueryTallyResultResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -971,9 +1351,222 @@ func (m *QueryTallyResultResponse) GetTally() TallyResult {
 	return TallyResult{}
 }
 
-// QueryCensorshipsRequest is the request type for the Query/Censorships RPC method.
-type QueryCensorshipsRequest struct {
-	// pagination defines an optional pagination for the request.
+// QuerySimulateProposalRequest is the Query/SimulateProposal request type.
+type QuerySimulateProposalRequest struct {
+	// proposal_id is the unique id of a proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QuerySimulateProposalRequest) Reset()         { *m = QuerySimulateProposalRequest{} }
+func (m *QuerySimulateProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateProposalRequest) ProtoMessage()    {}
+func (*QuerySimulateProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15a18e20c0e403af, []int{26}
+}
+func (m *QuerySimulateProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateProposalRequest.Merge(m, src)
+}
+func (m *QuerySimulateProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateProposalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateProposalRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateProposalRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// QuerySimulateProposalResponse is the Query/SimulateProposal response type.
+type QuerySimulateProposalResponse struct {
+	// results are the results of the messages executed before the simulation ends.
+	// On failure, it does not include the result of the failed message.
+	Results []types.Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+	// error is the reason of the failure, which is empty on success.
+	// The simulation fails with out of gas if it exceeds the gas limit of the node.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// events are the events emitted during the simulation, including the ones of the results.
+	Events []types1.Event `protobuf:"bytes,3,rep,name=events,proto3" json:"events"`
+}
+
+func (m *QuerySimulateProposalResponse) Reset()         { *m = QuerySimulateProposalResponse{} }
+func (m *QuerySimulateProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateProposalResponse) ProtoMessage()    {}
+func (*QuerySimulateProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15a18e20c0e403af, []int{27}
+}
+func (m *QuerySimulateProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateProposalResponse.Merge(m, src)
+}
+func (m *QuerySimulateProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateProposalResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateProposalResponse) GetResults() []types.Result {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *QuerySimulateProposalResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *QuerySimulateProposalResponse) GetEvents() []types1.Event {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+// QueryMsgTypeDecisionPoliciesRequest is the request type for the Query/MsgTypeDecisionPolicies RPC method.
+type QueryMsgTypeDecisionPoliciesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMsgTypeDecisionPoliciesRequest) Reset()         { *m = QueryMsgTypeDecisionPoliciesRequest{} }
+func (m *QueryMsgTypeDecisionPoliciesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMsgTypeDecisionPoliciesRequest) ProtoMessage()    {}
+func (*QueryMsgTypeDecisionPoliciesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15a18e20c0e403af, []int{28}
+}
+func (m *QueryMsgTypeDecisionPoliciesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMsgTypeDecisionPoliciesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMsgTypeDecisionPoliciesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMsgTypeDecisionPoliciesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMsgTypeDecisionPoliciesRequest.Merge(m, src)
+}
+func (m *QueryMsgTypeDecisionPoliciesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMsgTypeDecisionPoliciesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMsgTypeDecisionPoliciesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMsgTypeDecisionPoliciesRequest proto.InternalMessageInfo
+
+func (m *QueryMsgTypeDecisionPoliciesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMsgTypeDecisionPoliciesResponse is the response type for the Query/MsgTypeDecisionPolicies RPC method.
+type QueryMsgTypeDecisionPoliciesResponse struct {
+	// policies is the list of the decision policies per message type.
+	Policies []MsgTypeDecisionPolicy `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMsgTypeDecisionPoliciesResponse) Reset()         { *m = QueryMsgTypeDecisionPoliciesResponse{} }
+func (m *QueryMsgTypeDecisionPoliciesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMsgTypeDecisionPoliciesResponse) ProtoMessage()    {}
+func (*QueryMsgTypeDecisionPoliciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15a18e20c0e403af, []int{29}
+}
+func (m *QueryMsgTypeDecisionPoliciesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMsgTypeDecisionPoliciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMsgTypeDecisionPoliciesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMsgTypeDecisionPoliciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMsgTypeDecisionPoliciesResponse.Merge(m, src)
+}
+func (m *QueryMsgTypeDecisionPoliciesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMsgTypeDecisionPoliciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMsgTypeDecisionPoliciesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMsgTypeDecisionPoliciesResponse proto.InternalMessageInfo
+
+func (m *QueryMsgTypeDecisionPoliciesResponse) GetPolicies() []MsgTypeDecisionPolicy {
+	if m != nil {
+		return m.Policies
+	}
+	return nil
+}
+
+func (m *QueryMsgTypeDecisionPoliciesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryCensorshipsRequest is the request type for the Query/Censorships RPC method.
+type QueryCensorshipsRequest struct {
+	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
@@ -981,7 +1574,7 @@ func (m *QueryCensorshipsRequest) Reset()         { *m = QueryCensorshipsRequest
 func (m *QueryCensorshipsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCensorshipsRequest) ProtoMessage()    {}
 func (*QueryCensorshipsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15a18e20c0e403af, []int{20}
+	return fileDescriptor_15a18e20c0e403af, []int{30}
 }
 func (m *QueryCensorshipsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1029,7 +1622,7 @@ func (m *QueryCensorshipsResponse) Reset()         { *m = QueryCensorshipsRespon
 func (m *QueryCensorshipsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCensorshipsResponse) ProtoMessage()    {}
 func (*QueryCensorshipsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15a18e20c0e403af, []int{21}
+	return fileDescriptor_15a18e20c0e403af, []int{31}
 }
 func (m *QueryCensorshipsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1072,6 +1665,217 @@ func (m *QueryCensorshipsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryTreasuryOutflowLimitsRequest is the request type for the Query/TreasuryOutflowLimits RPC method.
+type QueryTreasuryOutflowLimitsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTreasuryOutflowLimitsRequest) Reset()         { *m = QueryTreasuryOutflowLimitsRequest{} }
+func (m *QueryTreasuryOutflowLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTreasuryOutflowLimitsRequest) ProtoMessage()    {}
+func (*QueryTreasuryOutflowLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15a18e20c0e403af, []int{32}
+}
+func (m *QueryTreasuryOutflowLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTreasuryOutflowLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTreasuryOutflowLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTreasuryOutflowLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTreasuryOutflowLimitsRequest.Merge(m, src)
+}
+func (m *QueryTreasuryOutflowLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTreasuryOutflowLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTreasuryOutflowLimitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTreasuryOutflowLimitsRequest proto.InternalMessageInfo
+
+func (m *QueryTreasuryOutflowLimitsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTreasuryOutflowLimitsResponse is the response type for the Query/TreasuryOutflowLimits RPC method.
+type QueryTreasuryOutflowLimitsResponse struct {
+	// limits is the list of the outflow limits.
+	Limits []TreasuryOutflowLimit `protobuf:"bytes,1,rep,name=limits,proto3" json:"limits"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTreasuryOutflowLimitsResponse) Reset()         { *m = QueryTreasuryOutflowLimitsResponse{} }
+func (m *QueryTreasuryOutflowLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTreasuryOutflowLimitsResponse) ProtoMessage()    {}
+func (*QueryTreasuryOutflowLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15a18e20c0e403af, []int{33}
+}
+func (m *QueryTreasuryOutflowLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTreasuryOutflowLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTreasuryOutflowLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTreasuryOutflowLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTreasuryOutflowLimitsResponse.Merge(m, src)
+}
+func (m *QueryTreasuryOutflowLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTreasuryOutflowLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTreasuryOutflowLimitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTreasuryOutflowLimitsResponse proto.InternalMessageInfo
+
+func (m *QueryTreasuryOutflowLimitsResponse) GetLimits() []TreasuryOutflowLimit {
+	if m != nil {
+		return m.Limits
+	}
+	return nil
+}
+
+func (m *QueryTreasuryOutflowLimitsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTreasuryOutflowHeadroomRequest is the request type for the Query/TreasuryOutflowHeadroom RPC method.
+type QueryTreasuryOutflowHeadroomRequest struct {
+	// denom is the denom of the coins.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryTreasuryOutflowHeadroomRequest) Reset()         { *m = QueryTreasuryOutflowHeadroomRequest{} }
+func (m *QueryTreasuryOutflowHeadroomRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTreasuryOutflowHeadroomRequest) ProtoMessage()    {}
+func (*QueryTreasuryOutflowHeadroomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15a18e20c0e403af, []int{34}
+}
+func (m *QueryTreasuryOutflowHeadroomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTreasuryOutflowHeadroomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTreasuryOutflowHeadroomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTreasuryOutflowHeadroomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTreasuryOutflowHeadroomRequest.Merge(m, src)
+}
+func (m *QueryTreasuryOutflowHeadroomRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTreasuryOutflowHeadroomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTreasuryOutflowHeadroomRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTreasuryOutflowHeadroomRequest proto.InternalMessageInfo
+
+func (m *QueryTreasuryOutflowHeadroomRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryTreasuryOutflowHeadroomResponse is the response type for the Query/TreasuryOutflowHeadroom RPC method.
+type QueryTreasuryOutflowHeadroomResponse struct {
+	// limit is the outflow limit of the denom.
+	Limit TreasuryOutflowLimit `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit"`
+	// outflow is the amount withdrawn within the current window.
+	Outflow types.Coin `protobuf:"bytes,2,opt,name=outflow,proto3" json:"outflow"`
+	// headroom is the amount which could be withdrawn now.
+	Headroom types.Coin `protobuf:"bytes,3,opt,name=headroom,proto3" json:"headroom"`
+}
+
+func (m *QueryTreasuryOutflowHeadroomResponse) Reset()         { *m = QueryTreasuryOutflowHeadroomResponse{} }
+func (m *QueryTreasuryOutflowHeadroomResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTreasuryOutflowHeadroomResponse) ProtoMessage()    {}
+func (*QueryTreasuryOutflowHeadroomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15a18e20c0e403af, []int{35}
+}
+func (m *QueryTreasuryOutflowHeadroomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTreasuryOutflowHeadroomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTreasuryOutflowHeadroomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTreasuryOutflowHeadroomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTreasuryOutflowHeadroomResponse.Merge(m, src)
+}
+func (m *QueryTreasuryOutflowHeadroomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTreasuryOutflowHeadroomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTreasuryOutflowHeadroomResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTreasuryOutflowHeadroomResponse proto.InternalMessageInfo
+
+func (m *QueryTreasuryOutflowHeadroomResponse) GetLimit() TreasuryOutflowLimit {
+	if m != nil {
+		return m.Limit
+	}
+	return TreasuryOutflowLimit{}
+}
+
+func (m *QueryTreasuryOutflowHeadroomResponse) GetOutflow() types.Coin {
+	if m != nil {
+		return m.Outflow
+	}
+	return types.Coin{}
+}
+
+func (m *QueryTreasuryOutflowHeadroomResponse) GetHeadroom() types.Coin {
+	if m != nil {
+		return m.Headroom
+	}
+	return types.Coin{}
+}
+
 // QueryGrantsRequest is the request type for the Query/Grants RPC method.
 type QueryGrantsRequest struct {
 	Grantee string `protobuf:"bytes,1,opt,name=grantee,proto3" json:"grantee,omitempty"`
@@ -1085,7 +1889,7 @@ func (m *QueryGrantsRequest) Reset()         { *m = QueryGrantsRequest{} }
 func (m *QueryGrantsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGrantsRequest) ProtoMessage()    {}
 func (*QueryGrantsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15a18e20c0e403af, []int{22}
+	return fileDescriptor_15a18e20c0e403af, []int{36}
 }
 func (m *QueryGrantsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1138,7 +1942,7 @@ func (m *QueryGrantsRequest) GetPagination() *query.PageRequest {
 // QueryGrantsResponse is the response type for the Query/Grants RPC method.
 type QueryGrantsResponse struct {
 	// authorizations is a list of grants granted for grantee.
-	Authorizations []*types1.Any `protobuf:"bytes,1,rep,name=authorizations,proto3" json:"authorizations,omitempty"`
+	Authorizations []*types2.Any `protobuf:"bytes,1,rep,name=authorizations,proto3" json:"authorizations,omitempty"`
 	// pagination defines the pagination in the response.
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
@@ -1147,7 +1951,7 @@ func (m *QueryGrantsResponse) Reset()         { *m = QueryGrantsResponse{} }
 func (m *QueryGrantsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGrantsResponse) ProtoMessage()    {}
 func (*QueryGrantsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15a18e20c0e403af, []int{23}
+	return fileDescriptor_15a18e20c0e403af, []int{37}
 }
 func (m *QueryGrantsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1176,7 +1980,7 @@ func (m *QueryGrantsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryGrantsResponse proto.InternalMessageInfo
 
-func (m *QueryGrantsResponse) GetAuthorizations() []*types1.Any {
+func (m *QueryGrantsResponse) GetAuthorizations() []*types2.Any {
 	if m != nil {
 		return m.Authorizations
 	}
@@ -1201,18 +2005,32 @@ func init() {
 	proto.RegisterType((*QueryMemberResponse)(nil), "lbm.foundation.v1.QueryMemberResponse")
 	proto.RegisterType((*QueryMembersRequest)(nil), "lbm.foundation.v1.QueryMembersRequest")
 	proto.RegisterType((*QueryMembersResponse)(nil), "lbm.foundation.v1.QueryMembersResponse")
+	proto.RegisterType((*QueryExpiringMembersRequest)(nil), "lbm.foundation.v1.QueryExpiringMembersRequest")
+	proto.RegisterType((*QueryExpiringMembersResponse)(nil), "lbm.foundation.v1.QueryExpiringMembersResponse")
 	proto.RegisterType((*QueryProposalRequest)(nil), "lbm.foundation.v1.QueryProposalRequest")
 	proto.RegisterType((*QueryProposalResponse)(nil), "lbm.foundation.v1.QueryProposalResponse")
 	proto.RegisterType((*QueryProposalsRequest)(nil), "lbm.foundation.v1.QueryProposalsRequest")
 	proto.RegisterType((*QueryProposalsResponse)(nil), "lbm.foundation.v1.QueryProposalsResponse")
+	proto.RegisterType((*QueryArchivedProposalsRequest)(nil), "lbm.foundation.v1.QueryArchivedProposalsRequest")
+	proto.RegisterType((*QueryArchivedProposalsResponse)(nil), "lbm.foundation.v1.QueryArchivedProposalsResponse")
 	proto.RegisterType((*QueryVoteRequest)(nil), "lbm.foundation.v1.QueryVoteRequest")
 	proto.RegisterType((*QueryVoteResponse)(nil), "lbm.foundation.v1.QueryVoteResponse")
 	proto.RegisterType((*QueryVotesRequest)(nil), "lbm.foundation.v1.QueryVotesRequest")
 	proto.RegisterType((*QueryVotesResponse)(nil), "lbm.foundation.v1.QueryVotesResponse")
+	proto.RegisterType((*QueryVoteDelegationsRequest)(nil), "lbm.foundation.v1.QueryVoteDelegationsRequest")
+	proto.RegisterType((*QueryVoteDelegationsResponse)(nil), "lbm.foundation.v1.QueryVoteDelegationsResponse")
 	proto.RegisterType((*QueryTallyResultRequest)(nil), "lbm.foundation.v1.QueryTallyResultRequest")
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "lbm.foundation.v1.QueryTallyResultResponse")
+	proto.RegisterType((*QuerySimulateProposalRequest)(nil), "lbm.foundation.v1.QuerySimulateProposalRequest")
+	proto.RegisterType((*QuerySimulateProposalResponse)(nil), "lbm.foundation.v1.QuerySimulateProposalResponse")
+	proto.RegisterType((*QueryMsgTypeDecisionPoliciesRequest)(nil), "lbm.foundation.v1.QueryMsgTypeDecisionPoliciesRequest")
+	proto.RegisterType((*QueryMsgTypeDecisionPoliciesResponse)(nil), "lbm.foundation.v1.QueryMsgTypeDecisionPoliciesResponse")
 	proto.RegisterType((*QueryCensorshipsRequest)(nil), "lbm.foundation.v1.QueryCensorshipsRequest")
 	proto.RegisterType((*QueryCensorshipsResponse)(nil), "lbm.foundation.v1.QueryCensorshipsResponse")
+	proto.RegisterType((*QueryTreasuryOutflowLimitsRequest)(nil), "lbm.foundation.v1.QueryTreasuryOutflowLimitsRequest")
+	proto.RegisterType((*QueryTreasuryOutflowLimitsResponse)(nil), "lbm.foundation.v1.QueryTreasuryOutflowLimitsResponse")
+	proto.RegisterType((*QueryTreasuryOutflowHeadroomRequest)(nil), "lbm.foundation.v1.QueryTreasuryOutflowHeadroomRequest")
+	proto.RegisterType((*QueryTreasuryOutflowHeadroomResponse)(nil), "lbm.foundation.v1.QueryTreasuryOutflowHeadroomResponse")
 	proto.RegisterType((*QueryGrantsRequest)(nil), "lbm.foundation.v1.QueryGrantsRequest")
 	proto.RegisterType((*QueryGrantsResponse)(nil), "lbm.foundation.v1.QueryGrantsResponse")
 }
@@ -1220,85 +2038,128 @@ func init() {
 func init() { proto.RegisterFile("lbm/foundation/v1/query.proto", fileDescriptor_15a18e20c0e403af) }
 
 var fileDescriptor_15a18e20c0e403af = []byte{
-	// 1241 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0xb4, 0x89, 0x93, 0x8c, 0xa1, 0xa2, 0x43, 0x68, 0x13, 0x27, 0x71, 0xd2, 0x6d, 0x7e,
-	0x91, 0x92, 0x1d, 0xec, 0x22, 0xa5, 0xa4, 0x87, 0xb6, 0x29, 0x24, 0xe4, 0x00, 0x0a, 0x56, 0xe9,
-	0xa1, 0x12, 0xb2, 0xd6, 0xf6, 0xc6, 0x59, 0xe1, 0xdd, 0x71, 0x77, 0x76, 0x23, 0xdc, 0xe0, 0x4b,
-	0x0f, 0x95, 0xb8, 0xa0, 0x42, 0x2e, 0x08, 0x2e, 0x88, 0x63, 0xcf, 0xfc, 0x11, 0x15, 0xa7, 0x4a,
-	0xbd, 0x70, 0xe1, 0x87, 0x12, 0xfe, 0x10, 0xb4, 0x33, 0x6f, 0xec, 0x5d, 0x7b, 0xd7, 0x76, 0x8b,
-	0x6f, 0xbb, 0x33, 0xef, 0xbd, 0xef, 0x7b, 0xef, 0xcd, 0xcc, 0xfb, 0xf0, 0x7c, 0xad, 0x64, 0xd3,
-	0x03, 0xe6, 0x3b, 0x15, 0xc3, 0xb3, 0x98, 0x43, 0x8f, 0x72, 0xf4, 0xa1, 0x6f, 0xba, 0x0d, 0xbd,
-	0xee, 0x32, 0x8f, 0x91, 0x8b, 0xb5, 0x92, 0xad, 0xb7, 0xb7, 0xf5, 0xa3, 0x5c, 0x66, 0xbd, 0xcc,
-	0xb8, 0xcd, 0x38, 0x2d, 0x19, 0xdc, 0x94, 0xb6, 0xf4, 0x28, 0x57, 0x32, 0x3d, 0x23, 0x47, 0xeb,
-	0x46, 0xd5, 0x72, 0xa4, 0xa1, 0x70, 0xcf, 0xcc, 0x55, 0x19, 0xab, 0xd6, 0x4c, 0x6a, 0xd4, 0x2d,
-	0x6a, 0x38, 0x0e, 0xf3, 0xc4, 0x26, 0x87, 0x5d, 0xad, 0x1b, 0x3b, 0x04, 0x25, 0x6d, 0xb2, 0x61,
-	0x34, 0x85, 0x53, 0x66, 0x96, 0xda, 0x9f, 0x01, 0x04, 0xf1, 0x57, 0xf2, 0x0f, 0xa8, 0xe1, 0x34,
-	0xd4, 0x96, 0x74, 0x2d, 0x8a, 0x3f, 0x2a, 0x7f, 0x60, 0x6b, 0xaa, 0xca, 0xaa, 0x4c, 0xae, 0x07,
-	0x5f, 0x72, 0x55, 0x9b, 0xc2, 0xe4, 0xf3, 0x20, 0x9f, 0x7d, 0xc3, 0x35, 0x6c, 0x5e, 0x30, 0x1f,
-	0xfa, 0x26, 0xf7, 0xb4, 0xcf, 0xf0, 0xdb, 0x91, 0x55, 0x5e, 0x67, 0x0e, 0x37, 0xc9, 0x26, 0x4e,
-	0xd5, 0xc5, 0xca, 0x34, 0x5a, 0x44, 0x6b, 0xe9, 0xfc, 0x8c, 0xde, 0x55, 0x2a, 0x5d, 0xba, 0x6c,
-	0x8f, 0x3e, 0xff, 0x6b, 0x61, 0xa4, 0x00, 0xe6, 0xda, 0x25, 0x3c, 0x25, 0xe2, 0xdd, 0x73, 0x4d,
-	0x83, 0xfb, 0x6e, 0x43, 0xe1, 0x3c, 0x41, 0xf8, 0x9d, 0x8e, 0x0d, 0x80, 0xb2, 0x71, 0xca, 0xb0,
-	0x99, 0xef, 0x78, 0xd3, 0x68, 0xf1, 0xfc, 0x5a, 0x3a, 0x3f, 0xa7, 0x43, 0x32, 0x41, 0x51, 0x74,
-	0x28, 0x8a, 0xfe, 0x91, 0x59, 0xbe, 0xcb, 0x2c, 0x67, 0x7b, 0x33, 0x40, 0x7b, 0xf6, 0xf7, 0x02,
-	0xad, 0x5a, 0xde, 0xa1, 0x5f, 0xd2, 0xcb, 0xcc, 0xa6, 0x3b, 0x96, 0xc3, 0xcb, 0x87, 0x96, 0x41,
-	0x0f, 0xe0, 0x63, 0x83, 0x57, 0xbe, 0xa2, 0x5e, 0xa3, 0x6e, 0x72, 0xe5, 0xc7, 0x0b, 0x00, 0xa2,
-	0xcd, 0xe1, 0x8c, 0xe0, 0xb1, 0xd3, 0xca, 0x65, 0xcf, 0x39, 0x60, 0x8a, 0xe6, 0x03, 0x3c, 0x1b,
-	0xbb, 0x0b, 0x5c, 0x6f, 0xe2, 0x51, 0xcb, 0x39, 0x60, 0x50, 0x94, 0x2b, 0x31, 0x45, 0x89, 0x3a,
-	0x42, 0x71, 0x84, 0x93, 0xa6, 0x43, 0x03, 0x3e, 0x35, 0xed, 0x92, 0xe9, 0x02, 0x22, 0x99, 0xc6,
-	0xe3, 0x46, 0xa5, 0xe2, 0x9a, 0x5c, 0x96, 0x7a, 0xb2, 0xa0, 0x7e, 0xb5, 0x4f, 0xa0, 0x35, 0xca,
-	0x1e, 0x38, 0xe4, 0x70, 0xca, 0x16, 0x2b, 0x3d, 0x5a, 0x03, 0x2e, 0x60, 0xa8, 0x7d, 0x19, 0x89,
-	0xa4, 0x7a, 0x4f, 0x76, 0x30, 0x6e, 0x9f, 0x69, 0x88, 0xb6, 0x12, 0xa9, 0xbe, 0xbc, 0x2c, 0xaa,
-	0x07, 0xfb, 0x46, 0xd5, 0x04, 0xdf, 0x42, 0xc8, 0x53, 0xfb, 0x09, 0x41, 0xd3, 0x5b, 0xf1, 0x81,
-	0xea, 0x87, 0x78, 0x5c, 0x32, 0xe0, 0xd0, 0xdb, 0x64, 0xae, 0x50, 0x29, 0x65, 0x4f, 0x76, 0x23,
-	0xdc, 0xce, 0x09, 0x6e, 0xab, 0x7d, 0xb9, 0x49, 0xdc, 0x08, 0xb9, 0x4d, 0xe0, 0xb6, 0xef, 0xb2,
-	0x3a, 0xe3, 0x46, 0x4d, 0x25, 0xbf, 0x80, 0xd3, 0x75, 0x58, 0x2a, 0x5a, 0x15, 0x91, 0xfd, 0x68,
-	0x01, 0xab, 0xa5, 0xbd, 0x8a, 0xb6, 0x0f, 0x07, 0xb6, 0xed, 0xd8, 0xba, 0x1b, 0x13, 0xca, 0x0c,
-	0x8a, 0x36, 0x1b, 0x77, 0x3b, 0x94, 0x5b, 0xcb, 0x58, 0x2b, 0x76, 0x44, 0x1c, 0x7a, 0x23, 0x7e,
-	0x45, 0xf8, 0x52, 0x27, 0x02, 0x90, 0xbe, 0x85, 0x27, 0x15, 0x0f, 0xd5, 0x8c, 0x5e, 0xac, 0xa1,
-	0x1d, 0x6d, 0x9f, 0xe1, 0x35, 0x64, 0x0f, 0xbf, 0x25, 0x38, 0xde, 0x67, 0x9e, 0x39, 0x68, 0x33,
-	0xc8, 0x14, 0x1e, 0x3b, 0x62, 0x9e, 0xe9, 0x0a, 0xe0, 0xc9, 0x82, 0xfc, 0xd1, 0x6e, 0xe3, 0x8b,
-	0xa1, 0x50, 0x90, 0xe9, 0x35, 0x3c, 0x1a, 0xec, 0x42, 0x19, 0x2f, 0xc7, 0x24, 0x29, 0xcc, 0x85,
-	0x91, 0xf6, 0x4d, 0x28, 0x02, 0x1f, 0x98, 0xcd, 0x4e, 0x4c, 0x2d, 0x5e, 0xa7, 0x5f, 0x3f, 0x20,
-	0x78, 0x12, 0x00, 0x1e, 0x32, 0xb8, 0x2e, 0x93, 0x55, 0x7d, 0x4a, 0x4a, 0x01, 0x7a, 0x24, 0x6d,
-	0x87, 0xd7, 0x9f, 0x2d, 0x7c, 0x59, 0x3e, 0xd4, 0x46, 0xad, 0x16, 0xbc, 0xd2, 0x7e, 0xcd, 0x1b,
-	0xf8, 0xce, 0xdc, 0xc7, 0xd3, 0xdd, 0xbe, 0x90, 0xd5, 0x16, 0x1e, 0xf3, 0x82, 0x65, 0x68, 0x4c,
-	0x36, 0x26, 0xab, 0x90, 0x9b, 0x4a, 0x4e, 0xb8, 0x68, 0x06, 0x70, 0xba, 0x6b, 0x3a, 0x9c, 0xb9,
-	0xfc, 0xd0, 0xaa, 0x0f, 0xfd, 0xee, 0x3c, 0x43, 0xc0, 0x3d, 0x82, 0x01, 0xdc, 0x3f, 0xc6, 0xe9,
-	0x72, 0x7b, 0x19, 0xfa, 0x32, 0x1f, 0x93, 0x41, 0xdb, 0x19, 0x12, 0x08, 0xfb, 0x0d, 0xaf, 0x47,
-	0x3f, 0xaa, 0x83, 0xb3, 0xeb, 0x1a, 0x8e, 0xc7, 0x43, 0xb3, 0xa4, 0x1a, 0x2c, 0x98, 0xa6, 0x9a,
-	0x25, 0xf0, 0x4b, 0x16, 0xf1, 0x1b, 0x36, 0xaf, 0x16, 0x83, 0x99, 0x58, 0xf4, 0xdd, 0x1a, 0x5c,
-	0x23, 0x6c, 0xf3, 0xea, 0xbd, 0x46, 0xdd, 0xfc, 0xc2, 0xad, 0x75, 0xd4, 0xf1, 0xfc, 0x6b, 0xd7,
-	0xf1, 0x4f, 0x04, 0xc3, 0x46, 0x51, 0x83, 0x12, 0x36, 0xf1, 0x05, 0xc3, 0xf7, 0x0e, 0x99, 0x6b,
-	0x3d, 0x92, 0x32, 0x09, 0xaa, 0x38, 0xa5, 0x4b, 0x8d, 0xa3, 0x2b, 0x8d, 0xa3, 0xdf, 0x71, 0x1a,
-	0xdb, 0xb7, 0x7e, 0xff, 0x6d, 0xe3, 0x66, 0xdf, 0x11, 0xff, 0x75, 0x58, 0x51, 0xdd, 0x09, 0x47,
-	0x2f, 0x74, 0x80, 0x0d, 0xad, 0xf4, 0xf9, 0x97, 0x6f, 0xe2, 0x31, 0x91, 0x1f, 0x79, 0x84, 0x53,
-	0x52, 0x02, 0x91, 0xe5, 0x98, 0x93, 0xd0, 0xad, 0xb5, 0x32, 0x2b, 0xfd, 0xcc, 0x24, 0x9c, 0x76,
-	0xe5, 0xf1, 0xcb, 0x7f, 0x4f, 0xce, 0xcd, 0x92, 0x19, 0xda, 0x2d, 0x21, 0xa5, 0xcc, 0x22, 0x8f,
-	0x11, 0x9e, 0x50, 0x4a, 0x8a, 0xac, 0x26, 0xc5, 0xed, 0x10, 0x61, 0x99, 0xb5, 0xfe, 0x86, 0x40,
-	0xe1, 0xaa, 0xa0, 0x30, 0x4f, 0x66, 0x63, 0x28, 0x78, 0x0a, 0xf7, 0x67, 0x84, 0x2f, 0x44, 0xf5,
-	0x0e, 0xd9, 0x48, 0x42, 0x88, 0x95, 0x5b, 0x19, 0x7d, 0x50, 0x73, 0xa0, 0xb5, 0x2e, 0x68, 0x2d,
-	0x11, 0x8d, 0xf6, 0x12, 0xd7, 0xc5, 0x40, 0x6e, 0x91, 0xa7, 0x08, 0xa7, 0xa4, 0xb6, 0x48, 0xee,
-	0x4f, 0x44, 0x8a, 0x25, 0xf7, 0x27, 0xaa, 0xc0, 0xb4, 0x4d, 0xc1, 0x22, 0x47, 0x68, 0x6f, 0x16,
-	0x20, 0x65, 0xe8, 0x31, 0x08, 0xba, 0x26, 0xf9, 0x16, 0xe1, 0x71, 0xd0, 0x48, 0xa4, 0x0f, 0x58,
-	0xeb, 0xd0, 0xac, 0xf6, 0xb5, 0x03, 0x56, 0x1b, 0x82, 0xd5, 0x2a, 0x59, 0x1e, 0x88, 0x15, 0xf9,
-	0x1e, 0xe1, 0x09, 0x35, 0xed, 0x93, 0x4f, 0x50, 0x87, 0x6a, 0x4a, 0x3e, 0x41, 0x9d, 0x2a, 0x49,
-	0xcb, 0x0b, 0x3a, 0xef, 0x91, 0xf5, 0xb8, 0x43, 0xac, 0x54, 0x05, 0x3d, 0x0e, 0xcd, 0x93, 0x26,
-	0x79, 0x82, 0xf0, 0x64, 0x4b, 0xba, 0x90, 0xbe, 0x58, 0xad, 0x1a, 0xbd, 0x3b, 0x80, 0x25, 0xd0,
-	0x5a, 0x12, 0xb4, 0xb2, 0x64, 0xae, 0x17, 0x2d, 0x72, 0x82, 0xf0, 0x68, 0x30, 0x62, 0xc9, 0xd5,
-	0xa4, 0xc8, 0x21, 0xf5, 0x92, 0x59, 0xea, 0x6d, 0x04, 0xc8, 0xb7, 0x05, 0xf2, 0x16, 0xb9, 0x31,
-	0x78, 0x41, 0xa8, 0x18, 0xed, 0xf4, 0x58, 0xa8, 0x9d, 0x26, 0xf9, 0x0e, 0xe1, 0x31, 0xa1, 0x14,
-	0x48, 0x4f, 0xc4, 0x56, 0x59, 0x96, 0xfb, 0x58, 0x01, 0xb1, 0x1b, 0x82, 0x58, 0x9e, 0xbc, 0xff,
-	0xaa, 0xc4, 0xc8, 0x2f, 0x08, 0xa7, 0x43, 0x33, 0x9b, 0xac, 0x27, 0xbe, 0x2f, 0x5d, 0x5a, 0x22,
-	0x73, 0x6d, 0x20, 0xdb, 0xff, 0x41, 0x51, 0x28, 0x87, 0xa0, 0x66, 0xe9, 0xd0, 0x44, 0x4f, 0xa6,
-	0xd8, 0x2d, 0x2d, 0x92, 0x29, 0xc6, 0x48, 0x04, 0x6d, 0x45, 0x50, 0x5c, 0x24, 0xd9, 0x18, 0x8a,
-	0x61, 0x0d, 0x70, 0x82, 0x70, 0x4a, 0x8e, 0xc6, 0xe4, 0x67, 0x29, 0x32, 0xd5, 0x93, 0x9f, 0xa5,
-	0xe8, 0x84, 0xd5, 0xb6, 0x04, 0x83, 0x0f, 0x48, 0x3e, 0x86, 0x81, 0xd0, 0x01, 0x9c, 0x1e, 0x83,
-	0x1e, 0x68, 0xd2, 0xe3, 0xb0, 0x1c, 0x68, 0x6e, 0xef, 0x3e, 0x3f, 0xcd, 0xa2, 0x17, 0xa7, 0x59,
-	0xf4, 0xcf, 0x69, 0x16, 0x3d, 0x3d, 0xcb, 0x8e, 0xbc, 0x38, 0xcb, 0x8e, 0xfc, 0x71, 0x96, 0x1d,
-	0x79, 0xb0, 0xf1, 0x4a, 0x53, 0xb8, 0x94, 0x12, 0x63, 0xfc, 0xfa, 0x7f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x2b, 0x30, 0x28, 0x91, 0x7a, 0x11, 0x00, 0x00,
+	// 1925 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0x5a, 0x12, 0x25, 0x3f, 0x05, 0x8a, 0x3d, 0x96, 0x2d, 0x7a, 0x25, 0x51, 0xf2, 0xca,
+	0x96, 0x54, 0xab, 0xe2, 0x5a, 0xb2, 0x5d, 0x25, 0x12, 0x0a, 0xdb, 0x92, 0x2d, 0xc7, 0x41, 0x3f,
+	0x54, 0x46, 0xcd, 0x21, 0x40, 0x41, 0x2c, 0xc9, 0x11, 0xb5, 0x08, 0x77, 0x87, 0xd9, 0x59, 0xaa,
+	0x51, 0x54, 0x5d, 0x72, 0x08, 0xd0, 0x4b, 0xe0, 0x36, 0x97, 0xa2, 0x3d, 0xb4, 0xe8, 0xa5, 0x80,
+	0x81, 0x5e, 0x8a, 0x1c, 0x0a, 0xf4, 0xd4, 0x53, 0x83, 0x5c, 0x1a, 0xb4, 0x97, 0x5e, 0xda, 0x14,
+	0x56, 0xff, 0x90, 0x62, 0x67, 0xde, 0x90, 0xbb, 0xe4, 0x2e, 0xb9, 0x4c, 0x18, 0x20, 0x87, 0x9c,
+	0xc8, 0x9d, 0x79, 0x1f, 0xbf, 0xf7, 0x9b, 0x37, 0x1f, 0xef, 0xc1, 0x6c, 0xad, 0xe4, 0x98, 0x07,
+	0xac, 0xe1, 0x56, 0x2c, 0xdf, 0x66, 0xae, 0x79, 0xb4, 0x66, 0xbe, 0xd3, 0xa0, 0xde, 0x71, 0xbe,
+	0xee, 0x31, 0x9f, 0x91, 0x4b, 0xb5, 0x92, 0x93, 0x6f, 0x4d, 0xe7, 0x8f, 0xd6, 0xf4, 0x5b, 0x65,
+	0xc6, 0x1d, 0xc6, 0xcd, 0x92, 0xc5, 0xa9, 0x94, 0x35, 0x8f, 0xd6, 0x4a, 0xd4, 0xb7, 0xd6, 0xcc,
+	0xba, 0x55, 0xb5, 0x5d, 0x29, 0x28, 0xd4, 0xf5, 0x99, 0x2a, 0x63, 0xd5, 0x1a, 0x35, 0xad, 0xba,
+	0x6d, 0x5a, 0xae, 0xcb, 0x7c, 0x31, 0xc9, 0x71, 0xd6, 0xe8, 0xf4, 0xdd, 0xfa, 0x42, 0x99, 0x5c,
+	0xd8, 0x9b, 0xf2, 0x53, 0x66, 0xb6, 0x9a, 0x5f, 0x08, 0xcf, 0x5b, 0xa5, 0xb2, 0xdd, 0x14, 0x0a,
+	0x3e, 0x50, 0x68, 0xda, 0xa7, 0x6e, 0x85, 0x7a, 0x8e, 0xed, 0xfa, 0x52, 0xc6, 0x3f, 0xae, 0x53,
+	0x85, 0xe2, 0x1a, 0x62, 0x14, 0x5f, 0xa5, 0xc6, 0x81, 0x69, 0xb9, 0x18, 0xbd, 0x3e, 0xd7, 0x3e,
+	0xe5, 0xdb, 0x0e, 0xe5, 0xbe, 0xe5, 0xd4, 0x95, 0xae, 0xf4, 0x5e, 0x14, 0x5f, 0xa6, 0xfc, 0xc0,
+	0xa9, 0xc9, 0x2a, 0xab, 0x32, 0x39, 0x1e, 0xfc, 0x93, 0xa3, 0xc6, 0x24, 0x90, 0x1f, 0x05, 0x94,
+	0xed, 0x59, 0x9e, 0xe5, 0xf0, 0x02, 0x7d, 0xa7, 0x41, 0xb9, 0x6f, 0xfc, 0x00, 0x2e, 0x47, 0x46,
+	0x79, 0x9d, 0xb9, 0x9c, 0x92, 0x0d, 0xc8, 0xd4, 0xc5, 0x48, 0x56, 0x9b, 0xd7, 0x96, 0xc7, 0xd7,
+	0xaf, 0xe5, 0x3b, 0x56, 0x23, 0x2f, 0x55, 0xb6, 0x87, 0x3f, 0xf9, 0xcf, 0xdc, 0xb9, 0x02, 0x8a,
+	0x1b, 0x57, 0x61, 0x52, 0xd8, 0xdb, 0xf7, 0xa8, 0xc5, 0x1b, 0xde, 0xb1, 0xf2, 0xf3, 0x81, 0x06,
+	0x57, 0xda, 0x26, 0xd0, 0x95, 0x03, 0x19, 0xcb, 0x61, 0x0d, 0xd7, 0xcf, 0x6a, 0xf3, 0x43, 0xcb,
+	0xe3, 0xeb, 0x33, 0x79, 0x0c, 0x26, 0xe0, 0x35, 0x8f, 0x94, 0xe6, 0x1f, 0xd1, 0xf2, 0x0e, 0xb3,
+	0xdd, 0xed, 0x8d, 0xc0, 0xdb, 0xf3, 0xcf, 0xe7, 0xcc, 0xaa, 0xed, 0x1f, 0x36, 0x4a, 0xf9, 0x32,
+	0x73, 0xcc, 0x5d, 0xdb, 0xe5, 0xe5, 0x43, 0xdb, 0x32, 0x0f, 0xf0, 0xcf, 0x2a, 0xaf, 0xbc, 0x8d,
+	0x44, 0xa3, 0x1e, 0x2f, 0xa0, 0x13, 0x63, 0x06, 0x74, 0x81, 0x63, 0xb7, 0x19, 0xcb, 0x53, 0xf7,
+	0x80, 0x29, 0x98, 0x6f, 0xc1, 0x74, 0xec, 0x2c, 0x62, 0xdd, 0x82, 0x61, 0xdb, 0x3d, 0x60, 0x48,
+	0xca, 0xf5, 0x18, 0x52, 0xa2, 0x8a, 0x48, 0x8e, 0x50, 0x32, 0xf2, 0xb8, 0x00, 0xdf, 0xa7, 0x4e,
+	0x89, 0x7a, 0xe8, 0x91, 0x64, 0x61, 0xd4, 0xaa, 0x54, 0x3c, 0xca, 0x25, 0xd5, 0x17, 0x0a, 0xea,
+	0xd3, 0x78, 0x0d, 0x2e, 0x47, 0xe4, 0x11, 0xc3, 0x1a, 0x64, 0x1c, 0x31, 0xd2, 0x65, 0x69, 0x50,
+	0x05, 0x05, 0x8d, 0x9f, 0x44, 0x2c, 0xa9, 0xb5, 0x27, 0xbb, 0x00, 0xad, 0x6d, 0x83, 0xd6, 0x16,
+	0x23, 0xec, 0xcb, 0xfd, 0xa8, 0xd6, 0x60, 0xcf, 0xaa, 0x52, 0xd4, 0x2d, 0x84, 0x34, 0x8d, 0x5f,
+	0x6b, 0x30, 0x19, 0xb5, 0x8f, 0x50, 0x5f, 0x85, 0x51, 0x89, 0x80, 0xe3, 0xda, 0x26, 0x63, 0x45,
+	0xa6, 0x94, 0x3c, 0x79, 0x12, 0xc1, 0x76, 0x5e, 0x60, 0x5b, 0xea, 0x89, 0x4d, 0xfa, 0x8d, 0x80,
+	0xa3, 0xb8, 0xa2, 0x8f, 0xdf, 0xad, 0xdb, 0x9e, 0xed, 0x56, 0xbf, 0x22, 0x0e, 0x7e, 0xaf, 0xc1,
+	0x4c, 0xbc, 0x9f, 0xaf, 0x11, 0x17, 0x1b, 0xb8, 0x4e, 0x7b, 0x1e, 0xab, 0x33, 0x6e, 0xd5, 0x14,
+	0x09, 0x73, 0x30, 0x5e, 0xc7, 0xa1, 0xa2, 0x5d, 0x11, 0x2c, 0x0c, 0x17, 0x40, 0x0d, 0x3d, 0xad,
+	0x18, 0x7b, 0x70, 0xa5, 0x4d, 0xb1, 0x79, 0x4e, 0x8c, 0x29, 0x31, 0x24, 0x6f, 0x3a, 0xee, 0xa4,
+	0x50, 0x6a, 0x4d, 0x61, 0xe3, 0xd3, 0xf3, 0x6d, 0x26, 0x07, 0xbd, 0x22, 0xe4, 0x55, 0xc8, 0x70,
+	0xdf, 0xf2, 0x1b, 0x5c, 0x30, 0x36, 0x11, 0xbb, 0x5b, 0x95, 0xf3, 0x37, 0x84, 0x60, 0x01, 0x15,
+	0x88, 0xae, 0xa2, 0xa2, 0x5e, 0x76, 0x48, 0x6c, 0xca, 0xe6, 0x37, 0x79, 0x1d, 0x2e, 0xf2, 0x46,
+	0xc9, 0xb1, 0xfd, 0x62, 0x70, 0x22, 0x17, 0x0f, 0x3c, 0xe6, 0x64, 0x87, 0x05, 0x48, 0x3d, 0x2f,
+	0xcf, 0xec, 0xbc, 0x3a, 0xb3, 0xf3, 0xfb, 0xea, 0xcc, 0xde, 0x1e, 0x7e, 0xf6, 0xf9, 0x9c, 0x56,
+	0x98, 0x90, 0x9a, 0xc1, 0xf0, 0xae, 0xc7, 0x1c, 0xb2, 0x0b, 0x13, 0x61, 0x5b, 0x3e, 0xcb, 0x8e,
+	0xa4, 0xb4, 0xf4, 0x52, 0xcb, 0xd2, 0x3e, 0x0b, 0x92, 0xef, 0x6a, 0x3b, 0x99, 0xb8, 0x40, 0xf7,
+	0xe1, 0x82, 0xe2, 0x5c, 0x25, 0x5e, 0xb7, 0x15, 0xc2, 0xd4, 0x6b, 0xe9, 0x0c, 0x2e, 0xf9, 0xfe,
+	0x71, 0x1e, 0x66, 0x05, 0xc8, 0x87, 0x5e, 0xf9, 0xd0, 0x3e, 0xa2, 0x95, 0x6f, 0x56, 0xfe, 0xcb,
+	0xaf, 0xfc, 0x73, 0x0d, 0x72, 0x49, 0xa4, 0x7e, 0xed, 0x32, 0xe0, 0x29, 0x5c, 0x14, 0x58, 0xdf,
+	0x64, 0x3e, 0x4d, 0x7b, 0xf4, 0x90, 0x49, 0x18, 0x39, 0x62, 0x3e, 0xf5, 0x84, 0xe3, 0x0b, 0x05,
+	0xf9, 0x61, 0x3c, 0x80, 0x4b, 0x21, 0x53, 0x18, 0xe9, 0x0a, 0x0c, 0x07, 0xb3, 0x98, 0x39, 0x53,
+	0x31, 0x41, 0x0a, 0x71, 0x21, 0x64, 0xfc, 0x2c, 0x64, 0x81, 0xa7, 0x46, 0xb3, 0x1b, 0xc3, 0xc5,
+	0x17, 0xb9, 0x2e, 0x7e, 0xa9, 0x01, 0x09, 0xbb, 0xc7, 0x08, 0xee, 0xc8, 0x60, 0xd5, 0x3a, 0x25,
+	0x85, 0x80, 0x6b, 0x24, 0x65, 0x07, 0x7f, 0x55, 0x06, 0x2e, 0x1e, 0xd1, 0x1a, 0xad, 0x8a, 0xe1,
+	0x81, 0x5f, 0x95, 0x7f, 0x51, 0x57, 0x65, 0x87, 0x1f, 0x64, 0xa1, 0x00, 0x17, 0x83, 0xc8, 0x8a,
+	0x95, 0xd6, 0x1c, 0x12, 0x72, 0x3d, 0x81, 0x90, 0x96, 0x15, 0xa4, 0xe6, 0xe5, 0xa3, 0xa8, 0xed,
+	0xc1, 0x91, 0xb4, 0x09, 0x53, 0xf2, 0x1d, 0x6b, 0xd5, 0x6a, 0xc1, 0x23, 0xb6, 0x51, 0xf3, 0x53,
+	0x5f, 0xa3, 0x6f, 0x42, 0xb6, 0x53, 0x17, 0x83, 0xde, 0x84, 0x11, 0x3f, 0x18, 0x46, 0x62, 0x73,
+	0x31, 0x91, 0x86, 0xd4, 0x54, 0x06, 0x08, 0x15, 0xe3, 0x3e, 0x12, 0xfa, 0x86, 0xed, 0x34, 0x6a,
+	0x96, 0x4f, 0xfb, 0xbe, 0xdf, 0xff, 0xa8, 0xc1, 0x6c, 0x82, 0x05, 0x84, 0xf7, 0x00, 0x46, 0x3d,
+	0xe1, 0x59, 0x2d, 0xc5, 0x7c, 0x84, 0x3c, 0x51, 0xf1, 0x28, 0xee, 0x22, 0x10, 0x95, 0x5a, 0xb0,
+	0x91, 0xa9, 0xe7, 0xb1, 0xe6, 0x46, 0x16, 0x1f, 0xe4, 0x2e, 0x64, 0xe8, 0x11, 0x75, 0x7d, 0x9e,
+	0x1d, 0x12, 0x66, 0xaf, 0xe6, 0x5b, 0x05, 0x93, 0xb4, 0xfa, 0x38, 0x98, 0x56, 0x55, 0x86, 0x94,
+	0x35, 0x1c, 0x58, 0x90, 0x0f, 0x4e, 0x5e, 0xdd, 0x3f, 0xae, 0xd3, 0x47, 0xb4, 0x6c, 0x73, 0x9b,
+	0xb9, 0x7b, 0xac, 0x66, 0x97, 0x6d, 0xfa, 0x55, 0x64, 0xec, 0x8d, 0xee, 0xfe, 0x90, 0xa5, 0xd7,
+	0x61, 0xac, 0x8e, 0x63, 0x48, 0xd3, 0x72, 0xdc, 0x2b, 0x2f, 0xc6, 0xca, 0x31, 0x46, 0xd8, 0xd4,
+	0x1f, 0x5c, 0xc6, 0x5a, 0x98, 0xb1, 0x3b, 0xd4, 0xe5, 0xcc, 0xe3, 0x87, 0x76, 0x7d, 0xe0, 0x04,
+	0x3d, 0xd7, 0x20, 0xdb, 0xe9, 0x03, 0x49, 0x79, 0x0c, 0xe3, 0xe5, 0xd6, 0x30, 0xf2, 0x32, 0x1b,
+	0xc3, 0x4b, 0x4b, 0x19, 0xc9, 0x08, 0xeb, 0x0d, 0x8e, 0x8f, 0xb7, 0xe1, 0x7a, 0xa4, 0x12, 0xfd,
+	0x61, 0xc3, 0x3f, 0xa8, 0xb1, 0x9f, 0x7e, 0xcf, 0x76, 0x6c, 0x7f, 0xe0, 0xcc, 0x7c, 0xac, 0x81,
+	0xd1, 0xcd, 0x5b, 0x93, 0xa3, 0x4c, 0x4d, 0x8c, 0x20, 0x3d, 0x4b, 0x71, 0xdb, 0x3f, 0xc6, 0x82,
+	0xda, 0x17, 0x52, 0x79, 0x70, 0x1c, 0x6d, 0xe1, 0x06, 0x6b, 0xf3, 0xf9, 0x1a, 0xb5, 0x2a, 0x1e,
+	0x63, 0x8e, 0x62, 0x69, 0x12, 0x46, 0x2a, 0xd4, 0x65, 0x0e, 0x96, 0xae, 0xf2, 0xc3, 0x38, 0x53,
+	0xdb, 0x25, 0x51, 0x1b, 0xa3, 0xde, 0x81, 0x11, 0x01, 0x1c, 0xf9, 0xed, 0x33, 0x68, 0xa9, 0x1b,
+	0x14, 0x56, 0x4c, 0x4e, 0x62, 0xc0, 0xd7, 0x62, 0x1b, 0x08, 0xa2, 0x7b, 0x80, 0x47, 0x12, 0xca,
+	0x93, 0x2d, 0x18, 0x3b, 0x44, 0x4c, 0xd9, 0xa1, 0x74, 0xba, 0x4d, 0x05, 0xe3, 0x57, 0xea, 0x0a,
+	0x7f, 0xe2, 0x59, 0x6e, 0x2b, 0x71, 0xb2, 0x30, 0x5a, 0x0d, 0x06, 0x28, 0x55, 0xf5, 0x3c, 0x7e,
+	0x92, 0x79, 0x78, 0xc9, 0xe1, 0xd5, 0x62, 0xd0, 0x97, 0x28, 0x36, 0xbc, 0x1a, 0x9e, 0x83, 0xe0,
+	0xc8, 0xd3, 0xe0, 0xc7, 0x5e, 0xad, 0x2d, 0xe9, 0x86, 0xbe, 0x70, 0xd2, 0xfd, 0x5b, 0x83, 0xcb,
+	0x11, 0x68, 0xc8, 0xf7, 0x29, 0x4c, 0x58, 0x0d, 0xff, 0x90, 0x79, 0xf6, 0x7b, 0x91, 0x6b, 0x75,
+	0xb2, 0xe3, 0xd5, 0xf9, 0xd0, 0x3d, 0xde, 0xbe, 0xff, 0xe9, 0xc7, 0xab, 0x5b, 0x3d, 0xdb, 0x2c,
+	0xef, 0x86, 0x1b, 0x67, 0x0f, 0xc3, 0xd6, 0x0b, 0x6d, 0xce, 0x06, 0x96, 0x9d, 0xeb, 0x7f, 0x9b,
+	0x82, 0x11, 0x11, 0x1f, 0x79, 0x0f, 0x32, 0xb2, 0x0d, 0x45, 0x6e, 0xc6, 0x24, 0x4f, 0x67, 0xbf,
+	0x4b, 0x5f, 0xec, 0x25, 0x26, 0xdd, 0x19, 0xd7, 0xdf, 0xff, 0xe7, 0xff, 0x3e, 0x3a, 0x3f, 0x4d,
+	0xae, 0x99, 0x9d, 0x9d, 0x42, 0xd9, 0xea, 0x22, 0xef, 0x6b, 0x30, 0xa6, 0xd2, 0x93, 0x2c, 0x25,
+	0xd9, 0x6d, 0x6b, 0x84, 0xe9, 0xcb, 0xbd, 0x05, 0x11, 0xc2, 0x82, 0x80, 0x30, 0x4b, 0xa6, 0x63,
+	0x20, 0xf8, 0xca, 0xef, 0x6f, 0x34, 0x98, 0x88, 0xf6, 0x9c, 0xc8, 0x6a, 0x92, 0x87, 0xd8, 0x96,
+	0x97, 0x9e, 0x4f, 0x2b, 0x8e, 0xb0, 0x6e, 0x09, 0x58, 0x37, 0x88, 0x61, 0x76, 0xeb, 0xa1, 0x16,
+	0x83, 0x96, 0x17, 0x79, 0xa6, 0x41, 0x46, 0xf6, 0x34, 0x92, 0xd7, 0x27, 0xd2, 0x0e, 0xd3, 0x17,
+	0x7b, 0x89, 0x21, 0x8a, 0x0d, 0x81, 0x62, 0x8d, 0x98, 0xdd, 0x51, 0x60, 0x0b, 0xc5, 0x3c, 0xc1,
+	0xa6, 0xda, 0x29, 0xf9, 0xb9, 0x06, 0xa3, 0xd2, 0x16, 0x27, 0x3d, 0x9c, 0x35, 0x93, 0x66, 0xa9,
+	0xa7, 0x1c, 0xa2, 0x5a, 0x15, 0xa8, 0x96, 0xc8, 0xcd, 0x54, 0xa8, 0xc8, 0x6f, 0x35, 0x78, 0xb9,
+	0xad, 0x5f, 0x44, 0x12, 0x97, 0x23, 0xbe, 0x81, 0xa5, 0x9b, 0xa9, 0xe5, 0x11, 0xe3, 0x8a, 0xc0,
+	0x78, 0x93, 0x2c, 0xc4, 0x60, 0xa4, 0xa8, 0xd3, 0x44, 0xf8, 0x0b, 0x0d, 0xc6, 0xd4, 0x5b, 0x30,
+	0x39, 0xc7, 0xdb, 0xde, 0x9b, 0xfa, 0x72, 0x6f, 0x41, 0x04, 0xb3, 0x2e, 0xc0, 0x7c, 0x9b, 0xdc,
+	0x8a, 0xdb, 0x66, 0x28, 0xcc, 0xcd, 0x93, 0xd0, 0xeb, 0xf5, 0x94, 0x7c, 0xa0, 0xc1, 0x05, 0x65,
+	0x88, 0x93, 0x9e, 0xbe, 0x9a, 0x4c, 0x7d, 0x2b, 0x85, 0x24, 0xc2, 0xba, 0x21, 0x60, 0xe5, 0xc8,
+	0x4c, 0x37, 0x58, 0xe4, 0x0f, 0x1a, 0x5c, 0xea, 0xa8, 0xbb, 0xc9, 0xed, 0x24, 0x37, 0x49, 0x7d,
+	0x0f, 0x7d, 0xad, 0x0f, 0x8d, 0x14, 0x89, 0x66, 0xa1, 0x56, 0xb1, 0x85, 0xf4, 0x23, 0x0d, 0x86,
+	0x83, 0x3a, 0x89, 0x2c, 0x24, 0xb9, 0x0a, 0xd5, 0xe4, 0xfa, 0x8d, 0xee, 0x42, 0x08, 0xe1, 0x81,
+	0x80, 0xb0, 0x49, 0x5e, 0x49, 0xbf, 0x74, 0xa6, 0x28, 0x58, 0xcd, 0x93, 0xe0, 0xc7, 0x3b, 0x25,
+	0x1f, 0x6a, 0x30, 0x12, 0x98, 0xe4, 0xa4, 0xab, 0xc7, 0x26, 0x4f, 0x37, 0x7b, 0x48, 0x21, 0xb0,
+	0x57, 0x04, 0xb0, 0x75, 0x72, 0xbb, 0x5f, 0x60, 0x62, 0x3f, 0xb6, 0x15, 0xa5, 0xc9, 0xfb, 0x31,
+	0xbe, 0x4a, 0xd6, 0xcd, 0xd4, 0xf2, 0x29, 0xf6, 0x63, 0x7b, 0x19, 0x4c, 0x7e, 0xa7, 0xc1, 0x78,
+	0xa8, 0x0c, 0x24, 0xb7, 0x12, 0x6f, 0x93, 0x8e, 0xf2, 0x54, 0x5f, 0x49, 0x25, 0xfb, 0x25, 0x48,
+	0x14, 0xc5, 0x28, 0xf9, 0x93, 0x06, 0x17, 0xdb, 0xcb, 0x48, 0x92, 0xc8, 0x4a, 0x42, 0xc9, 0xaa,
+	0xdf, 0x4e, 0xaf, 0x80, 0x88, 0xb7, 0x04, 0xe2, 0x7b, 0xe4, 0x4e, 0x1f, 0x88, 0x39, 0x1a, 0x23,
+	0x7f, 0xd5, 0x60, 0x2a, 0xa1, 0xb8, 0x23, 0xdf, 0x49, 0x3c, 0xfd, 0xbb, 0x56, 0x9f, 0xfa, 0x46,
+	0xdf, 0x7a, 0x18, 0xc9, 0x3d, 0x11, 0x89, 0x49, 0x56, 0x63, 0x22, 0x69, 0xbe, 0x20, 0x2b, 0xa8,
+	0x5d, 0x6c, 0x16, 0x8c, 0x1f, 0x6a, 0x30, 0x1e, 0xaa, 0xbf, 0x92, 0x73, 0xa3, 0xb3, 0x10, 0xd4,
+	0x57, 0x52, 0xc9, 0x22, 0xbe, 0x45, 0x81, 0x6f, 0x9e, 0xe4, 0x62, 0xf0, 0x85, 0x2b, 0xb6, 0x3f,
+	0x6b, 0x70, 0x25, 0xb6, 0xec, 0x21, 0x77, 0x7b, 0x3d, 0x82, 0xe2, 0x6a, 0x32, 0xfd, 0x5e, 0x9f,
+	0x5a, 0x29, 0xee, 0x18, 0xf5, 0x8e, 0x32, 0xb1, 0x24, 0x28, 0x62, 0x21, 0xf5, 0x77, 0x0d, 0xa6,
+	0x12, 0xaa, 0x97, 0xe4, 0x7c, 0xe8, 0x5e, 0x2c, 0xe9, 0x1b, 0x7d, 0xeb, 0x61, 0x00, 0x3b, 0x22,
+	0x80, 0xef, 0x92, 0xad, 0xf4, 0x01, 0x98, 0x27, 0xa2, 0x14, 0x3b, 0x35, 0x55, 0xb9, 0x12, 0x5c,
+	0x01, 0x19, 0x59, 0x0e, 0x24, 0x3f, 0xc5, 0x22, 0x95, 0x8c, 0xbe, 0xd8, 0x4b, 0x0c, 0xe1, 0x6d,
+	0x0a, 0x78, 0x77, 0xc9, 0x7a, 0x0c, 0x3c, 0x51, 0xfb, 0x70, 0xf3, 0x44, 0xfc, 0x52, 0x7a, 0x6a,
+	0x9e, 0x84, 0x4b, 0xa0, 0xd3, 0xed, 0x27, 0x9f, 0xbc, 0xc8, 0x69, 0x9f, 0xbd, 0xc8, 0x69, 0xff,
+	0x7d, 0x91, 0xd3, 0x9e, 0x9d, 0xe5, 0xce, 0x7d, 0x76, 0x96, 0x3b, 0xf7, 0xaf, 0xb3, 0xdc, 0xb9,
+	0xb7, 0x56, 0xfb, 0xaa, 0x3c, 0x4a, 0x19, 0x51, 0xba, 0xdc, 0xf9, 0xff, 0x00, 0x32, 0x6b, 0x41,
+	0xad, 0x55, 0x20, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1323,18 +2184,39 @@ type QueryClient interface {
 	Member(ctx context.Context, in *QueryMemberRequest, opts ...grpc.CallOption) (*QueryMemberResponse, error)
 	// Members queries members of the foundation
 	Members(ctx context.Context, in *QueryMembersRequest, opts ...grpc.CallOption) (*QueryMembersResponse, error)
+	// ExpiringMembers queries the members with expiring memberships, sorted by
+	// the expiration time.
+	ExpiringMembers(ctx context.Context, in *QueryExpiringMembersRequest, opts ...grpc.CallOption) (*QueryExpiringMembersResponse, error)
 	// Proposal queries a proposal based on proposal id.
 	Proposal(ctx context.Context, in *QueryProposalRequest, opts ...grpc.CallOption) (*QueryProposalResponse, error)
 	// Proposals queries all proposals.
+	// The filters are applied while scanning all the proposals, as there is no index for them,
+	// so the cost of a filtered query is O(total proposals) regardless of the page size.
 	Proposals(ctx context.Context, in *QueryProposalsRequest, opts ...grpc.CallOption) (*QueryProposalsResponse, error)
+	// ArchivedProposals queries the finished proposals kept in the node's proposal archive.
+	// It is available only on the nodes which enabled the archive.
+	// It takes the same filters as Proposals, with the same cost of O(total archived proposals).
+	ArchivedProposals(ctx context.Context, in *QueryArchivedProposalsRequest, opts ...grpc.CallOption) (*QueryArchivedProposalsResponse, error)
 	// Vote queries a vote by proposal id and voter.
 	Vote(ctx context.Context, in *QueryVoteRequest, opts ...grpc.CallOption) (*QueryVoteResponse, error)
 	// Votes queries a vote by proposal.
 	Votes(ctx context.Context, in *QueryVotesRequest, opts ...grpc.CallOption) (*QueryVotesResponse, error)
+	// VoteDelegations queries all the vote delegations between the members.
+	VoteDelegations(ctx context.Context, in *QueryVoteDelegationsRequest, opts ...grpc.CallOption) (*QueryVoteDelegationsResponse, error)
 	// TallyResult queries the tally of a proposal votes.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// SimulateProposal simulates the execution of a proposal at the current height.
+	// The state would not be changed by the simulation.
+	SimulateProposal(ctx context.Context, in *QuerySimulateProposalRequest, opts ...grpc.CallOption) (*QuerySimulateProposalResponse, error)
+	// MsgTypeDecisionPolicies queries the decision policies per message type.
+	MsgTypeDecisionPolicies(ctx context.Context, in *QueryMsgTypeDecisionPoliciesRequest, opts ...grpc.CallOption) (*QueryMsgTypeDecisionPoliciesResponse, error)
 	// Censorships queries the censorship informations.
 	Censorships(ctx context.Context, in *QueryCensorshipsRequest, opts ...grpc.CallOption) (*QueryCensorshipsResponse, error)
+	// TreasuryOutflowLimits queries the outflow limits of the treasury.
+	TreasuryOutflowLimits(ctx context.Context, in *QueryTreasuryOutflowLimitsRequest, opts ...grpc.CallOption) (*QueryTreasuryOutflowLimitsResponse, error)
+	// TreasuryOutflowHeadroom queries the amount of a denom which could be
+	// withdrawn from the treasury under its outflow limit.
+	TreasuryOutflowHeadroom(ctx context.Context, in *QueryTreasuryOutflowHeadroomRequest, opts ...grpc.CallOption) (*QueryTreasuryOutflowHeadroomResponse, error)
 	// Returns list of authorizations, granted to the grantee.
 	Grants(ctx context.Context, in *QueryGrantsRequest, opts ...grpc.CallOption) (*QueryGrantsResponse, error)
 }
@@ -1392,6 +2274,15 @@ func (c *queryClient) Members(ctx context.Context, in *QueryMembersRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) ExpiringMembers(ctx context.Context, in *QueryExpiringMembersRequest, opts ...grpc.CallOption) (*QueryExpiringMembersResponse, error) {
+	out := new(QueryExpiringMembersResponse)
+	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Query/ExpiringMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Proposal(ctx context.Context, in *QueryProposalRequest, opts ...grpc.CallOption) (*QueryProposalResponse, error) {
 	out := new(QueryProposalResponse)
 	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Query/Proposal", in, out, opts...)
@@ -1410,6 +2301,15 @@ func (c *queryClient) Proposals(ctx context.Context, in *QueryProposalsRequest,
 	return out, nil
 }
 
+func (c *queryClient) ArchivedProposals(ctx context.Context, in *QueryArchivedProposalsRequest, opts ...grpc.CallOption) (*QueryArchivedProposalsResponse, error) {
+	out := new(QueryArchivedProposalsResponse)
+	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Query/ArchivedProposals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Vote(ctx context.Context, in *QueryVoteRequest, opts ...grpc.CallOption) (*QueryVoteResponse, error) {
 	out := new(QueryVoteResponse)
 	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Query/Vote", in, out, opts...)
@@ -1428,6 +2328,15 @@ func (c *queryClient) Votes(ctx context.Context, in *QueryVotesRequest, opts ...
 	return out, nil
 }
 
+func (c *queryClient) VoteDelegations(ctx context.Context, in *QueryVoteDelegationsRequest, opts ...grpc.CallOption) (*QueryVoteDelegationsResponse, error) {
+	out := new(QueryVoteDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Query/VoteDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error) {
 	out := new(QueryTallyResultResponse)
 	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Query/TallyResult", in, out, opts...)
@@ -1437,6 +2346,24 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) SimulateProposal(ctx context.Context, in *QuerySimulateProposalRequest, opts ...grpc.CallOption) (*QuerySimulateProposalResponse, error) {
+	out := new(QuerySimulateProposalResponse)
+	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Query/SimulateProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MsgTypeDecisionPolicies(ctx context.Context, in *QueryMsgTypeDecisionPoliciesRequest, opts ...grpc.CallOption) (*QueryMsgTypeDecisionPoliciesResponse, error) {
+	out := new(QueryMsgTypeDecisionPoliciesResponse)
+	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Query/MsgTypeDecisionPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Censorships(ctx context.Context, in *QueryCensorshipsRequest, opts ...grpc.CallOption) (*QueryCensorshipsResponse, error) {
 	out := new(QueryCensorshipsResponse)
 	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Query/Censorships", in, out, opts...)
@@ -1446,6 +2373,24 @@ func (c *queryClient) Censorships(ctx context.Context, in *QueryCensorshipsReque
 	return out, nil
 }
 
+func (c *queryClient) TreasuryOutflowLimits(ctx context.Context, in *QueryTreasuryOutflowLimitsRequest, opts ...grpc.CallOption) (*QueryTreasuryOutflowLimitsResponse, error) {
+	out := new(QueryTreasuryOutflowLimitsResponse)
+	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Query/TreasuryOutflowLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TreasuryOutflowHeadroom(ctx context.Context, in *QueryTreasuryOutflowHeadroomRequest, opts ...grpc.CallOption) (*QueryTreasuryOutflowHeadroomResponse, error) {
+	out := new(QueryTreasuryOutflowHeadroomResponse)
+	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Query/TreasuryOutflowHeadroom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Grants(ctx context.Context, in *QueryGrantsRequest, opts ...grpc.CallOption) (*QueryGrantsResponse, error) {
 	out := new(QueryGrantsResponse)
 	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Query/Grants", in, out, opts...)
@@ -1467,18 +2412,39 @@ type QueryServer interface {
 	Member(context.Context, *QueryMemberRequest) (*QueryMemberResponse, error)
 	// Members queries members of the foundation
 	Members(context.Context, *QueryMembersRequest) (*QueryMembersResponse, error)
+	// ExpiringMembers queries the members with expiring memberships, sorted by
+	// the expiration time.
+	ExpiringMembers(context.Context, *QueryExpiringMembersRequest) (*QueryExpiringMembersResponse, error)
 	// Proposal queries a proposal based on proposal id.
 	Proposal(context.Context, *QueryProposalRequest) (*QueryProposalResponse, error)
 	// Proposals queries all proposals.
+	// The filters are applied while scanning all the proposals, as there is no index for them,
+	// so the cost of a filtered query is O(total proposals) regardless of the page size.
 	Proposals(context.Context, *QueryProposalsRequest) (*QueryProposalsResponse, error)
+	// ArchivedProposals queries the finished proposals kept in the node's proposal archive.
+	// It is available only on the nodes which enabled the archive.
+	// It takes the same filters as Proposals, with the same cost of O(total archived proposals).
+	ArchivedProposals(context.Context, *QueryArchivedProposalsRequest) (*QueryArchivedProposalsResponse, error)
 	// Vote queries a vote by proposal id and voter.
 	Vote(context.Context, *QueryVoteRequest) (*QueryVoteResponse, error)
 	// Votes queries a vote by proposal.
 	Votes(context.Context, *QueryVotesRequest) (*QueryVotesResponse, error)
+	// VoteDelegations queries all the vote delegations between the members.
+	VoteDelegations(context.Context, *QueryVoteDelegationsRequest) (*QueryVoteDelegationsResponse, error)
 	// TallyResult queries the tally of a proposal votes.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// SimulateProposal simulates the execution of a proposal at the current height.
+	// The state would not be changed by the simulation.
+	SimulateProposal(context.Context, *QuerySimulateProposalRequest) (*QuerySimulateProposalResponse, error)
+	// MsgTypeDecisionPolicies queries the decision policies per message type.
+	MsgTypeDecisionPolicies(context.Context, *QueryMsgTypeDecisionPoliciesRequest) (*QueryMsgTypeDecisionPoliciesResponse, error)
 	// Censorships queries the censorship informations.
 	Censorships(context.Context, *QueryCensorshipsRequest) (*QueryCensorshipsResponse, error)
+	// TreasuryOutflowLimits queries the outflow limits of the treasury.
+	TreasuryOutflowLimits(context.Context, *QueryTreasuryOutflowLimitsRequest) (*QueryTreasuryOutflowLimitsResponse, error)
+	// TreasuryOutflowHeadroom queries the amount of a denom which could be
+	// withdrawn from the treasury under its outflow limit.
+	TreasuryOutflowHeadroom(context.Context, *QueryTreasuryOutflowHeadroomRequest) (*QueryTreasuryOutflowHeadroomResponse, error)
 	// Returns list of authorizations, granted to the grantee.
 	Grants(context.Context, *QueryGrantsRequest) (*QueryGrantsResponse, error)
 }
@@ -1502,24 +2468,45 @@ func (*UnimplementedQueryServer) Member(ctx context.Context, req *QueryMemberReq
 func (*UnimplementedQueryServer) Members(ctx context.Context, req *QueryMembersRequest) (*QueryMembersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Members not implemented")
 }
+func (*UnimplementedQueryServer) ExpiringMembers(ctx context.Context, req *QueryExpiringMembersRequest) (*QueryExpiringMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpiringMembers not implemented")
+}
 func (*UnimplementedQueryServer) Proposal(ctx context.Context, req *QueryProposalRequest) (*QueryProposalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Proposal not implemented")
 }
 func (*UnimplementedQueryServer) Proposals(ctx context.Context, req *QueryProposalsRequest) (*QueryProposalsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Proposals not implemented")
 }
+func (*UnimplementedQueryServer) ArchivedProposals(ctx context.Context, req *QueryArchivedProposalsRequest) (*QueryArchivedProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchivedProposals not implemented")
+}
 func (*UnimplementedQueryServer) Vote(ctx context.Context, req *QueryVoteRequest) (*QueryVoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
 }
 func (*UnimplementedQueryServer) Votes(ctx context.Context, req *QueryVotesRequest) (*QueryVotesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Votes not implemented")
 }
+func (*UnimplementedQueryServer) VoteDelegations(ctx context.Context, req *QueryVoteDelegationsRequest) (*QueryVoteDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteDelegations not implemented")
+}
 func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (*UnimplementedQueryServer) SimulateProposal(ctx context.Context, req *QuerySimulateProposalRequest) (*QuerySimulateProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateProposal not implemented")
+}
+func (*UnimplementedQueryServer) MsgTypeDecisionPolicies(ctx context.Context, req *QueryMsgTypeDecisionPoliciesRequest) (*QueryMsgTypeDecisionPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgTypeDecisionPolicies not implemented")
+}
 func (*UnimplementedQueryServer) Censorships(ctx context.Context, req *QueryCensorshipsRequest) (*QueryCensorshipsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Censorships not implemented")
 }
+func (*UnimplementedQueryServer) TreasuryOutflowLimits(ctx context.Context, req *QueryTreasuryOutflowLimitsRequest) (*QueryTreasuryOutflowLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TreasuryOutflowLimits not implemented")
+}
+func (*UnimplementedQueryServer) TreasuryOutflowHeadroom(ctx context.Context, req *QueryTreasuryOutflowHeadroomRequest) (*QueryTreasuryOutflowHeadroomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TreasuryOutflowHeadroom not implemented")
+}
 func (*UnimplementedQueryServer) Grants(ctx context.Context, req *QueryGrantsRequest) (*QueryGrantsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Grants not implemented")
 }
@@ -1618,6 +2605,24 @@ func _Query_Members_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ExpiringMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExpiringMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExpiringMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.foundation.v1.Query/ExpiringMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExpiringMembers(ctx, req.(*QueryExpiringMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Proposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryProposalRequest)
 	if err := dec(in); err != nil {
@@ -1654,6 +2659,24 @@ func _Query_Proposals_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ArchivedProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryArchivedProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ArchivedProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.foundation.v1.Query/ArchivedProposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ArchivedProposals(ctx, req.(*QueryArchivedProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryVoteRequest)
 	if err := dec(in); err != nil {
@@ -1690,6 +2713,24 @@ func _Query_Votes_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VoteDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VoteDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.foundation.v1.Query/VoteDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoteDelegations(ctx, req.(*QueryVoteDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTallyResultRequest)
 	if err := dec(in); err != nil {
@@ -1708,6 +2749,42 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SimulateProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.foundation.v1.Query/SimulateProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateProposal(ctx, req.(*QuerySimulateProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MsgTypeDecisionPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMsgTypeDecisionPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MsgTypeDecisionPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.foundation.v1.Query/MsgTypeDecisionPolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MsgTypeDecisionPolicies(ctx, req.(*QueryMsgTypeDecisionPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Censorships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryCensorshipsRequest)
 	if err := dec(in); err != nil {
@@ -1726,6 +2803,42 @@ func _Query_Censorships_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TreasuryOutflowLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTreasuryOutflowLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TreasuryOutflowLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.foundation.v1.Query/TreasuryOutflowLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TreasuryOutflowLimits(ctx, req.(*QueryTreasuryOutflowLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TreasuryOutflowHeadroom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTreasuryOutflowHeadroomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TreasuryOutflowHeadroom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.foundation.v1.Query/TreasuryOutflowHeadroom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TreasuryOutflowHeadroom(ctx, req.(*QueryTreasuryOutflowHeadroomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Grants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryGrantsRequest)
 	if err := dec(in); err != nil {
@@ -1768,6 +2881,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Members",
 			Handler:    _Query_Members_Handler,
 		},
+		{
+			MethodName: "ExpiringMembers",
+			Handler:    _Query_ExpiringMembers_Handler,
+		},
 		{
 			MethodName: "Proposal",
 			Handler:    _Query_Proposal_Handler,
@@ -1776,6 +2893,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Proposals",
 			Handler:    _Query_Proposals_Handler,
 		},
+		{
+			MethodName: "ArchivedProposals",
+			Handler:    _Query_ArchivedProposals_Handler,
+		},
 		{
 			MethodName: "Vote",
 			Handler:    _Query_Vote_Handler,
@@ -1784,14 +2905,34 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Votes",
 			Handler:    _Query_Votes_Handler,
 		},
+		{
+			MethodName: "VoteDelegations",
+			Handler:    _Query_VoteDelegations_Handler,
+		},
 		{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "SimulateProposal",
+			Handler:    _Query_SimulateProposal_Handler,
+		},
+		{
+			MethodName: "MsgTypeDecisionPolicies",
+			Handler:    _Query_MsgTypeDecisionPolicies_Handler,
+		},
 		{
 			MethodName: "Censorships",
 			Handler:    _Query_Censorships_Handler,
 		},
+		{
+			MethodName: "TreasuryOutflowLimits",
+			Handler:    _Query_TreasuryOutflowLimits_Handler,
+		},
+		{
+			MethodName: "TreasuryOutflowHeadroom",
+			Handler:    _Query_TreasuryOutflowHeadroom_Handler,
+		},
 		{
 			MethodName: "Grants",
 			Handler:    _Query_Grants_Handler,
@@ -2122,6 +3263,90 @@ func (m *QueryMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryExpiringMembersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExpiringMembersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExpiringMembersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExpiringMembersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExpiringMembersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExpiringMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryProposalRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2205,6 +3430,38 @@ func (m *QueryProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SubmitTimeTo != nil {
+		n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.SubmitTimeTo, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.SubmitTimeTo):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintQuery(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.SubmitTimeFrom != nil {
+		n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.SubmitTimeFrom, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.SubmitTimeFrom):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintQuery(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -2269,7 +3526,7 @@ func (m *QueryProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryVoteRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryArchivedProposalsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2279,25 +3536,141 @@ func (m *QueryVoteRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryVoteRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryArchivedProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryVoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryArchivedProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Voter) > 0 {
-		i -= len(m.Voter)
-		copy(dAtA[i:], m.Voter)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Voter)))
+	if m.SubmitTimeTo != nil {
+		n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.SubmitTimeTo, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.SubmitTimeTo):])
+		if err13 != nil {
+			return 0, err13
+		}
+		i -= n13
+		i = encodeVarintQuery(dAtA, i, uint64(n13))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x2a
 	}
-	if m.ProposalId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+	if m.SubmitTimeFrom != nil {
+		n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.SubmitTimeFrom, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.SubmitTimeFrom):])
+		if err14 != nil {
+			return 0, err14
+		}
+		i -= n14
+		i = encodeVarintQuery(dAtA, i, uint64(n14))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryArchivedProposalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryArchivedProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryArchivedProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
 		i--
 		dAtA[i] = 0x8
 	}
@@ -2428,6 +3801,90 @@ func (m *QueryVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryVoteDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VoteDelegations) > 0 {
+		for iNdEx := len(m.VoteDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryTallyResultRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2489,7 +3946,7 @@ func (m *QueryTallyResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCensorshipsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QuerySimulateProposalRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2499,32 +3956,25 @@ func (m *QueryCensorshipsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryCensorshipsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySimulateProposalRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCensorshipsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySimulateProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCensorshipsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QuerySimulateProposalResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2534,32 +3984,41 @@ func (m *QueryCensorshipsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryCensorshipsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySimulateProposalResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCensorshipsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySimulateProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1a
 		}
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Censorships) > 0 {
-		for iNdEx := len(m.Censorships) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Censorships[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2573,7 +4032,7 @@ func (m *QueryCensorshipsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGrantsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryMsgTypeDecisionPoliciesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2583,12 +4042,12 @@ func (m *QueryGrantsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGrantsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryMsgTypeDecisionPoliciesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGrantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryMsgTypeDecisionPoliciesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2603,26 +4062,12 @@ func (m *QueryGrantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.MsgTypeUrl) > 0 {
-		i -= len(m.MsgTypeUrl)
-		copy(dAtA[i:], m.MsgTypeUrl)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Grantee) > 0 {
-		i -= len(m.Grantee)
-		copy(dAtA[i:], m.Grantee)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGrantsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryMsgTypeDecisionPoliciesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2632,12 +4077,12 @@ func (m *QueryGrantsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGrantsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryMsgTypeDecisionPoliciesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryMsgTypeDecisionPoliciesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2654,10 +4099,10 @@ func (m *QueryGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Authorizations) > 0 {
-		for iNdEx := len(m.Authorizations) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Policies) > 0 {
+		for iNdEx := len(m.Policies) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Authorizations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Policies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2671,284 +4116,457 @@ func (m *QueryGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCensorshipsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryTreasuryRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryCensorshipsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTreasuryResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryCensorshipsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFoundationInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCensorshipsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryFoundationInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Info.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryCensorshipsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryMemberRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryCensorshipsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Censorships) > 0 {
+		for iNdEx := len(m.Censorships) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Censorships[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryMemberResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Member != nil {
-		l = m.Member.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryTreasuryOutflowLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryMembersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryTreasuryOutflowLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTreasuryOutflowLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryMembersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTreasuryOutflowLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTreasuryOutflowLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTreasuryOutflowLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Members) > 0 {
-		for _, e := range m.Members {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Limits) > 0 {
+		for iNdEx := len(m.Limits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Limits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovQuery(uint64(m.ProposalId))
+func (m *QueryTreasuryOutflowHeadroomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Proposal != nil {
-		l = m.Proposal.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryTreasuryOutflowHeadroomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryProposalsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryTreasuryOutflowHeadroomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryProposalsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Proposals) > 0 {
-		for _, e := range m.Proposals {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryTreasuryOutflowHeadroomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryVoteRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTreasuryOutflowHeadroomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTreasuryOutflowHeadroomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Headroom.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Outflow.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Limit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGrantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGrantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGrantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovQuery(uint64(m.ProposalId))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryVoteResponse) Size() (n int) {
+func (m *QueryGrantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGrantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authorizations) > 0 {
+		for iNdEx := len(m.Authorizations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Authorizations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Vote != nil {
-		l = m.Vote.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	return n
 }
 
-func (m *QueryVotesRequest) Size() (n int) {
+func (m *QueryParamsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovQuery(uint64(m.ProposalId))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTreasuryRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
+	var l int
+	_ = l
 	return n
 }
 
-func (m *QueryVotesResponse) Size() (n int) {
+func (m *QueryTreasuryResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Votes) > 0 {
-		for _, e := range m.Votes {
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFoundationInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
+	var l int
+	_ = l
 	return n
 }
 
-func (m *QueryTallyResultRequest) Size() (n int) {
+func (m *QueryFoundationInfoResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovQuery(uint64(m.ProposalId))
+	l = m.Info.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMemberRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryTallyResultResponse) Size() (n int) {
+func (m *QueryMemberResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Tally.Size()
-	n += 1 + l + sovQuery(uint64(l))
+	if m.Member != nil {
+		l = m.Member.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryCensorshipsRequest) Size() (n int) {
+func (m *QueryMembersRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2961,14 +4579,14 @@ func (m *QueryCensorshipsRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryCensorshipsResponse) Size() (n int) {
+func (m *QueryMembersResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Censorships) > 0 {
-		for _, e := range m.Censorships {
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -2980,20 +4598,12 @@ func (m *QueryCensorshipsResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryGrantsRequest) Size() (n int) {
+func (m *QueryExpiringMembersRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.MsgTypeUrl)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	if m.Pagination != nil {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
@@ -3001,14 +4611,14 @@ func (m *QueryGrantsRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryGrantsResponse) Size() (n int) {
+func (m *QueryExpiringMembersResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Authorizations) > 0 {
-		for _, e := range m.Authorizations {
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -3020,41 +4630,1774 @@ func (m *QueryGrantsResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
+
+func (m *QueryProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Proposal != nil {
+		l = m.Proposal.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SubmitTimeFrom != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.SubmitTimeFrom)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SubmitTimeTo != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.SubmitTimeTo)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryArchivedProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SubmitTimeFrom != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.SubmitTimeFrom)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SubmitTimeTo != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.SubmitTimeTo)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryArchivedProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Vote != nil {
+		l = m.Vote.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVotesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVoteDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVoteDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VoteDelegations) > 0 {
+		for _, e := range m.VoteDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTallyResultRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryTallyResultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Tally.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySimulateProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QuerySimulateProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMsgTypeDecisionPoliciesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMsgTypeDecisionPoliciesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Policies) > 0 {
+		for _, e := range m.Policies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCensorshipsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCensorshipsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Censorships) > 0 {
+		for _, e := range m.Censorships {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTreasuryOutflowLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTreasuryOutflowLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Limits) > 0 {
+		for _, e := range m.Limits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTreasuryOutflowHeadroomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTreasuryOutflowHeadroomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Limit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Outflow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Headroom.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGrantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGrantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Authorizations) > 0 {
+		for _, e := range m.Authorizations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTreasuryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTreasuryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTreasuryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTreasuryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTreasuryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTreasuryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.DecCoin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFoundationInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFoundationInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFoundationInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFoundationInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFoundationInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFoundationInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMemberRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMemberRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMemberRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMemberResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMemberResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Member == nil {
+				m.Member = &Member{}
+			}
+			if err := m.Member.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMembersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMembersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMembersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMembersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, Member{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExpiringMembersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExpiringMembersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExpiringMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExpiringMembersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExpiringMembersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExpiringMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, Member{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proposal == nil {
+				m.Proposal = &Proposal{}
+			}
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ProposalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTimeFrom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubmitTimeFrom == nil {
+				m.SubmitTimeFrom = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.SubmitTimeFrom, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTimeTo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.SubmitTimeTo == nil {
+				m.SubmitTimeTo = new(time.Time)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.SubmitTimeTo, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3076,7 +6419,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3099,15 +6442,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3134,7 +6477,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Proposals = append(m.Proposals, Proposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3159,7 +6539,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTreasuryRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryArchivedProposalsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3182,12 +6562,171 @@ func (m *QueryTreasuryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTreasuryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryArchivedProposalsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTreasuryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryArchivedProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ProposalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTimeFrom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubmitTimeFrom == nil {
+				m.SubmitTimeFrom = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.SubmitTimeFrom, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTimeTo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubmitTimeTo == nil {
+				m.SubmitTimeTo = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.SubmitTimeTo, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3209,7 +6748,7 @@ func (m *QueryTreasuryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTreasuryResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryArchivedProposalsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3232,15 +6771,49 @@ func (m *QueryTreasuryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTreasuryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryArchivedProposalsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTreasuryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryArchivedProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, Proposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3267,8 +6840,10 @@ func (m *QueryTreasuryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.DecCoin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3293,7 +6868,7 @@ func (m *QueryTreasuryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFoundationInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3316,12 +6891,63 @@ func (m *QueryFoundationInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFoundationInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVoteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFoundationInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3343,7 +6969,7 @@ func (m *QueryFoundationInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFoundationInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3366,15 +6992,15 @@ func (m *QueryFoundationInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFoundationInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFoundationInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3401,7 +7027,10 @@ func (m *QueryFoundationInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Vote == nil {
+				m.Vote = &Vote{}
+			}
+			if err := m.Vote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3426,7 +7055,7 @@ func (m *QueryFoundationInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMemberRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVotesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3449,17 +7078,36 @@ func (m *QueryMemberRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMemberRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVotesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMemberRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3469,23 +7117,27 @@ func (m *QueryMemberRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3508,7 +7160,7 @@ func (m *QueryMemberRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMemberResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVotesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3531,15 +7183,15 @@ func (m *QueryMemberResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMemberResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVotesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3566,10 +7218,44 @@ func (m *QueryMemberResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Member == nil {
-				m.Member = &Member{}
+			m.Votes = append(m.Votes, Vote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Member.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3594,7 +7280,7 @@ func (m *QueryMemberResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMembersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVoteDelegationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3617,10 +7303,10 @@ func (m *QueryMembersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMembersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVoteDelegationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVoteDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3680,7 +7366,7 @@ func (m *QueryMembersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMembersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVoteDelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3703,15 +7389,15 @@ func (m *QueryMembersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMembersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVoteDelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVoteDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteDelegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3738,8 +7424,8 @@ func (m *QueryMembersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Members = append(m.Members, Member{})
-			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.VoteDelegations = append(m.VoteDelegations, VoteDelegation{})
+			if err := m.VoteDelegations[len(m.VoteDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3800,7 +7486,7 @@ func (m *QueryMembersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTallyResultRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3823,10 +7509,10 @@ func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTallyResultRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTallyResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3869,7 +7555,7 @@ func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3892,15 +7578,15 @@ func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTallyResultResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTallyResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tally", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3927,10 +7613,7 @@ func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Proposal == nil {
-				m.Proposal = &Proposal{}
-			}
-			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Tally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3955,7 +7638,7 @@ func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3978,17 +7661,17 @@ func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var msglen int
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3998,28 +7681,11 @@ func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4041,7 +7707,7 @@ func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4064,15 +7730,15 @@ func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4099,14 +7765,46 @@ func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposals = append(m.Proposals, Proposal{})
-			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Results = append(m.Results, types.Result{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4133,10 +7831,8 @@ func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Events = append(m.Events, types1.Event{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4161,7 +7857,7 @@ func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMsgTypeDecisionPoliciesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4184,36 +7880,17 @@ func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVoteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMsgTypeDecisionPoliciesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMsgTypeDecisionPoliciesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
-			}
-			m.ProposalId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4223,23 +7900,27 @@ func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4262,7 +7943,7 @@ func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMsgTypeDecisionPoliciesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4285,15 +7966,15 @@ func (m *QueryVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMsgTypeDecisionPoliciesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMsgTypeDecisionPoliciesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4320,10 +8001,44 @@ func (m *QueryVoteResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Vote == nil {
-				m.Vote = &Vote{}
+			m.Policies = append(m.Policies, MsgTypeDecisionPolicy{})
+			if err := m.Policies[len(m.Policies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Vote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4348,7 +8063,7 @@ func (m *QueryVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVotesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCensorshipsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4371,32 +8086,13 @@ func (m *QueryVotesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCensorshipsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCensorshipsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
-			}
-			m.ProposalId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -4453,7 +8149,7 @@ func (m *QueryVotesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVotesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCensorshipsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4476,15 +8172,15 @@ func (m *QueryVotesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCensorshipsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCensorshipsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Censorships", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4511,8 +8207,8 @@ func (m *QueryVotesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Votes = append(m.Votes, Vote{})
-			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Censorships = append(m.Censorships, Censorship{})
+			if err := m.Censorships[len(m.Censorships)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4573,7 +8269,7 @@ func (m *QueryVotesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTallyResultRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTreasuryOutflowLimitsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4596,17 +8292,17 @@ func (m *QueryTallyResultRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTallyResultRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTreasuryOutflowLimitsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTallyResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTreasuryOutflowLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.ProposalId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4616,11 +8312,28 @@ func (m *QueryTallyResultRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4642,7 +8355,7 @@ func (m *QueryTallyResultRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTreasuryOutflowLimitsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4665,15 +8378,15 @@ func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTallyResultResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTreasuryOutflowLimitsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTallyResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTreasuryOutflowLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tally", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4700,7 +8413,44 @@ func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Tally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Limits = append(m.Limits, TreasuryOutflowLimit{})
+			if err := m.Limits[len(m.Limits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4725,7 +8475,7 @@ func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCensorshipsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTreasuryOutflowHeadroomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4748,17 +8498,17 @@ func (m *QueryCensorshipsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCensorshipsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTreasuryOutflowHeadroomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCensorshipsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTreasuryOutflowHeadroomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4768,27 +8518,23 @@ func (m *QueryCensorshipsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4811,7 +8557,7 @@ func (m *QueryCensorshipsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCensorshipsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTreasuryOutflowHeadroomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4834,15 +8580,15 @@ func (m *QueryCensorshipsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCensorshipsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTreasuryOutflowHeadroomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCensorshipsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTreasuryOutflowHeadroomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Censorships", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4869,14 +8615,13 @@ func (m *QueryCensorshipsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Censorships = append(m.Censorships, Censorship{})
-			if err := m.Censorships[len(m.Censorships)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Outflow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4903,10 +8648,40 @@ func (m *QueryCensorshipsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if err := m.Outflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headroom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Headroom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5139,7 +8914,7 @@ func (m *QueryGrantsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authorizations = append(m.Authorizations, &types1.Any{})
+			m.Authorizations = append(m.Authorizations, &types2.Any{})
 			if err := m.Authorizations[len(m.Authorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
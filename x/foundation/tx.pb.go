@@ -12,19 +12,23 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -457,6 +461,89 @@ func (m *MsgUpdateDecisionPolicyResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateDecisionPolicyResponse proto.InternalMessageInfo
 
+// MsgUpdateMsgTypeDecisionPolicy is the Msg/UpdateMsgTypeDecisionPolicy request type.
+type MsgUpdateMsgTypeDecisionPolicy struct {
+	// authority is the address of the privileged account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// msg_type_url is the type url of the message.
+	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// decision_policy is the updated decision policy of the message type.
+	// If it is empty, the foundation's decision policy would be applied to the message type.
+	DecisionPolicy *types1.Any `protobuf:"bytes,3,opt,name=decision_policy,json=decisionPolicy,proto3" json:"decision_policy,omitempty"`
+}
+
+func (m *MsgUpdateMsgTypeDecisionPolicy) Reset()         { *m = MsgUpdateMsgTypeDecisionPolicy{} }
+func (m *MsgUpdateMsgTypeDecisionPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMsgTypeDecisionPolicy) ProtoMessage()    {}
+func (*MsgUpdateMsgTypeDecisionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec2105611cae3ff, []int{10}
+}
+func (m *MsgUpdateMsgTypeDecisionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateMsgTypeDecisionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMsgTypeDecisionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateMsgTypeDecisionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMsgTypeDecisionPolicy.Merge(m, src)
+}
+func (m *MsgUpdateMsgTypeDecisionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateMsgTypeDecisionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMsgTypeDecisionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateMsgTypeDecisionPolicy proto.InternalMessageInfo
+
+// MsgUpdateMsgTypeDecisionPolicyResponse is the Msg/UpdateMsgTypeDecisionPolicy response type.
+type MsgUpdateMsgTypeDecisionPolicyResponse struct {
+}
+
+func (m *MsgUpdateMsgTypeDecisionPolicyResponse) Reset() {
+	*m = MsgUpdateMsgTypeDecisionPolicyResponse{}
+}
+func (m *MsgUpdateMsgTypeDecisionPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMsgTypeDecisionPolicyResponse) ProtoMessage()    {}
+func (*MsgUpdateMsgTypeDecisionPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec2105611cae3ff, []int{11}
+}
+func (m *MsgUpdateMsgTypeDecisionPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateMsgTypeDecisionPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMsgTypeDecisionPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateMsgTypeDecisionPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMsgTypeDecisionPolicyResponse.Merge(m, src)
+}
+func (m *MsgUpdateMsgTypeDecisionPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateMsgTypeDecisionPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMsgTypeDecisionPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateMsgTypeDecisionPolicyResponse proto.InternalMessageInfo
+
 // MsgSubmitProposal is the Msg/SubmitProposal request type.
 type MsgSubmitProposal struct {
 	// proposers are the account addresses of the proposers.
@@ -470,13 +557,23 @@ type MsgSubmitProposal struct {
 	// whether it should be executed immediately on creation or not.
 	// If so, proposers signatures are considered as Yes votes.
 	Exec Exec `protobuf:"varint,4,opt,name=exec,proto3,enum=lbm.foundation.v1.Exec" json:"exec,omitempty"`
+	// execute_after is an optional timestamp before which the proposal cannot be executed.
+	// If it is set, the proposal will be executed automatically once it has been accepted
+	// and the time has come. It cannot be used together with EXEC_TRY.
+	// It must not be earlier than the end of the min execution period of the decision policies.
+	ExecuteAfter *time.Time `protobuf:"bytes,5,opt,name=execute_after,json=executeAfter,proto3,stdtime" json:"execute_after,omitempty"`
+	// execute_after_height is an optional block height before which the proposal cannot be executed.
+	// It is the block height variant of execute_after, so they cannot be used together.
+	// It must be in the future, and reachable before the proposal expires if the blocks are
+	// produced at the fastest pace (i.e. every MinBlockTime of the keeper config).
+	ExecuteAfterHeight uint64 `protobuf:"varint,6,opt,name=execute_after_height,json=executeAfterHeight,proto3" json:"execute_after_height,omitempty"`
 }
 
 func (m *MsgSubmitProposal) Reset()         { *m = MsgSubmitProposal{} }
 func (m *MsgSubmitProposal) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitProposal) ProtoMessage()    {}
 func (*MsgSubmitProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{10}
+	return fileDescriptor_5ec2105611cae3ff, []int{12}
 }
 func (m *MsgSubmitProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -515,7 +612,7 @@ func (m *MsgSubmitProposalResponse) Reset()         { *m = MsgSubmitProposalResp
 func (m *MsgSubmitProposalResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitProposalResponse) ProtoMessage()    {}
 func (*MsgSubmitProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{11}
+	return fileDescriptor_5ec2105611cae3ff, []int{13}
 }
 func (m *MsgSubmitProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -556,7 +653,7 @@ func (m *MsgWithdrawProposal) Reset()         { *m = MsgWithdrawProposal{} }
 func (m *MsgWithdrawProposal) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawProposal) ProtoMessage()    {}
 func (*MsgWithdrawProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{12}
+	return fileDescriptor_5ec2105611cae3ff, []int{14}
 }
 func (m *MsgWithdrawProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -593,7 +690,7 @@ func (m *MsgWithdrawProposalResponse) Reset()         { *m = MsgWithdrawProposal
 func (m *MsgWithdrawProposalResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawProposalResponse) ProtoMessage()    {}
 func (*MsgWithdrawProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{13}
+	return fileDescriptor_5ec2105611cae3ff, []int{15}
 }
 func (m *MsgWithdrawProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -641,7 +738,7 @@ func (m *MsgVote) Reset()         { *m = MsgVote{} }
 func (m *MsgVote) String() string { return proto.CompactTextString(m) }
 func (*MsgVote) ProtoMessage()    {}
 func (*MsgVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{14}
+	return fileDescriptor_5ec2105611cae3ff, []int{16}
 }
 func (m *MsgVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -678,7 +775,7 @@ func (m *MsgVoteResponse) Reset()         { *m = MsgVoteResponse{} }
 func (m *MsgVoteResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgVoteResponse) ProtoMessage()    {}
 func (*MsgVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{15}
+	return fileDescriptor_5ec2105611cae3ff, []int{17}
 }
 func (m *MsgVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -707,6 +804,160 @@ func (m *MsgVoteResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgVoteResponse proto.InternalMessageInfo
 
+// MsgDelegateVote is the Msg/DelegateVote request type.
+type MsgDelegateVote struct {
+	// delegator is the account address of the member delegating its voting power.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// delegate is the account address of the member voting on behalf of the delegator.
+	Delegate string `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate,omitempty"`
+}
+
+func (m *MsgDelegateVote) Reset()         { *m = MsgDelegateVote{} }
+func (m *MsgDelegateVote) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateVote) ProtoMessage()    {}
+func (*MsgDelegateVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec2105611cae3ff, []int{18}
+}
+func (m *MsgDelegateVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateVote.Merge(m, src)
+}
+func (m *MsgDelegateVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateVote proto.InternalMessageInfo
+
+// MsgDelegateVoteResponse is the Msg/DelegateVote response type.
+type MsgDelegateVoteResponse struct {
+}
+
+func (m *MsgDelegateVoteResponse) Reset()         { *m = MsgDelegateVoteResponse{} }
+func (m *MsgDelegateVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateVoteResponse) ProtoMessage()    {}
+func (*MsgDelegateVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec2105611cae3ff, []int{19}
+}
+func (m *MsgDelegateVoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateVoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateVoteResponse.Merge(m, src)
+}
+func (m *MsgDelegateVoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateVoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateVoteResponse proto.InternalMessageInfo
+
+// MsgUndelegateVote is the Msg/UndelegateVote request type.
+type MsgUndelegateVote struct {
+	// delegator is the account address of the member removing its delegation.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+}
+
+func (m *MsgUndelegateVote) Reset()         { *m = MsgUndelegateVote{} }
+func (m *MsgUndelegateVote) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateVote) ProtoMessage()    {}
+func (*MsgUndelegateVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec2105611cae3ff, []int{20}
+}
+func (m *MsgUndelegateVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUndelegateVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateVote.Merge(m, src)
+}
+func (m *MsgUndelegateVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUndelegateVote proto.InternalMessageInfo
+
+// MsgUndelegateVoteResponse is the Msg/UndelegateVote response type.
+type MsgUndelegateVoteResponse struct {
+}
+
+func (m *MsgUndelegateVoteResponse) Reset()         { *m = MsgUndelegateVoteResponse{} }
+func (m *MsgUndelegateVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateVoteResponse) ProtoMessage()    {}
+func (*MsgUndelegateVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec2105611cae3ff, []int{21}
+}
+func (m *MsgUndelegateVoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateVoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUndelegateVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateVoteResponse.Merge(m, src)
+}
+func (m *MsgUndelegateVoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateVoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUndelegateVoteResponse proto.InternalMessageInfo
+
 // MsgExec is the Msg/Exec request type.
 type MsgExec struct {
 	// proposal is the unique ID of the proposal.
@@ -719,7 +970,7 @@ func (m *MsgExec) Reset()         { *m = MsgExec{} }
 func (m *MsgExec) String() string { return proto.CompactTextString(m) }
 func (*MsgExec) ProtoMessage()    {}
 func (*MsgExec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{16}
+	return fileDescriptor_5ec2105611cae3ff, []int{22}
 }
 func (m *MsgExec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -756,7 +1007,7 @@ func (m *MsgExecResponse) Reset()         { *m = MsgExecResponse{} }
 func (m *MsgExecResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExecResponse) ProtoMessage()    {}
 func (*MsgExecResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{17}
+	return fileDescriptor_5ec2105611cae3ff, []int{23}
 }
 func (m *MsgExecResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -795,7 +1046,7 @@ func (m *MsgLeaveFoundation) Reset()         { *m = MsgLeaveFoundation{} }
 func (m *MsgLeaveFoundation) String() string { return proto.CompactTextString(m) }
 func (*MsgLeaveFoundation) ProtoMessage()    {}
 func (*MsgLeaveFoundation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{18}
+	return fileDescriptor_5ec2105611cae3ff, []int{24}
 }
 func (m *MsgLeaveFoundation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -832,7 +1083,7 @@ func (m *MsgLeaveFoundationResponse) Reset()         { *m = MsgLeaveFoundationRe
 func (m *MsgLeaveFoundationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLeaveFoundationResponse) ProtoMessage()    {}
 func (*MsgLeaveFoundationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{19}
+	return fileDescriptor_5ec2105611cae3ff, []int{25}
 }
 func (m *MsgLeaveFoundationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -873,7 +1124,7 @@ func (m *MsgUpdateCensorship) Reset()         { *m = MsgUpdateCensorship{} }
 func (m *MsgUpdateCensorship) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateCensorship) ProtoMessage()    {}
 func (*MsgUpdateCensorship) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{20}
+	return fileDescriptor_5ec2105611cae3ff, []int{26}
 }
 func (m *MsgUpdateCensorship) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -910,7 +1161,7 @@ func (m *MsgUpdateCensorshipResponse) Reset()         { *m = MsgUpdateCensorship
 func (m *MsgUpdateCensorshipResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateCensorshipResponse) ProtoMessage()    {}
 func (*MsgUpdateCensorshipResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{21}
+	return fileDescriptor_5ec2105611cae3ff, []int{27}
 }
 func (m *MsgUpdateCensorshipResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -952,7 +1203,7 @@ func (m *MsgGrant) Reset()         { *m = MsgGrant{} }
 func (m *MsgGrant) String() string { return proto.CompactTextString(m) }
 func (*MsgGrant) ProtoMessage()    {}
 func (*MsgGrant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{22}
+	return fileDescriptor_5ec2105611cae3ff, []int{28}
 }
 func (m *MsgGrant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -989,7 +1240,7 @@ func (m *MsgGrantResponse) Reset()         { *m = MsgGrantResponse{} }
 func (m *MsgGrantResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgGrantResponse) ProtoMessage()    {}
 func (*MsgGrantResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{23}
+	return fileDescriptor_5ec2105611cae3ff, []int{29}
 }
 func (m *MsgGrantResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1030,7 +1281,7 @@ func (m *MsgRevoke) Reset()         { *m = MsgRevoke{} }
 func (m *MsgRevoke) String() string { return proto.CompactTextString(m) }
 func (*MsgRevoke) ProtoMessage()    {}
 func (*MsgRevoke) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{24}
+	return fileDescriptor_5ec2105611cae3ff, []int{30}
 }
 func (m *MsgRevoke) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1067,7 +1318,7 @@ func (m *MsgRevokeResponse) Reset()         { *m = MsgRevokeResponse{} }
 func (m *MsgRevokeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRevokeResponse) ProtoMessage()    {}
 func (*MsgRevokeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{25}
+	return fileDescriptor_5ec2105611cae3ff, []int{31}
 }
 func (m *MsgRevokeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1096,6 +1347,166 @@ func (m *MsgRevokeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRevokeResponse proto.InternalMessageInfo
 
+// MsgExecAsAuthority is the Msg/ExecAsAuthority request type.
+type MsgExecAsAuthority struct {
+	// group_policy_address is the address of the group policy account
+	// which the foundation has been outsourced to.
+	GroupPolicyAddress string `protobuf:"bytes,1,opt,name=group_policy_address,json=groupPolicyAddress,proto3" json:"group_policy_address,omitempty"`
+	// messages is a list of `sdk.Msg`s to be executed with the authority of the foundation.
+	Messages []*types1.Any `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (m *MsgExecAsAuthority) Reset()         { *m = MsgExecAsAuthority{} }
+func (m *MsgExecAsAuthority) String() string { return proto.CompactTextString(m) }
+func (*MsgExecAsAuthority) ProtoMessage()    {}
+func (*MsgExecAsAuthority) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec2105611cae3ff, []int{32}
+}
+func (m *MsgExecAsAuthority) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecAsAuthority) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecAsAuthority.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExecAsAuthority) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecAsAuthority.Merge(m, src)
+}
+func (m *MsgExecAsAuthority) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecAsAuthority) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecAsAuthority.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExecAsAuthority proto.InternalMessageInfo
+
+// MsgExecAsAuthorityResponse is the Msg/ExecAsAuthority response type.
+type MsgExecAsAuthorityResponse struct {
+}
+
+func (m *MsgExecAsAuthorityResponse) Reset()         { *m = MsgExecAsAuthorityResponse{} }
+func (m *MsgExecAsAuthorityResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExecAsAuthorityResponse) ProtoMessage()    {}
+func (*MsgExecAsAuthorityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec2105611cae3ff, []int{33}
+}
+func (m *MsgExecAsAuthorityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecAsAuthorityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecAsAuthorityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExecAsAuthorityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecAsAuthorityResponse.Merge(m, src)
+}
+func (m *MsgExecAsAuthorityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecAsAuthorityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecAsAuthorityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExecAsAuthorityResponse proto.InternalMessageInfo
+
+// MsgUpdateTreasuryOutflowLimit is the Msg/UpdateTreasuryOutflowLimit request type.
+type MsgUpdateTreasuryOutflowLimit struct {
+	// authority is the address of x/gov.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// limit is the new outflow limit of the denom.
+	Limit TreasuryOutflowLimit `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit"`
+	// remove is true if the limit of the denom should be removed.
+	// Only the denom of the limit is used in this case.
+	Remove bool `protobuf:"varint,3,opt,name=remove,proto3" json:"remove,omitempty"`
+}
+
+func (m *MsgUpdateTreasuryOutflowLimit) Reset()         { *m = MsgUpdateTreasuryOutflowLimit{} }
+func (m *MsgUpdateTreasuryOutflowLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTreasuryOutflowLimit) ProtoMessage()    {}
+func (*MsgUpdateTreasuryOutflowLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec2105611cae3ff, []int{34}
+}
+func (m *MsgUpdateTreasuryOutflowLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateTreasuryOutflowLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateTreasuryOutflowLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateTreasuryOutflowLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTreasuryOutflowLimit.Merge(m, src)
+}
+func (m *MsgUpdateTreasuryOutflowLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateTreasuryOutflowLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTreasuryOutflowLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateTreasuryOutflowLimit proto.InternalMessageInfo
+
+// MsgUpdateTreasuryOutflowLimitResponse is the Msg/UpdateTreasuryOutflowLimit response type.
+type MsgUpdateTreasuryOutflowLimitResponse struct {
+}
+
+func (m *MsgUpdateTreasuryOutflowLimitResponse) Reset()         { *m = MsgUpdateTreasuryOutflowLimitResponse{} }
+func (m *MsgUpdateTreasuryOutflowLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTreasuryOutflowLimitResponse) ProtoMessage()    {}
+func (*MsgUpdateTreasuryOutflowLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec2105611cae3ff, []int{35}
+}
+func (m *MsgUpdateTreasuryOutflowLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateTreasuryOutflowLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateTreasuryOutflowLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateTreasuryOutflowLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTreasuryOutflowLimitResponse.Merge(m, src)
+}
+func (m *MsgUpdateTreasuryOutflowLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateTreasuryOutflowLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTreasuryOutflowLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateTreasuryOutflowLimitResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("lbm.foundation.v1.Exec", Exec_name, Exec_value)
 	proto.RegisterType((*MsgFundTreasury)(nil), "lbm.foundation.v1.MsgFundTreasury")
@@ -1108,12 +1519,18 @@ func init() {
 	proto.RegisterType((*MsgUpdateMembersResponse)(nil), "lbm.foundation.v1.MsgUpdateMembersResponse")
 	proto.RegisterType((*MsgUpdateDecisionPolicy)(nil), "lbm.foundation.v1.MsgUpdateDecisionPolicy")
 	proto.RegisterType((*MsgUpdateDecisionPolicyResponse)(nil), "lbm.foundation.v1.MsgUpdateDecisionPolicyResponse")
+	proto.RegisterType((*MsgUpdateMsgTypeDecisionPolicy)(nil), "lbm.foundation.v1.MsgUpdateMsgTypeDecisionPolicy")
+	proto.RegisterType((*MsgUpdateMsgTypeDecisionPolicyResponse)(nil), "lbm.foundation.v1.MsgUpdateMsgTypeDecisionPolicyResponse")
 	proto.RegisterType((*MsgSubmitProposal)(nil), "lbm.foundation.v1.MsgSubmitProposal")
 	proto.RegisterType((*MsgSubmitProposalResponse)(nil), "lbm.foundation.v1.MsgSubmitProposalResponse")
 	proto.RegisterType((*MsgWithdrawProposal)(nil), "lbm.foundation.v1.MsgWithdrawProposal")
 	proto.RegisterType((*MsgWithdrawProposalResponse)(nil), "lbm.foundation.v1.MsgWithdrawProposalResponse")
 	proto.RegisterType((*MsgVote)(nil), "lbm.foundation.v1.MsgVote")
 	proto.RegisterType((*MsgVoteResponse)(nil), "lbm.foundation.v1.MsgVoteResponse")
+	proto.RegisterType((*MsgDelegateVote)(nil), "lbm.foundation.v1.MsgDelegateVote")
+	proto.RegisterType((*MsgDelegateVoteResponse)(nil), "lbm.foundation.v1.MsgDelegateVoteResponse")
+	proto.RegisterType((*MsgUndelegateVote)(nil), "lbm.foundation.v1.MsgUndelegateVote")
+	proto.RegisterType((*MsgUndelegateVoteResponse)(nil), "lbm.foundation.v1.MsgUndelegateVoteResponse")
 	proto.RegisterType((*MsgExec)(nil), "lbm.foundation.v1.MsgExec")
 	proto.RegisterType((*MsgExecResponse)(nil), "lbm.foundation.v1.MsgExecResponse")
 	proto.RegisterType((*MsgLeaveFoundation)(nil), "lbm.foundation.v1.MsgLeaveFoundation")
@@ -1124,84 +1541,109 @@ func init() {
 	proto.RegisterType((*MsgGrantResponse)(nil), "lbm.foundation.v1.MsgGrantResponse")
 	proto.RegisterType((*MsgRevoke)(nil), "lbm.foundation.v1.MsgRevoke")
 	proto.RegisterType((*MsgRevokeResponse)(nil), "lbm.foundation.v1.MsgRevokeResponse")
+	proto.RegisterType((*MsgExecAsAuthority)(nil), "lbm.foundation.v1.MsgExecAsAuthority")
+	proto.RegisterType((*MsgExecAsAuthorityResponse)(nil), "lbm.foundation.v1.MsgExecAsAuthorityResponse")
+	proto.RegisterType((*MsgUpdateTreasuryOutflowLimit)(nil), "lbm.foundation.v1.MsgUpdateTreasuryOutflowLimit")
+	proto.RegisterType((*MsgUpdateTreasuryOutflowLimitResponse)(nil), "lbm.foundation.v1.MsgUpdateTreasuryOutflowLimitResponse")
 }
 
 func init() { proto.RegisterFile("lbm/foundation/v1/tx.proto", fileDescriptor_5ec2105611cae3ff) }
 
 var fileDescriptor_5ec2105611cae3ff = []byte{
-	// 1148 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4d, 0x6f, 0xdb, 0x46,
-	0x13, 0x16, 0x6d, 0x45, 0xb1, 0x27, 0xb6, 0x6c, 0x33, 0xc6, 0x1b, 0x99, 0x49, 0x64, 0xbd, 0x4c,
-	0x1a, 0x18, 0x4e, 0x4c, 0xd6, 0x6e, 0x8b, 0x1e, 0x5a, 0xa0, 0x88, 0x1d, 0xab, 0x30, 0x10, 0x35,
-	0x2e, 0x63, 0xf7, 0xeb, 0x50, 0x61, 0x25, 0xae, 0x29, 0x36, 0x22, 0x97, 0xe5, 0x92, 0xaa, 0xd5,
-	0x1e, 0x7a, 0x2d, 0x7a, 0x28, 0xfa, 0x33, 0x8a, 0xa2, 0x87, 0x1e, 0x72, 0x6f, 0x8f, 0x46, 0x4e,
-	0x39, 0xf6, 0xd4, 0x0f, 0xfb, 0x8f, 0x14, 0x5c, 0x2e, 0xd7, 0xa4, 0x44, 0x7d, 0xb4, 0x40, 0x6f,
-	0xda, 0x9d, 0x67, 0x9e, 0x79, 0x66, 0x76, 0x38, 0x23, 0x50, 0xba, 0x2d, 0x47, 0x3f, 0x21, 0xa1,
-	0x6b, 0xa2, 0xc0, 0x26, 0xae, 0xde, 0xdb, 0xd6, 0x83, 0x53, 0xcd, 0xf3, 0x49, 0x40, 0xe4, 0x95,
-	0x6e, 0xcb, 0xd1, 0x2e, 0x6d, 0x5a, 0x6f, 0x5b, 0x59, 0xb5, 0x88, 0x45, 0x98, 0x55, 0x8f, 0x7e,
-	0xc5, 0x40, 0x45, 0x1d, 0x26, 0x49, 0xb9, 0xc5, 0x98, 0x6a, 0x9b, 0x50, 0x87, 0x50, 0xbd, 0x85,
-	0x28, 0xd6, 0x7b, 0xdb, 0x2d, 0x1c, 0xa0, 0x6d, 0xbd, 0x4d, 0xec, 0xc4, 0xbe, 0x66, 0x11, 0x62,
-	0x75, 0xb1, 0xce, 0x4e, 0xad, 0xf0, 0x44, 0x47, 0x6e, 0x3f, 0x31, 0xc5, 0xae, 0xcd, 0x38, 0x6e,
-	0x7c, 0x88, 0x4d, 0xea, 0x77, 0x12, 0x2c, 0x35, 0xa8, 0x55, 0x0f, 0x5d, 0xf3, 0xc8, 0xc7, 0x88,
-	0x86, 0x7e, 0x5f, 0x96, 0xa1, 0x78, 0xe2, 0x13, 0xa7, 0x22, 0xd5, 0xa4, 0x8d, 0x79, 0x83, 0xfd,
-	0x96, 0x2d, 0x28, 0x21, 0x87, 0x84, 0x6e, 0x50, 0x99, 0xa9, 0xcd, 0x6e, 0x5c, 0xdb, 0x59, 0xd3,
-	0x38, 0x4d, 0x24, 0x47, 0xe3, 0x72, 0xb4, 0x3d, 0x62, 0xbb, 0xbb, 0xaf, 0x9f, 0xfd, 0xbe, 0x5e,
-	0xf8, 0xf1, 0x8f, 0xf5, 0x07, 0x96, 0x1d, 0x74, 0xc2, 0x96, 0xd6, 0x26, 0x8e, 0x5e, 0xb7, 0x5d,
-	0xda, 0xee, 0xd8, 0x48, 0x3f, 0xe1, 0x3f, 0xb6, 0xa8, 0xf9, 0x4c, 0x0f, 0xfa, 0x1e, 0xa6, 0xcc,
-	0x89, 0x1a, 0x9c, 0x5e, 0x5d, 0x83, 0x1b, 0x03, 0x7a, 0x0c, 0x4c, 0x3d, 0xe2, 0x52, 0xac, 0xfe,
-	0x2c, 0x31, 0xdb, 0x87, 0x76, 0xd0, 0x31, 0x7d, 0xf4, 0x45, 0xdd, 0x27, 0x8e, 0xd0, 0x7c, 0x0b,
-	0xe6, 0x51, 0x18, 0x74, 0x88, 0x6f, 0x07, 0x7d, 0x2e, 0xfc, 0xf2, 0x42, 0x2e, 0xc3, 0x4c, 0x40,
-	0x2a, 0x33, 0xec, 0x7a, 0x26, 0x20, 0xa9, 0x6c, 0x66, 0xff, 0xdb, 0x6c, 0x3a, 0xac, 0xba, 0xc7,
-	0x9e, 0x89, 0x02, 0x7c, 0x88, 0x7c, 0xe4, 0xd0, 0x09, 0x4a, 0xdf, 0x84, 0x92, 0xc7, 0x70, 0x4c,
-	0x6d, 0xa4, 0x6c, 0xa8, 0x87, 0xb4, 0x98, 0x68, 0xb7, 0x18, 0x29, 0x33, 0x38, 0x9c, 0xd7, 0x2d,
-	0x1d, 0x49, 0xd4, 0xed, 0xff, 0xb0, 0x3e, 0xa2, 0x6c, 0x02, 0xf2, 0x35, 0x2c, 0x0b, 0xef, 0x06,
-	0x76, 0x5a, 0xd8, 0x9f, 0x24, 0xb4, 0x01, 0x65, 0x87, 0x01, 0x9b, 0x21, 0xf3, 0xa2, 0xbc, 0x31,
-	0x6a, 0x39, 0x82, 0x63, 0x46, 0x03, 0x7f, 0x1e, 0x62, 0x1a, 0x70, 0xdd, 0x8b, 0xb1, 0x77, 0x1c,
-	0x92, 0xaa, 0x0a, 0x54, 0x06, 0x05, 0x08, 0x71, 0xdf, 0x4a, 0xa9, 0xdc, 0x1e, 0xe1, 0xb6, 0x4d,
-	0x6d, 0xe2, 0x1e, 0x92, 0xae, 0xdd, 0x9e, 0xf4, 0xee, 0xef, 0xc3, 0x92, 0xc9, 0xf1, 0x4d, 0x8f,
-	0x39, 0xf0, 0xb2, 0xae, 0x6a, 0xf1, 0xd7, 0xa2, 0x25, 0x5f, 0x8b, 0xf6, 0xd0, 0xed, 0xef, 0xca,
-	0x2f, 0x9e, 0x6f, 0x95, 0xb3, 0x01, 0x8c, 0xb2, 0x99, 0x39, 0xf3, 0x62, 0xe6, 0x69, 0x11, 0x7a,
-	0x7f, 0x92, 0x60, 0xa5, 0x41, 0xad, 0xa7, 0x61, 0xcb, 0xb1, 0x83, 0x43, 0x9f, 0x78, 0x84, 0xa2,
-	0x6e, 0xa4, 0xd4, 0x63, 0xbf, 0xb1, 0x4f, 0x2b, 0x52, 0x6d, 0x36, 0x52, 0x2a, 0x2e, 0x64, 0x05,
-	0xe6, 0x1c, 0x1c, 0x20, 0x13, 0x05, 0x88, 0xf7, 0xa9, 0x38, 0xcb, 0xaf, 0x46, 0x36, 0x4a, 0x91,
-	0x85, 0x29, 0xef, 0xd7, 0x5c, 0xf9, 0x86, 0x40, 0xc9, 0xf7, 0xa1, 0x88, 0x4f, 0x71, 0xbb, 0x52,
-	0xac, 0x49, 0x1b, 0xe5, 0x9d, 0x1b, 0x39, 0x4f, 0xb2, 0x7f, 0x8a, 0xdb, 0x06, 0x03, 0xa9, 0x6f,
-	0xc3, 0xda, 0x90, 0xda, 0x24, 0x17, 0x79, 0x1d, 0xae, 0x79, 0xfc, 0xae, 0x69, 0x9b, 0xac, 0xc2,
-	0x45, 0x03, 0x92, 0xab, 0x03, 0x53, 0x3d, 0x84, 0xeb, 0xa9, 0xe6, 0x12, 0xd9, 0x4e, 0xf2, 0x93,
-	0x2b, 0x70, 0x15, 0x99, 0xa6, 0x8f, 0x29, 0xe5, 0xf9, 0x26, 0x47, 0xf5, 0x36, 0xdc, 0xcc, 0x61,
-	0x14, 0xd5, 0xfd, 0x55, 0x82, 0xab, 0x0d, 0x6a, 0x7d, 0x40, 0x82, 0xc9, 0xea, 0xe4, 0x55, 0xb8,
-	0xd2, 0x23, 0x01, 0xf6, 0x79, 0x8c, 0xf8, 0x20, 0xbf, 0x01, 0x25, 0xe2, 0x45, 0x95, 0xa8, 0xcc,
-	0xb2, 0x02, 0xdd, 0xce, 0x29, 0x50, 0xc4, 0xff, 0x84, 0x81, 0x0c, 0x0e, 0xce, 0xbc, 0x51, 0x71,
-	0xe0, 0x8d, 0x92, 0x8a, 0x5f, 0x99, 0xa6, 0xe2, 0x2b, 0x6c, 0x2a, 0x44, 0x11, 0x44, 0x56, 0xbb,
-	0x2c, 0xa9, 0x08, 0x33, 0x39, 0xa9, 0xff, 0x41, 0x89, 0xda, 0x96, 0x2b, 0xb2, 0xe2, 0x27, 0x4e,
-	0xcb, 0xe2, 0x24, 0xb4, 0x1a, 0xc8, 0x0d, 0x6a, 0x3d, 0xc6, 0xa8, 0x87, 0xeb, 0x42, 0x4d, 0xba,
-	0xf6, 0x52, 0xb6, 0xf6, 0xb7, 0x40, 0x19, 0xc6, 0x0b, 0xb6, 0x53, 0xf6, 0xd6, 0x71, 0xef, 0xef,
-	0x61, 0x97, 0x12, 0x9f, 0x76, 0x6c, 0x6f, 0xc2, 0x37, 0xb8, 0x07, 0xd0, 0x16, 0x58, 0xfe, 0xf9,
-	0xe5, 0x15, 0xfc, 0x92, 0x90, 0x4f, 0x88, 0x94, 0x1b, 0xef, 0x89, 0xc1, 0xc8, 0x42, 0xd8, 0x2f,
-	0x12, 0xcc, 0x35, 0xa8, 0xf5, 0xae, 0x8f, 0xdc, 0x60, 0x82, 0x9c, 0x0a, 0x5c, 0xb5, 0x22, 0x18,
-	0xc6, 0x49, 0xdf, 0xf1, 0xa3, 0xfc, 0x15, 0x2c, 0x72, 0xd8, 0x97, 0x48, 0x34, 0xc7, 0xa8, 0x51,
-	0xf1, 0xce, 0x8b, 0xe7, 0x5b, 0x6f, 0x4d, 0x5c, 0x09, 0xa7, 0xe9, 0x35, 0xfe, 0x30, 0x4d, 0x6e,
-	0x64, 0x63, 0xa9, 0x32, 0x1b, 0xc0, 0x2c, 0x01, 0x91, 0x15, 0x86, 0xf9, 0x06, 0xb5, 0x0c, 0xdc,
-	0x23, 0xcf, 0xf0, 0xbf, 0xce, 0xaa, 0x06, 0x0b, 0x0e, 0xb5, 0x9a, 0xd1, 0x72, 0x6a, 0x86, 0x7e,
-	0x97, 0x25, 0x35, 0x6f, 0x80, 0x43, 0xad, 0xa3, 0xbe, 0x87, 0x8f, 0xfd, 0xae, 0x7a, 0x9d, 0x4d,
-	0xab, 0x38, 0x4c, 0x12, 0x7b, 0x73, 0x13, 0x8a, 0xac, 0x19, 0x57, 0x61, 0x79, 0xff, 0xa3, 0xfd,
-	0xbd, 0xe6, 0xf1, 0x7b, 0x4f, 0x0f, 0xf7, 0xf7, 0x0e, 0xea, 0x07, 0xfb, 0x8f, 0x96, 0x0b, 0xf2,
-	0x02, 0xcc, 0xb1, 0xdb, 0x23, 0xe3, 0xe3, 0x65, 0x69, 0xe7, 0x9b, 0x79, 0x98, 0x6d, 0x50, 0x4b,
-	0xfe, 0x14, 0x16, 0x32, 0xff, 0x23, 0xd4, 0xbc, 0x55, 0x90, 0xdd, 0xed, 0xca, 0xe6, 0x64, 0x8c,
-	0x98, 0x45, 0x3d, 0x58, 0xcd, 0xdd, 0xfd, 0x23, 0x38, 0xf2, 0xb0, 0xca, 0xce, 0xf4, 0x58, 0x11,
-	0x17, 0xc1, 0x62, 0x76, 0x33, 0xde, 0xc9, 0x27, 0xc9, 0x80, 0x94, 0xfb, 0x53, 0x80, 0xd2, 0xa9,
-	0xe5, 0xae, 0xb7, 0xcd, 0x71, 0x24, 0x59, 0xec, 0xa8, 0xd4, 0xc6, 0xad, 0x2a, 0xd9, 0x84, 0xf2,
-	0xc0, 0x9a, 0xba, 0x9b, 0xcf, 0x92, 0x45, 0x29, 0x0f, 0xa6, 0x41, 0x89, 0x28, 0x9f, 0xc1, 0xf2,
-	0xd0, 0x82, 0xb8, 0x37, 0xfe, 0x21, 0x44, 0x24, 0x6d, 0x3a, 0x9c, 0x88, 0x55, 0x87, 0x22, 0x5b,
-	0x0d, 0x4a, 0xbe, 0x5f, 0x64, 0x53, 0xd4, 0xd1, 0xb6, 0x34, 0x0f, 0xfb, 0x00, 0x46, 0xf0, 0x44,
-	0xb6, 0x51, 0x3c, 0xe9, 0x09, 0x2c, 0x5b, 0xb0, 0x34, 0x38, 0x7e, 0x5f, 0xc9, 0x77, 0x1b, 0x80,
-	0x29, 0x5b, 0x53, 0xc1, 0xd2, 0x45, 0x1e, 0x9a, 0xcc, 0xf7, 0xc6, 0xb5, 0xc4, 0x25, 0x6e, 0x54,
-	0x91, 0x47, 0xcd, 0x5b, 0xf9, 0x00, 0xae, 0xc4, 0xb3, 0xf6, 0x66, 0xbe, 0x23, 0x33, 0x2a, 0x77,
-	0xc6, 0x18, 0x05, 0xd5, 0x63, 0x28, 0x25, 0x13, 0x2e, 0x1f, 0x1e, 0x5b, 0x95, 0xbb, 0xe3, 0xac,
-	0x09, 0xdb, 0xee, 0x93, 0xb3, 0xbf, 0xaa, 0x85, 0x1f, 0xce, 0xab, 0x85, 0xb3, 0xf3, 0xaa, 0xf4,
-	0xf2, 0xbc, 0x2a, 0xfd, 0x79, 0x5e, 0x95, 0xbe, 0xbf, 0xa8, 0x16, 0x5e, 0x5e, 0x54, 0x0b, 0xbf,
-	0x5d, 0x54, 0x0b, 0x9f, 0x6c, 0xfd, 0xa3, 0xa1, 0xdd, 0x2a, 0xb1, 0xa9, 0xff, 0xda, 0xdf, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x0a, 0x94, 0xad, 0x73, 0xe7, 0x0d, 0x00, 0x00,
+	// 1473 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0xdc, 0xc4,
+	0x17, 0x8f, 0x93, 0x4d, 0x9a, 0xbc, 0x26, 0xdb, 0xc4, 0x5d, 0x7d, 0xbb, 0x71, 0xda, 0xcd, 0x7e,
+	0xdd, 0x5f, 0x51, 0xda, 0x78, 0x93, 0x7c, 0xbf, 0x08, 0x10, 0x48, 0x28, 0x49, 0x13, 0x88, 0xe8,
+	0xd2, 0xe0, 0x26, 0xfc, 0x3a, 0x74, 0xe5, 0x5d, 0x4f, 0xbc, 0xa6, 0x6b, 0x8f, 0xf1, 0x8c, 0xb7,
+	0x09, 0x1c, 0xb8, 0x22, 0x90, 0x50, 0xcf, 0xdc, 0x91, 0x10, 0x27, 0x24, 0x7a, 0x87, 0x63, 0xd5,
+	0x53, 0x8f, 0x9c, 0x28, 0xa4, 0xff, 0x08, 0xf2, 0x78, 0x3c, 0xb1, 0x77, 0xbd, 0x3f, 0x02, 0xe2,
+	0xb4, 0x3b, 0x7e, 0x9f, 0xf7, 0xde, 0xe7, 0xbd, 0xf9, 0xcc, 0xcc, 0x03, 0xa5, 0x55, 0x77, 0x2a,
+	0x87, 0x38, 0x70, 0x4d, 0x83, 0xda, 0xd8, 0xad, 0xb4, 0xd7, 0x2a, 0xf4, 0x48, 0xf3, 0x7c, 0x4c,
+	0xb1, 0x3c, 0xd7, 0xaa, 0x3b, 0xda, 0xa9, 0x4d, 0x6b, 0xaf, 0x29, 0x05, 0x0b, 0x5b, 0x98, 0x59,
+	0x2b, 0xe1, 0xbf, 0x08, 0xa8, 0xa8, 0xdd, 0x41, 0x12, 0x6e, 0x11, 0xa6, 0xd4, 0xc0, 0xc4, 0xc1,
+	0xa4, 0x52, 0x37, 0x08, 0xaa, 0xb4, 0xd7, 0xea, 0x88, 0x1a, 0x6b, 0x95, 0x06, 0xb6, 0x63, 0xfb,
+	0xbc, 0x85, 0xb1, 0xd5, 0x42, 0x15, 0xb6, 0xaa, 0x07, 0x87, 0x15, 0xc3, 0x3d, 0xe6, 0xa6, 0xc5,
+	0x4e, 0x13, 0xb5, 0x1d, 0x44, 0xa8, 0xe1, 0x78, 0xb1, 0x6f, 0x14, 0xbb, 0x16, 0x11, 0x8b, 0x16,
+	0x91, 0x49, 0xfd, 0x56, 0x82, 0x0b, 0x55, 0x62, 0xed, 0x04, 0xae, 0xb9, 0xef, 0x23, 0x83, 0x04,
+	0xfe, 0xb1, 0x2c, 0x43, 0xee, 0xd0, 0xc7, 0x4e, 0x51, 0x2a, 0x4b, 0x4b, 0x53, 0x3a, 0xfb, 0x2f,
+	0x5b, 0x30, 0x61, 0x38, 0x38, 0x70, 0x69, 0x71, 0xb4, 0x3c, 0xb6, 0x74, 0x7e, 0x7d, 0x5e, 0xe3,
+	0x61, 0x42, 0xbe, 0x1a, 0xe7, 0xab, 0x6d, 0x61, 0xdb, 0xdd, 0xfc, 0xff, 0xd3, 0xdf, 0x17, 0x47,
+	0x7e, 0x7c, 0xb1, 0x78, 0xdb, 0xb2, 0x69, 0x33, 0xa8, 0x6b, 0x0d, 0xec, 0x54, 0x76, 0x6c, 0x97,
+	0x34, 0x9a, 0xb6, 0x51, 0x39, 0xe4, 0x7f, 0x56, 0x88, 0xf9, 0xb0, 0x42, 0x8f, 0x3d, 0x44, 0x98,
+	0x13, 0xd1, 0x79, 0x78, 0x75, 0x1e, 0x2e, 0x75, 0xf0, 0xd1, 0x11, 0xf1, 0xb0, 0x4b, 0x90, 0xfa,
+	0x93, 0xc4, 0x6c, 0x1f, 0xda, 0xb4, 0x69, 0xfa, 0xc6, 0xa3, 0x1d, 0x1f, 0x3b, 0x82, 0xf3, 0x65,
+	0x98, 0x32, 0x02, 0xda, 0xc4, 0xbe, 0x4d, 0x8f, 0x39, 0xf1, 0xd3, 0x0f, 0x72, 0x1e, 0x46, 0x29,
+	0x2e, 0x8e, 0xb2, 0xcf, 0xa3, 0x14, 0x27, 0xaa, 0x19, 0xfb, 0x77, 0xab, 0x69, 0xb2, 0xee, 0x1e,
+	0x78, 0xa6, 0x41, 0xd1, 0x9e, 0xe1, 0x1b, 0x0e, 0x19, 0xc0, 0xf4, 0x55, 0x98, 0xf0, 0x18, 0x8e,
+	0xb1, 0x0d, 0x99, 0x75, 0x89, 0x4c, 0x8b, 0x02, 0x6d, 0xe6, 0x42, 0x66, 0x3a, 0x87, 0xf3, 0xbe,
+	0x25, 0x33, 0x89, 0xbe, 0xfd, 0x17, 0x16, 0x7b, 0xb4, 0x4d, 0x40, 0xbe, 0x84, 0x59, 0xe1, 0x5d,
+	0x45, 0x4e, 0x1d, 0xf9, 0x83, 0x88, 0x56, 0x21, 0xef, 0x30, 0x60, 0x2d, 0x60, 0x5e, 0x84, 0x0b,
+	0xa3, 0x9c, 0x41, 0x38, 0x8a, 0xa8, 0xa3, 0xcf, 0x02, 0x44, 0x28, 0xe7, 0x3d, 0x13, 0x79, 0x47,
+	0x29, 0x89, 0xaa, 0x40, 0xb1, 0x93, 0x80, 0x20, 0xf7, 0xb5, 0x94, 0xa8, 0xed, 0x0e, 0x6a, 0xd8,
+	0xc4, 0xc6, 0xee, 0x1e, 0x6e, 0xd9, 0x8d, 0x41, 0xfb, 0xfe, 0x3e, 0x5c, 0x30, 0x39, 0xbe, 0xe6,
+	0x31, 0x07, 0xde, 0xd6, 0x82, 0x16, 0x9d, 0x19, 0x2d, 0x3e, 0x33, 0xda, 0x86, 0x7b, 0xbc, 0x29,
+	0x3f, 0x7b, 0xb2, 0x92, 0x4f, 0x27, 0xd0, 0xf3, 0x66, 0x6a, 0xcd, 0x9b, 0x99, 0xc5, 0x45, 0xf0,
+	0xfd, 0x59, 0x82, 0xd2, 0x69, 0x31, 0xc4, 0xda, 0x3f, 0xf6, 0xce, 0x46, 0xbb, 0x0c, 0xd3, 0x0e,
+	0xb1, 0x6a, 0xa1, 0xa0, 0x6a, 0x81, 0xdf, 0xe2, 0xc2, 0x05, 0x27, 0x0a, 0x75, 0xe0, 0xb7, 0xb2,
+	0x0a, 0x1b, 0xfb, 0x87, 0x85, 0x2d, 0xc1, 0x8d, 0xfe, 0xa4, 0x45, 0x7d, 0xdf, 0x8f, 0xc2, 0x5c,
+	0x95, 0x58, 0xf7, 0x83, 0xba, 0x63, 0xd3, 0x3d, 0x1f, 0x7b, 0x98, 0x18, 0xad, 0xb0, 0x24, 0x8f,
+	0xfd, 0x47, 0x3e, 0x29, 0x4a, 0xe5, 0xb1, 0xb0, 0x24, 0xf1, 0x41, 0x56, 0x60, 0xd2, 0x41, 0xd4,
+	0x30, 0x0d, 0x6a, 0xf0, 0x72, 0xc4, 0x5a, 0x5e, 0x0d, 0x6d, 0x84, 0x18, 0x16, 0x22, 0xfc, 0x3c,
+	0x66, 0x56, 0xa1, 0x0b, 0x94, 0x7c, 0x0b, 0x72, 0xe8, 0x08, 0x35, 0x8a, 0xb9, 0xb2, 0xb4, 0x94,
+	0x5f, 0xbf, 0x94, 0x21, 0xb9, 0xed, 0x23, 0xd4, 0xd0, 0x19, 0x48, 0xde, 0x86, 0x99, 0xf0, 0x37,
+	0xa0, 0xa8, 0x66, 0x1c, 0x52, 0xe4, 0x17, 0xc7, 0x59, 0xa7, 0x94, 0xae, 0x1c, 0xfb, 0xf1, 0xb5,
+	0xb9, 0x99, 0x7b, 0xfc, 0x62, 0x51, 0xd2, 0xa7, 0xb9, 0xdb, 0x46, 0xe8, 0x25, 0xaf, 0x42, 0x21,
+	0x15, 0xa6, 0xd6, 0x44, 0xb6, 0xd5, 0xa4, 0xc5, 0x89, 0xb2, 0xb4, 0x94, 0xd3, 0xe5, 0x24, 0xf6,
+	0x1d, 0x66, 0x51, 0xdf, 0x84, 0xf9, 0xae, 0x36, 0xc5, 0x4d, 0x94, 0x17, 0xe1, 0xbc, 0xc7, 0xbf,
+	0xd5, 0x6c, 0x93, 0x69, 0x20, 0xa7, 0x43, 0xfc, 0x69, 0xd7, 0x54, 0xf7, 0xe0, 0x62, 0xe2, 0xd4,
+	0x8a, 0x36, 0x0f, 0xf2, 0x93, 0x8b, 0x70, 0xce, 0x30, 0x4d, 0x1f, 0x11, 0xc2, 0x1b, 0x1d, 0x2f,
+	0xd5, 0x2b, 0xb0, 0x90, 0x11, 0x51, 0x6c, 0xeb, 0xaf, 0x12, 0x9c, 0xab, 0x12, 0xeb, 0x03, 0x4c,
+	0x07, 0xb3, 0x93, 0x0b, 0x30, 0xde, 0xc6, 0x61, 0x33, 0xa3, 0x1c, 0xd1, 0x42, 0x7e, 0x05, 0x26,
+	0xb0, 0x17, 0x6e, 0x01, 0x53, 0x63, 0x7e, 0xfd, 0x4a, 0xc6, 0xce, 0x84, 0xf1, 0xef, 0x31, 0x90,
+	0xce, 0xc1, 0x29, 0x71, 0xe4, 0x3a, 0xc4, 0x11, 0x6f, 0xf5, 0xf8, 0x10, 0x5b, 0xad, 0xce, 0xb1,
+	0xeb, 0x36, 0xcc, 0x20, 0xaa, 0x7a, 0x97, 0x7d, 0xba, 0x83, 0x5a, 0xc8, 0x32, 0x28, 0x62, 0xc5,
+	0x5d, 0x86, 0x29, 0x33, 0x5a, 0x63, 0x3f, 0x3e, 0x7c, 0xe2, 0x43, 0x48, 0x86, 0x2f, 0x50, 0xac,
+	0xd4, 0x78, 0xcd, 0x2f, 0xd9, 0x64, 0x30, 0x91, 0x67, 0x8d, 0x9d, 0x89, 0x03, 0xd7, 0x1c, 0x3a,
+	0x93, 0xba, 0x00, 0xf3, 0x5d, 0x2e, 0x22, 0xde, 0x26, 0xdb, 0x8c, 0xb0, 0xb6, 0xc1, 0x9b, 0xf1,
+	0x1f, 0x98, 0x20, 0xb6, 0xe5, 0x8a, 0xdd, 0xe0, 0x2b, 0xde, 0x0e, 0xd6, 0x9f, 0x38, 0xac, 0x06,
+	0x72, 0x95, 0x58, 0x77, 0x91, 0xd1, 0x46, 0x3b, 0xa2, 0x8b, 0x49, 0xcd, 0x48, 0x69, 0xcd, 0x5c,
+	0x06, 0xa5, 0x1b, 0x2f, 0xa2, 0x1d, 0xc1, 0x45, 0x71, 0x67, 0x6c, 0x21, 0x97, 0x60, 0x9f, 0x34,
+	0x6d, 0x6f, 0xc0, 0xed, 0xb6, 0x05, 0xd0, 0x10, 0x58, 0x7e, 0x1f, 0x67, 0x09, 0xe5, 0x34, 0x20,
+	0x7f, 0x32, 0x12, 0x6e, 0x5c, 0xcb, 0x9d, 0x99, 0x05, 0xb1, 0x5f, 0x24, 0x98, 0xac, 0x12, 0xeb,
+	0x6d, 0xdf, 0x70, 0xe9, 0x00, 0x3a, 0x45, 0x38, 0x67, 0x85, 0x30, 0x14, 0x6f, 0x77, 0xbc, 0x94,
+	0xbf, 0x80, 0x19, 0x0e, 0xfb, 0xdc, 0x10, 0xa2, 0xee, 0x75, 0xc5, 0xbe, 0xf5, 0xec, 0xc9, 0xca,
+	0x1b, 0x03, 0x67, 0x84, 0xa3, 0xe4, 0xe0, 0xb7, 0x91, 0x0c, 0xae, 0xa7, 0x73, 0xa9, 0x32, 0xcc,
+	0xc6, 0x05, 0x88, 0xaa, 0x10, 0x4c, 0x55, 0x89, 0xa5, 0xa3, 0x36, 0x7e, 0x88, 0xfe, 0x76, 0x55,
+	0x9d, 0x8f, 0xcb, 0x58, 0xe7, 0xe3, 0xa2, 0x5e, 0x84, 0x39, 0x91, 0x26, 0xb1, 0xd5, 0x32, 0xd7,
+	0xd2, 0x06, 0xd9, 0x10, 0x69, 0x56, 0xa1, 0x60, 0xf9, 0x38, 0xf0, 0xf8, 0x23, 0x54, 0x4b, 0xab,
+	0x48, 0x66, 0xb6, 0xe8, 0xf5, 0xd8, 0x88, 0x2c, 0xa9, 0xcb, 0x7e, 0x74, 0x98, 0xcb, 0x9e, 0x4b,
+	0xb0, 0x23, 0xb3, 0xe0, 0xf5, 0x9d, 0x04, 0x57, 0x84, 0x12, 0xe2, 0xb9, 0xe6, 0x5e, 0x40, 0x0f,
+	0x5b, 0xf8, 0xd1, 0x5d, 0xdb, 0xb1, 0xe9, 0x40, 0x35, 0x8e, 0xb7, 0x42, 0x18, 0x17, 0xe2, 0xcd,
+	0x0c, 0x21, 0x66, 0x45, 0xe5, 0x92, 0x8c, 0x7c, 0xc3, 0x03, 0xe8, 0x23, 0x07, 0xb7, 0x11, 0xeb,
+	0xe6, 0xa4, 0xce, 0x57, 0xea, 0x4d, 0xb8, 0xde, 0x97, 0x5b, 0x5c, 0xc5, 0xf2, 0x32, 0xe4, 0xd8,
+	0x51, 0x2f, 0xc0, 0xec, 0xf6, 0x47, 0xdb, 0x5b, 0xb5, 0x83, 0xf7, 0xee, 0xef, 0x6d, 0x6f, 0xed,
+	0xee, 0xec, 0x6e, 0xdf, 0x99, 0x1d, 0x91, 0xa7, 0x61, 0x92, 0x7d, 0xdd, 0xd7, 0x3f, 0x9e, 0x95,
+	0xd6, 0x9f, 0xcf, 0xc0, 0x58, 0x95, 0x58, 0xf2, 0x03, 0x98, 0x4e, 0x8d, 0xed, 0x6a, 0xd6, 0xe4,
+	0x95, 0x1e, 0xa5, 0x95, 0xe5, 0xc1, 0x18, 0xf1, 0x42, 0xb5, 0xa1, 0x90, 0x39, 0x6a, 0xf7, 0x88,
+	0x91, 0x85, 0x55, 0xd6, 0x87, 0xc7, 0x8a, 0xbc, 0x06, 0xcc, 0xa4, 0x07, 0xd1, 0xab, 0xd9, 0x41,
+	0x52, 0x20, 0xe5, 0xd6, 0x10, 0xa0, 0x64, 0x69, 0x99, 0xd3, 0xe4, 0x72, 0xbf, 0x20, 0x69, 0xac,
+	0xb2, 0x3e, 0x3c, 0x56, 0xe4, 0xfd, 0x46, 0x82, 0x85, 0x7e, 0x63, 0xe1, 0x5a, 0xdf, 0x22, 0xb2,
+	0x5c, 0x94, 0xd7, 0xcf, 0xec, 0x22, 0xd8, 0x98, 0x90, 0xef, 0x98, 0xe1, 0xae, 0x65, 0x07, 0x4b,
+	0xa3, 0x94, 0xdb, 0xc3, 0xa0, 0x44, 0x96, 0x4f, 0x61, 0xb6, 0x6b, 0x88, 0xb9, 0xd1, 0x5f, 0x16,
+	0x22, 0x93, 0x36, 0x1c, 0x4e, 0xe4, 0xda, 0x81, 0x1c, 0x7b, 0x77, 0x95, 0x6c, 0xbf, 0xd0, 0xa6,
+	0xa8, 0xbd, 0x6d, 0x22, 0xce, 0x03, 0x98, 0x4e, 0x4d, 0x0c, 0x3d, 0x7c, 0x92, 0x18, 0x65, 0x79,
+	0x30, 0x26, 0xd9, 0xf9, 0x8e, 0x49, 0xa1, 0x47, 0xe7, 0xd3, 0x28, 0xe5, 0xf6, 0x30, 0xa8, 0x64,
+	0x37, 0xd8, 0xa5, 0xd2, 0xa3, 0x1b, 0xa1, 0x4d, 0x51, 0x7b, 0xdb, 0x44, 0x1c, 0x0b, 0x2e, 0x74,
+	0x0e, 0x0c, 0xd7, 0xb3, 0xdd, 0x3a, 0x60, 0xca, 0xca, 0x50, 0xb0, 0xa4, 0x54, 0xba, 0x66, 0x89,
+	0x1b, 0xfd, 0xf4, 0x7d, 0x8a, 0x53, 0xb4, 0xe1, 0x70, 0x22, 0xd7, 0x2e, 0x8c, 0x47, 0xd3, 0xc1,
+	0x42, 0xb6, 0x23, 0x33, 0x2a, 0x57, 0xfb, 0x18, 0x45, 0xa8, 0xbb, 0x30, 0x11, 0xbf, 0xc9, 0xd9,
+	0xf0, 0xc8, 0xaa, 0x5c, 0xeb, 0x67, 0x4d, 0x76, 0xbb, 0xf3, 0x95, 0xbd, 0xde, 0x7b, 0x93, 0x12,
+	0x30, 0x65, 0x65, 0x28, 0x98, 0x48, 0xf4, 0x95, 0x04, 0x4a, 0x9f, 0x67, 0x73, 0xb5, 0x5f, 0x43,
+	0xb3, 0x3c, 0x94, 0xd7, 0xce, 0xea, 0x11, 0x53, 0xd9, 0xbc, 0xf7, 0xf4, 0xcf, 0xd2, 0xc8, 0x0f,
+	0x27, 0xa5, 0x91, 0xa7, 0x27, 0x25, 0xe9, 0xf9, 0x49, 0x49, 0xfa, 0xe3, 0xa4, 0x24, 0x3d, 0x7e,
+	0x59, 0x1a, 0x79, 0xfe, 0xb2, 0x34, 0xf2, 0xdb, 0xcb, 0xd2, 0xc8, 0x27, 0x2b, 0x67, 0x1a, 0xad,
+	0xea, 0x13, 0x6c, 0x96, 0xf8, 0xdf, 0x5f, 0x03, 0x00, 0x77, 0xb7, 0x3e, 0x35, 0xbf, 0x13, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1224,12 +1666,18 @@ type MsgClient interface {
 	UpdateMembers(ctx context.Context, in *MsgUpdateMembers, opts ...grpc.CallOption) (*MsgUpdateMembersResponse, error)
 	// UpdateDecisionPolicy allows a group policy's decision policy to be updated.
 	UpdateDecisionPolicy(ctx context.Context, in *MsgUpdateDecisionPolicy, opts ...grpc.CallOption) (*MsgUpdateDecisionPolicyResponse, error)
+	// UpdateMsgTypeDecisionPolicy allows the decision policy of a message type to be updated.
+	UpdateMsgTypeDecisionPolicy(ctx context.Context, in *MsgUpdateMsgTypeDecisionPolicy, opts ...grpc.CallOption) (*MsgUpdateMsgTypeDecisionPolicyResponse, error)
 	// SubmitProposal submits a new proposal.
 	SubmitProposal(ctx context.Context, in *MsgSubmitProposal, opts ...grpc.CallOption) (*MsgSubmitProposalResponse, error)
 	// WithdrawProposal aborts a proposal.
 	WithdrawProposal(ctx context.Context, in *MsgWithdrawProposal, opts ...grpc.CallOption) (*MsgWithdrawProposalResponse, error)
 	// Vote allows a voter to vote on a proposal.
 	Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOption) (*MsgVoteResponse, error)
+	// DelegateVote delegates the voting power of a member to another member.
+	DelegateVote(ctx context.Context, in *MsgDelegateVote, opts ...grpc.CallOption) (*MsgDelegateVoteResponse, error)
+	// UndelegateVote removes the vote delegation of a member.
+	UndelegateVote(ctx context.Context, in *MsgUndelegateVote, opts ...grpc.CallOption) (*MsgUndelegateVoteResponse, error)
 	// Exec executes a proposal.
 	Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error)
 	// LeaveFoundation allows a member to leave the foundation.
@@ -1243,6 +1691,13 @@ type MsgClient interface {
 	// Revoke revokes any authorization corresponding to the provided method name
 	// that has been granted to the grantee.
 	Revoke(ctx context.Context, in *MsgRevoke, opts ...grpc.CallOption) (*MsgRevokeResponse, error)
+	// ExecAsAuthority executes messages with the authority of the foundation.
+	// It is only available to the group policy account which the foundation
+	// has been outsourced to.
+	ExecAsAuthority(ctx context.Context, in *MsgExecAsAuthority, opts ...grpc.CallOption) (*MsgExecAsAuthorityResponse, error)
+	// UpdateTreasuryOutflowLimit updates the outflow limit of a denom from the
+	// treasury. It is only available to x/gov.
+	UpdateTreasuryOutflowLimit(ctx context.Context, in *MsgUpdateTreasuryOutflowLimit, opts ...grpc.CallOption) (*MsgUpdateTreasuryOutflowLimitResponse, error)
 }
 
 type msgClient struct {
@@ -1289,6 +1744,15 @@ func (c *msgClient) UpdateDecisionPolicy(ctx context.Context, in *MsgUpdateDecis
 	return out, nil
 }
 
+func (c *msgClient) UpdateMsgTypeDecisionPolicy(ctx context.Context, in *MsgUpdateMsgTypeDecisionPolicy, opts ...grpc.CallOption) (*MsgUpdateMsgTypeDecisionPolicyResponse, error) {
+	out := new(MsgUpdateMsgTypeDecisionPolicyResponse)
+	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Msg/UpdateMsgTypeDecisionPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SubmitProposal(ctx context.Context, in *MsgSubmitProposal, opts ...grpc.CallOption) (*MsgSubmitProposalResponse, error) {
 	out := new(MsgSubmitProposalResponse)
 	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Msg/SubmitProposal", in, out, opts...)
@@ -1316,16 +1780,34 @@ func (c *msgClient) Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOpti
 	return out, nil
 }
 
-func (c *msgClient) Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error) {
-	out := new(MsgExecResponse)
-	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Msg/Exec", in, out, opts...)
+func (c *msgClient) DelegateVote(ctx context.Context, in *MsgDelegateVote, opts ...grpc.CallOption) (*MsgDelegateVoteResponse, error) {
+	out := new(MsgDelegateVoteResponse)
+	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Msg/DelegateVote", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *msgClient) LeaveFoundation(ctx context.Context, in *MsgLeaveFoundation, opts ...grpc.CallOption) (*MsgLeaveFoundationResponse, error) {
+func (c *msgClient) UndelegateVote(ctx context.Context, in *MsgUndelegateVote, opts ...grpc.CallOption) (*MsgUndelegateVoteResponse, error) {
+	out := new(MsgUndelegateVoteResponse)
+	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Msg/UndelegateVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error) {
+	out := new(MsgExecResponse)
+	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Msg/Exec", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) LeaveFoundation(ctx context.Context, in *MsgLeaveFoundation, opts ...grpc.CallOption) (*MsgLeaveFoundationResponse, error) {
 	out := new(MsgLeaveFoundationResponse)
 	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Msg/LeaveFoundation", in, out, opts...)
 	if err != nil {
@@ -1361,6 +1843,24 @@ func (c *msgClient) Revoke(ctx context.Context, in *MsgRevoke, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) ExecAsAuthority(ctx context.Context, in *MsgExecAsAuthority, opts ...grpc.CallOption) (*MsgExecAsAuthorityResponse, error) {
+	out := new(MsgExecAsAuthorityResponse)
+	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Msg/ExecAsAuthority", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateTreasuryOutflowLimit(ctx context.Context, in *MsgUpdateTreasuryOutflowLimit, opts ...grpc.CallOption) (*MsgUpdateTreasuryOutflowLimitResponse, error) {
+	out := new(MsgUpdateTreasuryOutflowLimitResponse)
+	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Msg/UpdateTreasuryOutflowLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// FundTreasury defines a method to fund the treasury.
@@ -1371,12 +1871,18 @@ type MsgServer interface {
 	UpdateMembers(context.Context, *MsgUpdateMembers) (*MsgUpdateMembersResponse, error)
 	// UpdateDecisionPolicy allows a group policy's decision policy to be updated.
 	UpdateDecisionPolicy(context.Context, *MsgUpdateDecisionPolicy) (*MsgUpdateDecisionPolicyResponse, error)
+	// UpdateMsgTypeDecisionPolicy allows the decision policy of a message type to be updated.
+	UpdateMsgTypeDecisionPolicy(context.Context, *MsgUpdateMsgTypeDecisionPolicy) (*MsgUpdateMsgTypeDecisionPolicyResponse, error)
 	// SubmitProposal submits a new proposal.
 	SubmitProposal(context.Context, *MsgSubmitProposal) (*MsgSubmitProposalResponse, error)
 	// WithdrawProposal aborts a proposal.
 	WithdrawProposal(context.Context, *MsgWithdrawProposal) (*MsgWithdrawProposalResponse, error)
 	// Vote allows a voter to vote on a proposal.
 	Vote(context.Context, *MsgVote) (*MsgVoteResponse, error)
+	// DelegateVote delegates the voting power of a member to another member.
+	DelegateVote(context.Context, *MsgDelegateVote) (*MsgDelegateVoteResponse, error)
+	// UndelegateVote removes the vote delegation of a member.
+	UndelegateVote(context.Context, *MsgUndelegateVote) (*MsgUndelegateVoteResponse, error)
 	// Exec executes a proposal.
 	Exec(context.Context, *MsgExec) (*MsgExecResponse, error)
 	// LeaveFoundation allows a member to leave the foundation.
@@ -1390,6 +1896,13 @@ type MsgServer interface {
 	// Revoke revokes any authorization corresponding to the provided method name
 	// that has been granted to the grantee.
 	Revoke(context.Context, *MsgRevoke) (*MsgRevokeResponse, error)
+	// ExecAsAuthority executes messages with the authority of the foundation.
+	// It is only available to the group policy account which the foundation
+	// has been outsourced to.
+	ExecAsAuthority(context.Context, *MsgExecAsAuthority) (*MsgExecAsAuthorityResponse, error)
+	// UpdateTreasuryOutflowLimit updates the outflow limit of a denom from the
+	// treasury. It is only available to x/gov.
+	UpdateTreasuryOutflowLimit(context.Context, *MsgUpdateTreasuryOutflowLimit) (*MsgUpdateTreasuryOutflowLimitResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1408,6 +1921,9 @@ func (*UnimplementedMsgServer) UpdateMembers(ctx context.Context, req *MsgUpdate
 func (*UnimplementedMsgServer) UpdateDecisionPolicy(ctx context.Context, req *MsgUpdateDecisionPolicy) (*MsgUpdateDecisionPolicyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateDecisionPolicy not implemented")
 }
+func (*UnimplementedMsgServer) UpdateMsgTypeDecisionPolicy(ctx context.Context, req *MsgUpdateMsgTypeDecisionPolicy) (*MsgUpdateMsgTypeDecisionPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMsgTypeDecisionPolicy not implemented")
+}
 func (*UnimplementedMsgServer) SubmitProposal(ctx context.Context, req *MsgSubmitProposal) (*MsgSubmitProposalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitProposal not implemented")
 }
@@ -1417,6 +1933,12 @@ func (*UnimplementedMsgServer) WithdrawProposal(ctx context.Context, req *MsgWit
 func (*UnimplementedMsgServer) Vote(ctx context.Context, req *MsgVote) (*MsgVoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
 }
+func (*UnimplementedMsgServer) DelegateVote(ctx context.Context, req *MsgDelegateVote) (*MsgDelegateVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateVote not implemented")
+}
+func (*UnimplementedMsgServer) UndelegateVote(ctx context.Context, req *MsgUndelegateVote) (*MsgUndelegateVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateVote not implemented")
+}
 func (*UnimplementedMsgServer) Exec(ctx context.Context, req *MsgExec) (*MsgExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Exec not implemented")
 }
@@ -1432,6 +1954,12 @@ func (*UnimplementedMsgServer) Grant(ctx context.Context, req *MsgGrant) (*MsgGr
 func (*UnimplementedMsgServer) Revoke(ctx context.Context, req *MsgRevoke) (*MsgRevokeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Revoke not implemented")
 }
+func (*UnimplementedMsgServer) ExecAsAuthority(ctx context.Context, req *MsgExecAsAuthority) (*MsgExecAsAuthorityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecAsAuthority not implemented")
+}
+func (*UnimplementedMsgServer) UpdateTreasuryOutflowLimit(ctx context.Context, req *MsgUpdateTreasuryOutflowLimit) (*MsgUpdateTreasuryOutflowLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTreasuryOutflowLimit not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1509,6 +2037,24 @@ func _Msg_UpdateDecisionPolicy_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateMsgTypeDecisionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMsgTypeDecisionPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMsgTypeDecisionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.foundation.v1.Msg/UpdateMsgTypeDecisionPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMsgTypeDecisionPolicy(ctx, req.(*MsgUpdateMsgTypeDecisionPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SubmitProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSubmitProposal)
 	if err := dec(in); err != nil {
@@ -1563,6 +2109,42 @@ func _Msg_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DelegateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.foundation.v1.Msg/DelegateVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateVote(ctx, req.(*MsgDelegateVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UndelegateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegateVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.foundation.v1.Msg/UndelegateVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateVote(ctx, req.(*MsgUndelegateVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Exec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgExec)
 	if err := dec(in); err != nil {
@@ -1653,6 +2235,42 @@ func _Msg_Revoke_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ExecAsAuthority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExecAsAuthority)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExecAsAuthority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.foundation.v1.Msg/ExecAsAuthority",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExecAsAuthority(ctx, req.(*MsgExecAsAuthority))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateTreasuryOutflowLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTreasuryOutflowLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTreasuryOutflowLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.foundation.v1.Msg/UpdateTreasuryOutflowLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTreasuryOutflowLimit(ctx, req.(*MsgUpdateTreasuryOutflowLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lbm.foundation.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1673,6 +2291,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateDecisionPolicy",
 			Handler:    _Msg_UpdateDecisionPolicy_Handler,
 		},
+		{
+			MethodName: "UpdateMsgTypeDecisionPolicy",
+			Handler:    _Msg_UpdateMsgTypeDecisionPolicy_Handler,
+		},
 		{
 			MethodName: "SubmitProposal",
 			Handler:    _Msg_SubmitProposal_Handler,
@@ -1685,6 +2307,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Vote",
 			Handler:    _Msg_Vote_Handler,
 		},
+		{
+			MethodName: "DelegateVote",
+			Handler:    _Msg_DelegateVote_Handler,
+		},
+		{
+			MethodName: "UndelegateVote",
+			Handler:    _Msg_UndelegateVote_Handler,
+		},
 		{
 			MethodName: "Exec",
 			Handler:    _Msg_Exec_Handler,
@@ -1705,6 +2335,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Revoke",
 			Handler:    _Msg_Revoke_Handler,
 		},
+		{
+			MethodName: "ExecAsAuthority",
+			Handler:    _Msg_ExecAsAuthority_Handler,
+		},
+		{
+			MethodName: "UpdateTreasuryOutflowLimit",
+			Handler:    _Msg_UpdateTreasuryOutflowLimit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lbm/foundation/v1/tx.proto",
@@ -2046,6 +2684,78 @@ func (m *MsgUpdateDecisionPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateMsgTypeDecisionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMsgTypeDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMsgTypeDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DecisionPolicy != nil {
+		{
+			size, err := m.DecisionPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMsgTypeDecisionPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMsgTypeDecisionPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMsgTypeDecisionPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgSubmitProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2066,6 +2776,21 @@ func (m *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExecuteAfterHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExecuteAfterHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ExecuteAfter != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExecuteAfter, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExecuteAfter):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintTx(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Exec != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.Exec))
 		i--
@@ -2265,7 +2990,7 @@ func (m *MsgVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgExec) Marshal() (dAtA []byte, err error) {
+func (m *MsgDelegateVote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2275,32 +3000,34 @@ func (m *MsgExec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgExec) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDelegateVote) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgExec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDelegateVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegate)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.ProposalId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgExecResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDelegateVoteResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2310,12 +3037,12 @@ func (m *MsgExecResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgExecResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDelegateVoteResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDelegateVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2323,7 +3050,7 @@ func (m *MsgExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgLeaveFoundation) Marshal() (dAtA []byte, err error) {
+func (m *MsgUndelegateVote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2333,27 +3060,27 @@ func (m *MsgLeaveFoundation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgLeaveFoundation) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUndelegateVote) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgLeaveFoundation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUndelegateVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgLeaveFoundationResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgUndelegateVoteResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2363,12 +3090,12 @@ func (m *MsgLeaveFoundationResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgLeaveFoundationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUndelegateVoteResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgLeaveFoundationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUndelegateVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2376,7 +3103,7 @@ func (m *MsgLeaveFoundationResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateCensorship) Marshal() (dAtA []byte, err error) {
+func (m *MsgExec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2386,37 +3113,32 @@ func (m *MsgUpdateCensorship) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateCensorship) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgExec) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateCensorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgExec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Censorship.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateCensorshipResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgExecResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2426,12 +3148,12 @@ func (m *MsgUpdateCensorshipResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateCensorshipResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgExecResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateCensorshipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2439,7 +3161,7 @@ func (m *MsgUpdateCensorshipResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgGrant) Marshal() (dAtA []byte, err error) {
+func (m *MsgLeaveFoundation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2449,28 +3171,144 @@ func (m *MsgGrant) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgGrant) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgLeaveFoundation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgLeaveFoundation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Authorization != nil {
-		{
-			size, err := m.Authorization.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLeaveFoundationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLeaveFoundationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLeaveFoundationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCensorship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCensorship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCensorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Censorship.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCensorshipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCensorshipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCensorshipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Authorization != nil {
+		{
+			size, err := m.Authorization.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Grantee) > 0 {
 		i -= len(m.Grantee)
 		copy(dAtA[i:], m.Grantee)
@@ -2578,6 +3416,146 @@ func (m *MsgRevokeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgExecAsAuthority) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecAsAuthority) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecAsAuthority) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.GroupPolicyAddress) > 0 {
+		i -= len(m.GroupPolicyAddress)
+		copy(dAtA[i:], m.GroupPolicyAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupPolicyAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecAsAuthorityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecAsAuthorityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecAsAuthorityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTreasuryOutflowLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTreasuryOutflowLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTreasuryOutflowLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Remove {
+		i--
+		if m.Remove {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Limit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTreasuryOutflowLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTreasuryOutflowLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTreasuryOutflowLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -2727,23 +3705,53 @@ func (m *MsgUpdateDecisionPolicyResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgSubmitProposal) Size() (n int) {
+func (m *MsgUpdateMsgTypeDecisionPolicy) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Proposers) > 0 {
-		for _, s := range m.Proposers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	l = len(m.Metadata)
+	l = len(m.Authority)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if len(m.Messages) > 0 {
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DecisionPolicy != nil {
+		l = m.DecisionPolicy.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateMsgTypeDecisionPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposers) > 0 {
+		for _, s := range m.Proposers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Messages) > 0 {
 		for _, e := range m.Messages {
 			l = e.Size()
 			n += 1 + l + sovTx(uint64(l))
@@ -2752,6 +3760,13 @@ func (m *MsgSubmitProposal) Size() (n int) {
 	if m.Exec != 0 {
 		n += 1 + sovTx(uint64(m.Exec))
 	}
+	if m.ExecuteAfter != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExecuteAfter)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExecuteAfterHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExecuteAfterHeight))
+	}
 	return n
 }
 
@@ -2827,6 +3842,54 @@ func (m *MsgVoteResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgDelegateVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDelegateVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUndelegateVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUndelegateVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgExec) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2958,6 +4021,61 @@ func (m *MsgRevokeResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgExecAsAuthority) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GroupPolicyAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgExecAsAuthorityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateTreasuryOutflowLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Limit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Remove {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUpdateTreasuryOutflowLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3225,7 +4343,957 @@ func (m *MsgWithdrawFromTreasury) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawFromTreasuryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawFromTreasuryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawFromTreasuryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMembers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMembers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMembers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemberUpdates = append(m.MemberUpdates, MemberRequest{})
+			if err := m.MemberUpdates[len(m.MemberUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMembersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMembersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDecisionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDecisionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DecisionPolicy == nil {
+				m.DecisionPolicy = &types1.Any{}
+			}
+			if err := m.DecisionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDecisionPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDecisionPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDecisionPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMsgTypeDecisionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMsgTypeDecisionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMsgTypeDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DecisionPolicy == nil {
+				m.DecisionPolicy = &types1.Any{}
+			}
+			if err := m.DecisionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMsgTypeDecisionPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMsgTypeDecisionPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMsgTypeDecisionPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposers = append(m.Proposers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types1.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exec", wireType)
+			}
+			m.Exec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exec |= Exec(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteAfter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3252,11 +5320,32 @@ func (m *MsgWithdrawFromTreasury) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ExecuteAfter == nil {
+				m.ExecuteAfter = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExecuteAfter, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteAfterHeight", wireType)
+			}
+			m.ExecuteAfterHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteAfterHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3278,7 +5367,7 @@ func (m *MsgWithdrawFromTreasury) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3301,49 +5390,17 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var msglen int
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3353,25 +5410,11 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3393,7 +5436,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3416,12 +5459,63 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3443,7 +5537,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawFromTreasuryResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3466,10 +5560,10 @@ func (m *MsgWithdrawFromTreasuryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawFromTreasuryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawFromTreasuryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3493,7 +5587,7 @@ func (m *MsgWithdrawFromTreasuryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateMembers) Unmarshal(dAtA []byte) error {
+func (m *MsgVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3516,15 +5610,34 @@ func (m *MsgUpdateMembers) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateMembers: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateMembers: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3552,13 +5665,32 @@ func (m *MsgUpdateMembers) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemberUpdates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3568,26 +5700,43 @@ func (m *MsgUpdateMembers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MemberUpdates = append(m.MemberUpdates, MemberRequest{})
-			if err := m.MemberUpdates[len(m.MemberUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exec", wireType)
+			}
+			m.Exec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exec |= Exec(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3609,7 +5758,7 @@ func (m *MsgUpdateMembers) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateMembersResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3632,10 +5781,10 @@ func (m *MsgUpdateMembersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateMembersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3659,7 +5808,7 @@ func (m *MsgUpdateMembersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateDecisionPolicy) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3682,15 +5831,15 @@ func (m *MsgUpdateDecisionPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateDecisionPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3718,13 +5867,13 @@ func (m *MsgUpdateDecisionPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DecisionPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3734,27 +5883,23 @@ func (m *MsgUpdateDecisionPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DecisionPolicy == nil {
-				m.DecisionPolicy = &types1.Any{}
-			}
-			if err := m.DecisionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3777,7 +5922,7 @@ func (m *MsgUpdateDecisionPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateDecisionPolicyResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateVoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3800,10 +5945,10 @@ func (m *MsgUpdateDecisionPolicyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateDecisionPolicyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateVoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateDecisionPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3827,7 +5972,7 @@ func (m *MsgUpdateDecisionPolicyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegateVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3850,47 +5995,15 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegateVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegateVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposers", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Proposers = append(m.Proposers, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3918,61 +6031,8 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Messages = append(m.Messages, &types1.Any{})
-			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exec", wireType)
-			}
-			m.Exec = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Exec |= Exec(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3994,7 +6054,7 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegateVoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4017,31 +6077,12 @@ func (m *MsgSubmitProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegateVoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
-			}
-			m.ProposalId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4063,7 +6104,7 @@ func (m *MsgSubmitProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawProposal) Unmarshal(dAtA []byte) error {
+func (m *MsgExec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4086,10 +6127,10 @@ func (m *MsgWithdrawProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4113,7 +6154,7 @@ func (m *MsgWithdrawProposal) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4141,7 +6182,7 @@ func (m *MsgWithdrawProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4164,7 +6205,7 @@ func (m *MsgWithdrawProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4187,10 +6228,10 @@ func (m *MsgWithdrawProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExecResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4214,7 +6255,7 @@ func (m *MsgWithdrawProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVote) Unmarshal(dAtA []byte) error {
+func (m *MsgLeaveFoundation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4237,85 +6278,15 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLeaveFoundation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLeaveFoundation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
-			}
-			m.ProposalId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
-			}
-			m.Option = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Option |= VoteOption(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4343,27 +6314,8 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exec", wireType)
-			}
-			m.Exec = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Exec |= Exec(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4385,7 +6337,7 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgLeaveFoundationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4408,10 +6360,10 @@ func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLeaveFoundationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLeaveFoundationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4435,7 +6387,7 @@ func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExec) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCensorship) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4458,17 +6410,17 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExec: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCensorship: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCensorship: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			m.ProposalId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4478,16 +6430,29 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Censorship", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4497,23 +6462,24 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			if err := m.Censorship.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4536,7 +6502,7 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCensorshipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4559,10 +6525,10 @@ func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCensorshipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCensorshipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4586,7 +6552,7 @@ func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLeaveFoundation) Unmarshal(dAtA []byte) error {
+func (m *MsgGrant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4609,15 +6575,15 @@ func (m *MsgLeaveFoundation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLeaveFoundation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrant: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLeaveFoundation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrant: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4645,7 +6611,75 @@ func (m *MsgLeaveFoundation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Authorization == nil {
+				m.Authorization = &types1.Any{}
+			}
+			if err := m.Authorization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4668,7 +6702,7 @@ func (m *MsgLeaveFoundation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLeaveFoundationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4691,10 +6725,10 @@ func (m *MsgLeaveFoundationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLeaveFoundationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLeaveFoundationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4718,7 +6752,7 @@ func (m *MsgLeaveFoundationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateCensorship) Unmarshal(dAtA []byte) error {
+func (m *MsgRevoke) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4741,10 +6775,10 @@ func (m *MsgUpdateCensorship) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateCensorship: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevoke: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateCensorship: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevoke: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4781,9 +6815,9 @@ func (m *MsgUpdateCensorship) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Censorship", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4793,24 +6827,55 @@ func (m *MsgUpdateCensorship) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Censorship.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4833,7 +6898,7 @@ func (m *MsgUpdateCensorship) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateCensorshipResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4856,10 +6921,10 @@ func (m *MsgUpdateCensorshipResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateCensorshipResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateCensorshipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4883,7 +6948,7 @@ func (m *MsgUpdateCensorshipResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGrant) Unmarshal(dAtA []byte) error {
+func (m *MsgExecAsAuthority) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4906,15 +6971,15 @@ func (m *MsgGrant) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGrant: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExecAsAuthority: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExecAsAuthority: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4942,43 +7007,11 @@ func (m *MsgGrant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.GroupPolicyAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5005,10 +7038,8 @@ func (m *MsgGrant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Authorization == nil {
-				m.Authorization = &types1.Any{}
-			}
-			if err := m.Authorization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Messages = append(m.Messages, &types1.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5033,7 +7064,7 @@ func (m *MsgGrant) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGrantResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExecAsAuthorityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5056,10 +7087,10 @@ func (m *MsgGrantResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGrantResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExecAsAuthorityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGrantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExecAsAuthorityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5083,7 +7114,7 @@ func (m *MsgGrantResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevoke) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateTreasuryOutflowLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5106,10 +7137,10 @@ func (m *MsgRevoke) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevoke: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateTreasuryOutflowLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevoke: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateTreasuryOutflowLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5146,9 +7177,9 @@ func (m *MsgRevoke) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5158,29 +7189,30 @@ func (m *MsgRevoke) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5190,24 +7222,12 @@ func (m *MsgRevoke) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Remove = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5229,7 +7249,7 @@ func (m *MsgRevoke) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateTreasuryOutflowLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5252,10 +7272,10 @@ func (m *MsgRevokeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateTreasuryOutflowLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateTreasuryOutflowLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
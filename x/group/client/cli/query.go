@@ -0,0 +1,556 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Finschia/finschia-sdk/client"
+	"github.com/Finschia/finschia-sdk/client/flags"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/group"
+)
+
+// NewQueryCmd returns the parent command for all x/group CLi query commands.
+func NewQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   group.ModuleName,
+		Short: "Querying commands for the group module",
+	}
+
+	cmd.AddCommand(
+		NewQueryCmdGroupInfo(),
+		NewQueryCmdGroupPolicyInfo(),
+		NewQueryCmdGroupMembers(),
+		NewQueryCmdGroupsByAdmin(),
+		NewQueryCmdGroupsByMember(),
+		NewQueryCmdGroupPoliciesByGroup(),
+		NewQueryCmdGroupPoliciesByAdmin(),
+		NewQueryCmdProposal(),
+		NewQueryCmdProposalsByGroupPolicy(),
+		NewQueryCmdVoteByProposalVoter(),
+		NewQueryCmdVotesByProposal(),
+		NewQueryCmdVotesByVoter(),
+		NewQueryCmdTallyResult(),
+	)
+
+	return cmd
+}
+
+// NewQueryCmdGroupInfo returns the command to query the information of a group.
+func NewQueryCmdGroupInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "group-info [group-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the information of a group",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := group.NewQueryClient(clientCtx)
+
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := group.QueryGroupInfoRequest{
+				GroupId: groupID,
+			}
+			res, err := queryClient.GroupInfo(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewQueryCmdGroupPolicyInfo returns the command to query the information of a group policy.
+func NewQueryCmdGroupPolicyInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "group-policy-info [group-policy-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the information of a group policy",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := group.NewQueryClient(clientCtx)
+
+			address := args[0]
+			if _, err := sdk.AccAddressFromBech32(address); err != nil {
+				return err
+			}
+
+			req := group.QueryGroupPolicyInfoRequest{
+				Address: address,
+			}
+			res, err := queryClient.GroupPolicyInfo(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewQueryCmdGroupMembers returns the command to query the members of a group.
+func NewQueryCmdGroupMembers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "group-members [group-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the members of a group",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := group.NewQueryClient(clientCtx)
+
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := group.QueryGroupMembersRequest{
+				GroupId:    groupID,
+				Pagination: pageReq,
+			}
+			res, err := queryClient.GroupMembers(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "group-members")
+
+	return cmd
+}
+
+// NewQueryCmdGroupsByAdmin returns the command to query the groups by the admin.
+func NewQueryCmdGroupsByAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "groups-by-admin [admin]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the groups by the admin",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := group.NewQueryClient(clientCtx)
+
+			admin := args[0]
+			if _, err := sdk.AccAddressFromBech32(admin); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := group.QueryGroupsByAdminRequest{
+				Admin:      admin,
+				Pagination: pageReq,
+			}
+			res, err := queryClient.GroupsByAdmin(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "groups-by-admin")
+
+	return cmd
+}
+
+// NewQueryCmdGroupsByMember returns the command to query the groups by a member.
+func NewQueryCmdGroupsByMember() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "groups-by-member [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the groups by a member",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := group.NewQueryClient(clientCtx)
+
+			address := args[0]
+			if _, err := sdk.AccAddressFromBech32(address); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := group.QueryGroupsByMemberRequest{
+				Address:    address,
+				Pagination: pageReq,
+			}
+			res, err := queryClient.GroupsByMember(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "groups-by-member")
+
+	return cmd
+}
+
+// NewQueryCmdGroupPoliciesByGroup returns the command to query the group policies of a group.
+func NewQueryCmdGroupPoliciesByGroup() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "group-policies-by-group [group-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the group policies of a group",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := group.NewQueryClient(clientCtx)
+
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := group.QueryGroupPoliciesByGroupRequest{
+				GroupId:    groupID,
+				Pagination: pageReq,
+			}
+			res, err := queryClient.GroupPoliciesByGroup(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "group-policies-by-group")
+
+	return cmd
+}
+
+// NewQueryCmdGroupPoliciesByAdmin returns the command to query the group policies by the admin.
+func NewQueryCmdGroupPoliciesByAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "group-policies-by-admin [admin]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the group policies by the admin",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := group.NewQueryClient(clientCtx)
+
+			admin := args[0]
+			if _, err := sdk.AccAddressFromBech32(admin); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := group.QueryGroupPoliciesByAdminRequest{
+				Admin:      admin,
+				Pagination: pageReq,
+			}
+			res, err := queryClient.GroupPoliciesByAdmin(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "group-policies-by-admin")
+
+	return cmd
+}
+
+// NewQueryCmdProposal returns the command to query a proposal.
+func NewQueryCmdProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposal [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a proposal",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := group.NewQueryClient(clientCtx)
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := group.QueryProposalRequest{
+				ProposalId: proposalID,
+			}
+			res, err := queryClient.Proposal(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewQueryCmdProposalsByGroupPolicy returns the command to query the proposals of a group policy.
+func NewQueryCmdProposalsByGroupPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposals-by-group-policy [group-policy-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the proposals of a group policy",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := group.NewQueryClient(clientCtx)
+
+			address := args[0]
+			if _, err := sdk.AccAddressFromBech32(address); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := group.QueryProposalsByGroupPolicyRequest{
+				Address:    address,
+				Pagination: pageReq,
+			}
+			res, err := queryClient.ProposalsByGroupPolicy(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "proposals-by-group-policy")
+
+	return cmd
+}
+
+// NewQueryCmdVoteByProposalVoter returns the command to query a vote on a proposal.
+func NewQueryCmdVoteByProposalVoter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote [proposal-id] [voter]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query a vote on a proposal",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := group.NewQueryClient(clientCtx)
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			voter := args[1]
+			if _, err := sdk.AccAddressFromBech32(voter); err != nil {
+				return err
+			}
+
+			req := group.QueryVoteByProposalVoterRequest{
+				ProposalId: proposalID,
+				Voter:      voter,
+			}
+			res, err := queryClient.VoteByProposalVoter(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewQueryCmdVotesByProposal returns the command to query the votes on a proposal.
+func NewQueryCmdVotesByProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "votes-by-proposal [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the votes on a proposal",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := group.NewQueryClient(clientCtx)
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := group.QueryVotesByProposalRequest{
+				ProposalId: proposalID,
+				Pagination: pageReq,
+			}
+			res, err := queryClient.VotesByProposal(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "votes-by-proposal")
+
+	return cmd
+}
+
+// NewQueryCmdVotesByVoter returns the command to query the votes by a voter.
+func NewQueryCmdVotesByVoter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "votes-by-voter [voter]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the votes by a voter",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := group.NewQueryClient(clientCtx)
+
+			voter := args[0]
+			if _, err := sdk.AccAddressFromBech32(voter); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := group.QueryVotesByVoterRequest{
+				Voter:      voter,
+				Pagination: pageReq,
+			}
+			res, err := queryClient.VotesByVoter(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "votes-by-voter")
+
+	return cmd
+}
+
+// NewQueryCmdTallyResult returns the command to query the tally result of a proposal.
+func NewQueryCmdTallyResult() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tally [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the tally result of a proposal",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := group.NewQueryClient(clientCtx)
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := group.QueryTallyResultRequest{
+				ProposalId: proposalID,
+			}
+			res, err := queryClient.TallyResult(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
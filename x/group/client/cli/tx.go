@@ -0,0 +1,764 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Finschia/finschia-sdk/client"
+	"github.com/Finschia/finschia-sdk/client/flags"
+	"github.com/Finschia/finschia-sdk/client/tx"
+	"github.com/Finschia/finschia-sdk/codec"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/group"
+)
+
+// Proposal flags
+const (
+	FlagExec = "exec"
+	ExecTry  = "try"
+)
+
+func parseMemberRequests(codec codec.Codec, membersJSON string) ([]group.MemberRequest, error) {
+	var cliMembers []json.RawMessage
+	if err := json.Unmarshal([]byte(membersJSON), &cliMembers); err != nil {
+		return nil, err
+	}
+
+	members := make([]group.MemberRequest, len(cliMembers))
+	for i, cliMember := range cliMembers {
+		var member group.MemberRequest
+		if err := codec.UnmarshalJSON(cliMember, &member); err != nil {
+			return nil, err
+		}
+		members[i] = member
+	}
+
+	return members, nil
+}
+
+func parseAddresses(addressesJSON string) ([]string, error) {
+	var addresses []string
+	if err := json.Unmarshal([]byte(addressesJSON), &addresses); err != nil {
+		return nil, err
+	}
+	if len(addresses) == 0 {
+		return nil, fmt.Errorf("you must provide one address at least")
+	}
+
+	return addresses, nil
+}
+
+func parseDecisionPolicy(codec codec.Codec, policyJSON string) (group.DecisionPolicy, error) {
+	var policy group.DecisionPolicy
+	if err := codec.UnmarshalInterfaceJSON([]byte(policyJSON), &policy); err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+func parseMsgs(cdc codec.Codec, msgsJSON string) ([]sdk.Msg, error) {
+	var cliMsgs []json.RawMessage
+	if err := json.Unmarshal([]byte(msgsJSON), &cliMsgs); err != nil {
+		return nil, err
+	}
+
+	msgs := make([]sdk.Msg, len(cliMsgs))
+	for i, anyJSON := range cliMsgs {
+		var msg sdk.Msg
+		err := cdc.UnmarshalInterfaceJSON(anyJSON, &msg)
+		if err != nil {
+			return nil, err
+		}
+
+		msgs[i] = msg
+	}
+
+	return msgs, nil
+}
+
+func execFromString(execStr string) group.Exec {
+	exec := group.Exec_EXEC_UNSPECIFIED
+	if execStr == ExecTry {
+		exec = group.Exec_EXEC_TRY
+	}
+	return exec
+}
+
+func getEnumPrefix(str string) string {
+	delimiter := "_"
+	splitted := strings.Split(str, delimiter)
+	return strings.Join(splitted[:len(splitted)-1], delimiter) + delimiter
+}
+
+// voteOptionFromString returns a VoteOption from a string, which may omit the enum prefix.
+func voteOptionFromString(str string) (group.VoteOption, error) {
+	prefix := getEnumPrefix(group.VoteOption_name[0])
+	candidate := strings.ToUpper(str)
+	if !strings.HasPrefix(candidate, prefix) {
+		candidate = prefix + candidate
+	}
+
+	vo, ok := group.VoteOption_value[candidate]
+	if !ok {
+		return group.VOTE_OPTION_UNSPECIFIED, fmt.Errorf("'%s' is not a valid vote option", str)
+	}
+	return group.VoteOption(vo), nil
+}
+
+func parseGroupID(str string) (uint64, error) {
+	return strconv.ParseUint(str, 10, 64)
+}
+
+// NewTxCmd returns the transaction commands for this module
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        group.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", group.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewTxCmdCreateGroup(),
+		NewTxCmdUpdateGroupMembers(),
+		NewTxCmdUpdateGroupAdmin(),
+		NewTxCmdUpdateGroupMetadata(),
+		NewTxCmdCreateGroupPolicy(),
+		NewTxCmdUpdateGroupPolicyAdmin(),
+		NewTxCmdUpdateGroupPolicyDecisionPolicy(),
+		NewTxCmdUpdateGroupPolicyMetadata(),
+		NewTxCmdSubmitProposal(),
+		NewTxCmdWithdrawProposal(),
+		NewTxCmdVote(),
+		NewTxCmdExec(),
+		NewTxCmdLeaveGroup(),
+	)
+
+	return txCmd
+}
+
+func NewTxCmdCreateGroup() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-group [admin] [metadata] [members-json]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Create a group",
+		Long: `Create a group with the admin and the initial members.
+
+Example of the content of members-json:
+
+[
+  {
+    "address": "addr1",
+    "weight": "1",
+    "metadata": "some metadata"
+  },
+  {
+    "address": "addr2",
+    "weight": "1"
+  }
+]
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			admin := args[0]
+			if err := cmd.Flags().Set(flags.FlagFrom, admin); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			members, err := parseMemberRequests(clientCtx.Codec, args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := group.MsgCreateGroup{
+				Admin:    admin,
+				Members:  members,
+				Metadata: args[1],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdUpdateGroupMembers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-members [admin] [group-id] [members-json]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Update the members of a group",
+		Long: `Update the members of a group
+
+Example of the content of members-json:
+
+[
+  {
+    "address": "addr1",
+    "weight": "1",
+    "metadata": "some new metadata"
+  },
+  {
+    "address": "addr2",
+    "remove": true
+  }
+]
+
+Set a member's remove to true to delete it.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			admin := args[0]
+			if err := cmd.Flags().Set(flags.FlagFrom, admin); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := parseGroupID(args[1])
+			if err != nil {
+				return err
+			}
+
+			updates, err := parseMemberRequests(clientCtx.Codec, args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := group.MsgUpdateGroupMembers{
+				Admin:         admin,
+				GroupId:       groupID,
+				MemberUpdates: updates,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdUpdateGroupAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-admin [admin] [group-id] [new-admin]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Update the admin of a group",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			admin := args[0]
+			if err := cmd.Flags().Set(flags.FlagFrom, admin); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := parseGroupID(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := group.MsgUpdateGroupAdmin{
+				Admin:    admin,
+				GroupId:  groupID,
+				NewAdmin: args[2],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdUpdateGroupMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-metadata [admin] [group-id] [metadata]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Update the metadata of a group",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			admin := args[0]
+			if err := cmd.Flags().Set(flags.FlagFrom, admin); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := parseGroupID(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := group.MsgUpdateGroupMetadata{
+				Admin:    admin,
+				GroupId:  groupID,
+				Metadata: args[2],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdCreateGroupPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-group-policy [admin] [group-id] [metadata] [policy-json]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Create a group policy account",
+		Long: `Create a group policy account with the decision policy.
+
+Example of the content of policy-json:
+
+{
+  "@type": "/lbm.group.v1.ThresholdDecisionPolicy",
+  "threshold": "4",
+  "windows": {
+    "voting_period": "24h",
+    "min_execution_period": "0s"
+  }
+}
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			admin := args[0]
+			if err := cmd.Flags().Set(flags.FlagFrom, admin); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := parseGroupID(args[1])
+			if err != nil {
+				return err
+			}
+
+			policy, err := parseDecisionPolicy(clientCtx.Codec, args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := group.MsgCreateGroupPolicy{
+				Admin:    admin,
+				GroupId:  groupID,
+				Metadata: args[2],
+			}
+			if err := msg.SetDecisionPolicy(policy); err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdUpdateGroupPolicyAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-policy-admin [admin] [group-policy-address] [new-admin]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Update the admin of a group policy",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			admin := args[0]
+			if err := cmd.Flags().Set(flags.FlagFrom, admin); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := group.MsgUpdateGroupPolicyAdmin{
+				Admin:              admin,
+				GroupPolicyAddress: args[1],
+				NewAdmin:           args[2],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdUpdateGroupPolicyDecisionPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-policy-decision-policy [admin] [group-policy-address] [policy-json]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Update the decision policy of a group policy",
+		Long: `Update the decision policy of a group policy.
+
+Example of the content of policy-json:
+
+{
+  "@type": "/lbm.group.v1.PercentageDecisionPolicy",
+  "percentage": "0.5",
+  "windows": {
+    "voting_period": "24h",
+    "min_execution_period": "0s"
+  }
+}
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			admin := args[0]
+			if err := cmd.Flags().Set(flags.FlagFrom, admin); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			policy, err := parseDecisionPolicy(clientCtx.Codec, args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := group.MsgUpdateGroupPolicyDecisionPolicy{
+				Admin:              admin,
+				GroupPolicyAddress: args[1],
+			}
+			if err := msg.SetDecisionPolicy(policy); err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdUpdateGroupPolicyMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-policy-metadata [admin] [group-policy-address] [metadata]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Update the metadata of a group policy",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			admin := args[0]
+			if err := cmd.Flags().Set(flags.FlagFrom, admin); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := group.MsgUpdateGroupPolicyMetadata{
+				Admin:              admin,
+				GroupPolicyAddress: args[1],
+				Metadata:           args[2],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdSubmitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-proposal [group-policy-address] [metadata] [proposers-json] [messages-json]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Submit a new proposal",
+		Long: `Submit a new proposal
+
+Parameters:
+    group-policy-address: address of the group policy account.
+    metadata: metadata of the proposal.
+    proposers-json: the addresses of the proposers in json format.
+    messages-json: messages in json format that will be executed if the proposal is accepted.
+
+Example of the content of proposers-json:
+
+[
+  "addr1",
+  "addr2"
+]
+
+Example of the content of messages-json:
+
+[
+  {
+    "@type": "/cosmos.bank.v1beta1.MsgSend",
+    "from_address": "group-policy-address",
+    "to_address": "addr2",
+    "amount": [{"denom": "stake", "amount": "10000"}]
+  }
+]
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			proposers, err := parseAddresses(args[2])
+			if err != nil {
+				return err
+			}
+
+			signer := proposers[0]
+			if err := cmd.Flags().Set(flags.FlagFrom, signer); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			messages, err := parseMsgs(clientCtx.Codec, args[3])
+			if err != nil {
+				return err
+			}
+
+			execStr, err := cmd.Flags().GetString(FlagExec)
+			if err != nil {
+				return err
+			}
+			exec := execFromString(execStr)
+
+			msg := group.MsgSubmitProposal{
+				GroupPolicyAddress: args[0],
+				Proposers:          proposers,
+				Metadata:           args[1],
+				Exec:               exec,
+			}
+			if err := msg.SetMsgs(messages); err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagExec, "", "Set to 'try' to try to execute proposal immediately after creation (proposers signatures are considered as Yes votes)")
+
+	return cmd
+}
+
+func NewTxCmdWithdrawProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-proposal [proposal-id] [address]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Withdraw a submitted proposal",
+		Long: `Withdraw a submitted proposal.
+
+Parameters:
+    proposal-id: unique ID of the proposal.
+    address: one of the proposer of the proposal, or the admin of the group policy.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			address := args[1]
+			if err := cmd.Flags().Set(flags.FlagFrom, address); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := group.MsgWithdrawProposal{
+				ProposalId: proposalID,
+				Address:    address,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote [proposal-id] [voter] [option] [metadata]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Vote on a proposal",
+		Long: `Vote on a proposal.
+
+Parameters:
+    proposal-id: unique ID of the proposal
+    voter: voter account addresses.
+    vote-option: choice of the voter(s)
+        VOTE_OPTION_UNSPECIFIED: no-op
+        VOTE_OPTION_NO: no
+        VOTE_OPTION_YES: yes
+        VOTE_OPTION_ABSTAIN: abstain
+        VOTE_OPTION_NO_WITH_VETO: no-with-veto
+    metadata: metadata for the vote
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			voter := args[1]
+			if err := cmd.Flags().Set(flags.FlagFrom, voter); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			option, err := voteOptionFromString(args[2])
+			if err != nil {
+				return err
+			}
+
+			execStr, err := cmd.Flags().GetString(FlagExec)
+			if err != nil {
+				return err
+			}
+			exec := execFromString(execStr)
+
+			msg := group.MsgVote{
+				ProposalId: proposalID,
+				Voter:      voter,
+				Option:     option,
+				Metadata:   args[3],
+				Exec:       exec,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagExec, "", "Set to 'try' to try to execute proposal immediately after voting")
+
+	return cmd
+}
+
+func NewTxCmdExec() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec [proposal-id] [executor]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Execute a proposal",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			executor := args[1]
+			if err := cmd.Flags().Set(flags.FlagFrom, executor); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := group.MsgExec{
+				ProposalId: proposalID,
+				Executor:   executor,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdLeaveGroup() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "leave-group [address] [group-id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Leave a group",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			address := args[0]
+			if err := cmd.Flags().Set(flags.FlagFrom, address); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := parseGroupID(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := group.MsgLeaveGroup{
+				Address: address,
+				GroupId: groupID,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,687 @@
+package testutil
+
+import (
+	"fmt"
+
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/Finschia/finschia-sdk/client/flags"
+	clitestutil "github.com/Finschia/finschia-sdk/testutil/cli"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/group"
+	"github.com/Finschia/finschia-sdk/x/group/client/cli"
+)
+
+func (s *IntegrationTestSuite) TestNewQueryCmdGroupInfo() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, s.setupHeight),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid query": {
+			[]string{
+				fmt.Sprint(s.groupID),
+			},
+			true,
+		},
+		"wrong number of args": {
+			[]string{
+				fmt.Sprint(s.groupID),
+				"extra",
+			},
+			false,
+		},
+		"invalid id": {
+			[]string{
+				"-1",
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewQueryCmdGroupInfo()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var actual group.QueryGroupInfoResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &actual), out.String())
+			s.Require().Equal(s.admin.String(), actual.Info.Admin)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewQueryCmdGroupPolicyInfo() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, s.setupHeight),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid query": {
+			[]string{
+				s.groupPolicy.String(),
+			},
+			true,
+		},
+		"wrong number of args": {
+			[]string{
+				s.groupPolicy.String(),
+				"extra",
+			},
+			false,
+		},
+		"invalid address": {
+			[]string{
+				"invalid",
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewQueryCmdGroupPolicyInfo()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var actual group.QueryGroupPolicyInfoResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &actual), out.String())
+			s.Require().Equal(s.groupPolicy.String(), actual.Info.Address)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewQueryCmdGroupMembers() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, s.setupHeight),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid query": {
+			[]string{
+				fmt.Sprint(s.groupID),
+			},
+			true,
+		},
+		"wrong number of args": {
+			[]string{
+				fmt.Sprint(s.groupID),
+				"extra",
+			},
+			false,
+		},
+		"invalid id": {
+			[]string{
+				"-1",
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewQueryCmdGroupMembers()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var actual group.QueryGroupMembersResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &actual), out.String())
+			s.Require().Len(actual.Members, 1)
+			s.Require().Equal(s.member.String(), actual.Members[0].Member.Address)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewQueryCmdGroupsByAdmin() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, s.setupHeight),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid query": {
+			[]string{
+				s.admin.String(),
+			},
+			true,
+		},
+		"wrong number of args": {
+			[]string{
+				s.admin.String(),
+				"extra",
+			},
+			false,
+		},
+		"invalid address": {
+			[]string{
+				"invalid",
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewQueryCmdGroupsByAdmin()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var actual group.QueryGroupsByAdminResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &actual), out.String())
+			s.Require().Len(actual.Groups, 1)
+			s.Require().Equal(s.groupID, actual.Groups[0].Id)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewQueryCmdGroupsByMember() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, s.setupHeight),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid query": {
+			[]string{
+				s.member.String(),
+			},
+			true,
+		},
+		"wrong number of args": {
+			[]string{
+				s.member.String(),
+				"extra",
+			},
+			false,
+		},
+		"invalid address": {
+			[]string{
+				"invalid",
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewQueryCmdGroupsByMember()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var actual group.QueryGroupsByMemberResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &actual), out.String())
+			s.Require().Len(actual.Groups, 1)
+			s.Require().Equal(s.groupID, actual.Groups[0].Id)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewQueryCmdGroupPoliciesByGroup() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, s.setupHeight),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid query": {
+			[]string{
+				fmt.Sprint(s.groupID),
+			},
+			true,
+		},
+		"wrong number of args": {
+			[]string{
+				fmt.Sprint(s.groupID),
+				"extra",
+			},
+			false,
+		},
+		"invalid id": {
+			[]string{
+				"-1",
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewQueryCmdGroupPoliciesByGroup()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var actual group.QueryGroupPoliciesByGroupResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &actual), out.String())
+			s.Require().Len(actual.GroupPolicies, 1)
+			s.Require().Equal(s.groupPolicy.String(), actual.GroupPolicies[0].Address)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewQueryCmdGroupPoliciesByAdmin() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, s.setupHeight),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid query": {
+			[]string{
+				s.admin.String(),
+			},
+			true,
+		},
+		"wrong number of args": {
+			[]string{
+				s.admin.String(),
+				"extra",
+			},
+			false,
+		},
+		"invalid address": {
+			[]string{
+				"invalid",
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewQueryCmdGroupPoliciesByAdmin()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var actual group.QueryGroupPoliciesByAdminResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &actual), out.String())
+			s.Require().Len(actual.GroupPolicies, 1)
+			s.Require().Equal(s.groupPolicy.String(), actual.GroupPolicies[0].Address)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewQueryCmdProposal() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, s.setupHeight),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid query": {
+			[]string{
+				fmt.Sprint(s.proposalID),
+			},
+			true,
+		},
+		"wrong number of args": {
+			[]string{
+				fmt.Sprint(s.proposalID),
+				"extra",
+			},
+			false,
+		},
+		"invalid id": {
+			[]string{
+				"-1",
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewQueryCmdProposal()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var actual group.QueryProposalResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &actual), out.String())
+			s.Require().Equal(s.proposalID, actual.Proposal.Id)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewQueryCmdProposalsByGroupPolicy() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, s.setupHeight),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid query": {
+			[]string{
+				s.groupPolicy.String(),
+			},
+			true,
+		},
+		"wrong number of args": {
+			[]string{
+				s.groupPolicy.String(),
+				"extra",
+			},
+			false,
+		},
+		"invalid address": {
+			[]string{
+				"invalid",
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewQueryCmdProposalsByGroupPolicy()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var actual group.QueryProposalsByGroupPolicyResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &actual), out.String())
+			s.Require().Len(actual.Proposals, 1)
+			s.Require().Equal(s.proposalID, actual.Proposals[0].Id)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewQueryCmdVoteByProposalVoter() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, s.setupHeight),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid query": {
+			[]string{
+				fmt.Sprint(s.proposalID),
+				s.member.String(),
+			},
+			true,
+		},
+		"wrong number of args": {
+			[]string{
+				fmt.Sprint(s.proposalID),
+				s.member.String(),
+				"extra",
+			},
+			false,
+		},
+		"invalid voter": {
+			[]string{
+				fmt.Sprint(s.proposalID),
+				"invalid",
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewQueryCmdVoteByProposalVoter()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var actual group.QueryVoteByProposalVoterResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &actual), out.String())
+			s.Require().Equal(group.VOTE_OPTION_YES, actual.Vote.Option)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewQueryCmdVotesByProposal() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, s.setupHeight),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid query": {
+			[]string{
+				fmt.Sprint(s.proposalID),
+			},
+			true,
+		},
+		"wrong number of args": {
+			[]string{
+				fmt.Sprint(s.proposalID),
+				"extra",
+			},
+			false,
+		},
+		"invalid id": {
+			[]string{
+				"-1",
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewQueryCmdVotesByProposal()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var actual group.QueryVotesByProposalResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &actual), out.String())
+			s.Require().Len(actual.Votes, 1)
+			s.Require().Equal(s.member.String(), actual.Votes[0].Voter)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewQueryCmdVotesByVoter() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, s.setupHeight),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid query": {
+			[]string{
+				s.member.String(),
+			},
+			true,
+		},
+		"wrong number of args": {
+			[]string{
+				s.member.String(),
+				"extra",
+			},
+			false,
+		},
+		"invalid address": {
+			[]string{
+				"invalid",
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewQueryCmdVotesByVoter()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var actual group.QueryVotesByVoterResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &actual), out.String())
+			s.Require().Len(actual.Votes, 1)
+			s.Require().Equal(s.proposalID, actual.Votes[0].ProposalId)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewQueryCmdTallyResult() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, s.setupHeight),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid query": {
+			[]string{
+				fmt.Sprint(s.proposalID),
+			},
+			true,
+		},
+		"wrong number of args": {
+			[]string{
+				fmt.Sprint(s.proposalID),
+				"extra",
+			},
+			false,
+		},
+		"invalid id": {
+			[]string{
+				"-1",
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewQueryCmdTallyResult()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var actual group.QueryTallyResultResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &actual), out.String())
+			s.Require().Equal(sdk.OneDec(), actual.Tally.YesCount)
+		})
+	}
+}
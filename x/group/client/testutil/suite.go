@@ -0,0 +1,246 @@
+package testutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/Finschia/finschia-sdk/client/flags"
+	"github.com/Finschia/finschia-sdk/crypto/hd"
+	"github.com/Finschia/finschia-sdk/crypto/keyring"
+	clitestutil "github.com/Finschia/finschia-sdk/testutil/cli"
+	"github.com/Finschia/finschia-sdk/testutil/network"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	bankcli "github.com/Finschia/finschia-sdk/x/bank/client/cli"
+	banktypes "github.com/Finschia/finschia-sdk/x/bank/types"
+	"github.com/Finschia/finschia-sdk/x/group"
+	"github.com/Finschia/finschia-sdk/x/group/client/cli"
+)
+
+type IntegrationTestSuite struct {
+	suite.Suite
+
+	cfg     network.Config
+	network *network.Network
+
+	setupHeight int64
+
+	admin    sdk.AccAddress
+	member   sdk.AccAddress
+	stranger sdk.AccAddress
+
+	groupID     uint64
+	groupPolicy sdk.AccAddress
+	proposalID  uint64
+}
+
+var commonArgs = []string{
+	fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+	fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+	fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))).String()),
+}
+
+func NewIntegrationTestSuite(cfg network.Config) *IntegrationTestSuite {
+	return &IntegrationTestSuite{cfg: cfg}
+}
+
+func (s *IntegrationTestSuite) SetupSuite() {
+	s.T().Log("setting up integration test suite")
+
+	s.network = network.New(s.T(), s.cfg)
+	_, err := s.network.WaitForHeight(1)
+	s.Require().NoError(err)
+
+	s.admin = s.createAccount("admin")
+	s.member = s.createAccount("member")
+	s.stranger = s.createAccount("stranger")
+
+	// admin creates a group of the member, and its group policy
+	s.groupID = s.createGroup()
+	s.groupPolicy = s.createGroupPolicy()
+	s.fundAccount(s.groupPolicy, sdk.NewInt(1000))
+
+	// member submits a proposal, and votes yes on it
+	s.proposalID = s.submitProposal(&banktypes.MsgSend{
+		FromAddress: s.groupPolicy.String(),
+		ToAddress:   s.stranger.String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.OneInt())),
+	})
+	s.vote(s.proposalID, s.member)
+
+	s.setupHeight, err = s.network.LatestHeight()
+	s.Require().NoError(err)
+	s.Require().NoError(s.network.WaitForNextBlock())
+}
+
+func (s *IntegrationTestSuite) TearDownSuite() {
+	s.T().Log("tearing down integration test suite")
+	s.network.Cleanup()
+}
+
+func (s *IntegrationTestSuite) createGroup() uint64 {
+	args := append([]string{
+		s.admin.String(),
+		"test group",
+		s.membersToString(s.member),
+	}, commonArgs...)
+
+	res := s.execTx(cli.NewTxCmdCreateGroup(), args)
+
+	var event group.EventCreateGroup
+	s.pickEvent(res.Events, &event, func(e proto.Message) {
+		event = *e.(*group.EventCreateGroup)
+	})
+	return event.GroupId
+}
+
+func (s *IntegrationTestSuite) createGroupPolicy() sdk.AccAddress {
+	args := append([]string{
+		s.admin.String(),
+		fmt.Sprint(s.groupID),
+		"test group policy",
+		s.policyToString(workingPolicy()),
+	}, commonArgs...)
+
+	res := s.execTx(cli.NewTxCmdCreateGroupPolicy(), args)
+
+	var event group.EventCreateGroupPolicy
+	s.pickEvent(res.Events, &event, func(e proto.Message) {
+		event = *e.(*group.EventCreateGroupPolicy)
+	})
+	return sdk.MustAccAddressFromBech32(event.Address)
+}
+
+func (s *IntegrationTestSuite) submitProposal(msg sdk.Msg) uint64 {
+	proposers := []string{s.member.String()}
+	proposersBz, err := json.Marshal(&proposers)
+	s.Require().NoError(err)
+
+	args := append([]string{
+		s.groupPolicy.String(),
+		"test proposal",
+		string(proposersBz),
+		s.msgToString(msg),
+	}, commonArgs...)
+
+	res := s.execTx(cli.NewTxCmdSubmitProposal(), args)
+
+	var event group.EventSubmitProposal
+	s.pickEvent(res.Events, &event, func(e proto.Message) {
+		event = *e.(*group.EventSubmitProposal)
+	})
+	return event.ProposalId
+}
+
+func (s *IntegrationTestSuite) vote(proposalID uint64, voter sdk.AccAddress) {
+	args := append([]string{
+		fmt.Sprint(proposalID),
+		voter.String(),
+		group.VOTE_OPTION_YES.String(),
+		"test vote",
+	}, commonArgs...)
+
+	s.execTx(cli.NewTxCmdVote(), args)
+}
+
+// workingPolicy returns a decision policy which any single vote of the
+// member would pass.
+func workingPolicy() group.DecisionPolicy {
+	return &group.ThresholdDecisionPolicy{
+		Threshold: sdk.OneDec(),
+		Windows: &group.DecisionPolicyWindows{
+			VotingPeriod: 24 * time.Hour,
+		},
+	}
+}
+
+func (s *IntegrationTestSuite) membersToString(members ...sdk.AccAddress) string {
+	requests := make([]json.RawMessage, len(members))
+	for i, member := range members {
+		bz, err := s.cfg.Codec.MarshalJSON(&group.MemberRequest{
+			Address: member.String(),
+			Weight:  sdk.OneDec(),
+		})
+		s.Require().NoError(err)
+		requests[i] = bz
+	}
+
+	bz, err := json.Marshal(requests)
+	s.Require().NoError(err)
+
+	return string(bz)
+}
+
+func (s *IntegrationTestSuite) policyToString(policy group.DecisionPolicy) string {
+	bz, err := s.cfg.Codec.MarshalInterfaceJSON(policy)
+	s.Require().NoError(err)
+
+	return string(bz)
+}
+
+func (s *IntegrationTestSuite) msgToString(msg sdk.Msg) string {
+	anyJSON, err := s.cfg.Codec.MarshalInterfaceJSON(msg)
+	s.Require().NoError(err)
+
+	cliMsgs := []json.RawMessage{anyJSON}
+	msgsBz, err := json.Marshal(cliMsgs)
+	s.Require().NoError(err)
+
+	return string(msgsBz)
+}
+
+// execTx broadcasts the transaction of the command, which must succeed.
+func (s *IntegrationTestSuite) execTx(cmd *cobra.Command, args []string) sdk.TxResponse {
+	val := s.network.Validators[0]
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, args)
+	s.Require().NoError(err)
+
+	var res sdk.TxResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+	s.Require().EqualValues(0, res.Code, out.String())
+
+	return res
+}
+
+// creates an account and send some coins to it for the future transactions.
+func (s *IntegrationTestSuite) createAccount(uid string) sdk.AccAddress {
+	val := s.network.Validators[0]
+	keyInfo, _, err := val.ClientCtx.Keyring.NewMnemonic(uid, keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	s.Require().NoError(err)
+	addr := keyInfo.GetAddress()
+
+	s.fundAccount(addr, sdk.NewInt(1000))
+
+	return addr
+}
+
+func (s *IntegrationTestSuite) fundAccount(addr sdk.AccAddress, amount sdk.Int) {
+	val := s.network.Validators[0]
+	args := append([]string{
+		val.Address.String(),
+		addr.String(),
+		sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, amount)).String(),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+	}, commonArgs...)
+
+	s.execTx(bankcli.NewSendTxCmd(), args)
+}
+
+func (s *IntegrationTestSuite) pickEvent(events []abci.Event, event proto.Message, fn func(event proto.Message)) {
+	for _, e := range events {
+		if e.Type == proto.MessageName(event) {
+			msg, err := sdk.ParseTypedEvent(e)
+			s.Require().NoError(err)
+
+			fn(msg)
+			return
+		}
+	}
+
+	s.Require().Failf("event not found", "%s", events)
+}
@@ -0,0 +1,734 @@
+package testutil
+
+import (
+	"fmt"
+
+	"github.com/Finschia/finschia-sdk/client/flags"
+	clitestutil "github.com/Finschia/finschia-sdk/testutil/cli"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	txtypes "github.com/Finschia/finschia-sdk/types/tx"
+	banktypes "github.com/Finschia/finschia-sdk/x/bank/types"
+	"github.com/Finschia/finschia-sdk/x/group"
+	"github.com/Finschia/finschia-sdk/x/group/client/cli"
+)
+
+func (s *IntegrationTestSuite) TestNewTxCmdCreateGroup() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s", flags.FlagGenerateOnly),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				s.admin.String(),
+				"test group",
+				s.membersToString(s.member),
+			},
+			true,
+		},
+		"wrong number of args": {
+			[]string{
+				s.admin.String(),
+				"test group",
+				s.membersToString(s.member),
+				"extra",
+			},
+			false,
+		},
+		"invalid members": {
+			[]string{
+				s.admin.String(),
+				"test group",
+				"invalid",
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdCreateGroup()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res txtypes.Tx
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewTxCmdUpdateGroupMembers() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s", flags.FlagGenerateOnly),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				s.admin.String(),
+				fmt.Sprint(s.groupID),
+				s.membersToString(s.stranger),
+			},
+			true,
+		},
+		"wrong number of args": {
+			[]string{
+				s.admin.String(),
+				fmt.Sprint(s.groupID),
+				s.membersToString(s.stranger),
+				"extra",
+			},
+			false,
+		},
+		"invalid members": {
+			[]string{
+				s.admin.String(),
+				fmt.Sprint(s.groupID),
+				"invalid",
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdUpdateGroupMembers()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res txtypes.Tx
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewTxCmdUpdateGroupAdmin() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s", flags.FlagGenerateOnly),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				s.admin.String(),
+				fmt.Sprint(s.groupID),
+				s.stranger.String(),
+			},
+			true,
+		},
+		"wrong number of args": {
+			[]string{
+				s.admin.String(),
+				fmt.Sprint(s.groupID),
+				s.stranger.String(),
+				"extra",
+			},
+			false,
+		},
+		"invalid id": {
+			[]string{
+				s.admin.String(),
+				"-1",
+				s.stranger.String(),
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdUpdateGroupAdmin()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res txtypes.Tx
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewTxCmdUpdateGroupMetadata() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s", flags.FlagGenerateOnly),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				s.admin.String(),
+				fmt.Sprint(s.groupID),
+				"new metadata",
+			},
+			true,
+		},
+		"wrong number of args": {
+			[]string{
+				s.admin.String(),
+				fmt.Sprint(s.groupID),
+				"new metadata",
+				"extra",
+			},
+			false,
+		},
+		"invalid id": {
+			[]string{
+				s.admin.String(),
+				"-1",
+				"new metadata",
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdUpdateGroupMetadata()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res txtypes.Tx
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewTxCmdCreateGroupPolicy() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s", flags.FlagGenerateOnly),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				s.admin.String(),
+				fmt.Sprint(s.groupID),
+				"test group policy",
+				s.policyToString(workingPolicy()),
+			},
+			true,
+		},
+		"wrong number of args": {
+			[]string{
+				s.admin.String(),
+				fmt.Sprint(s.groupID),
+				"test group policy",
+				s.policyToString(workingPolicy()),
+				"extra",
+			},
+			false,
+		},
+		"invalid policy": {
+			[]string{
+				s.admin.String(),
+				fmt.Sprint(s.groupID),
+				"test group policy",
+				"invalid",
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdCreateGroupPolicy()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res txtypes.Tx
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewTxCmdUpdateGroupPolicyAdmin() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s", flags.FlagGenerateOnly),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				s.admin.String(),
+				s.groupPolicy.String(),
+				s.stranger.String(),
+			},
+			true,
+		},
+		"wrong number of args": {
+			[]string{
+				s.admin.String(),
+				s.groupPolicy.String(),
+				s.stranger.String(),
+				"extra",
+			},
+			false,
+		},
+		"invalid new admin": {
+			[]string{
+				s.admin.String(),
+				s.groupPolicy.String(),
+				"invalid",
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdUpdateGroupPolicyAdmin()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res txtypes.Tx
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewTxCmdUpdateGroupPolicyDecisionPolicy() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s", flags.FlagGenerateOnly),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				s.admin.String(),
+				s.groupPolicy.String(),
+				s.policyToString(workingPolicy()),
+			},
+			true,
+		},
+		"wrong number of args": {
+			[]string{
+				s.admin.String(),
+				s.groupPolicy.String(),
+				s.policyToString(workingPolicy()),
+				"extra",
+			},
+			false,
+		},
+		"invalid policy": {
+			[]string{
+				s.admin.String(),
+				s.groupPolicy.String(),
+				"invalid",
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdUpdateGroupPolicyDecisionPolicy()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res txtypes.Tx
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewTxCmdUpdateGroupPolicyMetadata() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s", flags.FlagGenerateOnly),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				s.admin.String(),
+				s.groupPolicy.String(),
+				"new metadata",
+			},
+			true,
+		},
+		"wrong number of args": {
+			[]string{
+				s.admin.String(),
+				s.groupPolicy.String(),
+				"new metadata",
+				"extra",
+			},
+			false,
+		},
+		"invalid group policy": {
+			[]string{
+				s.admin.String(),
+				"invalid",
+				"new metadata",
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdUpdateGroupPolicyMetadata()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res txtypes.Tx
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewTxCmdSubmitProposal() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s", flags.FlagGenerateOnly),
+	}
+
+	msg := &banktypes.MsgSend{
+		FromAddress: s.groupPolicy.String(),
+		ToAddress:   s.stranger.String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.OneInt())),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				s.groupPolicy.String(),
+				"test proposal",
+				fmt.Sprintf("[%q]", s.member.String()),
+				s.msgToString(msg),
+			},
+			true,
+		},
+		"wrong number of args": {
+			[]string{
+				s.groupPolicy.String(),
+				"test proposal",
+				fmt.Sprintf("[%q]", s.member.String()),
+				s.msgToString(msg),
+				"extra",
+			},
+			false,
+		},
+		"invalid msgs": {
+			[]string{
+				s.groupPolicy.String(),
+				"test proposal",
+				fmt.Sprintf("[%q]", s.member.String()),
+				"invalid",
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdSubmitProposal()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res txtypes.Tx
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewTxCmdWithdrawProposal() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s", flags.FlagGenerateOnly),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				fmt.Sprint(s.proposalID),
+				s.member.String(),
+			},
+			true,
+		},
+		"wrong number of args": {
+			[]string{
+				fmt.Sprint(s.proposalID),
+				s.member.String(),
+				"extra",
+			},
+			false,
+		},
+		"invalid id": {
+			[]string{
+				"-1",
+				s.member.String(),
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdWithdrawProposal()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res txtypes.Tx
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewTxCmdVote() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s", flags.FlagGenerateOnly),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				fmt.Sprint(s.proposalID),
+				s.member.String(),
+				group.VOTE_OPTION_YES.String(),
+				"test vote",
+			},
+			true,
+		},
+		"wrong number of args": {
+			[]string{
+				fmt.Sprint(s.proposalID),
+				s.member.String(),
+				group.VOTE_OPTION_YES.String(),
+				"test vote",
+				"extra",
+			},
+			false,
+		},
+		"invalid option": {
+			[]string{
+				fmt.Sprint(s.proposalID),
+				s.member.String(),
+				"invalid",
+				"test vote",
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdVote()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res txtypes.Tx
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewTxCmdExec() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s", flags.FlagGenerateOnly),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				fmt.Sprint(s.proposalID),
+				s.member.String(),
+			},
+			true,
+		},
+		"wrong number of args": {
+			[]string{
+				fmt.Sprint(s.proposalID),
+				s.member.String(),
+				"extra",
+			},
+			false,
+		},
+		"invalid id": {
+			[]string{
+				"-1",
+				s.member.String(),
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdExec()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res txtypes.Tx
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewTxCmdLeaveGroup() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s", flags.FlagGenerateOnly),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				s.member.String(),
+				fmt.Sprint(s.groupID),
+			},
+			true,
+		},
+		"wrong number of args": {
+			[]string{
+				s.member.String(),
+				fmt.Sprint(s.groupID),
+				"extra",
+			},
+			false,
+		},
+		"invalid id": {
+			[]string{
+				s.member.String(),
+				"-1",
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdLeaveGroup()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res txtypes.Tx
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out)
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package group
+
+import (
+	"github.com/Finschia/finschia-sdk/codec"
+	"github.com/Finschia/finschia-sdk/codec/legacy"
+	"github.com/Finschia/finschia-sdk/codec/types"
+	cryptocodec "github.com/Finschia/finschia-sdk/crypto/codec"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/types/msgservice"
+	authzcodec "github.com/Finschia/finschia-sdk/x/authz/codec"
+	fdncodec "github.com/Finschia/finschia-sdk/x/foundation/codec"
+	govcodec "github.com/Finschia/finschia-sdk/x/gov/codec"
+)
+
+// RegisterLegacyAminoCodec registers concrete types on the LegacyAmino codec.
+// The names are prefixed by the module name, to avoid the conflict with
+// `x/foundation`. Some of them are shortened to fit in the length limit of amino.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgCreateGroup{}, "lbm-sdk/group/MsgCreateGroup")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateGroupMembers{}, "lbm-sdk/group/MsgUpdateGroupMembers")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateGroupAdmin{}, "lbm-sdk/group/MsgUpdateGroupAdmin")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateGroupMetadata{}, "lbm-sdk/group/MsgUpdateGroupMetadata")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateGroupPolicy{}, "lbm-sdk/group/MsgCreateGroupPolicy")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateGroupPolicyAdmin{}, "lbm-sdk/group/MsgUpdateGroupPolicyAdmin")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateGroupPolicyDecisionPolicy{}, "lbm-sdk/group/MsgUpdateDecisionPolicy")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateGroupPolicyMetadata{}, "lbm-sdk/group/MsgUpdatePolicyMetadata")
+	legacy.RegisterAminoMsg(cdc, &MsgSubmitProposal{}, "lbm-sdk/group/MsgSubmitProposal")
+	legacy.RegisterAminoMsg(cdc, &MsgWithdrawProposal{}, "lbm-sdk/group/MsgWithdrawProposal")
+	legacy.RegisterAminoMsg(cdc, &MsgVote{}, "lbm-sdk/group/MsgVote")
+	legacy.RegisterAminoMsg(cdc, &MsgExec{}, "lbm-sdk/group/MsgExec")
+	legacy.RegisterAminoMsg(cdc, &MsgLeaveGroup{}, "lbm-sdk/group/MsgLeaveGroup")
+
+	cdc.RegisterInterface((*DecisionPolicy)(nil), nil)
+	cdc.RegisterConcrete(&ThresholdDecisionPolicy{}, "lbm-sdk/group/ThresholdDecisionPolicy", nil)
+	cdc.RegisterConcrete(&PercentageDecisionPolicy{}, "lbm-sdk/group/PercentageDecisionPolicy", nil)
+}
+
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateGroup{},
+		&MsgUpdateGroupMembers{},
+		&MsgUpdateGroupAdmin{},
+		&MsgUpdateGroupMetadata{},
+		&MsgCreateGroupPolicy{},
+		&MsgUpdateGroupPolicyAdmin{},
+		&MsgUpdateGroupPolicyDecisionPolicy{},
+		&MsgUpdateGroupPolicyMetadata{},
+		&MsgSubmitProposal{},
+		&MsgWithdrawProposal{},
+		&MsgVote{},
+		&MsgExec{},
+		&MsgLeaveGroup{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+
+	registry.RegisterInterface(
+		"lbm.group.v1.DecisionPolicy",
+		(*DecisionPolicy)(nil),
+		&ThresholdDecisionPolicy{},
+		&PercentageDecisionPolicy{},
+	)
+}
+
+var (
+	amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	sdk.RegisterLegacyAminoCodec(amino)
+
+	// Register all Amino interfaces and concrete types on the authz and gov Amino codec so that this can later be
+	// used to properly serialize MsgGrant, MsgExec and MsgSubmitProposal instances
+	RegisterLegacyAminoCodec(authzcodec.Amino)
+	RegisterLegacyAminoCodec(govcodec.Amino)
+	RegisterLegacyAminoCodec(fdncodec.Amino)
+}
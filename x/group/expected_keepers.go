@@ -0,0 +1,16 @@
+package group
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
+)
+
+type (
+	// AccountKeeper defines the auth module interface contract needed by the
+	// group module.
+	AccountKeeper interface {
+		NewAccount(ctx sdk.Context, acc authtypes.AccountI) authtypes.AccountI
+		GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
+		SetAccount(ctx sdk.Context, acc authtypes.AccountI)
+	}
+)
@@ -0,0 +1,141 @@
+package group
+
+import (
+	codectypes "github.com/Finschia/finschia-sdk/codec/types"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+)
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+func (data GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, p := range data.GroupPolicies {
+		if err := p.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	for _, p := range data.Proposals {
+		if err := p.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	groups := map[uint64]GroupInfo{}
+	for _, group := range data.Groups {
+		id := group.Id
+		if id > data.GroupSeq {
+			return sdkerrors.ErrInvalidRequest.Wrapf("group %d has not yet been created", id)
+		}
+		if _, exists := groups[id]; exists {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicated group id of %d", id)
+		}
+
+		if err := group.ValidateBasic(); err != nil {
+			return err
+		}
+		groups[id] = group
+	}
+
+	weights := map[uint64]sdk.Dec{}
+	members := map[uint64]map[string]bool{}
+	for _, member := range data.GroupMembers {
+		id := member.GroupId
+		if _, exists := groups[id]; !exists {
+			return sdkerrors.ErrInvalidRequest.Wrapf("member of a group which does not exist: id %d", id)
+		}
+
+		if err := member.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if members[id] == nil {
+			members[id] = map[string]bool{}
+			weights[id] = sdk.ZeroDec()
+		}
+		addr := member.Member.Address
+		if members[id][addr] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicated member %s of group %d", addr, id)
+		}
+		members[id][addr] = true
+		weights[id] = weights[id].Add(member.Member.Weight)
+	}
+	for id, group := range groups {
+		realWeight, exists := weights[id]
+		if !exists {
+			realWeight = sdk.ZeroDec()
+		}
+		if !group.TotalWeight.Equal(realWeight) {
+			return sdkerrors.ErrInvalidRequest.Wrapf("total weight of group %d not match, %s != %s", id, group.TotalWeight, realWeight)
+		}
+	}
+
+	policies := map[string]GroupPolicyInfo{}
+	for _, policy := range data.GroupPolicies {
+		if err := policy.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if _, exists := groups[policy.GroupId]; !exists {
+			return sdkerrors.ErrInvalidRequest.Wrapf("group policy of a group which does not exist: id %d", policy.GroupId)
+		}
+
+		addr := policy.Address
+		if _, exists := policies[addr]; exists {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicated group policy %s", addr)
+		}
+		policies[addr] = policy
+	}
+
+	proposalIDs := map[uint64]bool{}
+	for _, proposal := range data.Proposals {
+		id := proposal.Id
+		if id > data.ProposalSeq {
+			return sdkerrors.ErrInvalidRequest.Wrapf("proposal %d has not yet been submitted", id)
+		}
+		if proposalIDs[id] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicated proposal id of %d", id)
+		}
+		proposalIDs[id] = true
+
+		if err := proposal.ValidateBasic(); err != nil {
+			return err
+		}
+
+		policy, exists := policies[proposal.GroupPolicyAddress]
+		if !exists {
+			return sdkerrors.ErrInvalidRequest.Wrapf("proposal %d of a group policy which does not exist", id)
+		}
+		if proposal.GroupPolicyVersion > policy.Version {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid group policy version of proposal %d", id)
+		}
+		if proposal.GroupVersion > groups[policy.GroupId].Version {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid group version of proposal %d", id)
+		}
+	}
+
+	for _, vote := range data.Votes {
+		if !proposalIDs[vote.ProposalId] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("vote for a proposal which does not exist: id %d", vote.ProposalId)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(vote.Voter); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid voter address: %s", vote.Voter)
+		}
+
+		if err := validateVoteOption(vote.Option); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
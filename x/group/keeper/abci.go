@@ -0,0 +1,10 @@
+package keeper
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+)
+
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	k.UpdateTallyOfVPEndProposals(ctx)
+	k.PruneExpiredProposals(ctx)
+}
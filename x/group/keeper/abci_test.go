@@ -0,0 +1,39 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/Finschia/finschia-sdk/x/group"
+	"github.com/Finschia/finschia-sdk/x/group/keeper"
+)
+
+func (s *KeeperTestSuite) TestEndBlocker() {
+	ctx, _ := s.ctx.CacheContext()
+
+	// after the voting period ends, the tally would be finalized
+	votingPeriod := workingPolicy().GetVotingPeriod()
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(votingPeriod))
+	keeper.EndBlocker(ctx, s.keeper)
+
+	for proposalID, status := range map[uint64]group.ProposalStatus{
+		s.activeProposal: group.PROPOSAL_STATUS_ACCEPTED,
+		s.votedProposal:  group.PROPOSAL_STATUS_REJECTED,
+	} {
+		proposal, err := s.keeper.GetProposal(ctx, proposalID)
+		s.Require().NoError(err)
+		s.Require().Equal(status, proposal.Status)
+	}
+
+	// after the max execution period, the proposals would be pruned
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(group.DefaultConfig().MaxExecutionPeriod).Add(time.Nanosecond))
+	keeper.EndBlocker(ctx, s.keeper)
+
+	for _, proposalID := range []uint64{
+		s.activeProposal,
+		s.votedProposal,
+		s.withdrawnProposal,
+	} {
+		_, err := s.keeper.GetProposal(ctx, proposalID)
+		s.Require().Error(err)
+	}
+}
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/group"
+)
+
+// ensureMsgAuthz checks that if a message requires signers that all of them are equal to the given account address of the group policy.
+func ensureMsgAuthz(msgs []sdk.Msg, policyAddress sdk.AccAddress) error {
+	for _, msg := range msgs {
+		// In practice, GetSigners() should return a non-empty array without
+		// duplicates, so the code below is equivalent to:
+		// `msgs[i].GetSigners()[0] == policyAddress`
+		// but we prefer to loop through all GetSigners just to be sure.
+		for _, signer := range msg.GetSigners() {
+			if !policyAddress.Equals(signer) {
+				return sdkerrors.ErrUnauthorized.Wrapf("bad signer; expected %s, got %s", policyAddress, signer)
+			}
+		}
+	}
+	return nil
+}
+
+func (k Keeper) Exec(ctx sdk.Context, proposalID uint64) error {
+	proposal, err := k.GetProposal(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+
+	if proposal.Status != group.PROPOSAL_STATUS_SUBMITTED &&
+		proposal.Status != group.PROPOSAL_STATUS_ACCEPTED {
+		return sdkerrors.ErrInvalidRequest.Wrapf("not possible with proposal status: %s", proposal.Status)
+	}
+
+	if proposal.Status == group.PROPOSAL_STATUS_SUBMITTED {
+		if err := k.doTallyAndUpdate(ctx, proposal); err != nil {
+			return err
+		}
+	}
+
+	// Execute proposal payload.
+	var logs string
+	if proposal.Status == group.PROPOSAL_STATUS_ACCEPTED &&
+		proposal.ExecutorResult != group.PROPOSAL_EXECUTOR_RESULT_SUCCESS {
+		logger := k.Logger(ctx)
+		// Caching context so that we don't update the store in case of failure.
+		cacheCtx, flush := ctx.CacheContext()
+
+		if results, err := k.doExecuteMsgs(cacheCtx, *proposal); err != nil {
+			proposal.ExecutorResult = group.PROPOSAL_EXECUTOR_RESULT_FAILURE
+			logs = fmt.Sprintf("proposal execution failed on proposal %d, because of error %s", proposalID, err.Error())
+			logger.Info("proposal execution failed", "cause", err, "proposalID", proposal.Id)
+		} else {
+			proposal.ExecutorResult = group.PROPOSAL_EXECUTOR_RESULT_SUCCESS
+			flush()
+
+			for _, res := range results {
+				// NOTE: The sdk msg handler creates a new EventManager, so events must be correctly propagated back to the current context
+				ctx.EventManager().EmitEvents(res.GetEvents())
+			}
+		}
+	}
+
+	// If proposal has successfully run, delete it from state.
+	if proposal.ExecutorResult == group.PROPOSAL_EXECUTOR_RESULT_SUCCESS {
+		k.pruneProposal(ctx, *proposal)
+	} else {
+		k.setProposal(ctx, *proposal)
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&group.EventExec{
+		ProposalId: proposal.Id,
+		Logs:       logs,
+		Result:     proposal.ExecutorResult,
+	}); err != nil {
+		panic(err)
+	}
+
+	return nil
+}
+
+// doExecuteMsgs routes the messages to the registered handlers.
+// On failure, it returns the results of the messages executed before the failed one.
+func (k Keeper) doExecuteMsgs(ctx sdk.Context, proposal group.Proposal) ([]sdk.Result, error) {
+	msgs := proposal.GetMsgs()
+	results := make([]sdk.Result, len(msgs))
+
+	policyAddress := sdk.MustAccAddressFromBech32(proposal.GroupPolicyAddress)
+	if err := ensureMsgAuthz(msgs, policyAddress); err != nil {
+		return nil, err
+	}
+
+	for i, msg := range msgs {
+		handler := k.router.Handler(msg)
+		if handler == nil {
+			return nil, sdkerrors.ErrUnknownRequest.Wrapf("no message handler found for %q", sdk.MsgTypeURL(msg))
+		}
+		r, err := handler(ctx, msg)
+		if err != nil {
+			return results[:i], sdkerrors.Wrapf(err, "message %q at position %d", msg, i)
+		}
+		if r != nil {
+			results[i] = *r
+		}
+	}
+	return results, nil
+}
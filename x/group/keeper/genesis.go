@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/group"
+)
+
+func (k Keeper) InitGenesis(ctx sdk.Context, data *group.GenesisState) error {
+	k.setSequence(ctx, groupSeqKey, data.GroupSeq)
+	k.setSequence(ctx, groupPolicySeqKey, data.GroupPolicySeq)
+	k.setSequence(ctx, proposalSeqKey, data.ProposalSeq)
+
+	for _, info := range data.Groups {
+		if err := validateMetadata(info.Metadata, k.config); err != nil {
+			return err
+		}
+
+		k.setGroupInfo(ctx, info)
+	}
+
+	for _, member := range data.GroupMembers {
+		if err := validateMetadata(member.Member.Metadata, k.config); err != nil {
+			return err
+		}
+
+		k.setGroupMember(ctx, member.GroupId, member.Member)
+	}
+
+	for _, info := range data.GroupPolicies {
+		if err := validateMetadata(info.Metadata, k.config); err != nil {
+			return err
+		}
+
+		k.setGroupPolicyInfo(ctx, info)
+	}
+
+	for _, proposal := range data.Proposals {
+		if err := validateMetadata(proposal.Metadata, k.config); err != nil {
+			return err
+		}
+
+		k.setProposal(ctx, proposal)
+		k.addProposalToVPEndQueue(ctx, proposal)
+	}
+
+	for _, vote := range data.Votes {
+		if err := validateMetadata(vote.Metadata, k.config); err != nil {
+			return err
+		}
+
+		k.setVote(ctx, vote)
+	}
+
+	return nil
+}
+
+func (k Keeper) ExportGenesis(ctx sdk.Context) *group.GenesisState {
+	var groups []group.GroupInfo
+	var members []group.GroupMember
+	k.iterateGroups(ctx, func(info group.GroupInfo) (stop bool) {
+		groups = append(groups, info)
+
+		k.iterateGroupMembers(ctx, info.Id, func(member group.Member) (stop bool) {
+			members = append(members, group.GroupMember{
+				GroupId: info.Id,
+				Member:  member,
+			})
+			return false
+		})
+
+		return false
+	})
+
+	var policies []group.GroupPolicyInfo
+	k.iterateGroupPolicies(ctx, func(info group.GroupPolicyInfo) (stop bool) {
+		policies = append(policies, info)
+		return false
+	})
+
+	var proposals []group.Proposal
+	var votes []group.Vote
+	k.iterateProposals(ctx, func(proposal group.Proposal) (stop bool) {
+		proposals = append(proposals, proposal)
+		votes = append(votes, k.GetVotes(ctx, proposal.Id)...)
+		return false
+	})
+
+	return &group.GenesisState{
+		GroupSeq:       k.getSequence(ctx, groupSeqKey),
+		Groups:         groups,
+		GroupMembers:   members,
+		GroupPolicySeq: k.getSequence(ctx, groupPolicySeqKey),
+		GroupPolicies:  policies,
+		ProposalSeq:    k.getSequence(ctx, proposalSeqKey),
+		Proposals:      proposals,
+		Votes:          votes,
+	}
+}
@@ -0,0 +1,26 @@
+package keeper_test
+
+import (
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/Finschia/finschia-sdk/simapp"
+	"github.com/Finschia/finschia-sdk/x/group"
+)
+
+func (s *KeeperTestSuite) TestImportExportGenesis() {
+	exported := s.keeper.ExportGenesis(s.ctx)
+	s.Require().NoError(group.ValidateGenesis(*exported))
+	s.Require().Equal(uint64(1), exported.GroupSeq)
+	s.Require().Len(exported.GroupMembers, len(s.members))
+	s.Require().Len(exported.GroupPolicies, 1)
+	s.Require().Len(exported.Proposals, 3)
+	s.Require().Len(exported.Votes, len(s.members)*2-1)
+
+	// import into a fresh app
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	err := app.GroupKeeper.InitGenesis(ctx, exported)
+	s.Require().NoError(err)
+
+	s.Require().Equal(exported, app.GroupKeeper.ExportGenesis(ctx))
+}
@@ -0,0 +1,254 @@
+package keeper
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/group"
+)
+
+func (k Keeper) CreateGroup(ctx sdk.Context, admin sdk.AccAddress, members []group.MemberRequest, metadata string) (uint64, error) {
+	if err := validateMetadata(metadata, k.config); err != nil {
+		return 0, err
+	}
+
+	id := k.nextSequence(ctx, groupSeqKey)
+	info := group.GroupInfo{
+		Id:          id,
+		Admin:       admin.String(),
+		Metadata:    metadata,
+		Version:     1,
+		TotalWeight: sdk.ZeroDec(),
+		CreatedAt:   ctx.BlockTime(),
+	}
+
+	for _, request := range members {
+		if err := validateMetadata(request.Metadata, k.config); err != nil {
+			return 0, err
+		}
+
+		member := group.Member{
+			Address:  request.Address,
+			Weight:   request.Weight,
+			Metadata: request.Metadata,
+			AddedAt:  ctx.BlockTime(),
+		}
+		k.setGroupMember(ctx, id, member)
+
+		info.TotalWeight = info.TotalWeight.Add(member.Weight)
+	}
+
+	k.setGroupInfo(ctx, info)
+
+	return id, nil
+}
+
+func (k Keeper) UpdateGroupMembers(ctx sdk.Context, groupID uint64, requests []group.MemberRequest) error {
+	info, err := k.GetGroupInfo(ctx, groupID)
+	if err != nil {
+		return err
+	}
+
+	for _, request := range requests {
+		if err := validateMetadata(request.Metadata, k.config); err != nil {
+			return err
+		}
+
+		addr := sdk.MustAccAddressFromBech32(request.Address)
+		old, err := k.GetGroupMember(ctx, groupID, addr)
+		if err != nil && request.Remove { // the member must exist
+			return err
+		}
+		if old != nil {
+			info.TotalWeight = info.TotalWeight.Sub(old.Weight)
+		}
+
+		if request.Remove {
+			k.deleteGroupMember(ctx, groupID, addr)
+			continue
+		}
+
+		member := group.Member{
+			Address:  request.Address,
+			Weight:   request.Weight,
+			Metadata: request.Metadata,
+			AddedAt:  ctx.BlockTime(),
+		}
+		if old != nil {
+			member.AddedAt = old.AddedAt
+		}
+		k.setGroupMember(ctx, groupID, member)
+
+		info.TotalWeight = info.TotalWeight.Add(member.Weight)
+	}
+
+	info.Version++
+	k.setGroupInfo(ctx, *info)
+	k.abortOldProposalsOfGroup(ctx, groupID)
+
+	return nil
+}
+
+func (k Keeper) LeaveGroup(ctx sdk.Context, groupID uint64, address sdk.AccAddress) error {
+	info, err := k.GetGroupInfo(ctx, groupID)
+	if err != nil {
+		return err
+	}
+
+	member, err := k.GetGroupMember(ctx, groupID, address)
+	if err != nil {
+		return err
+	}
+	k.deleteGroupMember(ctx, groupID, address)
+
+	info.TotalWeight = info.TotalWeight.Sub(member.Weight)
+	info.Version++
+	k.setGroupInfo(ctx, *info)
+	k.abortOldProposalsOfGroup(ctx, groupID)
+
+	return nil
+}
+
+func (k Keeper) UpdateGroupAdmin(ctx sdk.Context, groupID uint64, newAdmin sdk.AccAddress) error {
+	info, err := k.GetGroupInfo(ctx, groupID)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	oldAdmin := sdk.MustAccAddressFromBech32(info.Admin)
+	store.Delete(groupByAdminKey(oldAdmin, groupID))
+
+	info.Admin = newAdmin.String()
+	k.setGroupInfo(ctx, *info)
+
+	return nil
+}
+
+func (k Keeper) UpdateGroupMetadata(ctx sdk.Context, groupID uint64, metadata string) error {
+	if err := validateMetadata(metadata, k.config); err != nil {
+		return err
+	}
+
+	info, err := k.GetGroupInfo(ctx, groupID)
+	if err != nil {
+		return err
+	}
+
+	info.Metadata = metadata
+	k.setGroupInfo(ctx, *info)
+
+	return nil
+}
+
+func (k Keeper) GetGroupInfo(ctx sdk.Context, id uint64) (*group.GroupInfo, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(groupKey(id))
+	if len(bz) == 0 {
+		return nil, sdkerrors.ErrNotFound.Wrapf("No group for id: %d", id)
+	}
+
+	var info group.GroupInfo
+	k.cdc.MustUnmarshal(bz, &info)
+
+	return &info, nil
+}
+
+func (k Keeper) setGroupInfo(ctx sdk.Context, info group.GroupInfo) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&info)
+	store.Set(groupKey(info.Id), bz)
+
+	admin := sdk.MustAccAddressFromBech32(info.Admin)
+	store.Set(groupByAdminKey(admin, info.Id), []byte{})
+}
+
+func (k Keeper) iterateGroups(ctx sdk.Context, fn func(info group.GroupInfo) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, groupKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var info group.GroupInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &info)
+		if stop := fn(info); stop {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetGroupMember(ctx sdk.Context, groupID uint64, address sdk.AccAddress) (*group.Member, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(groupMemberKey(groupID, address))
+	if len(bz) == 0 {
+		return nil, sdkerrors.ErrNotFound.Wrapf("%s is not a member of group %d", address, groupID)
+	}
+
+	var member group.Member
+	k.cdc.MustUnmarshal(bz, &member)
+
+	return &member, nil
+}
+
+func (k Keeper) setGroupMember(ctx sdk.Context, groupID uint64, member group.Member) {
+	store := ctx.KVStore(k.storeKey)
+	addr := sdk.MustAccAddressFromBech32(member.Address)
+	bz := k.cdc.MustMarshal(&member)
+	store.Set(groupMemberKey(groupID, addr), bz)
+	store.Set(groupMemberByMemberKey(addr, groupID), []byte{})
+}
+
+func (k Keeper) deleteGroupMember(ctx sdk.Context, groupID uint64, address sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(groupMemberKey(groupID, address))
+	store.Delete(groupMemberByMemberKey(address, groupID))
+}
+
+func (k Keeper) iterateGroupMembers(ctx sdk.Context, groupID uint64, fn func(member group.Member) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, groupMemberKeyPrefixByGroup(groupID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var member group.Member
+		k.cdc.MustUnmarshal(iterator.Value(), &member)
+		if stop := fn(member); stop {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetGroupMembers(ctx sdk.Context, groupID uint64) []group.Member {
+	var members []group.Member
+	k.iterateGroupMembers(ctx, groupID, func(member group.Member) (stop bool) {
+		members = append(members, member)
+		return false
+	})
+
+	return members
+}
+
+// validateGroupMembers checks all the addresses are the members of the group.
+func (k Keeper) validateGroupMembers(ctx sdk.Context, groupID uint64, addresses []string) error {
+	for _, address := range addresses {
+		addr := sdk.MustAccAddressFromBech32(address)
+		if _, err := k.GetGroupMember(ctx, groupID, addr); err != nil {
+			return sdkerrors.ErrUnauthorized.Wrapf("%s is not a member of group %d", address, groupID)
+		}
+	}
+
+	return nil
+}
+
+// validateGroupAdmin checks the address is the admin of the group.
+func (k Keeper) validateGroupAdmin(ctx sdk.Context, groupID uint64, address string) error {
+	info, err := k.GetGroupInfo(ctx, groupID)
+	if err != nil {
+		return err
+	}
+
+	if address != info.Admin {
+		return sdkerrors.ErrUnauthorized.Wrapf("not authorized as the admin of group %d: %s", groupID, address)
+	}
+
+	return nil
+}
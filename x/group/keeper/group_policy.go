@@ -0,0 +1,194 @@
+package keeper
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/types/address"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
+	"github.com/Finschia/finschia-sdk/x/group"
+)
+
+// maxAddressDerivations is the number of attempts to derive an unused group
+// policy address.
+const maxAddressDerivations = 10
+
+func (k Keeper) CreateGroupPolicy(ctx sdk.Context, admin sdk.AccAddress, groupID uint64, metadata string, policy group.DecisionPolicy) (sdk.AccAddress, error) {
+	if err := validateMetadata(metadata, k.config); err != nil {
+		return nil, err
+	}
+
+	groupInfo, err := k.GetGroupInfo(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := policy.Validate(*groupInfo, k.config); err != nil {
+		return nil, err
+	}
+
+	addr, err := k.newGroupPolicyAddress(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// nobody has the key of the account, so the messages of the group policy
+	// could be executed only by the proposals.
+	account := k.accountKeeper.NewAccount(ctx, authtypes.NewBaseAccountWithAddress(addr))
+	k.accountKeeper.SetAccount(ctx, account)
+
+	info := group.GroupPolicyInfo{
+		Address:   addr.String(),
+		GroupId:   groupID,
+		Admin:     admin.String(),
+		Metadata:  metadata,
+		Version:   1,
+		CreatedAt: ctx.BlockTime(),
+	}
+	if err := info.SetDecisionPolicy(policy); err != nil {
+		return nil, err
+	}
+	k.setGroupPolicyInfo(ctx, info)
+
+	return addr, nil
+}
+
+// newGroupPolicyAddress derives a new address from the group policy sequence.
+// An address may be used already (e.g. someone has sent coins to it), so it
+// skips such addresses.
+func (k Keeper) newGroupPolicyAddress(ctx sdk.Context) (sdk.AccAddress, error) {
+	for i := 0; i < maxAddressDerivations; i++ {
+		seq := k.nextSequence(ctx, groupPolicySeqKey)
+		addr := sdk.AccAddress(address.Module(group.ModuleName, Uint64ToBytes(seq)))
+		if k.accountKeeper.GetAccount(ctx, addr) == nil {
+			return addr, nil
+		}
+	}
+
+	return nil, sdkerrors.ErrConflict.Wrap("failed to derive an unused group policy address")
+}
+
+func (k Keeper) UpdateGroupPolicyAdmin(ctx sdk.Context, address, newAdmin sdk.AccAddress) error {
+	info, err := k.GetGroupPolicyInfo(ctx, address)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	oldAdmin := sdk.MustAccAddressFromBech32(info.Admin)
+	store.Delete(groupPolicyByAdminKey(oldAdmin, address))
+
+	info.Admin = newAdmin.String()
+	k.setGroupPolicyInfo(ctx, *info)
+
+	return nil
+}
+
+func (k Keeper) UpdateGroupPolicyDecisionPolicy(ctx sdk.Context, address sdk.AccAddress, policy group.DecisionPolicy) error {
+	info, err := k.GetGroupPolicyInfo(ctx, address)
+	if err != nil {
+		return err
+	}
+
+	groupInfo, err := k.GetGroupInfo(ctx, info.GroupId)
+	if err != nil {
+		return err
+	}
+
+	if err := policy.Validate(*groupInfo, k.config); err != nil {
+		return err
+	}
+
+	if err := info.SetDecisionPolicy(policy); err != nil {
+		return err
+	}
+	info.Version++
+	k.setGroupPolicyInfo(ctx, *info)
+	k.abortOldProposals(ctx, address)
+
+	return nil
+}
+
+func (k Keeper) UpdateGroupPolicyMetadata(ctx sdk.Context, address sdk.AccAddress, metadata string) error {
+	if err := validateMetadata(metadata, k.config); err != nil {
+		return err
+	}
+
+	info, err := k.GetGroupPolicyInfo(ctx, address)
+	if err != nil {
+		return err
+	}
+
+	info.Metadata = metadata
+	k.setGroupPolicyInfo(ctx, *info)
+
+	return nil
+}
+
+func (k Keeper) GetGroupPolicyInfo(ctx sdk.Context, address sdk.AccAddress) (*group.GroupPolicyInfo, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(groupPolicyKey(address))
+	if len(bz) == 0 {
+		return nil, sdkerrors.ErrNotFound.Wrapf("No group policy for address: %s", address)
+	}
+
+	var info group.GroupPolicyInfo
+	k.cdc.MustUnmarshal(bz, &info)
+
+	return &info, nil
+}
+
+func (k Keeper) setGroupPolicyInfo(ctx sdk.Context, info group.GroupPolicyInfo) {
+	store := ctx.KVStore(k.storeKey)
+	addr := sdk.MustAccAddressFromBech32(info.Address)
+	bz := k.cdc.MustMarshal(&info)
+	store.Set(groupPolicyKey(addr), bz)
+
+	store.Set(groupPolicyByGroupKey(info.GroupId, addr), []byte{})
+
+	admin := sdk.MustAccAddressFromBech32(info.Admin)
+	store.Set(groupPolicyByAdminKey(admin, addr), []byte{})
+}
+
+func (k Keeper) iterateGroupPolicies(ctx sdk.Context, fn func(info group.GroupPolicyInfo) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, groupPolicyKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var info group.GroupPolicyInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &info)
+		if stop := fn(info); stop {
+			break
+		}
+	}
+}
+
+// iterateGroupPolicyAddressesByGroup iterates over the addresses of the group
+// policies of a group.
+func (k Keeper) iterateGroupPolicyAddressesByGroup(ctx sdk.Context, groupID uint64, fn func(address sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := groupPolicyByGroupKeyPrefixByGroup(groupID)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		addr := sdk.AccAddress(iterator.Key()[len(prefix):])
+		if stop := fn(addr); stop {
+			break
+		}
+	}
+}
+
+// validateGroupPolicyAdmin checks the address is the admin of the group policy.
+func (k Keeper) validateGroupPolicyAdmin(ctx sdk.Context, policyAddress sdk.AccAddress, address string) error {
+	info, err := k.GetGroupPolicyInfo(ctx, policyAddress)
+	if err != nil {
+		return err
+	}
+
+	if address != info.Admin {
+		return sdkerrors.ErrUnauthorized.Wrapf("not authorized as the admin of group policy %s: %s", policyAddress, address)
+	}
+
+	return nil
+}
@@ -0,0 +1,337 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Finschia/finschia-sdk/store/prefix"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/types/query"
+	"github.com/Finschia/finschia-sdk/x/group"
+)
+
+type queryServer struct {
+	keeper Keeper
+}
+
+func NewQueryServer(keeper Keeper) group.QueryServer {
+	return &queryServer{
+		keeper: keeper,
+	}
+}
+
+var _ group.QueryServer = (*queryServer)(nil)
+
+func (s queryServer) GroupInfo(c context.Context, req *group.QueryGroupInfoRequest) (*group.QueryGroupInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	info, err := s.keeper.GetGroupInfo(ctx, req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryGroupInfoResponse{Info: *info}, nil
+}
+
+func (s queryServer) GroupPolicyInfo(c context.Context, req *group.QueryGroupPolicyInfoRequest) (*group.QueryGroupPolicyInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	info, err := s.keeper.GetGroupPolicyInfo(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryGroupPolicyInfoResponse{Info: *info}, nil
+}
+
+func (s queryServer) GroupMembers(c context.Context, req *group.QueryGroupMembersRequest) (*group.QueryGroupMembersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var members []group.GroupMember
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	memberStore := prefix.NewStore(store, groupMemberKeyPrefixByGroup(req.GroupId))
+	pageRes, err := query.Paginate(memberStore, req.Pagination, func(key, value []byte) error {
+		var member group.Member
+		s.keeper.cdc.MustUnmarshal(value, &member)
+		members = append(members, group.GroupMember{
+			GroupId: req.GroupId,
+			Member:  member,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryGroupMembersResponse{Members: members, Pagination: pageRes}, nil
+}
+
+func (s queryServer) GroupsByAdmin(c context.Context, req *group.QueryGroupsByAdminRequest) (*group.QueryGroupsByAdminResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	admin, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	groups, pageRes, err := s.paginateGroups(ctx, groupByAdminKeyPrefixByAdmin(admin), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryGroupsByAdminResponse{Groups: groups, Pagination: pageRes}, nil
+}
+
+func (s queryServer) GroupsByMember(c context.Context, req *group.QueryGroupsByMemberRequest) (*group.QueryGroupsByMemberResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	member, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	groups, pageRes, err := s.paginateGroups(ctx, groupMemberByMemberKeyPrefixByMember(member), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryGroupsByMemberResponse{Groups: groups, Pagination: pageRes}, nil
+}
+
+// paginateGroups paginates the groups of an index whose keys end with the group ids.
+func (s queryServer) paginateGroups(ctx sdk.Context, indexPrefix []byte, pagination *query.PageRequest) ([]group.GroupInfo, *query.PageResponse, error) {
+	var groups []group.GroupInfo
+	store := ctx.KVStore(s.keeper.storeKey)
+	indexStore := prefix.NewStore(store, indexPrefix)
+	pageRes, err := query.Paginate(indexStore, pagination, func(key, _ []byte) error {
+		info, err := s.keeper.GetGroupInfo(ctx, Uint64FromBytes(key))
+		if err != nil {
+			return err
+		}
+		groups = append(groups, *info)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return groups, pageRes, nil
+}
+
+func (s queryServer) GroupPoliciesByGroup(c context.Context, req *group.QueryGroupPoliciesByGroupRequest) (*group.QueryGroupPoliciesByGroupResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	policies, pageRes, err := s.paginateGroupPolicies(ctx, groupPolicyByGroupKeyPrefixByGroup(req.GroupId), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryGroupPoliciesByGroupResponse{GroupPolicies: policies, Pagination: pageRes}, nil
+}
+
+func (s queryServer) GroupPoliciesByAdmin(c context.Context, req *group.QueryGroupPoliciesByAdminRequest) (*group.QueryGroupPoliciesByAdminResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	admin, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	policies, pageRes, err := s.paginateGroupPolicies(ctx, groupPolicyByAdminKeyPrefixByAdmin(admin), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryGroupPoliciesByAdminResponse{GroupPolicies: policies, Pagination: pageRes}, nil
+}
+
+// paginateGroupPolicies paginates the group policies of an index whose keys end with the group policy addresses.
+func (s queryServer) paginateGroupPolicies(ctx sdk.Context, indexPrefix []byte, pagination *query.PageRequest) ([]group.GroupPolicyInfo, *query.PageResponse, error) {
+	var policies []group.GroupPolicyInfo
+	store := ctx.KVStore(s.keeper.storeKey)
+	indexStore := prefix.NewStore(store, indexPrefix)
+	pageRes, err := query.Paginate(indexStore, pagination, func(key, _ []byte) error {
+		info, err := s.keeper.GetGroupPolicyInfo(ctx, sdk.AccAddress(key))
+		if err != nil {
+			return err
+		}
+		policies = append(policies, *info)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return policies, pageRes, nil
+}
+
+func (s queryServer) Proposal(c context.Context, req *group.QueryProposalRequest) (*group.QueryProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	proposal, err := s.keeper.GetProposal(ctx, req.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryProposalResponse{Proposal: *proposal}, nil
+}
+
+func (s queryServer) ProposalsByGroupPolicy(c context.Context, req *group.QueryProposalsByGroupPolicyRequest) (*group.QueryProposalsByGroupPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	var proposals []group.Proposal
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	indexStore := prefix.NewStore(store, proposalByGroupPolicyKeyPrefixByGroupPolicy(addr))
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key, _ []byte) error {
+		proposal, err := s.keeper.GetProposal(ctx, Uint64FromBytes(key))
+		if err != nil {
+			return err
+		}
+		proposals = append(proposals, *proposal)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryProposalsByGroupPolicyResponse{Proposals: proposals, Pagination: pageRes}, nil
+}
+
+func (s queryServer) VoteByProposalVoter(c context.Context, req *group.QueryVoteByProposalVoterRequest) (*group.QueryVoteByProposalVoterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	voter, err := sdk.AccAddressFromBech32(req.Voter)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	vote, err := s.keeper.GetVote(ctx, req.ProposalId, voter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryVoteByProposalVoterResponse{Vote: *vote}, nil
+}
+
+func (s queryServer) VotesByProposal(c context.Context, req *group.QueryVotesByProposalRequest) (*group.QueryVotesByProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var votes []group.Vote
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	voteStore := prefix.NewStore(store, voteKeyPrefixByProposal(req.ProposalId))
+	pageRes, err := query.Paginate(voteStore, req.Pagination, func(key, value []byte) error {
+		var vote group.Vote
+		s.keeper.cdc.MustUnmarshal(value, &vote)
+		votes = append(votes, vote)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryVotesByProposalResponse{Votes: votes, Pagination: pageRes}, nil
+}
+
+func (s queryServer) VotesByVoter(c context.Context, req *group.QueryVotesByVoterRequest) (*group.QueryVotesByVoterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	voter, err := sdk.AccAddressFromBech32(req.Voter)
+	if err != nil {
+		return nil, err
+	}
+
+	var votes []group.Vote
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	indexStore := prefix.NewStore(store, voteByVoterKeyPrefixByVoter(voter))
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key, _ []byte) error {
+		vote, err := s.keeper.GetVote(ctx, Uint64FromBytes(key), voter)
+		if err != nil {
+			return err
+		}
+		votes = append(votes, *vote)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryVotesByVoterResponse{Votes: votes, Pagination: pageRes}, nil
+}
+
+func (s queryServer) TallyResult(c context.Context, req *group.QueryTallyResultRequest) (*group.QueryTallyResultResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	proposal, err := s.keeper.GetProposal(ctx, req.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	if proposal.Status == group.PROPOSAL_STATUS_WITHDRAWN || proposal.Status == group.PROPOSAL_STATUS_ABORTED {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("can't get the tally of a proposal with the status of %s", proposal.Status)
+	}
+
+	policyAddress := sdk.MustAccAddressFromBech32(proposal.GroupPolicyAddress)
+	policyInfo, err := s.keeper.GetGroupPolicyInfo(ctx, policyAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	tally, err := s.keeper.tally(ctx, *proposal, policyInfo.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryTallyResultResponse{Tally: tally}, nil
+}
@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/group"
+)
+
+func (s *KeeperTestSuite) TestQueryGroupMembers() {
+	res, err := s.queryServer.GroupMembers(sdk.WrapSDKContext(s.ctx), &group.QueryGroupMembersRequest{GroupId: s.groupID})
+	s.Require().NoError(err)
+	s.Require().Len(res.Members, len(s.members))
+
+	res, err = s.queryServer.GroupMembers(sdk.WrapSDKContext(s.ctx), &group.QueryGroupMembersRequest{GroupId: s.groupID + 1})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Members)
+}
+
+func (s *KeeperTestSuite) TestQueryGroupsByMember() {
+	testCases := map[string]struct {
+		address  sdk.AccAddress
+		expected int
+	}{
+		"member": {
+			address:  s.members[0],
+			expected: 1,
+		},
+		"stranger": {
+			address: s.stranger,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			res, err := s.queryServer.GroupsByMember(sdk.WrapSDKContext(s.ctx), &group.QueryGroupsByMemberRequest{Address: tc.address.String()})
+			s.Require().NoError(err)
+			s.Require().Len(res.Groups, tc.expected)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryGroupPoliciesByGroup() {
+	res, err := s.queryServer.GroupPoliciesByGroup(sdk.WrapSDKContext(s.ctx), &group.QueryGroupPoliciesByGroupRequest{GroupId: s.groupID})
+	s.Require().NoError(err)
+	s.Require().Len(res.GroupPolicies, 1)
+	s.Require().Equal(s.groupPolicy.String(), res.GroupPolicies[0].Address)
+}
+
+func (s *KeeperTestSuite) TestQueryProposal() {
+	testCases := map[string]struct {
+		proposalID uint64
+		valid      bool
+	}{
+		"valid request": {
+			proposalID: s.activeProposal,
+			valid:      true,
+		},
+		"no such a proposal": {
+			proposalID: s.withdrawnProposal + 1,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			res, err := s.queryServer.Proposal(sdk.WrapSDKContext(s.ctx), &group.QueryProposalRequest{ProposalId: tc.proposalID})
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.proposalID, res.Proposal.Id)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryTallyResult() {
+	res, err := s.queryServer.TallyResult(sdk.WrapSDKContext(s.ctx), &group.QueryTallyResultRequest{ProposalId: s.activeProposal})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDec(int64(len(s.members)-1)), res.Tally.YesCount)
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/Finschia/finschia-sdk/baseapp"
+	"github.com/Finschia/finschia-sdk/codec"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/group"
+)
+
+// Keeper defines the group module Keeper
+type Keeper struct {
+	// The codec for binary encoding/decoding.
+	cdc codec.Codec
+
+	// The (unexposed) keys used to access the stores from the Context.
+	storeKey sdk.StoreKey
+
+	router *baseapp.MsgServiceRouter
+
+	// keepers
+	accountKeeper group.AccountKeeper
+
+	config group.Config
+}
+
+// NewKeeper returns a group keeper
+func NewKeeper(
+	cdc codec.Codec,
+	key sdk.StoreKey,
+	router *baseapp.MsgServiceRouter,
+	accountKeeper group.AccountKeeper,
+	config group.Config,
+) Keeper {
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      key,
+		router:        router,
+		accountKeeper: accountKeeper,
+		config:        config,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+group.ModuleName)
+}
+
+func validateMetadata(metadata string, config group.Config) error {
+	if len(metadata) > int(config.MaxMetadataLen) {
+		return sdkerrors.ErrInvalidRequest.Wrap("metadata is too large")
+	}
+
+	return nil
+}
+
+func (k Keeper) getSequence(ctx sdk.Context, key []byte) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return 0
+	}
+	return Uint64FromBytes(bz)
+}
+
+func (k Keeper) setSequence(ctx sdk.Context, key []byte, seq uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(key, Uint64ToBytes(seq))
+}
+
+func (k Keeper) nextSequence(ctx sdk.Context, key []byte) uint64 {
+	seq := k.getSequence(ctx, key) + 1
+	k.setSequence(ctx, key, seq)
+
+	return seq
+}
@@ -0,0 +1,141 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/Finschia/finschia-sdk/crypto/keys/secp256k1"
+	"github.com/Finschia/finschia-sdk/simapp"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	banktypes "github.com/Finschia/finschia-sdk/x/bank/types"
+	"github.com/Finschia/finschia-sdk/x/group"
+	"github.com/Finschia/finschia-sdk/x/group/keeper"
+	minttypes "github.com/Finschia/finschia-sdk/x/mint/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app *simapp.SimApp
+	ctx sdk.Context
+
+	keeper      keeper.Keeper
+	queryServer group.QueryServer
+	msgServer   group.MsgServer
+
+	admin    sdk.AccAddress
+	members  []sdk.AccAddress
+	stranger sdk.AccAddress
+
+	groupID     uint64
+	groupPolicy sdk.AccAddress
+
+	activeProposal    uint64
+	votedProposal     uint64
+	withdrawnProposal uint64
+
+	balance sdk.Int
+}
+
+func createAddresses(accNum int) []sdk.AccAddress {
+	addresses := make([]sdk.AccAddress, accNum)
+	for i := range addresses {
+		addresses[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+	return addresses
+}
+
+func workingPolicy() group.DecisionPolicy {
+	return &group.ThresholdDecisionPolicy{
+		Threshold: sdk.NewDec(3),
+		Windows: &group.DecisionPolicyWindows{
+			VotingPeriod: 7 * 24 * time.Hour, // one week
+		},
+	}
+}
+
+func (s *KeeperTestSuite) newMsgSend(amount sdk.Int) sdk.Msg {
+	return &banktypes.MsgSend{
+		FromAddress: s.groupPolicy.String(),
+		ToAddress:   s.stranger.String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, amount)),
+	}
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	checkTx := false
+	s.app = simapp.Setup(checkTx)
+	s.ctx = s.app.BaseApp.NewContext(checkTx, tmproto.Header{})
+	s.keeper = s.app.GroupKeeper
+
+	s.queryServer = keeper.NewQueryServer(s.keeper)
+	s.msgServer = keeper.NewMsgServer(s.keeper)
+
+	numMembers := 5
+	addresses := createAddresses(numMembers + 2)
+	s.admin = addresses[0]
+	s.members = addresses[1 : numMembers+1]
+	s.stranger = addresses[numMembers+1]
+
+	requests := make([]group.MemberRequest, len(s.members))
+	for i, member := range s.members {
+		requests[i] = group.MemberRequest{
+			Address: member.String(),
+			Weight:  sdk.OneDec(),
+		}
+	}
+	var err error
+	s.groupID, err = s.keeper.CreateGroup(s.ctx, s.admin, requests, "")
+	s.Require().NoError(err)
+
+	s.groupPolicy, err = s.keeper.CreateGroupPolicy(s.ctx, s.admin, s.groupID, "", workingPolicy())
+	s.Require().NoError(err)
+
+	// fund the group policy account
+	s.balance = sdk.NewInt(987654321)
+	amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, s.balance))
+	err = s.app.BankKeeper.MintCoins(s.ctx, minttypes.ModuleName, amount)
+	s.Require().NoError(err)
+	err = s.app.BankKeeper.SendCoinsFromModuleToAccount(s.ctx, minttypes.ModuleName, s.groupPolicy, amount)
+	s.Require().NoError(err)
+
+	// create an active proposal, voted yes by all members except the first member
+	s.activeProposal, err = s.keeper.SubmitProposal(s.ctx, s.groupPolicy, []string{s.members[0].String()}, "", []sdk.Msg{s.newMsgSend(s.balance)})
+	s.Require().NoError(err)
+
+	for _, member := range s.members[1:] {
+		err := s.keeper.Vote(s.ctx, group.Vote{
+			ProposalId: s.activeProposal,
+			Voter:      member.String(),
+			Option:     group.VOTE_OPTION_YES,
+		})
+		s.Require().NoError(err)
+	}
+
+	// create a proposal voted no by all members
+	s.votedProposal, err = s.keeper.SubmitProposal(s.ctx, s.groupPolicy, []string{s.members[0].String()}, "", []sdk.Msg{s.newMsgSend(sdk.OneInt())})
+	s.Require().NoError(err)
+
+	for _, member := range s.members {
+		err := s.keeper.Vote(s.ctx, group.Vote{
+			ProposalId: s.votedProposal,
+			Voter:      member.String(),
+			Option:     group.VOTE_OPTION_NO,
+		})
+		s.Require().NoError(err)
+	}
+
+	// create a withdrawn proposal
+	s.withdrawnProposal, err = s.keeper.SubmitProposal(s.ctx, s.groupPolicy, []string{s.members[0].String()}, "", []sdk.Msg{s.newMsgSend(sdk.OneInt())})
+	s.Require().NoError(err)
+
+	err = s.keeper.WithdrawProposal(s.ctx, s.withdrawnProposal)
+	s.Require().NoError(err)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"time"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/types/address"
+)
+
+// Keys for group store
+// Items are stored with the following key: values
+var (
+	groupSeqKey                  = []byte{0x10}
+	groupKeyPrefix               = []byte{0x11}
+	groupByAdminKeyPrefix        = []byte{0x12}
+	groupMemberKeyPrefix         = []byte{0x13}
+	groupMemberByMemberKeyPrefix = []byte{0x14}
+
+	groupPolicySeqKey           = []byte{0x20}
+	groupPolicyKeyPrefix        = []byte{0x21}
+	groupPolicyByGroupKeyPrefix = []byte{0x22}
+	groupPolicyByAdminKeyPrefix = []byte{0x23}
+
+	proposalSeqKey                 = []byte{0x30}
+	proposalKeyPrefix              = []byte{0x31}
+	proposalByGroupPolicyKeyPrefix = []byte{0x32}
+	proposalByVPEndKeyPrefix       = []byte{0x33}
+
+	voteKeyPrefix        = []byte{0x40}
+	voteByVoterKeyPrefix = []byte{0x41}
+)
+
+// must be constant
+var lenTime = len(sdk.FormatTimeBytes(time.Now()))
+
+// Uint64FromBytes converts a byte array to uint64
+func Uint64FromBytes(bz []byte) uint64 {
+	return binary.BigEndian.Uint64(bz)
+}
+
+// Uint64ToBytes converts a number in uint64 to a byte array
+func Uint64ToBytes(number uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, number)
+	return bz
+}
+
+func concatKey(parts ...[]byte) []byte {
+	length := 0
+	for _, part := range parts {
+		length += len(part)
+	}
+
+	key := make([]byte, 0, length)
+	for _, part := range parts {
+		key = append(key, part...)
+	}
+
+	return key
+}
+
+// groupKey key for a specific group from the store
+func groupKey(id uint64) []byte {
+	return concatKey(groupKeyPrefix, Uint64ToBytes(id))
+}
+
+func groupByAdminKeyPrefixByAdmin(admin sdk.AccAddress) []byte {
+	return concatKey(groupByAdminKeyPrefix, address.MustLengthPrefix(admin))
+}
+
+func groupByAdminKey(admin sdk.AccAddress, id uint64) []byte {
+	return concatKey(groupByAdminKeyPrefixByAdmin(admin), Uint64ToBytes(id))
+}
+
+func groupMemberKeyPrefixByGroup(groupID uint64) []byte {
+	return concatKey(groupMemberKeyPrefix, Uint64ToBytes(groupID))
+}
+
+func groupMemberKey(groupID uint64, member sdk.AccAddress) []byte {
+	return concatKey(groupMemberKeyPrefixByGroup(groupID), member)
+}
+
+func groupMemberByMemberKeyPrefixByMember(member sdk.AccAddress) []byte {
+	return concatKey(groupMemberByMemberKeyPrefix, address.MustLengthPrefix(member))
+}
+
+func groupMemberByMemberKey(member sdk.AccAddress, groupID uint64) []byte {
+	return concatKey(groupMemberByMemberKeyPrefixByMember(member), Uint64ToBytes(groupID))
+}
+
+// groupPolicyKey key for a specific group policy from the store
+func groupPolicyKey(policy sdk.AccAddress) []byte {
+	return concatKey(groupPolicyKeyPrefix, policy)
+}
+
+func groupPolicyByGroupKeyPrefixByGroup(groupID uint64) []byte {
+	return concatKey(groupPolicyByGroupKeyPrefix, Uint64ToBytes(groupID))
+}
+
+func groupPolicyByGroupKey(groupID uint64, policy sdk.AccAddress) []byte {
+	return concatKey(groupPolicyByGroupKeyPrefixByGroup(groupID), policy)
+}
+
+func groupPolicyByAdminKeyPrefixByAdmin(admin sdk.AccAddress) []byte {
+	return concatKey(groupPolicyByAdminKeyPrefix, address.MustLengthPrefix(admin))
+}
+
+func groupPolicyByAdminKey(admin, policy sdk.AccAddress) []byte {
+	return concatKey(groupPolicyByAdminKeyPrefixByAdmin(admin), policy)
+}
+
+// proposalKey key for a specific proposal from the store
+func proposalKey(id uint64) []byte {
+	return concatKey(proposalKeyPrefix, Uint64ToBytes(id))
+}
+
+func proposalByGroupPolicyKeyPrefixByGroupPolicy(policy sdk.AccAddress) []byte {
+	return concatKey(proposalByGroupPolicyKeyPrefix, address.MustLengthPrefix(policy))
+}
+
+func proposalByGroupPolicyKey(policy sdk.AccAddress, id uint64) []byte {
+	return concatKey(proposalByGroupPolicyKeyPrefixByGroupPolicy(policy), Uint64ToBytes(id))
+}
+
+func proposalByVPEndKey(vpEnd time.Time, id uint64) []byte {
+	return concatKey(proposalByVPEndKeyPrefix, sdk.FormatTimeBytes(vpEnd), Uint64ToBytes(id))
+}
+
+func splitProposalByVPEndKey(key []byte) (vpEnd time.Time, id uint64) {
+	prefix := proposalByVPEndKeyPrefix
+	begin := len(prefix)
+	end := begin + lenTime
+	vpEnd, err := sdk.ParseTimeBytes(key[begin:end])
+	if err != nil {
+		panic(err)
+	}
+
+	begin = end
+	id = Uint64FromBytes(key[begin:])
+
+	return
+}
+
+func voteKeyPrefixByProposal(proposalID uint64) []byte {
+	return concatKey(voteKeyPrefix, Uint64ToBytes(proposalID))
+}
+
+func voteKey(proposalID uint64, voter sdk.AccAddress) []byte {
+	return concatKey(voteKeyPrefixByProposal(proposalID), voter)
+}
+
+func voteByVoterKeyPrefixByVoter(voter sdk.AccAddress) []byte {
+	return concatKey(voteByVoterKeyPrefix, address.MustLengthPrefix(voter))
+}
+
+func voteByVoterKey(voter sdk.AccAddress, proposalID uint64) []byte {
+	return concatKey(voteByVoterKeyPrefixByVoter(voter), Uint64ToBytes(proposalID))
+}
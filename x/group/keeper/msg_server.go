@@ -0,0 +1,348 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/group"
+)
+
+const gasCostPerIteration = uint64(20)
+
+type msgServer struct {
+	keeper Keeper
+}
+
+// NewMsgServer returns an implementation of the group MsgServer interface
+// for the provided Keeper.
+func NewMsgServer(keeper Keeper) group.MsgServer {
+	return &msgServer{
+		keeper: keeper,
+	}
+}
+
+var _ group.MsgServer = msgServer{}
+
+func (s msgServer) CreateGroup(c context.Context, req *group.MsgCreateGroup) (*group.MsgCreateGroupResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	admin := sdk.MustAccAddressFromBech32(req.Admin)
+	id, err := s.keeper.CreateGroup(ctx, admin, req.Members, req.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&group.EventCreateGroup{
+		GroupId: id,
+	}); err != nil {
+		panic(err)
+	}
+
+	return &group.MsgCreateGroupResponse{GroupId: id}, nil
+}
+
+func (s msgServer) UpdateGroupMembers(c context.Context, req *group.MsgUpdateGroupMembers) (*group.MsgUpdateGroupMembersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := s.keeper.validateGroupAdmin(ctx, req.GroupId, req.Admin); err != nil {
+		return nil, err
+	}
+
+	if err := s.keeper.UpdateGroupMembers(ctx, req.GroupId, req.MemberUpdates); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&group.EventUpdateGroup{
+		GroupId: req.GroupId,
+	}); err != nil {
+		panic(err)
+	}
+
+	return &group.MsgUpdateGroupMembersResponse{}, nil
+}
+
+func (s msgServer) UpdateGroupAdmin(c context.Context, req *group.MsgUpdateGroupAdmin) (*group.MsgUpdateGroupAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := s.keeper.validateGroupAdmin(ctx, req.GroupId, req.Admin); err != nil {
+		return nil, err
+	}
+
+	newAdmin := sdk.MustAccAddressFromBech32(req.NewAdmin)
+	if err := s.keeper.UpdateGroupAdmin(ctx, req.GroupId, newAdmin); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&group.EventUpdateGroup{
+		GroupId: req.GroupId,
+	}); err != nil {
+		panic(err)
+	}
+
+	return &group.MsgUpdateGroupAdminResponse{}, nil
+}
+
+func (s msgServer) UpdateGroupMetadata(c context.Context, req *group.MsgUpdateGroupMetadata) (*group.MsgUpdateGroupMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := s.keeper.validateGroupAdmin(ctx, req.GroupId, req.Admin); err != nil {
+		return nil, err
+	}
+
+	if err := s.keeper.UpdateGroupMetadata(ctx, req.GroupId, req.Metadata); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&group.EventUpdateGroup{
+		GroupId: req.GroupId,
+	}); err != nil {
+		panic(err)
+	}
+
+	return &group.MsgUpdateGroupMetadataResponse{}, nil
+}
+
+func (s msgServer) CreateGroupPolicy(c context.Context, req *group.MsgCreateGroupPolicy) (*group.MsgCreateGroupPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := s.keeper.validateGroupAdmin(ctx, req.GroupId, req.Admin); err != nil {
+		return nil, err
+	}
+
+	admin := sdk.MustAccAddressFromBech32(req.Admin)
+	address, err := s.keeper.CreateGroupPolicy(ctx, admin, req.GroupId, req.Metadata, req.GetDecisionPolicy())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&group.EventCreateGroupPolicy{
+		Address: address.String(),
+	}); err != nil {
+		panic(err)
+	}
+
+	return &group.MsgCreateGroupPolicyResponse{Address: address.String()}, nil
+}
+
+func (s msgServer) UpdateGroupPolicyAdmin(c context.Context, req *group.MsgUpdateGroupPolicyAdmin) (*group.MsgUpdateGroupPolicyAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address := sdk.MustAccAddressFromBech32(req.GroupPolicyAddress)
+	if err := s.keeper.validateGroupPolicyAdmin(ctx, address, req.Admin); err != nil {
+		return nil, err
+	}
+
+	newAdmin := sdk.MustAccAddressFromBech32(req.NewAdmin)
+	if err := s.keeper.UpdateGroupPolicyAdmin(ctx, address, newAdmin); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&group.EventUpdateGroupPolicy{
+		Address: req.GroupPolicyAddress,
+	}); err != nil {
+		panic(err)
+	}
+
+	return &group.MsgUpdateGroupPolicyAdminResponse{}, nil
+}
+
+func (s msgServer) UpdateGroupPolicyDecisionPolicy(c context.Context, req *group.MsgUpdateGroupPolicyDecisionPolicy) (*group.MsgUpdateGroupPolicyDecisionPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address := sdk.MustAccAddressFromBech32(req.GroupPolicyAddress)
+	if err := s.keeper.validateGroupPolicyAdmin(ctx, address, req.Admin); err != nil {
+		return nil, err
+	}
+
+	if err := s.keeper.UpdateGroupPolicyDecisionPolicy(ctx, address, req.GetDecisionPolicy()); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&group.EventUpdateGroupPolicy{
+		Address: req.GroupPolicyAddress,
+	}); err != nil {
+		panic(err)
+	}
+
+	return &group.MsgUpdateGroupPolicyDecisionPolicyResponse{}, nil
+}
+
+func (s msgServer) UpdateGroupPolicyMetadata(c context.Context, req *group.MsgUpdateGroupPolicyMetadata) (*group.MsgUpdateGroupPolicyMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address := sdk.MustAccAddressFromBech32(req.GroupPolicyAddress)
+	if err := s.keeper.validateGroupPolicyAdmin(ctx, address, req.Admin); err != nil {
+		return nil, err
+	}
+
+	if err := s.keeper.UpdateGroupPolicyMetadata(ctx, address, req.Metadata); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&group.EventUpdateGroupPolicy{
+		Address: req.GroupPolicyAddress,
+	}); err != nil {
+		panic(err)
+	}
+
+	return &group.MsgUpdateGroupPolicyMetadataResponse{}, nil
+}
+
+func (s msgServer) SubmitProposal(c context.Context, req *group.MsgSubmitProposal) (*group.MsgSubmitProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address := sdk.MustAccAddressFromBech32(req.GroupPolicyAddress)
+	policyInfo, err := s.keeper.GetGroupPolicyInfo(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.keeper.validateGroupMembers(ctx, policyInfo.GroupId, req.Proposers); err != nil {
+		return nil, err
+	}
+
+	id, err := s.keeper.SubmitProposal(ctx, address, req.Proposers, req.Metadata, req.GetMsgs())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&group.EventSubmitProposal{
+		ProposalId: id,
+	}); err != nil {
+		panic(err)
+	}
+
+	// Try to execute proposal immediately
+	if req.Exec == group.Exec_EXEC_TRY {
+		// Consider proposers as Yes votes
+		for _, proposer := range req.Proposers {
+			ctx.GasMeter().ConsumeGas(gasCostPerIteration, "vote on proposal")
+
+			vote := group.Vote{
+				ProposalId: id,
+				Voter:      proposer,
+				Option:     group.VOTE_OPTION_YES,
+			}
+			if err := s.keeper.Vote(ctx, vote); err != nil {
+				return &group.MsgSubmitProposalResponse{ProposalId: id}, sdkerrors.Wrap(err, "The proposal was created but failed on vote")
+			}
+		}
+
+		// Then try to execute the proposal
+		if err := s.keeper.Exec(ctx, id); err != nil {
+			return &group.MsgSubmitProposalResponse{ProposalId: id}, sdkerrors.Wrap(err, "The proposal was created but failed on exec")
+		}
+	}
+
+	return &group.MsgSubmitProposalResponse{ProposalId: id}, nil
+}
+
+func (s msgServer) WithdrawProposal(c context.Context, req *group.MsgWithdrawProposal) (*group.MsgWithdrawProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	id := req.ProposalId
+
+	proposal, err := s.keeper.GetProposal(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// the admin of the group policy may withdraw any proposal.
+	policyAddress := sdk.MustAccAddressFromBech32(proposal.GroupPolicyAddress)
+	if err := s.keeper.validateGroupPolicyAdmin(ctx, policyAddress, req.Address); err != nil {
+		// check whether the address is in proposers list.
+		if err := validateActorForProposal(req.Address, *proposal); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.keeper.WithdrawProposal(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&group.EventWithdrawProposal{
+		ProposalId: id,
+	}); err != nil {
+		panic(err)
+	}
+
+	return &group.MsgWithdrawProposalResponse{}, nil
+}
+
+func (s msgServer) Vote(c context.Context, req *group.MsgVote) (*group.MsgVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	proposal, err := s.keeper.GetProposal(ctx, req.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	policyAddress := sdk.MustAccAddressFromBech32(proposal.GroupPolicyAddress)
+	policyInfo, err := s.keeper.GetGroupPolicyInfo(ctx, policyAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.keeper.validateGroupMembers(ctx, policyInfo.GroupId, []string{req.Voter}); err != nil {
+		return nil, err
+	}
+
+	vote := group.Vote{
+		ProposalId: req.ProposalId,
+		Voter:      req.Voter,
+		Option:     req.Option,
+		Metadata:   req.Metadata,
+	}
+	if err := s.keeper.Vote(ctx, vote); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&group.EventVote{
+		ProposalId: req.ProposalId,
+	}); err != nil {
+		panic(err)
+	}
+
+	// Try to execute proposal immediately
+	if req.Exec == group.Exec_EXEC_TRY {
+		if err := s.keeper.Exec(ctx, req.ProposalId); err != nil {
+			return nil, err
+		}
+	}
+
+	return &group.MsgVoteResponse{}, nil
+}
+
+func (s msgServer) Exec(c context.Context, req *group.MsgExec) (*group.MsgExecResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := s.keeper.Exec(ctx, req.ProposalId); err != nil {
+		return nil, err
+	}
+
+	// the proposal has been pruned on success
+	result := group.PROPOSAL_EXECUTOR_RESULT_SUCCESS
+	if proposal, err := s.keeper.GetProposal(ctx, req.ProposalId); err == nil {
+		result = proposal.ExecutorResult
+	}
+
+	return &group.MsgExecResponse{Result: result}, nil
+}
+
+func (s msgServer) LeaveGroup(c context.Context, req *group.MsgLeaveGroup) (*group.MsgLeaveGroupResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address := sdk.MustAccAddressFromBech32(req.Address)
+	if err := s.keeper.LeaveGroup(ctx, req.GroupId, address); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&group.EventLeaveGroup{
+		GroupId: req.GroupId,
+		Address: req.Address,
+	}); err != nil {
+		panic(err)
+	}
+
+	return &group.MsgLeaveGroupResponse{}, nil
+}
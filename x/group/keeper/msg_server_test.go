@@ -0,0 +1,719 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	banktypes "github.com/Finschia/finschia-sdk/x/bank/types"
+	"github.com/Finschia/finschia-sdk/x/group"
+)
+
+func (s *KeeperTestSuite) TestMsgCreateGroup() {
+	testCases := map[string]struct {
+		members []group.MemberRequest
+		valid   bool
+	}{
+		"valid request": {
+			members: []group.MemberRequest{{
+				Address: s.stranger.String(),
+				Weight:  sdk.OneDec(),
+			}},
+			valid: true,
+		},
+		"long metadata": {
+			members: []group.MemberRequest{{
+				Address:  s.stranger.String(),
+				Weight:   sdk.OneDec(),
+				Metadata: string(make([]rune, 256)),
+			}},
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			req := &group.MsgCreateGroup{
+				Admin:   s.admin.String(),
+				Members: tc.members,
+			}
+			res, err := s.msgServer.CreateGroup(sdk.WrapSDKContext(ctx), req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+
+			info, err := s.keeper.GetGroupInfo(ctx, res.GroupId)
+			s.Require().NoError(err)
+			s.Require().Equal(s.admin.String(), info.Admin)
+			s.Require().Equal(sdk.OneDec(), info.TotalWeight)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgUpdateGroupMembers() {
+	testCases := map[string]struct {
+		admin   sdk.AccAddress
+		request group.MemberRequest
+		valid   bool
+	}{
+		"valid request": {
+			admin: s.admin,
+			request: group.MemberRequest{
+				Address: s.stranger.String(),
+				Weight:  sdk.OneDec(),
+			},
+			valid: true,
+		},
+		"not authorized": {
+			admin: s.stranger,
+			request: group.MemberRequest{
+				Address: s.stranger.String(),
+				Weight:  sdk.OneDec(),
+			},
+		},
+		"remove a non-member": {
+			admin: s.admin,
+			request: group.MemberRequest{
+				Address: s.stranger.String(),
+				Remove:  true,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			req := &group.MsgUpdateGroupMembers{
+				Admin:         tc.admin.String(),
+				GroupId:       s.groupID,
+				MemberUpdates: []group.MemberRequest{tc.request},
+			}
+			res, err := s.msgServer.UpdateGroupMembers(sdk.WrapSDKContext(ctx), req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+
+			// the active proposals must be aborted
+			proposal, err := s.keeper.GetProposal(ctx, s.activeProposal)
+			s.Require().NoError(err)
+			s.Require().Equal(group.PROPOSAL_STATUS_ABORTED, proposal.Status)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgUpdateGroupAdmin() {
+	testCases := map[string]struct {
+		admin sdk.AccAddress
+		valid bool
+	}{
+		"valid request": {
+			admin: s.admin,
+			valid: true,
+		},
+		"not authorized": {
+			admin: s.stranger,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			req := &group.MsgUpdateGroupAdmin{
+				Admin:    tc.admin.String(),
+				GroupId:  s.groupID,
+				NewAdmin: s.stranger.String(),
+			}
+			res, err := s.msgServer.UpdateGroupAdmin(sdk.WrapSDKContext(ctx), req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+
+			info, err := s.keeper.GetGroupInfo(ctx, s.groupID)
+			s.Require().NoError(err)
+			s.Require().Equal(s.stranger.String(), info.Admin)
+
+			// the admin index follows the new admin
+			for admin, expected := range map[string]int{
+				s.admin.String():    0,
+				s.stranger.String(): 1,
+			} {
+				groups, err := s.queryServer.GroupsByAdmin(sdk.WrapSDKContext(ctx), &group.QueryGroupsByAdminRequest{Admin: admin})
+				s.Require().NoError(err)
+				s.Require().Len(groups.Groups, expected)
+			}
+
+			// the proposals are not affected
+			proposal, err := s.keeper.GetProposal(ctx, s.activeProposal)
+			s.Require().NoError(err)
+			s.Require().Equal(group.PROPOSAL_STATUS_SUBMITTED, proposal.Status)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgUpdateGroupMetadata() {
+	testCases := map[string]struct {
+		admin    sdk.AccAddress
+		metadata string
+		valid    bool
+	}{
+		"valid request": {
+			admin:    s.admin,
+			metadata: "new metadata",
+			valid:    true,
+		},
+		"not authorized": {
+			admin:    s.stranger,
+			metadata: "new metadata",
+		},
+		"long metadata": {
+			admin:    s.admin,
+			metadata: string(make([]rune, 256)),
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			req := &group.MsgUpdateGroupMetadata{
+				Admin:    tc.admin.String(),
+				GroupId:  s.groupID,
+				Metadata: tc.metadata,
+			}
+			res, err := s.msgServer.UpdateGroupMetadata(sdk.WrapSDKContext(ctx), req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+
+			info, err := s.keeper.GetGroupInfo(ctx, s.groupID)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.metadata, info.Metadata)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgCreateGroupPolicy() {
+	testCases := map[string]struct {
+		admin  sdk.AccAddress
+		policy group.DecisionPolicy
+		valid  bool
+	}{
+		"valid request": {
+			admin:  s.admin,
+			policy: workingPolicy(),
+			valid:  true,
+		},
+		"not authorized": {
+			admin:  s.stranger,
+			policy: workingPolicy(),
+		},
+		"voting period too long": {
+			admin: s.admin,
+			policy: &group.PercentageDecisionPolicy{
+				Percentage: sdk.OneDec(),
+				Windows: &group.DecisionPolicyWindows{
+					VotingPeriod:       1,
+					MinExecutionPeriod: group.DefaultConfig().MaxExecutionPeriod + 2,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			req := &group.MsgCreateGroupPolicy{
+				Admin:   tc.admin.String(),
+				GroupId: s.groupID,
+			}
+			err := req.SetDecisionPolicy(tc.policy)
+			s.Require().NoError(err)
+
+			res, err := s.msgServer.CreateGroupPolicy(sdk.WrapSDKContext(ctx), req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+
+			address := sdk.MustAccAddressFromBech32(res.Address)
+			s.Require().NotEqual(s.groupPolicy, address)
+			s.Require().NotNil(s.app.AccountKeeper.GetAccount(ctx, address))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgUpdateGroupPolicyDecisionPolicy() {
+	testCases := map[string]struct {
+		admin sdk.AccAddress
+		valid bool
+	}{
+		"valid request": {
+			admin: s.admin,
+			valid: true,
+		},
+		"not authorized": {
+			admin: s.stranger,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			req := &group.MsgUpdateGroupPolicyDecisionPolicy{
+				Admin:              tc.admin.String(),
+				GroupPolicyAddress: s.groupPolicy.String(),
+			}
+			err := req.SetDecisionPolicy(workingPolicy())
+			s.Require().NoError(err)
+
+			res, err := s.msgServer.UpdateGroupPolicyDecisionPolicy(sdk.WrapSDKContext(ctx), req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+
+			// the active proposals must be aborted
+			proposal, err := s.keeper.GetProposal(ctx, s.activeProposal)
+			s.Require().NoError(err)
+			s.Require().Equal(group.PROPOSAL_STATUS_ABORTED, proposal.Status)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgUpdateGroupPolicyAdmin() {
+	testCases := map[string]struct {
+		admin sdk.AccAddress
+		valid bool
+	}{
+		"valid request": {
+			admin: s.admin,
+			valid: true,
+		},
+		"not authorized": {
+			admin: s.stranger,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			req := &group.MsgUpdateGroupPolicyAdmin{
+				Admin:              tc.admin.String(),
+				GroupPolicyAddress: s.groupPolicy.String(),
+				NewAdmin:           s.stranger.String(),
+			}
+			res, err := s.msgServer.UpdateGroupPolicyAdmin(sdk.WrapSDKContext(ctx), req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+
+			info, err := s.keeper.GetGroupPolicyInfo(ctx, s.groupPolicy)
+			s.Require().NoError(err)
+			s.Require().Equal(s.stranger.String(), info.Admin)
+
+			// the admin index follows the new admin
+			for admin, expected := range map[string]int{
+				s.admin.String():    0,
+				s.stranger.String(): 1,
+			} {
+				policies, err := s.queryServer.GroupPoliciesByAdmin(sdk.WrapSDKContext(ctx), &group.QueryGroupPoliciesByAdminRequest{Admin: admin})
+				s.Require().NoError(err)
+				s.Require().Len(policies.GroupPolicies, expected)
+			}
+
+			// the proposals are not affected
+			proposal, err := s.keeper.GetProposal(ctx, s.activeProposal)
+			s.Require().NoError(err)
+			s.Require().Equal(group.PROPOSAL_STATUS_SUBMITTED, proposal.Status)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgUpdateGroupPolicyMetadata() {
+	testCases := map[string]struct {
+		admin    sdk.AccAddress
+		metadata string
+		valid    bool
+	}{
+		"valid request": {
+			admin:    s.admin,
+			metadata: "new metadata",
+			valid:    true,
+		},
+		"not authorized": {
+			admin:    s.stranger,
+			metadata: "new metadata",
+		},
+		"long metadata": {
+			admin:    s.admin,
+			metadata: string(make([]rune, 256)),
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			req := &group.MsgUpdateGroupPolicyMetadata{
+				Admin:              tc.admin.String(),
+				GroupPolicyAddress: s.groupPolicy.String(),
+				Metadata:           tc.metadata,
+			}
+			res, err := s.msgServer.UpdateGroupPolicyMetadata(sdk.WrapSDKContext(ctx), req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+
+			info, err := s.keeper.GetGroupPolicyInfo(ctx, s.groupPolicy)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.metadata, info.Metadata)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgSubmitProposal() {
+	testCases := map[string]struct {
+		proposers []string
+		exec      group.Exec
+		valid     bool
+	}{
+		"valid request": {
+			proposers: []string{s.members[0].String()},
+			valid:     true,
+		},
+		"not a member": {
+			proposers: []string{s.stranger.String()},
+		},
+		"try exec": {
+			proposers: []string{s.members[0].String(), s.members[1].String(), s.members[2].String()},
+			exec:      group.Exec_EXEC_TRY,
+			valid:     true,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			req := &group.MsgSubmitProposal{
+				GroupPolicyAddress: s.groupPolicy.String(),
+				Proposers:          tc.proposers,
+				Exec:               tc.exec,
+			}
+			err := req.SetMsgs([]sdk.Msg{s.newMsgSend(s.balance)})
+			s.Require().NoError(err)
+
+			res, err := s.msgServer.SubmitProposal(sdk.WrapSDKContext(ctx), req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+
+			if tc.exec == group.Exec_EXEC_TRY {
+				// executed and pruned
+				_, err := s.keeper.GetProposal(ctx, res.ProposalId)
+				s.Require().Error(err)
+				s.Require().Equal(s.balance, s.app.BankKeeper.GetBalance(ctx, s.stranger, sdk.DefaultBondDenom).Amount)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgWithdrawProposal() {
+	testCases := map[string]struct {
+		proposalID uint64
+		address    sdk.AccAddress
+		valid      bool
+	}{
+		"valid request (proposer)": {
+			proposalID: s.activeProposal,
+			address:    s.members[0],
+			valid:      true,
+		},
+		"valid request (admin)": {
+			proposalID: s.activeProposal,
+			address:    s.admin,
+			valid:      true,
+		},
+		"not authorized": {
+			proposalID: s.activeProposal,
+			address:    s.stranger,
+		},
+		"already withdrawn": {
+			proposalID: s.withdrawnProposal,
+			address:    s.members[0],
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			req := &group.MsgWithdrawProposal{
+				ProposalId: tc.proposalID,
+				Address:    tc.address.String(),
+			}
+			res, err := s.msgServer.WithdrawProposal(sdk.WrapSDKContext(ctx), req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgVote() {
+	testCases := map[string]struct {
+		proposalID uint64
+		voter      sdk.AccAddress
+		exec       group.Exec
+		valid      bool
+	}{
+		"valid request": {
+			proposalID: s.activeProposal,
+			voter:      s.members[0],
+			valid:      true,
+		},
+		"valid request (exec)": {
+			proposalID: s.activeProposal,
+			voter:      s.members[0],
+			exec:       group.Exec_EXEC_TRY,
+			valid:      true,
+		},
+		"not a member": {
+			proposalID: s.activeProposal,
+			voter:      s.stranger,
+		},
+		"already voted": {
+			proposalID: s.votedProposal,
+			voter:      s.members[0],
+		},
+		"withdrawn proposal": {
+			proposalID: s.withdrawnProposal,
+			voter:      s.members[0],
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			req := &group.MsgVote{
+				ProposalId: tc.proposalID,
+				Voter:      tc.voter.String(),
+				Option:     group.VOTE_OPTION_YES,
+				Exec:       tc.exec,
+			}
+			res, err := s.msgServer.Vote(sdk.WrapSDKContext(ctx), req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgExec() {
+	testCases := map[string]struct {
+		proposalID uint64
+		result     group.ProposalExecutorResult
+		valid      bool
+	}{
+		"valid request (accepted)": {
+			proposalID: s.activeProposal,
+			result:     group.PROPOSAL_EXECUTOR_RESULT_SUCCESS,
+			valid:      true,
+		},
+		"valid request (rejected)": {
+			proposalID: s.votedProposal,
+			result:     group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN,
+			valid:      true,
+		},
+		"withdrawn proposal": {
+			proposalID: s.withdrawnProposal,
+		},
+		"no such a proposal": {
+			proposalID: s.withdrawnProposal + 1,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			req := &group.MsgExec{
+				ProposalId: tc.proposalID,
+				Executor:   s.stranger.String(),
+			}
+			res, err := s.msgServer.Exec(sdk.WrapSDKContext(ctx), req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			s.Require().Equal(tc.result, res.Result)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgLeaveGroup() {
+	testCases := map[string]struct {
+		address sdk.AccAddress
+		valid   bool
+	}{
+		"valid request": {
+			address: s.members[0],
+			valid:   true,
+		},
+		"not a member": {
+			address: s.stranger,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			req := &group.MsgLeaveGroup{
+				Address: tc.address.String(),
+				GroupId: s.groupID,
+			}
+			res, err := s.msgServer.LeaveGroup(sdk.WrapSDKContext(ctx), req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+
+			info, err := s.keeper.GetGroupInfo(ctx, s.groupID)
+			s.Require().NoError(err)
+			s.Require().Equal(sdk.NewDec(int64(len(s.members)-1)), info.TotalWeight)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestImportProposal() {
+	// submitted an hour ago
+	submitTime := s.ctx.BlockTime()
+	votingPeriodEnd := submitTime.Add(workingPolicy().GetVotingPeriod())
+	now := submitTime.Add(time.Hour)
+
+	testCases := map[string]struct {
+		policyAddress sdk.AccAddress
+		msg           sdk.Msg
+		metadata      string
+		voter         sdk.AccAddress
+		valid         bool
+	}{
+		"valid request": {
+			policyAddress: s.groupPolicy,
+			msg:           s.newMsgSend(sdk.OneInt()),
+			voter:         s.members[0],
+			valid:         true,
+		},
+		"no such a group policy": {
+			policyAddress: s.stranger,
+			msg:           s.newMsgSend(sdk.OneInt()),
+			voter:         s.members[0],
+		},
+		"unauthorized msg": {
+			policyAddress: s.groupPolicy,
+			msg: &banktypes.MsgSend{
+				FromAddress: s.stranger.String(),
+				ToAddress:   s.stranger.String(),
+				Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())),
+			},
+			voter: s.members[0],
+		},
+		"long metadata": {
+			policyAddress: s.groupPolicy,
+			msg:           s.newMsgSend(sdk.OneInt()),
+			metadata:      string(make([]rune, 256)),
+			voter:         s.members[0],
+		},
+		"voter not a member": {
+			policyAddress: s.groupPolicy,
+			msg:           s.newMsgSend(sdk.OneInt()),
+			voter:         s.stranger,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			ctx = ctx.WithBlockTime(now)
+
+			proposal := group.Proposal{
+				GroupPolicyAddress: tc.policyAddress.String(),
+				Metadata:           tc.metadata,
+				Proposers:          []string{s.stranger.String()},
+				SubmitTime:         submitTime,
+				VotingPeriodEnd:    votingPeriodEnd,
+			}
+			err := proposal.SetMsgs([]sdk.Msg{tc.msg})
+			s.Require().NoError(err)
+
+			votes := []group.Vote{{
+				Voter:  tc.voter.String(),
+				Option: group.VOTE_OPTION_YES,
+			}}
+			id, err := s.keeper.ImportProposal(ctx, proposal, votes)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			// the proposal keeps its proposers and periods
+			imported, err := s.keeper.GetProposal(ctx, id)
+			s.Require().NoError(err)
+			s.Require().Equal(group.PROPOSAL_STATUS_SUBMITTED, imported.Status)
+			s.Require().Equal(proposal.Proposers, imported.Proposers)
+			s.Require().Equal(submitTime, imported.SubmitTime)
+			s.Require().Equal(votingPeriodEnd, imported.VotingPeriodEnd)
+
+			// and the votes are moved to the new id
+			vote, err := s.queryServer.VoteByProposalVoter(sdk.WrapSDKContext(ctx), &group.QueryVoteByProposalVoterRequest{
+				ProposalId: id,
+				Voter:      tc.voter.String(),
+			})
+			s.Require().NoError(err)
+			s.Require().Equal(group.VOTE_OPTION_YES, vote.Vote.Option)
+		})
+	}
+}
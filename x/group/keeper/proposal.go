@@ -0,0 +1,322 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/group"
+)
+
+func (k Keeper) SubmitProposal(ctx sdk.Context, policyAddress sdk.AccAddress, proposers []string, metadata string, msgs []sdk.Msg) (uint64, error) {
+	if err := validateMetadata(metadata, k.config); err != nil {
+		return 0, err
+	}
+
+	policyInfo, err := k.GetGroupPolicyInfo(ctx, policyAddress)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := ensureMsgAuthz(msgs, policyAddress); err != nil {
+		return 0, err
+	}
+
+	groupInfo, err := k.GetGroupInfo(ctx, policyInfo.GroupId)
+	if err != nil {
+		return 0, err
+	}
+
+	// Prevent proposal that can not succeed.
+	policy := policyInfo.GetDecisionPolicy()
+	if err := policy.Validate(*groupInfo, k.config); err != nil {
+		return 0, err
+	}
+
+	proposal := group.Proposal{
+		GroupPolicyAddress: policyInfo.Address,
+		Metadata:           metadata,
+		Proposers:          proposers,
+		SubmitTime:         ctx.BlockTime(),
+		VotingPeriodEnd:    ctx.BlockTime().Add(policy.GetVotingPeriod()),
+	}
+	if err := proposal.SetMsgs(msgs); err != nil {
+		return 0, err
+	}
+
+	return k.addProposal(ctx, proposal, *groupInfo, *policyInfo), nil
+}
+
+// ImportProposal adds a proposal which has been submitted elsewhere, keeping
+// its proposers, submit time and the end of its voting period, together with
+// the votes already cast. The voters must be the members of the group.
+func (k Keeper) ImportProposal(ctx sdk.Context, proposal group.Proposal, votes []group.Vote) (uint64, error) {
+	if err := validateMetadata(proposal.Metadata, k.config); err != nil {
+		return 0, err
+	}
+
+	policyAddress := sdk.MustAccAddressFromBech32(proposal.GroupPolicyAddress)
+	policyInfo, err := k.GetGroupPolicyInfo(ctx, policyAddress)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := ensureMsgAuthz(proposal.GetMsgs(), policyAddress); err != nil {
+		return 0, err
+	}
+
+	groupInfo, err := k.GetGroupInfo(ctx, policyInfo.GroupId)
+	if err != nil {
+		return 0, err
+	}
+
+	id := k.addProposal(ctx, proposal, *groupInfo, *policyInfo)
+
+	for _, vote := range votes {
+		if err := validateMetadata(vote.Metadata, k.config); err != nil {
+			return 0, err
+		}
+		if err := k.validateGroupMembers(ctx, groupInfo.Id, []string{vote.Voter}); err != nil {
+			return 0, err
+		}
+
+		vote.ProposalId = id
+		k.setVote(ctx, vote)
+	}
+
+	return id, nil
+}
+
+// addProposal stores a new proposal of the current versions of the group and
+// the group policy.
+func (k Keeper) addProposal(ctx sdk.Context, proposal group.Proposal, groupInfo group.GroupInfo, policyInfo group.GroupPolicyInfo) uint64 {
+	id := k.nextSequence(ctx, proposalSeqKey)
+
+	proposal.Id = id
+	proposal.GroupVersion = groupInfo.Version
+	proposal.GroupPolicyVersion = policyInfo.Version
+	proposal.Status = group.PROPOSAL_STATUS_SUBMITTED
+	proposal.ExecutorResult = group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN
+	proposal.FinalTallyResult = group.DefaultTallyResult()
+
+	k.setProposal(ctx, proposal)
+	k.addProposalToVPEndQueue(ctx, proposal)
+
+	return id
+}
+
+func (k Keeper) WithdrawProposal(ctx sdk.Context, proposalID uint64) error {
+	proposal, err := k.GetProposal(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+
+	// Ensure the proposal can be withdrawn.
+	if proposal.Status != group.PROPOSAL_STATUS_SUBMITTED {
+		return sdkerrors.ErrInvalidRequest.Wrapf("cannot withdraw a proposal with the status of %s", proposal.Status)
+	}
+
+	proposal.Status = group.PROPOSAL_STATUS_WITHDRAWN
+	k.setProposal(ctx, *proposal)
+
+	return nil
+}
+
+// pruneProposal deletes a proposal from state.
+func (k Keeper) pruneProposal(ctx sdk.Context, proposal group.Proposal) {
+	k.pruneVotes(ctx, proposal.Id)
+	k.removeProposalFromVPEndQueue(ctx, proposal)
+	k.deleteProposal(ctx, proposal)
+}
+
+// PruneExpiredProposals prunes all proposals which are expired,
+// i.e. whose `submit_time + voting_period + max_execution_period` is smaller than (or equal to) now.
+func (k Keeper) PruneExpiredProposals(ctx sdk.Context) {
+	votingPeriodEnd := ctx.BlockTime().Add(-k.config.MaxExecutionPeriod).Add(time.Nanosecond)
+
+	var proposals []group.Proposal
+	k.iterateProposalsByVPEnd(ctx, votingPeriodEnd, func(proposal group.Proposal) (stop bool) {
+		proposals = append(proposals, proposal)
+		return false
+	})
+
+	for _, proposal := range proposals {
+		k.pruneProposal(ctx, proposal)
+	}
+}
+
+// abortOldProposalsOfGroup aborts the old proposals of all the group policies
+// of a group.
+func (k Keeper) abortOldProposalsOfGroup(ctx sdk.Context, groupID uint64) {
+	var addresses []sdk.AccAddress
+	k.iterateGroupPolicyAddressesByGroup(ctx, groupID, func(address sdk.AccAddress) (stop bool) {
+		addresses = append(addresses, address)
+		return false
+	})
+
+	for _, address := range addresses {
+		k.abortOldProposals(ctx, address)
+	}
+}
+
+// abortOldProposals aborts all proposals of a group policy which have lower
+// versions than the current group's or group policy's.
+func (k Keeper) abortOldProposals(ctx sdk.Context, policyAddress sdk.AccAddress) {
+	policyInfo, err := k.GetGroupPolicyInfo(ctx, policyAddress)
+	if err != nil {
+		panic(err)
+	}
+	groupInfo, err := k.GetGroupInfo(ctx, policyInfo.GroupId)
+	if err != nil {
+		panic(err)
+	}
+
+	var proposals []group.Proposal
+	k.iterateProposalsByGroupPolicy(ctx, policyAddress, func(proposal group.Proposal) (stop bool) {
+		if proposal.Status != group.PROPOSAL_STATUS_SUBMITTED {
+			return false
+		}
+
+		if proposal.GroupVersion != groupInfo.Version || proposal.GroupPolicyVersion != policyInfo.Version {
+			proposals = append(proposals, proposal)
+		}
+
+		return false
+	})
+
+	for _, proposal := range proposals {
+		k.pruneVotes(ctx, proposal.Id)
+
+		proposal.Status = group.PROPOSAL_STATUS_ABORTED
+		k.setProposal(ctx, proposal)
+	}
+}
+
+func (k Keeper) iterateProposals(ctx sdk.Context, fn func(proposal group.Proposal) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, proposalKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var proposal group.Proposal
+		k.cdc.MustUnmarshal(iterator.Value(), &proposal)
+		if stop := fn(proposal); stop {
+			break
+		}
+	}
+}
+
+func (k Keeper) iterateProposalsByGroupPolicy(ctx sdk.Context, policyAddress sdk.AccAddress, fn func(proposal group.Proposal) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := proposalByGroupPolicyKeyPrefixByGroupPolicy(policyAddress)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		id := Uint64FromBytes(iterator.Key()[len(prefix):])
+		proposal, err := k.GetProposal(ctx, id)
+		if err != nil {
+			panic(err)
+		}
+
+		if stop := fn(*proposal); stop {
+			break
+		}
+	}
+}
+
+func (k Keeper) iterateProposalsByVPEnd(ctx sdk.Context, endTime time.Time, fn func(proposal group.Proposal) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(proposalByVPEndKeyPrefix, sdk.PrefixEndBytes(append(proposalByVPEndKeyPrefix, sdk.FormatTimeBytes(endTime)...)))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		_, id := splitProposalByVPEndKey(iter.Key())
+
+		proposal, err := k.GetProposal(ctx, id)
+		if err != nil {
+			panic(err)
+		}
+
+		if fn(*proposal) {
+			break
+		}
+	}
+}
+
+func (k Keeper) UpdateTallyOfVPEndProposals(ctx sdk.Context) {
+	var proposals []group.Proposal
+	k.iterateProposalsByVPEnd(ctx, ctx.BlockTime(), func(proposal group.Proposal) (stop bool) {
+		proposals = append(proposals, proposal)
+		return false
+	})
+
+	for _, proposal := range proposals {
+		proposal := proposal
+
+		if proposal.Status == group.PROPOSAL_STATUS_ABORTED || proposal.Status == group.PROPOSAL_STATUS_WITHDRAWN {
+			k.pruneProposal(ctx, proposal)
+			continue
+		}
+
+		// the tally fails if the min execution period has not passed yet,
+		// in which case MsgExec would tally the proposal later.
+		if err := k.doTallyAndUpdate(ctx, &proposal); err != nil {
+			k.Logger(ctx).Info("failed to tally proposal", "proposal_id", proposal.Id, "error", err)
+			continue
+		}
+		k.setProposal(ctx, proposal)
+	}
+}
+
+func (k Keeper) GetProposal(ctx sdk.Context, id uint64) (*group.Proposal, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(proposalKey(id))
+	if len(bz) == 0 {
+		return nil, sdkerrors.ErrNotFound.Wrapf("No proposal for id: %d", id)
+	}
+
+	var proposal group.Proposal
+	k.cdc.MustUnmarshal(bz, &proposal)
+
+	return &proposal, nil
+}
+
+func (k Keeper) setProposal(ctx sdk.Context, proposal group.Proposal) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&proposal)
+	store.Set(proposalKey(proposal.Id), bz)
+
+	policyAddress := sdk.MustAccAddressFromBech32(proposal.GroupPolicyAddress)
+	store.Set(proposalByGroupPolicyKey(policyAddress, proposal.Id), []byte{})
+}
+
+func (k Keeper) deleteProposal(ctx sdk.Context, proposal group.Proposal) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(proposalKey(proposal.Id))
+
+	policyAddress := sdk.MustAccAddressFromBech32(proposal.GroupPolicyAddress)
+	store.Delete(proposalByGroupPolicyKey(policyAddress, proposal.Id))
+}
+
+func (k Keeper) addProposalToVPEndQueue(ctx sdk.Context, proposal group.Proposal) {
+	store := ctx.KVStore(k.storeKey)
+	key := proposalByVPEndKey(proposal.VotingPeriodEnd, proposal.Id)
+	store.Set(key, []byte{})
+}
+
+func (k Keeper) removeProposalFromVPEndQueue(ctx sdk.Context, proposal group.Proposal) {
+	store := ctx.KVStore(k.storeKey)
+	key := proposalByVPEndKey(proposal.VotingPeriodEnd, proposal.Id)
+	store.Delete(key)
+}
+
+func validateActorForProposal(address string, proposal group.Proposal) error {
+	for _, proposer := range proposal.Proposers {
+		if address == proposer {
+			return nil
+		}
+	}
+
+	return sdkerrors.ErrUnauthorized.Wrapf("not a proposer: %s", address)
+}
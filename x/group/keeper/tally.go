@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/group"
+)
+
+// doTallyAndUpdate performs a tally, and, if the tally result is final, then:
+// - updates the proposal's `Status` and `FinalTallyResult` fields,
+// - prune all the votes.
+func (k Keeper) doTallyAndUpdate(ctx sdk.Context, p *group.Proposal) error {
+	policyAddress := sdk.MustAccAddressFromBech32(p.GroupPolicyAddress)
+	policyInfo, err := k.GetGroupPolicyInfo(ctx, policyAddress)
+	if err != nil {
+		return err
+	}
+	groupInfo, err := k.GetGroupInfo(ctx, policyInfo.GroupId)
+	if err != nil {
+		return err
+	}
+
+	tallyResult, err := k.tally(ctx, *p, groupInfo.Id)
+	if err != nil {
+		return err
+	}
+
+	policy := policyInfo.GetDecisionPolicy()
+	sinceSubmission := ctx.BlockTime().Sub(p.SubmitTime) // duration passed since proposal submission.
+	result, err := policy.Allow(tallyResult, groupInfo.TotalWeight, sinceSubmission)
+	if err != nil {
+		return err
+	}
+
+	// If the result was final (i.e. enough votes to pass) or if the voting
+	// period ended, then we consider the proposal as final.
+	if isFinal := result.Final || ctx.BlockTime().After(p.VotingPeriodEnd); isFinal {
+		k.pruneVotes(ctx, p.Id)
+		p.FinalTallyResult = tallyResult
+		if result.Allow {
+			p.Status = group.PROPOSAL_STATUS_ACCEPTED
+		} else {
+			p.Status = group.PROPOSAL_STATUS_REJECTED
+		}
+	}
+
+	return nil
+}
+
+// tally is a function that tallies a proposal by iterating through its votes,
+// and returns the tally result without modifying the proposal or any state.
+func (k Keeper) tally(ctx sdk.Context, p group.Proposal, groupID uint64) (group.TallyResult, error) {
+	// If proposal has already been tallied and updated, then its status is
+	// accepted/rejected, in which case we just return the previously stored result.
+	//
+	// In all other cases (including withdrawn, aborted...) we do the tally
+	// again.
+	if p.Status == group.PROPOSAL_STATUS_ACCEPTED || p.Status == group.PROPOSAL_STATUS_REJECTED {
+		return p.FinalTallyResult, nil
+	}
+
+	tallyResult := group.DefaultTallyResult()
+	var errIter error
+	k.iterateVotes(ctx, p.Id, func(vote group.Vote) (stop bool) {
+		voter := sdk.MustAccAddressFromBech32(vote.Voter)
+
+		member, err := k.GetGroupMember(ctx, groupID, voter)
+		switch {
+		case sdkerrors.ErrNotFound.Is(err):
+			// If the member left the group after voting, then we simply skip the
+			// vote.
+			return false
+		case err != nil:
+			// For any other errors, we stop and return the error.
+			errIter = err
+			return true
+		}
+
+		if err := tallyResult.Add(vote.Option, member.Weight); err != nil {
+			panic(err)
+		}
+
+		return false
+	})
+
+	if errIter != nil {
+		return tallyResult, errIter
+	}
+
+	return tallyResult, nil
+}
@@ -0,0 +1,173 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	banktypes "github.com/Finschia/finschia-sdk/x/bank/types"
+	"github.com/Finschia/finschia-sdk/x/group"
+)
+
+// setWeights gives the members the weights of 4, 3, 1, 1 and 1, so the total
+// weight of the group is 10.
+func (s *KeeperTestSuite) setWeights(ctx sdk.Context) {
+	weights := []int64{4, 3, 1, 1, 1}
+	requests := make([]group.MemberRequest, len(s.members))
+	for i, member := range s.members {
+		requests[i] = group.MemberRequest{
+			Address: member.String(),
+			Weight:  sdk.NewDec(weights[i]),
+		}
+	}
+	err := s.keeper.UpdateGroupMembers(ctx, s.groupID, requests)
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestTallyWeighted() {
+	policy := &group.ThresholdDecisionPolicy{
+		Threshold: sdk.NewDec(5),
+		Windows: &group.DecisionPolicyWindows{
+			VotingPeriod: time.Hour,
+		},
+	}
+
+	testCases := map[string]struct {
+		votes  map[int]group.VoteOption
+		tally  group.TallyResult
+		status group.ProposalStatus
+	}{
+		"reach the threshold": {
+			votes: map[int]group.VoteOption{
+				0: group.VOTE_OPTION_YES,
+				2: group.VOTE_OPTION_YES,
+			},
+			tally:  group.NewTallyResult(sdk.NewDec(5), sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+			status: group.PROPOSAL_STATUS_ACCEPTED,
+		},
+		"heaviest member alone": {
+			votes: map[int]group.VoteOption{
+				0: group.VOTE_OPTION_YES,
+				1: group.VOTE_OPTION_NO,
+			},
+			tally:  group.NewTallyResult(sdk.NewDec(4), sdk.ZeroDec(), sdk.NewDec(3), sdk.ZeroDec()),
+			status: group.PROPOSAL_STATUS_REJECTED,
+		},
+		"majority of the voters, not of the weight": {
+			votes: map[int]group.VoteOption{
+				0: group.VOTE_OPTION_NO_WITH_VETO,
+				2: group.VOTE_OPTION_YES,
+				3: group.VOTE_OPTION_YES,
+				4: group.VOTE_OPTION_YES,
+			},
+			tally:  group.NewTallyResult(sdk.NewDec(3), sdk.ZeroDec(), sdk.ZeroDec(), sdk.NewDec(4)),
+			status: group.PROPOSAL_STATUS_REJECTED,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			s.setWeights(ctx)
+			s.testTally(ctx, policy, tc.votes, tc.tally, tc.status)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestTallyPercentage() {
+	policy := &group.PercentageDecisionPolicy{
+		Percentage: sdk.MustNewDecFromStr("0.5"),
+		Windows: &group.DecisionPolicyWindows{
+			VotingPeriod: time.Hour,
+		},
+	}
+
+	testCases := map[string]struct {
+		votes  map[int]group.VoteOption
+		tally  group.TallyResult
+		status group.ProposalStatus
+	}{
+		"reach the percentage": {
+			votes: map[int]group.VoteOption{
+				1: group.VOTE_OPTION_YES,
+				2: group.VOTE_OPTION_YES,
+				3: group.VOTE_OPTION_YES,
+				0: group.VOTE_OPTION_NO,
+			},
+			tally:  group.NewTallyResult(sdk.NewDec(5), sdk.ZeroDec(), sdk.NewDec(4), sdk.ZeroDec()),
+			status: group.PROPOSAL_STATUS_ACCEPTED,
+		},
+		"below the percentage": {
+			votes: map[int]group.VoteOption{
+				0: group.VOTE_OPTION_YES,
+				1: group.VOTE_OPTION_NO,
+			},
+			tally:  group.NewTallyResult(sdk.NewDec(4), sdk.ZeroDec(), sdk.NewDec(3), sdk.ZeroDec()),
+			status: group.PROPOSAL_STATUS_REJECTED,
+		},
+		"abstentions excluded": {
+			// 4 / (10 - 3) >= 0.5
+			votes: map[int]group.VoteOption{
+				0: group.VOTE_OPTION_YES,
+				1: group.VOTE_OPTION_ABSTAIN,
+			},
+			tally:  group.NewTallyResult(sdk.NewDec(4), sdk.NewDec(3), sdk.ZeroDec(), sdk.ZeroDec()),
+			status: group.PROPOSAL_STATUS_ACCEPTED,
+		},
+		"all abstain": {
+			votes: map[int]group.VoteOption{
+				0: group.VOTE_OPTION_ABSTAIN,
+				1: group.VOTE_OPTION_ABSTAIN,
+				2: group.VOTE_OPTION_ABSTAIN,
+				3: group.VOTE_OPTION_ABSTAIN,
+				4: group.VOTE_OPTION_ABSTAIN,
+			},
+			tally:  group.NewTallyResult(sdk.ZeroDec(), sdk.NewDec(10), sdk.ZeroDec(), sdk.ZeroDec()),
+			status: group.PROPOSAL_STATUS_REJECTED,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			s.setWeights(ctx)
+			s.testTally(ctx, policy, tc.votes, tc.tally, tc.status)
+		})
+	}
+}
+
+// testTally submits a proposal to a new group policy of the decision policy,
+// casts the votes by the indexes of the members, and checks the tally and the
+// final status of the proposal after its voting period.
+func (s *KeeperTestSuite) testTally(ctx sdk.Context, policy group.DecisionPolicy, votes map[int]group.VoteOption, tally group.TallyResult, status group.ProposalStatus) {
+	policyAddress, err := s.keeper.CreateGroupPolicy(ctx, s.admin, s.groupID, "", policy)
+	s.Require().NoError(err)
+
+	msg := &banktypes.MsgSend{
+		FromAddress: policyAddress.String(),
+		ToAddress:   s.stranger.String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())),
+	}
+	id, err := s.keeper.SubmitProposal(ctx, policyAddress, []string{s.members[0].String()}, "", []sdk.Msg{msg})
+	s.Require().NoError(err)
+
+	for i, option := range votes {
+		err := s.keeper.Vote(ctx, group.Vote{
+			ProposalId: id,
+			Voter:      s.members[i].String(),
+			Option:     option,
+		})
+		s.Require().NoError(err)
+	}
+
+	res, err := s.queryServer.TallyResult(sdk.WrapSDKContext(ctx), &group.QueryTallyResultRequest{ProposalId: id})
+	s.Require().NoError(err)
+	s.Require().Equal(tally, res.Tally)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(policy.GetVotingPeriod()).Add(time.Nanosecond))
+	s.keeper.UpdateTallyOfVPEndProposals(ctx)
+
+	proposal, err := s.keeper.GetProposal(ctx, id)
+	s.Require().NoError(err)
+	s.Require().Equal(status, proposal.Status)
+	s.Require().Equal(tally, proposal.FinalTallyResult)
+}
@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/group"
+)
+
+func (k Keeper) Vote(ctx sdk.Context, vote group.Vote) error {
+	if err := validateMetadata(vote.Metadata, k.config); err != nil {
+		return err
+	}
+
+	// Make sure that a voter hasn't already voted.
+	voter := sdk.MustAccAddressFromBech32(vote.Voter)
+	if k.hasVote(ctx, vote.ProposalId, voter) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("Already voted: %s", vote.Voter)
+	}
+
+	proposal, err := k.GetProposal(ctx, vote.ProposalId)
+	if err != nil {
+		return err
+	}
+
+	// Ensure that we can still accept votes for this proposal.
+	if proposal.Status != group.PROPOSAL_STATUS_SUBMITTED {
+		return sdkerrors.ErrInvalidRequest.Wrapf("not possible with proposal status: %s", proposal.Status)
+	}
+	if !ctx.BlockTime().Before(proposal.VotingPeriodEnd) {
+		return sdkerrors.ErrInvalidRequest.Wrap("voting period has ended already")
+	}
+
+	vote.SubmitTime = ctx.BlockTime()
+	k.setVote(ctx, vote)
+
+	return nil
+}
+
+func (k Keeper) hasVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(voteKey(proposalID, voter))
+}
+
+func (k Keeper) GetVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress) (*group.Vote, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(voteKey(proposalID, voter))
+	if len(bz) == 0 {
+		return nil, sdkerrors.ErrNotFound.Wrapf("No vote for proposal %d: %s", proposalID, voter)
+	}
+
+	var vote group.Vote
+	k.cdc.MustUnmarshal(bz, &vote)
+
+	return &vote, nil
+}
+
+func (k Keeper) setVote(ctx sdk.Context, vote group.Vote) {
+	store := ctx.KVStore(k.storeKey)
+	voter := sdk.MustAccAddressFromBech32(vote.Voter)
+	bz := k.cdc.MustMarshal(&vote)
+	store.Set(voteKey(vote.ProposalId, voter), bz)
+	store.Set(voteByVoterKey(voter, vote.ProposalId), []byte{})
+}
+
+func (k Keeper) iterateVotes(ctx sdk.Context, proposalID uint64, fn func(vote group.Vote) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, voteKeyPrefixByProposal(proposalID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var vote group.Vote
+		k.cdc.MustUnmarshal(iterator.Value(), &vote)
+		if stop := fn(vote); stop {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetVotes(ctx sdk.Context, proposalID uint64) []group.Vote {
+	var votes []group.Vote
+	k.iterateVotes(ctx, proposalID, func(vote group.Vote) (stop bool) {
+		votes = append(votes, vote)
+		return false
+	})
+
+	return votes
+}
+
+// pruneVotes prunes all votes for a proposal from state.
+func (k Keeper) pruneVotes(ctx sdk.Context, proposalID uint64) {
+	var voters []sdk.AccAddress
+	k.iterateVotes(ctx, proposalID, func(vote group.Vote) (stop bool) {
+		voters = append(voters, sdk.MustAccAddressFromBech32(vote.Voter))
+		return false
+	})
+
+	store := ctx.KVStore(k.storeKey)
+	for _, voter := range voters {
+		store.Delete(voteKey(proposalID, voter))
+		store.Delete(voteByVoterKey(voter, proposalID))
+	}
+}
@@ -0,0 +1,598 @@
+package group
+
+import (
+	codectypes "github.com/Finschia/finschia-sdk/codec/types"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+)
+
+var _ sdk.Msg = (*MsgCreateGroup)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgCreateGroup) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", m.Admin)
+	}
+
+	members := MemberRequests{Members: m.Members}
+	if err := members.ValidateBasic(); err != nil {
+		return err
+	}
+	for _, member := range m.Members {
+		if member.Remove {
+			return sdkerrors.ErrInvalidRequest.Wrapf("cannot remove %s on creation", member.Address)
+		}
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg.
+func (m MsgCreateGroup) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Admin)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgCreateGroup) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgCreateGroup) Route() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgCreateGroup) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgUpdateGroupMembers)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgUpdateGroupMembers) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", m.Admin)
+	}
+
+	if m.GroupId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty group id")
+	}
+
+	if len(m.MemberUpdates) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty updates")
+	}
+	members := MemberRequests{Members: m.MemberUpdates}
+	if err := members.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg.
+func (m MsgUpdateGroupMembers) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Admin)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgUpdateGroupMembers) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgUpdateGroupMembers) Route() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgUpdateGroupMembers) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgUpdateGroupAdmin)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgUpdateGroupAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", m.Admin)
+	}
+
+	if m.GroupId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty group id")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.NewAdmin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid new admin address: %s", m.NewAdmin)
+	}
+
+	if m.Admin == m.NewAdmin {
+		return sdkerrors.ErrInvalidRequest.Wrap("new admin is the same as the old one")
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg.
+func (m MsgUpdateGroupAdmin) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Admin)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgUpdateGroupAdmin) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgUpdateGroupAdmin) Route() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgUpdateGroupAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgUpdateGroupMetadata)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgUpdateGroupMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", m.Admin)
+	}
+
+	if m.GroupId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty group id")
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg.
+func (m MsgUpdateGroupMetadata) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Admin)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgUpdateGroupMetadata) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgUpdateGroupMetadata) Route() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgUpdateGroupMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgCreateGroupPolicy)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgCreateGroupPolicy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", m.Admin)
+	}
+
+	if m.GroupId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty group id")
+	}
+
+	if m.GetDecisionPolicy() == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("nil decision policy")
+	}
+	if err := m.GetDecisionPolicy().ValidateBasic(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg.
+func (m MsgCreateGroupPolicy) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Admin)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgCreateGroupPolicy) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgCreateGroupPolicy) Route() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgCreateGroupPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgCreateGroupPolicy) GetDecisionPolicy() DecisionPolicy {
+	return getDecisionPolicy(m.DecisionPolicy)
+}
+
+func (m *MsgCreateGroupPolicy) SetDecisionPolicy(policy DecisionPolicy) error {
+	any, err := setDecisionPolicy(policy)
+	if err != nil {
+		return err
+	}
+	m.DecisionPolicy = any
+
+	return nil
+}
+
+func (m MsgCreateGroupPolicy) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var policy DecisionPolicy
+	return unpacker.UnpackAny(m.DecisionPolicy, &policy)
+}
+
+var _ sdk.Msg = (*MsgUpdateGroupPolicyAdmin)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgUpdateGroupPolicyAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", m.Admin)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.GroupPolicyAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid group policy address: %s", m.GroupPolicyAddress)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.NewAdmin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid new admin address: %s", m.NewAdmin)
+	}
+
+	if m.Admin == m.NewAdmin {
+		return sdkerrors.ErrInvalidRequest.Wrap("new admin is the same as the old one")
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg.
+func (m MsgUpdateGroupPolicyAdmin) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Admin)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgUpdateGroupPolicyAdmin) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgUpdateGroupPolicyAdmin) Route() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgUpdateGroupPolicyAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgUpdateGroupPolicyDecisionPolicy)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgUpdateGroupPolicyDecisionPolicy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", m.Admin)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.GroupPolicyAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid group policy address: %s", m.GroupPolicyAddress)
+	}
+
+	if m.GetDecisionPolicy() == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("nil decision policy")
+	}
+	if err := m.GetDecisionPolicy().ValidateBasic(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg.
+func (m MsgUpdateGroupPolicyDecisionPolicy) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Admin)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgUpdateGroupPolicyDecisionPolicy) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgUpdateGroupPolicyDecisionPolicy) Route() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgUpdateGroupPolicyDecisionPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgUpdateGroupPolicyDecisionPolicy) GetDecisionPolicy() DecisionPolicy {
+	return getDecisionPolicy(m.DecisionPolicy)
+}
+
+func (m *MsgUpdateGroupPolicyDecisionPolicy) SetDecisionPolicy(policy DecisionPolicy) error {
+	any, err := setDecisionPolicy(policy)
+	if err != nil {
+		return err
+	}
+	m.DecisionPolicy = any
+
+	return nil
+}
+
+func (m MsgUpdateGroupPolicyDecisionPolicy) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var policy DecisionPolicy
+	return unpacker.UnpackAny(m.DecisionPolicy, &policy)
+}
+
+var _ sdk.Msg = (*MsgUpdateGroupPolicyMetadata)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgUpdateGroupPolicyMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", m.Admin)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.GroupPolicyAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid group policy address: %s", m.GroupPolicyAddress)
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg.
+func (m MsgUpdateGroupPolicyMetadata) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Admin)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgUpdateGroupPolicyMetadata) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgUpdateGroupPolicyMetadata) Route() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgUpdateGroupPolicyMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgSubmitProposal)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgSubmitProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.GroupPolicyAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid group policy address: %s", m.GroupPolicyAddress)
+	}
+
+	if err := validateProposers(m.Proposers); err != nil {
+		return err
+	}
+
+	if err := validateMsgs(m.GetMsgs()); err != nil {
+		return err
+	}
+
+	if err := validateExec(m.Exec); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgSubmitProposal) GetMsgs() []sdk.Msg {
+	msgs, err := GetMsgs(m.Messages, "proposal")
+	if err != nil {
+		panic(err)
+	}
+	return msgs
+}
+
+func (m *MsgSubmitProposal) SetMsgs(msgs []sdk.Msg) error {
+	anys, err := SetMsgs(msgs)
+	if err != nil {
+		return err
+	}
+	m.Messages = anys
+	return nil
+}
+
+func (m MsgSubmitProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return UnpackInterfaces(unpacker, m.Messages)
+}
+
+// GetSigners implements Msg.
+func (m MsgSubmitProposal) GetSigners() []sdk.AccAddress {
+	signers := make([]sdk.AccAddress, len(m.Proposers))
+	for i, p := range m.Proposers {
+		proposer := sdk.MustAccAddressFromBech32(p)
+		signers[i] = proposer
+	}
+	return signers
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgSubmitProposal) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgSubmitProposal) Route() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgSubmitProposal) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgWithdrawProposal)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgWithdrawProposal) ValidateBasic() error {
+	if m.ProposalId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty proposal id")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid withdrawer address: %s", m.Address)
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg.
+func (m MsgWithdrawProposal) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Address)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgWithdrawProposal) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgWithdrawProposal) Route() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgWithdrawProposal) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgVote)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgVote) ValidateBasic() error {
+	if m.ProposalId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty proposal id")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Voter); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid voter address: %s", m.Voter)
+	}
+
+	if err := validateVoteOption(m.Option); err != nil {
+		return err
+	}
+
+	if err := validateExec(m.Exec); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg.
+func (m MsgVote) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Voter)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgVote) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgVote) Route() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgVote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgExec)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgExec) ValidateBasic() error {
+	if m.ProposalId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty proposal id")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Executor); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid executor address: %s", m.Executor)
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg.
+func (m MsgExec) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Executor)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgExec) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgExec) Route() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgExec) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgLeaveGroup)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgLeaveGroup) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid member address: %s", m.Address)
+	}
+
+	if m.GroupId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty group id")
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg.
+func (m MsgLeaveGroup) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Address)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgLeaveGroup) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgLeaveGroup) Route() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgLeaveGroup) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
@@ -0,0 +1,311 @@
+package group_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/finschia-sdk/crypto/keys/secp256k1"
+	"github.com/Finschia/finschia-sdk/testutil/testdata"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/auth/legacy/legacytx"
+	"github.com/Finschia/finschia-sdk/x/group"
+)
+
+func TestMsgCreateGroup(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 2)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		admin   sdk.AccAddress
+		members []group.MemberRequest
+		valid   bool
+	}{
+		"valid msg": {
+			admin: addrs[0],
+			members: []group.MemberRequest{{
+				Address: addrs[1].String(),
+				Weight:  sdk.OneDec(),
+			}},
+			valid: true,
+		},
+		"valid msg without members": {
+			admin: addrs[0],
+			valid: true,
+		},
+		"empty admin": {
+			members: []group.MemberRequest{{
+				Address: addrs[1].String(),
+				Weight:  sdk.OneDec(),
+			}},
+		},
+		"duplicate members": {
+			admin: addrs[0],
+			members: []group.MemberRequest{
+				{
+					Address: addrs[1].String(),
+					Weight:  sdk.OneDec(),
+				},
+				{
+					Address: addrs[1].String(),
+					Weight:  sdk.OneDec(),
+				},
+			},
+		},
+		"remove on creation": {
+			admin: addrs[0],
+			members: []group.MemberRequest{{
+				Address: addrs[1].String(),
+				Remove:  true,
+			}},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := group.MsgCreateGroup{
+				Admin:   tc.admin.String(),
+				Members: tc.members,
+			}
+
+			err := msg.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, []sdk.AccAddress{tc.admin}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgCreateGroupPolicy(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 1)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		admin   sdk.AccAddress
+		groupID uint64
+		policy  group.DecisionPolicy
+		valid   bool
+	}{
+		"valid msg": {
+			admin:   addrs[0],
+			groupID: 1,
+			policy: &group.ThresholdDecisionPolicy{
+				Threshold: sdk.OneDec(),
+				Windows: &group.DecisionPolicyWindows{
+					VotingPeriod: time.Hour,
+				},
+			},
+			valid: true,
+		},
+		"empty group id": {
+			admin: addrs[0],
+			policy: &group.ThresholdDecisionPolicy{
+				Threshold: sdk.OneDec(),
+				Windows: &group.DecisionPolicyWindows{
+					VotingPeriod: time.Hour,
+				},
+			},
+		},
+		"invalid policy": {
+			admin:   addrs[0],
+			groupID: 1,
+			policy: &group.PercentageDecisionPolicy{
+				Percentage: sdk.NewDec(2),
+				Windows: &group.DecisionPolicyWindows{
+					VotingPeriod: time.Hour,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := group.MsgCreateGroupPolicy{
+				Admin:   tc.admin.String(),
+				GroupId: tc.groupID,
+			}
+			err := msg.SetDecisionPolicy(tc.policy)
+			require.NoError(t, err)
+
+			err = msg.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, []sdk.AccAddress{tc.admin}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgSubmitProposal(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 2)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		groupPolicy sdk.AccAddress
+		proposers   []sdk.AccAddress
+		msgs        []sdk.Msg
+		exec        group.Exec
+		valid       bool
+	}{
+		"valid msg": {
+			groupPolicy: addrs[0],
+			proposers:   []sdk.AccAddress{addrs[1]},
+			msgs:        []sdk.Msg{testdata.NewTestMsg()},
+			valid:       true,
+		},
+		"empty group policy": {
+			proposers: []sdk.AccAddress{addrs[1]},
+			msgs:      []sdk.Msg{testdata.NewTestMsg()},
+		},
+		"empty proposers": {
+			groupPolicy: addrs[0],
+			msgs:        []sdk.Msg{testdata.NewTestMsg()},
+		},
+		"duplicate proposers": {
+			groupPolicy: addrs[0],
+			proposers:   []sdk.AccAddress{addrs[1], addrs[1]},
+			msgs:        []sdk.Msg{testdata.NewTestMsg()},
+		},
+		"empty msgs": {
+			groupPolicy: addrs[0],
+			proposers:   []sdk.AccAddress{addrs[1]},
+		},
+		"invalid exec": {
+			groupPolicy: addrs[0],
+			proposers:   []sdk.AccAddress{addrs[1]},
+			msgs:        []sdk.Msg{testdata.NewTestMsg()},
+			exec:        -1,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var proposers []string
+			for _, proposer := range tc.proposers {
+				proposers = append(proposers, proposer.String())
+			}
+
+			msg := group.MsgSubmitProposal{
+				GroupPolicyAddress: tc.groupPolicy.String(),
+				Proposers:          proposers,
+				Exec:               tc.exec,
+			}
+			err := msg.SetMsgs(tc.msgs)
+			require.NoError(t, err)
+
+			err = msg.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, tc.proposers, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgVote(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 1)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		proposalID uint64
+		voter      sdk.AccAddress
+		option     group.VoteOption
+		valid      bool
+	}{
+		"valid msg": {
+			proposalID: 1,
+			voter:      addrs[0],
+			option:     group.VOTE_OPTION_YES,
+			valid:      true,
+		},
+		"empty proposal id": {
+			voter:  addrs[0],
+			option: group.VOTE_OPTION_YES,
+		},
+		"empty voter": {
+			proposalID: 1,
+			option:     group.VOTE_OPTION_YES,
+		},
+		"empty option": {
+			proposalID: 1,
+			voter:      addrs[0],
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := group.MsgVote{
+				ProposalId: tc.proposalID,
+				Voter:      tc.voter.String(),
+				Option:     tc.option,
+			}
+
+			err := msg.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, []sdk.AccAddress{tc.voter}, msg.GetSigners())
+		})
+	}
+}
+
+func TestAminoJSON(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 2)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		msg      legacytx.LegacyMsg
+		expected string
+	}{
+		"MsgLeaveGroup": {
+			&group.MsgLeaveGroup{
+				Address: addrs[0].String(),
+				GroupId: 1,
+			},
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/group/MsgLeaveGroup\",\"value\":{\"address\":\"%s\",\"group_id\":\"1\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String()),
+		},
+		"MsgUpdateGroupPolicyMetadata": {
+			&group.MsgUpdateGroupPolicyMetadata{
+				Admin:              addrs[0].String(),
+				GroupPolicyAddress: addrs[1].String(),
+				Metadata:           "new metadata",
+			},
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/group/MsgUpdatePolicyMetadata\",\"value\":{\"admin\":\"%s\",\"group_policy_address\":\"%s\",\"metadata\":\"new metadata\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), addrs[1].String()),
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, sdk.MsgTypeURL(tc.msg), tc.msg.Type())
+			require.Equal(t, sdk.MsgTypeURL(tc.msg), tc.msg.Route())
+			require.Equal(t, tc.expected, string(legacytx.StdSignBytes("foo", 1, 1, 1, legacytx.StdFee{}, []sdk.Msg{tc.msg}, "memo")))
+		})
+	}
+}
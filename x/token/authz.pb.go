@@ -0,0 +1,671 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lbm/token/v1/authz.proto
+
+package token
+
+import (
+	fmt "fmt"
+	github_com_Finschia_finschia_sdk_types "github.com/Finschia/finschia-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/regen-network/cosmos-proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TokenSendAuthorization allows the grantee to send up to spend_limits tokens
+// of the contracts on behalf of the granter.
+// It applies to MsgSend by default, or to MsgOperatorSend if operator is true.
+//
+// Since: 0.49.0 (finschia)
+//
+// Deprecated: Do not use.
+type TokenSendAuthorization struct {
+	// spend limits per contract.
+	SpendLimits []SpendLimit `protobuf:"bytes,1,rep,name=spend_limits,json=spendLimits,proto3" json:"spend_limits"`
+	// addresses of the recipients allowed.
+	// empty list means any recipient is allowed.
+	AllowList []string `protobuf:"bytes,2,rep,name=allow_list,json=allowList,proto3" json:"allow_list,omitempty"`
+	// whether the authorization applies to MsgOperatorSend instead of MsgSend.
+	Operator bool `protobuf:"varint,3,opt,name=operator,proto3" json:"operator,omitempty"`
+}
+
+func (m *TokenSendAuthorization) Reset()         { *m = TokenSendAuthorization{} }
+func (m *TokenSendAuthorization) String() string { return proto.CompactTextString(m) }
+func (*TokenSendAuthorization) ProtoMessage()    {}
+func (*TokenSendAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ac2f073f9b8e2d3, []int{0}
+}
+func (m *TokenSendAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenSendAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenSendAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenSendAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenSendAuthorization.Merge(m, src)
+}
+func (m *TokenSendAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenSendAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenSendAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenSendAuthorization proto.InternalMessageInfo
+
+func (m *TokenSendAuthorization) GetSpendLimits() []SpendLimit {
+	if m != nil {
+		return m.SpendLimits
+	}
+	return nil
+}
+
+func (m *TokenSendAuthorization) GetAllowList() []string {
+	if m != nil {
+		return m.AllowList
+	}
+	return nil
+}
+
+func (m *TokenSendAuthorization) GetOperator() bool {
+	if m != nil {
+		return m.Operator
+	}
+	return false
+}
+
+// SpendLimit defines the amount of tokens of a contract the grantee is allowed to send.
+//
+// Since: 0.49.0 (finschia)
+//
+// Deprecated: Do not use.
+type SpendLimit struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// remaining amount of tokens to send.
+	Amount github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"amount"`
+}
+
+func (m *SpendLimit) Reset()         { *m = SpendLimit{} }
+func (m *SpendLimit) String() string { return proto.CompactTextString(m) }
+func (*SpendLimit) ProtoMessage()    {}
+func (*SpendLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ac2f073f9b8e2d3, []int{1}
+}
+func (m *SpendLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpendLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpendLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpendLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpendLimit.Merge(m, src)
+}
+func (m *SpendLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpendLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpendLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpendLimit proto.InternalMessageInfo
+
+func (m *SpendLimit) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*TokenSendAuthorization)(nil), "lbm.token.v1.TokenSendAuthorization")
+	proto.RegisterType((*SpendLimit)(nil), "lbm.token.v1.SpendLimit")
+}
+
+func init() { proto.RegisterFile("lbm/token/v1/authz.proto", fileDescriptor_0ac2f073f9b8e2d3) }
+
+var fileDescriptor_0ac2f073f9b8e2d3 = []byte{
+	// 351 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xbf, 0x6e, 0xe2, 0x40,
+	0x10, 0xc6, 0xbd, 0x70, 0x42, 0x78, 0xe1, 0x1a, 0xdf, 0xe9, 0xb4, 0x87, 0x14, 0x63, 0x51, 0x59,
+	0x91, 0xb0, 0x05, 0xe9, 0xe8, 0x70, 0x11, 0x89, 0x88, 0xca, 0xa4, 0x4a, 0x63, 0xf9, 0x5f, 0xf0,
+	0x0a, 0x7b, 0xc7, 0xf2, 0xae, 0x49, 0x82, 0x94, 0x77, 0xc8, 0x7b, 0xa4, 0xcd, 0x43, 0x50, 0xa2,
+	0x54, 0x51, 0x0a, 0x14, 0xc1, 0x8b, 0x44, 0xb6, 0x09, 0x49, 0xaa, 0x74, 0x33, 0xdf, 0xef, 0xdb,
+	0xf9, 0x46, 0x3b, 0x98, 0xc4, 0x5e, 0x62, 0x0a, 0x58, 0x84, 0xcc, 0x5c, 0x0e, 0x4c, 0x37, 0x17,
+	0xd1, 0xca, 0x48, 0x33, 0x10, 0xa0, 0xb4, 0x63, 0x2f, 0x31, 0x4a, 0x62, 0x2c, 0x07, 0x9d, 0xbf,
+	0x73, 0x98, 0x43, 0x09, 0xcc, 0xa2, 0xaa, 0x3c, 0x9d, 0xff, 0x3e, 0xf0, 0x04, 0xb8, 0x53, 0x81,
+	0xaa, 0xa9, 0x50, 0xef, 0x11, 0xe1, 0x7f, 0x97, 0xc5, 0xeb, 0x59, 0xc8, 0x82, 0x71, 0x2e, 0x22,
+	0xc8, 0xe8, 0xca, 0x15, 0x14, 0x98, 0x32, 0xc6, 0x6d, 0x9e, 0x86, 0x2c, 0x70, 0x62, 0x9a, 0x50,
+	0xc1, 0x09, 0xd2, 0xea, 0x7a, 0x6b, 0x48, 0x8c, 0xaf, 0x81, 0xc6, 0xac, 0x70, 0x4c, 0x0b, 0x83,
+	0xf5, 0x6b, 0xbd, 0xed, 0x4a, 0x76, 0x8b, 0x1f, 0x15, 0xae, 0x9c, 0x60, 0xec, 0xc6, 0x31, 0xdc,
+	0x38, 0x31, 0xe5, 0x82, 0xd4, 0xb4, 0xba, 0x2e, 0xdb, 0x72, 0xa9, 0x4c, 0x29, 0x17, 0x4a, 0x07,
+	0x37, 0x21, 0x0d, 0x33, 0x57, 0x40, 0x46, 0xea, 0x1a, 0xd2, 0x9b, 0xf6, 0xb1, 0x1f, 0xfd, 0x79,
+	0x7e, 0xea, 0xff, 0xfe, 0xb6, 0x10, 0x41, 0xbd, 0x7b, 0x8c, 0x3f, 0x03, 0x95, 0x2e, 0x6e, 0xf9,
+	0xc0, 0x44, 0xe6, 0xfa, 0xc2, 0xa1, 0x01, 0x41, 0x1a, 0xd2, 0x65, 0x1b, 0x7f, 0x48, 0x93, 0x40,
+	0xb9, 0xc0, 0x0d, 0x37, 0x81, 0x9c, 0x15, 0xd1, 0x48, 0x97, 0xad, 0x61, 0xb1, 0xe1, 0xeb, 0xb6,
+	0x7b, 0x3a, 0xa7, 0x22, 0xca, 0x3d, 0xc3, 0x87, 0xc4, 0x3c, 0xa7, 0x8c, 0xfb, 0x11, 0x75, 0xcd,
+	0xeb, 0x43, 0xd1, 0xe7, 0xc1, 0xc2, 0x14, 0x77, 0x69, 0xc8, 0x8d, 0x09, 0x13, 0xf6, 0x61, 0xc2,
+	0xa8, 0x46, 0x90, 0x65, 0xad, 0x77, 0x2a, 0xda, 0xec, 0x54, 0xf4, 0xb6, 0x53, 0xd1, 0xc3, 0x5e,
+	0x95, 0x36, 0x7b, 0x55, 0x7a, 0xd9, 0xab, 0xd2, 0x95, 0xfe, 0xe3, 0xc4, 0xdb, 0xea, 0x7c, 0x5e,
+	0xa3, 0xfc, 0xf7, 0xb3, 0xf7, 0x01, 0x00, 0xa6, 0x3f, 0xbf, 0x54, 0xd2, 0x01, 0x00, 0x00,
+}
+
+func (m *TokenSendAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenSendAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenSendAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Operator {
+		i--
+		if m.Operator {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AllowList) > 0 {
+		for iNdEx := len(m.AllowList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowList[iNdEx])
+			copy(dAtA[i:], m.AllowList[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowList[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SpendLimits) > 0 {
+		for iNdEx := len(m.SpendLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpendLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpendLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpendLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuthz(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TokenSendAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpendLimits) > 0 {
+		for _, e := range m.SpendLimits {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.AllowList) > 0 {
+		for _, s := range m.AllowList {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.Operator {
+		n += 2
+	}
+	return n
+}
+
+func (m *SpendLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovAuthz(uint64(l))
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TokenSendAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenSendAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenSendAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimits = append(m.SpendLimits, SpendLimit{})
+			if err := m.SpendLimits[len(m.SpendLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowList = append(m.AllowList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Operator = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpendLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpendLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpendLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
@@ -31,6 +31,15 @@ func NewQueryCmd() *cobra.Command {
 		NewQueryCmdGranteeGrants(),
 		NewQueryCmdIsOperatorFor(),
 		NewQueryCmdHoldersByOperator(),
+		NewQueryCmdPaused(),
+		NewQueryCmdFrozenAccounts(),
+		NewQueryCmdMaxSupply(),
+		NewQueryCmdAllowance(),
+		NewQueryCmdAllowancesByOwner(),
+		NewQueryCmdContracts(),
+		NewQueryCmdHolders(),
+		NewQueryCmdBalancesByAddress(),
+		NewQueryCmdVestings(),
 	)
 
 	return queryCmd
@@ -254,3 +263,276 @@ func NewQueryCmdHoldersByOperator() *cobra.Command {
 	flags.AddPaginationFlagsToCmd(cmd, "authorizations")
 	return cmd
 }
+
+func NewQueryCmdPaused() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "paused [contract-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "query whether the contract is paused or not",
+		Example: fmt.Sprintf(`$ %s query %s paused <contract-id>`, version.AppName, token.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := token.NewQueryClient(clientCtx)
+			res, err := queryClient.Paused(cmd.Context(), &token.QueryPausedRequest{
+				ContractId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewQueryCmdFrozenAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "frozen-accounts [contract-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "query all the frozen accounts of a contract",
+		Example: fmt.Sprintf(`$ %s query %s frozen-accounts <contract-id>`, version.AppName, token.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := token.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.FrozenAccounts(cmd.Context(), &token.QueryFrozenAccountsRequest{
+				ContractId: args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "frozen accounts")
+	return cmd
+}
+
+func NewQueryCmdMaxSupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "max-supply [contract-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "query the max supply of a contract",
+		Example: fmt.Sprintf(`$ %s query %s max-supply <contract-id>`, version.AppName, token.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := token.NewQueryClient(clientCtx)
+			res, err := queryClient.MaxSupply(cmd.Context(), &token.QueryMaxSupplyRequest{
+				ContractId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewQueryCmdAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "allowance [contract-id] [owner] [spender]",
+		Args:    cobra.ExactArgs(3),
+		Short:   "query the allowance of a spender on the tokens of an owner",
+		Example: fmt.Sprintf(`$ %s query %s allowance <contract-id> <owner> <spender>`, version.AppName, token.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := token.NewQueryClient(clientCtx)
+			res, err := queryClient.Allowance(cmd.Context(), &token.QueryAllowanceRequest{
+				ContractId: args[0],
+				Owner:      args[1],
+				Spender:    args[2],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewQueryCmdAllowancesByOwner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "allowances-by-owner [contract-id] [owner]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "query all the allowances given by an owner",
+		Example: fmt.Sprintf(`$ %s query %s allowances-by-owner <contract-id> <owner>`, version.AppName, token.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := token.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.AllowancesByOwner(cmd.Context(), &token.QueryAllowancesByOwnerRequest{
+				ContractId: args[0],
+				Owner:      args[1],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "allowances")
+	return cmd
+}
+
+func NewQueryCmdContracts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "contracts",
+		Args:    cobra.NoArgs,
+		Short:   "query all the contracts",
+		Example: fmt.Sprintf(`$ %s query %s contracts`, version.AppName, token.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := token.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.Contracts(cmd.Context(), &token.QueryContractsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "contracts")
+	return cmd
+}
+
+func NewQueryCmdHolders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "holders [contract-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "query all the holders of a contract with their balances",
+		Example: fmt.Sprintf(`$ %s query %s holders <contract-id>`, version.AppName, token.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := token.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.Holders(cmd.Context(), &token.QueryHoldersRequest{
+				ContractId: args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "holders")
+	return cmd
+}
+
+func NewQueryCmdBalancesByAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "balances-by-address [address]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "query all the balances of an address across the contracts",
+		Example: fmt.Sprintf(`$ %s query %s balances-by-address <address>`, version.AppName, token.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := token.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.BalancesByAddress(cmd.Context(), &token.QueryBalancesByAddressRequest{
+				Address:    args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "balances")
+	return cmd
+}
+
+func NewQueryCmdVestings() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "vestings [contract-id] [address]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "query all the vestings of an address",
+		Example: fmt.Sprintf(`$ %s query %s vestings <contract-id> <address>`, version.AppName, token.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := token.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.Vestings(cmd.Context(), &token.QueryVestingsRequest{
+				ContractId: args[0],
+				Address:    args[1],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "vestings")
+	return cmd
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -16,14 +17,19 @@ import (
 )
 
 const (
-	FlagSupply   = "supply"
-	FlagDecimals = "decimals"
-	FlagMintable = "mintable"
-	FlagMeta     = "meta"
-	FlagImageURI = "image-uri"
-
-	DefaultDecimals = 8
-	DefaultSupply   = "1"
+	FlagSupply    = "supply"
+	FlagMaxSupply = "max-supply"
+	FlagDecimals  = "decimals"
+	FlagMintable  = "mintable"
+	FlagMeta      = "meta"
+	FlagImageURI  = "image-uri"
+	FlagStartTime = "start-time"
+	FlagEndTime   = "end-time"
+	FlagPeriods   = "periods"
+
+	DefaultDecimals  = 8
+	DefaultSupply    = "1"
+	DefaultMaxSupply = "0"
 )
 
 // NewTxCmd returns the transaction commands for this module
@@ -48,6 +54,17 @@ func NewTxCmd() *cobra.Command {
 		NewTxCmdBurn(),
 		NewTxCmdOperatorBurn(),
 		NewTxCmdModify(),
+		NewTxCmdPause(),
+		NewTxCmdUnpause(),
+		NewTxCmdFreeze(),
+		NewTxCmdUnfreeze(),
+		NewTxCmdApprove(),
+		NewTxCmdTransferFrom(),
+		NewTxCmdWrap(),
+		NewTxCmdUnwrap(),
+		NewTxCmdMultiSend(),
+		NewTxCmdMintVested(),
+		NewTxCmdSendVested(),
 	)
 
 	return txCmd
@@ -219,6 +236,15 @@ func NewTxCmdIssue() *cobra.Command {
 				return sdkerrors.ErrInvalidType.Wrapf("failed to set supply: %s", supplyStr)
 			}
 
+			maxSupplyStr, err := cmd.Flags().GetString(FlagMaxSupply)
+			if err != nil {
+				return err
+			}
+			maxSupply, ok := sdk.NewIntFromString(maxSupplyStr)
+			if !ok {
+				return sdkerrors.ErrInvalidType.Wrapf("failed to set max supply: %s", maxSupplyStr)
+			}
+
 			mintable, err := cmd.Flags().GetBool(FlagMintable)
 			if err != nil {
 				return err
@@ -230,15 +256,16 @@ func NewTxCmdIssue() *cobra.Command {
 			}
 
 			msg := token.MsgIssue{
-				Owner:    args[0],
-				To:       args[1],
-				Name:     args[2],
-				Symbol:   args[3],
-				Uri:      imageURI,
-				Meta:     meta,
-				Amount:   supply,
-				Mintable: mintable,
-				Decimals: decimals,
+				Owner:     args[0],
+				To:        args[1],
+				Name:      args[2],
+				Symbol:    args[3],
+				Uri:       imageURI,
+				Meta:      meta,
+				Amount:    supply,
+				MaxSupply: maxSupply,
+				Mintable:  mintable,
+				Decimals:  decimals,
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -251,6 +278,7 @@ func NewTxCmdIssue() *cobra.Command {
 	cmd.Flags().String(FlagImageURI, "", "set image-uri")
 	cmd.Flags().String(FlagMeta, "", "set meta")
 	cmd.Flags().String(FlagSupply, DefaultSupply, "initial supply")
+	cmd.Flags().String(FlagMaxSupply, DefaultMaxSupply, "max supply (0 for no cap)")
 	cmd.Flags().Bool(FlagMintable, false, "set mintable")
 	cmd.Flags().Int32(FlagDecimals, DefaultDecimals, "set decimals")
 
@@ -458,3 +486,460 @@ func NewTxCmdModify() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func NewTxCmdPause() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause [contract-id] [grantee]",
+		Args:  cobra.ExactArgs(2),
+		Short: "pause a contract",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s pause <contract-id> <grantee>`, version.AppName, token.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := token.MsgPause{
+				ContractId: args[0],
+				From:       args[1],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdUnpause() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unpause [contract-id] [grantee]",
+		Args:  cobra.ExactArgs(2),
+		Short: "unpause a contract",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s unpause <contract-id> <grantee>`, version.AppName, token.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := token.MsgUnpause{
+				ContractId: args[0],
+				From:       args[1],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdFreeze() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze [contract-id] [grantee] [account]",
+		Args:  cobra.ExactArgs(3),
+		Short: "freeze an account on a contract",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s freeze <contract-id> <grantee> <account>`, version.AppName, token.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := token.MsgFreeze{
+				ContractId: args[0],
+				From:       args[1],
+				Account:    args[2],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdUnfreeze() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unfreeze [contract-id] [grantee] [account]",
+		Args:  cobra.ExactArgs(3),
+		Short: "unfreeze an account on a contract",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s unfreeze <contract-id> <grantee> <account>`, version.AppName, token.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := token.MsgUnfreeze{
+				ContractId: args[0],
+				From:       args[1],
+				Account:    args[2],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdApprove() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve [contract-id] [owner] [spender] [amount]",
+		Args:  cobra.ExactArgs(4),
+		Short: "set the allowance of a spender (zero amount removes the allowance)",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s approve <contract-id> <owner> <spender> <amount>`, version.AppName, token.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amountStr := args[3]
+			amount, ok := sdk.NewIntFromString(amountStr)
+			if !ok {
+				return sdkerrors.ErrInvalidType.Wrapf("failed to set amount: %s", amountStr)
+			}
+
+			msg := token.MsgApprove{
+				ContractId: args[0],
+				Owner:      args[1],
+				Spender:    args[2],
+				Amount:     amount,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdTransferFrom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-from [contract-id] [spender] [from] [to] [amount]",
+		Args:  cobra.ExactArgs(5),
+		Short: "send tokens by spender, decreasing the allowance",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s transfer-from <contract-id> <spender> <from> <to> <amount>`, version.AppName, token.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amountStr := args[4]
+			amount, ok := sdk.NewIntFromString(amountStr)
+			if !ok {
+				return sdkerrors.ErrInvalidType.Wrapf("failed to set amount: %s", amountStr)
+			}
+
+			msg := token.MsgTransferFrom{
+				ContractId: args[0],
+				Spender:    args[1],
+				From:       args[2],
+				To:         args[3],
+				Amount:     amount,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdWrap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wrap [contract-id] [from] [amount]",
+		Args:  cobra.ExactArgs(3),
+		Short: "wrap tokens into the coins of x/bank",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s wrap <contract-id> <from> <amount>`, version.AppName, token.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amountStr := args[2]
+			amount, ok := sdk.NewIntFromString(amountStr)
+			if !ok {
+				return sdkerrors.ErrInvalidType.Wrapf("failed to set amount: %s", amountStr)
+			}
+
+			msg := token.MsgWrap{
+				ContractId: args[0],
+				From:       args[1],
+				Amount:     amount,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdUnwrap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unwrap [contract-id] [from] [amount]",
+		Args:  cobra.ExactArgs(3),
+		Short: "unwrap the coins of x/bank into tokens",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s unwrap <contract-id> <from> <amount>`, version.AppName, token.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amountStr := args[2]
+			amount, ok := sdk.NewIntFromString(amountStr)
+			if !ok {
+				return sdkerrors.ErrInvalidType.Wrapf("failed to set amount: %s", amountStr)
+			}
+
+			msg := token.MsgUnwrap{
+				ContractId: args[0],
+				From:       args[1],
+				Amount:     amount,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdMultiSend() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multi-send [contract-id] [from] [to]:[amount]...",
+		Args:  cobra.MinimumNArgs(3),
+		Short: "send tokens to multiple accounts",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s multi-send <contract-id> <from> <to>:<amount> [<to>:<amount>...]`, version.AppName, token.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputs := make([]token.Output, 0, len(args[2:]))
+			for _, outputStr := range args[2:] {
+				to, amountStr, found := strings.Cut(outputStr, ":")
+				if !found {
+					return sdkerrors.ErrInvalidRequest.Wrapf("invalid output: %s", outputStr)
+				}
+				amount, ok := sdk.NewIntFromString(amountStr)
+				if !ok {
+					return sdkerrors.ErrInvalidType.Wrapf("failed to set amount: %s", amountStr)
+				}
+				outputs = append(outputs, token.Output{
+					To:     to,
+					Amount: amount,
+				})
+			}
+
+			msg := token.MsgMultiSend{
+				ContractId: args[0],
+				From:       args[1],
+				Outputs:    outputs,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdMintVested() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-vested [contract-id] [grantee] [to] [amount]",
+		Args:  cobra.ExactArgs(4),
+		Short: "mint tokens locked by a vesting schedule",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s mint-vested <contract-id> <grantee> <to> <amount> --%s=<start-time> --%s=<end-time>
+			$ %s tx %s mint-vested <contract-id> <grantee> <to> <amount> --%s=<start-time> --%s=<length>:<amount>[,<length>:<amount>...]`,
+			version.AppName, token.ModuleName, FlagStartTime, FlagEndTime,
+			version.AppName, token.ModuleName, FlagStartTime, FlagPeriods),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amountStr := args[3]
+			amount, ok := sdk.NewIntFromString(amountStr)
+			if !ok {
+				return sdkerrors.ErrInvalidType.Wrapf("failed to set amount: %s", amountStr)
+			}
+
+			schedule, err := readVestingSchedule(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := token.MsgMintVested{
+				ContractId: args[0],
+				From:       args[1],
+				To:         args[2],
+				Amount:     amount,
+				Schedule:   schedule,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	addVestingScheduleFlags(cmd)
+
+	return cmd
+}
+
+func NewTxCmdSendVested() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-vested [contract-id] [from] [to] [amount]",
+		Args:  cobra.ExactArgs(4),
+		Short: "send tokens locked by a vesting schedule",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s send-vested <contract-id> <from> <to> <amount> --%s=<start-time> --%s=<end-time>
+			$ %s tx %s send-vested <contract-id> <from> <to> <amount> --%s=<start-time> --%s=<length>:<amount>[,<length>:<amount>...]`,
+			version.AppName, token.ModuleName, FlagStartTime, FlagEndTime,
+			version.AppName, token.ModuleName, FlagStartTime, FlagPeriods),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amountStr := args[3]
+			amount, ok := sdk.NewIntFromString(amountStr)
+			if !ok {
+				return sdkerrors.ErrInvalidType.Wrapf("failed to set amount: %s", amountStr)
+			}
+
+			schedule, err := readVestingSchedule(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := token.MsgSendVested{
+				ContractId: args[0],
+				From:       args[1],
+				To:         args[2],
+				Amount:     amount,
+				Schedule:   schedule,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	addVestingScheduleFlags(cmd)
+
+	return cmd
+}
+
+func addVestingScheduleFlags(cmd *cobra.Command) {
+	cmd.Flags().Int64(FlagStartTime, 0, "start time of the vesting, in unix seconds")
+	cmd.Flags().Int64(FlagEndTime, 0, "end time of the continuous vesting, in unix seconds")
+	cmd.Flags().String(FlagPeriods, "", "periods of the periodic vesting, as comma separated <length>:<amount>")
+}
+
+func readVestingSchedule(cmd *cobra.Command) (token.VestingSchedule, error) {
+	startTime, err := cmd.Flags().GetInt64(FlagStartTime)
+	if err != nil {
+		return token.VestingSchedule{}, err
+	}
+
+	endTime, err := cmd.Flags().GetInt64(FlagEndTime)
+	if err != nil {
+		return token.VestingSchedule{}, err
+	}
+
+	periodsStr, err := cmd.Flags().GetString(FlagPeriods)
+	if err != nil {
+		return token.VestingSchedule{}, err
+	}
+	var periods []token.VestingPeriod
+	if len(periodsStr) != 0 {
+		for _, periodStr := range strings.Split(periodsStr, ",") {
+			lengthStr, amountStr, found := strings.Cut(periodStr, ":")
+			if !found {
+				return token.VestingSchedule{}, sdkerrors.ErrInvalidRequest.Wrapf("invalid period: %s", periodStr)
+			}
+			length, err := strconv.ParseInt(lengthStr, 10, 64)
+			if err != nil {
+				return token.VestingSchedule{}, sdkerrors.ErrInvalidType.Wrapf("failed to set length: %s", lengthStr)
+			}
+			amount, ok := sdk.NewIntFromString(amountStr)
+			if !ok {
+				return token.VestingSchedule{}, sdkerrors.ErrInvalidType.Wrapf("failed to set amount: %s", amountStr)
+			}
+			periods = append(periods, token.VestingPeriod{
+				Length: length,
+				Amount: amount,
+			})
+		}
+	}
+
+	return token.VestingSchedule{
+		StartTime: startTime,
+		EndTime:   endTime,
+		Periods:   periods,
+	}, nil
+}
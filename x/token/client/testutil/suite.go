@@ -56,16 +56,18 @@ func (s *IntegrationTestSuite) SetupSuite() {
 
 	s.classes = []token.Contract{
 		{
-			Name:     "test",
-			Symbol:   "ZERO",
-			Decimals: 8,
-			Mintable: true,
+			Name:      "test",
+			Symbol:    "ZERO",
+			Decimals:  8,
+			Mintable:  true,
+			MaxSupply: sdk.ZeroInt(),
 		},
 		{
-			Name:     "test",
-			Symbol:   "ONE",
-			Decimals: 8,
-			Mintable: true,
+			Name:      "test",
+			Symbol:    "ONE",
+			Decimals:  8,
+			Mintable:  true,
+			MaxSupply: sdk.ZeroInt(),
 		},
 	}
 
@@ -3,6 +3,8 @@ package testutil
 import (
 	"fmt"
 
+	"github.com/spf13/cobra"
+
 	"github.com/Finschia/finschia-sdk/client/flags"
 	clitestutil "github.com/Finschia/finschia-sdk/testutil/cli"
 	sdk "github.com/Finschia/finschia-sdk/types"
@@ -80,6 +82,72 @@ func (s *IntegrationTestSuite) TestNewTxCmdSend() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestNewTxCmdMultiSend() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, s.customer),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				s.classes[0].Id,
+				s.customer.String(),
+				fmt.Sprintf("%s:1", s.vendor),
+				fmt.Sprintf("%s:1", s.customer),
+			},
+			true,
+		},
+		"not enough args": {
+			[]string{
+				s.classes[0].Id,
+				s.customer.String(),
+			},
+			false,
+		},
+		"invalid output": {
+			[]string{
+				s.classes[0].Id,
+				s.customer.String(),
+				s.vendor.String(),
+			},
+			false,
+		},
+		"amount out of range": {
+			[]string{
+				s.classes[0].Id,
+				s.customer.String(),
+				fmt.Sprintf("%s:10000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000", s.vendor),
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdMultiSend()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res sdk.TxResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+			s.Require().EqualValues(0, res.Code, out.String())
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestNewTxCmdOperatorSend() {
 	val := s.network.Validators[0]
 	commonArgs := []string{
@@ -297,6 +365,29 @@ func (s *IntegrationTestSuite) TestNewTxCmdIssue() {
 			},
 			true,
 		},
+		"valid transaction with max supply": {
+			[]string{
+				s.vendor.String(),
+				s.vendor.String(),
+				"Test class",
+				"TT",
+				fmt.Sprintf("--%s=%s", cli.FlagSupply, "10000000000"),
+				fmt.Sprintf("--%s=%s", cli.FlagMaxSupply, "20000000000"),
+				fmt.Sprintf("--%s=%v", cli.FlagMintable, true),
+			},
+			true,
+		},
+		"supply exceeds max supply": {
+			[]string{
+				s.vendor.String(),
+				s.vendor.String(),
+				"Test class",
+				"TT",
+				fmt.Sprintf("--%s=%s", cli.FlagSupply, "10000000000"),
+				fmt.Sprintf("--%s=%s", cli.FlagMaxSupply, "1"),
+			},
+			false,
+		},
 		"extra args": {
 			[]string{
 				s.vendor.String(),
@@ -695,3 +786,523 @@ func (s *IntegrationTestSuite) TestNewTxCmdModify() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestNewTxCmdPause() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, s.vendor),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	// use a dedicated class, so the other tests would not be affected
+	contractID := s.createClass(s.vendor, s.vendor, "pausable", "PAUSE", s.balance, true)
+
+	// the order matters, so it does not use a map
+	testCases := []struct {
+		name  string
+		cmd   func() *cobra.Command
+		args  []string
+		valid bool
+	}{
+		{
+			"valid pause",
+			cli.NewTxCmdPause,
+			[]string{
+				contractID,
+				s.vendor.String(),
+			},
+			true,
+		},
+		{
+			"valid unpause",
+			cli.NewTxCmdUnpause,
+			[]string{
+				contractID,
+				s.vendor.String(),
+			},
+			true,
+		},
+		{
+			"extra args",
+			cli.NewTxCmdPause,
+			[]string{
+				contractID,
+				s.vendor.String(),
+				"extra",
+			},
+			false,
+		},
+		{
+			"not enough args",
+			cli.NewTxCmdUnpause,
+			[]string{
+				contractID,
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, tc.cmd(), append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res sdk.TxResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+			s.Require().EqualValues(0, res.Code, out.String())
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewTxCmdFreeze() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, s.vendor),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	// use a dedicated class, so the other tests would not be affected
+	contractID := s.createClass(s.vendor, s.vendor, "freezable", "FRZ", s.balance, true)
+
+	// the order matters, so it does not use a map
+	testCases := []struct {
+		name  string
+		cmd   func() *cobra.Command
+		args  []string
+		valid bool
+	}{
+		{
+			"valid freeze",
+			cli.NewTxCmdFreeze,
+			[]string{
+				contractID,
+				s.vendor.String(),
+				s.customer.String(),
+			},
+			true,
+		},
+		{
+			"valid unfreeze",
+			cli.NewTxCmdUnfreeze,
+			[]string{
+				contractID,
+				s.vendor.String(),
+				s.customer.String(),
+			},
+			true,
+		},
+		{
+			"extra args",
+			cli.NewTxCmdFreeze,
+			[]string{
+				contractID,
+				s.vendor.String(),
+				s.customer.String(),
+				"extra",
+			},
+			false,
+		},
+		{
+			"not enough args",
+			cli.NewTxCmdUnfreeze,
+			[]string{
+				contractID,
+				s.vendor.String(),
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, tc.cmd(), append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res sdk.TxResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+			s.Require().EqualValues(0, res.Code, out.String())
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewTxCmdApprove() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	// use a dedicated class, so the other tests would not be affected
+	contractID := s.createClass(s.vendor, s.vendor, "allowance", "ALW", s.balance, true)
+
+	// the order matters, so it does not use a map
+	testCases := []struct {
+		name  string
+		cmd   func() *cobra.Command
+		args  []string
+		valid bool
+	}{
+		{
+			"valid approve",
+			cli.NewTxCmdApprove,
+			[]string{
+				contractID,
+				s.vendor.String(),
+				s.customer.String(),
+				s.balance.String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.vendor),
+			},
+			true,
+		},
+		{
+			"valid transfer from",
+			cli.NewTxCmdTransferFrom,
+			[]string{
+				contractID,
+				s.customer.String(),
+				s.vendor.String(),
+				s.customer.String(),
+				s.balance.String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.customer),
+			},
+			true,
+		},
+		{
+			"extra args",
+			cli.NewTxCmdApprove,
+			[]string{
+				contractID,
+				s.vendor.String(),
+				s.customer.String(),
+				s.balance.String(),
+				"extra",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.vendor),
+			},
+			false,
+		},
+		{
+			"not enough args",
+			cli.NewTxCmdTransferFrom,
+			[]string{
+				contractID,
+				s.customer.String(),
+				s.vendor.String(),
+				s.customer.String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.customer),
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, tc.cmd(), append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res sdk.TxResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+			s.Require().EqualValues(0, res.Code, out.String())
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewTxCmdWrap() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	// use a dedicated class, so the other tests would not be affected
+	contractID := s.createClass(s.vendor, s.vendor, "wrappable", "WRP", s.balance, true)
+
+	// the order matters, so it does not use a map
+	testCases := []struct {
+		name  string
+		cmd   func() *cobra.Command
+		args  []string
+		valid bool
+	}{
+		{
+			"valid wrap",
+			cli.NewTxCmdWrap,
+			[]string{
+				contractID,
+				s.vendor.String(),
+				s.balance.String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.vendor),
+			},
+			true,
+		},
+		{
+			"valid unwrap",
+			cli.NewTxCmdUnwrap,
+			[]string{
+				contractID,
+				s.vendor.String(),
+				s.balance.String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.vendor),
+			},
+			true,
+		},
+		{
+			"extra args",
+			cli.NewTxCmdWrap,
+			[]string{
+				contractID,
+				s.vendor.String(),
+				s.balance.String(),
+				"extra",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.vendor),
+			},
+			false,
+		},
+		{
+			"not enough args",
+			cli.NewTxCmdUnwrap,
+			[]string{
+				contractID,
+				s.vendor.String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.vendor),
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, tc.cmd(), append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res sdk.TxResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+			s.Require().EqualValues(0, res.Code, out.String())
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewTxCmdMintVested() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, s.vendor),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid continuous vesting": {
+			[]string{
+				s.classes[0].Id,
+				s.vendor.String(),
+				s.customer.String(),
+				"10",
+				fmt.Sprintf("--%s=1000", cli.FlagStartTime),
+				fmt.Sprintf("--%s=2000", cli.FlagEndTime),
+			},
+			true,
+		},
+		"valid periodic vesting": {
+			[]string{
+				s.classes[0].Id,
+				s.vendor.String(),
+				s.customer.String(),
+				"10",
+				fmt.Sprintf("--%s=1000", cli.FlagStartTime),
+				fmt.Sprintf("--%s=100:3,100:7", cli.FlagPeriods),
+			},
+			true,
+		},
+		"extra args": {
+			[]string{
+				s.classes[0].Id,
+				s.vendor.String(),
+				s.customer.String(),
+				"10",
+				"extra",
+				fmt.Sprintf("--%s=1000", cli.FlagStartTime),
+				fmt.Sprintf("--%s=2000", cli.FlagEndTime),
+			},
+			false,
+		},
+		"not enough args": {
+			[]string{
+				s.classes[0].Id,
+				s.vendor.String(),
+				s.customer.String(),
+				fmt.Sprintf("--%s=1000", cli.FlagStartTime),
+				fmt.Sprintf("--%s=2000", cli.FlagEndTime),
+			},
+			false,
+		},
+		"invalid periods": {
+			[]string{
+				s.classes[0].Id,
+				s.vendor.String(),
+				s.customer.String(),
+				"10",
+				fmt.Sprintf("--%s=1000", cli.FlagStartTime),
+				fmt.Sprintf("--%s=100", cli.FlagPeriods),
+			},
+			false,
+		},
+		"no schedule": {
+			[]string{
+				s.classes[0].Id,
+				s.vendor.String(),
+				s.customer.String(),
+				"10",
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdMintVested()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res sdk.TxResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+			s.Require().EqualValues(0, res.Code, out.String())
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewTxCmdSendVested() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, s.vendor),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid continuous vesting": {
+			[]string{
+				s.classes[1].Id,
+				s.vendor.String(),
+				s.customer.String(),
+				"10",
+				fmt.Sprintf("--%s=1000", cli.FlagStartTime),
+				fmt.Sprintf("--%s=2000", cli.FlagEndTime),
+			},
+			true,
+		},
+		"valid periodic vesting": {
+			[]string{
+				s.classes[1].Id,
+				s.vendor.String(),
+				s.customer.String(),
+				"10",
+				fmt.Sprintf("--%s=1000", cli.FlagStartTime),
+				fmt.Sprintf("--%s=100:3,100:7", cli.FlagPeriods),
+			},
+			true,
+		},
+		"extra args": {
+			[]string{
+				s.classes[1].Id,
+				s.vendor.String(),
+				s.customer.String(),
+				"10",
+				"extra",
+				fmt.Sprintf("--%s=1000", cli.FlagStartTime),
+				fmt.Sprintf("--%s=2000", cli.FlagEndTime),
+			},
+			false,
+		},
+		"not enough args": {
+			[]string{
+				s.classes[1].Id,
+				s.vendor.String(),
+				s.customer.String(),
+				fmt.Sprintf("--%s=1000", cli.FlagStartTime),
+				fmt.Sprintf("--%s=2000", cli.FlagEndTime),
+			},
+			false,
+		},
+		"invalid periods": {
+			[]string{
+				s.classes[1].Id,
+				s.vendor.String(),
+				s.customer.String(),
+				"10",
+				fmt.Sprintf("--%s=1000", cli.FlagStartTime),
+				fmt.Sprintf("--%s=100", cli.FlagPeriods),
+			},
+			false,
+		},
+		"no schedule": {
+			[]string{
+				s.classes[1].Id,
+				s.vendor.String(),
+				s.customer.String(),
+				"10",
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdSendVested()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res sdk.TxResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+			s.Require().EqualValues(0, res.Code, out.String())
+		})
+	}
+}
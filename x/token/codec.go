@@ -7,6 +7,7 @@ import (
 	cryptocodec "github.com/Finschia/finschia-sdk/crypto/codec"
 	sdk "github.com/Finschia/finschia-sdk/types"
 	"github.com/Finschia/finschia-sdk/types/msgservice"
+	"github.com/Finschia/finschia-sdk/x/authz"
 	authzcodec "github.com/Finschia/finschia-sdk/x/authz/codec"
 	fdncodec "github.com/Finschia/finschia-sdk/x/foundation/codec"
 	govcodec "github.com/Finschia/finschia-sdk/x/gov/codec"
@@ -25,6 +26,19 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgBurn{}, "lbm-sdk/MsgBurn")
 	legacy.RegisterAminoMsg(cdc, &MsgOperatorBurn{}, "lbm-sdk/MsgOperatorBurn")
 	legacy.RegisterAminoMsg(cdc, &MsgModify{}, "lbm-sdk/token/MsgModify") // Changed msgName due to conflict with `x/collection`
+	legacy.RegisterAminoMsg(cdc, &MsgPause{}, "lbm-sdk/token/MsgPause")
+	legacy.RegisterAminoMsg(cdc, &MsgUnpause{}, "lbm-sdk/token/MsgUnpause")
+	legacy.RegisterAminoMsg(cdc, &MsgFreeze{}, "lbm-sdk/token/MsgFreeze")
+	legacy.RegisterAminoMsg(cdc, &MsgUnfreeze{}, "lbm-sdk/token/MsgUnfreeze")
+	legacy.RegisterAminoMsg(cdc, &MsgApprove{}, "lbm-sdk/token/MsgApprove")
+	legacy.RegisterAminoMsg(cdc, &MsgTransferFrom{}, "lbm-sdk/token/MsgTransferFrom")
+	legacy.RegisterAminoMsg(cdc, &MsgWrap{}, "lbm-sdk/token/MsgWrap")
+	legacy.RegisterAminoMsg(cdc, &MsgUnwrap{}, "lbm-sdk/token/MsgUnwrap")
+	legacy.RegisterAminoMsg(cdc, &MsgMultiSend{}, "lbm-sdk/token/MsgMultiSend")
+	legacy.RegisterAminoMsg(cdc, &MsgMintVested{}, "lbm-sdk/token/MsgMintVested")
+	legacy.RegisterAminoMsg(cdc, &MsgSendVested{}, "lbm-sdk/token/MsgSendVested")
+
+	cdc.RegisterConcrete(&TokenSendAuthorization{}, "lbm-sdk/token/TokenSendAuthorization", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -40,6 +54,22 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgOperatorBurn{},
 		&MsgGrantPermission{},
 		&MsgRevokePermission{},
+		&MsgPause{},
+		&MsgUnpause{},
+		&MsgFreeze{},
+		&MsgUnfreeze{},
+		&MsgApprove{},
+		&MsgTransferFrom{},
+		&MsgWrap{},
+		&MsgUnwrap{},
+		&MsgMultiSend{},
+		&MsgMintVested{},
+		&MsgSendVested{},
+	)
+
+	registry.RegisterImplementations(
+		(*authz.Authorization)(nil),
+		&TokenSendAuthorization{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
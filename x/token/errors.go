@@ -26,8 +26,11 @@ var (
 	ErrInvalidChangesField      = sdkerrors.Register(tokenCodespace, 18, "invalid field of changes")
 	ErrDuplicateChangesField    = sdkerrors.Register(tokenCodespace, 19, "invalid field of changes")
 	ErrInvalidMetaLength        = sdkerrors.Register(tokenCodespace, 20, "invalid meta length")
-	ErrSupplyOverflow           = sdkerrors.Register(tokenCodespace, 21, "supply for token reached maximum") // Currently never used
+	ErrSupplyOverflow           = sdkerrors.Register(tokenCodespace, 21, "supply for token reached maximum")
 	ErrApproverProxySame        = sdkerrors.Register(tokenCodespace, 22, "approver is same with proxy")
 	ErrTokenNotApproved         = sdkerrors.Register(tokenCodespace, 23, "proxy is not approved on the token")
 	ErrTokenAlreadyApproved     = sdkerrors.Register(tokenCodespace, 24, "proxy is already approved on the token")
+	ErrContractPaused           = sdkerrors.Register(tokenCodespace, 25, "contract is paused")
+	ErrAccountFrozen            = sdkerrors.Register(tokenCodespace, 26, "account is frozen")
+	ErrInsufficientAllowance    = sdkerrors.Register(tokenCodespace, 27, "insufficient allowance")
 )
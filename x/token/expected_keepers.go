@@ -2,6 +2,8 @@ package token
 
 import (
 	sdk "github.com/Finschia/finschia-sdk/types"
+	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
+	banktypes "github.com/Finschia/finschia-sdk/x/bank/types"
 )
 
 type (
@@ -13,4 +15,26 @@ type (
 		InitGenesis(ctx sdk.Context, data *ClassGenesisState)
 		ExportGenesis(ctx sdk.Context) *ClassGenesisState
 	}
+
+	// AccountKeeper defines the account keeper interface contract needed by the
+	// token module, on simulations.
+	AccountKeeper interface {
+		GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
+	}
+
+	// BankKeeper defines the bank module interface contract needed by the
+	// token module, on wrapping tokens into coins.
+	BankKeeper interface {
+		SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+		GetSupply(ctx sdk.Context, denom string) sdk.Coin
+
+		GetDenomMetaData(ctx sdk.Context, denom string) (banktypes.Metadata, bool)
+		SetDenomMetaData(ctx sdk.Context, denomMetaData banktypes.Metadata)
+
+		MintCoins(ctx sdk.Context, moduleName string, amounts sdk.Coins) error
+		BurnCoins(ctx sdk.Context, moduleName string, amounts sdk.Coins) error
+
+		SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+		SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	}
 )
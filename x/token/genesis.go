@@ -9,6 +9,13 @@ import (
 
 // ValidateGenesis check the given genesis state has no integrity issues
 func ValidateGenesis(data GenesisState) error {
+	// empty params fall back to the default ones
+	if data.Params != (Params{}) {
+		if err := data.Params.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
 	if data.ClassState != nil {
 		if err := ValidateClassGenesis(*data.ClassState); err != nil {
 			return err
@@ -90,12 +97,93 @@ func ValidateGenesis(data GenesisState) error {
 		}
 	}
 
+	seenPaused := map[string]bool{}
+	for _, contractID := range data.Paused {
+		if err := ValidateContractID(contractID); err != nil {
+			return err
+		}
+		if seenPaused[contractID] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate paused contract: %s", contractID)
+		}
+		seenPaused[contractID] = true
+	}
+
+	seenFrozen := map[string]bool{}
+	for _, contractFrozen := range data.Frozen {
+		if err := ValidateContractID(contractFrozen.ContractId); err != nil {
+			return err
+		}
+		if seenFrozen[contractFrozen.ContractId] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate frozen accounts of contract: %s", contractFrozen.ContractId)
+		}
+		seenFrozen[contractFrozen.ContractId] = true
+
+		if len(contractFrozen.Accounts) == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrap("frozen accounts cannot be empty")
+		}
+		seenAccounts := map[string]bool{}
+		for _, account := range contractFrozen.Accounts {
+			if _, err := sdk.AccAddressFromBech32(account); err != nil {
+				return err
+			}
+			if seenAccounts[account] {
+				return sdkerrors.ErrInvalidRequest.Wrapf("duplicate frozen account: %s", account)
+			}
+			seenAccounts[account] = true
+		}
+	}
+
+	for _, contractAllowances := range data.Allowances {
+		if err := ValidateContractID(contractAllowances.ContractId); err != nil {
+			return err
+		}
+
+		if len(contractAllowances.Allowances) == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrap("allowances cannot be empty")
+		}
+		for _, allowance := range contractAllowances.Allowances {
+			if _, err := sdk.AccAddressFromBech32(allowance.Owner); err != nil {
+				return err
+			}
+			if _, err := sdk.AccAddressFromBech32(allowance.Spender); err != nil {
+				return err
+			}
+			if err := validateAmount(allowance.Amount); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, contractVestings := range data.Vestings {
+		if err := ValidateContractID(contractVestings.ContractId); err != nil {
+			return err
+		}
+
+		if len(contractVestings.Vestings) == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrap("vestings cannot be empty")
+		}
+		for _, vesting := range contractVestings.Vestings {
+			if _, err := sdk.AccAddressFromBech32(vesting.Holder); err != nil {
+				return err
+			}
+			if err := validateAmount(vesting.OriginalAmount); err != nil {
+				return err
+			}
+			if err := vesting.Schedule.ValidateBasic(vesting.OriginalAmount); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
 // DefaultGenesisState - Return a default genesis state
 func DefaultGenesisState() *GenesisState {
-	return &GenesisState{ClassState: DefaultClassGenesisState()}
+	return &GenesisState{
+		Params:     DefaultParams(),
+		ClassState: DefaultClassGenesisState(),
+	}
 }
 
 // For Class keeper
@@ -46,6 +46,22 @@ type GenesisState struct {
 	Mints []ContractCoin `protobuf:"bytes,8,rep,name=mints,proto3" json:"mints"`
 	// burns represents the total burns of tokens.
 	Burns []ContractCoin `protobuf:"bytes,9,rep,name=burns,proto3" json:"burns"`
+	// paused defines the ids of the paused contracts.
+	//
+	// Since: 0.49.0 (finschia)
+	Paused []string `protobuf:"bytes,10,rep,name=paused,proto3" json:"paused,omitempty"`
+	// frozen defines the frozen accounts of the contracts.
+	//
+	// Since: 0.49.0 (finschia)
+	Frozen []ContractFrozenAccounts `protobuf:"bytes,11,rep,name=frozen,proto3" json:"frozen"`
+	// allowances defines the allowances of the contracts.
+	//
+	// Since: 0.49.0 (finschia)
+	Allowances []ContractAllowances `protobuf:"bytes,12,rep,name=allowances,proto3" json:"allowances"`
+	// vestings defines the vestings of the contracts.
+	//
+	// Since: 0.49.0 (finschia)
+	Vestings []ContractVestings `protobuf:"bytes,13,rep,name=vestings,proto3" json:"vestings"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -144,6 +160,34 @@ func (m *GenesisState) GetBurns() []ContractCoin {
 	return nil
 }
 
+func (m *GenesisState) GetPaused() []string {
+	if m != nil {
+		return m.Paused
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFrozen() []ContractFrozenAccounts {
+	if m != nil {
+		return m.Frozen
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAllowances() []ContractAllowances {
+	if m != nil {
+		return m.Allowances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVestings() []ContractVestings {
+	if m != nil {
+		return m.Vestings
+	}
+	return nil
+}
+
 // ClassGenesisState defines the classs keeper's genesis state.
 //
 // Deprecated: Do not use.
@@ -359,6 +403,183 @@ func (m *ContractAuthorizations) GetAuthorizations() []Authorization {
 	return nil
 }
 
+// ContractFrozenAccounts defines frozen accounts belong to a contract.
+//
+// Since: 0.49.0 (finschia)
+//
+// Deprecated: Do not use.
+type ContractFrozenAccounts struct {
+	// contract id associated with the token class.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// addresses of the frozen accounts.
+	Accounts []string `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+}
+
+func (m *ContractFrozenAccounts) Reset()         { *m = ContractFrozenAccounts{} }
+func (m *ContractFrozenAccounts) String() string { return proto.CompactTextString(m) }
+func (*ContractFrozenAccounts) ProtoMessage()    {}
+func (*ContractFrozenAccounts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4528f1ba25ef9938, []int{5}
+}
+func (m *ContractFrozenAccounts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractFrozenAccounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractFrozenAccounts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractFrozenAccounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractFrozenAccounts.Merge(m, src)
+}
+func (m *ContractFrozenAccounts) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractFrozenAccounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractFrozenAccounts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractFrozenAccounts proto.InternalMessageInfo
+
+func (m *ContractFrozenAccounts) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *ContractFrozenAccounts) GetAccounts() []string {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+// ContractVestings defines vestings belong to a contract.
+//
+// Since: 0.49.0 (finschia)
+//
+// Deprecated: Do not use.
+type ContractVestings struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// vestings of the contract.
+	Vestings []Vesting `protobuf:"bytes,2,rep,name=vestings,proto3" json:"vestings"`
+}
+
+func (m *ContractVestings) Reset()         { *m = ContractVestings{} }
+func (m *ContractVestings) String() string { return proto.CompactTextString(m) }
+func (*ContractVestings) ProtoMessage()    {}
+func (*ContractVestings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4528f1ba25ef9938, []int{6}
+}
+func (m *ContractVestings) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractVestings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractVestings.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractVestings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractVestings.Merge(m, src)
+}
+func (m *ContractVestings) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractVestings) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractVestings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractVestings proto.InternalMessageInfo
+
+func (m *ContractVestings) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *ContractVestings) GetVestings() []Vesting {
+	if m != nil {
+		return m.Vestings
+	}
+	return nil
+}
+
+// ContractAllowances defines allowances belong to a contract.
+//
+// Since: 0.49.0 (finschia)
+//
+// Deprecated: Do not use.
+type ContractAllowances struct {
+	// contract id associated with the token class.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// allowances of the contract.
+	Allowances []Allowance `protobuf:"bytes,2,rep,name=allowances,proto3" json:"allowances"`
+}
+
+func (m *ContractAllowances) Reset()         { *m = ContractAllowances{} }
+func (m *ContractAllowances) String() string { return proto.CompactTextString(m) }
+func (*ContractAllowances) ProtoMessage()    {}
+func (*ContractAllowances) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4528f1ba25ef9938, []int{7}
+}
+func (m *ContractAllowances) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractAllowances) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractAllowances.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractAllowances) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractAllowances.Merge(m, src)
+}
+func (m *ContractAllowances) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractAllowances) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractAllowances.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractAllowances proto.InternalMessageInfo
+
+func (m *ContractAllowances) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *ContractAllowances) GetAllowances() []Allowance {
+	if m != nil {
+		return m.Allowances
+	}
+	return nil
+}
+
 // ContractGrant defines grants belong to a contract.
 //
 // Deprecated: Do not use.
@@ -373,7 +594,7 @@ func (m *ContractGrants) Reset()         { *m = ContractGrants{} }
 func (m *ContractGrants) String() string { return proto.CompactTextString(m) }
 func (*ContractGrants) ProtoMessage()    {}
 func (*ContractGrants) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4528f1ba25ef9938, []int{5}
+	return fileDescriptor_4528f1ba25ef9938, []int{8}
 }
 func (m *ContractGrants) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -428,7 +649,7 @@ func (m *ContractCoin) Reset()         { *m = ContractCoin{} }
 func (m *ContractCoin) String() string { return proto.CompactTextString(m) }
 func (*ContractCoin) ProtoMessage()    {}
 func (*ContractCoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4528f1ba25ef9938, []int{6}
+	return fileDescriptor_4528f1ba25ef9938, []int{9}
 }
 func (m *ContractCoin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -470,6 +691,9 @@ func init() {
 	proto.RegisterType((*ContractBalances)(nil), "lbm.token.v1.ContractBalances")
 	proto.RegisterType((*Balance)(nil), "lbm.token.v1.Balance")
 	proto.RegisterType((*ContractAuthorizations)(nil), "lbm.token.v1.ContractAuthorizations")
+	proto.RegisterType((*ContractFrozenAccounts)(nil), "lbm.token.v1.ContractFrozenAccounts")
+	proto.RegisterType((*ContractVestings)(nil), "lbm.token.v1.ContractVestings")
+	proto.RegisterType((*ContractAllowances)(nil), "lbm.token.v1.ContractAllowances")
 	proto.RegisterType((*ContractGrants)(nil), "lbm.token.v1.ContractGrants")
 	proto.RegisterType((*ContractCoin)(nil), "lbm.token.v1.ContractCoin")
 }
@@ -477,44 +701,52 @@ func init() {
 func init() { proto.RegisterFile("lbm/token/v1/genesis.proto", fileDescriptor_4528f1ba25ef9938) }
 
 var fileDescriptor_4528f1ba25ef9938 = []byte{
-	// 592 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x1c, 0xc6, 0xe3, 0xa4, 0x75, 0x9a, 0x6f, 0xaa, 0xaa, 0xbf, 0xfb, 0x95, 0xea, 0x14, 0x90, 0x53,
-	0x55, 0x0c, 0x11, 0x08, 0x5b, 0x49, 0xa5, 0x22, 0x45, 0x0c, 0xc5, 0x95, 0xa8, 0xc2, 0x84, 0x8c,
-	0x58, 0x58, 0xaa, 0xf3, 0x1f, 0x92, 0x53, 0xe3, 0x3b, 0xcb, 0x77, 0x29, 0x7f, 0x16, 0x56, 0x46,
-	0x5e, 0x02, 0x2f, 0x85, 0xb1, 0x63, 0x47, 0xc4, 0x50, 0xa1, 0x64, 0xe1, 0x65, 0x20, 0xdf, 0xd9,
-	0x55, 0x9c, 0x06, 0x25, 0x03, 0xdb, 0xc5, 0xf7, 0x3c, 0x9f, 0xc7, 0xcf, 0xe5, 0x7b, 0x86, 0xd6,
-	0xd8, 0x8f, 0x1d, 0xc9, 0x2f, 0x22, 0xe6, 0x5c, 0x76, 0x9d, 0x61, 0xc4, 0x22, 0x41, 0x85, 0x9d,
-	0xa4, 0x5c, 0x72, 0xb4, 0x3d, 0xf6, 0x63, 0x5b, 0xed, 0xd9, 0x97, 0xdd, 0xd6, 0xde, 0x90, 0x0f,
-	0xb9, 0xda, 0x70, 0xb2, 0x95, 0xd6, 0xb4, 0x70, 0xc9, 0xaf, 0xc5, 0x6a, 0xe7, 0xf0, 0xfb, 0x06,
-	0x6c, 0x9f, 0x69, 0xde, 0x6b, 0x49, 0x64, 0x84, 0x7a, 0x60, 0x26, 0x24, 0x25, 0xb1, 0xc0, 0xc6,
-	0x81, 0xd1, 0x69, 0xf6, 0xf6, 0xec, 0x79, 0xbe, 0xfd, 0x4a, 0xed, 0xb9, 0x1b, 0x57, 0x37, 0xed,
-	0x8a, 0x97, 0x2b, 0xd1, 0x09, 0x34, 0x83, 0x31, 0x11, 0xe2, 0x5c, 0x64, 0x08, 0x5c, 0x55, 0xc6,
-	0x76, 0xd9, 0x78, 0x9a, 0x09, 0xe6, 0x93, 0x3c, 0x50, 0x1e, 0x9d, 0x7a, 0x02, 0x5b, 0x3e, 0x19,
-	0x13, 0x16, 0x44, 0x02, 0xd7, 0x0e, 0x6a, 0x9d, 0x66, 0xcf, 0x5a, 0xb0, 0x73, 0x26, 0x53, 0x12,
-	0x48, 0x37, 0x57, 0xe5, 0x6f, 0x70, 0xeb, 0x42, 0xc7, 0x50, 0x57, 0xbc, 0x48, 0xe0, 0x0d, 0x05,
-	0xd8, 0xff, 0x0b, 0x40, 0x1b, 0x0b, 0x31, 0xea, 0x83, 0x39, 0x4c, 0x09, 0x93, 0x02, 0x6f, 0x2a,
-	0xdb, 0x83, 0xe5, 0xb6, 0x33, 0xa5, 0x29, 0x7a, 0x6b, 0x07, 0xf2, 0x60, 0x87, 0x4c, 0xe4, 0x88,
-	0xa7, 0xf4, 0x13, 0x91, 0x94, 0x33, 0x81, 0x4d, 0xc5, 0x78, 0xb8, 0x9c, 0xf1, 0xbc, 0xa4, 0xcd,
-	0x59, 0x0b, 0x04, 0xf4, 0x0c, 0xb6, 0xc4, 0x24, 0x49, 0xc6, 0x34, 0x12, 0xb8, 0xae, 0x68, 0xad,
-	0xe5, 0xb4, 0x53, 0x4e, 0x59, 0x71, 0x0a, 0x85, 0x03, 0x1d, 0xc3, 0x66, 0x4c, 0xb3, 0x32, 0x5b,
-	0x6b, 0x5a, 0xb5, 0x3c, 0xf3, 0xf9, 0x93, 0x94, 0x09, 0xdc, 0x58, 0xd7, 0xa7, 0xe4, 0xfd, 0x2a,
-	0x36, 0x0e, 0x25, 0xfc, 0x77, 0xe7, 0xcf, 0x45, 0x03, 0xd8, 0x64, 0x9c, 0x05, 0x91, 0x9a, 0xa2,
-	0x86, 0x7b, 0x94, 0x99, 0x7e, 0xde, 0xb4, 0x1f, 0x0f, 0xa9, 0x1c, 0x4d, 0x7c, 0x3b, 0xe0, 0xb1,
-	0xf3, 0x82, 0x32, 0x11, 0x8c, 0x28, 0x71, 0xde, 0xe5, 0x8b, 0x27, 0x22, 0xbc, 0x70, 0xe4, 0xc7,
-	0x24, 0x12, 0xf6, 0x1b, 0xca, 0xa4, 0xa7, 0x09, 0x68, 0x17, 0x6a, 0x34, 0x14, 0xb8, 0x7a, 0x50,
-	0xeb, 0x34, 0xbc, 0x6c, 0xa9, 0x52, 0x13, 0xd8, 0x5d, 0x9c, 0x09, 0xd4, 0x86, 0x66, 0x90, 0x3f,
-	0x3b, 0xa7, 0xa1, 0x8e, 0xf6, 0xa0, 0x78, 0x34, 0x08, 0xd1, 0xd3, 0xb9, 0x31, 0xab, 0xaa, 0xa6,
-	0xf7, 0xca, 0x4d, 0x73, 0xd4, 0xe2, 0x74, 0xa9, 0xc4, 0xf7, 0x50, 0xcf, 0xb7, 0x11, 0x86, 0x3a,
-	0x09, 0xc3, 0x34, 0x12, 0x22, 0x0f, 0x29, 0x7e, 0xa2, 0x97, 0x60, 0x92, 0x98, 0x4f, 0x98, 0x54,
-	0xb7, 0xa0, 0xe1, 0xf6, 0xf2, 0xe2, 0x8f, 0xd6, 0x2c, 0x3e, 0x60, 0xd2, 0xcb, 0x09, 0x7d, 0xf3,
-	0xf7, 0xb7, 0xb6, 0x81, 0x8d, 0xc3, 0x2f, 0x06, 0xec, 0x2f, 0x9f, 0xa1, 0xd5, 0x8d, 0x07, 0x77,
-	0x46, 0x54, 0xf7, 0xbe, 0x5f, 0xee, 0x5d, 0xc2, 0x2e, 0x9f, 0x4c, 0x75, 0x06, 0x23, 0xd8, 0x29,
-	0xdf, 0x88, 0xd5, 0x6f, 0xd0, 0xbd, 0xbd, 0x60, 0x3a, 0xf9, 0xff, 0x72, 0xb2, 0xc2, 0x94, 0xef,
-	0x95, 0x4a, 0xfa, 0x0c, 0xdb, 0xf3, 0x63, 0xb7, 0x3a, 0xe7, 0x5f, 0x9e, 0x7c, 0x15, 0x1b, 0xae,
-	0x7b, 0x35, 0xb5, 0x8c, 0xeb, 0xa9, 0x65, 0xfc, 0x9a, 0x5a, 0xc6, 0xd7, 0x99, 0x55, 0xb9, 0x9e,
-	0x59, 0x95, 0x1f, 0x33, 0xab, 0xf2, 0xb6, 0xb3, 0x92, 0xf8, 0x41, 0x7f, 0x63, 0x7d, 0x53, 0x7d,
-	0x64, 0x8f, 0xfe, 0x04, 0x00, 0x00, 0xff, 0xff, 0x2a, 0xaf, 0x98, 0x1b, 0xc0, 0x05, 0x00, 0x00,
+	// 720 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6f, 0xda, 0x48,
+	0x14, 0xc7, 0x90, 0x18, 0x78, 0xb0, 0x51, 0x76, 0x36, 0xcb, 0x8e, 0xd8, 0x0a, 0x50, 0xd4, 0x03,
+	0x6a, 0x55, 0x50, 0x88, 0x94, 0x4a, 0x51, 0x2b, 0x25, 0x8e, 0x94, 0x88, 0x9e, 0x2a, 0x57, 0xad,
+	0xd4, 0x5e, 0xa2, 0xc1, 0x76, 0xc0, 0x8a, 0x99, 0x41, 0x9e, 0x21, 0x49, 0x73, 0xe9, 0xb5, 0xc7,
+	0x7e, 0x84, 0x9e, 0xfa, 0x59, 0x72, 0xcc, 0xb1, 0xea, 0x21, 0xaa, 0x92, 0x4b, 0x3f, 0x46, 0xe5,
+	0x99, 0x31, 0xc2, 0xe0, 0x04, 0x0e, 0xbd, 0x79, 0x3c, 0xbf, 0x3f, 0x7e, 0x6f, 0x7e, 0x6f, 0x0c,
+	0xd5, 0xa0, 0x37, 0x6c, 0x0b, 0x76, 0xea, 0xd1, 0xf6, 0xd9, 0x56, 0xbb, 0xef, 0x51, 0x8f, 0xfb,
+	0xbc, 0x35, 0x0a, 0x99, 0x60, 0xa8, 0x1c, 0xf4, 0x86, 0x2d, 0xb9, 0xd7, 0x3a, 0xdb, 0xaa, 0x6e,
+	0xf4, 0x59, 0x9f, 0xc9, 0x8d, 0x76, 0xf4, 0xa4, 0x30, 0x55, 0x9c, 0xe0, 0x2b, 0xb0, 0xdc, 0xd9,
+	0xfc, 0x66, 0x42, 0xf9, 0x48, 0xe9, 0xbd, 0x11, 0x44, 0x78, 0xa8, 0x03, 0xe6, 0x88, 0x84, 0x64,
+	0xc8, 0xb1, 0xd1, 0x30, 0x9a, 0xa5, 0xce, 0x46, 0x6b, 0x5a, 0xbf, 0xf5, 0x5a, 0xee, 0x59, 0x2b,
+	0x57, 0x37, 0xf5, 0x8c, 0xad, 0x91, 0x68, 0x0f, 0x4a, 0x4e, 0x40, 0x38, 0x3f, 0xe6, 0x91, 0x04,
+	0xce, 0x4a, 0x62, 0x3d, 0x49, 0x3c, 0x88, 0x00, 0xd3, 0x4e, 0x36, 0x48, 0x8e, 0x72, 0xdd, 0x83,
+	0x42, 0x8f, 0x04, 0x84, 0x3a, 0x1e, 0xc7, 0xb9, 0x46, 0xae, 0x59, 0xea, 0xd4, 0x66, 0xe8, 0x8c,
+	0x8a, 0x90, 0x38, 0xc2, 0xd2, 0x28, 0xfd, 0x05, 0x13, 0x16, 0xda, 0x81, 0xbc, 0xd4, 0xf3, 0x38,
+	0x5e, 0x91, 0x02, 0x95, 0x7b, 0x04, 0x14, 0x31, 0x06, 0xa3, 0x5d, 0x30, 0xfb, 0x21, 0xa1, 0x82,
+	0xe3, 0x55, 0x49, 0x7b, 0x94, 0x4e, 0x3b, 0x92, 0x98, 0xb8, 0x6e, 0xc5, 0x40, 0x36, 0xac, 0x91,
+	0xb1, 0x18, 0xb0, 0xd0, 0xbf, 0x24, 0xc2, 0x67, 0x94, 0x63, 0x53, 0x6a, 0x3c, 0x4e, 0xd7, 0xd8,
+	0x4f, 0x60, 0xb5, 0xd6, 0x8c, 0x02, 0x7a, 0x01, 0x05, 0x3e, 0x1e, 0x8d, 0x02, 0xdf, 0xe3, 0x38,
+	0x2f, 0xd5, 0xaa, 0xe9, 0x6a, 0x07, 0xcc, 0xa7, 0x71, 0x17, 0x62, 0x06, 0xda, 0x81, 0xd5, 0xa1,
+	0x1f, 0x15, 0x53, 0x58, 0x92, 0xaa, 0xe0, 0x11, 0xaf, 0x37, 0x0e, 0x29, 0xc7, 0xc5, 0x65, 0x79,
+	0x12, 0x8e, 0x2a, 0x51, 0x5a, 0xc6, 0xdc, 0x73, 0x31, 0x34, 0x72, 0xcd, 0xa2, 0xad, 0x57, 0xc8,
+	0x02, 0xf3, 0x24, 0x64, 0x97, 0x1e, 0xc5, 0xa5, 0x87, 0x3a, 0x72, 0x28, 0x31, 0xfb, 0x8e, 0xc3,
+	0xc6, 0x53, 0xdd, 0x55, 0x4c, 0x74, 0x08, 0x40, 0x82, 0x80, 0x9d, 0xab, 0x54, 0x94, 0xa5, 0x4e,
+	0xe3, 0x9e, 0xce, 0x4e, 0x70, 0x5a, 0x63, 0x8a, 0x19, 0x65, 0xeb, 0xcc, 0xe3, 0xc2, 0xa7, 0x7d,
+	0x8e, 0xff, 0x7a, 0x28, 0x5b, 0xef, 0x34, 0x2a, 0xee, 0x6a, 0xcc, 0xda, 0xcd, 0x62, 0x63, 0x53,
+	0xc0, 0xdf, 0x73, 0x11, 0x46, 0x5d, 0x58, 0xa5, 0x8c, 0x3a, 0x9e, 0x9c, 0x95, 0xa2, 0xb5, 0x1d,
+	0xf1, 0x7e, 0xdc, 0xd4, 0x9f, 0xf6, 0x7d, 0x31, 0x18, 0xf7, 0x5a, 0x0e, 0x1b, 0xb6, 0x0f, 0x7d,
+	0xca, 0x9d, 0x81, 0x4f, 0xda, 0x27, 0xfa, 0xe1, 0x19, 0x77, 0x4f, 0xdb, 0xe2, 0xe3, 0xc8, 0xe3,
+	0xad, 0xb7, 0x3e, 0x15, 0xb6, 0x52, 0x40, 0xeb, 0x90, 0xf3, 0x5d, 0x8e, 0xb3, 0xb2, 0x8d, 0xd1,
+	0xa3, 0x74, 0x1d, 0xc1, 0xfa, 0x6c, 0xf2, 0x51, 0x1d, 0x4a, 0x8e, 0x7e, 0x77, 0xec, 0xbb, 0xca,
+	0xda, 0x86, 0xf8, 0x55, 0xd7, 0x45, 0xcf, 0xa7, 0x86, 0x29, 0x2b, 0x0b, 0xfe, 0x37, 0x59, 0xb0,
+	0x96, 0x9a, 0x9d, 0x21, 0xe9, 0x78, 0x0e, 0x79, 0xbd, 0x8d, 0x30, 0xe4, 0x89, 0xeb, 0x86, 0x1e,
+	0xe7, 0xda, 0x24, 0x5e, 0xa2, 0x57, 0x60, 0x92, 0x61, 0x74, 0x66, 0x72, 0xd6, 0x8b, 0x56, 0x47,
+	0x17, 0xfe, 0x64, 0xc9, 0xc2, 0xbb, 0x54, 0xd8, 0x5a, 0x61, 0xd7, 0xfc, 0xf5, 0xb5, 0x6e, 0x60,
+	0x63, 0xf3, 0xb3, 0x01, 0x95, 0xf4, 0x49, 0x59, 0x5c, 0x71, 0x77, 0x6e, 0x10, 0x55, 0xdd, 0xff,
+	0x27, 0xeb, 0x4e, 0xc8, 0xa6, 0xcf, 0x9f, 0xec, 0xc1, 0x7b, 0xa8, 0xa4, 0x27, 0x74, 0xf1, 0x97,
+	0x54, 0xa1, 0x40, 0x34, 0x58, 0x9f, 0xe5, 0x64, 0x3d, 0x7b, 0xa0, 0x71, 0xdc, 0x96, 0x3a, 0xd0,
+	0x49, 0x82, 0x53, 0x0f, 0x54, 0x4b, 0xa5, 0x06, 0xf7, 0x02, 0xd0, 0xfc, 0x98, 0x2c, 0xf6, 0x7c,
+	0x99, 0x98, 0x3e, 0xe5, 0xfa, 0xdf, 0x4c, 0x3b, 0xe3, 0xfd, 0xf9, 0xa1, 0x93, 0xce, 0x03, 0x58,
+	0x4b, 0x5e, 0x9f, 0x8b, 0x5d, 0xb7, 0x26, 0xb7, 0xb1, 0x72, 0xfc, 0x27, 0xe9, 0x28, 0x65, 0x92,
+	0x97, 0xb0, 0x74, 0xfa, 0x04, 0xe5, 0xe9, 0x3b, 0x6a, 0xb1, 0xcf, 0x9f, 0x0c, 0x70, 0x16, 0x1b,
+	0x96, 0x75, 0x75, 0x5b, 0x33, 0xae, 0x6f, 0x6b, 0xc6, 0xcf, 0xdb, 0x9a, 0xf1, 0xe5, 0xae, 0x96,
+	0xb9, 0xbe, 0xab, 0x65, 0xbe, 0xdf, 0xd5, 0x32, 0x1f, 0x9a, 0x0b, 0x15, 0x2f, 0xd4, 0x0f, 0xb9,
+	0x67, 0xca, 0x3f, 0xf2, 0xf6, 0xef, 0x01, 0x00, 0xf2, 0xc3, 0xfd, 0xf5, 0xed, 0x07, 0x00, 0x00,
 }
 
 func (this *Balance) Equal(that interface{}) bool {
@@ -564,6 +796,57 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Vestings) > 0 {
+		for iNdEx := len(m.Vestings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vestings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Allowances) > 0 {
+		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Frozen) > 0 {
+		for iNdEx := len(m.Frozen) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Frozen[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Paused) > 0 {
+		for iNdEx := len(m.Paused) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Paused[iNdEx])
+			copy(dAtA[i:], m.Paused[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Paused[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.Burns) > 0 {
 		for iNdEx := len(m.Burns) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -857,7 +1140,7 @@ func (m *ContractAuthorizations) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *ContractGrants) Marshal() (dAtA []byte, err error) {
+func (m *ContractFrozenAccounts) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -867,20 +1150,59 @@ func (m *ContractGrants) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContractGrants) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContractFrozenAccounts) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContractGrants) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContractFrozenAccounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Grants) > 0 {
-		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Accounts[iNdEx])
+			copy(dAtA[i:], m.Accounts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Accounts[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractVestings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractVestings) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractVestings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Vestings) > 0 {
+		for iNdEx := len(m.Vestings) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Vestings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -901,7 +1223,7 @@ func (m *ContractGrants) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ContractCoin) Marshal() (dAtA []byte, err error) {
+func (m *ContractAllowances) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -911,26 +1233,30 @@ func (m *ContractCoin) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContractCoin) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContractAllowances) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContractCoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContractAllowances) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.Allowances) > 0 {
+		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
 	if len(m.ContractId) > 0 {
 		i -= len(m.ContractId)
 		copy(dAtA[i:], m.ContractId)
@@ -941,26 +1267,110 @@ func (m *ContractCoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ContractGrants) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ContractGrants) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractGrants) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if m.ClassState != nil {
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCoin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCoin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.ClassState != nil {
 		l = m.ClassState.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
@@ -1006,6 +1416,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Paused) > 0 {
+		for _, s := range m.Paused {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Frozen) > 0 {
+		for _, e := range m.Frozen {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Allowances) > 0 {
+		for _, e := range m.Allowances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Vestings) > 0 {
+		for _, e := range m.Vestings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1079,6 +1513,63 @@ func (m *ContractAuthorizations) Size() (n int) {
 	return n
 }
 
+func (m *ContractFrozenAccounts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Accounts) > 0 {
+		for _, s := range m.Accounts {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractVestings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Vestings) > 0 {
+		for _, e := range m.Vestings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractAllowances) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Allowances) > 0 {
+		for _, e := range m.Allowances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ContractGrants) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1265,31 +1756,419 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Classes = append(m.Classes, Contract{})
+			if err := m.Classes[len(m.Classes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, ContractGrants{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorizations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authorizations = append(m.Authorizations, ContractAuthorizations{})
+			if err := m.Authorizations[len(m.Authorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supplies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Supplies = append(m.Supplies, ContractCoin{})
+			if err := m.Supplies[len(m.Supplies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mints = append(m.Mints, ContractCoin{})
+			if err := m.Mints[len(m.Mints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burns = append(m.Burns, ContractCoin{})
+			if err := m.Burns[len(m.Burns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Paused = append(m.Paused, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Frozen = append(m.Frozen, ContractFrozenAccounts{})
+			if err := m.Frozen[len(m.Frozen)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowances = append(m.Allowances, ContractAllowances{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vestings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vestings = append(m.Vestings, ContractVestings{})
+			if err := m.Vestings[len(m.Vestings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClassGenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClassGenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClassGenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Classes = append(m.Classes, Contract{})
-			if err := m.Classes[len(m.Classes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Nonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1299,31 +2178,79 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grants = append(m.Grants, ContractGrants{})
-			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 6:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractBalances) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractBalances: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractBalances: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authorizations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1333,29 +2260,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authorizations = append(m.Authorizations, ContractAuthorizations{})
-			if err := m.Authorizations[len(m.Authorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supplies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1382,16 +2307,66 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Supplies = append(m.Supplies, ContractCoin{})
-			if err := m.Supplies[len(m.Supplies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Balances = append(m.Balances, Balance{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Balance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Balance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Balance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mints", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1401,31 +2376,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mints = append(m.Mints, ContractCoin{})
-			if err := m.Mints[len(m.Mints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Burns", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1435,23 +2408,23 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Burns = append(m.Burns, ContractCoin{})
-			if err := m.Burns[len(m.Burns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1476,7 +2449,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClassGenesisState) Unmarshal(dAtA []byte) error {
+func (m *ContractAuthorizations) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1499,15 +2472,15 @@ func (m *ClassGenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClassGenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractAuthorizations: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClassGenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractAuthorizations: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1535,15 +2508,13 @@ func (m *ClassGenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Nonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorizations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1553,23 +2524,25 @@ func (m *ClassGenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
+			m.Authorizations = append(m.Authorizations, Authorization{})
+			if err := m.Authorizations[len(m.Authorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1592,7 +2565,7 @@ func (m *ClassGenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractBalances) Unmarshal(dAtA []byte) error {
+func (m *ContractFrozenAccounts) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1615,10 +2588,10 @@ func (m *ContractBalances) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractBalances: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractFrozenAccounts: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractBalances: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractFrozenAccounts: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1655,9 +2628,9 @@ func (m *ContractBalances) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1667,25 +2640,23 @@ func (m *ContractBalances) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balances = append(m.Balances, Balance{})
-			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1708,7 +2679,7 @@ func (m *ContractBalances) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Balance) Unmarshal(dAtA []byte) error {
+func (m *ContractVestings) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1731,15 +2702,15 @@ func (m *Balance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Balance: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractVestings: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Balance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractVestings: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1767,13 +2738,13 @@ func (m *Balance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.ContractId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vestings", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1783,23 +2754,23 @@ func (m *Balance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Vestings = append(m.Vestings, Vesting{})
+			if err := m.Vestings[len(m.Vestings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1824,7 +2795,7 @@ func (m *Balance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractAuthorizations) Unmarshal(dAtA []byte) error {
+func (m *ContractAllowances) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1847,10 +2818,10 @@ func (m *ContractAuthorizations) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractAuthorizations: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractAllowances: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractAuthorizations: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractAllowances: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1887,7 +2858,7 @@ func (m *ContractAuthorizations) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authorizations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1914,8 +2885,8 @@ func (m *ContractAuthorizations) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authorizations = append(m.Authorizations, Authorization{})
-			if err := m.Authorizations[len(m.Authorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Allowances = append(m.Allowances, Allowance{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
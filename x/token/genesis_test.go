@@ -13,6 +13,10 @@ import (
 
 func TestValidateGenesis(t *testing.T) {
 	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	schedule := token.VestingSchedule{
+		StartTime: 100,
+		EndTime:   200,
+	}
 	testCases := map[string]struct {
 		gs    *token.GenesisState
 		valid bool
@@ -21,6 +25,21 @@ func TestValidateGenesis(t *testing.T) {
 			token.DefaultGenesisState(),
 			true,
 		},
+		"empty params": {
+			&token.GenesisState{
+				ClassState: token.DefaultClassGenesisState(),
+			},
+			true,
+		},
+		"invalid max vestings per holder": {
+			&token.GenesisState{
+				Params: token.Params{
+					MaxMultiSendOutputs: token.DefaultMaxMultiSendOutputs,
+				},
+				ClassState: token.DefaultClassGenesisState(),
+			},
+			false,
+		},
 		"invalid class nonce": {
 			&token.GenesisState{
 				ClassState: &token.ClassGenesisState{
@@ -229,6 +248,222 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			false,
 		},
+		"paused contract": {
+			&token.GenesisState{
+				Paused: []string{"deadbeef"},
+			},
+			true,
+		},
+		"invalid paused contract id": {
+			&token.GenesisState{
+				Paused: []string{""},
+			},
+			false,
+		},
+		"duplicate paused contract": {
+			&token.GenesisState{
+				Paused: []string{"deadbeef", "deadbeef"},
+			},
+			false,
+		},
+		"frozen accounts": {
+			&token.GenesisState{
+				Frozen: []token.ContractFrozenAccounts{{
+					ContractId: "deadbeef",
+					Accounts:   []string{addr.String()},
+				}},
+			},
+			true,
+		},
+		"invalid contract id of frozen accounts": {
+			&token.GenesisState{
+				Frozen: []token.ContractFrozenAccounts{{
+					Accounts: []string{addr.String()},
+				}},
+			},
+			false,
+		},
+		"duplicate contract of frozen accounts": {
+			&token.GenesisState{
+				Frozen: []token.ContractFrozenAccounts{
+					{
+						ContractId: "deadbeef",
+						Accounts:   []string{addr.String()},
+					},
+					{
+						ContractId: "deadbeef",
+						Accounts:   []string{addr.String()},
+					},
+				},
+			},
+			false,
+		},
+		"empty frozen accounts": {
+			&token.GenesisState{
+				Frozen: []token.ContractFrozenAccounts{{
+					ContractId: "deadbeef",
+				}},
+			},
+			false,
+		},
+		"invalid frozen account": {
+			&token.GenesisState{
+				Frozen: []token.ContractFrozenAccounts{{
+					ContractId: "deadbeef",
+					Accounts:   []string{""},
+				}},
+			},
+			false,
+		},
+		"duplicate frozen account": {
+			&token.GenesisState{
+				Frozen: []token.ContractFrozenAccounts{{
+					ContractId: "deadbeef",
+					Accounts:   []string{addr.String(), addr.String()},
+				}},
+			},
+			false,
+		},
+		"allowances": {
+			&token.GenesisState{
+				Allowances: []token.ContractAllowances{{
+					ContractId: "deadbeef",
+					Allowances: []token.Allowance{{
+						Owner:   addr.String(),
+						Spender: addr.String(),
+						Amount:  sdk.OneInt(),
+					}},
+				}},
+			},
+			true,
+		},
+		"invalid contract id of allowances": {
+			&token.GenesisState{
+				Allowances: []token.ContractAllowances{{
+					Allowances: []token.Allowance{{
+						Owner:   addr.String(),
+						Spender: addr.String(),
+						Amount:  sdk.OneInt(),
+					}},
+				}},
+			},
+			false,
+		},
+		"empty allowances": {
+			&token.GenesisState{
+				Allowances: []token.ContractAllowances{{
+					ContractId: "deadbeef",
+				}},
+			},
+			false,
+		},
+		"invalid owner of allowance": {
+			&token.GenesisState{
+				Allowances: []token.ContractAllowances{{
+					ContractId: "deadbeef",
+					Allowances: []token.Allowance{{
+						Spender: addr.String(),
+						Amount:  sdk.OneInt(),
+					}},
+				}},
+			},
+			false,
+		},
+		"invalid spender of allowance": {
+			&token.GenesisState{
+				Allowances: []token.ContractAllowances{{
+					ContractId: "deadbeef",
+					Allowances: []token.Allowance{{
+						Owner:  addr.String(),
+						Amount: sdk.OneInt(),
+					}},
+				}},
+			},
+			false,
+		},
+		"invalid amount of allowance": {
+			&token.GenesisState{
+				Allowances: []token.ContractAllowances{{
+					ContractId: "deadbeef",
+					Allowances: []token.Allowance{{
+						Owner:   addr.String(),
+						Spender: addr.String(),
+						Amount:  sdk.ZeroInt(),
+					}},
+				}},
+			},
+			false,
+		},
+		"vestings": {
+			&token.GenesisState{
+				Vestings: []token.ContractVestings{{
+					ContractId: "deadbeef",
+					Vestings: []token.Vesting{{
+						Holder:         addr.String(),
+						OriginalAmount: sdk.OneInt(),
+						Schedule:       schedule,
+					}},
+				}},
+			},
+			true,
+		},
+		"invalid contract id of vestings": {
+			&token.GenesisState{
+				Vestings: []token.ContractVestings{{
+					Vestings: []token.Vesting{{
+						Holder:         addr.String(),
+						OriginalAmount: sdk.OneInt(),
+						Schedule:       schedule,
+					}},
+				}},
+			},
+			false,
+		},
+		"empty vestings": {
+			&token.GenesisState{
+				Vestings: []token.ContractVestings{{
+					ContractId: "deadbeef",
+				}},
+			},
+			false,
+		},
+		"invalid holder of vesting": {
+			&token.GenesisState{
+				Vestings: []token.ContractVestings{{
+					ContractId: "deadbeef",
+					Vestings: []token.Vesting{{
+						OriginalAmount: sdk.OneInt(),
+						Schedule:       schedule,
+					}},
+				}},
+			},
+			false,
+		},
+		"invalid amount of vesting": {
+			&token.GenesisState{
+				Vestings: []token.ContractVestings{{
+					ContractId: "deadbeef",
+					Vestings: []token.Vesting{{
+						Holder:         addr.String(),
+						OriginalAmount: sdk.ZeroInt(),
+						Schedule:       schedule,
+					}},
+				}},
+			},
+			false,
+		},
+		"invalid schedule of vesting": {
+			&token.GenesisState{
+				Vestings: []token.ContractVestings{{
+					ContractId: "deadbeef",
+					Vestings: []token.Vesting{{
+						Holder:         addr.String(),
+						OriginalAmount: sdk.OneInt(),
+					}},
+				}},
+			},
+			false,
+		},
 	}
 
 	for name, tc := range testCases {
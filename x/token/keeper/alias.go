@@ -41,7 +41,7 @@ func (k Keeper) iterateBalancesImpl(ctx sdk.Context, prefix []byte, fn func(cont
 func (k Keeper) iterateClasses(ctx sdk.Context, fn func(class token.Contract) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
 
-	iterator := sdk.KVStorePrefixIterator(store, classKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, ClassKeyPrefix)
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
@@ -129,13 +129,97 @@ func (k Keeper) iterateStatistics(ctx sdk.Context, prefix []byte, fn func(contra
 }
 
 func (k Keeper) iterateSupplies(ctx sdk.Context, fn func(contractID string, amount sdk.Int) (stop bool)) {
-	k.iterateStatistics(ctx, supplyKeyPrefix, fn)
+	k.iterateStatistics(ctx, SupplyKeyPrefix, fn)
 }
 
 func (k Keeper) iterateMinteds(ctx sdk.Context, fn func(contractID string, amount sdk.Int) (stop bool)) {
-	k.iterateStatistics(ctx, mintKeyPrefix, fn)
+	k.iterateStatistics(ctx, MintKeyPrefix, fn)
 }
 
 func (k Keeper) iterateBurnts(ctx sdk.Context, fn func(contractID string, amount sdk.Int) (stop bool)) {
-	k.iterateStatistics(ctx, burnKeyPrefix, fn)
+	k.iterateStatistics(ctx, BurnKeyPrefix, fn)
+}
+
+// iterate through the paused contracts and perform the provided function
+func (k Keeper) iteratePaused(ctx sdk.Context, fn func(contractID string) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, PausedKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		contractID := splitPausedKey(iterator.Key())
+
+		stop := fn(contractID)
+		if stop {
+			break
+		}
+	}
+}
+
+func (k Keeper) iterateContractFrozenAccounts(ctx sdk.Context, contractID string, fn func(account sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, frozenKeyPrefixByContractID(contractID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		_, account := splitFrozenKey(iterator.Key())
+
+		stop := fn(account)
+		if stop {
+			break
+		}
+	}
+}
+
+func (k Keeper) iterateContractAllowances(ctx sdk.Context, contractID string, fn func(allowance token.Allowance) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, allowanceKeyPrefixByContractID(contractID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		_, owner, spender := splitAllowanceKey(iterator.Key())
+
+		var amount sdk.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		allowance := token.Allowance{
+			Owner:   owner.String(),
+			Spender: spender.String(),
+			Amount:  amount,
+		}
+
+		stop := fn(allowance)
+		if stop {
+			break
+		}
+	}
+}
+
+func (k Keeper) iterateHolderVestings(ctx sdk.Context, contractID string, holder sdk.AccAddress, fn func(vesting token.Vesting) (stop bool)) {
+	k.iterateVestingsImpl(ctx, vestingKeyPrefixByHolder(contractID, holder), fn)
+}
+
+func (k Keeper) iterateContractVestings(ctx sdk.Context, contractID string, fn func(vesting token.Vesting) (stop bool)) {
+	k.iterateVestingsImpl(ctx, vestingKeyPrefixByContractID(contractID), fn)
+}
+
+func (k Keeper) iterateVestingsImpl(ctx sdk.Context, prefix []byte, fn func(vesting token.Vesting) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var vesting token.Vesting
+		k.cdc.MustUnmarshal(iterator.Value(), &vesting)
+
+		stop := fn(vesting)
+		if stop {
+			break
+		}
+	}
 }
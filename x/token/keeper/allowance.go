@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/token"
+)
+
+// Approve sets the allowance of the spender on the tokens of the owner.
+// It overwrites the existing allowance, and zero amount removes it.
+func (k Keeper) Approve(ctx sdk.Context, contractID string, owner, spender sdk.AccAddress, amount sdk.Int) {
+	k.setAllowance(ctx, contractID, owner, spender, amount)
+
+	event := token.EventApproved{
+		ContractId: contractID,
+		Owner:      owner.String(),
+		Spender:    spender.String(),
+		Amount:     amount,
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+}
+
+// TransferFrom sends the tokens of the owner by the spender, decreasing the allowance.
+// It does not consider the operators authorized by MsgAuthorizeOperator.
+func (k Keeper) TransferFrom(ctx sdk.Context, contractID string, spender, from, to sdk.AccAddress, amount sdk.Int) error {
+	allowance := k.GetAllowance(ctx, contractID, from, spender)
+	if allowance.LT(amount) {
+		return token.ErrInsufficientAllowance.Wrapf("%s is smaller than %s", allowance, amount)
+	}
+
+	if err := k.Send(ctx, contractID, from, to, amount); err != nil {
+		return err
+	}
+	k.setAllowance(ctx, contractID, from, spender, allowance.Sub(amount))
+
+	return nil
+}
+
+func (k Keeper) GetAllowance(ctx sdk.Context, contractID string, owner, spender sdk.AccAddress) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	amount := sdk.ZeroInt()
+	bz := store.Get(allowanceKey(contractID, owner, spender))
+	if bz != nil {
+		if err := amount.Unmarshal(bz); err != nil {
+			panic(err)
+		}
+	}
+	return amount
+}
+
+// setAllowance sets the allowance.
+// The caller must validate `amount`.
+func (k Keeper) setAllowance(ctx sdk.Context, contractID string, owner, spender sdk.AccAddress, amount sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	key := allowanceKey(contractID, owner, spender)
+	if amount.IsZero() {
+		store.Delete(key)
+	} else {
+		bz, err := amount.Marshal()
+		if err != nil {
+			panic(err)
+		}
+		store.Set(key, bz)
+	}
+}
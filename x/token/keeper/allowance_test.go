@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/token"
+)
+
+func (s *KeeperTestSuite) TestApprove() {
+	testCases := map[string]struct {
+		amount sdk.Int
+	}{
+		"set an allowance": {
+			amount: s.balance,
+		},
+		"remove the allowance": {
+			amount: sdk.ZeroInt(),
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			// an existing allowance is overwritten
+			s.keeper.Approve(ctx, s.contractID, s.customer, s.stranger, sdk.OneInt())
+			s.keeper.Approve(ctx, s.contractID, s.customer, s.stranger, tc.amount)
+
+			allowance := s.keeper.GetAllowance(ctx, s.contractID, s.customer, s.stranger)
+			s.Require().Equal(tc.amount, allowance)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestTransferFrom() {
+	testCases := map[string]struct {
+		spender   sdk.AccAddress
+		allowance sdk.Int
+		amount    sdk.Int
+		err       error
+	}{
+		"valid request": {
+			spender:   s.stranger,
+			allowance: s.balance,
+			amount:    sdk.OneInt(),
+		},
+		"whole allowance": {
+			spender:   s.stranger,
+			allowance: s.balance,
+			amount:    s.balance,
+		},
+		"insufficient allowance": {
+			spender:   s.stranger,
+			allowance: sdk.OneInt(),
+			amount:    sdk.NewInt(2),
+			err:       token.ErrInsufficientAllowance,
+		},
+		"insufficient balance": {
+			spender:   s.stranger,
+			allowance: s.balance.Add(sdk.OneInt()),
+			amount:    s.balance.Add(sdk.OneInt()),
+			err:       token.ErrInsufficientBalance,
+		},
+		"operator without allowance": {
+			spender:   s.operator,
+			allowance: sdk.ZeroInt(),
+			amount:    sdk.OneInt(),
+			err:       token.ErrInsufficientAllowance,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			s.keeper.Approve(ctx, s.contractID, s.customer, tc.spender, tc.allowance)
+
+			err := s.keeper.TransferFrom(ctx, s.contractID, tc.spender, s.customer, s.vendor, tc.amount)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				// the allowance must not change on failure
+				s.Require().Equal(tc.allowance, s.keeper.GetAllowance(ctx, s.contractID, s.customer, tc.spender))
+				return
+			}
+
+			allowance := s.keeper.GetAllowance(ctx, s.contractID, s.customer, tc.spender)
+			s.Require().True(tc.allowance.Sub(tc.amount).Equal(allowance), allowance)
+			s.Require().True(s.balance.Sub(tc.amount).Equal(s.keeper.GetBalance(ctx, s.contractID, s.customer)))
+			s.Require().True(s.balance.Add(tc.amount).Equal(s.keeper.GetBalance(ctx, s.contractID, s.vendor)))
+		})
+	}
+}
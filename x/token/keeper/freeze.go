@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/token"
+)
+
+func (k Keeper) Freeze(ctx sdk.Context, contractID string, grantee, account sdk.AccAddress) error {
+	if _, err := k.GetGrant(ctx, contractID, grantee, token.PermissionFreeze); err != nil {
+		return token.ErrTokenNoPermission.Wrap(err.Error())
+	}
+
+	if k.IsFrozen(ctx, contractID, account) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("%s is already frozen on %s", account, contractID)
+	}
+	k.setFrozen(ctx, contractID, account, true)
+
+	event := token.EventFrozen{
+		ContractId: contractID,
+		Operator:   grantee.String(),
+		Account:    account.String(),
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+	return nil
+}
+
+func (k Keeper) Unfreeze(ctx sdk.Context, contractID string, grantee, account sdk.AccAddress) error {
+	if _, err := k.GetGrant(ctx, contractID, grantee, token.PermissionFreeze); err != nil {
+		return token.ErrTokenNoPermission.Wrap(err.Error())
+	}
+
+	if !k.IsFrozen(ctx, contractID, account) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("%s is not frozen on %s", account, contractID)
+	}
+	k.setFrozen(ctx, contractID, account, false)
+
+	event := token.EventUnfrozen{
+		ContractId: contractID,
+		Operator:   grantee.String(),
+		Account:    account.String(),
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+	return nil
+}
+
+func (k Keeper) IsFrozen(ctx sdk.Context, contractID string, account sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(frozenKey(contractID, account))
+}
+
+func (k Keeper) setFrozen(ctx sdk.Context, contractID string, account sdk.AccAddress, frozen bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := frozenKey(contractID, account)
+	if frozen {
+		store.Set(key, []byte{})
+	} else {
+		store.Delete(key)
+	}
+}
+
+// validateNotFrozen returns an error if the account is frozen on the contract.
+// It must be called on all the accounts whose balances would change.
+func (k Keeper) validateNotFrozen(ctx sdk.Context, contractID string, account sdk.AccAddress) error {
+	if k.IsFrozen(ctx, contractID, account) {
+		return token.ErrAccountFrozen.Wrapf("%s on %s", account, contractID)
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package keeper_test
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/token"
+)
+
+func (s *KeeperTestSuite) TestFreeze() {
+	testCases := map[string]struct {
+		grantee sdk.AccAddress
+		frozen  bool
+		err     error
+	}{
+		"valid request": {
+			grantee: s.vendor,
+		},
+		"no permission": {
+			grantee: s.operator,
+			err:     token.ErrTokenNoPermission,
+		},
+		"already frozen": {
+			grantee: s.vendor,
+			frozen:  true,
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			if tc.frozen {
+				err := s.keeper.Freeze(ctx, s.contractID, s.vendor, s.customer)
+				s.Require().NoError(err)
+			}
+
+			err := s.keeper.Freeze(ctx, s.contractID, tc.grantee, s.customer)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().True(s.keeper.IsFrozen(ctx, s.contractID, s.customer))
+			s.Require().False(s.keeper.IsFrozen(ctx, s.unmintableContractId, s.customer))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestUnfreeze() {
+	testCases := map[string]struct {
+		grantee sdk.AccAddress
+		frozen  bool
+		err     error
+	}{
+		"valid request": {
+			grantee: s.vendor,
+			frozen:  true,
+		},
+		"no permission": {
+			grantee: s.operator,
+			frozen:  true,
+			err:     token.ErrTokenNoPermission,
+		},
+		"not frozen": {
+			grantee: s.vendor,
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			if tc.frozen {
+				err := s.keeper.Freeze(ctx, s.contractID, s.vendor, s.customer)
+				s.Require().NoError(err)
+			}
+
+			err := s.keeper.Unfreeze(ctx, s.contractID, tc.grantee, s.customer)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().False(s.keeper.IsFrozen(ctx, s.contractID, s.customer))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestFrozenAccount() {
+	testCases := map[string]struct {
+		frozen    sdk.AccAddress
+		operation func(ctx sdk.Context) error
+	}{
+		"send from frozen": {
+			frozen: s.customer,
+			operation: func(ctx sdk.Context) error {
+				return s.keeper.Send(ctx, s.contractID, s.customer, s.vendor, sdk.OneInt())
+			},
+		},
+		"send to frozen": {
+			frozen: s.customer,
+			operation: func(ctx sdk.Context) error {
+				return s.keeper.Send(ctx, s.contractID, s.vendor, s.customer, sdk.OneInt())
+			},
+		},
+		"operator send from frozen": {
+			frozen: s.customer,
+			operation: func(ctx sdk.Context) error {
+				_, err := s.msgServer.OperatorSend(sdk.WrapSDKContext(ctx), &token.MsgOperatorSend{
+					ContractId: s.contractID,
+					Operator:   s.operator.String(),
+					From:       s.customer.String(),
+					To:         s.vendor.String(),
+					Amount:     sdk.OneInt(),
+				})
+				return err
+			},
+		},
+		"mint to frozen": {
+			frozen: s.customer,
+			operation: func(ctx sdk.Context) error {
+				return s.keeper.Mint(ctx, s.contractID, s.vendor, s.customer, sdk.OneInt())
+			},
+		},
+		"burn of frozen": {
+			frozen: s.vendor,
+			operation: func(ctx sdk.Context) error {
+				return s.keeper.Burn(ctx, s.contractID, s.vendor, sdk.OneInt())
+			},
+		},
+		"operator burn of frozen": {
+			frozen: s.customer,
+			operation: func(ctx sdk.Context) error {
+				return s.keeper.OperatorBurn(ctx, s.contractID, s.operator, s.customer, sdk.OneInt())
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			err := s.keeper.Freeze(ctx, s.contractID, s.vendor, tc.frozen)
+			s.Require().NoError(err)
+			s.Require().ErrorIs(tc.operation(ctx), token.ErrAccountFrozen)
+
+			// the freeze is scoped to the contract
+			err = s.keeper.Send(ctx, s.unmintableContractId, s.vendor, s.customer, sdk.OneInt())
+			s.Require().NoError(err)
+
+			err = s.keeper.Unfreeze(ctx, s.contractID, s.vendor, tc.frozen)
+			s.Require().NoError(err)
+			s.Require().NoError(tc.operation(ctx))
+		})
+	}
+}
@@ -7,6 +7,12 @@ import (
 
 // InitGenesis new token genesis
 func (k Keeper) InitGenesis(ctx sdk.Context, data *token.GenesisState) {
+	params := data.Params
+	if params == (token.Params{}) {
+		params = token.DefaultParams()
+	}
+	k.SetParams(ctx, params)
+
 	if data.ClassState == nil {
 		data.ClassState = token.DefaultClassGenesisState()
 	}
@@ -62,6 +68,46 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *token.GenesisState) {
 	for _, amount := range data.Burns {
 		k.setBurnt(ctx, amount.ContractId, amount.Amount)
 	}
+
+	for _, contractID := range data.Paused {
+		k.setPaused(ctx, contractID, true)
+	}
+
+	for _, contractFrozen := range data.Frozen {
+		for _, account := range contractFrozen.Accounts {
+			addr, err := sdk.AccAddressFromBech32(account)
+			if err != nil {
+				panic(err)
+			}
+			k.setFrozen(ctx, contractFrozen.ContractId, addr, true)
+		}
+	}
+
+	for _, contractAllowances := range data.Allowances {
+		for _, allowance := range contractAllowances.Allowances {
+			owner, err := sdk.AccAddressFromBech32(allowance.Owner)
+			if err != nil {
+				panic(err)
+			}
+			spender, err := sdk.AccAddressFromBech32(allowance.Spender)
+			if err != nil {
+				panic(err)
+			}
+			k.setAllowance(ctx, contractAllowances.ContractId, owner, spender, allowance.Amount)
+		}
+	}
+
+	for _, contractVestings := range data.Vestings {
+		seqs := map[string]uint64{}
+		for _, vesting := range contractVestings.Vestings {
+			holder, err := sdk.AccAddressFromBech32(vesting.Holder)
+			if err != nil {
+				panic(err)
+			}
+			k.setVesting(ctx, contractVestings.ContractId, holder, seqs[vesting.Holder], vesting)
+			seqs[vesting.Holder]++
+		}
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context.
@@ -150,7 +196,61 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *token.GenesisState {
 		}
 	}
 
+	var paused []string
+	k.iteratePaused(ctx, func(contractID string) (stop bool) {
+		paused = append(paused, contractID)
+		return false
+	})
+
+	var frozen []token.ContractFrozenAccounts
+	for _, class := range classes {
+		id := class.Id
+		contractFrozen := token.ContractFrozenAccounts{
+			ContractId: id,
+		}
+
+		k.iterateContractFrozenAccounts(ctx, id, func(account sdk.AccAddress) (stop bool) {
+			contractFrozen.Accounts = append(contractFrozen.Accounts, account.String())
+			return false
+		})
+		if len(contractFrozen.Accounts) != 0 {
+			frozen = append(frozen, contractFrozen)
+		}
+	}
+
+	var allowances []token.ContractAllowances
+	for _, class := range classes {
+		id := class.Id
+		contractAllowances := token.ContractAllowances{
+			ContractId: id,
+		}
+
+		k.iterateContractAllowances(ctx, id, func(allowance token.Allowance) (stop bool) {
+			contractAllowances.Allowances = append(contractAllowances.Allowances, allowance)
+			return false
+		})
+		if len(contractAllowances.Allowances) != 0 {
+			allowances = append(allowances, contractAllowances)
+		}
+	}
+
+	var vestings []token.ContractVestings
+	for _, class := range classes {
+		id := class.Id
+		contractVestings := token.ContractVestings{
+			ContractId: id,
+		}
+		k.iterateContractVestings(ctx, id, func(vesting token.Vesting) (stop bool) {
+			contractVestings.Vestings = append(contractVestings.Vestings, vesting)
+			return false
+		})
+		if len(contractVestings.Vestings) != 0 {
+			vestings = append(vestings, contractVestings)
+		}
+	}
+
 	return &token.GenesisState{
+		Params:         k.GetParams(ctx),
 		ClassState:     k.classKeeper.ExportGenesis(ctx),
 		Balances:       balances,
 		Classes:        classes,
@@ -159,5 +259,9 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *token.GenesisState {
 		Supplies:       supplies,
 		Mints:          mints,
 		Burns:          burns,
+		Paused:         paused,
+		Frozen:         frozen,
+		Allowances:     allowances,
+		Vestings:       vestings,
 	}
 }
@@ -1,19 +1,48 @@
 package keeper_test
 
 import (
+	sdk "github.com/Finschia/finschia-sdk/types"
 	"github.com/Finschia/finschia-sdk/x/token"
 )
 
 func (s *KeeperTestSuite) TestImportExportGenesis() {
+	// pause a contract
+	err := s.keeper.Pause(s.ctx, s.unmintableContractId, s.vendor)
+	s.Require().NoError(err)
+
+	// freeze an account
+	err = s.keeper.Freeze(s.ctx, s.contractID, s.vendor, s.stranger)
+	s.Require().NoError(err)
+
+	// approve an allowance
+	s.keeper.Approve(s.ctx, s.contractID, s.customer, s.stranger, s.balance)
+
+	// lock tokens by a vesting
+	schedule := token.VestingSchedule{
+		StartTime: 1000,
+		EndTime:   2000,
+	}
+	err = s.keeper.SendVested(s.ctx, s.contractID, s.vendor, s.operator, sdk.OneInt(), schedule)
+	s.Require().NoError(err)
+
+	// change the params
+	s.keeper.SetParams(s.ctx, token.Params{MaxMultiSendOutputs: 42, MaxVestingsPerHolder: 7})
+
 	// export
 	genesis := s.keeper.ExportGenesis(s.ctx)
 
 	// forge
-	err := s.keeper.Burn(s.ctx, s.contractID, s.vendor, s.balance)
+	err = s.keeper.Burn(s.ctx, s.contractID, s.vendor, sdk.OneInt())
 	s.Require().NoError(err)
 	err = s.keeper.Mint(s.ctx, s.contractID, s.vendor, s.customer, s.balance)
 	s.Require().NoError(err)
 	s.keeper.Abandon(s.ctx, s.contractID, s.vendor, token.PermissionMint)
+	err = s.keeper.Unpause(s.ctx, s.unmintableContractId, s.vendor)
+	s.Require().NoError(err)
+	err = s.keeper.Unfreeze(s.ctx, s.contractID, s.vendor, s.stranger)
+	s.Require().NoError(err)
+	s.keeper.Approve(s.ctx, s.contractID, s.customer, s.stranger, sdk.ZeroInt())
+	s.keeper.SetParams(s.ctx, token.DefaultParams())
 
 	// restore
 	s.keeper.InitGenesis(s.ctx, genesis)
@@ -21,7 +50,23 @@ func (s *KeeperTestSuite) TestImportExportGenesis() {
 	// export again and compare
 	newGenesis := s.keeper.ExportGenesis(s.ctx)
 	s.Require().Equal(genesis, newGenesis)
+	s.Require().Equal([]string{s.unmintableContractId}, newGenesis.Paused)
+	s.Require().Equal([]token.ContractFrozenAccounts{{
+		ContractId: s.contractID,
+		Accounts:   []string{s.stranger.String()},
+	}}, newGenesis.Frozen)
+	s.Require().Len(newGenesis.Allowances, 1)
+	s.Require().Equal([]token.ContractVestings{{
+		ContractId: s.contractID,
+		Vestings: []token.Vesting{{
+			Holder:         s.operator.String(),
+			OriginalAmount: sdk.OneInt(),
+			Schedule:       schedule,
+		}},
+	}}, newGenesis.Vestings)
+	s.Require().Equal(token.Params{MaxMultiSendOutputs: 42, MaxVestingsPerHolder: 7}, newGenesis.Params)
 
-	// nil class state
+	// nil class state and empty params
 	s.keeper.InitGenesis(s.ctx, &token.GenesisState{})
+	s.Require().Equal(token.DefaultParams(), s.keeper.GetParams(s.ctx))
 }
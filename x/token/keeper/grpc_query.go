@@ -52,8 +52,13 @@ func (s queryServer) Balance(c context.Context, req *token.QueryBalanceRequest)
 
 	ctx := sdk.UnwrapSDKContext(c)
 	balance := s.keeper.GetBalance(ctx, req.ContractId, addr)
+	spendable := s.keeper.GetSpendable(ctx, req.ContractId, addr)
 
-	return &token.QueryBalanceResponse{Amount: balance}, nil
+	return &token.QueryBalanceResponse{
+		Amount:    balance,
+		Spendable: spendable,
+		Locked:    balance.Sub(spendable),
+	}, nil
 }
 
 // Supply queries the number of tokens from the given contract id.
@@ -207,3 +212,251 @@ func (s queryServer) HoldersByOperator(c context.Context, req *token.QueryHolder
 
 	return &token.QueryHoldersByOperatorResponse{Holders: holders, Pagination: pageRes}, nil
 }
+
+// Paused queries whether a contract is paused or not.
+func (s queryServer) Paused(c context.Context, req *token.QueryPausedRequest) (*token.QueryPausedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := token.ValidateContractID(req.ContractId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	paused := s.keeper.IsPaused(ctx, req.ContractId)
+
+	return &token.QueryPausedResponse{Paused: paused}, nil
+}
+
+// FrozenAccounts queries the frozen accounts of a contract.
+func (s queryServer) FrozenAccounts(c context.Context, req *token.QueryFrozenAccountsRequest) (*token.QueryFrozenAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := token.ValidateContractID(req.ContractId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	frozenStore := prefix.NewStore(store, frozenKeyPrefixByContractID(req.ContractId))
+	var accounts []string
+	pageRes, err := query.Paginate(frozenStore, req.Pagination, func(key, _ []byte) error {
+		account := sdk.AccAddress(key)
+		accounts = append(accounts, account.String())
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &token.QueryFrozenAccountsResponse{Accounts: accounts, Pagination: pageRes}, nil
+}
+
+// MaxSupply queries the hard cap on the supply of a contract.
+func (s queryServer) MaxSupply(c context.Context, req *token.QueryMaxSupplyRequest) (*token.QueryMaxSupplyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := token.ValidateContractID(req.ContractId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	maxSupply, err := s.keeper.GetMaxSupply(ctx, req.ContractId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &token.QueryMaxSupplyResponse{Amount: maxSupply}, nil
+}
+
+// Allowance queries the amount of tokens the spender is allowed to transfer on behalf of the owner.
+func (s queryServer) Allowance(c context.Context, req *token.QueryAllowanceRequest) (*token.QueryAllowanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := token.ValidateContractID(req.ContractId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	owner, err := s.addressFromBech32GRPC(req.Owner, "owner")
+	if err != nil {
+		return nil, err
+	}
+	spender, err := s.addressFromBech32GRPC(req.Spender, "spender")
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	amount := s.keeper.GetAllowance(ctx, req.ContractId, owner, spender)
+
+	return &token.QueryAllowanceResponse{Amount: amount}, nil
+}
+
+// AllowancesByOwner queries all the allowances given by the owner.
+func (s queryServer) AllowancesByOwner(c context.Context, req *token.QueryAllowancesByOwnerRequest) (*token.QueryAllowancesByOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := token.ValidateContractID(req.ContractId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	owner, err := s.addressFromBech32GRPC(req.Owner, "owner")
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	allowanceStore := prefix.NewStore(store, allowanceKeyPrefixByOwner(req.ContractId, owner))
+	var allowances []token.Allowance
+	pageRes, err := query.Paginate(allowanceStore, req.Pagination, func(key, value []byte) error {
+		var amount sdk.Int
+		if err := amount.Unmarshal(value); err != nil {
+			return err
+		}
+
+		spender := sdk.AccAddress(key)
+		allowances = append(allowances, token.Allowance{
+			Owner:   req.Owner,
+			Spender: spender.String(),
+			Amount:  amount,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &token.QueryAllowancesByOwnerResponse{Allowances: allowances, Pagination: pageRes}, nil
+}
+
+// Contracts queries all the contracts.
+func (s queryServer) Contracts(c context.Context, req *token.QueryContractsRequest) (*token.QueryContractsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	classStore := prefix.NewStore(store, ClassKeyPrefix)
+	var contracts []token.Contract
+	pageRes, err := query.Paginate(classStore, req.Pagination, func(_, value []byte) error {
+		var contract token.Contract
+		if err := s.keeper.cdc.Unmarshal(value, &contract); err != nil {
+			return err
+		}
+
+		contracts = append(contracts, contract)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &token.QueryContractsResponse{Contracts: contracts, Pagination: pageRes}, nil
+}
+
+// Holders queries all the holders of a contract with their balances.
+func (s queryServer) Holders(c context.Context, req *token.QueryHoldersRequest) (*token.QueryHoldersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := token.ValidateContractID(req.ContractId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	balanceStore := prefix.NewStore(store, balanceKeyPrefixByContractID(req.ContractId))
+	var balances []token.Balance
+	pageRes, err := query.Paginate(balanceStore, req.Pagination, func(key, value []byte) error {
+		var amount sdk.Int
+		if err := amount.Unmarshal(value); err != nil {
+			return err
+		}
+
+		holder := sdk.AccAddress(key)
+		balances = append(balances, token.Balance{
+			Address: holder.String(),
+			Amount:  amount,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &token.QueryHoldersResponse{Balances: balances, Pagination: pageRes}, nil
+}
+
+// BalancesByAddress queries all the balances of an address across the contracts.
+func (s queryServer) BalancesByAddress(c context.Context, req *token.QueryBalancesByAddressRequest) (*token.QueryBalancesByAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := s.addressFromBech32GRPC(req.Address, "address")
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	indexStore := prefix.NewStore(store, balanceByAddressKeyPrefixByAddress(addr))
+	var balances []token.ContractBalance
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key, _ []byte) error {
+		contractID := string(key)
+		balances = append(balances, token.ContractBalance{
+			ContractId: contractID,
+			Amount:     s.keeper.GetBalance(ctx, contractID, addr),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &token.QueryBalancesByAddressResponse{Balances: balances, Pagination: pageRes}, nil
+}
+
+// Vestings queries all the vestings of an address on a contract.
+func (s queryServer) Vestings(c context.Context, req *token.QueryVestingsRequest) (*token.QueryVestingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := token.ValidateContractID(req.ContractId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	addr, err := s.addressFromBech32GRPC(req.Address, "address")
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	vestingStore := prefix.NewStore(store, vestingKeyPrefixByHolder(req.ContractId, addr))
+	var vestings []token.Vesting
+	pageRes, err := query.Paginate(vestingStore, req.Pagination, func(key, value []byte) error {
+		var vesting token.Vesting
+		if err := s.keeper.cdc.Unmarshal(value, &vesting); err != nil {
+			return err
+		}
+
+		vestings = append(vestings, vesting)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &token.QueryVestingsResponse{Vestings: vestings, Pagination: pageRes}, nil
+}
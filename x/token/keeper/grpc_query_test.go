@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/Finschia/finschia-sdk/types"
 	"github.com/Finschia/finschia-sdk/types/query"
 	"github.com/Finschia/finschia-sdk/x/token"
@@ -23,6 +25,8 @@ func (s *KeeperTestSuite) TestQueryBalance() {
 			valid:      true,
 			postTest: func(res *token.QueryBalanceResponse) {
 				s.Require().Equal(s.balance, res.Amount)
+				s.Require().True(s.balance.Equal(res.Spendable))
+				s.Require().True(res.Locked.IsZero())
 			},
 		},
 		"invalid contract id": {
@@ -240,7 +244,7 @@ func (s *KeeperTestSuite) TestQueryGranteeGrants() {
 			grantee:    s.vendor,
 			valid:      true,
 			postTest: func(res *token.QueryGranteeGrantsResponse) {
-				s.Require().Equal(3, len(res.Grants))
+				s.Require().Equal(5, len(res.Grants))
 			},
 		},
 		"class not found": {
@@ -407,3 +411,498 @@ func (s *KeeperTestSuite) TestQueryHoldersByOperator() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestQueryPaused() {
+	// empty request
+	_, err := s.queryServer.Paused(s.goCtx, nil)
+	s.Require().Error(err)
+
+	ctx, _ := s.ctx.CacheContext()
+	err = s.keeper.Pause(ctx, s.contractID, s.vendor)
+	s.Require().NoError(err)
+
+	testCases := map[string]struct {
+		contractID string
+		valid      bool
+		paused     bool
+	}{
+		"paused contract": {
+			contractID: s.contractID,
+			valid:      true,
+			paused:     true,
+		},
+		"not paused contract": {
+			contractID: s.unmintableContractId,
+			valid:      true,
+		},
+		"invalid contract id": {},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			req := &token.QueryPausedRequest{
+				ContractId: tc.contractID,
+			}
+			res, err := s.queryServer.Paused(sdk.WrapSDKContext(ctx), req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			s.Require().Equal(tc.paused, res.Paused)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryFrozenAccounts() {
+	// empty request
+	_, err := s.queryServer.FrozenAccounts(s.goCtx, nil)
+	s.Require().Error(err)
+
+	ctx, _ := s.ctx.CacheContext()
+	err = s.keeper.Freeze(ctx, s.contractID, s.vendor, s.customer)
+	s.Require().NoError(err)
+
+	testCases := map[string]struct {
+		contractID string
+		valid      bool
+		accounts   []string
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			valid:      true,
+			accounts:   []string{s.customer.String()},
+		},
+		"no frozen accounts": {
+			contractID: s.unmintableContractId,
+			valid:      true,
+		},
+		"invalid contract id": {},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			req := &token.QueryFrozenAccountsRequest{
+				ContractId: tc.contractID,
+			}
+			res, err := s.queryServer.FrozenAccounts(sdk.WrapSDKContext(ctx), req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			s.Require().Equal(tc.accounts, res.Accounts)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryMaxSupply() {
+	// empty request
+	_, err := s.queryServer.MaxSupply(s.goCtx, nil)
+	s.Require().Error(err)
+
+	ctx, _ := s.ctx.CacheContext()
+	maxSupply := s.balance.Mul(sdk.NewInt(10))
+	change := token.Attribute{Key: token.AttributeKeyMaxSupply.String(), Value: maxSupply.String()}
+	err = s.keeper.Modify(ctx, s.contractID, s.vendor, []token.Attribute{change})
+	s.Require().NoError(err)
+
+	testCases := map[string]struct {
+		contractID string
+		valid      bool
+		maxSupply  sdk.Int
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			valid:      true,
+			maxSupply:  maxSupply,
+		},
+		"no cap": {
+			contractID: s.unmintableContractId,
+			valid:      true,
+			maxSupply:  sdk.ZeroInt(),
+		},
+		"invalid contract id": {},
+		"no such a contract": {
+			contractID: "fee1dead",
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			req := &token.QueryMaxSupplyRequest{
+				ContractId: tc.contractID,
+			}
+			res, err := s.queryServer.MaxSupply(sdk.WrapSDKContext(ctx), req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			s.Require().Equal(tc.maxSupply, res.Amount)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryAllowance() {
+	// empty request
+	_, err := s.queryServer.Allowance(s.goCtx, nil)
+	s.Require().Error(err)
+
+	ctx, _ := s.ctx.CacheContext()
+	s.keeper.Approve(ctx, s.contractID, s.customer, s.stranger, s.balance)
+
+	testCases := map[string]struct {
+		contractID string
+		owner      sdk.AccAddress
+		spender    sdk.AccAddress
+		valid      bool
+		amount     sdk.Int
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			owner:      s.customer,
+			spender:    s.stranger,
+			valid:      true,
+			amount:     s.balance,
+		},
+		"no allowance": {
+			contractID: s.contractID,
+			owner:      s.stranger,
+			spender:    s.customer,
+			valid:      true,
+			amount:     sdk.ZeroInt(),
+		},
+		"invalid contract id": {
+			owner:   s.customer,
+			spender: s.stranger,
+		},
+		"invalid owner": {
+			contractID: s.contractID,
+			spender:    s.stranger,
+		},
+		"invalid spender": {
+			contractID: s.contractID,
+			owner:      s.customer,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			req := &token.QueryAllowanceRequest{
+				ContractId: tc.contractID,
+				Owner:      tc.owner.String(),
+				Spender:    tc.spender.String(),
+			}
+			res, err := s.queryServer.Allowance(sdk.WrapSDKContext(ctx), req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			s.Require().Equal(tc.amount, res.Amount)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryAllowancesByOwner() {
+	// empty request
+	_, err := s.queryServer.AllowancesByOwner(s.goCtx, nil)
+	s.Require().Error(err)
+
+	ctx, _ := s.ctx.CacheContext()
+	s.keeper.Approve(ctx, s.contractID, s.customer, s.stranger, s.balance)
+	s.keeper.Approve(ctx, s.contractID, s.customer, s.operator, sdk.OneInt())
+
+	testCases := map[string]struct {
+		contractID string
+		owner      sdk.AccAddress
+		valid      bool
+		count      uint64
+		postTest   func(res *token.QueryAllowancesByOwnerResponse)
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			owner:      s.customer,
+			valid:      true,
+			count:      1000000,
+			postTest: func(res *token.QueryAllowancesByOwnerResponse) {
+				s.Require().ElementsMatch([]token.Allowance{
+					{Owner: s.customer.String(), Spender: s.stranger.String(), Amount: s.balance},
+					{Owner: s.customer.String(), Spender: s.operator.String(), Amount: sdk.OneInt()},
+				}, res.Allowances)
+			},
+		},
+		"valid request with limit": {
+			contractID: s.contractID,
+			owner:      s.customer,
+			valid:      true,
+			count:      1,
+			postTest: func(res *token.QueryAllowancesByOwnerResponse) {
+				s.Require().Equal(1, len(res.Allowances))
+			},
+		},
+		"invalid contract id": {
+			owner: s.customer,
+		},
+		"invalid owner": {
+			contractID: s.contractID,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			pageReq := &query.PageRequest{}
+			if tc.count != 0 {
+				pageReq.Limit = tc.count
+			}
+			req := &token.QueryAllowancesByOwnerRequest{
+				ContractId: tc.contractID,
+				Owner:      tc.owner.String(),
+				Pagination: pageReq,
+			}
+			res, err := s.queryServer.AllowancesByOwner(sdk.WrapSDKContext(ctx), req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			tc.postTest(res)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryContracts() {
+	// empty request
+	_, err := s.queryServer.Contracts(s.goCtx, nil)
+	s.Require().Error(err)
+
+	testCases := map[string]struct {
+		count    uint64
+		postTest func(res *token.QueryContractsResponse)
+	}{
+		"valid request": {
+			postTest: func(res *token.QueryContractsResponse) {
+				s.Require().Equal(3, len(res.Contracts))
+				var ids []string
+				for _, contract := range res.Contracts {
+					ids = append(ids, contract.Id)
+				}
+				s.Require().Contains(ids, s.contractID)
+				s.Require().Contains(ids, s.unmintableContractId)
+			},
+		},
+		"valid request with limit": {
+			count: 1,
+			postTest: func(res *token.QueryContractsResponse) {
+				s.Require().Equal(1, len(res.Contracts))
+				s.Require().NotNil(res.Pagination.NextKey)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			pageReq := &query.PageRequest{}
+			if tc.count != 0 {
+				pageReq.Limit = tc.count
+			}
+			req := &token.QueryContractsRequest{
+				Pagination: pageReq,
+			}
+			res, err := s.queryServer.Contracts(s.goCtx, req)
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			tc.postTest(res)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryHolders() {
+	// empty request
+	_, err := s.queryServer.Holders(s.goCtx, nil)
+	s.Require().Error(err)
+
+	testCases := map[string]struct {
+		contractID string
+		valid      bool
+		count      uint64
+		postTest   func(res *token.QueryHoldersResponse)
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			valid:      true,
+			postTest: func(res *token.QueryHoldersResponse) {
+				s.Require().ElementsMatch([]token.Balance{
+					{Address: s.vendor.String(), Amount: s.balance},
+					{Address: s.operator.String(), Amount: s.balance},
+					{Address: s.customer.String(), Amount: s.balance},
+				}, res.Balances)
+			},
+		},
+		"valid request with limit": {
+			contractID: s.contractID,
+			valid:      true,
+			count:      1,
+			postTest: func(res *token.QueryHoldersResponse) {
+				s.Require().Equal(1, len(res.Balances))
+			},
+		},
+		"invalid contract id": {},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			pageReq := &query.PageRequest{}
+			if tc.count != 0 {
+				pageReq.Limit = tc.count
+			}
+			req := &token.QueryHoldersRequest{
+				ContractId: tc.contractID,
+				Pagination: pageReq,
+			}
+			res, err := s.queryServer.Holders(s.goCtx, req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			tc.postTest(res)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryBalancesByAddress() {
+	// empty request
+	_, err := s.queryServer.BalancesByAddress(s.goCtx, nil)
+	s.Require().Error(err)
+
+	testCases := map[string]struct {
+		address  sdk.AccAddress
+		valid    bool
+		count    uint64
+		postTest func(res *token.QueryBalancesByAddressResponse)
+	}{
+		"valid request": {
+			address: s.vendor,
+			valid:   true,
+			postTest: func(res *token.QueryBalancesByAddressResponse) {
+				s.Require().Equal(3, len(res.Balances))
+				s.Require().Contains(res.Balances, token.ContractBalance{ContractId: s.contractID, Amount: s.balance})
+				s.Require().Contains(res.Balances, token.ContractBalance{ContractId: s.unmintableContractId, Amount: s.balance})
+			},
+		},
+		"valid request with limit": {
+			address: s.vendor,
+			valid:   true,
+			count:   1,
+			postTest: func(res *token.QueryBalancesByAddressResponse) {
+				s.Require().Equal(1, len(res.Balances))
+			},
+		},
+		"no balances": {
+			address: s.stranger,
+			valid:   true,
+			postTest: func(res *token.QueryBalancesByAddressResponse) {
+				s.Require().Empty(res.Balances)
+			},
+		},
+		"invalid address": {},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			pageReq := &query.PageRequest{}
+			if tc.count != 0 {
+				pageReq.Limit = tc.count
+			}
+			req := &token.QueryBalancesByAddressRequest{
+				Address:    tc.address.String(),
+				Pagination: pageReq,
+			}
+			res, err := s.queryServer.BalancesByAddress(s.goCtx, req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			tc.postTest(res)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryVestings() {
+	// empty request
+	_, err := s.queryServer.Vestings(s.goCtx, nil)
+	s.Require().Error(err)
+
+	ctx, _ := s.ctx.CacheContext()
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	schedule := token.VestingSchedule{
+		StartTime: 1000,
+		EndTime:   2000,
+	}
+	err = s.keeper.SendVested(ctx, s.contractID, s.vendor, s.stranger, s.balance, schedule)
+	s.Require().NoError(err)
+
+	// the balance reports the locked amount
+	balanceRes, err := s.queryServer.Balance(goCtx, &token.QueryBalanceRequest{
+		ContractId: s.contractID,
+		Address:    s.stranger.String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(s.balance, balanceRes.Amount)
+	s.Require().True(balanceRes.Spendable.IsZero())
+	s.Require().True(s.balance.Equal(balanceRes.Locked))
+
+	testCases := map[string]struct {
+		contractID string
+		address    sdk.AccAddress
+		valid      bool
+		count      int
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			address:    s.stranger,
+			valid:      true,
+			count:      1,
+		},
+		"no vestings": {
+			contractID: s.contractID,
+			address:    s.customer,
+			valid:      true,
+		},
+		"invalid contract id": {
+			address: s.stranger,
+		},
+		"invalid address": {
+			contractID: s.contractID,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			req := &token.QueryVestingsRequest{
+				ContractId: tc.contractID,
+				Address:    tc.address.String(),
+			}
+			res, err := s.queryServer.Vestings(goCtx, req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			s.Require().Len(res.Vestings, tc.count)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/token"
+)
+
+const (
+	supplyInvariant        = "supply"
+	statisticsInvariant    = "statistics"
+	wrappedSupplyInvariant = "wrapped-supply"
+)
+
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(token.ModuleName, supplyInvariant, SupplyInvariant(k))
+	ir.RegisterRoute(token.ModuleName, statisticsInvariant, StatisticsInvariant(k))
+	ir.RegisterRoute(token.ModuleName, wrappedSupplyInvariant, WrappedSupplyInvariant(k))
+}
+
+// SupplyInvariant checks that the supply of each contract equals to the sum of its balances.
+func SupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg := ""
+		broken := false
+
+		k.iterateClasses(ctx, func(class token.Contract) (stop bool) {
+			sum := sdk.ZeroInt()
+			k.iterateContractBalances(ctx, class.Id, func(balance token.Balance) (stop bool) {
+				sum = sum.Add(balance.Amount)
+				return false
+			})
+
+			supply := k.GetSupply(ctx, class.Id)
+			if !supply.Equal(sum) {
+				msg += fmt.Sprintf("supply of %s; expected %s, got %s\n", class.Id, sum, supply)
+				broken = true
+			}
+
+			return false
+		})
+
+		return sdk.FormatInvariant(token.ModuleName, supplyInvariant, msg), broken
+	}
+}
+
+// StatisticsInvariant checks that the supply of each contract equals to
+// the amount minted minus the amount burnt.
+func StatisticsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg := ""
+		broken := false
+
+		k.iterateClasses(ctx, func(class token.Contract) (stop bool) {
+			minted := k.GetMinted(ctx, class.Id)
+			burnt := k.GetBurnt(ctx, class.Id)
+			expected := minted.Sub(burnt)
+
+			supply := k.GetSupply(ctx, class.Id)
+			if !supply.Equal(expected) {
+				msg += fmt.Sprintf("statistics of %s; minted %s, burnt %s, got supply %s\n", class.Id, minted, burnt, supply)
+				broken = true
+			}
+
+			return false
+		})
+
+		return sdk.FormatInvariant(token.ModuleName, statisticsInvariant, msg), broken
+	}
+}
+
+// WrappedSupplyInvariant checks that the supply of the wrapped coins of each contract
+// equals to the amount of the escrowed tokens.
+func WrappedSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg := ""
+		broken := false
+
+		escrow := escrowAddress()
+		k.iterateClasses(ctx, func(class token.Contract) (stop bool) {
+			escrowed := k.GetBalance(ctx, class.Id, escrow)
+			wrapped := k.bankKeeper.GetSupply(ctx, token.WrappedDenom(class.Id)).Amount
+			if !wrapped.Equal(escrowed) {
+				msg += fmt.Sprintf("wrapped supply of %s; expected %s, got %s\n", class.Id, escrowed, wrapped)
+				broken = true
+			}
+
+			return false
+		})
+
+		return sdk.FormatInvariant(token.ModuleName, wrappedSupplyInvariant, msg), broken
+	}
+}
@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/token"
+	"github.com/Finschia/finschia-sdk/x/token/keeper"
+)
+
+func (s *KeeperTestSuite) TestSupplyInvariant() {
+	testCases := map[string]struct {
+		malleate func(ctx sdk.Context)
+		valid    bool
+	}{
+		"invariant not broken": {
+			valid: true,
+		},
+		"supply not matching the balances": {
+			malleate: func(ctx sdk.Context) {
+				s.keeper.InitGenesis(ctx, &token.GenesisState{
+					Supplies: []token.ContractCoin{{
+						ContractId: s.contractID,
+						Amount:     s.balance,
+					}},
+				})
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			if tc.malleate != nil {
+				tc.malleate(ctx)
+			}
+
+			invariant := keeper.SupplyInvariant(s.keeper)
+			_, broken := invariant(ctx)
+			s.Require().Equal(!tc.valid, broken)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestStatisticsInvariant() {
+	testCases := map[string]struct {
+		malleate func(ctx sdk.Context)
+		valid    bool
+	}{
+		"invariant not broken": {
+			valid: true,
+		},
+		"after burning": {
+			malleate: func(ctx sdk.Context) {
+				err := s.keeper.Burn(ctx, s.contractID, s.vendor, s.balance)
+				s.Require().NoError(err)
+			},
+			valid: true,
+		},
+		"burnt not matching the supply": {
+			malleate: func(ctx sdk.Context) {
+				s.keeper.InitGenesis(ctx, &token.GenesisState{
+					Burns: []token.ContractCoin{{
+						ContractId: s.contractID,
+						Amount:     s.balance.MulRaw(2),
+					}},
+				})
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			if tc.malleate != nil {
+				tc.malleate(ctx)
+			}
+
+			invariant := keeper.StatisticsInvariant(s.keeper)
+			_, broken := invariant(ctx)
+			s.Require().Equal(!tc.valid, broken)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestWrappedSupplyInvariant() {
+	testCases := map[string]struct {
+		malleate func(ctx sdk.Context)
+		valid    bool
+	}{
+		"invariant not broken": {
+			valid: true,
+		},
+		"wrapped coins minted without escrow": {
+			malleate: func(ctx sdk.Context) {
+				coins := sdk.NewCoins(sdk.NewCoin(token.WrappedDenom(s.contractID), sdk.OneInt()))
+				err := s.bankKeeper.MintCoins(ctx, token.ModuleName, coins)
+				s.Require().NoError(err)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			err := s.keeper.Wrap(ctx, s.contractID, s.customer, s.balance)
+			s.Require().NoError(err)
+			if tc.malleate != nil {
+				tc.malleate(ctx)
+			}
+
+			invariant := keeper.WrappedSupplyInvariant(s.keeper)
+			_, broken := invariant(ctx)
+			s.Require().Equal(!tc.valid, broken)
+		})
+	}
+}
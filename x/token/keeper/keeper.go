@@ -3,6 +3,7 @@ package keeper
 import (
 	"github.com/Finschia/finschia-sdk/codec"
 	sdk "github.com/Finschia/finschia-sdk/types"
+	paramtypes "github.com/Finschia/finschia-sdk/x/params/types"
 	"github.com/Finschia/finschia-sdk/x/token"
 	"github.com/Finschia/finschia-sdk/x/token/class"
 )
@@ -10,12 +11,15 @@ import (
 // Keeper defines the token module Keeper
 type Keeper struct {
 	classKeeper token.ClassKeeper
+	bankKeeper  token.BankKeeper
 
 	// The (unexposed) keys used to access the stores from the Context.
 	storeKey sdk.StoreKey
 
 	// The codec for binary encoding/decoding.
 	cdc codec.Codec
+
+	paramSpace paramtypes.Subspace
 }
 
 // NewKeeper returns a token keeper
@@ -23,11 +27,20 @@ func NewKeeper(
 	cdc codec.Codec,
 	key sdk.StoreKey,
 	ck token.ClassKeeper,
+	bk token.BankKeeper,
+	paramSpace paramtypes.Subspace,
 ) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(token.ParamKeyTable())
+	}
+
 	return Keeper{
 		classKeeper: ck,
+		bankKeeper:  bk,
 		storeKey:    key,
 		cdc:         cdc,
+		paramSpace:  paramSpace,
 	}
 }
 
@@ -10,6 +10,7 @@ import (
 	"github.com/Finschia/finschia-sdk/crypto/keys/secp256k1"
 	"github.com/Finschia/finschia-sdk/simapp"
 	sdk "github.com/Finschia/finschia-sdk/types"
+	authzkeeper "github.com/Finschia/finschia-sdk/x/authz/keeper"
 	"github.com/Finschia/finschia-sdk/x/token"
 	"github.com/Finschia/finschia-sdk/x/token/keeper"
 )
@@ -20,6 +21,8 @@ type KeeperTestSuite struct {
 	ctx         sdk.Context
 	goCtx       context.Context
 	keeper      keeper.Keeper
+	bankKeeper  token.BankKeeper
+	authzKeeper authzkeeper.Keeper
 	queryServer token.QueryServer
 	msgServer   token.MsgServer
 
@@ -58,6 +61,8 @@ func (s *KeeperTestSuite) SetupTest() {
 	s.ctx = app.BaseApp.NewContext(checkTx, tmproto.Header{})
 	s.goCtx = sdk.WrapSDKContext(s.ctx)
 	s.keeper = app.TokenKeeper
+	s.bankKeeper = app.BankKeeper
+	s.authzKeeper = app.AuthzKeeper
 
 	s.queryServer = keeper.NewQueryServer(s.keeper)
 	s.msgServer = keeper.NewMsgServer(s.keeper)
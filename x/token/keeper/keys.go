@@ -1,29 +1,51 @@
 package keeper
 
 import (
+	"encoding/binary"
+
 	sdk "github.com/Finschia/finschia-sdk/types"
 	"github.com/Finschia/finschia-sdk/x/token"
 )
 
 var (
-	balanceKeyPrefix       = []byte{0x00}
-	classKeyPrefix         = []byte{0x01}
-	grantKeyPrefix         = []byte{0x02}
-	authorizationKeyPrefix = []byte{0x03}
+	BalanceKeyPrefix       = []byte{0x00}
+	ClassKeyPrefix         = []byte{0x01}
+	GrantKeyPrefix         = []byte{0x02}
+	AuthorizationKeyPrefix = []byte{0x03}
 
 	// statistics keys
-	supplyKeyPrefix = []byte{0x04}
-	mintKeyPrefix   = []byte{0x05}
-	burnKeyPrefix   = []byte{0x06}
+	SupplyKeyPrefix = []byte{0x04}
+	MintKeyPrefix   = []byte{0x05}
+	BurnKeyPrefix   = []byte{0x06}
+
+	PausedKeyPrefix    = []byte{0x07}
+	FrozenKeyPrefix    = []byte{0x08}
+	AllowanceKeyPrefix = []byte{0x09}
+
+	// indexes
+	BalanceByAddressKeyPrefix = []byte{0x0a}
+
+	VestingKeyPrefix = []byte{0x0b}
 )
 
 func classKey(id string) []byte {
-	key := make([]byte, len(classKeyPrefix)+len(id))
-	copy(key, classKeyPrefix)
-	copy(key[len(classKeyPrefix):], id)
+	key := make([]byte, len(ClassKeyPrefix)+len(id))
+	copy(key, ClassKeyPrefix)
+	copy(key[len(ClassKeyPrefix):], id)
 	return key
 }
 
+func pausedKey(contractID string) []byte {
+	key := make([]byte, len(PausedKeyPrefix)+len(contractID))
+	copy(key, PausedKeyPrefix)
+	copy(key[len(PausedKeyPrefix):], contractID)
+	return key
+}
+
+func splitPausedKey(key []byte) (contractID string) {
+	return string(key[len(PausedKeyPrefix):])
+}
+
 func balanceKey(contractID string, address sdk.AccAddress) []byte {
 	prefix := balanceKeyPrefixByContractID(contractID)
 	key := make([]byte, len(prefix)+len(address))
@@ -35,12 +57,12 @@ func balanceKey(contractID string, address sdk.AccAddress) []byte {
 }
 
 func balanceKeyPrefixByContractID(contractID string) []byte {
-	key := make([]byte, len(balanceKeyPrefix)+1+len(contractID))
+	key := make([]byte, len(BalanceKeyPrefix)+1+len(contractID))
 
 	begin := 0
-	copy(key, balanceKeyPrefix)
+	copy(key, BalanceKeyPrefix)
 
-	begin += len(balanceKeyPrefix)
+	begin += len(BalanceKeyPrefix)
 	key[begin] = byte(len(contractID))
 
 	begin++
@@ -50,7 +72,7 @@ func balanceKeyPrefixByContractID(contractID string) []byte {
 }
 
 func splitBalanceKey(key []byte) (contractID string, address sdk.AccAddress) {
-	begin := len(balanceKeyPrefix) + 1
+	begin := len(BalanceKeyPrefix) + 1
 	end := begin + int(key[begin-1])
 	contractID = string(key[begin:end])
 
@@ -68,15 +90,15 @@ func statisticsKey(keyPrefix []byte, contractID string) []byte {
 }
 
 // func supplyKey(contractID string) []byte {
-// 	return statisticsKey(supplyKeyPrefix, contractID)
+// 	return statisticsKey(SupplyKeyPrefix, contractID)
 // }
 
 // func mintKey(contractID string) []byte {
-// 	return statisticsKey(mintKeyPrefix, contractID)
+// 	return statisticsKey(MintKeyPrefix, contractID)
 // }
 
 // func burnKey(contractID string) []byte {
-// 	return statisticsKey(burnKeyPrefix, contractID)
+// 	return statisticsKey(BurnKeyPrefix, contractID)
 // }
 
 func splitStatisticsKey(key, keyPrefix []byte) (contractID string) {
@@ -84,15 +106,15 @@ func splitStatisticsKey(key, keyPrefix []byte) (contractID string) {
 }
 
 // func splitSupplyKey(key []byte) (contractID string) {
-// 	return splitStatisticsKey(key, supplyKeyPrefix)
+// 	return splitStatisticsKey(key, SupplyKeyPrefix)
 // }
 
 // func splitMintKey(key []byte) (contractID string) {
-// 	return splitStatisticsKey(key, mintKeyPrefix)
+// 	return splitStatisticsKey(key, MintKeyPrefix)
 // }
 
 // func splitBurnKey(key []byte) (contractID string) {
-// 	return splitStatisticsKey(key, burnKeyPrefix)
+// 	return splitStatisticsKey(key, BurnKeyPrefix)
 // }
 
 func grantKey(contractID string, grantee sdk.AccAddress, permission token.Permission) []byte {
@@ -122,12 +144,12 @@ func grantKeyPrefixByGrantee(contractID string, grantee sdk.AccAddress) []byte {
 }
 
 func grantKeyPrefixByContractID(contractID string) []byte {
-	key := make([]byte, len(grantKeyPrefix)+1+len(contractID))
+	key := make([]byte, len(GrantKeyPrefix)+1+len(contractID))
 
 	begin := 0
-	copy(key, grantKeyPrefix)
+	copy(key, GrantKeyPrefix)
 
-	begin += len(grantKeyPrefix)
+	begin += len(GrantKeyPrefix)
 	key[begin] = byte(len(contractID))
 
 	begin++
@@ -137,7 +159,7 @@ func grantKeyPrefixByContractID(contractID string) []byte {
 }
 
 func splitGrantKey(key []byte) (contractID string, grantee sdk.AccAddress, permission token.Permission) {
-	begin := len(grantKeyPrefix) + 1
+	begin := len(GrantKeyPrefix) + 1
 	end := begin + int(key[begin-1])
 	contractID = string(key[begin:end])
 
@@ -178,12 +200,12 @@ func authorizationKeyPrefixByOperator(contractID string, operator sdk.AccAddress
 }
 
 func authorizationKeyPrefixByContractID(contractID string) []byte {
-	key := make([]byte, len(authorizationKeyPrefix)+1+len(contractID))
+	key := make([]byte, len(AuthorizationKeyPrefix)+1+len(contractID))
 
 	begin := 0
-	copy(key, authorizationKeyPrefix)
+	copy(key, AuthorizationKeyPrefix)
 
-	begin += len(authorizationKeyPrefix)
+	begin += len(AuthorizationKeyPrefix)
 	key[begin] = byte(len(contractID))
 
 	begin++
@@ -193,7 +215,7 @@ func authorizationKeyPrefixByContractID(contractID string) []byte {
 }
 
 func splitAuthorizationKey(key []byte) (contractID string, operator, holder sdk.AccAddress) {
-	begin := len(authorizationKeyPrefix) + 1
+	begin := len(AuthorizationKeyPrefix) + 1
 	end := begin + int(key[begin-1])
 	contractID = string(key[begin:end])
 
@@ -206,3 +228,177 @@ func splitAuthorizationKey(key []byte) (contractID string, operator, holder sdk.
 
 	return
 }
+
+func frozenKey(contractID string, account sdk.AccAddress) []byte {
+	prefix := frozenKeyPrefixByContractID(contractID)
+	key := make([]byte, len(prefix)+len(account))
+
+	copy(key, prefix)
+	copy(key[len(prefix):], account)
+
+	return key
+}
+
+func frozenKeyPrefixByContractID(contractID string) []byte {
+	key := make([]byte, len(FrozenKeyPrefix)+1+len(contractID))
+
+	begin := 0
+	copy(key, FrozenKeyPrefix)
+
+	begin += len(FrozenKeyPrefix)
+	key[begin] = byte(len(contractID))
+
+	begin++
+	copy(key[begin:], contractID)
+
+	return key
+}
+
+func splitFrozenKey(key []byte) (contractID string, account sdk.AccAddress) {
+	begin := len(FrozenKeyPrefix) + 1
+	end := begin + int(key[begin-1])
+	contractID = string(key[begin:end])
+
+	begin = end
+	account = key[begin:]
+
+	return
+}
+
+func allowanceKey(contractID string, owner, spender sdk.AccAddress) []byte {
+	prefix := allowanceKeyPrefixByOwner(contractID, owner)
+	key := make([]byte, len(prefix)+len(spender))
+
+	copy(key, prefix)
+	copy(key[len(prefix):], spender)
+
+	return key
+}
+
+func allowanceKeyPrefixByOwner(contractID string, owner sdk.AccAddress) []byte {
+	prefix := allowanceKeyPrefixByContractID(contractID)
+	key := make([]byte, len(prefix)+1+len(owner))
+
+	begin := 0
+	copy(key, prefix)
+
+	begin += len(prefix)
+	key[begin] = byte(len(owner))
+
+	begin++
+	copy(key[begin:], owner)
+
+	return key
+}
+
+func allowanceKeyPrefixByContractID(contractID string) []byte {
+	key := make([]byte, len(AllowanceKeyPrefix)+1+len(contractID))
+
+	begin := 0
+	copy(key, AllowanceKeyPrefix)
+
+	begin += len(AllowanceKeyPrefix)
+	key[begin] = byte(len(contractID))
+
+	begin++
+	copy(key[begin:], contractID)
+
+	return key
+}
+
+func splitAllowanceKey(key []byte) (contractID string, owner, spender sdk.AccAddress) {
+	begin := len(AllowanceKeyPrefix) + 1
+	end := begin + int(key[begin-1])
+	contractID = string(key[begin:end])
+
+	begin = end + 1
+	end = begin + int(key[begin-1])
+	owner = key[begin:end]
+
+	begin = end
+	spender = key[begin:]
+
+	return
+}
+
+// balanceByAddressKey is the key of the index from an address to the contracts it holds tokens of.
+func balanceByAddressKey(address sdk.AccAddress, contractID string) []byte {
+	prefix := balanceByAddressKeyPrefixByAddress(address)
+	key := make([]byte, len(prefix)+len(contractID))
+
+	copy(key, prefix)
+	copy(key[len(prefix):], contractID)
+
+	return key
+}
+
+func balanceByAddressKeyPrefixByAddress(address sdk.AccAddress) []byte {
+	key := make([]byte, len(BalanceByAddressKeyPrefix)+1+len(address))
+
+	begin := 0
+	copy(key, BalanceByAddressKeyPrefix)
+
+	begin += len(BalanceByAddressKeyPrefix)
+	key[begin] = byte(len(address))
+
+	begin++
+	copy(key[begin:], address)
+
+	return key
+}
+
+func vestingKey(contractID string, holder sdk.AccAddress, seq uint64) []byte {
+	prefix := vestingKeyPrefixByHolder(contractID, holder)
+	key := make([]byte, len(prefix)+8)
+
+	copy(key, prefix)
+	binary.BigEndian.PutUint64(key[len(prefix):], seq)
+
+	return key
+}
+
+func splitVestingKey(key []byte) (contractID string, holder sdk.AccAddress, seq uint64) {
+	begin := len(VestingKeyPrefix) + 1
+	end := begin + int(key[begin-1])
+	contractID = string(key[begin:end])
+
+	begin = end + 1
+	end = begin + int(key[begin-1])
+	holder = key[begin:end]
+
+	begin = end
+	seq = binary.BigEndian.Uint64(key[begin:])
+
+	return
+}
+
+func vestingKeyPrefixByHolder(contractID string, holder sdk.AccAddress) []byte {
+	prefix := vestingKeyPrefixByContractID(contractID)
+	key := make([]byte, len(prefix)+1+len(holder))
+
+	begin := 0
+	copy(key, prefix)
+
+	begin += len(prefix)
+	key[begin] = byte(len(holder))
+
+	begin++
+	copy(key[begin:], holder)
+
+	return key
+}
+
+func vestingKeyPrefixByContractID(contractID string) []byte {
+	key := make([]byte, len(VestingKeyPrefix)+1+len(contractID))
+
+	begin := 0
+	copy(key, VestingKeyPrefix)
+
+	begin += len(VestingKeyPrefix)
+	key[begin] = byte(len(contractID))
+
+	begin++
+	copy(key[begin:], contractID)
+
+	return key
+}
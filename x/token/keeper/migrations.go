@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/types/module"
+	"github.com/Finschia/finschia-sdk/x/token"
+	v2 "github.com/Finschia/finschia-sdk/x/token/keeper/migrations/v2"
+	v3 "github.com/Finschia/finschia-sdk/x/token/keeper/migrations/v3"
+	v4 "github.com/Finschia/finschia-sdk/x/token/keeper/migrations/v4"
+	v5 "github.com/Finschia/finschia-sdk/x/token/keeper/migrations/v5"
+	v6 "github.com/Finschia/finschia-sdk/x/token/keeper/migrations/v6"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+func (m Migrator) Register(register func(moduleName string, fromVersion uint64, handler module.MigrationHandler) error) error {
+	for fromVersion, handler := range map[uint64]module.MigrationHandler{
+		1: func(ctx sdk.Context) error {
+			return v2.MigrateStore(ctx, m.keeper.storeKey)
+		},
+		2: func(ctx sdk.Context) error {
+			return v3.MigrateStore(ctx, m.keeper.storeKey)
+		},
+		3: func(ctx sdk.Context) error {
+			return v4.MigrateStore(ctx, m.keeper.storeKey)
+		},
+		4: func(ctx sdk.Context) error {
+			return v5.MigrateStore(ctx, m.keeper.paramSpace)
+		},
+		5: func(ctx sdk.Context) error {
+			return v6.MigrateStore(ctx, m.keeper.paramSpace)
+		},
+	} {
+		if err := register(token.ModuleName, fromVersion, handler); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package v2
+
+import (
+	storetypes "github.com/Finschia/finschia-sdk/store/types"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/token"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey) error {
+	store := ctx.KVStore(storeKey)
+
+	// the pause permission was split out of the modify permission,
+	// so the existing contracts would not lose their pausers.
+	splitModifyGrants(store, token.PermissionPause)
+
+	return nil
+}
+
+func splitModifyGrants(store storetypes.KVStore, permissions ...token.Permission) {
+	var newKeys [][]byte
+	func() {
+		iterator := sdk.KVStorePrefixIterator(store, grantKeyPrefix)
+		defer iterator.Close()
+
+		for ; iterator.Valid(); iterator.Next() {
+			contractID, grantee, permission := splitGrantKey(iterator.Key())
+			if permission != token.PermissionModify {
+				continue
+			}
+
+			for _, newPermission := range permissions {
+				newKeys = append(newKeys, GrantKey(contractID, grantee, newPermission))
+			}
+		}
+	}()
+
+	// avoid writing into the domain of the iterator
+	for _, key := range newKeys {
+		store.Set(key, []byte{})
+	}
+}
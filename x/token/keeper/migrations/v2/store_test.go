@@ -0,0 +1,41 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/finschia-sdk/testutil"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/token"
+	"github.com/Finschia/finschia-sdk/x/token/keeper/migrations/v2"
+)
+
+func TestMigrateStore(t *testing.T) {
+	tokenKey := sdk.NewKVStoreKey(token.StoreKey)
+	newKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(tokenKey, newKey)
+
+	// set state
+	store := ctx.KVStore(tokenKey)
+
+	contractIDs := []string{"deadbeef", "fee1dead"}
+	modifier := sdk.AccAddress("fennec")
+	minter := sdk.AccAddress("penguin")
+	for _, contractID := range contractIDs {
+		store.Set(v2.GrantKey(contractID, modifier, token.PermissionModify), []byte{})
+		store.Set(v2.GrantKey(contractID, minter, token.PermissionMint), []byte{})
+	}
+
+	// migrate
+	err := v2.MigrateStore(ctx, tokenKey)
+	require.NoError(t, err)
+
+	for _, contractID := range contractIDs {
+		require.True(t, store.Has(v2.GrantKey(contractID, modifier, token.PermissionModify)))
+		require.True(t, store.Has(v2.GrantKey(contractID, modifier, token.PermissionPause)))
+
+		require.True(t, store.Has(v2.GrantKey(contractID, minter, token.PermissionMint)))
+		require.False(t, store.Has(v2.GrantKey(contractID, minter, token.PermissionPause)))
+	}
+}
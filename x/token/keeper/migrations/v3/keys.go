@@ -0,0 +1,64 @@
+package v3
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/token"
+)
+
+var grantKeyPrefix = []byte{0x02}
+
+func GrantKey(contractID string, grantee sdk.AccAddress, permission token.Permission) []byte {
+	prefix := grantKeyPrefixByGrantee(contractID, grantee)
+	key := make([]byte, len(prefix)+1)
+
+	copy(key, prefix)
+	key[len(prefix)] = byte(permission)
+
+	return key
+}
+
+func grantKeyPrefixByGrantee(contractID string, grantee sdk.AccAddress) []byte {
+	prefix := grantKeyPrefixByContractID(contractID)
+	key := make([]byte, len(prefix)+1+len(grantee))
+
+	begin := 0
+	copy(key, prefix)
+
+	begin += len(prefix)
+	key[begin] = byte(len(grantee))
+
+	begin++
+	copy(key[begin:], grantee)
+
+	return key
+}
+
+func grantKeyPrefixByContractID(contractID string) []byte {
+	key := make([]byte, len(grantKeyPrefix)+1+len(contractID))
+
+	begin := 0
+	copy(key, grantKeyPrefix)
+
+	begin += len(grantKeyPrefix)
+	key[begin] = byte(len(contractID))
+
+	begin++
+	copy(key[begin:], contractID)
+
+	return key
+}
+
+func splitGrantKey(key []byte) (contractID string, grantee sdk.AccAddress, permission token.Permission) {
+	begin := len(grantKeyPrefix) + 1
+	end := begin + int(key[begin-1])
+	contractID = string(key[begin:end])
+
+	begin = end + 1
+	end = begin + int(key[begin-1])
+	grantee = key[begin:end]
+
+	begin = end
+	permission = token.Permission(key[begin])
+
+	return
+}
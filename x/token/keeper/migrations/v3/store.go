@@ -0,0 +1,42 @@
+package v3
+
+import (
+	storetypes "github.com/Finschia/finschia-sdk/store/types"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/token"
+)
+
+// MigrateStore performs in-place store migrations from v2 to v3.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey) error {
+	store := ctx.KVStore(storeKey)
+
+	// the freeze permission was split out of the modify permission,
+	// so the existing contracts would not lose their freezers.
+	splitModifyGrants(store, token.PermissionFreeze)
+
+	return nil
+}
+
+func splitModifyGrants(store storetypes.KVStore, permissions ...token.Permission) {
+	var newKeys [][]byte
+	func() {
+		iterator := sdk.KVStorePrefixIterator(store, grantKeyPrefix)
+		defer iterator.Close()
+
+		for ; iterator.Valid(); iterator.Next() {
+			contractID, grantee, permission := splitGrantKey(iterator.Key())
+			if permission != token.PermissionModify {
+				continue
+			}
+
+			for _, newPermission := range permissions {
+				newKeys = append(newKeys, GrantKey(contractID, grantee, newPermission))
+			}
+		}
+	}()
+
+	// avoid writing into the domain of the iterator
+	for _, key := range newKeys {
+		store.Set(key, []byte{})
+	}
+}
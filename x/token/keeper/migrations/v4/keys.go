@@ -0,0 +1,65 @@
+package v4
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+)
+
+var (
+	balanceKeyPrefix = []byte{0x00}
+
+	balanceByAddressKeyPrefix = []byte{0x0a}
+)
+
+func BalanceKey(contractID string, address sdk.AccAddress) []byte {
+	prefix := balanceKeyPrefixByContractID(contractID)
+	key := make([]byte, len(prefix)+len(address))
+
+	copy(key, prefix)
+	copy(key[len(prefix):], address)
+
+	return key
+}
+
+func balanceKeyPrefixByContractID(contractID string) []byte {
+	key := make([]byte, len(balanceKeyPrefix)+1+len(contractID))
+
+	begin := 0
+	copy(key, balanceKeyPrefix)
+
+	begin += len(balanceKeyPrefix)
+	key[begin] = byte(len(contractID))
+
+	begin++
+	copy(key[begin:], contractID)
+
+	return key
+}
+
+func splitBalanceKey(key []byte) (contractID string, address sdk.AccAddress) {
+	begin := len(balanceKeyPrefix) + 1
+	end := begin + int(key[begin-1])
+	contractID = string(key[begin:end])
+
+	begin = end
+	address = key[begin:]
+
+	return
+}
+
+func BalanceByAddressKey(address sdk.AccAddress, contractID string) []byte {
+	key := make([]byte, len(balanceByAddressKeyPrefix)+1+len(address)+len(contractID))
+
+	begin := 0
+	copy(key, balanceByAddressKeyPrefix)
+
+	begin += len(balanceByAddressKeyPrefix)
+	key[begin] = byte(len(address))
+
+	begin++
+	copy(key[begin:], address)
+
+	begin += len(address)
+	copy(key[begin:], contractID)
+
+	return key
+}
@@ -0,0 +1,45 @@
+package v4
+
+import (
+	storetypes "github.com/Finschia/finschia-sdk/store/types"
+	sdk "github.com/Finschia/finschia-sdk/types"
+)
+
+// MigrateStore performs in-place store migrations from v3 to v4.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey) error {
+	store := ctx.KVStore(storeKey)
+
+	// build the index of balances by address
+	indexBalancesByAddress(store)
+
+	return nil
+}
+
+func indexBalancesByAddress(store storetypes.KVStore) {
+	for _, balance := range getBalances(store) {
+		store.Set(BalanceByAddressKey(balance.address, balance.contractID), []byte{})
+	}
+}
+
+type balance struct {
+	contractID string
+	address    sdk.AccAddress
+}
+
+func getBalances(store storetypes.KVStore) []balance {
+	iterator := sdk.KVStorePrefixIterator(store, balanceKeyPrefix)
+	defer iterator.Close()
+
+	var balances []balance
+	for ; iterator.Valid(); iterator.Next() {
+		contractID, address := splitBalanceKey(iterator.Key())
+
+		// the key may be reused by the iterator
+		balances = append(balances, balance{
+			contractID: contractID,
+			address:    append(sdk.AccAddress{}, address...),
+		})
+	}
+
+	return balances
+}
@@ -0,0 +1,46 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/finschia-sdk/testutil"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/token"
+	"github.com/Finschia/finschia-sdk/x/token/keeper/migrations/v4"
+)
+
+func TestMigrateStore(t *testing.T) {
+	tokenKey := sdk.NewKVStoreKey(token.StoreKey)
+	newKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(tokenKey, newKey)
+
+	// set state
+	store := ctx.KVStore(tokenKey)
+
+	oneIntBz, err := sdk.OneInt().Marshal()
+	require.NoError(t, err)
+	contractIDs := []string{"deadbeef", "fee1dead"}
+	addresses := []sdk.AccAddress{
+		sdk.AccAddress("fennec"),
+		sdk.AccAddress("penguin"),
+		sdk.AccAddress("cheetah"),
+	}
+	for _, contractID := range contractIDs {
+		for _, addr := range addresses {
+			store.Set(v4.BalanceKey(contractID, addr), oneIntBz)
+		}
+	}
+
+	// migrate
+	err = v4.MigrateStore(ctx, tokenKey)
+	require.NoError(t, err)
+
+	for _, contractID := range contractIDs {
+		for _, addr := range addresses {
+			require.True(t, store.Has(v4.BalanceByAddressKey(addr, contractID)))
+		}
+	}
+	require.False(t, store.Has(v4.BalanceByAddressKey(sdk.AccAddress("hyena"), contractIDs[0])))
+}
@@ -0,0 +1,9 @@
+package v5
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+)
+
+type Subspace interface {
+	Set(ctx sdk.Context, key []byte, value interface{})
+}
@@ -0,0 +1,8 @@
+package v5
+
+// the params as of v5, which must not follow the later changes of x/token
+const (
+	ParamKeyMaxMultiSendOutputs = "MaxMultiSendOutputs"
+
+	DefaultMaxMultiSendOutputs uint32 = 100
+)
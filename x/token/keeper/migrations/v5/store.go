@@ -0,0 +1,13 @@
+package v5
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+)
+
+// MigrateStore performs in-place store migrations from v4 to v5.
+func MigrateStore(ctx sdk.Context, subspace Subspace) error {
+	// the module had no params before v5
+	subspace.Set(ctx, []byte(ParamKeyMaxMultiSendOutputs), DefaultMaxMultiSendOutputs)
+
+	return nil
+}
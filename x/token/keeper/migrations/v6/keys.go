@@ -0,0 +1,8 @@
+package v6
+
+// the params as of v6, which must not follow the later changes of x/token
+const (
+	ParamKeyMaxVestingsPerHolder = "MaxVestingsPerHolder"
+
+	DefaultMaxVestingsPerHolder uint32 = 20
+)
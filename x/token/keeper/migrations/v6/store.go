@@ -0,0 +1,13 @@
+package v6
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+)
+
+// MigrateStore performs in-place store migrations from v5 to v6.
+func MigrateStore(ctx sdk.Context, subspace Subspace) error {
+	// the param was introduced in v6
+	subspace.Set(ctx, []byte(ParamKeyMaxVestingsPerHolder), DefaultMaxVestingsPerHolder)
+
+	return nil
+}
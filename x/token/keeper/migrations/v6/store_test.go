@@ -0,0 +1,35 @@
+package v6_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/finschia-sdk/testutil"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/token"
+	"github.com/Finschia/finschia-sdk/x/token/keeper/migrations/v6"
+)
+
+type mockSubspace struct {
+	params map[string]interface{}
+}
+
+func (ms *mockSubspace) Set(ctx sdk.Context, key []byte, value interface{}) {
+	ms.params[string(key)] = value
+}
+
+func TestMigrateStore(t *testing.T) {
+	tokenKey := sdk.NewKVStoreKey(token.StoreKey)
+	newKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(tokenKey, newKey)
+
+	// migrate
+	subspace := &mockSubspace{params: map[string]interface{}{}}
+	err := v6.MigrateStore(ctx, subspace)
+	require.NoError(t, err)
+
+	require.Equal(t, map[string]interface{}{
+		v6.ParamKeyMaxVestingsPerHolder: v6.DefaultMaxVestingsPerHolder,
+	}, subspace.params)
+}
@@ -50,6 +50,76 @@ func (s msgServer) Send(c context.Context, req *token.MsgSend) (*token.MsgSendRe
 	return &token.MsgSendResponse{}, nil
 }
 
+// MultiSend defines a method to send tokens from one account to many accounts at once
+func (s msgServer) MultiSend(c context.Context, req *token.MsgMultiSend) (*token.MsgMultiSendResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	from := sdk.MustAccAddressFromBech32(req.From)
+
+	if err := s.keeper.MultiSend(ctx, req.ContractId, from, req.Outputs); err != nil {
+		return nil, err
+	}
+
+	for _, output := range req.Outputs {
+		event := token.EventSent{
+			ContractId: req.ContractId,
+			Operator:   req.From,
+			From:       req.From,
+			To:         output.To,
+			Amount:     output.Amount,
+		}
+		if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+			panic(err)
+		}
+	}
+
+	return &token.MsgMultiSendResponse{}, nil
+}
+
+// SendVested defines a method to send tokens which are locked by a vesting schedule
+func (s msgServer) SendVested(c context.Context, req *token.MsgSendVested) (*token.MsgSendVestedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	from := sdk.MustAccAddressFromBech32(req.From)
+	to := sdk.MustAccAddressFromBech32(req.To)
+
+	if err := s.keeper.SendVested(ctx, req.ContractId, from, to, req.Amount, req.Schedule); err != nil {
+		return nil, err
+	}
+
+	sentEvent := token.EventSent{
+		ContractId: req.ContractId,
+		Operator:   req.From,
+		From:       req.From,
+		To:         req.To,
+		Amount:     req.Amount,
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&sentEvent); err != nil {
+		panic(err)
+	}
+
+	vestingEvent := token.EventVestingScheduled{
+		ContractId: req.ContractId,
+		Operator:   req.From,
+		Holder:     req.To,
+		Amount:     req.Amount,
+		Schedule:   req.Schedule,
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&vestingEvent); err != nil {
+		panic(err)
+	}
+
+	return &token.MsgSendVestedResponse{}, nil
+}
+
 // OperatorSend defines a method to send tokens from one account to another account by the operator
 func (s msgServer) OperatorSend(c context.Context, req *token.MsgOperatorSend) (*token.MsgOperatorSendResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -141,16 +211,20 @@ func (s msgServer) AuthorizeOperator(c context.Context, req *token.MsgAuthorizeO
 func (s msgServer) Issue(c context.Context, req *token.MsgIssue) (*token.MsgIssueResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	class := token.Contract{
-		Name:     req.Name,
-		Symbol:   req.Symbol,
-		Uri:      req.Uri,
-		Meta:     req.Meta,
-		Decimals: req.Decimals,
-		Mintable: req.Mintable,
+		Name:      req.Name,
+		Symbol:    req.Symbol,
+		Uri:       req.Uri,
+		Meta:      req.Meta,
+		Decimals:  req.Decimals,
+		Mintable:  req.Mintable,
+		MaxSupply: req.MaxSupply,
 	}
 
 	owner := sdk.MustAccAddressFromBech32(req.Owner)
 	to := sdk.MustAccAddressFromBech32(req.To)
+	if err := validateNotEscrow(to); err != nil {
+		return nil, err
+	}
 	contractID := s.keeper.Issue(ctx, class, owner, to, req.Amount)
 
 	return &token.MsgIssueResponse{ContractId: contractID}, nil
@@ -213,6 +287,35 @@ func (s msgServer) Mint(c context.Context, req *token.MsgMint) (*token.MsgMintRe
 	return &token.MsgMintResponse{}, nil
 }
 
+// MintVested defines a method to mint tokens which are locked by a vesting schedule
+func (s msgServer) MintVested(c context.Context, req *token.MsgMintVested) (*token.MsgMintVestedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	from := sdk.MustAccAddressFromBech32(req.From)
+	to := sdk.MustAccAddressFromBech32(req.To)
+
+	if err := s.keeper.MintVested(ctx, req.ContractId, from, to, req.Amount, req.Schedule); err != nil {
+		return nil, err
+	}
+
+	event := token.EventVestingScheduled{
+		ContractId: req.ContractId,
+		Operator:   req.From,
+		Holder:     req.To,
+		Amount:     req.Amount,
+		Schedule:   req.Schedule,
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+
+	return &token.MsgMintVestedResponse{}, nil
+}
+
 // Burn defines a method to burn tokens
 func (s msgServer) Burn(c context.Context, req *token.MsgBurn) (*token.MsgBurnResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -270,3 +373,151 @@ func (s msgServer) Modify(c context.Context, req *token.MsgModify) (*token.MsgMo
 
 	return &token.MsgModifyResponse{}, nil
 }
+
+// Pause defines a method to pause a contract
+func (s msgServer) Pause(c context.Context, req *token.MsgPause) (*token.MsgPauseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	grantee := sdk.MustAccAddressFromBech32(req.From)
+
+	if err := s.keeper.Pause(ctx, req.ContractId, grantee); err != nil {
+		return nil, err
+	}
+
+	return &token.MsgPauseResponse{}, nil
+}
+
+// Unpause defines a method to unpause a contract
+func (s msgServer) Unpause(c context.Context, req *token.MsgUnpause) (*token.MsgUnpauseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	grantee := sdk.MustAccAddressFromBech32(req.From)
+
+	if err := s.keeper.Unpause(ctx, req.ContractId, grantee); err != nil {
+		return nil, err
+	}
+
+	return &token.MsgUnpauseResponse{}, nil
+}
+
+// Freeze defines a method to freeze an account on a contract
+func (s msgServer) Freeze(c context.Context, req *token.MsgFreeze) (*token.MsgFreezeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	grantee := sdk.MustAccAddressFromBech32(req.From)
+	account := sdk.MustAccAddressFromBech32(req.Account)
+
+	if err := s.keeper.Freeze(ctx, req.ContractId, grantee, account); err != nil {
+		return nil, err
+	}
+
+	return &token.MsgFreezeResponse{}, nil
+}
+
+// Unfreeze defines a method to unfreeze an account on a contract
+func (s msgServer) Unfreeze(c context.Context, req *token.MsgUnfreeze) (*token.MsgUnfreezeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	grantee := sdk.MustAccAddressFromBech32(req.From)
+	account := sdk.MustAccAddressFromBech32(req.Account)
+
+	if err := s.keeper.Unfreeze(ctx, req.ContractId, grantee, account); err != nil {
+		return nil, err
+	}
+
+	return &token.MsgUnfreezeResponse{}, nil
+}
+
+// Approve sets the amount of tokens the spender is allowed to transfer on behalf of the owner
+func (s msgServer) Approve(c context.Context, req *token.MsgApprove) (*token.MsgApproveResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	owner := sdk.MustAccAddressFromBech32(req.Owner)
+	spender := sdk.MustAccAddressFromBech32(req.Spender)
+
+	s.keeper.Approve(ctx, req.ContractId, owner, spender, req.Amount)
+
+	return &token.MsgApproveResponse{}, nil
+}
+
+// TransferFrom defines a method to send tokens from one account to another account by the spender
+func (s msgServer) TransferFrom(c context.Context, req *token.MsgTransferFrom) (*token.MsgTransferFromResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	spender := sdk.MustAccAddressFromBech32(req.Spender)
+	from := sdk.MustAccAddressFromBech32(req.From)
+	to := sdk.MustAccAddressFromBech32(req.To)
+
+	if err := s.keeper.TransferFrom(ctx, req.ContractId, spender, from, to, req.Amount); err != nil {
+		return nil, err
+	}
+
+	event := token.EventSent{
+		ContractId: req.ContractId,
+		Operator:   req.Spender,
+		From:       req.From,
+		To:         req.To,
+		Amount:     req.Amount,
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+
+	return &token.MsgTransferFromResponse{}, nil
+}
+
+// Wrap escrows tokens of a contract, minting the same amount of the wrapped coins.
+func (s msgServer) Wrap(c context.Context, req *token.MsgWrap) (*token.MsgWrapResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	from := sdk.MustAccAddressFromBech32(req.From)
+	if err := s.keeper.Wrap(ctx, req.ContractId, from, req.Amount); err != nil {
+		return nil, err
+	}
+
+	return &token.MsgWrapResponse{}, nil
+}
+
+// Unwrap burns the wrapped coins, releasing the same amount of the escrowed tokens.
+func (s msgServer) Unwrap(c context.Context, req *token.MsgUnwrap) (*token.MsgUnwrapResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	from := sdk.MustAccAddressFromBech32(req.From)
+	if err := s.keeper.Unwrap(ctx, req.ContractId, from, req.Amount); err != nil {
+		return nil, err
+	}
+
+	return &token.MsgUnwrapResponse{}, nil
+}
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/Finschia/finschia-sdk/testutil"
@@ -8,6 +10,7 @@ import (
 	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 	"github.com/Finschia/finschia-sdk/x/token"
 	"github.com/Finschia/finschia-sdk/x/token/class"
+	"github.com/Finschia/finschia-sdk/x/token/keeper"
 )
 
 func (s *KeeperTestSuite) TestMsgSend() {
@@ -67,6 +70,158 @@ func (s *KeeperTestSuite) TestMsgSend() {
 	}
 }
 
+func (s *KeeperTestSuite) TestMsgMultiSend() {
+	sentEvent := func(to sdk.AccAddress, amount sdk.Int) sdk.Event {
+		return sdk.Event{
+			Type: "lbm.token.v1.EventSent",
+			Attributes: []abci.EventAttribute{
+				{Key: []byte("amount"), Value: testutil.W(amount), Index: false},
+				{Key: []byte("contract_id"), Value: testutil.W(s.contractID), Index: false},
+				{Key: []byte("from"), Value: testutil.W(s.vendor), Index: false},
+				{Key: []byte("operator"), Value: testutil.W(s.vendor), Index: false},
+				{Key: []byte("to"), Value: testutil.W(to), Index: false},
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		contractID string
+		outputs    []token.Output
+		maxOutputs uint32
+		err        error
+		events     sdk.Events
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			outputs: []token.Output{
+				{To: s.customer.String(), Amount: sdk.OneInt()},
+				{To: s.stranger.String(), Amount: s.balance.Sub(sdk.OneInt())},
+			},
+			maxOutputs: 2,
+			events: sdk.Events{
+				sentEvent(s.customer, sdk.OneInt()),
+				sentEvent(s.stranger, s.balance.Sub(sdk.OneInt())),
+			},
+		},
+		"contract not found": {
+			contractID: "fee1dead",
+			outputs: []token.Output{
+				{To: s.customer.String(), Amount: sdk.OneInt()},
+			},
+			maxOutputs: 2,
+			err:        class.ErrContractNotExist,
+		},
+		"too many outputs": {
+			contractID: s.contractID,
+			outputs: []token.Output{
+				{To: s.customer.String(), Amount: sdk.OneInt()},
+				{To: s.stranger.String(), Amount: sdk.OneInt()},
+			},
+			maxOutputs: 1,
+			err:        sdkerrors.ErrInvalidRequest,
+		},
+		"insufficient funds": {
+			contractID: s.contractID,
+			outputs: []token.Output{
+				{To: s.customer.String(), Amount: s.balance},
+				{To: s.stranger.String(), Amount: sdk.OneInt()},
+			},
+			maxOutputs: 2,
+			err:        token.ErrInsufficientBalance,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			params := token.DefaultParams()
+			params.MaxMultiSendOutputs = tc.maxOutputs
+			s.keeper.SetParams(ctx, params)
+
+			req := &token.MsgMultiSend{
+				ContractId: tc.contractID,
+				From:       s.vendor.String(),
+				Outputs:    tc.outputs,
+			}
+			gasBefore := ctx.GasMeter().GasConsumed()
+			res, err := s.msgServer.MultiSend(sdk.WrapSDKContext(ctx), req)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().NotNil(res)
+			s.Require().Equal(tc.events, ctx.EventManager().Events())
+			s.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed()-gasBefore, uint64(len(tc.outputs))*keeper.GasCostMultiSendOutput)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgSendVested() {
+	schedule := token.VestingSchedule{
+		StartTime: 1000,
+		EndTime:   2000,
+	}
+
+	testCases := map[string]struct {
+		contractID string
+		amount     sdk.Int
+		err        error
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			amount:     s.balance,
+		},
+		"contract not found": {
+			contractID: "fee1dead",
+			amount:     sdk.OneInt(),
+			err:        class.ErrContractNotExist,
+		},
+		"insufficient funds": {
+			contractID: s.contractID,
+			amount:     s.balance.Add(sdk.OneInt()),
+			err:        token.ErrInsufficientBalance,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+
+			req := &token.MsgSendVested{
+				ContractId: tc.contractID,
+				From:       s.vendor.String(),
+				To:         s.stranger.String(),
+				Amount:     tc.amount,
+				Schedule:   schedule,
+			}
+			res, err := s.msgServer.SendVested(sdk.WrapSDKContext(ctx), req)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+			s.Require().NotNil(res)
+
+			events := ctx.EventManager().Events()
+			s.Require().Len(events, 2)
+			s.Require().Equal("lbm.token.v1.EventSent", events[0].Type)
+
+			msg, err := sdk.ParseTypedEvent(abci.Event(events[1]))
+			s.Require().NoError(err)
+			event, ok := msg.(*token.EventVestingScheduled)
+			s.Require().True(ok)
+			s.Require().Equal(tc.contractID, event.ContractId)
+			s.Require().Equal(s.vendor.String(), event.Operator)
+			s.Require().Equal(s.stranger.String(), event.Holder)
+			s.Require().Equal(tc.amount, event.Amount)
+			s.Require().Equal(schedule.String(), event.Schedule.String())
+
+			s.Require().True(tc.amount.Equal(s.keeper.GetLocked(ctx, tc.contractID, s.stranger)))
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestMsgOperatorSend() {
 	testCases := map[string]struct {
 		contractID string
@@ -289,6 +444,24 @@ func (s *KeeperTestSuite) TestMsgIssue() {
 						{Key: []uint8("permission"), Value: testutil.W("PERMISSION_MODIFY"), Index: false},
 					},
 				},
+				sdk.Event{
+					Type: "lbm.token.v1.EventGranted",
+					Attributes: []abci.EventAttribute{
+						{Key: []uint8("contract_id"), Value: testutil.W("ca8bfd79"), Index: false},
+						{Key: []uint8("grantee"), Value: testutil.W(s.vendor), Index: false},
+						{Key: []uint8("granter"), Value: testutil.W(""), Index: false},
+						{Key: []uint8("permission"), Value: testutil.W("PERMISSION_PAUSE"), Index: false},
+					},
+				},
+				sdk.Event{
+					Type: "lbm.token.v1.EventGranted",
+					Attributes: []abci.EventAttribute{
+						{Key: []uint8("contract_id"), Value: testutil.W("ca8bfd79"), Index: false},
+						{Key: []uint8("grantee"), Value: testutil.W(s.vendor), Index: false},
+						{Key: []uint8("granter"), Value: testutil.W(""), Index: false},
+						{Key: []uint8("permission"), Value: testutil.W("PERMISSION_FREEZE"), Index: false},
+					},
+				},
 				sdk.Event{
 					Type: "lbm.token.v1.EventGranted",
 					Attributes: []abci.EventAttribute{
@@ -344,6 +517,24 @@ func (s *KeeperTestSuite) TestMsgIssue() {
 						{Key: []uint8("permission"), Value: testutil.W("PERMISSION_MODIFY"), Index: false},
 					},
 				},
+				sdk.Event{
+					Type: "lbm.token.v1.EventGranted",
+					Attributes: []abci.EventAttribute{
+						{Key: []uint8("contract_id"), Value: testutil.W("ca8bfd79"), Index: false},
+						{Key: []uint8("grantee"), Value: testutil.W(s.vendor), Index: false},
+						{Key: []uint8("granter"), Value: testutil.W(""), Index: false},
+						{Key: []uint8("permission"), Value: testutil.W("PERMISSION_PAUSE"), Index: false},
+					},
+				},
+				sdk.Event{
+					Type: "lbm.token.v1.EventGranted",
+					Attributes: []abci.EventAttribute{
+						{Key: []uint8("contract_id"), Value: testutil.W("ca8bfd79"), Index: false},
+						{Key: []uint8("grantee"), Value: testutil.W(s.vendor), Index: false},
+						{Key: []uint8("granter"), Value: testutil.W(""), Index: false},
+						{Key: []uint8("permission"), Value: testutil.W("PERMISSION_FREEZE"), Index: false},
+					},
+				},
 				sdk.Event{
 					Type: "lbm.token.v1.EventMinted",
 					Attributes: []abci.EventAttribute{
@@ -691,6 +882,71 @@ func (s *KeeperTestSuite) TestMsgRevokePermission() {
 	}
 }
 
+func (s *KeeperTestSuite) TestMsgMintVested() {
+	schedule := token.VestingSchedule{
+		StartTime: 1000,
+		EndTime:   2000,
+	}
+
+	testCases := map[string]struct {
+		contractID string
+		amount     sdk.Int
+		err        error
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			amount:     s.balance,
+		},
+		"contract not found": {
+			contractID: "fee1dead",
+			amount:     sdk.OneInt(),
+			err:        class.ErrContractNotExist,
+		},
+		"no permission": {
+			contractID: s.unmintableContractId,
+			amount:     sdk.OneInt(),
+			err:        token.ErrTokenNoPermission,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+
+			req := &token.MsgMintVested{
+				ContractId: tc.contractID,
+				From:       s.vendor.String(),
+				To:         s.stranger.String(),
+				Amount:     tc.amount,
+				Schedule:   schedule,
+			}
+			res, err := s.msgServer.MintVested(sdk.WrapSDKContext(ctx), req)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+			s.Require().NotNil(res)
+
+			events := ctx.EventManager().Events()
+			s.Require().Len(events, 2)
+			s.Require().Equal("lbm.token.v1.EventMinted", events[0].Type)
+
+			msg, err := sdk.ParseTypedEvent(abci.Event(events[1]))
+			s.Require().NoError(err)
+			event, ok := msg.(*token.EventVestingScheduled)
+			s.Require().True(ok)
+			s.Require().Equal(tc.contractID, event.ContractId)
+			s.Require().Equal(s.vendor.String(), event.Operator)
+			s.Require().Equal(s.stranger.String(), event.Holder)
+			s.Require().Equal(tc.amount, event.Amount)
+			s.Require().Equal(schedule.String(), event.Schedule.String())
+
+			s.Require().True(tc.amount.Equal(s.keeper.GetLocked(ctx, tc.contractID, s.stranger)))
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestMsgMint() {
 	testCases := map[string]struct {
 		isNegativeCase bool
@@ -1071,3 +1327,567 @@ func (s *KeeperTestSuite) TestMsgModify() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestMsgPause() {
+	testCases := map[string]struct {
+		isNegativeCase bool
+		req            *token.MsgPause
+		expectedEvents sdk.Events
+		expectedError  *sdkerrors.Error
+	}{
+		"pause(contractID, from)": {
+			req: &token.MsgPause{
+				ContractId: s.contractID,
+				From:       s.vendor.String(),
+			},
+			expectedEvents: sdk.Events{
+				sdk.Event{
+					Type: "lbm.token.v1.EventPaused",
+					Attributes: []abci.EventAttribute{
+						{Key: []byte("contract_id"), Value: testutil.W(s.contractID), Index: false},
+						{Key: []byte("operator"), Value: testutil.W(s.vendor), Index: false},
+					},
+				},
+			},
+		},
+		"pause(nonExistingContractId, from) -> error": {
+			isNegativeCase: true,
+			req: &token.MsgPause{
+				ContractId: "fee1dead",
+				From:       s.vendor.String(),
+			},
+			expectedError: class.ErrContractNotExist,
+		},
+		"pause(contractID, unauthorized account) -> error": {
+			isNegativeCase: true,
+			req: &token.MsgPause{
+				ContractId: s.contractID,
+				From:       s.stranger.String(),
+			},
+			expectedError: token.ErrTokenNoPermission,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			// Arrange
+			ctx, _ := s.ctx.CacheContext()
+			s.Require().NoError(tc.req.ValidateBasic())
+
+			// Act
+			res, err := s.msgServer.Pause(sdk.WrapSDKContext(ctx), tc.req)
+			if tc.isNegativeCase {
+				s.Require().Nil(res)
+				s.Require().ErrorIs(err, tc.expectedError)
+				s.Require().Equal(0, len(ctx.EventManager().Events()))
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+
+			// Assert
+			events := ctx.EventManager().Events()
+			s.Require().Equal(tc.expectedEvents, events)
+			s.Require().True(s.keeper.IsPaused(ctx, tc.req.ContractId))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgUnpause() {
+	testCases := map[string]struct {
+		isNegativeCase bool
+		req            *token.MsgUnpause
+		expectedEvents sdk.Events
+		expectedError  *sdkerrors.Error
+	}{
+		"unpause(contractID, from)": {
+			req: &token.MsgUnpause{
+				ContractId: s.contractID,
+				From:       s.vendor.String(),
+			},
+			expectedEvents: sdk.Events{
+				sdk.Event{
+					Type: "lbm.token.v1.EventUnpaused",
+					Attributes: []abci.EventAttribute{
+						{Key: []byte("contract_id"), Value: testutil.W(s.contractID), Index: false},
+						{Key: []byte("operator"), Value: testutil.W(s.vendor), Index: false},
+					},
+				},
+			},
+		},
+		"unpause(nonExistingContractId, from) -> error": {
+			isNegativeCase: true,
+			req: &token.MsgUnpause{
+				ContractId: "fee1dead",
+				From:       s.vendor.String(),
+			},
+			expectedError: class.ErrContractNotExist,
+		},
+		"unpause(contractID, unauthorized account) -> error": {
+			isNegativeCase: true,
+			req: &token.MsgUnpause{
+				ContractId: s.contractID,
+				From:       s.stranger.String(),
+			},
+			expectedError: token.ErrTokenNoPermission,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			// Arrange
+			ctx, _ := s.ctx.CacheContext()
+			s.Require().NoError(tc.req.ValidateBasic())
+			err := s.keeper.Pause(ctx, s.contractID, s.vendor)
+			s.Require().NoError(err)
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+			// Act
+			res, err := s.msgServer.Unpause(sdk.WrapSDKContext(ctx), tc.req)
+			if tc.isNegativeCase {
+				s.Require().Nil(res)
+				s.Require().ErrorIs(err, tc.expectedError)
+				s.Require().Equal(0, len(ctx.EventManager().Events()))
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+
+			// Assert
+			events := ctx.EventManager().Events()
+			s.Require().Equal(tc.expectedEvents, events)
+			s.Require().False(s.keeper.IsPaused(ctx, tc.req.ContractId))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgFreeze() {
+	testCases := map[string]struct {
+		isNegativeCase bool
+		req            *token.MsgFreeze
+		expectedEvents sdk.Events
+		expectedError  *sdkerrors.Error
+	}{
+		"freeze(contractID, from, account)": {
+			req: &token.MsgFreeze{
+				ContractId: s.contractID,
+				From:       s.vendor.String(),
+				Account:    s.customer.String(),
+			},
+			expectedEvents: sdk.Events{
+				sdk.Event{
+					Type: "lbm.token.v1.EventFrozen",
+					Attributes: []abci.EventAttribute{
+						{Key: []byte("account"), Value: testutil.W(s.customer), Index: false},
+						{Key: []byte("contract_id"), Value: testutil.W(s.contractID), Index: false},
+						{Key: []byte("operator"), Value: testutil.W(s.vendor), Index: false},
+					},
+				},
+			},
+		},
+		"freeze(nonExistingContractId, from, account) -> error": {
+			isNegativeCase: true,
+			req: &token.MsgFreeze{
+				ContractId: "fee1dead",
+				From:       s.vendor.String(),
+				Account:    s.customer.String(),
+			},
+			expectedError: class.ErrContractNotExist,
+		},
+		"freeze(contractID, unauthorized account, account) -> error": {
+			isNegativeCase: true,
+			req: &token.MsgFreeze{
+				ContractId: s.contractID,
+				From:       s.stranger.String(),
+				Account:    s.customer.String(),
+			},
+			expectedError: token.ErrTokenNoPermission,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			// Arrange
+			ctx, _ := s.ctx.CacheContext()
+			s.Require().NoError(tc.req.ValidateBasic())
+
+			// Act
+			res, err := s.msgServer.Freeze(sdk.WrapSDKContext(ctx), tc.req)
+			if tc.isNegativeCase {
+				s.Require().Nil(res)
+				s.Require().ErrorIs(err, tc.expectedError)
+				s.Require().Equal(0, len(ctx.EventManager().Events()))
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+
+			// Assert
+			events := ctx.EventManager().Events()
+			s.Require().Equal(tc.expectedEvents, events)
+			s.Require().True(s.keeper.IsFrozen(ctx, tc.req.ContractId, s.customer))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgUnfreeze() {
+	testCases := map[string]struct {
+		isNegativeCase bool
+		req            *token.MsgUnfreeze
+		expectedEvents sdk.Events
+		expectedError  *sdkerrors.Error
+	}{
+		"unfreeze(contractID, from, account)": {
+			req: &token.MsgUnfreeze{
+				ContractId: s.contractID,
+				From:       s.vendor.String(),
+				Account:    s.customer.String(),
+			},
+			expectedEvents: sdk.Events{
+				sdk.Event{
+					Type: "lbm.token.v1.EventUnfrozen",
+					Attributes: []abci.EventAttribute{
+						{Key: []byte("account"), Value: testutil.W(s.customer), Index: false},
+						{Key: []byte("contract_id"), Value: testutil.W(s.contractID), Index: false},
+						{Key: []byte("operator"), Value: testutil.W(s.vendor), Index: false},
+					},
+				},
+			},
+		},
+		"unfreeze(nonExistingContractId, from, account) -> error": {
+			isNegativeCase: true,
+			req: &token.MsgUnfreeze{
+				ContractId: "fee1dead",
+				From:       s.vendor.String(),
+				Account:    s.customer.String(),
+			},
+			expectedError: class.ErrContractNotExist,
+		},
+		"unfreeze(contractID, unauthorized account, account) -> error": {
+			isNegativeCase: true,
+			req: &token.MsgUnfreeze{
+				ContractId: s.contractID,
+				From:       s.stranger.String(),
+				Account:    s.customer.String(),
+			},
+			expectedError: token.ErrTokenNoPermission,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			// Arrange
+			ctx, _ := s.ctx.CacheContext()
+			s.Require().NoError(tc.req.ValidateBasic())
+			err := s.keeper.Freeze(ctx, s.contractID, s.vendor, s.customer)
+			s.Require().NoError(err)
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+			// Act
+			res, err := s.msgServer.Unfreeze(sdk.WrapSDKContext(ctx), tc.req)
+			if tc.isNegativeCase {
+				s.Require().Nil(res)
+				s.Require().ErrorIs(err, tc.expectedError)
+				s.Require().Equal(0, len(ctx.EventManager().Events()))
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+
+			// Assert
+			events := ctx.EventManager().Events()
+			s.Require().Equal(tc.expectedEvents, events)
+			s.Require().False(s.keeper.IsFrozen(ctx, tc.req.ContractId, s.customer))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgApprove() {
+	testCases := map[string]struct {
+		isNegativeCase bool
+		req            *token.MsgApprove
+		expectedEvents sdk.Events
+		expectedError  *sdkerrors.Error
+	}{
+		"approve(contractID, owner, spender, amount)": {
+			req: &token.MsgApprove{
+				ContractId: s.contractID,
+				Owner:      s.customer.String(),
+				Spender:    s.stranger.String(),
+				Amount:     sdk.OneInt(),
+			},
+			expectedEvents: sdk.Events{
+				sdk.Event{
+					Type: "lbm.token.v1.EventApproved",
+					Attributes: []abci.EventAttribute{
+						{Key: []byte("amount"), Value: testutil.W(sdk.OneInt()), Index: false},
+						{Key: []byte("contract_id"), Value: testutil.W(s.contractID), Index: false},
+						{Key: []byte("owner"), Value: testutil.W(s.customer), Index: false},
+						{Key: []byte("spender"), Value: testutil.W(s.stranger), Index: false},
+					},
+				},
+			},
+		},
+		"approve(nonExistingContractId, owner, spender, amount) -> error": {
+			isNegativeCase: true,
+			req: &token.MsgApprove{
+				ContractId: "fee1dead",
+				Owner:      s.customer.String(),
+				Spender:    s.stranger.String(),
+				Amount:     sdk.OneInt(),
+			},
+			expectedError: class.ErrContractNotExist,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			// Arrange
+			ctx, _ := s.ctx.CacheContext()
+			s.Require().NoError(tc.req.ValidateBasic())
+
+			// Act
+			res, err := s.msgServer.Approve(sdk.WrapSDKContext(ctx), tc.req)
+			if tc.isNegativeCase {
+				s.Require().Nil(res)
+				s.Require().ErrorIs(err, tc.expectedError)
+				s.Require().Equal(0, len(ctx.EventManager().Events()))
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+
+			// Assert
+			events := ctx.EventManager().Events()
+			s.Require().Equal(tc.expectedEvents, events)
+			s.Require().Equal(tc.req.Amount, s.keeper.GetAllowance(ctx, tc.req.ContractId, s.customer, s.stranger))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgTransferFrom() {
+	testCases := map[string]struct {
+		isNegativeCase bool
+		req            *token.MsgTransferFrom
+		expectedEvents sdk.Events
+		expectedError  *sdkerrors.Error
+	}{
+		"transferFrom(contractID, spender, from, to, amount)": {
+			req: &token.MsgTransferFrom{
+				ContractId: s.contractID,
+				Spender:    s.stranger.String(),
+				From:       s.customer.String(),
+				To:         s.vendor.String(),
+				Amount:     sdk.OneInt(),
+			},
+			expectedEvents: sdk.Events{
+				sdk.Event{
+					Type: "lbm.token.v1.EventSent",
+					Attributes: []abci.EventAttribute{
+						{Key: []byte("amount"), Value: testutil.W(sdk.OneInt()), Index: false},
+						{Key: []byte("contract_id"), Value: testutil.W(s.contractID), Index: false},
+						{Key: []byte("from"), Value: testutil.W(s.customer), Index: false},
+						{Key: []byte("operator"), Value: testutil.W(s.stranger), Index: false},
+						{Key: []byte("to"), Value: testutil.W(s.vendor), Index: false},
+					},
+				},
+			},
+		},
+		"transferFrom(nonExistingContractId, spender, from, to, amount) -> error": {
+			isNegativeCase: true,
+			req: &token.MsgTransferFrom{
+				ContractId: "fee1dead",
+				Spender:    s.stranger.String(),
+				From:       s.customer.String(),
+				To:         s.vendor.String(),
+				Amount:     sdk.OneInt(),
+			},
+			expectedError: class.ErrContractNotExist,
+		},
+		"transferFrom(contractID, spender, from, to, amount exceeding the allowance) -> error": {
+			isNegativeCase: true,
+			req: &token.MsgTransferFrom{
+				ContractId: s.contractID,
+				Spender:    s.stranger.String(),
+				From:       s.customer.String(),
+				To:         s.vendor.String(),
+				Amount:     sdk.NewInt(2),
+			},
+			expectedError: token.ErrInsufficientAllowance,
+		},
+		"transferFrom(contractID, operator without allowance, from, to, amount) -> error": {
+			isNegativeCase: true,
+			req: &token.MsgTransferFrom{
+				ContractId: s.contractID,
+				Spender:    s.operator.String(),
+				From:       s.customer.String(),
+				To:         s.vendor.String(),
+				Amount:     sdk.OneInt(),
+			},
+			expectedError: token.ErrInsufficientAllowance,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			// Arrange
+			ctx, _ := s.ctx.CacheContext()
+			s.Require().NoError(tc.req.ValidateBasic())
+			s.keeper.Approve(ctx, s.contractID, s.customer, s.stranger, sdk.OneInt())
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+			// Act
+			res, err := s.msgServer.TransferFrom(sdk.WrapSDKContext(ctx), tc.req)
+			if tc.isNegativeCase {
+				s.Require().Nil(res)
+				s.Require().ErrorIs(err, tc.expectedError)
+				s.Require().Equal(0, len(ctx.EventManager().Events()))
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+
+			// Assert
+			events := ctx.EventManager().Events()
+			s.Require().Equal(tc.expectedEvents, events)
+			s.Require().True(s.keeper.GetAllowance(ctx, s.contractID, s.customer, s.stranger).IsZero())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgWrap() {
+	testCases := map[string]struct {
+		isNegativeCase bool
+		req            *token.MsgWrap
+		expectedEvent  sdk.Event
+		expectedError  *sdkerrors.Error
+	}{
+		"wrap(contractID, from, amount)": {
+			req: &token.MsgWrap{
+				ContractId: s.contractID,
+				From:       s.customer.String(),
+				Amount:     s.balance,
+			},
+			expectedEvent: sdk.Event{
+				Type: "lbm.token.v1.EventWrapped",
+				Attributes: []abci.EventAttribute{
+					{Key: []byte("amount"), Value: testutil.W(s.balance), Index: false},
+					{Key: []byte("contract_id"), Value: testutil.W(s.contractID), Index: false},
+					{Key: []byte("from"), Value: testutil.W(s.customer), Index: false},
+				},
+			},
+		},
+		"wrap(nonExistingContractId, from, amount) -> error": {
+			isNegativeCase: true,
+			req: &token.MsgWrap{
+				ContractId: "fee1dead",
+				From:       s.customer.String(),
+				Amount:     s.balance,
+			},
+			expectedError: class.ErrContractNotExist,
+		},
+		"wrap(contractID, from, amount exceeding the balance) -> error": {
+			isNegativeCase: true,
+			req: &token.MsgWrap{
+				ContractId: s.contractID,
+				From:       s.customer.String(),
+				Amount:     s.balance.Add(sdk.OneInt()),
+			},
+			expectedError: token.ErrInsufficientBalance,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			// Arrange
+			ctx, _ := s.ctx.CacheContext()
+			s.Require().NoError(tc.req.ValidateBasic())
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+			// Act
+			res, err := s.msgServer.Wrap(sdk.WrapSDKContext(ctx), tc.req)
+			if tc.isNegativeCase {
+				s.Require().Nil(res)
+				s.Require().ErrorIs(err, tc.expectedError)
+				s.Require().Equal(0, len(ctx.EventManager().Events()))
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+
+			// Assert
+			events := ctx.EventManager().Events()
+			s.Require().Equal(tc.expectedEvent, events[len(events)-1])
+			s.Require().Equal(tc.req.Amount, s.bankKeeper.GetSupply(ctx, token.WrappedDenom(s.contractID)).Amount)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgUnwrap() {
+	testCases := map[string]struct {
+		isNegativeCase bool
+		req            *token.MsgUnwrap
+		expectedEvent  sdk.Event
+		expectedError  *sdkerrors.Error
+	}{
+		"unwrap(contractID, from, amount)": {
+			req: &token.MsgUnwrap{
+				ContractId: s.contractID,
+				From:       s.customer.String(),
+				Amount:     s.balance,
+			},
+			expectedEvent: sdk.Event{
+				Type: "lbm.token.v1.EventUnwrapped",
+				Attributes: []abci.EventAttribute{
+					{Key: []byte("amount"), Value: testutil.W(s.balance), Index: false},
+					{Key: []byte("contract_id"), Value: testutil.W(s.contractID), Index: false},
+					{Key: []byte("from"), Value: testutil.W(s.customer), Index: false},
+				},
+			},
+		},
+		"unwrap(nonExistingContractId, from, amount) -> error": {
+			isNegativeCase: true,
+			req: &token.MsgUnwrap{
+				ContractId: "fee1dead",
+				From:       s.customer.String(),
+				Amount:     s.balance,
+			},
+			expectedError: class.ErrContractNotExist,
+		},
+		"unwrap(contractID, from, amount exceeding the wrapped coins) -> error": {
+			isNegativeCase: true,
+			req: &token.MsgUnwrap{
+				ContractId: s.contractID,
+				From:       s.customer.String(),
+				Amount:     s.balance.Add(sdk.OneInt()),
+			},
+			expectedError: sdkerrors.ErrInsufficientFunds,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			// Arrange
+			ctx, _ := s.ctx.CacheContext()
+			s.Require().NoError(tc.req.ValidateBasic())
+			err := s.keeper.Wrap(ctx, s.contractID, s.customer, s.balance)
+			s.Require().NoError(err)
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+			// Act
+			res, err := s.msgServer.Unwrap(sdk.WrapSDKContext(ctx), tc.req)
+			if tc.isNegativeCase {
+				s.Require().Nil(res)
+				s.Require().ErrorIs(err, tc.expectedError)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+
+			// Assert
+			events := ctx.EventManager().Events()
+			s.Require().Equal(tc.expectedEvent, events[len(events)-1])
+			s.Require().Equal(s.balance, s.keeper.GetBalance(ctx, s.contractID, s.customer))
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package keeper
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/token"
+)
+
+func (k Keeper) GetParams(ctx sdk.Context) token.Params {
+	var params token.Params
+	k.paramSpace.GetParamSet(ctx, &params)
+
+	return params
+}
+
+func (k Keeper) SetParams(ctx sdk.Context, params token.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
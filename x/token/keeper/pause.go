@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/token"
+)
+
+func (k Keeper) Pause(ctx sdk.Context, contractID string, grantee sdk.AccAddress) error {
+	if _, err := k.GetGrant(ctx, contractID, grantee, token.PermissionPause); err != nil {
+		return token.ErrTokenNoPermission.Wrap(err.Error())
+	}
+
+	if k.IsPaused(ctx, contractID) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("contract %s is already paused", contractID)
+	}
+	k.setPaused(ctx, contractID, true)
+
+	event := token.EventPaused{
+		ContractId: contractID,
+		Operator:   grantee.String(),
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+	return nil
+}
+
+func (k Keeper) Unpause(ctx sdk.Context, contractID string, grantee sdk.AccAddress) error {
+	if _, err := k.GetGrant(ctx, contractID, grantee, token.PermissionPause); err != nil {
+		return token.ErrTokenNoPermission.Wrap(err.Error())
+	}
+
+	if !k.IsPaused(ctx, contractID) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("contract %s is not paused", contractID)
+	}
+	k.setPaused(ctx, contractID, false)
+
+	event := token.EventUnpaused{
+		ContractId: contractID,
+		Operator:   grantee.String(),
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+	return nil
+}
+
+func (k Keeper) IsPaused(ctx sdk.Context, contractID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(pausedKey(contractID))
+}
+
+func (k Keeper) setPaused(ctx sdk.Context, contractID string, paused bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := pausedKey(contractID)
+	if paused {
+		store.Set(key, []byte{})
+	} else {
+		store.Delete(key)
+	}
+}
+
+// validateNotPaused returns an error if the contract is paused.
+// It must be called by all the operations changing the balances.
+func (k Keeper) validateNotPaused(ctx sdk.Context, contractID string) error {
+	if k.IsPaused(ctx, contractID) {
+		return token.ErrContractPaused.Wrap(contractID)
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/token"
+)
+
+func (s *KeeperTestSuite) TestPause() {
+	testCases := map[string]struct {
+		grantee sdk.AccAddress
+		paused  bool
+		err     error
+	}{
+		"valid request": {
+			grantee: s.vendor,
+		},
+		"no permission": {
+			grantee: s.operator,
+			err:     token.ErrTokenNoPermission,
+		},
+		"already paused": {
+			grantee: s.vendor,
+			paused:  true,
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			if tc.paused {
+				err := s.keeper.Pause(ctx, s.contractID, s.vendor)
+				s.Require().NoError(err)
+			}
+
+			err := s.keeper.Pause(ctx, s.contractID, tc.grantee)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().True(s.keeper.IsPaused(ctx, s.contractID))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestUnpause() {
+	testCases := map[string]struct {
+		grantee sdk.AccAddress
+		paused  bool
+		err     error
+	}{
+		"valid request": {
+			grantee: s.vendor,
+			paused:  true,
+		},
+		"no permission": {
+			grantee: s.operator,
+			paused:  true,
+			err:     token.ErrTokenNoPermission,
+		},
+		"not paused": {
+			grantee: s.vendor,
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			if tc.paused {
+				err := s.keeper.Pause(ctx, s.contractID, s.vendor)
+				s.Require().NoError(err)
+			}
+
+			err := s.keeper.Unpause(ctx, s.contractID, tc.grantee)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().False(s.keeper.IsPaused(ctx, s.contractID))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestPausedContract() {
+	testCases := map[string]func(ctx sdk.Context) error{
+		"send": func(ctx sdk.Context) error {
+			return s.keeper.Send(ctx, s.contractID, s.vendor, s.customer, sdk.OneInt())
+		},
+		"mint": func(ctx sdk.Context) error {
+			return s.keeper.Mint(ctx, s.contractID, s.vendor, s.customer, sdk.OneInt())
+		},
+		"burn": func(ctx sdk.Context) error {
+			return s.keeper.Burn(ctx, s.contractID, s.vendor, sdk.OneInt())
+		},
+		"operator burn": func(ctx sdk.Context) error {
+			return s.keeper.OperatorBurn(ctx, s.contractID, s.operator, s.customer, sdk.OneInt())
+		},
+	}
+
+	for name, operation := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			err := s.keeper.Pause(ctx, s.contractID, s.vendor)
+			s.Require().NoError(err)
+			s.Require().ErrorIs(operation(ctx), token.ErrContractPaused)
+
+			// other contracts are not affected
+			err = s.keeper.Send(ctx, s.unmintableContractId, s.vendor, s.customer, sdk.OneInt())
+			s.Require().NoError(err)
+
+			err = s.keeper.Unpause(ctx, s.contractID, s.vendor)
+			s.Require().NoError(err)
+			s.Require().NoError(operation(ctx))
+		})
+	}
+}
@@ -6,11 +6,46 @@ import (
 	"github.com/Finschia/finschia-sdk/x/token"
 )
 
+// GasCostMultiSendOutput is the gas consumed for each output of MsgMultiSend,
+// on top of the gas for the store accesses.
+const GasCostMultiSendOutput uint64 = 1000
+
+// MultiSend sends tokens from one account to each of the outputs.
+// The caller must revert all the changes if it fails.
+func (k Keeper) MultiSend(ctx sdk.Context, contractID string, from sdk.AccAddress, outputs []token.Output) error {
+	if limit := k.GetParams(ctx).MaxMultiSendOutputs; uint32(len(outputs)) > limit {
+		return sdkerrors.ErrInvalidRequest.Wrapf("number of outputs exceeds the limit: %d > %d", len(outputs), limit)
+	}
+
+	for _, output := range outputs {
+		ctx.GasMeter().ConsumeGas(GasCostMultiSendOutput, "multi-send output")
+
+		to := sdk.MustAccAddressFromBech32(output.To)
+		if err := k.Send(ctx, contractID, from, to, output.Amount); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (k Keeper) Send(ctx sdk.Context, contractID string, from, to sdk.AccAddress, amount sdk.Int) error {
 	if !amount.IsPositive() {
 		panic(sdkerrors.ErrInvalidRequest.Wrap("amount must be positive"))
 	}
 
+	if err := k.validateNotPaused(ctx, contractID); err != nil {
+		return err
+	}
+	if err := validateNotEscrow(to); err != nil {
+		return err
+	}
+	for _, addr := range []sdk.AccAddress{from, to} {
+		if err := k.validateNotFrozen(ctx, contractID, addr); err != nil {
+			return err
+		}
+	}
+
 	if err := k.subtractToken(ctx, contractID, from, amount); err != nil {
 		return err
 	}
@@ -72,6 +107,9 @@ func (k Keeper) subtractToken(ctx sdk.Context, contractID string, addr sdk.AccAd
 		// Daphne emits ErrInsufficientFunds here, which is against to the spec.
 		return token.ErrInsufficientBalance.Wrapf("%s is smaller than %s", balance, amount)
 	}
+	if locked := k.GetLocked(ctx, contractID, addr); newBalance.LT(locked) {
+		return token.ErrInsufficientBalance.Wrapf("%s is smaller than %s; %s locked by the vestings", balance, amount.Add(locked), locked)
+	}
 
 	k.setBalance(ctx, contractID, addr, newBalance)
 
@@ -102,13 +140,16 @@ func (k Keeper) GetBalance(ctx sdk.Context, contractID string, addr sdk.AccAddre
 func (k Keeper) setBalance(ctx sdk.Context, contractID string, addr sdk.AccAddress, balance sdk.Int) {
 	store := ctx.KVStore(k.storeKey)
 	key := balanceKey(contractID, addr)
+	indexKey := balanceByAddressKey(addr, contractID)
 	if balance.IsZero() {
 		store.Delete(key)
+		store.Delete(indexKey)
 	} else {
 		bz, err := balance.Marshal()
 		if err != nil {
 			panic(err)
 		}
 		store.Set(key, bz)
+		store.Set(indexKey, []byte{})
 	}
 }
@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/token"
+)
+
+func (s *KeeperTestSuite) TestExecTokenSendAuthorization() {
+	testCases := map[string]struct {
+		operator bool
+		msg      sdk.Msg
+		err      error
+		left     sdk.Int
+	}{
+		"send": {
+			msg: &token.MsgSend{
+				ContractId: s.contractID,
+				From:       s.customer.String(),
+				To:         s.stranger.String(),
+				Amount:     sdk.OneInt(),
+			},
+			left: s.balance.Sub(sdk.OneInt()),
+		},
+		"send all": {
+			msg: &token.MsgSend{
+				ContractId: s.contractID,
+				From:       s.customer.String(),
+				To:         s.stranger.String(),
+				Amount:     s.balance,
+			},
+		},
+		"operator send": {
+			operator: true,
+			msg: &token.MsgOperatorSend{
+				ContractId: s.contractID,
+				Operator:   s.customer.String(),
+				From:       s.vendor.String(),
+				To:         s.stranger.String(),
+				Amount:     sdk.OneInt(),
+			},
+			left: s.balance.Sub(sdk.OneInt()),
+		},
+		"recipient not allowed": {
+			msg: &token.MsgSend{
+				ContractId: s.contractID,
+				From:       s.customer.String(),
+				To:         s.vendor.String(),
+				Amount:     sdk.OneInt(),
+			},
+			err: sdkerrors.ErrUnauthorized,
+		},
+		"exceeding the limit": {
+			msg: &token.MsgSend{
+				ContractId: s.contractID,
+				From:       s.customer.String(),
+				To:         s.stranger.String(),
+				Amount:     s.balance.Add(sdk.OneInt()),
+			},
+			err: sdkerrors.ErrInsufficientFunds,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			if tc.operator {
+				err := s.keeper.AuthorizeOperator(ctx, s.contractID, s.vendor, s.customer)
+				s.Require().NoError(err)
+			}
+
+			// customer allows operator to send its tokens to stranger
+			authorization := token.NewTokenSendAuthorization(
+				[]token.SpendLimit{{ContractId: s.contractID, Amount: s.balance}},
+				[]sdk.AccAddress{s.stranger},
+				tc.operator,
+			)
+			err := s.authzKeeper.SaveGrant(ctx, s.operator, s.customer, authorization, ctx.BlockTime().Add(time.Hour))
+			s.Require().NoError(err)
+
+			_, err = s.authzKeeper.DispatchActions(ctx, s.operator, []sdk.Msg{tc.msg})
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			updated, _ := s.authzKeeper.GetCleanAuthorization(ctx, s.operator, s.customer, authorization.MsgTypeURL())
+			if tc.left.IsNil() {
+				s.Require().Equal(s.balance, s.keeper.GetBalance(ctx, s.contractID, s.stranger))
+				s.Require().Nil(updated)
+				return
+			}
+			s.Require().Equal(s.balance.Sub(tc.left), s.keeper.GetBalance(ctx, s.contractID, s.stranger))
+			s.Require().NotNil(updated)
+			limits := updated.(*token.TokenSendAuthorization).SpendLimits
+			s.Require().Len(limits, 1)
+			s.Require().True(tc.left.Equal(limits[0].Amount))
+		})
+	}
+}
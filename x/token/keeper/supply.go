@@ -25,6 +25,8 @@ func (k Keeper) Issue(ctx sdk.Context, class token.Contract, owner, to sdk.AccAd
 
 	permissions := []token.Permission{
 		token.PermissionModify,
+		token.PermissionPause,
+		token.PermissionFreeze,
 	}
 	if class.Mintable {
 		permissions = append(permissions,
@@ -106,6 +108,19 @@ func (k Keeper) mint(ctx sdk.Context, contractID string, grantee, to sdk.AccAddr
 		return token.ErrTokenNoPermission.Wrap(err.Error())
 	}
 
+	if err := k.validateNotPaused(ctx, contractID); err != nil {
+		return err
+	}
+	if err := validateNotEscrow(to); err != nil {
+		return err
+	}
+	if err := k.validateNotFrozen(ctx, contractID, to); err != nil {
+		return err
+	}
+	if err := k.validateMaxSupply(ctx, contractID, amount); err != nil {
+		return err
+	}
+
 	k.mintToken(ctx, contractID, to, amount)
 
 	return nil
@@ -186,6 +201,13 @@ func (k Keeper) operatorBurn(ctx sdk.Context, contractID string, operator, from
 }
 
 func (k Keeper) burnToken(ctx sdk.Context, contractID string, addr sdk.AccAddress, amount sdk.Int) error {
+	if err := k.validateNotPaused(ctx, contractID); err != nil {
+		return err
+	}
+	if err := k.validateNotFrozen(ctx, contractID, addr); err != nil {
+		return err
+	}
+
 	if err := k.subtractToken(ctx, contractID, addr, amount); err != nil {
 		return err
 	}
@@ -230,27 +252,27 @@ func (k Keeper) setStatistics(ctx sdk.Context, contractID string, amount sdk.Int
 }
 
 func (k Keeper) GetSupply(ctx sdk.Context, contractID string) sdk.Int {
-	return k.getStatistics(ctx, contractID, supplyKeyPrefix)
+	return k.getStatistics(ctx, contractID, SupplyKeyPrefix)
 }
 
 func (k Keeper) GetMinted(ctx sdk.Context, contractID string) sdk.Int {
-	return k.getStatistics(ctx, contractID, mintKeyPrefix)
+	return k.getStatistics(ctx, contractID, MintKeyPrefix)
 }
 
 func (k Keeper) GetBurnt(ctx sdk.Context, contractID string) sdk.Int {
-	return k.getStatistics(ctx, contractID, burnKeyPrefix)
+	return k.getStatistics(ctx, contractID, BurnKeyPrefix)
 }
 
 func (k Keeper) setSupply(ctx sdk.Context, contractID string, amount sdk.Int) {
-	k.setStatistics(ctx, contractID, amount, supplyKeyPrefix)
+	k.setStatistics(ctx, contractID, amount, SupplyKeyPrefix)
 }
 
 func (k Keeper) setMinted(ctx sdk.Context, contractID string, amount sdk.Int) {
-	k.setStatistics(ctx, contractID, amount, mintKeyPrefix)
+	k.setStatistics(ctx, contractID, amount, MintKeyPrefix)
 }
 
 func (k Keeper) setBurnt(ctx sdk.Context, contractID string, amount sdk.Int) {
-	k.setStatistics(ctx, contractID, amount, burnKeyPrefix)
+	k.setStatistics(ctx, contractID, amount, BurnKeyPrefix)
 }
 
 func (k Keeper) Modify(ctx sdk.Context, contractID string, grantee sdk.AccAddress, changes []token.Attribute) error {
@@ -275,20 +297,42 @@ func (k Keeper) modify(ctx sdk.Context, contractID string, changes []token.Attri
 		panic(err)
 	}
 
-	modifiers := map[token.AttributeKey]func(string){
-		token.AttributeKeyName: func(name string) {
+	modifiers := map[token.AttributeKey]func(string) error{
+		token.AttributeKeyName: func(name string) error {
 			class.Name = name
+			return nil
 		},
-		token.AttributeKeyURI: func(uri string) {
+		token.AttributeKeyURI: func(uri string) error {
 			class.Uri = uri
+			return nil
 		},
-		token.AttributeKeyMeta: func(meta string) {
+		token.AttributeKeyMeta: func(meta string) error {
 			class.Meta = meta
+			return nil
+		},
+		token.AttributeKeyMaxSupply: func(value string) error {
+			maxSupply, ok := sdk.NewIntFromString(value)
+			if !ok {
+				return token.ErrInvalidAmount.Wrapf("invalid max supply: %s", value)
+			}
+
+			// the cap can only be lowered
+			if hasMaxSupply(*class) && maxSupply.GT(class.MaxSupply) {
+				return token.ErrInvalidAmount.Wrapf("max supply cannot be raised: %s > %s", maxSupply, class.MaxSupply)
+			}
+			if supply := k.GetMinted(ctx, contractID).Sub(k.GetBurnt(ctx, contractID)); maxSupply.LT(supply) {
+				return token.ErrInvalidAmount.Wrapf("max supply cannot be lower than the current supply: %s < %s", maxSupply, supply)
+			}
+
+			class.MaxSupply = maxSupply
+			return nil
 		},
 	}
 	for _, change := range changes {
 		key := token.AttributeKeyFromString(change.Key)
-		modifiers[key](change.Value)
+		if err := modifiers[key](change.Value); err != nil {
+			return err
+		}
 	}
 
 	k.setClass(ctx, *class)
@@ -352,3 +396,38 @@ func (k Keeper) deleteGrant(ctx sdk.Context, contractID string, grantee sdk.AccA
 	key := grantKey(contractID, grantee, permission)
 	store.Delete(key)
 }
+
+func (k Keeper) GetMaxSupply(ctx sdk.Context, contractID string) (sdk.Int, error) {
+	class, err := k.GetClass(ctx, contractID)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	if !hasMaxSupply(*class) {
+		return sdk.ZeroInt(), nil
+	}
+	return class.MaxSupply, nil
+}
+
+// validateMaxSupply returns an error if minting the amount would exceed the max supply.
+func (k Keeper) validateMaxSupply(ctx sdk.Context, contractID string, amount sdk.Int) error {
+	maxSupply, err := k.GetMaxSupply(ctx, contractID)
+	if err != nil {
+		panic(err)
+	}
+	if maxSupply.IsZero() {
+		return nil
+	}
+
+	supply := k.GetMinted(ctx, contractID).Sub(k.GetBurnt(ctx, contractID))
+	if supply.Add(amount).GT(maxSupply) {
+		return token.ErrSupplyOverflow.Wrapf("%s + %s exceeds the max supply %s", supply, amount, maxSupply)
+	}
+	return nil
+}
+
+// hasMaxSupply returns whether the contract has a cap on its supply.
+// The contracts issued before the introduction of the cap have nil max supply.
+func hasMaxSupply(class token.Contract) bool {
+	return !class.MaxSupply.IsNil() && class.MaxSupply.IsPositive()
+}
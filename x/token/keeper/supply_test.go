@@ -152,3 +152,112 @@ func (s *KeeperTestSuite) TestModify() {
 	s.Require().Equal(changes[1].Value, class.Uri)
 	s.Require().Equal(changes[2].Value, class.Meta)
 }
+
+func (s *KeeperTestSuite) TestMaxSupply() {
+	testCases := map[string]struct {
+		maxSupply sdk.Int
+		amount    sdk.Int
+		err       error
+	}{
+		"no cap": {
+			amount: s.balance,
+		},
+		"within the cap": {
+			maxSupply: s.balance.Add(sdk.OneInt()),
+			amount:    sdk.OneInt(),
+		},
+		"exceeds the cap": {
+			maxSupply: s.balance.Add(sdk.OneInt()),
+			amount:    sdk.NewInt(2),
+			err:       token.ErrSupplyOverflow,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			class := token.Contract{
+				Name:      "Capped",
+				Symbol:    "CAP",
+				Mintable:  true,
+				MaxSupply: tc.maxSupply,
+			}
+			contractID := s.keeper.Issue(ctx, class, s.vendor, s.vendor, s.balance)
+
+			maxSupply, err := s.keeper.GetMaxSupply(ctx, contractID)
+			s.Require().NoError(err)
+			if tc.maxSupply.IsNil() {
+				s.Require().True(maxSupply.IsZero())
+			} else {
+				s.Require().Equal(tc.maxSupply, maxSupply)
+			}
+
+			err = s.keeper.Mint(ctx, contractID, s.vendor, s.customer, tc.amount)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			// burnt tokens make room for the new ones
+			err = s.keeper.Burn(ctx, contractID, s.vendor, tc.amount)
+			s.Require().NoError(err)
+			err = s.keeper.Mint(ctx, contractID, s.vendor, s.customer, tc.amount)
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestModifyMaxSupply() {
+	supply := s.keeper.GetMinted(s.ctx, s.contractID).Sub(s.keeper.GetBurnt(s.ctx, s.contractID))
+
+	testCases := map[string]struct {
+		maxSupply    sdk.Int
+		newMaxSupply sdk.Int
+		err          error
+	}{
+		"set a cap": {
+			newMaxSupply: supply.Add(sdk.OneInt()),
+		},
+		"lower the cap": {
+			maxSupply:    supply.Add(sdk.NewInt(2)),
+			newMaxSupply: supply.Add(sdk.OneInt()),
+		},
+		"lower the cap to the current supply": {
+			maxSupply:    supply.Add(sdk.OneInt()),
+			newMaxSupply: supply,
+		},
+		"raise the cap": {
+			maxSupply:    supply.Add(sdk.OneInt()),
+			newMaxSupply: supply.Add(sdk.NewInt(2)),
+			err:          token.ErrInvalidAmount,
+		},
+		"lower than the current supply": {
+			newMaxSupply: supply.Sub(sdk.OneInt()),
+			err:          token.ErrInvalidAmount,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			if !tc.maxSupply.IsNil() {
+				change := token.Attribute{Key: token.AttributeKeyMaxSupply.String(), Value: tc.maxSupply.String()}
+				err := s.keeper.Modify(ctx, s.contractID, s.vendor, []token.Attribute{change})
+				s.Require().NoError(err)
+			}
+
+			change := token.Attribute{Key: token.AttributeKeyMaxSupply.String(), Value: tc.newMaxSupply.String()}
+			err := s.keeper.Modify(ctx, s.contractID, s.vendor, []token.Attribute{change})
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			maxSupply, err := s.keeper.GetMaxSupply(ctx, s.contractID)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.newMaxSupply, maxSupply)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/token"
+)
+
+// MintVested mints tokens to the recipient, locking them by the vesting schedule.
+// The caller must validate the schedule.
+func (k Keeper) MintVested(ctx sdk.Context, contractID string, grantee, to sdk.AccAddress, amount sdk.Int, schedule token.VestingSchedule) error {
+	if err := k.Mint(ctx, contractID, grantee, to, amount); err != nil {
+		return err
+	}
+
+	return k.addVesting(ctx, contractID, to, amount, schedule)
+}
+
+// SendVested sends tokens to the recipient, locking them by the vesting schedule.
+// Only the minters may lock the tokens of the others, so no one could stuff
+// the vestings of a holder. The caller must validate the schedule.
+func (k Keeper) SendVested(ctx sdk.Context, contractID string, from, to sdk.AccAddress, amount sdk.Int, schedule token.VestingSchedule) error {
+	if _, err := k.GetGrant(ctx, contractID, from, token.PermissionMint); err != nil {
+		return token.ErrTokenNoPermission.Wrap(err.Error())
+	}
+
+	if err := k.Send(ctx, contractID, from, to, amount); err != nil {
+		return err
+	}
+
+	return k.addVesting(ctx, contractID, to, amount, schedule)
+}
+
+// GetLocked returns the amount of the tokens of the holder still locked by the vestings.
+func (k Keeper) GetLocked(ctx sdk.Context, contractID string, holder sdk.AccAddress) sdk.Int {
+	blockTime := ctx.BlockTime().Unix()
+
+	locked := sdk.ZeroInt()
+	k.iterateHolderVestings(ctx, contractID, holder, func(vesting token.Vesting) (stop bool) {
+		locked = locked.Add(vesting.LockedAt(blockTime))
+		return false
+	})
+
+	return locked
+}
+
+// GetSpendable returns the amount of the tokens of the holder which can be transferred.
+func (k Keeper) GetSpendable(ctx sdk.Context, contractID string, holder sdk.AccAddress) sdk.Int {
+	spendable := k.GetBalance(ctx, contractID, holder).Sub(k.GetLocked(ctx, contractID, holder))
+	if spendable.IsNegative() {
+		return sdk.ZeroInt()
+	}
+
+	return spendable
+}
+
+// addVesting attaches a new vesting to the holder, pruning the fully vested ones.
+// The number of the vestings of a holder is limited by the params, because
+// every transfer of the holder iterates over them.
+func (k Keeper) addVesting(ctx sdk.Context, contractID string, holder sdk.AccAddress, amount sdk.Int, schedule token.VestingSchedule) error {
+	store := ctx.KVStore(k.storeKey)
+	blockTime := ctx.BlockTime().Unix()
+
+	var lockedSeqs, vestedSeqs []uint64
+	values := map[uint64][]byte{}
+	iterator := sdk.KVStorePrefixIterator(store, vestingKeyPrefixByHolder(contractID, holder))
+	for ; iterator.Valid(); iterator.Next() {
+		var vesting token.Vesting
+		k.cdc.MustUnmarshal(iterator.Value(), &vesting)
+
+		_, _, seq := splitVestingKey(iterator.Key())
+		if vesting.LockedAt(blockTime).IsPositive() {
+			lockedSeqs = append(lockedSeqs, seq)
+			values[seq] = iterator.Value()
+		} else {
+			vestedSeqs = append(vestedSeqs, seq)
+		}
+	}
+	iterator.Close()
+
+	if limit := k.GetParams(ctx).MaxVestingsPerHolder; len(lockedSeqs) >= int(limit) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("%s already has %d vestings", holder, limit)
+	}
+
+	// keep the sequences contiguous, moving the last vestings into the holes
+	numLocked := uint64(len(lockedSeqs))
+	var movings []uint64
+	for _, seq := range lockedSeqs {
+		if seq >= numLocked {
+			movings = append(movings, seq)
+		}
+	}
+	for _, seq := range vestedSeqs {
+		if seq < numLocked && len(movings) != 0 {
+			moving := movings[0]
+			movings = movings[1:]
+
+			store.Set(vestingKey(contractID, holder, seq), values[moving])
+			store.Delete(vestingKey(contractID, holder, moving))
+			continue
+		}
+
+		store.Delete(vestingKey(contractID, holder, seq))
+	}
+
+	k.setVesting(ctx, contractID, holder, numLocked, token.Vesting{
+		Holder:         holder.String(),
+		OriginalAmount: amount,
+		Schedule:       schedule,
+	})
+
+	return nil
+}
+
+func (k Keeper) setVesting(ctx sdk.Context, contractID string, holder sdk.AccAddress, seq uint64, vesting token.Vesting) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := vesting.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(vestingKey(contractID, holder, seq), bz)
+}
@@ -0,0 +1,179 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/token"
+)
+
+func (s *KeeperTestSuite) TestSendVested() {
+	ctx, _ := s.ctx.CacheContext()
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+
+	schedule := token.VestingSchedule{
+		StartTime: 1000,
+		EndTime:   2000,
+	}
+	balance := s.keeper.GetBalance(ctx, s.contractID, s.stranger)
+	err := s.keeper.SendVested(ctx, s.contractID, s.vendor, s.stranger, s.balance, schedule)
+	s.Require().NoError(err)
+
+	s.Require().True(balance.Add(s.balance).Equal(s.keeper.GetBalance(ctx, s.contractID, s.stranger)))
+	s.Require().True(s.balance.Equal(s.keeper.GetLocked(ctx, s.contractID, s.stranger)))
+	s.Require().True(balance.Equal(s.keeper.GetSpendable(ctx, s.contractID, s.stranger)))
+
+	// only the minters can lock the tokens of the others
+	err = s.keeper.SendVested(ctx, s.contractID, s.customer, s.stranger, sdk.OneInt(), schedule)
+	s.Require().ErrorIs(err, token.ErrTokenNoPermission)
+
+	testCases := map[string]struct {
+		blockTime int64
+		locked    sdk.Int
+	}{
+		"at the start": {
+			blockTime: 1000,
+			locked:    s.balance,
+		},
+		"in the middle": {
+			blockTime: 1500,
+			locked:    s.balance.QuoRaw(2),
+		},
+		"at the end": {
+			blockTime: 2000,
+			locked:    sdk.ZeroInt(),
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := ctx.CacheContext()
+			ctx = ctx.WithBlockTime(time.Unix(tc.blockTime, 0))
+
+			s.Require().True(tc.locked.Equal(s.keeper.GetLocked(ctx, s.contractID, s.stranger)))
+
+			// the locked tokens cannot be sent
+			spendable := balance.Add(s.balance).Sub(tc.locked)
+			if spendable.IsPositive() {
+				err := s.keeper.Send(ctx, s.contractID, s.stranger, s.customer, spendable)
+				s.Require().NoError(err)
+			}
+			if tc.locked.IsPositive() {
+				err := s.keeper.Send(ctx, s.contractID, s.stranger, s.customer, sdk.OneInt())
+				s.Require().ErrorIs(err, token.ErrInsufficientBalance)
+
+				err = s.keeper.Wrap(ctx, s.contractID, s.stranger, sdk.OneInt())
+				s.Require().ErrorIs(err, token.ErrInsufficientBalance)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMintVested() {
+	schedule := token.VestingSchedule{
+		StartTime: 1000,
+		Periods: []token.VestingPeriod{
+			{Length: 100, Amount: sdk.OneInt()},
+			{Length: 100, Amount: s.balance.Sub(sdk.OneInt())},
+		},
+	}
+
+	testCases := map[string]struct {
+		grantee sdk.AccAddress
+		err     error
+	}{
+		"valid request": {
+			grantee: s.vendor,
+		},
+		"no permission": {
+			grantee: s.stranger,
+			err:     token.ErrTokenNoPermission,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+
+			balance := s.keeper.GetBalance(ctx, s.contractID, s.stranger)
+			err := s.keeper.MintVested(ctx, s.contractID, tc.grantee, s.stranger, s.balance, schedule)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().True(balance.Add(s.balance).Equal(s.keeper.GetBalance(ctx, s.contractID, s.stranger)))
+			s.Require().True(s.balance.Equal(s.keeper.GetLocked(ctx, s.contractID, s.stranger)))
+
+			// after the first period
+			ctx = ctx.WithBlockTime(time.Unix(1100, 0))
+			s.Require().True(s.balance.Sub(sdk.OneInt()).Equal(s.keeper.GetLocked(ctx, s.contractID, s.stranger)))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestPruneVestings() {
+	ctx, _ := s.ctx.CacheContext()
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+
+	for _, endTime := range []int64{1100, 1200} {
+		schedule := token.VestingSchedule{
+			StartTime: 1000,
+			EndTime:   endTime,
+		}
+		err := s.keeper.SendVested(ctx, s.contractID, s.vendor, s.stranger, sdk.OneInt(), schedule)
+		s.Require().NoError(err)
+	}
+
+	// the first vesting ends
+	ctx = ctx.WithBlockTime(time.Unix(1100, 0))
+	schedule := token.VestingSchedule{
+		StartTime: 1100,
+		EndTime:   1300,
+	}
+	err := s.keeper.SendVested(ctx, s.contractID, s.vendor, s.stranger, sdk.OneInt(), schedule)
+	s.Require().NoError(err)
+
+	res, err := s.queryServer.Vestings(sdk.WrapSDKContext(ctx), &token.QueryVestingsRequest{
+		ContractId: s.contractID,
+		Address:    s.stranger.String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Vestings, 2)
+	s.Require().Equal(int64(1200), res.Vestings[0].Schedule.EndTime)
+	s.Require().Equal(int64(1300), res.Vestings[1].Schedule.EndTime)
+}
+
+func (s *KeeperTestSuite) TestVestingLimit() {
+	ctx, _ := s.ctx.CacheContext()
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+
+	params := token.DefaultParams()
+	params.MaxVestingsPerHolder = 2
+	s.keeper.SetParams(ctx, params)
+
+	for _, endTime := range []int64{1100, 1200} {
+		schedule := token.VestingSchedule{
+			StartTime: 1000,
+			EndTime:   endTime,
+		}
+		err := s.keeper.MintVested(ctx, s.contractID, s.vendor, s.stranger, sdk.OneInt(), schedule)
+		s.Require().NoError(err)
+	}
+
+	schedule := token.VestingSchedule{
+		StartTime: 1000,
+		EndTime:   1300,
+	}
+	err := s.keeper.SendVested(ctx, s.contractID, s.vendor, s.stranger, sdk.OneInt(), schedule)
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	err = s.keeper.MintVested(ctx, s.contractID, s.vendor, s.stranger, sdk.OneInt(), schedule)
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// the fully vested ones do not count
+	ctx = ctx.WithBlockTime(time.Unix(1100, 0))
+	err = s.keeper.SendVested(ctx, s.contractID, s.vendor, s.stranger, sdk.OneInt(), schedule)
+	s.Require().NoError(err)
+}
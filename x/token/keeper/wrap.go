@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
+	banktypes "github.com/Finschia/finschia-sdk/x/bank/types"
+	"github.com/Finschia/finschia-sdk/x/token"
+)
+
+// Wrap escrows the tokens of the holder, minting the same amount of the wrapped coins to the holder.
+// The wrapped coins are out of the reach of Pause and Freeze, as x/bank transfers them.
+func (k Keeper) Wrap(ctx sdk.Context, contractID string, from sdk.AccAddress, amount sdk.Int) error {
+	if err := k.validateNotPaused(ctx, contractID); err != nil {
+		return err
+	}
+	if err := k.validateNotFrozen(ctx, contractID, from); err != nil {
+		return err
+	}
+
+	if err := k.subtractToken(ctx, contractID, from, amount); err != nil {
+		return err
+	}
+	k.addToken(ctx, contractID, escrowAddress(), amount)
+
+	if err := k.setWrappedDenomMetadata(ctx, contractID); err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(token.WrappedDenom(contractID), amount))
+	if err := k.bankKeeper.MintCoins(ctx, token.ModuleName, coins); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, token.ModuleName, from, coins); err != nil {
+		return err
+	}
+
+	event := token.EventWrapped{
+		ContractId: contractID,
+		From:       from.String(),
+		Amount:     amount,
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+
+	return nil
+}
+
+// Unwrap burns the wrapped coins of the holder, releasing the same amount of the escrowed tokens to the holder.
+func (k Keeper) Unwrap(ctx sdk.Context, contractID string, from sdk.AccAddress, amount sdk.Int) error {
+	if err := k.validateNotPaused(ctx, contractID); err != nil {
+		return err
+	}
+	if err := k.validateNotFrozen(ctx, contractID, from); err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(token.WrappedDenom(contractID), amount))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, from, token.ModuleName, coins); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, token.ModuleName, coins); err != nil {
+		return err
+	}
+
+	if err := k.subtractToken(ctx, contractID, escrowAddress(), amount); err != nil {
+		panic(err)
+	}
+	k.addToken(ctx, contractID, from, amount)
+
+	event := token.EventUnwrapped{
+		ContractId: contractID,
+		From:       from.String(),
+		Amount:     amount,
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+
+	return nil
+}
+
+// setWrappedDenomMetadata sets the metadata of the wrapped coins from the contract, if not exists.
+func (k Keeper) setWrappedDenomMetadata(ctx sdk.Context, contractID string) error {
+	denom := token.WrappedDenom(contractID)
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, denom); found {
+		return nil
+	}
+
+	class, err := k.GetClass(ctx, contractID)
+	if err != nil {
+		return err
+	}
+
+	metadata := wrappedDenomMetadata(*class)
+	if err := metadata.Validate(); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid metadata of the wrapped coins: %s", err)
+	}
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+
+	return nil
+}
+
+// wrappedDenomMetadata returns the metadata of the wrapped coins from the
+// contract. The display unit is namespaced by the base denom, so it would not
+// clash with the ones of the other contracts or the native coins.
+func wrappedDenomMetadata(class token.Contract) banktypes.Metadata {
+	base := token.WrappedDenom(class.Id)
+	units := []*banktypes.DenomUnit{
+		{Denom: base, Exponent: 0},
+	}
+	display := base
+	if class.Decimals > 0 {
+		display = base + "/" + strings.ToLower(class.Symbol)
+		units = append(units, &banktypes.DenomUnit{
+			Denom:    display,
+			Exponent: uint32(class.Decimals),
+		})
+	}
+
+	return banktypes.Metadata{
+		Description: class.Name,
+		DenomUnits:  units,
+		Base:        base,
+		Display:     display,
+		Name:        class.Name,
+		Symbol:      class.Symbol,
+	}
+}
+
+// escrowAddress returns the address holding the tokens wrapped into coins.
+func escrowAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(token.ModuleName)
+}
+
+func validateNotEscrow(addr sdk.AccAddress) error {
+	if addr.Equals(escrowAddress()) {
+		return sdkerrors.ErrUnauthorized.Wrapf("%s is not allowed to receive tokens", addr)
+	}
+
+	return nil
+}
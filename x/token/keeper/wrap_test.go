@@ -0,0 +1,170 @@
+package keeper_test
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
+	"github.com/Finschia/finschia-sdk/x/token"
+)
+
+func (s *KeeperTestSuite) TestWrap() {
+	testCases := map[string]struct {
+		paused bool
+		frozen bool
+		amount sdk.Int
+		err    error
+	}{
+		"valid request": {
+			amount: s.balance,
+		},
+		"insufficient balance": {
+			amount: s.balance.Add(sdk.OneInt()),
+			err:    token.ErrInsufficientBalance,
+		},
+		"contract paused": {
+			paused: true,
+			amount: s.balance,
+			err:    token.ErrContractPaused,
+		},
+		"account frozen": {
+			frozen: true,
+			amount: s.balance,
+			err:    token.ErrAccountFrozen,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			if tc.paused {
+				err := s.keeper.Pause(ctx, s.contractID, s.vendor)
+				s.Require().NoError(err)
+			}
+			if tc.frozen {
+				err := s.keeper.Freeze(ctx, s.contractID, s.vendor, s.customer)
+				s.Require().NoError(err)
+			}
+
+			err := s.keeper.Wrap(ctx, s.contractID, s.customer, tc.amount)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			denom := token.WrappedDenom(s.contractID)
+			s.Require().True(s.keeper.GetBalance(ctx, s.contractID, s.customer).IsZero())
+			s.Require().Equal(tc.amount, s.keeper.GetBalance(ctx, s.contractID, authtypes.NewModuleAddress(token.ModuleName)))
+			s.Require().Equal(tc.amount, s.bankKeeper.GetSupply(ctx, denom).Amount)
+
+			// the supply of the contract does not change
+			s.Require().Equal(s.balance.Mul(sdk.NewInt(3)), s.keeper.GetSupply(ctx, s.contractID))
+
+			metadata, found := s.bankKeeper.GetDenomMetaData(ctx, denom)
+			s.Require().True(found)
+			s.Require().Equal(denom, metadata.Base)
+			s.Require().Equal("Mintable", metadata.Name)
+			s.Require().Equal("OK", metadata.Symbol)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestWrapDenomMetadata() {
+	testCases := map[string]struct {
+		decimals int32
+		display  func(base string) string
+	}{
+		"no decimals": {
+			display: func(base string) string { return base },
+		},
+		"decimals": {
+			decimals: 6,
+			display:  func(base string) string { return base + "/ab" },
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			// the symbol is shorter than the minimum length of the denom
+			contractID := s.keeper.Issue(ctx, token.Contract{
+				Name:     "Decimal",
+				Symbol:   "AB",
+				Decimals: tc.decimals,
+			}, s.vendor, s.customer, s.balance)
+
+			err := s.keeper.Wrap(ctx, contractID, s.customer, s.balance)
+			s.Require().NoError(err)
+
+			denom := token.WrappedDenom(contractID)
+			metadata, found := s.bankKeeper.GetDenomMetaData(ctx, denom)
+			s.Require().True(found)
+			s.Require().NoError(metadata.Validate())
+			s.Require().Equal(tc.display(denom), metadata.Display)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestUnwrap() {
+	testCases := map[string]struct {
+		paused bool
+		frozen bool
+		amount sdk.Int
+		err    error
+	}{
+		"valid request": {
+			amount: s.balance,
+		},
+		"insufficient wrapped coins": {
+			amount: s.balance.Add(sdk.OneInt()),
+			err:    sdkerrors.ErrInsufficientFunds,
+		},
+		"contract paused": {
+			paused: true,
+			amount: s.balance,
+			err:    token.ErrContractPaused,
+		},
+		"account frozen": {
+			frozen: true,
+			amount: s.balance,
+			err:    token.ErrAccountFrozen,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			err := s.keeper.Wrap(ctx, s.contractID, s.customer, s.balance)
+			s.Require().NoError(err)
+			if tc.paused {
+				err := s.keeper.Pause(ctx, s.contractID, s.vendor)
+				s.Require().NoError(err)
+			}
+			if tc.frozen {
+				err := s.keeper.Freeze(ctx, s.contractID, s.vendor, s.customer)
+				s.Require().NoError(err)
+			}
+
+			err = s.keeper.Unwrap(ctx, s.contractID, s.customer, tc.amount)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().Equal(tc.amount, s.keeper.GetBalance(ctx, s.contractID, s.customer))
+			s.Require().True(s.keeper.GetBalance(ctx, s.contractID, authtypes.NewModuleAddress(token.ModuleName)).IsZero())
+			s.Require().True(s.bankKeeper.GetSupply(ctx, token.WrappedDenom(s.contractID)).Amount.IsZero())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestEscrowNotReceivable() {
+	escrow := authtypes.NewModuleAddress(token.ModuleName)
+
+	ctx, _ := s.ctx.CacheContext()
+	err := s.keeper.Send(ctx, s.contractID, s.customer, escrow, sdk.OneInt())
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	err = s.keeper.Mint(ctx, s.contractID, s.vendor, escrow, sdk.OneInt())
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+}
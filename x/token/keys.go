@@ -10,3 +10,9 @@ const (
 	// RouterKey defines the module's message routing key
 	RouterKey = ModuleName
 )
+
+// Parameter store keys
+const (
+	ParamKeyMaxMultiSendOutputs  = "MaxMultiSendOutputs"
+	ParamKeyMaxVestingsPerHolder = "MaxVestingsPerHolder"
+)
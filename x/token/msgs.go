@@ -223,6 +223,13 @@ func (m MsgIssue) ValidateBasic() error {
 		return err
 	}
 
+	if err := validateMaxSupply(m.MaxSupply); err != nil {
+		return err
+	}
+	if !m.MaxSupply.IsNil() && m.MaxSupply.IsPositive() && m.Amount.GT(m.MaxSupply) {
+		return ErrInvalidAmount.Wrapf("amount %s exceeds the max supply %s", m.Amount, m.MaxSupply)
+	}
+
 	return nil
 }
 
@@ -517,3 +524,466 @@ func (m MsgModify) Route() string {
 func (m MsgModify) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
 }
+
+var _ sdk.Msg = (*MsgPause)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgPause) ValidateBasic() error {
+	if err := ValidateContractID(m.ContractId); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(m.From); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid from address: %s", m.From)
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgPause) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.From)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgPause) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgPause) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgPause) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgUnpause)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgUnpause) ValidateBasic() error {
+	if err := ValidateContractID(m.ContractId); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(m.From); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid from address: %s", m.From)
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgUnpause) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.From)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgUnpause) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgUnpause) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgUnpause) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgFreeze)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgFreeze) ValidateBasic() error {
+	if err := ValidateContractID(m.ContractId); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(m.From); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid from address: %s", m.From)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Account); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid account address: %s", m.Account)
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgFreeze) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.From)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgFreeze) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgFreeze) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgFreeze) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgUnfreeze)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgUnfreeze) ValidateBasic() error {
+	if err := ValidateContractID(m.ContractId); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(m.From); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid from address: %s", m.From)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Account); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid account address: %s", m.Account)
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgUnfreeze) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.From)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgUnfreeze) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgUnfreeze) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgUnfreeze) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgApprove)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgApprove) ValidateBasic() error {
+	if err := ValidateContractID(m.ContractId); err != nil {
+		return err
+	}
+
+	owner, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", m.Owner)
+	}
+	spender, err := sdk.AccAddressFromBech32(m.Spender)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid spender address: %s", m.Spender)
+	}
+	if owner.Equals(spender) {
+		return ErrApproverProxySame
+	}
+
+	// zero amount is allowed, which removes the allowance
+	if m.Amount.IsNil() || m.Amount.IsNegative() {
+		return ErrInvalidAmount.Wrapf("amount cannot be negative: %s", m.Amount)
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgApprove) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgApprove) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgApprove) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgApprove) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgTransferFrom)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgTransferFrom) ValidateBasic() error {
+	if err := ValidateContractID(m.ContractId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Spender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid spender address: %s", m.Spender)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.From); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid from address: %s", m.From)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.To); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid to address: %s", m.To)
+	}
+
+	if err := validateAmount(m.Amount); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgTransferFrom) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Spender)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgTransferFrom) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgTransferFrom) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgTransferFrom) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgWrap)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgWrap) ValidateBasic() error {
+	if err := ValidateContractID(m.ContractId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.From); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid from address: %s", m.From)
+	}
+
+	if err := validateAmount(m.Amount); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgWrap) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.From)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgWrap) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgWrap) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgWrap) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgUnwrap)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgUnwrap) ValidateBasic() error {
+	if err := ValidateContractID(m.ContractId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.From); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid from address: %s", m.From)
+	}
+
+	if err := validateAmount(m.Amount); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgUnwrap) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.From)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgUnwrap) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgUnwrap) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgUnwrap) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgMultiSend)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgMultiSend) ValidateBasic() error {
+	if err := ValidateContractID(m.ContractId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.From); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid from address: %s", m.From)
+	}
+
+	if len(m.Outputs) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("outputs cannot be empty")
+	}
+	for _, output := range m.Outputs {
+		if _, err := sdk.AccAddressFromBech32(output.To); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid to address: %s", output.To)
+		}
+		if err := validateAmount(output.Amount); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgMultiSend) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.From)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgMultiSend) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgMultiSend) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgMultiSend) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgMintVested)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgMintVested) ValidateBasic() error {
+	if err := ValidateContractID(m.ContractId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.From); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", m.From)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.To); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid to address: %s", m.To)
+	}
+
+	if err := validateAmount(m.Amount); err != nil {
+		return err
+	}
+
+	if err := m.Schedule.ValidateBasic(m.Amount); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgMintVested) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.From)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgMintVested) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgMintVested) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgMintVested) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgSendVested)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgSendVested) ValidateBasic() error {
+	if err := ValidateContractID(m.ContractId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.From); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid from address: %s", m.From)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.To); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid to address: %s", m.To)
+	}
+
+	if err := validateAmount(m.Amount); err != nil {
+		return err
+	}
+
+	if err := m.Schedule.ValidateBasic(m.Amount); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgSendVested) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.From)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgSendVested) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgSendVested) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgSendVested) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
@@ -298,15 +298,16 @@ func TestMsgIssue(t *testing.T) {
 	}
 
 	testCases := map[string]struct {
-		owner    sdk.AccAddress
-		to       sdk.AccAddress
-		name     string
-		symbol   string
-		imageUri string
-		meta     string
-		decimals int32
-		amount   sdk.Int
-		err      error
+		owner     sdk.AccAddress
+		to        sdk.AccAddress
+		name      string
+		symbol    string
+		imageUri  string
+		meta      string
+		decimals  int32
+		amount    sdk.Int
+		maxSupply sdk.Int
+		err       error
 	}{
 		"valid msg": {
 			owner:    addrs[0],
@@ -468,19 +469,55 @@ func TestMsgIssue(t *testing.T) {
 			amount:   sdk.ZeroInt(),
 			err:      token.ErrInvalidAmount,
 		},
+		"valid max supply": {
+			owner:     addrs[0],
+			to:        addrs[1],
+			name:      "test",
+			symbol:    "TT",
+			imageUri:  "some URI",
+			meta:      "some meta",
+			decimals:  8,
+			amount:    sdk.OneInt(),
+			maxSupply: sdk.OneInt(),
+		},
+		"negative max supply": {
+			owner:     addrs[0],
+			to:        addrs[1],
+			name:      "test",
+			symbol:    "TT",
+			imageUri:  "some URI",
+			meta:      "some meta",
+			decimals:  8,
+			amount:    sdk.OneInt(),
+			maxSupply: sdk.NewInt(-1),
+			err:       token.ErrInvalidAmount,
+		},
+		"amount exceeds max supply": {
+			owner:     addrs[0],
+			to:        addrs[1],
+			name:      "test",
+			symbol:    "TT",
+			imageUri:  "some URI",
+			meta:      "some meta",
+			decimals:  8,
+			amount:    sdk.NewInt(2),
+			maxSupply: sdk.OneInt(),
+			err:       token.ErrInvalidAmount,
+		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			msg := token.MsgIssue{
-				Owner:    tc.owner.String(),
-				To:       tc.to.String(),
-				Name:     tc.name,
-				Symbol:   tc.symbol,
-				Uri:      tc.imageUri,
-				Meta:     tc.meta,
-				Decimals: tc.decimals,
-				Amount:   tc.amount,
+				Owner:     tc.owner.String(),
+				To:        tc.to.String(),
+				Name:      tc.name,
+				Symbol:    tc.symbol,
+				Uri:       tc.imageUri,
+				Meta:      tc.meta,
+				Decimals:  tc.decimals,
+				Amount:    tc.amount,
+				MaxSupply: tc.maxSupply,
 			}
 
 			err := msg.ValidateBasic()
@@ -759,6 +796,23 @@ func TestMsgModify(t *testing.T) {
 			changes:    []token.Attribute{{Key: token.AttributeKeyMeta.String(), Value: string(make([]rune, 1001))}},
 			err:        token.ErrInvalidMetaLength,
 		},
+		"valid max supply": {
+			contractID: "deadbeef",
+			grantee:    addrs[0],
+			changes:    []token.Attribute{{Key: token.AttributeKeyMaxSupply.String(), Value: "1000"}},
+		},
+		"zero max supply": {
+			contractID: "deadbeef",
+			grantee:    addrs[0],
+			changes:    []token.Attribute{{Key: token.AttributeKeyMaxSupply.String(), Value: "0"}},
+			err:        token.ErrInvalidAmount,
+		},
+		"invalid max supply": {
+			contractID: "deadbeef",
+			grantee:    addrs[0],
+			changes:    []token.Attribute{{Key: token.AttributeKeyMaxSupply.String(), Value: "one"}},
+			err:        token.ErrInvalidAmount,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -1036,3 +1090,711 @@ func TestAminoJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgPause(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 1)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		contractID string
+		from       sdk.AccAddress
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+		},
+		"invalid contract id": {
+			from: addrs[0],
+			err:  class.ErrInvalidContractID,
+		},
+		"invalid from": {
+			contractID: "deadbeef",
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := token.MsgPause{
+				ContractId: tc.contractID,
+				From:       tc.from.String(),
+			}
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.from}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgUnpause(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 1)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		contractID string
+		from       sdk.AccAddress
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+		},
+		"invalid contract id": {
+			from: addrs[0],
+			err:  class.ErrInvalidContractID,
+		},
+		"invalid from": {
+			contractID: "deadbeef",
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := token.MsgUnpause{
+				ContractId: tc.contractID,
+				From:       tc.from.String(),
+			}
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.from}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgFreeze(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 2)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		contractID string
+		from       sdk.AccAddress
+		account    sdk.AccAddress
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			account:    addrs[1],
+		},
+		"invalid contract id": {
+			from:    addrs[0],
+			account: addrs[1],
+			err:     class.ErrInvalidContractID,
+		},
+		"invalid from": {
+			contractID: "deadbeef",
+			account:    addrs[1],
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"invalid account": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := token.MsgFreeze{
+				ContractId: tc.contractID,
+				From:       tc.from.String(),
+				Account:    tc.account.String(),
+			}
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.from}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgUnfreeze(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 2)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		contractID string
+		from       sdk.AccAddress
+		account    sdk.AccAddress
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			account:    addrs[1],
+		},
+		"invalid contract id": {
+			from:    addrs[0],
+			account: addrs[1],
+			err:     class.ErrInvalidContractID,
+		},
+		"invalid from": {
+			contractID: "deadbeef",
+			account:    addrs[1],
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"invalid account": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := token.MsgUnfreeze{
+				ContractId: tc.contractID,
+				From:       tc.from.String(),
+				Account:    tc.account.String(),
+			}
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.from}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgApprove(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 2)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		contractID string
+		owner      sdk.AccAddress
+		spender    sdk.AccAddress
+		amount     sdk.Int
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			owner:      addrs[0],
+			spender:    addrs[1],
+			amount:     sdk.OneInt(),
+		},
+		"zero amount": {
+			contractID: "deadbeef",
+			owner:      addrs[0],
+			spender:    addrs[1],
+			amount:     sdk.ZeroInt(),
+		},
+		"invalid contract id": {
+			owner:   addrs[0],
+			spender: addrs[1],
+			amount:  sdk.OneInt(),
+			err:     class.ErrInvalidContractID,
+		},
+		"invalid owner": {
+			contractID: "deadbeef",
+			spender:    addrs[1],
+			amount:     sdk.OneInt(),
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"invalid spender": {
+			contractID: "deadbeef",
+			owner:      addrs[0],
+			amount:     sdk.OneInt(),
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"owner and spender are the same": {
+			contractID: "deadbeef",
+			owner:      addrs[0],
+			spender:    addrs[0],
+			amount:     sdk.OneInt(),
+			err:        token.ErrApproverProxySame,
+		},
+		"negative amount": {
+			contractID: "deadbeef",
+			owner:      addrs[0],
+			spender:    addrs[1],
+			amount:     sdk.NewInt(-1),
+			err:        token.ErrInvalidAmount,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := token.MsgApprove{
+				ContractId: tc.contractID,
+				Owner:      tc.owner.String(),
+				Spender:    tc.spender.String(),
+				Amount:     tc.amount,
+			}
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.owner}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgTransferFrom(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 3)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		contractID string
+		spender    sdk.AccAddress
+		from       sdk.AccAddress
+		to         sdk.AccAddress
+		amount     sdk.Int
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			spender:    addrs[0],
+			from:       addrs[1],
+			to:         addrs[2],
+			amount:     sdk.OneInt(),
+		},
+		"invalid contract id": {
+			spender: addrs[0],
+			from:    addrs[1],
+			to:      addrs[2],
+			amount:  sdk.OneInt(),
+			err:     class.ErrInvalidContractID,
+		},
+		"invalid spender": {
+			contractID: "deadbeef",
+			from:       addrs[1],
+			to:         addrs[2],
+			amount:     sdk.OneInt(),
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"invalid from": {
+			contractID: "deadbeef",
+			spender:    addrs[0],
+			to:         addrs[2],
+			amount:     sdk.OneInt(),
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"invalid to": {
+			contractID: "deadbeef",
+			spender:    addrs[0],
+			from:       addrs[1],
+			amount:     sdk.OneInt(),
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"invalid amount": {
+			contractID: "deadbeef",
+			spender:    addrs[0],
+			from:       addrs[1],
+			to:         addrs[2],
+			amount:     sdk.ZeroInt(),
+			err:        token.ErrInvalidAmount,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := token.MsgTransferFrom{
+				ContractId: tc.contractID,
+				Spender:    tc.spender.String(),
+				From:       tc.from.String(),
+				To:         tc.to.String(),
+				Amount:     tc.amount,
+			}
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.spender}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgWrap(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 1)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		contractID string
+		from       sdk.AccAddress
+		amount     sdk.Int
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			amount:     sdk.OneInt(),
+		},
+		"invalid contract id": {
+			from:   addrs[0],
+			amount: sdk.OneInt(),
+			err:    class.ErrInvalidContractID,
+		},
+		"invalid from": {
+			contractID: "deadbeef",
+			amount:     sdk.OneInt(),
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"invalid amount": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			amount:     sdk.ZeroInt(),
+			err:        token.ErrInvalidAmount,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := token.MsgWrap{
+				ContractId: tc.contractID,
+				From:       tc.from.String(),
+				Amount:     tc.amount,
+			}
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.from}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgUnwrap(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 1)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		contractID string
+		from       sdk.AccAddress
+		amount     sdk.Int
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			amount:     sdk.OneInt(),
+		},
+		"invalid contract id": {
+			from:   addrs[0],
+			amount: sdk.OneInt(),
+			err:    class.ErrInvalidContractID,
+		},
+		"invalid from": {
+			contractID: "deadbeef",
+			amount:     sdk.OneInt(),
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"invalid amount": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			amount:     sdk.ZeroInt(),
+			err:        token.ErrInvalidAmount,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := token.MsgUnwrap{
+				ContractId: tc.contractID,
+				From:       tc.from.String(),
+				Amount:     tc.amount,
+			}
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.from}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgMultiSend(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 2)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		contractID string
+		from       sdk.AccAddress
+		outputs    []token.Output
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			outputs: []token.Output{{
+				To:     addrs[1].String(),
+				Amount: sdk.OneInt(),
+			}},
+		},
+		"invalid contract id": {
+			from: addrs[0],
+			outputs: []token.Output{{
+				To:     addrs[1].String(),
+				Amount: sdk.OneInt(),
+			}},
+			err: class.ErrInvalidContractID,
+		},
+		"invalid from": {
+			contractID: "deadbeef",
+			outputs: []token.Output{{
+				To:     addrs[1].String(),
+				Amount: sdk.OneInt(),
+			}},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		"empty outputs": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			err:        sdkerrors.ErrInvalidRequest,
+		},
+		"invalid to": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			outputs: []token.Output{{
+				Amount: sdk.OneInt(),
+			}},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		"invalid amount": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			outputs: []token.Output{{
+				To:     addrs[1].String(),
+				Amount: sdk.ZeroInt(),
+			}},
+			err: token.ErrInvalidAmount,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := token.MsgMultiSend{
+				ContractId: tc.contractID,
+				From:       tc.from.String(),
+				Outputs:    tc.outputs,
+			}
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.from}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgMintVested(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 2)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	schedule := token.VestingSchedule{
+		StartTime: 100,
+		EndTime:   200,
+	}
+
+	testCases := map[string]struct {
+		contractID string
+		from       sdk.AccAddress
+		to         sdk.AccAddress
+		amount     sdk.Int
+		schedule   token.VestingSchedule
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			to:         addrs[1],
+			amount:     sdk.OneInt(),
+			schedule:   schedule,
+		},
+		"invalid contract id": {
+			from:     addrs[0],
+			to:       addrs[1],
+			amount:   sdk.OneInt(),
+			schedule: schedule,
+			err:      class.ErrInvalidContractID,
+		},
+		"invalid from": {
+			contractID: "deadbeef",
+			to:         addrs[1],
+			amount:     sdk.OneInt(),
+			schedule:   schedule,
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"invalid to": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			amount:     sdk.OneInt(),
+			schedule:   schedule,
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"invalid amount": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			to:         addrs[1],
+			amount:     sdk.ZeroInt(),
+			schedule:   schedule,
+			err:        token.ErrInvalidAmount,
+		},
+		"invalid schedule": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			to:         addrs[1],
+			amount:     sdk.OneInt(),
+			err:        sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := token.MsgMintVested{
+				ContractId: tc.contractID,
+				From:       tc.from.String(),
+				To:         tc.to.String(),
+				Amount:     tc.amount,
+				Schedule:   tc.schedule,
+			}
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.from}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgSendVested(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 2)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	schedule := token.VestingSchedule{
+		StartTime: 100,
+		EndTime:   200,
+	}
+
+	testCases := map[string]struct {
+		contractID string
+		from       sdk.AccAddress
+		to         sdk.AccAddress
+		amount     sdk.Int
+		schedule   token.VestingSchedule
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			to:         addrs[1],
+			amount:     sdk.OneInt(),
+			schedule:   schedule,
+		},
+		"invalid contract id": {
+			from:     addrs[0],
+			to:       addrs[1],
+			amount:   sdk.OneInt(),
+			schedule: schedule,
+			err:      class.ErrInvalidContractID,
+		},
+		"invalid from": {
+			contractID: "deadbeef",
+			to:         addrs[1],
+			amount:     sdk.OneInt(),
+			schedule:   schedule,
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"invalid to": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			amount:     sdk.OneInt(),
+			schedule:   schedule,
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"invalid amount": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			to:         addrs[1],
+			amount:     sdk.ZeroInt(),
+			schedule:   schedule,
+			err:        token.ErrInvalidAmount,
+		},
+		"invalid schedule": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			to:         addrs[1],
+			amount:     sdk.OneInt(),
+			err:        sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := token.MsgSendVested{
+				ContractId: tc.contractID,
+				From:       tc.from.String(),
+				To:         tc.to.String(),
+				Amount:     tc.amount,
+				Schedule:   tc.schedule,
+			}
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.from}, msg.GetSigners())
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package token
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/authz"
+)
+
+var _ authz.Authorization = (*TokenSendAuthorization)(nil)
+
+// NewTokenSendAuthorization creates a new TokenSendAuthorization object.
+func NewTokenSendAuthorization(spendLimits []SpendLimit, allowList []sdk.AccAddress, operator bool) *TokenSendAuthorization {
+	return &TokenSendAuthorization{
+		SpendLimits: spendLimits,
+		AllowList:   authz.NewAllowList(allowList),
+		Operator:    operator,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a TokenSendAuthorization) MsgTypeURL() string {
+	if a.Operator {
+		return sdk.MsgTypeURL(&MsgOperatorSend{})
+	}
+	return sdk.MsgTypeURL(&MsgSend{})
+}
+
+// Accept implements Authorization.Accept.
+func (a TokenSendAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	var contractID, to string
+	var amount sdk.Int
+	switch m := msg.(type) {
+	case *MsgSend:
+		if a.Operator {
+			return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+		}
+		contractID, to, amount = m.ContractId, m.To, m.Amount
+	case *MsgOperatorSend:
+		if !a.Operator {
+			return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+		}
+		contractID, to, amount = m.ContractId, m.To, m.Amount
+	default:
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	if !authz.IsAllowed(a.AllowList, to) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot send to %s", to)
+	}
+
+	limits := make([]SpendLimit, 0, len(a.SpendLimits))
+	found := false
+	for _, limit := range a.SpendLimits {
+		if limit.ContractId != contractID {
+			limits = append(limits, limit)
+			continue
+		}
+		found = true
+
+		if limit.Amount.LT(amount) {
+			return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrap("requested amount is more than spend limit")
+		}
+		if left := limit.Amount.Sub(amount); left.IsPositive() {
+			limits = append(limits, SpendLimit{ContractId: contractID, Amount: left})
+		}
+	}
+	if !found {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("no spend limit on %s", contractID)
+	}
+
+	if len(limits) == 0 {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authz.AcceptResponse{Accept: true, Delete: false, Updated: &TokenSendAuthorization{
+		SpendLimits: limits,
+		AllowList:   a.AllowList,
+		Operator:    a.Operator,
+	}}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a TokenSendAuthorization) ValidateBasic() error {
+	if len(a.SpendLimits) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("spend limits cannot be empty")
+	}
+
+	seenContracts := map[string]bool{}
+	for _, limit := range a.SpendLimits {
+		if err := ValidateContractID(limit.ContractId); err != nil {
+			return err
+		}
+		if seenContracts[limit.ContractId] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate spend limits on %s", limit.ContractId)
+		}
+		seenContracts[limit.ContractId] = true
+
+		if limit.Amount.IsNil() {
+			return ErrInvalidAmount.Wrap("nil spend limit")
+		}
+		if err := validateAmount(limit.Amount); err != nil {
+			return err
+		}
+	}
+
+	return authz.ValidateAllowList(a.AllowList)
+}
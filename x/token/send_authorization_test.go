@@ -0,0 +1,197 @@
+package token_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/Finschia/finschia-sdk/crypto/keys/secp256k1"
+	"github.com/Finschia/finschia-sdk/simapp"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/token"
+	"github.com/Finschia/finschia-sdk/x/token/class"
+)
+
+func TestTokenSendAuthorization(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := make([]sdk.AccAddress, 3)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+	contractID := "deadbeef"
+	limit := sdk.NewInt(1000)
+
+	testCases := map[string]struct {
+		allowList []sdk.AccAddress
+		operator  bool
+		msg       sdk.Msg
+		err       error
+		deleted   bool
+		left      sdk.Int
+	}{
+		"send partially": {
+			msg: &token.MsgSend{
+				ContractId: contractID,
+				From:       addrs[0].String(),
+				To:         addrs[1].String(),
+				Amount:     sdk.OneInt(),
+			},
+			left: limit.Sub(sdk.OneInt()),
+		},
+		"send all": {
+			msg: &token.MsgSend{
+				ContractId: contractID,
+				From:       addrs[0].String(),
+				To:         addrs[1].String(),
+				Amount:     limit,
+			},
+			deleted: true,
+		},
+		"operator send": {
+			operator: true,
+			msg: &token.MsgOperatorSend{
+				ContractId: contractID,
+				Operator:   addrs[0].String(),
+				From:       addrs[2].String(),
+				To:         addrs[1].String(),
+				Amount:     sdk.OneInt(),
+			},
+			left: limit.Sub(sdk.OneInt()),
+		},
+		"allowed recipient": {
+			allowList: []sdk.AccAddress{addrs[1]},
+			msg: &token.MsgSend{
+				ContractId: contractID,
+				From:       addrs[0].String(),
+				To:         addrs[1].String(),
+				Amount:     sdk.OneInt(),
+			},
+			left: limit.Sub(sdk.OneInt()),
+		},
+		"recipient not allowed": {
+			allowList: []sdk.AccAddress{addrs[2]},
+			msg: &token.MsgSend{
+				ContractId: contractID,
+				From:       addrs[0].String(),
+				To:         addrs[1].String(),
+				Amount:     sdk.OneInt(),
+			},
+			err: sdkerrors.ErrUnauthorized,
+		},
+		"exceeding the limit": {
+			msg: &token.MsgSend{
+				ContractId: contractID,
+				From:       addrs[0].String(),
+				To:         addrs[1].String(),
+				Amount:     limit.Add(sdk.OneInt()),
+			},
+			err: sdkerrors.ErrInsufficientFunds,
+		},
+		"no limit on the contract": {
+			msg: &token.MsgSend{
+				ContractId: "fee1dead",
+				From:       addrs[0].String(),
+				To:         addrs[1].String(),
+				Amount:     sdk.OneInt(),
+			},
+			err: sdkerrors.ErrUnauthorized,
+		},
+		"type mismatch": {
+			operator: true,
+			msg: &token.MsgSend{
+				ContractId: contractID,
+				From:       addrs[0].String(),
+				To:         addrs[1].String(),
+				Amount:     sdk.OneInt(),
+			},
+			err: sdkerrors.ErrInvalidType,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			authorization := token.NewTokenSendAuthorization([]token.SpendLimit{{ContractId: contractID, Amount: limit}}, tc.allowList, tc.operator)
+			require.NoError(t, authorization.ValidateBasic())
+
+			resp, err := authorization.Accept(ctx, tc.msg)
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+			require.Equal(t, sdk.MsgTypeURL(tc.msg), authorization.MsgTypeURL())
+
+			require.True(t, resp.Accept)
+			require.Equal(t, tc.deleted, resp.Delete)
+			if tc.deleted {
+				require.Nil(t, resp.Updated)
+				return
+			}
+
+			updated, ok := resp.Updated.(*token.TokenSendAuthorization)
+			require.True(t, ok)
+			require.Len(t, updated.SpendLimits, 1)
+			require.True(t, tc.left.Equal(updated.SpendLimits[0].Amount))
+		})
+	}
+}
+
+func TestTokenSendAuthorizationValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	testCases := map[string]struct {
+		spendLimits []token.SpendLimit
+		allowList   []string
+		err         error
+	}{
+		"valid authorization": {
+			spendLimits: []token.SpendLimit{{ContractId: "deadbeef", Amount: sdk.OneInt()}},
+			allowList:   []string{addr.String()},
+		},
+		"empty spend limits": {
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		"invalid contract id": {
+			spendLimits: []token.SpendLimit{{Amount: sdk.OneInt()}},
+			err:         class.ErrInvalidContractID,
+		},
+		"duplicate contracts": {
+			spendLimits: []token.SpendLimit{
+				{ContractId: "deadbeef", Amount: sdk.OneInt()},
+				{ContractId: "deadbeef", Amount: sdk.OneInt()},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		"nil amount": {
+			spendLimits: []token.SpendLimit{{ContractId: "deadbeef"}},
+			err:         token.ErrInvalidAmount,
+		},
+		"zero amount": {
+			spendLimits: []token.SpendLimit{{ContractId: "deadbeef", Amount: sdk.ZeroInt()}},
+			err:         token.ErrInvalidAmount,
+		},
+		"invalid address in allow list": {
+			spendLimits: []token.SpendLimit{{ContractId: "deadbeef", Amount: sdk.OneInt()}},
+			allowList:   []string{"invalid"},
+			err:         sdkerrors.ErrInvalidAddress,
+		},
+		"duplicate addresses in allow list": {
+			spendLimits: []token.SpendLimit{{ContractId: "deadbeef", Amount: sdk.OneInt()}},
+			allowList:   []string{addr.String(), addr.String()},
+			err:         sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			authorization := token.TokenSendAuthorization{
+				SpendLimits: tc.spendLimits,
+				AllowList:   tc.allowList,
+			}
+			require.ErrorIs(t, authorization.ValidateBasic(), tc.err)
+		})
+	}
+}
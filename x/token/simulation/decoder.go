@@ -0,0 +1,53 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/Finschia/finschia-sdk/codec"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/types/kv"
+	"github.com/Finschia/finschia-sdk/x/token"
+	"github.com/Finschia/finschia-sdk/x/token/keeper"
+)
+
+// NewDecodeStore returns a decoder function closure that umarshals the KVPair's
+// Value to the corresponding token type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], keeper.ClassKeyPrefix):
+			var classA, classB token.Contract
+			cdc.MustUnmarshal(kvA.Value, &classA)
+			cdc.MustUnmarshal(kvB.Value, &classB)
+			return fmt.Sprintf("%v\n%v", classA, classB)
+		case bytes.Equal(kvA.Key[:1], keeper.VestingKeyPrefix):
+			var vestingA, vestingB token.Vesting
+			cdc.MustUnmarshal(kvA.Value, &vestingA)
+			cdc.MustUnmarshal(kvB.Value, &vestingB)
+			return fmt.Sprintf("%v\n%v", vestingA, vestingB)
+		case bytes.Equal(kvA.Key[:1], keeper.BalanceKeyPrefix),
+			bytes.Equal(kvA.Key[:1], keeper.SupplyKeyPrefix),
+			bytes.Equal(kvA.Key[:1], keeper.MintKeyPrefix),
+			bytes.Equal(kvA.Key[:1], keeper.BurnKeyPrefix),
+			bytes.Equal(kvA.Key[:1], keeper.AllowanceKeyPrefix):
+			var amountA, amountB sdk.Int
+			if err := amountA.Unmarshal(kvA.Value); err != nil {
+				panic(err)
+			}
+			if err := amountB.Unmarshal(kvB.Value); err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("%v\n%v", amountA, amountB)
+		case bytes.Equal(kvA.Key[:1], keeper.GrantKeyPrefix),
+			bytes.Equal(kvA.Key[:1], keeper.AuthorizationKeyPrefix),
+			bytes.Equal(kvA.Key[:1], keeper.PausedKeyPrefix),
+			bytes.Equal(kvA.Key[:1], keeper.FrozenKeyPrefix),
+			bytes.Equal(kvA.Key[:1], keeper.BalanceByAddressKeyPrefix):
+			// the existence of the key is the only information
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+		default:
+			panic(fmt.Sprintf("invalid token key %X", kvA.Key))
+		}
+	}
+}
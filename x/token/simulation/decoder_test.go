@@ -0,0 +1,78 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/finschia-sdk/simapp"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/types/kv"
+	"github.com/Finschia/finschia-sdk/x/token"
+	"github.com/Finschia/finschia-sdk/x/token/keeper"
+	"github.com/Finschia/finschia-sdk/x/token/simulation"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := simapp.MakeTestEncodingConfig().Marshaler
+	dec := simulation.NewDecodeStore(cdc)
+
+	class := token.Contract{
+		Id:     "deadbeef",
+		Name:   "Test",
+		Symbol: "TT",
+	}
+	classBz, err := cdc.Marshal(&class)
+	require.NoError(t, err)
+
+	amount := sdk.NewInt(1000)
+	amountBz, err := amount.Marshal()
+	require.NoError(t, err)
+
+	vesting := token.Vesting{
+		Holder:         "link1",
+		OriginalAmount: amount,
+		Schedule: token.VestingSchedule{
+			StartTime: 1000,
+			EndTime:   2000,
+		},
+	}
+	vestingBz, err := cdc.Marshal(&vesting)
+	require.NoError(t, err)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: keeper.ClassKeyPrefix, Value: classBz},
+			{Key: keeper.BalanceKeyPrefix, Value: amountBz},
+			{Key: keeper.SupplyKeyPrefix, Value: amountBz},
+			{Key: keeper.GrantKeyPrefix, Value: []byte{}},
+			{Key: keeper.VestingKeyPrefix, Value: vestingBz},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectErr   bool
+		expectedLog string
+	}{
+		{"Class", false, fmt.Sprintf("%v\n%v", class, class)},
+		{"Balance", false, fmt.Sprintf("%v\n%v", amount, amount)},
+		{"Supply", false, fmt.Sprintf("%v\n%v", amount, amount)},
+		{"Grant", false, "\n"},
+		{"Vesting", false, fmt.Sprintf("%v\n%v", vesting, vesting)},
+		{"other", true, ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.expectErr {
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			} else {
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/types/module"
+	simtypes "github.com/Finschia/finschia-sdk/types/simulation"
+	"github.com/Finschia/finschia-sdk/x/token"
+)
+
+const (
+	maxContracts      = 3
+	maxInitialBalance = 1_000_000
+)
+
+var symbolChars = []rune("ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789")
+
+// genContractID returns a random contract id not in the given set.
+func genContractID(r *rand.Rand, seen map[string]bool) string {
+	for {
+		id := fmt.Sprintf("%08x", r.Uint32())
+		if !seen[id] {
+			seen[id] = true
+			return id
+		}
+	}
+}
+
+// genSymbol returns a random symbol, which starts with a capital letter.
+func genSymbol(r *rand.Rand) string {
+	symbol := make([]rune, simtypes.RandIntBetween(r, 2, 6))
+	symbol[0] = symbolChars[r.Intn(26)]
+	for i := 1; i < len(symbol); i++ {
+		symbol[i] = symbolChars[r.Intn(len(symbolChars))]
+	}
+
+	return string(symbol)
+}
+
+// genContract returns a random contract with the given id.
+func genContract(r *rand.Rand, id string) token.Contract {
+	return token.Contract{
+		Id:        id,
+		Name:      simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 1, 21)),
+		Symbol:    genSymbol(r),
+		Meta:      simtypes.RandStringOfLength(r, r.Intn(100)),
+		Decimals:  int32(r.Intn(19)),
+		Mintable:  r.Intn(2) == 0,
+		MaxSupply: sdk.ZeroInt(),
+	}
+}
+
+// ownerPermissions returns the permissions granted to the owner of the contract on its issue.
+func ownerPermissions(class token.Contract) []token.Permission {
+	permissions := []token.Permission{
+		token.PermissionModify,
+		token.PermissionPause,
+		token.PermissionFreeze,
+	}
+	if class.Mintable {
+		permissions = append(permissions,
+			token.PermissionMint,
+			token.PermissionBurn,
+		)
+	}
+
+	return permissions
+}
+
+// genGenesisState returns a random genesis state of consistent contracts,
+// balances and statistics.
+func genGenesisState(r *rand.Rand, accounts []simtypes.Account) *token.GenesisState {
+	genState := token.DefaultGenesisState()
+
+	seen := map[string]bool{}
+	numContracts := simtypes.RandIntBetween(r, 1, maxContracts+1)
+	for i := 0; i < numContracts; i++ {
+		class := genContract(r, genContractID(r, seen))
+		genState.Classes = append(genState.Classes, class)
+		genState.ClassState.Ids = append(genState.ClassState.Ids, class.Id)
+
+		owner, _ := simtypes.RandomAcc(r, accounts)
+		contractGrants := token.ContractGrants{ContractId: class.Id}
+		for _, permission := range ownerPermissions(class) {
+			contractGrants.Grants = append(contractGrants.Grants, token.Grant{
+				Grantee:    owner.Address.String(),
+				Permission: permission,
+			})
+		}
+		genState.Grants = append(genState.Grants, contractGrants)
+
+		supply := sdk.ZeroInt()
+		contractBalances := token.ContractBalances{ContractId: class.Id}
+		for _, acc := range accounts {
+			if r.Intn(2) == 0 {
+				continue
+			}
+
+			amount := sdk.NewInt(int64(simtypes.RandIntBetween(r, 1, maxInitialBalance)))
+			contractBalances.Balances = append(contractBalances.Balances, token.Balance{
+				Address: acc.Address.String(),
+				Amount:  amount,
+			})
+			supply = supply.Add(amount)
+		}
+		if supply.IsZero() {
+			continue
+		}
+		genState.Balances = append(genState.Balances, contractBalances)
+
+		coin := token.ContractCoin{
+			ContractId: class.Id,
+			Amount:     supply,
+		}
+		genState.Supplies = append(genState.Supplies, coin)
+		genState.Mints = append(genState.Mints, coin)
+	}
+
+	return genState
+}
+
+// RandomizedGenState generates a random GenesisState for token.
+func RandomizedGenState(simState *module.SimulationState) {
+	genState := genGenesisState(simState.Rand, simState.Accounts)
+
+	simState.GenState[token.ModuleName] = simState.Cdc.MustMarshalJSON(genState)
+}
@@ -0,0 +1,57 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/finschia-sdk/simapp"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/types/module"
+	simtypes "github.com/Finschia/finschia-sdk/types/simulation"
+	"github.com/Finschia/finschia-sdk/x/token"
+	"github.com/Finschia/finschia-sdk/x/token/simulation"
+)
+
+func TestRandomizedGenState(t *testing.T) {
+	app := simapp.Setup(false)
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	simState := module.SimulationState{
+		AppParams:    make(simtypes.AppParams),
+		Cdc:          app.AppCodec(),
+		Rand:         r,
+		NumBonded:    3,
+		Accounts:     simtypes.RandomAccounts(r, 3),
+		InitialStake: 1000,
+		GenState:     make(map[string]json.RawMessage),
+	}
+
+	simulation.RandomizedGenState(&simState)
+	var tokenGenesis token.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[token.ModuleName], &tokenGenesis)
+
+	require.NoError(t, token.ValidateGenesis(tokenGenesis))
+	require.NotEmpty(t, tokenGenesis.Classes)
+	require.Len(t, tokenGenesis.ClassState.Ids, len(tokenGenesis.Classes))
+
+	// the statistics must be consistent with the balances
+	supplies := map[string]sdk.Int{}
+	for _, contractBalances := range tokenGenesis.Balances {
+		sum := sdk.ZeroInt()
+		for _, balance := range contractBalances.Balances {
+			sum = sum.Add(balance.Amount)
+		}
+		supplies[contractBalances.ContractId] = sum
+	}
+	require.Len(t, tokenGenesis.Supplies, len(supplies))
+	for _, supply := range tokenGenesis.Supplies {
+		require.Equal(t, supplies[supply.ContractId], supply.Amount)
+	}
+	require.Equal(t, tokenGenesis.Supplies, tokenGenesis.Mints)
+	require.Empty(t, tokenGenesis.Burns)
+}
@@ -0,0 +1,1074 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/Finschia/finschia-sdk/baseapp"
+	"github.com/Finschia/finschia-sdk/codec"
+	simappparams "github.com/Finschia/finschia-sdk/simapp/params"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	simtypes "github.com/Finschia/finschia-sdk/types/simulation"
+	"github.com/Finschia/finschia-sdk/x/simulation"
+	"github.com/Finschia/finschia-sdk/x/token"
+	"github.com/Finschia/finschia-sdk/x/token/keeper"
+)
+
+// token message types
+var (
+	TypeMsgSend              = sdk.MsgTypeURL(&token.MsgSend{})
+	TypeMsgOperatorSend      = sdk.MsgTypeURL(&token.MsgOperatorSend{})
+	TypeMsgAuthorizeOperator = sdk.MsgTypeURL(&token.MsgAuthorizeOperator{})
+	TypeMsgRevokeOperator    = sdk.MsgTypeURL(&token.MsgRevokeOperator{})
+	TypeMsgIssue             = sdk.MsgTypeURL(&token.MsgIssue{})
+	TypeMsgGrantPermission   = sdk.MsgTypeURL(&token.MsgGrantPermission{})
+	TypeMsgRevokePermission  = sdk.MsgTypeURL(&token.MsgRevokePermission{})
+	TypeMsgMint              = sdk.MsgTypeURL(&token.MsgMint{})
+	TypeMsgBurn              = sdk.MsgTypeURL(&token.MsgBurn{})
+	TypeMsgOperatorBurn      = sdk.MsgTypeURL(&token.MsgOperatorBurn{})
+	TypeMsgModify            = sdk.MsgTypeURL(&token.MsgModify{})
+	TypeMsgPause             = sdk.MsgTypeURL(&token.MsgPause{})
+	TypeMsgUnpause           = sdk.MsgTypeURL(&token.MsgUnpause{})
+	TypeMsgFreeze            = sdk.MsgTypeURL(&token.MsgFreeze{})
+	TypeMsgUnfreeze          = sdk.MsgTypeURL(&token.MsgUnfreeze{})
+	TypeMsgApprove           = sdk.MsgTypeURL(&token.MsgApprove{})
+	TypeMsgTransferFrom      = sdk.MsgTypeURL(&token.MsgTransferFrom{})
+	TypeMsgWrap              = sdk.MsgTypeURL(&token.MsgWrap{})
+	TypeMsgUnwrap            = sdk.MsgTypeURL(&token.MsgUnwrap{})
+	TypeMsgMultiSend         = sdk.MsgTypeURL(&token.MsgMultiSend{})
+	TypeMsgMintVested        = sdk.MsgTypeURL(&token.MsgMintVested{})
+	TypeMsgSendVested        = sdk.MsgTypeURL(&token.MsgSendVested{})
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgSend              = "op_weight_msg_token_send"
+	OpWeightMsgOperatorSend      = "op_weight_msg_token_operator_send"
+	OpWeightMsgAuthorizeOperator = "op_weight_msg_token_authorize_operator"
+	OpWeightMsgRevokeOperator    = "op_weight_msg_token_revoke_operator"
+	OpWeightMsgIssue             = "op_weight_msg_token_issue"
+	OpWeightMsgGrantPermission   = "op_weight_msg_token_grant_permission"
+	OpWeightMsgRevokePermission  = "op_weight_msg_token_revoke_permission"
+	OpWeightMsgMint              = "op_weight_msg_token_mint"
+	OpWeightMsgBurn              = "op_weight_msg_token_burn"
+	OpWeightMsgOperatorBurn      = "op_weight_msg_token_operator_burn"
+	OpWeightMsgModify            = "op_weight_msg_token_modify"
+	OpWeightMsgPause             = "op_weight_msg_token_pause"
+	OpWeightMsgUnpause           = "op_weight_msg_token_unpause"
+	OpWeightMsgFreeze            = "op_weight_msg_token_freeze"
+	OpWeightMsgUnfreeze          = "op_weight_msg_token_unfreeze"
+	OpWeightMsgApprove           = "op_weight_msg_token_approve"
+	OpWeightMsgTransferFrom      = "op_weight_msg_token_transfer_from"
+	OpWeightMsgWrap              = "op_weight_msg_token_wrap"
+	OpWeightMsgUnwrap            = "op_weight_msg_token_unwrap"
+	OpWeightMsgMultiSend         = "op_weight_msg_token_multi_send"
+	OpWeightMsgMintVested        = "op_weight_msg_token_mint_vested"
+	OpWeightMsgSendVested        = "op_weight_msg_token_send_vested"
+)
+
+// token operations weights
+const (
+	WeightSend              = 100
+	WeightOperatorSend      = 50
+	WeightAuthorizeOperator = 30
+	WeightRevokeOperator    = 10
+	WeightIssue             = 10
+	WeightGrantPermission   = 20
+	WeightRevokePermission  = 5
+	WeightMint              = 50
+	WeightBurn              = 30
+	WeightOperatorBurn      = 20
+	WeightModify            = 10
+	WeightPause             = 5
+	WeightUnpause           = 20
+	WeightFreeze            = 5
+	WeightUnfreeze          = 20
+	WeightApprove           = 30
+	WeightTransferFrom      = 30
+	WeightWrap              = 30
+	WeightUnwrap            = 20
+	WeightMultiSend         = 30
+	WeightMintVested        = 20
+	WeightSendVested        = 20
+)
+
+const (
+	maxAmount          = 1_000_000
+	maxOutputs         = 5
+	maxVestingPeriods  = 4
+	maxVestingDuration = 60 * 60 * 24
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak token.AccountKeeper, bk token.BankKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	operations := []struct {
+		key           string
+		defaultWeight int
+		operation     simtypes.Operation
+	}{
+		{OpWeightMsgSend, WeightSend, SimulateMsgSend(ak, bk, k)},
+		{OpWeightMsgOperatorSend, WeightOperatorSend, SimulateMsgOperatorSend(ak, bk, k)},
+		{OpWeightMsgAuthorizeOperator, WeightAuthorizeOperator, SimulateMsgAuthorizeOperator(ak, bk, k)},
+		{OpWeightMsgRevokeOperator, WeightRevokeOperator, SimulateMsgRevokeOperator(ak, bk, k)},
+		{OpWeightMsgIssue, WeightIssue, SimulateMsgIssue(ak, bk, k)},
+		{OpWeightMsgGrantPermission, WeightGrantPermission, SimulateMsgGrantPermission(ak, bk, k)},
+		{OpWeightMsgRevokePermission, WeightRevokePermission, SimulateMsgRevokePermission(ak, bk, k)},
+		{OpWeightMsgMint, WeightMint, SimulateMsgMint(ak, bk, k)},
+		{OpWeightMsgBurn, WeightBurn, SimulateMsgBurn(ak, bk, k)},
+		{OpWeightMsgOperatorBurn, WeightOperatorBurn, SimulateMsgOperatorBurn(ak, bk, k)},
+		{OpWeightMsgModify, WeightModify, SimulateMsgModify(ak, bk, k)},
+		{OpWeightMsgPause, WeightPause, SimulateMsgPause(ak, bk, k)},
+		{OpWeightMsgUnpause, WeightUnpause, SimulateMsgUnpause(ak, bk, k)},
+		{OpWeightMsgFreeze, WeightFreeze, SimulateMsgFreeze(ak, bk, k)},
+		{OpWeightMsgUnfreeze, WeightUnfreeze, SimulateMsgUnfreeze(ak, bk, k)},
+		{OpWeightMsgApprove, WeightApprove, SimulateMsgApprove(ak, bk, k)},
+		{OpWeightMsgTransferFrom, WeightTransferFrom, SimulateMsgTransferFrom(ak, bk, k)},
+		{OpWeightMsgWrap, WeightWrap, SimulateMsgWrap(ak, bk, k)},
+		{OpWeightMsgUnwrap, WeightUnwrap, SimulateMsgUnwrap(ak, bk, k)},
+		{OpWeightMsgMultiSend, WeightMultiSend, SimulateMsgMultiSend(ak, bk, k)},
+		{OpWeightMsgMintVested, WeightMintVested, SimulateMsgMintVested(ak, bk, k)},
+		{OpWeightMsgSendVested, WeightSendVested, SimulateMsgSendVested(ak, bk, k)},
+	}
+
+	weightedOperations := make(simulation.WeightedOperations, len(operations))
+	for i, op := range operations {
+		var weight int
+		appParams.GetOrGenerate(cdc, op.key, &weight, nil,
+			func(_ *rand.Rand) {
+				weight = op.defaultWeight
+			},
+		)
+
+		weightedOperations[i] = simulation.NewWeightedOperation(weight, op.operation)
+	}
+
+	return weightedOperations
+}
+
+// SimulateMsgSend generates a MsgSend with random values.
+func SimulateMsgSend(ak token.AccountKeeper, bk token.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		class, found := randomActiveContract(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgSend, "no active contract"), nil, nil
+		}
+
+		from, found := randomAccount(r, accs, isSpendable(ctx, k, class.Id))
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgSend, "no holder"), nil, nil
+		}
+		to, found := randomAccount(r, accs, isNotFrozen(ctx, k, class.Id))
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgSend, "no recipient"), nil, nil
+		}
+
+		msg := &token.MsgSend{
+			ContractId: class.Id,
+			From:       from.Address.String(),
+			To:         to.Address.String(),
+			Amount:     randomPositiveAmount(r, k.GetSpendable(ctx, class.Id, from.Address)),
+		}
+
+		return deliver(r, app, ctx, ak, bk, from, msg, nil)
+	}
+}
+
+// SimulateMsgOperatorSend generates a MsgOperatorSend with random values.
+func SimulateMsgOperatorSend(ak token.AccountKeeper, bk token.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		class, found := randomActiveContract(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgOperatorSend, "no active contract"), nil, nil
+		}
+
+		from, operator, found := randomAuthorization(r, ctx, k, accs, class.Id, isSpendable(ctx, k, class.Id), anyAccount)
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgOperatorSend, "no authorization"), nil, nil
+		}
+		to, found := randomAccount(r, accs, isNotFrozen(ctx, k, class.Id))
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgOperatorSend, "no recipient"), nil, nil
+		}
+
+		msg := &token.MsgOperatorSend{
+			ContractId: class.Id,
+			Operator:   operator.Address.String(),
+			From:       from.Address.String(),
+			To:         to.Address.String(),
+			Amount:     randomPositiveAmount(r, k.GetSpendable(ctx, class.Id, from.Address)),
+		}
+
+		return deliver(r, app, ctx, ak, bk, operator, msg, nil)
+	}
+}
+
+// SimulateMsgAuthorizeOperator generates a MsgAuthorizeOperator with random values.
+func SimulateMsgAuthorizeOperator(ak token.AccountKeeper, bk token.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		class, found := randomContract(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgAuthorizeOperator, "no contract"), nil, nil
+		}
+
+		holder, _ := simtypes.RandomAcc(r, accs)
+		operator, found := randomAccount(r, accs, func(acc simtypes.Account) bool {
+			return !acc.Equals(holder) && !isOperatorOf(ctx, k, class.Id, holder)(acc)
+		})
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgAuthorizeOperator, "no operator to authorize"), nil, nil
+		}
+
+		msg := &token.MsgAuthorizeOperator{
+			ContractId: class.Id,
+			Holder:     holder.Address.String(),
+			Operator:   operator.Address.String(),
+		}
+
+		return deliver(r, app, ctx, ak, bk, holder, msg, nil)
+	}
+}
+
+// SimulateMsgRevokeOperator generates a MsgRevokeOperator with random values.
+func SimulateMsgRevokeOperator(ak token.AccountKeeper, bk token.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		class, found := randomContract(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgRevokeOperator, "no contract"), nil, nil
+		}
+
+		holder, operator, found := randomAuthorization(r, ctx, k, accs, class.Id, anyAccount, anyAccount)
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgRevokeOperator, "no authorization"), nil, nil
+		}
+
+		msg := &token.MsgRevokeOperator{
+			ContractId: class.Id,
+			Holder:     holder.Address.String(),
+			Operator:   operator.Address.String(),
+		}
+
+		return deliver(r, app, ctx, ak, bk, holder, msg, nil)
+	}
+}
+
+// SimulateMsgIssue generates a MsgIssue with random values.
+func SimulateMsgIssue(ak token.AccountKeeper, bk token.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		owner, _ := simtypes.RandomAcc(r, accs)
+		to := owner
+		if r.Intn(2) == 0 {
+			to, _ = simtypes.RandomAcc(r, accs)
+		}
+
+		class := genContract(r, "")
+		amount := randomPositiveAmount(r, sdk.NewInt(maxAmount))
+		if r.Intn(2) == 0 {
+			class.MaxSupply = amount.Add(simtypes.RandomAmount(r, sdk.NewInt(maxAmount)))
+		}
+
+		msg := &token.MsgIssue{
+			Name:      class.Name,
+			Symbol:    class.Symbol,
+			Uri:       class.Uri,
+			Meta:      class.Meta,
+			Decimals:  class.Decimals,
+			Mintable:  class.Mintable,
+			Owner:     owner.Address.String(),
+			To:        to.Address.String(),
+			Amount:    amount,
+			MaxSupply: class.MaxSupply,
+		}
+
+		return deliver(r, app, ctx, ak, bk, owner, msg, nil)
+	}
+}
+
+// SimulateMsgGrantPermission generates a MsgGrantPermission with random values.
+func SimulateMsgGrantPermission(ak token.AccountKeeper, bk token.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		class, found := randomContract(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgGrantPermission, "no contract"), nil, nil
+		}
+
+		permission := randomPermission(r)
+		granter, found := randomAccount(r, accs, hasPermission(ctx, k, class.Id, permission))
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgGrantPermission, "no granter"), nil, nil
+		}
+		grantee, found := randomAccount(r, accs, func(acc simtypes.Account) bool {
+			return !hasPermission(ctx, k, class.Id, permission)(acc)
+		})
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgGrantPermission, "no grantee"), nil, nil
+		}
+
+		msg := &token.MsgGrantPermission{
+			ContractId: class.Id,
+			From:       granter.Address.String(),
+			To:         grantee.Address.String(),
+			Permission: token.LegacyPermission(permission).String(),
+		}
+
+		return deliver(r, app, ctx, ak, bk, granter, msg, nil)
+	}
+}
+
+// SimulateMsgRevokePermission generates a MsgRevokePermission with random values.
+func SimulateMsgRevokePermission(ak token.AccountKeeper, bk token.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		class, found := randomContract(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgRevokePermission, "no contract"), nil, nil
+		}
+
+		permission := randomPermission(r)
+		grantee, found := randomAccount(r, accs, hasPermission(ctx, k, class.Id, permission))
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgRevokePermission, "no grantee"), nil, nil
+		}
+
+		msg := &token.MsgRevokePermission{
+			ContractId: class.Id,
+			From:       grantee.Address.String(),
+			Permission: token.LegacyPermission(permission).String(),
+		}
+
+		return deliver(r, app, ctx, ak, bk, grantee, msg, nil)
+	}
+}
+
+// SimulateMsgMint generates a MsgMint with random values.
+func SimulateMsgMint(ak token.AccountKeeper, bk token.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		class, found := randomActiveContract(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgMint, "no active contract"), nil, nil
+		}
+
+		limit := sdk.NewInt(maxAmount)
+		if maxSupply, err := k.GetMaxSupply(ctx, class.Id); err == nil && maxSupply.IsPositive() {
+			supply := k.GetMinted(ctx, class.Id).Sub(k.GetBurnt(ctx, class.Id))
+			limit = sdk.MinInt(limit, maxSupply.Sub(supply))
+		}
+		if !limit.IsPositive() {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgMint, "max supply reached"), nil, nil
+		}
+
+		grantee, found := randomAccount(r, accs, hasPermission(ctx, k, class.Id, token.PermissionMint))
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgMint, "no minter"), nil, nil
+		}
+		to := grantee
+		if r.Intn(2) == 0 || !isNotFrozen(ctx, k, class.Id)(to) {
+			if to, found = randomAccount(r, accs, isNotFrozen(ctx, k, class.Id)); !found {
+				return simtypes.NoOpMsg(token.ModuleName, TypeMsgMint, "no recipient"), nil, nil
+			}
+		}
+
+		msg := &token.MsgMint{
+			ContractId: class.Id,
+			From:       grantee.Address.String(),
+			To:         to.Address.String(),
+			Amount:     randomPositiveAmount(r, limit),
+		}
+
+		return deliver(r, app, ctx, ak, bk, grantee, msg, nil)
+	}
+}
+
+// SimulateMsgBurn generates a MsgBurn with random values.
+func SimulateMsgBurn(ak token.AccountKeeper, bk token.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		class, found := randomActiveContract(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgBurn, "no active contract"), nil, nil
+		}
+
+		from, found := randomAccount(r, accs, func(acc simtypes.Account) bool {
+			return hasPermission(ctx, k, class.Id, token.PermissionBurn)(acc) && isSpendable(ctx, k, class.Id)(acc)
+		})
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgBurn, "no burner"), nil, nil
+		}
+
+		msg := &token.MsgBurn{
+			ContractId: class.Id,
+			From:       from.Address.String(),
+			Amount:     randomPositiveAmount(r, k.GetSpendable(ctx, class.Id, from.Address)),
+		}
+
+		return deliver(r, app, ctx, ak, bk, from, msg, nil)
+	}
+}
+
+// SimulateMsgOperatorBurn generates a MsgOperatorBurn with random values.
+func SimulateMsgOperatorBurn(ak token.AccountKeeper, bk token.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		class, found := randomActiveContract(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgOperatorBurn, "no active contract"), nil, nil
+		}
+
+		from, operator, found := randomAuthorization(r, ctx, k, accs, class.Id, isSpendable(ctx, k, class.Id), hasPermission(ctx, k, class.Id, token.PermissionBurn))
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgOperatorBurn, "no authorization"), nil, nil
+		}
+
+		msg := &token.MsgOperatorBurn{
+			ContractId: class.Id,
+			Operator:   operator.Address.String(),
+			From:       from.Address.String(),
+			Amount:     randomPositiveAmount(r, k.GetSpendable(ctx, class.Id, from.Address)),
+		}
+
+		return deliver(r, app, ctx, ak, bk, operator, msg, nil)
+	}
+}
+
+// SimulateMsgModify generates a MsgModify with random values.
+func SimulateMsgModify(ak token.AccountKeeper, bk token.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		class, found := randomContract(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgModify, "no contract"), nil, nil
+		}
+
+		grantee, found := randomAccount(r, accs, hasPermission(ctx, k, class.Id, token.PermissionModify))
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgModify, "no modifier"), nil, nil
+		}
+
+		changes := []token.Attribute{
+			{
+				Key:   token.AttributeKeyName.String(),
+				Value: simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 1, 21)),
+			},
+			{
+				Key:   token.AttributeKeyURI.String(),
+				Value: simtypes.RandStringOfLength(r, r.Intn(100)),
+			},
+			{
+				Key:   token.AttributeKeyMeta.String(),
+				Value: simtypes.RandStringOfLength(r, r.Intn(100)),
+			},
+		}
+		r.Shuffle(len(changes), func(i, j int) {
+			changes[i], changes[j] = changes[j], changes[i]
+		})
+
+		msg := &token.MsgModify{
+			ContractId: class.Id,
+			Owner:      grantee.Address.String(),
+			Changes:    changes[:simtypes.RandIntBetween(r, 1, len(changes)+1)],
+		}
+
+		return deliver(r, app, ctx, ak, bk, grantee, msg, nil)
+	}
+}
+
+// SimulateMsgPause generates a MsgPause with random values.
+func SimulateMsgPause(ak token.AccountKeeper, bk token.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		class, found := randomActiveContract(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgPause, "no active contract"), nil, nil
+		}
+
+		grantee, found := randomAccount(r, accs, hasPermission(ctx, k, class.Id, token.PermissionPause))
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgPause, "no pauser"), nil, nil
+		}
+
+		msg := &token.MsgPause{
+			ContractId: class.Id,
+			From:       grantee.Address.String(),
+		}
+
+		return deliver(r, app, ctx, ak, bk, grantee, msg, nil)
+	}
+}
+
+// SimulateMsgUnpause generates a MsgUnpause with random values.
+func SimulateMsgUnpause(ak token.AccountKeeper, bk token.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		class, found := randomContract(r, ctx, k)
+		if !found || !k.IsPaused(ctx, class.Id) {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgUnpause, "no paused contract"), nil, nil
+		}
+
+		grantee, found := randomAccount(r, accs, hasPermission(ctx, k, class.Id, token.PermissionPause))
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgUnpause, "no pauser"), nil, nil
+		}
+
+		msg := &token.MsgUnpause{
+			ContractId: class.Id,
+			From:       grantee.Address.String(),
+		}
+
+		return deliver(r, app, ctx, ak, bk, grantee, msg, nil)
+	}
+}
+
+// SimulateMsgFreeze generates a MsgFreeze with random values.
+func SimulateMsgFreeze(ak token.AccountKeeper, bk token.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		class, found := randomContract(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgFreeze, "no contract"), nil, nil
+		}
+
+		grantee, found := randomAccount(r, accs, hasPermission(ctx, k, class.Id, token.PermissionFreeze))
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgFreeze, "no freezer"), nil, nil
+		}
+		account, found := randomAccount(r, accs, isNotFrozen(ctx, k, class.Id))
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgFreeze, "no account to freeze"), nil, nil
+		}
+
+		msg := &token.MsgFreeze{
+			ContractId: class.Id,
+			From:       grantee.Address.String(),
+			Account:    account.Address.String(),
+		}
+
+		return deliver(r, app, ctx, ak, bk, grantee, msg, nil)
+	}
+}
+
+// SimulateMsgUnfreeze generates a MsgUnfreeze with random values.
+func SimulateMsgUnfreeze(ak token.AccountKeeper, bk token.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		class, found := randomContract(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgUnfreeze, "no contract"), nil, nil
+		}
+
+		account, found := randomAccount(r, accs, func(acc simtypes.Account) bool {
+			return !isNotFrozen(ctx, k, class.Id)(acc)
+		})
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgUnfreeze, "no frozen account"), nil, nil
+		}
+		grantee, found := randomAccount(r, accs, hasPermission(ctx, k, class.Id, token.PermissionFreeze))
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgUnfreeze, "no freezer"), nil, nil
+		}
+
+		msg := &token.MsgUnfreeze{
+			ContractId: class.Id,
+			From:       grantee.Address.String(),
+			Account:    account.Address.String(),
+		}
+
+		return deliver(r, app, ctx, ak, bk, grantee, msg, nil)
+	}
+}
+
+// SimulateMsgApprove generates a MsgApprove with random values.
+func SimulateMsgApprove(ak token.AccountKeeper, bk token.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		class, found := randomContract(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgApprove, "no contract"), nil, nil
+		}
+
+		owner, _ := simtypes.RandomAcc(r, accs)
+		spender, _ := simtypes.RandomAcc(r, accs)
+		if owner.Equals(spender) {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgApprove, "owner and spender are same"), nil, nil
+		}
+
+		msg := &token.MsgApprove{
+			ContractId: class.Id,
+			Owner:      owner.Address.String(),
+			Spender:    spender.Address.String(),
+			Amount:     simtypes.RandomAmount(r, sdk.NewInt(maxAmount)),
+		}
+
+		return deliver(r, app, ctx, ak, bk, owner, msg, nil)
+	}
+}
+
+// SimulateMsgTransferFrom generates a MsgTransferFrom with random values.
+func SimulateMsgTransferFrom(ak token.AccountKeeper, bk token.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		class, found := randomActiveContract(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgTransferFrom, "no active contract"), nil, nil
+		}
+
+		from, spender, found := randomAllowance(r, ctx, k, accs, class.Id, isSpendable(ctx, k, class.Id))
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgTransferFrom, "no allowance"), nil, nil
+		}
+		to, found := randomAccount(r, accs, isNotFrozen(ctx, k, class.Id))
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgTransferFrom, "no recipient"), nil, nil
+		}
+
+		limit := sdk.MinInt(k.GetSpendable(ctx, class.Id, from.Address), k.GetAllowance(ctx, class.Id, from.Address, spender.Address))
+		msg := &token.MsgTransferFrom{
+			ContractId: class.Id,
+			Spender:    spender.Address.String(),
+			From:       from.Address.String(),
+			To:         to.Address.String(),
+			Amount:     randomPositiveAmount(r, limit),
+		}
+
+		return deliver(r, app, ctx, ak, bk, spender, msg, nil)
+	}
+}
+
+// SimulateMsgWrap generates a MsgWrap with random values.
+func SimulateMsgWrap(ak token.AccountKeeper, bk token.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		class, found := randomActiveContract(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgWrap, "no active contract"), nil, nil
+		}
+
+		from, found := randomAccount(r, accs, isSpendable(ctx, k, class.Id))
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgWrap, "no holder"), nil, nil
+		}
+
+		msg := &token.MsgWrap{
+			ContractId: class.Id,
+			From:       from.Address.String(),
+			Amount:     randomPositiveAmount(r, k.GetSpendable(ctx, class.Id, from.Address)),
+		}
+
+		return deliver(r, app, ctx, ak, bk, from, msg, nil)
+	}
+}
+
+// SimulateMsgUnwrap generates a MsgUnwrap with random values.
+func SimulateMsgUnwrap(ak token.AccountKeeper, bk token.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		class, found := randomActiveContract(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgUnwrap, "no active contract"), nil, nil
+		}
+
+		denom := token.WrappedDenom(class.Id)
+		from, found := randomAccount(r, accs, func(acc simtypes.Account) bool {
+			return isNotFrozen(ctx, k, class.Id)(acc) && bk.SpendableCoins(ctx, acc.Address).AmountOf(denom).IsPositive()
+		})
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgUnwrap, "no wrapped coins"), nil, nil
+		}
+
+		amount := randomPositiveAmount(r, bk.SpendableCoins(ctx, from.Address).AmountOf(denom))
+		msg := &token.MsgUnwrap{
+			ContractId: class.Id,
+			From:       from.Address.String(),
+			Amount:     amount,
+		}
+
+		return deliver(r, app, ctx, ak, bk, from, msg, sdk.NewCoins(sdk.NewCoin(denom, amount)))
+	}
+}
+
+// SimulateMsgMultiSend generates a MsgMultiSend with random values.
+func SimulateMsgMultiSend(ak token.AccountKeeper, bk token.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		class, found := randomActiveContract(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgMultiSend, "no active contract"), nil, nil
+		}
+
+		from, found := randomAccount(r, accs, isSpendable(ctx, k, class.Id))
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgMultiSend, "no holder"), nil, nil
+		}
+
+		spendable := k.GetSpendable(ctx, class.Id, from.Address)
+		numOutputs := simtypes.RandIntBetween(r, 1, maxOutputs+1)
+		if limit := int(k.GetParams(ctx).MaxMultiSendOutputs); numOutputs > limit {
+			numOutputs = limit
+		}
+		if spendable.LT(sdk.NewInt(int64(numOutputs))) {
+			numOutputs = int(spendable.Int64())
+		}
+
+		// leave at least one token for each of the remaining outputs
+		remaining := spendable
+		outputs := make([]token.Output, numOutputs)
+		for i := range outputs {
+			to, found := randomAccount(r, accs, isNotFrozen(ctx, k, class.Id))
+			if !found {
+				return simtypes.NoOpMsg(token.ModuleName, TypeMsgMultiSend, "no recipient"), nil, nil
+			}
+
+			amount := randomPositiveAmount(r, remaining.SubRaw(int64(numOutputs-i-1)))
+			remaining = remaining.Sub(amount)
+
+			outputs[i] = token.Output{
+				To:     to.Address.String(),
+				Amount: amount,
+			}
+		}
+
+		msg := &token.MsgMultiSend{
+			ContractId: class.Id,
+			From:       from.Address.String(),
+			Outputs:    outputs,
+		}
+
+		return deliver(r, app, ctx, ak, bk, from, msg, nil)
+	}
+}
+
+// SimulateMsgMintVested generates a MsgMintVested with random values.
+func SimulateMsgMintVested(ak token.AccountKeeper, bk token.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		class, found := randomActiveContract(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgMintVested, "no active contract"), nil, nil
+		}
+
+		limit := sdk.NewInt(maxAmount)
+		if maxSupply, err := k.GetMaxSupply(ctx, class.Id); err == nil && maxSupply.IsPositive() {
+			supply := k.GetMinted(ctx, class.Id).Sub(k.GetBurnt(ctx, class.Id))
+			limit = sdk.MinInt(limit, maxSupply.Sub(supply))
+		}
+		if !limit.IsPositive() {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgMintVested, "max supply reached"), nil, nil
+		}
+
+		grantee, found := randomAccount(r, accs, hasPermission(ctx, k, class.Id, token.PermissionMint))
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgMintVested, "no minter"), nil, nil
+		}
+		to, found := randomAccount(r, accs, canVest(ctx, k, class.Id))
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgMintVested, "no recipient"), nil, nil
+		}
+
+		amount := randomPositiveAmount(r, limit)
+		msg := &token.MsgMintVested{
+			ContractId: class.Id,
+			From:       grantee.Address.String(),
+			To:         to.Address.String(),
+			Amount:     amount,
+			Schedule:   randomVestingSchedule(r, ctx, amount),
+		}
+
+		return deliver(r, app, ctx, ak, bk, grantee, msg, nil)
+	}
+}
+
+// SimulateMsgSendVested generates a MsgSendVested with random values.
+func SimulateMsgSendVested(ak token.AccountKeeper, bk token.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		class, found := randomActiveContract(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgSendVested, "no active contract"), nil, nil
+		}
+
+		isMinter := hasPermission(ctx, k, class.Id, token.PermissionMint)
+		from, found := randomAccount(r, accs, func(acc simtypes.Account) bool {
+			return isMinter(acc) && isSpendable(ctx, k, class.Id)(acc)
+		})
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgSendVested, "no minter"), nil, nil
+		}
+		to, found := randomAccount(r, accs, canVest(ctx, k, class.Id))
+		if !found {
+			return simtypes.NoOpMsg(token.ModuleName, TypeMsgSendVested, "no recipient"), nil, nil
+		}
+
+		amount := randomPositiveAmount(r, k.GetSpendable(ctx, class.Id, from.Address))
+		msg := &token.MsgSendVested{
+			ContractId: class.Id,
+			From:       from.Address.String(),
+			To:         to.Address.String(),
+			Amount:     amount,
+			Schedule:   randomVestingSchedule(r, ctx, amount),
+		}
+
+		return deliver(r, app, ctx, ak, bk, from, msg, nil)
+	}
+}
+
+// deliver generates a transaction of the message with random fees and delivers it.
+func deliver(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak token.AccountKeeper, bk token.BankKeeper,
+	signer simtypes.Account, msg sdk.Msg, coinsSpentInMsg sdk.Coins,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+		Cdc:             nil,
+		Msg:             msg,
+		MsgType:         sdk.MsgTypeURL(msg),
+		Context:         ctx,
+		SimAccount:      signer,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      token.ModuleName,
+		CoinsSpentInMsg: coinsSpentInMsg,
+	}
+
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
+
+// randomContract returns a random contract, if any.
+func randomContract(r *rand.Rand, ctx sdk.Context, k keeper.Keeper) (*token.Contract, bool) {
+	res, err := keeper.NewQueryServer(k).Contracts(sdk.WrapSDKContext(ctx), &token.QueryContractsRequest{})
+	if err != nil {
+		panic(err)
+	}
+	if len(res.Contracts) == 0 {
+		return nil, false
+	}
+
+	return &res.Contracts[r.Intn(len(res.Contracts))], true
+}
+
+// randomActiveContract returns a random contract which is not paused, if any.
+func randomActiveContract(r *rand.Rand, ctx sdk.Context, k keeper.Keeper) (*token.Contract, bool) {
+	class, found := randomContract(r, ctx, k)
+	if !found || k.IsPaused(ctx, class.Id) {
+		return nil, false
+	}
+
+	return class, true
+}
+
+// randomAccount returns a random account satisfying the condition, if any.
+func randomAccount(r *rand.Rand, accs []simtypes.Account, cond func(acc simtypes.Account) bool) (simtypes.Account, bool) {
+	for _, i := range r.Perm(len(accs)) {
+		if cond(accs[i]) {
+			return accs[i], true
+		}
+	}
+
+	return simtypes.Account{}, false
+}
+
+// randomAuthorization returns a random pair of a holder and its operator satisfying the conditions, if any.
+func randomAuthorization(
+	r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account, contractID string,
+	holderCond, operatorCond func(acc simtypes.Account) bool,
+) (holder, operator simtypes.Account, found bool) {
+	queryServer := keeper.NewQueryServer(k)
+	for _, i := range r.Perm(len(accs)) {
+		operator = accs[i]
+		if !operatorCond(operator) {
+			continue
+		}
+
+		res, err := queryServer.HoldersByOperator(sdk.WrapSDKContext(ctx), &token.QueryHoldersByOperatorRequest{
+			ContractId: contractID,
+			Operator:   operator.Address.String(),
+		})
+		if err != nil {
+			panic(err)
+		}
+
+		for _, j := range r.Perm(len(res.Holders)) {
+			holder, found = simtypes.FindAccount(accs, sdk.MustAccAddressFromBech32(res.Holders[j]))
+			if found && holderCond(holder) {
+				return holder, operator, true
+			}
+		}
+	}
+
+	return simtypes.Account{}, simtypes.Account{}, false
+}
+
+// randomAllowance returns a random pair of an owner satisfying the condition and its spender, if any.
+func randomAllowance(
+	r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account, contractID string,
+	ownerCond func(acc simtypes.Account) bool,
+) (owner, spender simtypes.Account, found bool) {
+	queryServer := keeper.NewQueryServer(k)
+	for _, i := range r.Perm(len(accs)) {
+		owner = accs[i]
+		if !ownerCond(owner) {
+			continue
+		}
+
+		res, err := queryServer.AllowancesByOwner(sdk.WrapSDKContext(ctx), &token.QueryAllowancesByOwnerRequest{
+			ContractId: contractID,
+			Owner:      owner.Address.String(),
+		})
+		if err != nil {
+			panic(err)
+		}
+
+		for _, j := range r.Perm(len(res.Allowances)) {
+			spender, found = simtypes.FindAccount(accs, sdk.MustAccAddressFromBech32(res.Allowances[j].Spender))
+			if found {
+				return owner, spender, true
+			}
+		}
+	}
+
+	return simtypes.Account{}, simtypes.Account{}, false
+}
+
+func randomPermission(r *rand.Rand) token.Permission {
+	permissions := []token.Permission{
+		token.PermissionModify,
+		token.PermissionMint,
+		token.PermissionBurn,
+		token.PermissionPause,
+		token.PermissionFreeze,
+	}
+
+	return permissions[r.Intn(len(permissions))]
+}
+
+// randomPositiveAmount returns a random amount in [1, max].
+func randomPositiveAmount(r *rand.Rand, max sdk.Int) sdk.Int {
+	return simtypes.RandomAmount(r, max.SubRaw(1)).AddRaw(1)
+}
+
+// randomVestingSchedule returns a random continuous or periodic schedule locking the amount,
+// which starts around the block time.
+func randomVestingSchedule(r *rand.Rand, ctx sdk.Context, amount sdk.Int) token.VestingSchedule {
+	startTime := ctx.BlockTime().Unix() + int64(simtypes.RandIntBetween(r, -maxVestingDuration, maxVestingDuration))
+	if startTime < 0 {
+		startTime = 0
+	}
+
+	numPeriods := r.Intn(maxVestingPeriods + 1)
+	if amount.LT(sdk.NewInt(int64(numPeriods))) {
+		numPeriods = int(amount.Int64())
+	}
+	if numPeriods == 0 {
+		return token.VestingSchedule{
+			StartTime: startTime,
+			EndTime:   startTime + int64(simtypes.RandIntBetween(r, 1, maxVestingDuration)),
+		}
+	}
+
+	// leave at least one token for each of the remaining periods
+	remaining := amount
+	periods := make([]token.VestingPeriod, numPeriods)
+	for i := range periods {
+		periodAmount := remaining
+		if i != numPeriods-1 {
+			periodAmount = randomPositiveAmount(r, remaining.SubRaw(int64(numPeriods-i-1)))
+		}
+		remaining = remaining.Sub(periodAmount)
+
+		periods[i] = token.VestingPeriod{
+			Length: int64(simtypes.RandIntBetween(r, 1, maxVestingDuration/numPeriods)),
+			Amount: periodAmount,
+		}
+	}
+
+	return token.VestingSchedule{
+		StartTime: startTime,
+		Periods:   periods,
+	}
+}
+
+func anyAccount(simtypes.Account) bool {
+	return true
+}
+
+func hasPermission(ctx sdk.Context, k keeper.Keeper, contractID string, permission token.Permission) func(acc simtypes.Account) bool {
+	return func(acc simtypes.Account) bool {
+		_, err := k.GetGrant(ctx, contractID, acc.Address, permission)
+		return err == nil
+	}
+}
+
+func isOperatorOf(ctx sdk.Context, k keeper.Keeper, contractID string, holder simtypes.Account) func(acc simtypes.Account) bool {
+	return func(acc simtypes.Account) bool {
+		_, err := k.GetAuthorization(ctx, contractID, holder.Address, acc.Address)
+		return err == nil
+	}
+}
+
+func isNotFrozen(ctx sdk.Context, k keeper.Keeper, contractID string) func(acc simtypes.Account) bool {
+	return func(acc simtypes.Account) bool {
+		return !k.IsFrozen(ctx, contractID, acc.Address)
+	}
+}
+
+// canVest returns whether the account can receive a new vesting.
+func canVest(ctx sdk.Context, k keeper.Keeper, contractID string) func(acc simtypes.Account) bool {
+	return func(acc simtypes.Account) bool {
+		if !isNotFrozen(ctx, k, contractID)(acc) {
+			return false
+		}
+
+		res, err := keeper.NewQueryServer(k).Vestings(sdk.WrapSDKContext(ctx), &token.QueryVestingsRequest{
+			ContractId: contractID,
+			Address:    acc.Address.String(),
+		})
+		if err != nil {
+			panic(err)
+		}
+
+		numVestings := 0
+		for _, vesting := range res.Vestings {
+			if vesting.LockedAt(ctx.BlockTime().Unix()).IsPositive() {
+				numVestings++
+			}
+		}
+
+		return numVestings < int(k.GetParams(ctx).MaxVestingsPerHolder)
+	}
+}
+
+// isSpendable returns whether the account can send its tokens.
+func isSpendable(ctx sdk.Context, k keeper.Keeper, contractID string) func(acc simtypes.Account) bool {
+	return func(acc simtypes.Account) bool {
+		return isNotFrozen(ctx, k, contractID)(acc) && k.GetSpendable(ctx, contractID, acc.Address).IsPositive()
+	}
+}
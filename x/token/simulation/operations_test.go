@@ -0,0 +1,266 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/Finschia/finschia-sdk/simapp"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	simtypes "github.com/Finschia/finschia-sdk/types/simulation"
+	"github.com/Finschia/finschia-sdk/x/token"
+	"github.com/Finschia/finschia-sdk/x/token/simulation"
+)
+
+type SimTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *simapp.SimApp
+}
+
+func (suite *SimTestSuite) SetupTest() {
+	checkTx := false
+	app := simapp.Setup(checkTx)
+	suite.app = app
+	suite.ctx = app.BaseApp.NewContext(checkTx, tmproto.Header{})
+}
+
+func (suite *SimTestSuite) TestWeightedOperations() {
+	cdc := suite.app.AppCodec()
+	appParams := make(simtypes.AppParams)
+
+	weightedOps := simulation.WeightedOperations(appParams, cdc, suite.app.AccountKeeper,
+		suite.app.BankKeeper, suite.app.TokenKeeper,
+	)
+
+	// setup 3 accounts
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accs := suite.getTestingAccounts(r, 3)
+
+	suite.beginBlock()
+
+	expected := []struct {
+		weight     int
+		opMsgRoute string
+		opMsgName  string
+	}{
+		{simulation.WeightSend, token.ModuleName, simulation.TypeMsgSend},
+		{simulation.WeightOperatorSend, token.ModuleName, simulation.TypeMsgOperatorSend},
+		{simulation.WeightAuthorizeOperator, token.ModuleName, simulation.TypeMsgAuthorizeOperator},
+		{simulation.WeightRevokeOperator, token.ModuleName, simulation.TypeMsgRevokeOperator},
+		{simulation.WeightIssue, token.ModuleName, simulation.TypeMsgIssue},
+		{simulation.WeightGrantPermission, token.ModuleName, simulation.TypeMsgGrantPermission},
+		{simulation.WeightRevokePermission, token.ModuleName, simulation.TypeMsgRevokePermission},
+		{simulation.WeightMint, token.ModuleName, simulation.TypeMsgMint},
+		{simulation.WeightBurn, token.ModuleName, simulation.TypeMsgBurn},
+		{simulation.WeightOperatorBurn, token.ModuleName, simulation.TypeMsgOperatorBurn},
+		{simulation.WeightModify, token.ModuleName, simulation.TypeMsgModify},
+		{simulation.WeightPause, token.ModuleName, simulation.TypeMsgPause},
+		{simulation.WeightUnpause, token.ModuleName, simulation.TypeMsgUnpause},
+		{simulation.WeightFreeze, token.ModuleName, simulation.TypeMsgFreeze},
+		{simulation.WeightUnfreeze, token.ModuleName, simulation.TypeMsgUnfreeze},
+		{simulation.WeightApprove, token.ModuleName, simulation.TypeMsgApprove},
+		{simulation.WeightTransferFrom, token.ModuleName, simulation.TypeMsgTransferFrom},
+		{simulation.WeightWrap, token.ModuleName, simulation.TypeMsgWrap},
+		{simulation.WeightUnwrap, token.ModuleName, simulation.TypeMsgUnwrap},
+		{simulation.WeightMultiSend, token.ModuleName, simulation.TypeMsgMultiSend},
+		{simulation.WeightMintVested, token.ModuleName, simulation.TypeMsgMintVested},
+		{simulation.WeightSendVested, token.ModuleName, simulation.TypeMsgSendVested},
+	}
+	suite.Require().Len(weightedOps, len(expected))
+
+	for i, w := range weightedOps {
+		operationMsg, _, err := w.Op()(r, suite.app.BaseApp, suite.ctx, accs, "")
+		suite.Require().NoError(err)
+		// the following checks are very much dependent from the ordering of the output given
+		// by WeightedOperations. if the ordering in WeightedOperations changes some tests
+		// will fail
+		suite.Require().Equal(expected[i].weight, w.Weight(), "weight should be the same")
+		suite.Require().Equal(expected[i].opMsgRoute, operationMsg.Route, "route should be the same")
+		suite.Require().Equal(expected[i].opMsgName, operationMsg.Name, "operation Msg name should be the same")
+	}
+}
+
+func (suite *SimTestSuite) getTestingAccounts(r *rand.Rand, n int) []simtypes.Account {
+	accounts := simtypes.RandomAccounts(r, n)
+
+	initAmt := suite.app.StakingKeeper.TokensFromConsensusPower(suite.ctx, 200000)
+	initCoins := sdk.NewCoins(sdk.NewCoin("stake", initAmt))
+
+	// add coins to the accounts
+	for _, account := range accounts {
+		acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, account.Address)
+		suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+		suite.Require().NoError(simapp.FundAccount(suite.app, suite.ctx, account.Address, initCoins))
+	}
+
+	return accounts
+}
+
+func (suite *SimTestSuite) beginBlock() {
+	suite.app.BeginBlock(abci.RequestBeginBlock{
+		Header: tmproto.Header{
+			Height:  suite.app.LastBlockHeight() + 1,
+			AppHash: suite.app.LastCommitID().Hash,
+		},
+	})
+}
+
+func (suite *SimTestSuite) issue(owner sdk.AccAddress, amount sdk.Int) string {
+	class := token.Contract{
+		Name:   "Test",
+		Symbol: "TT",
+	}
+	return suite.app.TokenKeeper.Issue(suite.ctx, class, owner, owner, amount)
+}
+
+func (suite *SimTestSuite) TestSimulateMsgIssue() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 3)
+
+	suite.beginBlock()
+
+	// execute operation
+	op := simulation.SimulateMsgIssue(suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.TokenKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg token.MsgIssue
+	err = token.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+	suite.Require().NoError(err)
+
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Equal(simulation.TypeMsgIssue, operationMsg.Name)
+	suite.Require().NoError(msg.ValidateBasic())
+	suite.Require().Len(futureOperations, 0)
+}
+
+func (suite *SimTestSuite) TestSimulateMsgSend() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 3)
+
+	suite.beginBlock()
+
+	holder := accounts[0]
+	amount := sdk.NewInt(1000)
+	contractID := suite.issue(holder.Address, amount)
+
+	// execute operation
+	op := simulation.SimulateMsgSend(suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.TokenKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg token.MsgSend
+	err = token.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+	suite.Require().NoError(err)
+
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Equal(contractID, msg.ContractId)
+	suite.Require().Equal(holder.Address.String(), msg.From)
+	suite.Require().True(msg.Amount.IsPositive())
+	suite.Require().True(msg.Amount.LTE(amount))
+	suite.Require().Len(futureOperations, 0)
+}
+
+func (suite *SimTestSuite) TestSimulateMsgMultiSend() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 3)
+
+	suite.beginBlock()
+
+	holder := accounts[0]
+	amount := sdk.NewInt(1000)
+	contractID := suite.issue(holder.Address, amount)
+
+	// execute operation
+	op := simulation.SimulateMsgMultiSend(suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.TokenKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg token.MsgMultiSend
+	err = token.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+	suite.Require().NoError(err)
+
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Equal(contractID, msg.ContractId)
+	suite.Require().Equal(holder.Address.String(), msg.From)
+	suite.Require().NotEmpty(msg.Outputs)
+
+	sum := sdk.ZeroInt()
+	for _, output := range msg.Outputs {
+		suite.Require().True(output.Amount.IsPositive())
+		sum = sum.Add(output.Amount)
+	}
+	suite.Require().True(sum.LTE(amount))
+	suite.Require().Len(futureOperations, 0)
+}
+
+func (suite *SimTestSuite) TestSimulateMsgSendVested() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 3)
+
+	suite.beginBlock()
+
+	holder := accounts[0]
+	amount := sdk.NewInt(1000)
+	contractID := suite.issue(holder.Address, amount)
+	suite.app.TokenKeeper.Grant(suite.ctx, contractID, nil, holder.Address, token.PermissionMint)
+
+	// execute operation
+	op := simulation.SimulateMsgSendVested(suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.TokenKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg token.MsgSendVested
+	err = token.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+	suite.Require().NoError(err)
+
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Equal(contractID, msg.ContractId)
+	suite.Require().Equal(holder.Address.String(), msg.From)
+	suite.Require().True(msg.Amount.LTE(amount))
+	suite.Require().NoError(msg.ValidateBasic())
+	suite.Require().Len(futureOperations, 0)
+}
+
+func (suite *SimTestSuite) TestSimulateMsgUnwrap() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 3)
+
+	suite.beginBlock()
+
+	holder := accounts[0]
+	amount := sdk.NewInt(1000)
+	contractID := suite.issue(holder.Address, amount)
+	err := suite.app.TokenKeeper.Wrap(suite.ctx, contractID, holder.Address, amount)
+	suite.Require().NoError(err)
+
+	// execute operation
+	op := simulation.SimulateMsgUnwrap(suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.TokenKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg token.MsgUnwrap
+	err = token.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+	suite.Require().NoError(err)
+
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Equal(contractID, msg.ContractId)
+	suite.Require().Equal(holder.Address.String(), msg.From)
+	suite.Require().True(msg.Amount.LTE(amount))
+	suite.Require().Len(futureOperations, 0)
+}
+
+func TestSimTestSuite(t *testing.T) {
+	suite.Run(t, new(SimTestSuite))
+}
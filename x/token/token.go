@@ -2,12 +2,90 @@ package token
 
 import (
 	"strings"
+
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	paramtypes "github.com/Finschia/finschia-sdk/x/params/types"
 )
 
 const (
 	prefixLegacyPermission = "LEGACY_PERMISSION_"
+
+	// WrappedDenomPrefix is the prefix of the denoms of the coins wrapping tokens.
+	WrappedDenomPrefix = "token/"
+
+	// DefaultMaxMultiSendOutputs is the default limit on the number of the outputs in MsgMultiSend.
+	DefaultMaxMultiSendOutputs uint32 = 100
+
+	// DefaultMaxVestingsPerHolder is the default limit on the number of the vestings of a holder.
+	DefaultMaxVestingsPerHolder uint32 = 20
 )
 
+// WrappedDenom returns the denom of the coins wrapping the tokens of the contract.
+func WrappedDenom(contractID string) string {
+	return WrappedDenomPrefix + contractID
+}
+
+// DefaultParams returns the default parameters of the module.
+func DefaultParams() Params {
+	return Params{
+		MaxMultiSendOutputs:  DefaultMaxMultiSendOutputs,
+		MaxVestingsPerHolder: DefaultMaxVestingsPerHolder,
+	}
+}
+
+func (p Params) ValidateBasic() error {
+	if err := validateMaxMultiSendOutputs(p.MaxMultiSendOutputs); err != nil {
+		return err
+	}
+	if err := validateMaxVestingsPerHolder(p.MaxVestingsPerHolder); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair([]byte(ParamKeyMaxMultiSendOutputs), &p.MaxMultiSendOutputs, func(i interface{}) error {
+			v, ok := i.(uint32)
+			if !ok {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidType.Wrapf("%T", i), ParamKeyMaxMultiSendOutputs)
+			}
+
+			return validateMaxMultiSendOutputs(v)
+		}),
+		paramtypes.NewParamSetPair([]byte(ParamKeyMaxVestingsPerHolder), &p.MaxVestingsPerHolder, func(i interface{}) error {
+			v, ok := i.(uint32)
+			if !ok {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidType.Wrapf("%T", i), ParamKeyMaxVestingsPerHolder)
+			}
+
+			return validateMaxVestingsPerHolder(v)
+		}),
+	}
+}
+
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func validateMaxMultiSendOutputs(limit uint32) error {
+	if limit == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("%s must be positive", ParamKeyMaxMultiSendOutputs)
+	}
+
+	return nil
+}
+
+func validateMaxVestingsPerHolder(limit uint32) error {
+	if limit == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("%s must be positive", ParamKeyMaxVestingsPerHolder)
+	}
+
+	return nil
+}
+
 func (x LegacyPermission) String() string {
 	lenPrefix := len(prefixLegacyPermission)
 	return strings.ToLower(LegacyPermission_name[int32(x)][lenPrefix:])
@@ -5,6 +5,7 @@ package token
 
 import (
 	fmt "fmt"
+	github_com_Finschia_finschia_sdk_types "github.com/Finschia/finschia-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -35,6 +36,14 @@ const (
 	PermissionMint Permission = 2
 	// PERMISSION_BURN defines a permission to burn tokens of a contract.
 	PermissionBurn Permission = 3
+	// PERMISSION_PAUSE defines a permission to pause or unpause a contract.
+	//
+	// Since: 0.49.0 (finschia)
+	PermissionPause Permission = 4
+	// PERMISSION_FREEZE defines a permission to freeze or unfreeze accounts on a contract.
+	//
+	// Since: 0.49.0 (finschia)
+	PermissionFreeze Permission = 5
 )
 
 var Permission_name = map[int32]string{
@@ -42,6 +51,8 @@ var Permission_name = map[int32]string{
 	1: "PERMISSION_MODIFY",
 	2: "PERMISSION_MINT",
 	3: "PERMISSION_BURN",
+	4: "PERMISSION_PAUSE",
+	5: "PERMISSION_FREEZE",
 }
 
 var Permission_value = map[string]int32{
@@ -49,6 +60,8 @@ var Permission_value = map[string]int32{
 	"PERMISSION_MODIFY":      1,
 	"PERMISSION_MINT":        2,
 	"PERMISSION_BURN":        3,
+	"PERMISSION_PAUSE":       4,
+	"PERMISSION_FREEZE":      5,
 }
 
 func (x Permission) String() string {
@@ -73,6 +86,10 @@ const (
 	LegacyPermissionMint LegacyPermission = 2
 	// burn defines a permission to burn tokens of a contract.
 	LegacyPermissionBurn LegacyPermission = 3
+	// pause defines a permission to pause or unpause a contract.
+	LegacyPermissionPause LegacyPermission = 4
+	// freeze defines a permission to freeze or unfreeze accounts on a contract.
+	LegacyPermissionFreeze LegacyPermission = 5
 )
 
 var LegacyPermission_name = map[int32]string{
@@ -80,6 +97,8 @@ var LegacyPermission_name = map[int32]string{
 	1: "LEGACY_PERMISSION_MODIFY",
 	2: "LEGACY_PERMISSION_MINT",
 	3: "LEGACY_PERMISSION_BURN",
+	4: "LEGACY_PERMISSION_PAUSE",
+	5: "LEGACY_PERMISSION_FREEZE",
 }
 
 var LegacyPermission_value = map[string]int32{
@@ -87,6 +106,8 @@ var LegacyPermission_value = map[string]int32{
 	"LEGACY_PERMISSION_MODIFY":      1,
 	"LEGACY_PERMISSION_MINT":        2,
 	"LEGACY_PERMISSION_BURN":        3,
+	"LEGACY_PERMISSION_PAUSE":       4,
+	"LEGACY_PERMISSION_FREEZE":      5,
 }
 
 func (LegacyPermission) EnumDescriptor() ([]byte, []int) {
@@ -97,6 +118,14 @@ func (LegacyPermission) EnumDescriptor() ([]byte, []int) {
 //
 // Deprecated: Do not use.
 type Params struct {
+	// maximum number of outputs allowed in a single MsgMultiSend.
+	//
+	// Since: 0.49.0 (finschia)
+	MaxMultiSendOutputs uint32 `protobuf:"varint,1,opt,name=max_multi_send_outputs,json=maxMultiSendOutputs,proto3" json:"max_multi_send_outputs,omitempty"`
+	// maximum number of vestings which lock the tokens of a holder at once.
+	//
+	// Since: 0.49.0 (finschia)
+	MaxVestingsPerHolder uint32 `protobuf:"varint,2,opt,name=max_vestings_per_holder,json=maxVestingsPerHolder,proto3" json:"max_vestings_per_holder,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -150,6 +179,11 @@ type Contract struct {
 	Decimals int32 `protobuf:"varint,6,opt,name=decimals,proto3" json:"decimals,omitempty"`
 	// mintable represents whether the token is allowed to mint or burn.
 	Mintable bool `protobuf:"varint,7,opt,name=mintable,proto3" json:"mintable,omitempty"`
+	// max_supply is the hard cap on the number of tokens in existence (minted minus burnt).
+	// zero means that there is no cap.
+	//
+	// Since: 0.49.0 (finschia)
+	MaxSupply github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,8,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"max_supply"`
 }
 
 func (m *Contract) Reset()         { *m = Contract{} }
@@ -269,6 +303,239 @@ func (m *Authorization) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Authorization proto.InternalMessageInfo
 
+// Allowance defines an amount of tokens which the spender is allowed to transfer on behalf of the owner.
+//
+// Since: 0.49.0 (finschia)
+//
+// Deprecated: Do not use.
+type Allowance struct {
+	// address of the token holder which approves the allowance.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// address of the spender which the allowance is given to.
+	Spender string `protobuf:"bytes,2,opt,name=spender,proto3" json:"spender,omitempty"`
+	// remaining amount of the allowance.
+	Amount github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"amount"`
+}
+
+func (m *Allowance) Reset()         { *m = Allowance{} }
+func (m *Allowance) String() string { return proto.CompactTextString(m) }
+func (*Allowance) ProtoMessage()    {}
+func (*Allowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cc82dfde9e68378, []int{4}
+}
+func (m *Allowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Allowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Allowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Allowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Allowance.Merge(m, src)
+}
+func (m *Allowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *Allowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_Allowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Allowance proto.InternalMessageInfo
+
+// VestingPeriod defines a period of the periodic vesting.
+//
+// Since: 0.49.0 (finschia)
+//
+// Deprecated: Do not use.
+type VestingPeriod struct {
+	// length of the period, in seconds.
+	Length int64 `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+	// amount of tokens vested at the end of the period.
+	Amount github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"amount"`
+}
+
+func (m *VestingPeriod) Reset()         { *m = VestingPeriod{} }
+func (m *VestingPeriod) String() string { return proto.CompactTextString(m) }
+func (*VestingPeriod) ProtoMessage()    {}
+func (*VestingPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cc82dfde9e68378, []int{5}
+}
+func (m *VestingPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingPeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingPeriod.Merge(m, src)
+}
+func (m *VestingPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingPeriod proto.InternalMessageInfo
+
+// VestingSchedule defines how locked tokens vest over time.
+// The vesting is continuous if it has no periods, and periodic otherwise.
+//
+// Since: 0.49.0 (finschia)
+//
+// Deprecated: Do not use.
+type VestingSchedule struct {
+	// start time of the vesting, in unix seconds.
+	StartTime int64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end time of the continuous vesting, in unix seconds.
+	// it must be empty for the periodic vesting.
+	EndTime int64 `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// periods of the periodic vesting.
+	Periods []VestingPeriod `protobuf:"bytes,3,rep,name=periods,proto3" json:"periods"`
+}
+
+func (m *VestingSchedule) Reset()         { *m = VestingSchedule{} }
+func (m *VestingSchedule) String() string { return proto.CompactTextString(m) }
+func (*VestingSchedule) ProtoMessage()    {}
+func (*VestingSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cc82dfde9e68378, []int{6}
+}
+func (m *VestingSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingSchedule.Merge(m, src)
+}
+func (m *VestingSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingSchedule proto.InternalMessageInfo
+
+// Vesting defines tokens of a holder locked by a vesting schedule.
+//
+// Since: 0.49.0 (finschia)
+//
+// Deprecated: Do not use.
+type Vesting struct {
+	// address of the token holder.
+	Holder string `protobuf:"bytes,1,opt,name=holder,proto3" json:"holder,omitempty"`
+	// amount of tokens locked at the start of the vesting.
+	OriginalAmount github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,2,opt,name=original_amount,json=originalAmount,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"original_amount"`
+	// schedule of the vesting.
+	Schedule VestingSchedule `protobuf:"bytes,3,opt,name=schedule,proto3" json:"schedule"`
+}
+
+func (m *Vesting) Reset()         { *m = Vesting{} }
+func (m *Vesting) String() string { return proto.CompactTextString(m) }
+func (*Vesting) ProtoMessage()    {}
+func (*Vesting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cc82dfde9e68378, []int{7}
+}
+func (m *Vesting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Vesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Vesting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Vesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vesting.Merge(m, src)
+}
+func (m *Vesting) XXX_Size() int {
+	return m.Size()
+}
+func (m *Vesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vesting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Vesting proto.InternalMessageInfo
+
+// ContractBalance defines the balance of an account on a contract.
+//
+// Since: 0.49.0 (finschia)
+//
+// Deprecated: Do not use.
+type ContractBalance struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// amount of the balance.
+	Amount github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"amount"`
+}
+
+func (m *ContractBalance) Reset()         { *m = ContractBalance{} }
+func (m *ContractBalance) String() string { return proto.CompactTextString(m) }
+func (*ContractBalance) ProtoMessage()    {}
+func (*ContractBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cc82dfde9e68378, []int{8}
+}
+func (m *ContractBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractBalance.Merge(m, src)
+}
+func (m *ContractBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractBalance proto.InternalMessageInfo
+
 // Grant defines permission given to a grantee.
 //
 // Deprecated: Do not use.
@@ -283,7 +550,7 @@ func (m *Grant) Reset()         { *m = Grant{} }
 func (m *Grant) String() string { return proto.CompactTextString(m) }
 func (*Grant) ProtoMessage()    {}
 func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1cc82dfde9e68378, []int{4}
+	return fileDescriptor_1cc82dfde9e68378, []int{9}
 }
 func (m *Grant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -319,50 +586,80 @@ func init() {
 	proto.RegisterType((*Contract)(nil), "lbm.token.v1.Contract")
 	proto.RegisterType((*Attribute)(nil), "lbm.token.v1.Attribute")
 	proto.RegisterType((*Authorization)(nil), "lbm.token.v1.Authorization")
+	proto.RegisterType((*Allowance)(nil), "lbm.token.v1.Allowance")
+	proto.RegisterType((*VestingPeriod)(nil), "lbm.token.v1.VestingPeriod")
+	proto.RegisterType((*VestingSchedule)(nil), "lbm.token.v1.VestingSchedule")
+	proto.RegisterType((*Vesting)(nil), "lbm.token.v1.Vesting")
+	proto.RegisterType((*ContractBalance)(nil), "lbm.token.v1.ContractBalance")
 	proto.RegisterType((*Grant)(nil), "lbm.token.v1.Grant")
 }
 
 func init() { proto.RegisterFile("lbm/token/v1/token.proto", fileDescriptor_1cc82dfde9e68378) }
 
 var fileDescriptor_1cc82dfde9e68378 = []byte{
-	// 590 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x53, 0x4d, 0x4f, 0xdb, 0x40,
-	0x10, 0xb5, 0x03, 0x09, 0x61, 0x44, 0xa9, 0xbb, 0x4a, 0xa3, 0xad, 0xab, 0xba, 0x16, 0x97, 0x46,
-	0x54, 0x4d, 0x44, 0x3f, 0x54, 0xd4, 0x1b, 0x81, 0x80, 0x52, 0x41, 0x88, 0x42, 0x39, 0xd0, 0x0b,
-	0x5a, 0xc7, 0x4b, 0xb2, 0xc2, 0xf6, 0x46, 0xeb, 0x0d, 0x6a, 0xfa, 0x03, 0xaa, 0x2a, 0xa7, 0xfe,
-	0x01, 0x9f, 0xda, 0x03, 0x3f, 0x85, 0x23, 0xc7, 0x1e, 0xdb, 0xf0, 0x47, 0xaa, 0xb5, 0x4d, 0xb0,
-	0x42, 0x7a, 0x7b, 0x6f, 0xe6, 0xbd, 0xd9, 0x79, 0xbb, 0x5a, 0xc0, 0x9e, 0xe3, 0xd7, 0x24, 0x3f,
-	0xa7, 0x41, 0xed, 0x62, 0x23, 0x01, 0xd5, 0x81, 0xe0, 0x92, 0xa3, 0x15, 0xcf, 0xf1, 0xab, 0x49,
-	0xe1, 0x62, 0xc3, 0x2c, 0xf5, 0x78, 0x8f, 0xc7, 0x8d, 0x9a, 0x42, 0x89, 0x66, 0x6d, 0x05, 0x0a,
-	0x6d, 0x22, 0x88, 0x1f, 0x7e, 0xc8, 0x61, 0x7d, 0xed, 0x52, 0x87, 0xe2, 0x36, 0x0f, 0xa4, 0x20,
-	0x5d, 0x89, 0x56, 0x21, 0xc7, 0x5c, 0xac, 0xdb, 0x7a, 0x65, 0xb9, 0x93, 0x63, 0x2e, 0x42, 0xb0,
-	0x18, 0x10, 0x9f, 0xe2, 0x5c, 0x5c, 0x89, 0x31, 0x2a, 0x43, 0x21, 0x1c, 0xf9, 0x0e, 0xf7, 0xf0,
-	0x42, 0x5c, 0x4d, 0x19, 0x32, 0x60, 0x61, 0x28, 0x18, 0x5e, 0x8c, 0x8b, 0x0a, 0x2a, 0xb7, 0x4f,
-	0x25, 0xc1, 0xf9, 0xc4, 0xad, 0x30, 0x32, 0xa1, 0xe8, 0xd2, 0x2e, 0xf3, 0x89, 0x17, 0xe2, 0x82,
-	0xad, 0x57, 0xf2, 0x9d, 0x29, 0x57, 0x3d, 0x9f, 0x05, 0x92, 0x38, 0x1e, 0xc5, 0x4b, 0xb6, 0x5e,
-	0x29, 0x76, 0xa6, 0x3c, 0x5e, 0xf5, 0x3d, 0x2c, 0x6f, 0x49, 0x29, 0x98, 0x33, 0x94, 0x54, 0x1d,
-	0x77, 0x4e, 0x47, 0xe9, 0xae, 0x0a, 0xa2, 0x12, 0xe4, 0x2f, 0x88, 0x37, 0xbc, 0xdd, 0x36, 0x21,
-	0xb1, 0x71, 0x0f, 0x1e, 0x6c, 0x0d, 0x65, 0x9f, 0x0b, 0xf6, 0x95, 0x48, 0xc6, 0x03, 0x95, 0xa1,
-	0xcf, 0x3d, 0x97, 0x8a, 0xd4, 0x9f, 0x32, 0xb5, 0x01, 0x1f, 0x50, 0x41, 0x24, 0x17, 0xe9, 0x94,
-	0x29, 0x8f, 0x07, 0x9d, 0x42, 0x7e, 0x4f, 0x90, 0x40, 0x22, 0x0c, 0x4b, 0x3d, 0x05, 0x28, 0x4d,
-	0x27, 0xdc, 0x52, 0xb4, 0x09, 0x30, 0xa0, 0xc2, 0x67, 0x61, 0xc8, 0x78, 0x10, 0x0f, 0x59, 0x7d,
-	0x8d, 0xab, 0xd9, 0x67, 0xa9, 0xb6, 0xa7, 0xfd, 0x4e, 0x46, 0xab, 0x0e, 0x58, 0xbf, 0xd2, 0x01,
-	0xee, 0xda, 0xe8, 0x1d, 0x94, 0xdb, 0x8d, 0xce, 0x41, 0xf3, 0xe8, 0xa8, 0x79, 0xd8, 0x3a, 0x3d,
-	0x6e, 0x1d, 0xb5, 0x1b, 0xdb, 0xcd, 0xdd, 0x66, 0x63, 0xc7, 0xd0, 0xcc, 0x27, 0xe3, 0xc8, 0x7e,
-	0x7c, 0xa7, 0x3d, 0x0e, 0xc2, 0x01, 0xed, 0xb2, 0x33, 0x46, 0x5d, 0xf4, 0x12, 0x1e, 0x65, 0x6c,
-	0x07, 0x87, 0x3b, 0xcd, 0xdd, 0x13, 0x43, 0x37, 0x4b, 0xe3, 0xc8, 0x36, 0xee, 0x1c, 0x07, 0xdc,
-	0x65, 0x67, 0x23, 0xf4, 0x02, 0x1e, 0x66, 0xc5, 0xcd, 0xd6, 0x27, 0x23, 0x67, 0xa2, 0x71, 0x64,
-	0xaf, 0x66, 0xa4, 0x2c, 0x90, 0x33, 0xc2, 0xfa, 0x71, 0xa7, 0x65, 0x2c, 0xcc, 0x0a, 0xeb, 0x43,
-	0x11, 0x98, 0x8b, 0xdf, 0x7f, 0x5a, 0xda, 0xfa, 0xb7, 0x1c, 0x18, 0xfb, 0xb4, 0x47, 0xba, 0xa3,
-	0x4c, 0xa0, 0x3a, 0x3c, 0xdb, 0x6f, 0xec, 0x6d, 0x6d, 0x9f, 0x9c, 0xfe, 0x37, 0xd7, 0xf3, 0x71,
-	0x64, 0x3f, 0x9d, 0x35, 0x66, 0xd3, 0x6d, 0x02, 0xbe, 0x3f, 0x63, 0x1a, 0xd2, 0x1c, 0x47, 0x76,
-	0x79, 0xd6, 0x9e, 0x46, 0x7d, 0x0b, 0xe5, 0x39, 0xce, 0x24, 0x31, 0x1e, 0x47, 0x76, 0xe9, 0x9e,
-	0x4f, 0xe5, 0x9e, 0xeb, 0x4a, 0xe3, 0xcf, 0x75, 0xc5, 0x97, 0x50, 0x54, 0x97, 0x70, 0xf9, 0xcb,
-	0xd2, 0xea, 0x1f, 0xaf, 0xfe, 0x5a, 0xda, 0xe5, 0xc4, 0xd2, 0xae, 0x26, 0x96, 0x7e, 0x3d, 0xb1,
-	0xf4, 0x3f, 0x13, 0x4b, 0xff, 0x71, 0x63, 0x69, 0xd7, 0x37, 0x96, 0xf6, 0xfb, 0xc6, 0xd2, 0x3e,
-	0x57, 0x7a, 0x4c, 0xf6, 0x87, 0x4e, 0xb5, 0xcb, 0xfd, 0xda, 0x2e, 0x0b, 0xc2, 0x6e, 0x9f, 0x91,
-	0xda, 0x59, 0x0a, 0x5e, 0x85, 0xee, 0x79, 0xed, 0x4b, 0xf2, 0xcb, 0x9d, 0x42, 0xfc, 0x85, 0xdf,
-	0xfc, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x05, 0x39, 0x38, 0xea, 0x02, 0x04, 0x00, 0x00,
+	// 988 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xda, 0xf9, 0x63, 0xbf, 0x90, 0xc4, 0x4c, 0x43, 0xba, 0x75, 0x15, 0x67, 0xd5, 0x0b,
+	0x21, 0x08, 0x5b, 0x4d, 0x29, 0x54, 0x70, 0x40, 0x76, 0x6a, 0x07, 0x57, 0x4d, 0x62, 0xd6, 0x0d,
+	0x52, 0xcb, 0x61, 0x35, 0xf6, 0x4e, 0xec, 0x51, 0x76, 0x67, 0x56, 0xbb, 0xb3, 0x89, 0xdd, 0x23,
+	0x27, 0x64, 0x38, 0xf0, 0x05, 0x2c, 0x21, 0xc1, 0xa1, 0xdf, 0x83, 0x4b, 0x8e, 0x3d, 0x22, 0x0e,
+	0x15, 0x24, 0x5f, 0x02, 0x89, 0x0b, 0x9a, 0xd9, 0x5d, 0xc7, 0x75, 0x5c, 0x21, 0x41, 0x6f, 0xef,
+	0xdf, 0xef, 0xbd, 0xf7, 0xfb, 0xcd, 0x8c, 0x34, 0xa0, 0x3b, 0x6d, 0xb7, 0x2c, 0xf8, 0x09, 0x61,
+	0xe5, 0xd3, 0xbb, 0x91, 0x51, 0xf2, 0x7c, 0x2e, 0x38, 0x7a, 0xc7, 0x69, 0xbb, 0xa5, 0x28, 0x70,
+	0x7a, 0xb7, 0xb0, 0xd6, 0xe5, 0x5d, 0xae, 0x12, 0x65, 0x69, 0x45, 0x35, 0x77, 0xfa, 0xb0, 0xd0,
+	0xc4, 0x3e, 0x76, 0x03, 0x74, 0x0f, 0xd6, 0x5d, 0xdc, 0xb7, 0xdc, 0xd0, 0x11, 0xd4, 0x0a, 0x08,
+	0xb3, 0x2d, 0x1e, 0x0a, 0x2f, 0x14, 0x81, 0xae, 0x19, 0xda, 0xd6, 0xb2, 0x79, 0xc3, 0xc5, 0xfd,
+	0x7d, 0x99, 0x6c, 0x11, 0x66, 0x1f, 0x46, 0x29, 0x74, 0x1f, 0x6e, 0x4a, 0xd0, 0x29, 0x09, 0x04,
+	0x65, 0xdd, 0xc0, 0xf2, 0x88, 0x6f, 0xf5, 0xb8, 0x63, 0x13, 0x5f, 0x4f, 0x2b, 0xd4, 0x9a, 0x8b,
+	0xfb, 0x5f, 0xc7, 0xd9, 0x26, 0xf1, 0xbf, 0x54, 0xb9, 0xcf, 0xd2, 0xba, 0x76, 0xe7, 0x6f, 0x0d,
+	0xb2, 0xbb, 0x9c, 0x09, 0x1f, 0x77, 0x04, 0x5a, 0x81, 0x34, 0xb5, 0xd5, 0xa0, 0x9c, 0x99, 0xa6,
+	0x36, 0x42, 0x30, 0xc7, 0xb0, 0x4b, 0x54, 0x93, 0x9c, 0xa9, 0x6c, 0xb4, 0x0e, 0x0b, 0xc1, 0xc0,
+	0x6d, 0x73, 0x47, 0xcf, 0xa8, 0x68, 0xec, 0xa1, 0x3c, 0x64, 0x42, 0x9f, 0xea, 0x73, 0x2a, 0x28,
+	0x4d, 0x89, 0x76, 0x89, 0xc0, 0xfa, 0x7c, 0x84, 0x96, 0x36, 0x2a, 0x40, 0xd6, 0x26, 0x1d, 0xea,
+	0x62, 0x27, 0xd0, 0x17, 0x0c, 0x6d, 0x6b, 0xde, 0x1c, 0xfb, 0x32, 0xe7, 0x52, 0x26, 0x70, 0xdb,
+	0x21, 0xfa, 0xa2, 0xa1, 0x6d, 0x65, 0xcd, 0xb1, 0x8f, 0xbe, 0x02, 0x90, 0x0c, 0x83, 0xd0, 0xf3,
+	0x9c, 0x81, 0x9e, 0x95, 0x1d, 0xab, 0x3b, 0xe7, 0xaf, 0x36, 0x53, 0xbf, 0xbf, 0xda, 0xdc, 0xee,
+	0x52, 0xd1, 0x0b, 0xdb, 0xa5, 0x0e, 0x77, 0xcb, 0x75, 0xca, 0x82, 0x4e, 0x8f, 0xe2, 0xf2, 0x71,
+	0x6c, 0x7c, 0x14, 0xd8, 0x27, 0x65, 0x31, 0xf0, 0x48, 0x50, 0x6a, 0x30, 0x61, 0xe6, 0x5c, 0xdc,
+	0x6f, 0xa9, 0x26, 0x8a, 0xfd, 0xa7, 0x90, 0xab, 0x08, 0xe1, 0xd3, 0x76, 0x28, 0x88, 0x64, 0x70,
+	0x42, 0x06, 0x31, 0x7d, 0x69, 0xa2, 0x35, 0x98, 0x3f, 0xc5, 0x4e, 0x98, 0x08, 0x10, 0x39, 0x0a,
+	0xb8, 0x07, 0xcb, 0x95, 0x50, 0xf4, 0xb8, 0x4f, 0x9f, 0x63, 0x41, 0x39, 0x93, 0xb2, 0xc4, 0x8a,
+	0x47, 0xf8, 0xd8, 0x93, 0xa4, 0xb8, 0x47, 0x7c, 0x2c, 0xb8, 0x1f, 0x77, 0x19, 0xfb, 0xaa, 0xd1,
+	0xf7, 0x1a, 0xe4, 0x2a, 0x8e, 0xc3, 0xcf, 0x30, 0xeb, 0x10, 0x39, 0x90, 0x9f, 0xb1, 0x71, 0x93,
+	0xc8, 0x41, 0x3a, 0x2c, 0x06, 0x1e, 0x61, 0xc9, 0x71, 0xe6, 0xcc, 0xc4, 0x45, 0x8f, 0x60, 0x01,
+	0xbb, 0x3c, 0x64, 0x42, 0xcf, 0xfc, 0x67, 0x49, 0xe2, 0x0e, 0x6a, 0x9b, 0x33, 0x58, 0x8e, 0xaf,
+	0x49, 0x93, 0xf8, 0x94, 0xdb, 0x92, 0x96, 0x43, 0x58, 0x57, 0xf4, 0xd4, 0x46, 0x19, 0x33, 0xf6,
+	0x26, 0x06, 0xa7, 0xdf, 0xca, 0xe0, 0x1f, 0x34, 0x58, 0x8d, 0x27, 0xb7, 0x3a, 0x3d, 0x62, 0x87,
+	0x0e, 0x41, 0x1b, 0x00, 0x81, 0xc0, 0xbe, 0xb0, 0x04, 0x75, 0x49, 0x3c, 0x3f, 0xa7, 0x22, 0x4f,
+	0xa8, 0x4b, 0xd0, 0x2d, 0xc8, 0xca, 0xe7, 0xa1, 0x92, 0x69, 0x95, 0x5c, 0x24, 0xcc, 0x56, 0xa9,
+	0xcf, 0x61, 0xd1, 0x53, 0xfb, 0x07, 0x7a, 0xc6, 0xc8, 0x6c, 0x2d, 0xed, 0xdc, 0x2e, 0x4d, 0x3e,
+	0xc2, 0xd2, 0x6b, 0x1c, 0xab, 0x73, 0x72, 0x77, 0x33, 0x41, 0xa8, 0x75, 0x7e, 0xd5, 0x60, 0x31,
+	0x2e, 0x7a, 0xe3, 0xc9, 0x7e, 0x03, 0xab, 0xdc, 0xa7, 0x5d, 0xca, 0xb0, 0x63, 0xfd, 0x6f, 0x2d,
+	0x56, 0x92, 0x56, 0x15, 0xd5, 0x09, 0x7d, 0x01, 0xd9, 0x20, 0xd6, 0x41, 0x1d, 0xed, 0xd2, 0xce,
+	0xc6, 0x4c, 0x0a, 0x89, 0x58, 0x31, 0x89, 0x31, 0x48, 0xb1, 0xf8, 0x56, 0x83, 0xd5, 0xe4, 0x6d,
+	0x57, 0xb1, 0xa3, 0x6e, 0xd8, 0x26, 0x2c, 0x75, 0xe2, 0x90, 0x35, 0x7e, 0xeb, 0x90, 0x84, 0x1a,
+	0xf6, 0x5b, 0x3f, 0x59, 0x0b, 0xe6, 0xf7, 0x7c, 0xcc, 0x84, 0xbc, 0xc5, 0x5d, 0x69, 0x10, 0x12,
+	0x4f, 0x4d, 0x5c, 0xf4, 0x00, 0xc0, 0x23, 0xbe, 0x4b, 0x83, 0x80, 0x72, 0xa6, 0xc6, 0xae, 0xec,
+	0xe8, 0xaf, 0xd3, 0x6d, 0x8e, 0xf3, 0xe6, 0x44, 0xad, 0x1c, 0xb0, 0xfd, 0x53, 0x1a, 0xe0, 0x2a,
+	0x8d, 0xee, 0xc3, 0x7a, 0xb3, 0x66, 0xee, 0x37, 0x5a, 0xad, 0xc6, 0xe1, 0x81, 0x75, 0x74, 0xd0,
+	0x6a, 0xd6, 0x76, 0x1b, 0xf5, 0x46, 0xed, 0x61, 0x3e, 0x55, 0xb8, 0x35, 0x1c, 0x19, 0xef, 0x5d,
+	0xd5, 0x1e, 0xb1, 0xc0, 0x23, 0x1d, 0x7a, 0x4c, 0x89, 0x8d, 0x3e, 0x84, 0x77, 0x27, 0x60, 0xfb,
+	0x87, 0x0f, 0x1b, 0xf5, 0xa7, 0x79, 0xad, 0xb0, 0x36, 0x1c, 0x19, 0xf9, 0x2b, 0xc4, 0x3e, 0xb7,
+	0xe9, 0xf1, 0x00, 0xbd, 0x0f, 0xab, 0x93, 0xc5, 0x8d, 0x83, 0x27, 0xf9, 0x74, 0x01, 0x0d, 0x47,
+	0xc6, 0xca, 0x44, 0x29, 0x65, 0x62, 0xaa, 0xb0, 0x7a, 0x64, 0x1e, 0xe4, 0x33, 0xd3, 0x85, 0xd5,
+	0xd0, 0x67, 0xe8, 0x03, 0xc8, 0x4f, 0x14, 0x36, 0x2b, 0x47, 0xad, 0x5a, 0x7e, 0xae, 0x70, 0x63,
+	0x38, 0x32, 0x56, 0xaf, 0x2a, 0x9b, 0x38, 0x0c, 0xc8, 0xd4, 0xa6, 0x75, 0xb3, 0x56, 0x7b, 0x56,
+	0xcb, 0xcf, 0x4f, 0x6f, 0x5a, 0xf7, 0x09, 0x79, 0x4e, 0x0a, 0x73, 0xdf, 0xfd, 0x5c, 0x4c, 0x6d,
+	0xff, 0x95, 0x86, 0xfc, 0x63, 0xd2, 0xc5, 0x9d, 0xc1, 0x84, 0x50, 0x55, 0xd8, 0x78, 0x5c, 0xdb,
+	0xab, 0xec, 0x3e, 0xb5, 0xde, 0xa8, 0xd7, 0xe6, 0x70, 0x64, 0xdc, 0x9e, 0x06, 0x4e, 0xaa, 0xf6,
+	0x00, 0xf4, 0xeb, 0x3d, 0xc6, 0xe2, 0x15, 0x86, 0x23, 0x63, 0x7d, 0x1a, 0x1e, 0x4b, 0xf8, 0x31,
+	0xac, 0xcf, 0x40, 0x46, 0x4a, 0xea, 0xc3, 0x91, 0xb1, 0x76, 0x0d, 0x27, 0xf5, 0x9c, 0x89, 0x8a,
+	0x65, 0x9d, 0x89, 0x52, 0xe2, 0x7e, 0x02, 0x37, 0xaf, 0xa3, 0x12, 0x8d, 0xd5, 0x9d, 0x98, 0x86,
+	0x45, 0x4a, 0xcf, 0x64, 0x37, 0x16, 0x7c, 0x26, 0xbb, 0x58, 0xf6, 0xac, 0x94, 0xfd, 0xc5, 0x2f,
+	0xc5, 0x54, 0xf5, 0xd1, 0xf9, 0x9f, 0xc5, 0xd4, 0x8b, 0x8b, 0x62, 0xea, 0xfc, 0xa2, 0xa8, 0xbd,
+	0xbc, 0x28, 0x6a, 0x7f, 0x5c, 0x14, 0xb5, 0x1f, 0x2f, 0x8b, 0xa9, 0x97, 0x97, 0xc5, 0xd4, 0x6f,
+	0x97, 0xc5, 0xd4, 0xb3, 0xad, 0x7f, 0x7d, 0x58, 0xfd, 0xe8, 0x3f, 0xd1, 0x5e, 0x50, 0x9f, 0x85,
+	0x7b, 0xff, 0x0c, 0x00, 0x05, 0x2f, 0x4d, 0x14, 0x6c, 0x08, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -385,6 +682,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxVestingsPerHolder != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.MaxVestingsPerHolder))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxMultiSendOutputs != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.MaxMultiSendOutputs))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -408,6 +715,16 @@ func (m *Contract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	if m.Mintable {
 		i--
 		if m.Mintable {
@@ -535,7 +852,7 @@ func (m *Authorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Grant) Marshal() (dAtA []byte, err error) {
+func (m *Allowance) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -545,112 +862,342 @@ func (m *Grant) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Grant) MarshalTo(dAtA []byte) (int, error) {
+func (m *Allowance) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Allowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Permission != 0 {
-		i = encodeVarintToken(dAtA, i, uint64(m.Permission))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Spender) > 0 {
+		i -= len(m.Spender)
+		copy(dAtA[i:], m.Spender)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Spender)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.Grantee) > 0 {
-		i -= len(m.Grantee)
-		copy(dAtA[i:], m.Grantee)
-		i = encodeVarintToken(dAtA, i, uint64(len(m.Grantee)))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Owner)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintToken(dAtA []byte, offset int, v uint64) int {
-	offset -= sovToken(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *VestingPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *VestingPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Contract) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *VestingPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovToken(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovToken(uint64(l))
-	}
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovToken(uint64(l))
-	}
-	l = len(m.Uri)
-	if l > 0 {
-		n += 1 + l + sovToken(uint64(l))
-	}
-	l = len(m.Meta)
-	if l > 0 {
-		n += 1 + l + sovToken(uint64(l))
-	}
-	if m.Decimals != 0 {
-		n += 1 + sovToken(uint64(m.Decimals))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
 	}
-	if m.Mintable {
-		n += 2
+	i--
+	dAtA[i] = 0x12
+	if m.Length != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.Length))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Attribute) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *VestingSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovToken(uint64(l))
+	if len(m.Periods) > 0 {
+		for iNdEx := len(m.Periods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Periods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintToken(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovToken(uint64(l))
+	if m.EndTime != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.StartTime != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Authorization) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Vesting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Vesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Holder)
-	if l > 0 {
-		n += 1 + l + sovToken(uint64(l))
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.OriginalAmount.Size()
+		i -= size
+		if _, err := m.OriginalAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Grant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Grant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Permission != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.Permission))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintToken(dAtA []byte, offset int, v uint64) int {
+	offset -= sovToken(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxMultiSendOutputs != 0 {
+		n += 1 + sovToken(uint64(m.MaxMultiSendOutputs))
+	}
+	if m.MaxVestingsPerHolder != 0 {
+		n += 1 + sovToken(uint64(m.MaxVestingsPerHolder))
+	}
+	return n
+}
+
+func (m *Contract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Uri)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Meta)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovToken(uint64(m.Decimals))
+	}
+	if m.Mintable {
+		n += 2
+	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovToken(uint64(l))
+	return n
+}
+
+func (m *Attribute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	return n
+}
+
+func (m *Authorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
 	}
 	l = len(m.Operator)
 	if l > 0 {
@@ -659,6 +1206,92 @@ func (m *Authorization) Size() (n int) {
 	return n
 }
 
+func (m *Allowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Spender)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovToken(uint64(l))
+	return n
+}
+
+func (m *VestingPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Length != 0 {
+		n += 1 + sovToken(uint64(m.Length))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovToken(uint64(l))
+	return n
+}
+
+func (m *VestingSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartTime != 0 {
+		n += 1 + sovToken(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovToken(uint64(m.EndTime))
+	}
+	if len(m.Periods) > 0 {
+		for _, e := range m.Periods {
+			l = e.Size()
+			n += 1 + l + sovToken(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Vesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = m.OriginalAmount.Size()
+	n += 1 + l + sovToken(uint64(l))
+	l = m.Schedule.Size()
+	n += 1 + l + sovToken(uint64(l))
+	return n
+}
+
+func (m *ContractBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovToken(uint64(l))
+	return n
+}
+
 func (m *Grant) Size() (n int) {
 	if m == nil {
 		return 0
@@ -691,25 +1324,574 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowToken
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMultiSendOutputs", wireType)
+			}
+			m.MaxMultiSendOutputs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMultiSendOutputs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVestingsPerHolder", wireType)
+			}
+			m.MaxVestingsPerHolder = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxVestingsPerHolder |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Contract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Contract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Contract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Meta = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mintable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Mintable = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Attribute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Attribute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Attribute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Authorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Authorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Authorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipToken(dAtA[iNdEx:])
@@ -731,7 +1913,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Contract) Unmarshal(dAtA []byte) error {
+func (m *Allowance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -754,15 +1936,15 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Contract: wiretype end group for non-group")
+			return fmt.Errorf("proto: Allowance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Contract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Allowance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -790,11 +1972,11 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -822,11 +2004,11 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Spender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -854,13 +2036,65 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VestingPeriod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingPeriod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			}
+			m.Length = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowToken
@@ -870,27 +2104,14 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Length |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthToken
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthToken
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Uri = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -918,13 +2139,65 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Meta = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VestingSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			m.Decimals = 0
+			m.StartTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowToken
@@ -934,16 +2207,16 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Decimals |= int32(b&0x7F) << shift
+				m.StartTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mintable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			var v int
+			m.EndTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowToken
@@ -953,12 +2226,45 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.EndTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Mintable = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Periods = append(m.Periods, VestingPeriod{})
+			if err := m.Periods[len(m.Periods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipToken(dAtA[iNdEx:])
@@ -980,7 +2286,7 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Attribute) Unmarshal(dAtA []byte) error {
+func (m *Vesting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1003,15 +2309,15 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Attribute: wiretype end group for non-group")
+			return fmt.Errorf("proto: Vesting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Attribute: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Vesting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1039,11 +2345,11 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Holder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1071,7 +2377,42 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			if err := m.OriginalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1094,7 +2435,7 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Authorization) Unmarshal(dAtA []byte) error {
+func (m *ContractBalance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1117,15 +2458,15 @@ func (m *Authorization) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Authorization: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractBalance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Authorization: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractBalance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1153,11 +2494,11 @@ func (m *Authorization) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holder = string(dAtA[iNdEx:postIndex])
+			m.ContractId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1185,7 +2526,9 @@ func (m *Authorization) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -62,6 +62,25 @@ func validateDecimals(decimals int32) error {
 	return nil
 }
 
+// validateMaxSupply validates the max supply of a contract.
+// Zero (or nil) represents that there is no cap.
+func validateMaxSupply(maxSupply sdk.Int) error {
+	if !maxSupply.IsNil() && maxSupply.IsNegative() {
+		return ErrInvalidAmount.Wrapf("max supply cannot be negative: %s", maxSupply)
+	}
+	return nil
+}
+
+// validateMaxSupplyChange validates a new cap given by MsgModify.
+// One cannot remove the cap by MsgModify, so it must be positive.
+func validateMaxSupplyChange(value string) error {
+	maxSupply, ok := sdk.NewIntFromString(value)
+	if !ok || !maxSupply.IsPositive() {
+		return ErrInvalidAmount.Wrapf("max supply must be positive: %s", value)
+	}
+	return nil
+}
+
 func validateAmount(amount sdk.Int) error {
 	if !amount.IsPositive() {
 		return ErrInvalidAmount.Wrapf("amount must be positive: %s", amount)
@@ -82,10 +101,11 @@ func ValidatePermission(permission Permission) error {
 
 func validateChange(change Attribute) error {
 	validators := map[string]func(string) error{
-		AttributeKeyName.String():     validateName,
-		AttributeKeyImageURI.String(): validateURI,
-		AttributeKeyMeta.String():     validateMeta,
-		AttributeKeyURI.String():      validateURI,
+		AttributeKeyName.String():      validateName,
+		AttributeKeyImageURI.String():  validateURI,
+		AttributeKeyMeta.String():      validateMeta,
+		AttributeKeyURI.String():       validateURI,
+		AttributeKeyMaxSupply.String(): validateMaxSupplyChange,
 	}
 
 	validator, ok := validators[change.Key]
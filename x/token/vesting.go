@@ -0,0 +1,99 @@
+package token
+
+import (
+	"math"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+)
+
+// ValidateBasic validates the schedule which locks the given amount of tokens.
+func (s VestingSchedule) ValidateBasic(amount sdk.Int) error {
+	if s.StartTime < 0 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("negative start time: %d", s.StartTime)
+	}
+
+	// continuous vesting
+	if len(s.Periods) == 0 {
+		if s.EndTime <= s.StartTime {
+			return sdkerrors.ErrInvalidRequest.Wrapf("end time must be after the start time: %d <= %d", s.EndTime, s.StartTime)
+		}
+		return nil
+	}
+
+	// periodic vesting
+	if s.EndTime != 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("end time must be empty for periodic vesting")
+	}
+
+	endTime := s.StartTime
+	sum := sdk.ZeroInt()
+	for i, period := range s.Periods {
+		if period.Length <= 0 {
+			return sdkerrors.ErrInvalidRequest.Wrapf("non-positive length of period %d: %d", i, period.Length)
+		}
+		if endTime > math.MaxInt64-period.Length {
+			return sdkerrors.ErrInvalidRequest.Wrap("end time overflows")
+		}
+		endTime += period.Length
+
+		if err := validateAmount(period.Amount); err != nil {
+			return sdkerrors.Wrapf(err, "period %d", i)
+		}
+		sum = sum.Add(period.Amount)
+	}
+	if !sum.Equal(amount) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("sum of the periods does not match the amount: %s != %s", sum, amount)
+	}
+
+	return nil
+}
+
+// GetEndTime returns the time when all the tokens get vested.
+func (s VestingSchedule) GetEndTime() int64 {
+	if len(s.Periods) == 0 {
+		return s.EndTime
+	}
+
+	endTime := s.StartTime
+	for _, period := range s.Periods {
+		endTime += period.Length
+	}
+	return endTime
+}
+
+// LockedAt returns the amount of the tokens still locked at the given time,
+// out of the given amount locked at the start of the vesting.
+func (s VestingSchedule) LockedAt(amount sdk.Int, blockTime int64) sdk.Int {
+	if blockTime < s.StartTime {
+		return amount
+	}
+	if blockTime >= s.GetEndTime() {
+		return sdk.ZeroInt()
+	}
+
+	// continuous vesting
+	if len(s.Periods) == 0 {
+		elapsed := sdk.NewInt(blockTime - s.StartTime)
+		duration := sdk.NewInt(s.EndTime - s.StartTime)
+		vested := amount.Mul(elapsed).Quo(duration)
+		return amount.Sub(vested)
+	}
+
+	// periodic vesting
+	locked := amount
+	periodEnd := s.StartTime
+	for _, period := range s.Periods {
+		periodEnd += period.Length
+		if blockTime < periodEnd {
+			break
+		}
+		locked = locked.Sub(period.Amount)
+	}
+	return locked
+}
+
+// LockedAt returns the amount of the tokens still locked at the given time.
+func (v Vesting) LockedAt(blockTime int64) sdk.Int {
+	return v.Schedule.LockedAt(v.OriginalAmount, blockTime)
+}
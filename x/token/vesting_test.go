@@ -0,0 +1,165 @@
+package token_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/token"
+)
+
+func TestVestingScheduleValidateBasic(t *testing.T) {
+	testCases := map[string]struct {
+		schedule token.VestingSchedule
+		amount   sdk.Int
+		err      error
+	}{
+		"valid continuous vesting": {
+			schedule: token.VestingSchedule{
+				StartTime: 100,
+				EndTime:   200,
+			},
+			amount: sdk.NewInt(10),
+		},
+		"valid periodic vesting": {
+			schedule: token.VestingSchedule{
+				StartTime: 100,
+				Periods: []token.VestingPeriod{
+					{Length: 10, Amount: sdk.NewInt(3)},
+					{Length: 20, Amount: sdk.NewInt(7)},
+				},
+			},
+			amount: sdk.NewInt(10),
+		},
+		"negative start time": {
+			schedule: token.VestingSchedule{
+				StartTime: -1,
+				EndTime:   200,
+			},
+			amount: sdk.NewInt(10),
+			err:    sdkerrors.ErrInvalidRequest,
+		},
+		"end time before start time": {
+			schedule: token.VestingSchedule{
+				StartTime: 100,
+				EndTime:   100,
+			},
+			amount: sdk.NewInt(10),
+			err:    sdkerrors.ErrInvalidRequest,
+		},
+		"end time of periodic vesting": {
+			schedule: token.VestingSchedule{
+				StartTime: 100,
+				EndTime:   200,
+				Periods: []token.VestingPeriod{
+					{Length: 10, Amount: sdk.NewInt(10)},
+				},
+			},
+			amount: sdk.NewInt(10),
+			err:    sdkerrors.ErrInvalidRequest,
+		},
+		"invalid period length": {
+			schedule: token.VestingSchedule{
+				StartTime: 100,
+				Periods: []token.VestingPeriod{
+					{Length: 0, Amount: sdk.NewInt(10)},
+				},
+			},
+			amount: sdk.NewInt(10),
+			err:    sdkerrors.ErrInvalidRequest,
+		},
+		"invalid period amount": {
+			schedule: token.VestingSchedule{
+				StartTime: 100,
+				Periods: []token.VestingPeriod{
+					{Length: 10, Amount: sdk.NewInt(10)},
+					{Length: 10, Amount: sdk.ZeroInt()},
+				},
+			},
+			amount: sdk.NewInt(10),
+			err:    token.ErrInvalidAmount,
+		},
+		"sum of periods not matching": {
+			schedule: token.VestingSchedule{
+				StartTime: 100,
+				Periods: []token.VestingPeriod{
+					{Length: 10, Amount: sdk.NewInt(9)},
+				},
+			},
+			amount: sdk.NewInt(10),
+			err:    sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.schedule.ValidateBasic(tc.amount)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestVestingScheduleLockedAt(t *testing.T) {
+	amount := sdk.NewInt(100)
+	continuous := token.VestingSchedule{
+		StartTime: 1000,
+		EndTime:   2000,
+	}
+	periodic := token.VestingSchedule{
+		StartTime: 1000,
+		Periods: []token.VestingPeriod{
+			{Length: 100, Amount: sdk.NewInt(30)},
+			{Length: 900, Amount: sdk.NewInt(70)},
+		},
+	}
+
+	testCases := map[string]struct {
+		schedule  token.VestingSchedule
+		blockTime int64
+		locked    sdk.Int
+	}{
+		"continuous, before the start": {
+			schedule:  continuous,
+			blockTime: 999,
+			locked:    amount,
+		},
+		"continuous, at the start": {
+			schedule:  continuous,
+			blockTime: 1000,
+			locked:    amount,
+		},
+		"continuous, in the middle": {
+			schedule:  continuous,
+			blockTime: 1255,
+			locked:    sdk.NewInt(75),
+		},
+		"continuous, at the end": {
+			schedule:  continuous,
+			blockTime: 2000,
+			locked:    sdk.ZeroInt(),
+		},
+		"periodic, before the first period ends": {
+			schedule:  periodic,
+			blockTime: 1099,
+			locked:    amount,
+		},
+		"periodic, after the first period": {
+			schedule:  periodic,
+			blockTime: 1100,
+			locked:    sdk.NewInt(70),
+		},
+		"periodic, after all the periods": {
+			schedule:  periodic,
+			blockTime: 2000,
+			locked:    sdk.ZeroInt(),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.locked, tc.schedule.LockedAt(amount, tc.blockTime))
+		})
+	}
+}